@@ -59,6 +59,7 @@ import (
 	"github.com/devtron-labs/devtron/api/restHandler/app/pipeline/trigger"
 	"github.com/devtron-labs/devtron/api/restHandler/app/pipeline/webhook"
 	"github.com/devtron-labs/devtron/api/restHandler/app/workflow"
+	"github.com/devtron-labs/devtron/api/restHandler/artifacts"
 	"github.com/devtron-labs/devtron/api/restHandler/scopedVariable"
 	"github.com/devtron-labs/devtron/api/router"
 	app3 "github.com/devtron-labs/devtron/api/router/app"
@@ -173,6 +174,7 @@ import (
 	"github.com/devtron-labs/devtron/pkg/ucid"
 	util3 "github.com/devtron-labs/devtron/pkg/util"
 	"github.com/devtron-labs/devtron/pkg/variables"
+	"github.com/devtron-labs/devtron/pkg/variables/externalSecret"
 	"github.com/devtron-labs/devtron/pkg/variables/parsers"
 	repository10 "github.com/devtron-labs/devtron/pkg/variables/repository"
 	workflow3 "github.com/devtron-labs/devtron/pkg/workflow"
@@ -264,6 +266,8 @@ func InitializeApp() (*App, error) {
 
 		sql.NewTransactionUtilImpl,
 		wire.Bind(new(sql.TransactionWrapper), new(*sql.TransactionUtilImpl)),
+		sql.NewCronLeaseImpl,
+		wire.Bind(new(sql.CronLease), new(*sql.CronLeaseImpl)),
 
 		trigger.NewPipelineRestHandler,
 		wire.Bind(new(trigger.PipelineTriggerRestHandler), new(*trigger.PipelineTriggerRestHandlerImpl)),
@@ -285,6 +289,10 @@ func InitializeApp() (*App, error) {
 		wire.Bind(new(deployment.DeploymentConfigRestHandler), new(*deployment.DeploymentConfigRestHandlerImpl)),
 		deployment.NewDeploymentRouterImpl,
 		wire.Bind(new(deployment.DeploymentConfigRouter), new(*deployment.DeploymentConfigRouterImpl)),
+		deployment.NewManifestPolicyRestHandlerImpl,
+		wire.Bind(new(deployment.ManifestPolicyRestHandler), new(*deployment.ManifestPolicyRestHandlerImpl)),
+		deployment.NewManifestPolicyRouterImpl,
+		wire.Bind(new(deployment.ManifestPolicyRouter), new(*deployment.ManifestPolicyRouterImpl)),
 
 		dashboardEvent.NewDashboardTelemetryRestHandlerImpl,
 		wire.Bind(new(dashboardEvent.DashboardTelemetryRestHandler), new(*dashboardEvent.DashboardTelemetryRestHandlerImpl)),
@@ -348,6 +356,8 @@ func InitializeApp() (*App, error) {
 		// scoped variables start
 		variables.NewScopedVariableServiceImpl,
 		wire.Bind(new(variables.ScopedVariableService), new(*variables.ScopedVariableServiceImpl)),
+		repository10.NewVariableSetVersionRepositoryImpl,
+		wire.Bind(new(repository10.VariableSetVersionRepository), new(*repository10.VariableSetVersionRepositoryImpl)),
 
 		parsers.NewVariableTemplateParserImpl,
 		wire.Bind(new(parsers.VariableTemplateParser), new(*parsers.VariableTemplateParserImpl)),
@@ -358,9 +368,14 @@ func InitializeApp() (*App, error) {
 		wire.Bind(new(repository10.VariableSnapshotHistoryRepository), new(*repository10.VariableSnapshotHistoryRepositoryImpl)),
 		variables.NewVariableEntityMappingServiceImpl,
 		wire.Bind(new(variables.VariableEntityMappingService), new(*variables.VariableEntityMappingServiceImpl)),
+		variables.NewVariableUsageServiceImpl,
+		wire.Bind(new(variables.VariableUsageService), new(*variables.VariableUsageServiceImpl)),
 		variables.NewVariableSnapshotHistoryServiceImpl,
 		wire.Bind(new(variables.VariableSnapshotHistoryService), new(*variables.VariableSnapshotHistoryServiceImpl)),
 
+		externalSecret.NewExternalSecretResolverImpl,
+		wire.Bind(new(externalSecret.ExternalSecretResolver), new(*externalSecret.ExternalSecretResolverImpl)),
+
 		variables.NewScopedVariableManagerImpl,
 		wire.Bind(new(variables.ScopedVariableManager), new(*variables.ScopedVariableManagerImpl)),
 
@@ -646,6 +661,8 @@ func InitializeApp() (*App, error) {
 		wire.Bind(new(chartGroup2.ChartGroupRouter), new(*chartGroup2.ChartGroupRouterImpl)),
 		repository4.NewChartGroupDeploymentRepositoryImpl,
 		wire.Bind(new(repository4.ChartGroupDeploymentRepository), new(*repository4.ChartGroupDeploymentRepositoryImpl)),
+		repository4.NewChartGroupRolloutRepositoryImpl,
+		wire.Bind(new(repository4.ChartGroupRolloutRepository), new(*repository4.ChartGroupRolloutRepositoryImpl)),
 		repository9.NewClusterInstalledAppsRepositoryImpl,
 		wire.Bind(new(repository9.ClusterInstalledAppsRepository), new(*repository9.ClusterInstalledAppsRepositoryImpl)),
 
@@ -732,6 +749,37 @@ func InitializeApp() (*App, error) {
 		wire.Bind(new(restHandler.WebhookEventHandler), new(*restHandler.WebhookEventHandlerImpl)),
 		router.NewGitHostRouterImpl,
 		wire.Bind(new(router.GitHostRouter), new(*router.GitHostRouterImpl)),
+
+		// Buildpack catalogue
+		restHandler.NewBuildpackCatalogueRestHandlerImpl,
+		wire.Bind(new(restHandler.BuildpackCatalogueRestHandler), new(*restHandler.BuildpackCatalogueRestHandlerImpl)),
+		router.NewBuildpackCatalogueRouterImpl,
+		wire.Bind(new(router.BuildpackCatalogueRouter), new(*router.BuildpackCatalogueRouterImpl)),
+
+		// Sbom
+		artifacts.NewSbomRestHandlerImpl,
+		wire.Bind(new(artifacts.SbomRestHandler), new(*artifacts.SbomRestHandlerImpl)),
+		router.NewSbomRouterImpl,
+		wire.Bind(new(router.SbomRouter), new(*router.SbomRouterImpl)),
+
+		// Image signing
+		restHandler.NewImageSigningRestHandlerImpl,
+		wire.Bind(new(restHandler.ImageSigningRestHandler), new(*restHandler.ImageSigningRestHandlerImpl)),
+		router.NewImageSigningRouterImpl,
+		wire.Bind(new(router.ImageSigningRouter), new(*router.ImageSigningRouterImpl)),
+
+		// Provenance
+		artifacts.NewProvenanceRestHandlerImpl,
+		wire.Bind(new(artifacts.ProvenanceRestHandler), new(*artifacts.ProvenanceRestHandlerImpl)),
+		router.NewProvenanceRouterImpl,
+		wire.Bind(new(router.ProvenanceRouter), new(*router.ProvenanceRouterImpl)),
+
+		// CVE exception
+		restHandler.NewCveExceptionRestHandlerImpl,
+		wire.Bind(new(restHandler.CveExceptionRestHandler), new(*restHandler.CveExceptionRestHandlerImpl)),
+		router.NewCveExceptionRouterImpl,
+		wire.Bind(new(router.CveExceptionRouter), new(*router.CveExceptionRouterImpl)),
+
 		router.NewWebhookListenerRouterImpl,
 		wire.Bind(new(router.WebhookListenerRouter), new(*router.WebhookListenerRouterImpl)),
 		repository.NewWebhookEventDataRepositoryImpl,
@@ -925,10 +973,14 @@ func InitializeApp() (*App, error) {
 		wire.Bind(new(executors.ArgoWorkflowExecutor), new(*executors.ArgoWorkflowExecutorImpl)),
 		executors.NewSystemWorkflowExecutorImpl,
 		wire.Bind(new(executors.SystemWorkflowExecutor), new(*executors.SystemWorkflowExecutorImpl)),
+		executors.NewTektonWorkflowExecutorImpl,
+		wire.Bind(new(executors.TektonWorkflowExecutor), new(*executors.TektonWorkflowExecutorImpl)),
 		repository5.NewManifestPushConfigRepository,
 		wire.Bind(new(repository5.ManifestPushConfigRepository), new(*repository5.ManifestPushConfigRepositoryImpl)),
 		publish.NewGitOpsManifestPushServiceImpl,
 		wire.Bind(new(publish.GitOpsPushService), new(*publish.GitOpsManifestPushServiceImpl)),
+		publish.NewOCIManifestPushServiceImpl,
+		wire.Bind(new(publish.OCIPushService), new(*publish.OCIManifestPushServiceImpl)),
 
 		// start: docker registry wire set injection
 		router.NewDockerRegRouterImpl,
@@ -400,6 +400,7 @@ func (handler *InstalledAppRestHandlerImpl) DeployBulk(w http.ResponseWriter, r
 		common.WriteJsonResp(w, err, nil, http.StatusInternalServerError)
 		return
 	} else {
+		authRes.RolloutId = res.RolloutId
 		res = authRes
 	}
 	common.WriteJsonResp(w, err, res, http.StatusOK)
@@ -56,6 +56,7 @@ type ChartGroupRestHandler interface {
 	CreateChartGroup(w http.ResponseWriter, r *http.Request)
 	UpdateChartGroup(w http.ResponseWriter, r *http.Request)
 	SaveChartGroupEntries(w http.ResponseWriter, r *http.Request)
+	GetChartGroupRollout(w http.ResponseWriter, r *http.Request)
 	GetChartGroupWithChartMetaData(w http.ResponseWriter, r *http.Request)
 	GetChartGroupList(w http.ResponseWriter, r *http.Request)
 	GetChartGroupInstallationDetail(w http.ResponseWriter, r *http.Request)
@@ -247,6 +248,37 @@ func (impl *ChartGroupRestHandlerImpl) GetChartGroupInstallationDetail(w http.Re
 	common.WriteJsonResp(w, err, res, http.StatusOK)
 }
 
+func (impl *ChartGroupRestHandlerImpl) GetChartGroupRollout(w http.ResponseWriter, r *http.Request) {
+	userId, err := impl.userAuthService.GetLoggedInUser(r)
+	if userId == 0 || err != nil {
+		common.HandleUnauthorized(w, r)
+		return
+	}
+
+	rolloutId, err := common.ExtractIntPathParamWithContext(w, r, "rolloutId")
+	if err != nil {
+		// Error already written by ExtractIntPathParamWithContext
+		return
+	}
+
+	//RBAC block starts from here
+	token := r.Header.Get("token")
+	rbacObject := ""
+	if ok := impl.enforcer.Enforce(token, casbin.ResourceChartGroup, casbin.ActionGet, rbacObject); !ok {
+		common.WriteJsonResp(w, fmt.Errorf("unauthorized user"), "Unauthorized User", http.StatusForbidden)
+		return
+	}
+	//RBAC block ends here
+
+	res, err := impl.ChartGroupService.GetRollout(rolloutId)
+	if err != nil {
+		impl.Logger.Errorw("service err, GetChartGroupRollout", "err", err, "rolloutId", rolloutId)
+		common.WriteJsonResp(w, err, nil, http.StatusInternalServerError)
+		return
+	}
+	common.WriteJsonResp(w, err, res, http.StatusOK)
+}
+
 func (impl *ChartGroupRestHandlerImpl) GetChartGroupList(w http.ResponseWriter, r *http.Request) {
 	userId, err := impl.userAuthService.GetLoggedInUser(r)
 	if userId == 0 || err != nil {
@@ -45,6 +45,8 @@ func (impl *ChartGroupRouterImpl) InitChartGroupRouter(chartGroupRouter *mux.Rou
 		HandlerFunc(impl.ChartGroupRestHandler.GetChartGroupWithChartMetaData).Methods("GET")
 	chartGroupRouter.Path("/installation-detail/{chartGroupId}").
 		HandlerFunc(impl.ChartGroupRestHandler.GetChartGroupInstallationDetail).Methods("GET")
+	chartGroupRouter.Path("/rollout/{rolloutId}").
+		HandlerFunc(impl.ChartGroupRestHandler.GetChartGroupRollout).Methods("GET")
 
 	chartGroupRouter.Path("/list/min").
 		HandlerFunc(impl.ChartGroupRestHandler.GetChartGroupListMin).Methods("GET")
@@ -29,6 +29,7 @@ import (
 	"github.com/devtron-labs/devtron/pkg/appStore/installedApp/service"
 	"github.com/devtron-labs/devtron/pkg/appStore/installedApp/service/EAMode"
 	bean2 "github.com/devtron-labs/devtron/pkg/appStore/installedApp/service/bean"
+	"github.com/devtron-labs/devtron/pkg/appStore/installedApp/service/upgradeAdvisor"
 	"github.com/devtron-labs/devtron/pkg/attributes"
 	"github.com/devtron-labs/devtron/pkg/auth/authorisation/casbin"
 	"github.com/devtron-labs/devtron/pkg/auth/user"
@@ -51,6 +52,10 @@ type AppStoreDeploymentRestHandler interface {
 	UpdateInstalledApp(w http.ResponseWriter, r *http.Request)
 	GetInstalledAppVersion(w http.ResponseWriter, r *http.Request)
 	UpdateProjectHelmApp(w http.ResponseWriter, r *http.Request)
+	GetChartUpgradeReport(w http.ResponseWriter, r *http.Request)
+	GetChartUpgradePreview(w http.ResponseWriter, r *http.Request)
+	UpgradeInstalledApp(w http.ResponseWriter, r *http.Request)
+	GetOutdatedInstalledApps(w http.ResponseWriter, r *http.Request)
 }
 
 type AppStoreDeploymentRestHandlerImpl struct {
@@ -65,6 +70,7 @@ type AppStoreDeploymentRestHandlerImpl struct {
 	helmAppService              service2.HelmAppService
 	installAppService           EAMode.InstalledAppDBService
 	attributesService           attributes.AttributesService
+	chartUpgradeAdvisorService  upgradeAdvisor.ChartUpgradeAdvisorService
 }
 
 func NewAppStoreDeploymentRestHandlerImpl(Logger *zap.SugaredLogger, userAuthService user.UserService,
@@ -73,7 +79,8 @@ func NewAppStoreDeploymentRestHandlerImpl(Logger *zap.SugaredLogger, userAuthSer
 	appStoreDeploymentDBService service.AppStoreDeploymentDBService,
 	validator *validator.Validate,
 	helmAppService service2.HelmAppService,
-	installAppService EAMode.InstalledAppDBService, attributesService attributes.AttributesService) *AppStoreDeploymentRestHandlerImpl {
+	installAppService EAMode.InstalledAppDBService, attributesService attributes.AttributesService,
+	chartUpgradeAdvisorService upgradeAdvisor.ChartUpgradeAdvisorService) *AppStoreDeploymentRestHandlerImpl {
 	return &AppStoreDeploymentRestHandlerImpl{
 		Logger:                      Logger,
 		userAuthService:             userAuthService,
@@ -86,6 +93,7 @@ func NewAppStoreDeploymentRestHandlerImpl(Logger *zap.SugaredLogger, userAuthSer
 		helmAppService:              helmAppService,
 		installAppService:           installAppService,
 		attributesService:           attributesService,
+		chartUpgradeAdvisorService:  chartUpgradeAdvisorService,
 	}
 }
 
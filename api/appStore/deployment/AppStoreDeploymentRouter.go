@@ -56,4 +56,16 @@ func (router AppStoreDeploymentRouterImpl) Init(configRouter *mux.Router) {
 	configRouter.Path("/application/update/project").
 		HandlerFunc(router.appStoreDeploymentRestHandler.UpdateProjectHelmApp).Methods("PUT")
 
+	configRouter.Path("/application/outdated").
+		HandlerFunc(router.appStoreDeploymentRestHandler.GetOutdatedInstalledApps).Methods("GET")
+
+	configRouter.Path("/application/upgrade-report/{installedAppVersionId}").
+		HandlerFunc(router.appStoreDeploymentRestHandler.GetChartUpgradeReport).Methods("GET")
+
+	configRouter.Path("/application/upgrade-preview").
+		HandlerFunc(router.appStoreDeploymentRestHandler.GetChartUpgradePreview).Methods("POST")
+
+	configRouter.Path("/application/upgrade").
+		HandlerFunc(router.appStoreDeploymentRestHandler.UpgradeInstalledApp).Methods("POST")
+
 }
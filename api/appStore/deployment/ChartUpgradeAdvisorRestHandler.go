@@ -0,0 +1,208 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package appStoreDeployment
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/devtron-labs/devtron/api/restHandler/common"
+	"github.com/devtron-labs/devtron/internal/util"
+	"github.com/devtron-labs/devtron/pkg/appStore/installedApp/service/upgradeAdvisor"
+	"github.com/devtron-labs/devtron/pkg/auth/authorisation/casbin"
+	util2 "github.com/devtron-labs/devtron/util"
+	"github.com/gorilla/mux"
+	"net/http"
+	"strconv"
+)
+
+func (handler AppStoreDeploymentRestHandlerImpl) GetChartUpgradeReport(w http.ResponseWriter, r *http.Request) {
+	userId, err := handler.userAuthService.GetLoggedInUser(r)
+	if userId == 0 || err != nil {
+		common.HandleUnauthorized(w, r)
+		return
+	}
+	vars := mux.Vars(r)
+	installedAppVersionId, err := strconv.Atoi(vars["installedAppVersionId"])
+	if err != nil {
+		handler.Logger.Errorw("request err, GetChartUpgradeReport", "err", err, "installedAppVersionId", vars["installedAppVersionId"])
+		common.WriteJsonResp(w, err, nil, http.StatusBadRequest)
+		return
+	}
+	token := r.Header.Get("token")
+	if ok := handler.checkInstalledAppVersionGetAccess(w, r, token, installedAppVersionId, userId); !ok {
+		return
+	}
+	report, err := handler.chartUpgradeAdvisorService.GetUpgradeReport(installedAppVersionId)
+	if err != nil {
+		handler.Logger.Errorw("service err, GetChartUpgradeReport", "err", err, "installedAppVersionId", installedAppVersionId)
+		handler.writeChartUpgradeErrResp(w, r, err, installedAppVersionId)
+		return
+	}
+	common.WriteJsonResp(w, nil, report, http.StatusOK)
+}
+
+func (handler AppStoreDeploymentRestHandlerImpl) GetChartUpgradePreview(w http.ResponseWriter, r *http.Request) {
+	userId, err := handler.userAuthService.GetLoggedInUser(r)
+	if userId == 0 || err != nil {
+		common.HandleUnauthorized(w, r)
+		return
+	}
+	var request upgradeAdvisor.UpgradePreviewRequest
+	decoder := json.NewDecoder(r.Body)
+	err = decoder.Decode(&request)
+	if err != nil {
+		handler.Logger.Errorw("request err, GetChartUpgradePreview", "err", err, "payload", request)
+		common.WriteJsonResp(w, err, nil, http.StatusBadRequest)
+		return
+	}
+	err = handler.validator.Struct(request)
+	if err != nil {
+		handler.Logger.Errorw("validation err, GetChartUpgradePreview", "err", err, "payload", request)
+		common.WriteJsonResp(w, err, nil, http.StatusBadRequest)
+		return
+	}
+	token := r.Header.Get("token")
+	if ok := handler.checkInstalledAppVersionGetAccess(w, r, token, request.InstalledAppVersionId, userId); !ok {
+		return
+	}
+	preview, err := handler.chartUpgradeAdvisorService.GetUpgradePreview(&request)
+	if err != nil {
+		handler.Logger.Errorw("service err, GetChartUpgradePreview", "err", err, "payload", request)
+		handler.writeChartUpgradeErrResp(w, r, err, request.InstalledAppVersionId)
+		return
+	}
+	common.WriteJsonResp(w, nil, preview, http.StatusOK)
+}
+
+// UpgradeInstalledApp deploys the installed app with the target chart version and the values reviewed in the upgrade preview
+func (handler AppStoreDeploymentRestHandlerImpl) UpgradeInstalledApp(w http.ResponseWriter, r *http.Request) {
+	userId, err := handler.userAuthService.GetLoggedInUser(r)
+	if userId == 0 || err != nil {
+		common.HandleUnauthorized(w, r)
+		return
+	}
+	var request upgradeAdvisor.UpgradeRequest
+	decoder := json.NewDecoder(r.Body)
+	err = decoder.Decode(&request)
+	if err != nil {
+		handler.Logger.Errorw("request err, UpgradeInstalledApp", "err", err, "payload", request)
+		common.WriteJsonResp(w, err, nil, http.StatusBadRequest)
+		return
+	}
+	err = handler.validator.Struct(request)
+	if err != nil {
+		handler.Logger.Errorw("validation err, UpgradeInstalledApp", "err", err, "payload", request)
+		common.WriteJsonResp(w, err, nil, http.StatusBadRequest)
+		return
+	}
+	installAppVersion, err := handler.installAppService.GetInstalledAppVersion(request.InstalledAppVersionId, userId)
+	if err != nil {
+		handler.Logger.Errorw("service err, GetInstalledAppVersion", "err", err, "installedAppVersionId", request.InstalledAppVersionId)
+		handler.writeChartUpgradeErrResp(w, r, err, request.InstalledAppVersionId)
+		return
+	}
+	token := r.Header.Get("token")
+	//rbac block starts from here
+	if !handler.isInstalledAppAuthorised(token, casbin.ActionUpdate, installAppVersion.AppOfferingMode, installAppVersion.ClusterId, installAppVersion.Namespace, installAppVersion.AppName, installAppVersion.EnvironmentId) {
+		common.WriteJsonResp(w, fmt.Errorf("unauthorized user"), nil, http.StatusForbidden)
+		return
+	}
+	//rbac block ends here
+	err = handler.chartUpgradeAdvisorService.SetUpgradeInInstallAppVersion(&request, installAppVersion)
+	if err != nil {
+		handler.Logger.Errorw("service err, UpgradeInstalledApp", "err", err, "payload", request)
+		handler.writeChartUpgradeErrResp(w, r, err, request.InstalledAppVersionId)
+		return
+	}
+	ctx := r.Context()
+	if util2.IsBaseStack() || util2.IsHelmApp(installAppVersion.AppOfferingMode) {
+		ctx = context.WithValue(r.Context(), "token", token)
+	}
+	res, err := handler.appStoreDeploymentService.UpdateInstalledApp(ctx, installAppVersion)
+	if err != nil {
+		handler.Logger.Errorw("service err, UpgradeInstalledApp", "err", err, "payload", request)
+		common.WriteJsonResp(w, err, nil, http.StatusInternalServerError)
+		return
+	}
+	common.WriteJsonResp(w, nil, res, http.StatusOK)
+}
+
+func (handler AppStoreDeploymentRestHandlerImpl) GetOutdatedInstalledApps(w http.ResponseWriter, r *http.Request) {
+	userId, err := handler.userAuthService.GetLoggedInUser(r)
+	if userId == 0 || err != nil {
+		common.HandleUnauthorized(w, r)
+		return
+	}
+	token := r.Header.Get("token")
+	outdatedApps, err := handler.chartUpgradeAdvisorService.GetOutdatedInstalledApps()
+	if err != nil {
+		handler.Logger.Errorw("service err, GetOutdatedInstalledApps", "err", err)
+		common.WriteJsonResp(w, err, nil, http.StatusInternalServerError)
+		return
+	}
+	//rbac block starts from here
+	authorisedApps := make([]*upgradeAdvisor.OutdatedInstalledApp, 0, len(outdatedApps))
+	for _, outdatedApp := range outdatedApps {
+		if handler.isInstalledAppAuthorised(token, casbin.ActionGet, outdatedApp.AppOfferingMode, outdatedApp.ClusterId, outdatedApp.Namespace, outdatedApp.AppName, outdatedApp.EnvironmentId) {
+			authorisedApps = append(authorisedApps, outdatedApp)
+		}
+	}
+	//rbac block ends here
+	common.WriteJsonResp(w, nil, authorisedApps, http.StatusOK)
+}
+
+// writeChartUpgradeErrResp writes not found for a missing installed app version or chart version and internal server error otherwise
+func (handler AppStoreDeploymentRestHandlerImpl) writeChartUpgradeErrResp(w http.ResponseWriter, r *http.Request, err error, installedAppVersionId int) {
+	if apiErr, ok := err.(*util.ApiError); ok && apiErr.HttpStatusCode == http.StatusNotFound {
+		common.WriteJsonResp(w, err, nil, http.StatusNotFound)
+		return
+	} else if util.IsErrNoRows(err) {
+		common.HandleResourceNotFound(w, r, "installedAppVersion", strconv.Itoa(installedAppVersionId))
+		return
+	}
+	common.WriteJsonResp(w, err, nil, http.StatusInternalServerError)
+}
+
+// checkInstalledAppVersionGetAccess writes the error response and returns false if the user does not have get access on the installed app
+func (handler AppStoreDeploymentRestHandlerImpl) checkInstalledAppVersionGetAccess(w http.ResponseWriter, r *http.Request, token string, installedAppVersionId int, userId int32) bool {
+	dto, err := handler.installAppService.GetInstalledAppVersion(installedAppVersionId, userId)
+	if err != nil {
+		handler.Logger.Errorw("service err, GetInstalledAppVersion", "err", err, "installedAppVersionId", installedAppVersionId)
+		handler.writeChartUpgradeErrResp(w, r, err, installedAppVersionId)
+		return false
+	}
+	if !handler.isInstalledAppAuthorised(token, casbin.ActionGet, dto.AppOfferingMode, dto.ClusterId, dto.Namespace, dto.AppName, dto.EnvironmentId) {
+		common.WriteJsonResp(w, fmt.Errorf("unauthorized user"), nil, http.StatusForbidden)
+		return false
+	}
+	return true
+}
+
+func (handler AppStoreDeploymentRestHandlerImpl) isInstalledAppAuthorised(token string, action string, appOfferingMode string, clusterId int, namespace string, appName string, envId int) bool {
+	var rbacObject string
+	var rbacObject2 string
+	if util2.IsHelmApp(appOfferingMode) {
+		rbacObject, rbacObject2 = handler.enforcerUtilHelm.GetHelmObjectByClusterIdNamespaceAndAppName(clusterId, namespace, appName)
+	} else {
+		rbacObject, rbacObject2 = handler.enforcerUtil.GetHelmObjectByAppNameAndEnvId(appName, envId)
+	}
+	if rbacObject2 == "" {
+		return handler.enforcer.Enforce(token, casbin.ResourceHelmApp, action, rbacObject)
+	}
+	return handler.enforcer.Enforce(token, casbin.ResourceHelmApp, action, rbacObject) || handler.enforcer.Enforce(token, casbin.ResourceHelmApp, action, rbacObject2)
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/devtron-labs/devtron/pkg/appStore/installedApp/service/FullMode/deploymentTypeChange"
 	"github.com/devtron-labs/devtron/pkg/appStore/installedApp/service/FullMode/resource"
 	appStoreDeploymentCommon "github.com/devtron-labs/devtron/pkg/appStore/installedApp/service/common"
+	"github.com/devtron-labs/devtron/pkg/appStore/installedApp/service/upgradeAdvisor"
 	"github.com/google/wire"
 )
 
@@ -59,6 +60,11 @@ var EAModeWireSet = wire.NewSet(
 	EAMode.NewInstalledAppDBServiceImpl,
 	wire.Bind(new(EAMode.InstalledAppDBService), new(*EAMode.InstalledAppDBServiceImpl)),
 
+	repository3.NewInstalledAppUpgradeAdvisoryRepositoryImpl,
+	wire.Bind(new(repository3.InstalledAppUpgradeAdvisoryRepository), new(*repository3.InstalledAppUpgradeAdvisoryRepositoryImpl)),
+	upgradeAdvisor.NewChartUpgradeAdvisorServiceImpl,
+	wire.Bind(new(upgradeAdvisor.ChartUpgradeAdvisorService), new(*upgradeAdvisor.ChartUpgradeAdvisorServiceImpl)),
+
 	installedAppReader.EAWireSet,
 )
 
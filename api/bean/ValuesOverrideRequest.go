@@ -19,6 +19,7 @@ package bean
 import (
 	"encoding/json"
 	"github.com/devtron-labs/devtron/internal/sql/models"
+	manifestPolicyBean "github.com/devtron-labs/devtron/pkg/deployment/manifest/policy/bean"
 	"github.com/devtron-labs/devtron/pkg/pipeline/repository"
 )
 
@@ -92,6 +93,9 @@ type ValuesOverrideRequest struct {
 	Namespace                             string                      `json:"-"`
 	ReleaseName                           string                      `json:"-"`
 	Image                                 string                      `json:"-"`
+	IsDryRun                              bool                        `json:"-"` // renders the trigger values without persisting pipeline override or env override
+	// ManifestPolicyViolations are the manifest policy violations found for the trigger, returned in the trigger response
+	ManifestPolicyViolations []*manifestPolicyBean.PolicyViolation `json:"-"`
 }
 
 type BulkCdDeployEvent struct {
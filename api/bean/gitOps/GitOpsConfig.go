@@ -41,6 +41,8 @@ type GitOpsConfigDto struct {
 	IsTLSCertDataPresent bool `json:"isTLSCertDataPresent"`
 	IsTLSKeyDataPresent  bool `json:"isTLSKeyDataPresent"`
 
+	CommitSigningConfig *CommitSigningConfig `json:"commitSigningConfig,omitempty"`
+
 	// TODO refactoring: create different struct for internal fields
 	GitRepoName    string `json:"-"`
 	TargetRevision string `json:"-"`
@@ -53,6 +55,16 @@ func (dto GitOpsConfigDto) GetHostUrl() string {
 	return dto.Host
 }
 
+// CommitSigningConfig is the GPG or SSH private key used to sign the commits pushed in gitOps repositories.
+// SigningKey and Passphrase are never sent back in the response, IsSigningKeyPresent is set instead.
+type CommitSigningConfig struct {
+	Enabled             bool   `json:"enabled"`
+	Format              string `json:"format" validate:"omitempty,oneof=GPG SSH"`
+	SigningKey          string `json:"signingKey,omitempty"`
+	Passphrase          string `json:"passphrase,omitempty"`
+	IsSigningKeyPresent bool   `json:"isSigningKeyPresent"`
+}
+
 type GitRepoRequestDto struct {
 	Host                 string `json:"host"`
 	Provider             string `json:"provider"`
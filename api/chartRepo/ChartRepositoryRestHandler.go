@@ -17,10 +17,12 @@
 package chartRepo
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"mime/multipart"
 	"net/http"
+	"path"
 
 	"github.com/devtron-labs/devtron/api/restHandler/common"
 	"github.com/devtron-labs/devtron/internal/util"
@@ -29,6 +31,7 @@ import (
 	"github.com/devtron-labs/devtron/pkg/auth/user"
 	"github.com/devtron-labs/devtron/pkg/chartRepo"
 	delete2 "github.com/devtron-labs/devtron/pkg/delete"
+	"github.com/gorilla/mux"
 	"go.uber.org/zap"
 	"gopkg.in/go-playground/validator.v9"
 )
@@ -51,6 +54,8 @@ type ChartRepositoryRestHandler interface {
 	ValidateChartRepo(w http.ResponseWriter, r *http.Request)
 	TriggerChartSyncManual(w http.ResponseWriter, r *http.Request)
 	DeleteChartRepo(w http.ResponseWriter, r *http.Request)
+	SyncChartRepoMirror(w http.ResponseWriter, r *http.Request)
+	ServeMirroredChartRepoFile(w http.ResponseWriter, r *http.Request)
 }
 
 type ChartRepositoryRestHandlerImpl struct {
@@ -325,3 +330,60 @@ func (handler *ChartRepositoryRestHandlerImpl) DeleteChartRepo(w http.ResponseWr
 	}
 	common.WriteJsonResp(w, nil, CHART_REPO_DELETE_SUCCESS_RESP, http.StatusOK)
 }
+
+func (handler *ChartRepositoryRestHandlerImpl) SyncChartRepoMirror(w http.ResponseWriter, r *http.Request) {
+	userId, err := handler.userAuthService.GetLoggedInUser(r)
+	if userId == 0 || err != nil {
+		common.WriteJsonResp(w, err, nil, http.StatusUnauthorized)
+		return
+	}
+	var request chartRepo.MirrorSyncRequest
+	err = json.NewDecoder(r.Body).Decode(&request)
+	if err != nil {
+		handler.Logger.Errorw("request err, SyncChartRepoMirror", "err", err, "payload", request)
+		common.WriteJsonResp(w, err, nil, http.StatusBadRequest)
+		return
+	}
+	err = handler.validator.Struct(request)
+	if err != nil {
+		handler.Logger.Errorw("validation err, SyncChartRepoMirror", "err", err, "payload", request)
+		common.WriteJsonResp(w, err, nil, http.StatusBadRequest)
+		return
+	}
+	request.UserId = userId
+
+	// RBAC enforcer applying
+	token := r.Header.Get("token")
+	if ok := handler.enforcer.Enforce(token, casbin.ResourceGlobal, casbin.ActionCreate, "*"); !ok {
+		common.WriteJsonResp(w, errors.New("unauthorized"), nil, http.StatusForbidden)
+		return
+	}
+	err = handler.chartRepositoryService.SyncChartRepoMirror(&request)
+	if err != nil {
+		handler.Logger.Errorw("service err, SyncChartRepoMirror", "err", err, "payload", request)
+		common.WriteJsonResp(w, err, nil, http.StatusInternalServerError)
+		return
+	}
+	common.WriteJsonResp(w, nil, map[string]string{"status": "ok"}, http.StatusOK)
+}
+
+// ServeMirroredChartRepoFile serves the index file and chart archives of a mirrored chart repository to helm clients,
+// argocd and kubelink authenticate with the pull credentials of the repository over basic auth instead of a devtron token
+func (handler *ChartRepositoryRestHandlerImpl) ServeMirroredChartRepoFile(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	userName, password, _ := r.BasicAuth()
+	file, err := handler.chartRepositoryService.GetMirroredChartRepoFile(vars["name"], vars["path"], userName, password)
+	if err != nil {
+		handler.Logger.Debugw("error in serving mirrored chart repo file", "name", vars["name"], "path", vars["path"], "err", err)
+		statusCode := http.StatusInternalServerError
+		if apiErr, ok := err.(*util.ApiError); ok {
+			statusCode = apiErr.HttpStatusCode
+		}
+		if statusCode == http.StatusUnauthorized {
+			w.Header().Set("WWW-Authenticate", `Basic realm="devtron chart mirror"`)
+		}
+		http.Error(w, http.StatusText(statusCode), statusCode)
+		return
+	}
+	http.ServeContent(w, r, path.Base(file.FilePath), file.UpdatedOn, bytes.NewReader(file.Content))
+}
@@ -35,6 +35,10 @@ func NewChartRepositoryRouterImpl(chartRepositoryRestHandler ChartRepositoryRest
 func (router ChartRepositoryRouterImpl) Init(configRouter *mux.Router) {
 	configRouter.Path("/sync-charts").
 		HandlerFunc(router.chartRepositoryRestHandler.TriggerChartSyncManual).Methods("POST")
+	configRouter.Path("/mirror/sync").
+		HandlerFunc(router.chartRepositoryRestHandler.SyncChartRepoMirror).Methods("POST")
+	configRouter.Path("/mirror/serve/{name}/{path:.+}").
+		HandlerFunc(router.chartRepositoryRestHandler.ServeMirroredChartRepoFile).Methods("GET", "HEAD")
 	configRouter.Path("/list").
 		HandlerFunc(router.chartRepositoryRestHandler.GetChartRepoList).Methods("GET")
 	configRouter.Path("/list/min").
@@ -18,6 +18,7 @@ package chartRepo
 
 import (
 	chartRepo "github.com/devtron-labs/devtron/pkg/chartRepo"
+	"github.com/devtron-labs/devtron/pkg/chartRepo/mirror"
 	chartRepoRepository "github.com/devtron-labs/devtron/pkg/chartRepo/repository"
 	"github.com/google/wire"
 )
@@ -29,6 +30,10 @@ var ChartRepositoryWireSet = wire.NewSet(
 	wire.Bind(new(chartRepoRepository.ChartRefRepository), new(*chartRepoRepository.ChartRefRepositoryImpl)),
 	chartRepoRepository.NewChartRepository,
 	wire.Bind(new(chartRepoRepository.ChartRepository), new(*chartRepoRepository.ChartRepositoryImpl)),
+	chartRepoRepository.NewChartRepoMirrorFileRepositoryImpl,
+	wire.Bind(new(chartRepoRepository.ChartRepoMirrorFileRepository), new(*chartRepoRepository.ChartRepoMirrorFileRepositoryImpl)),
+	mirror.NewChartRepoMirrorServiceImpl,
+	wire.Bind(new(mirror.ChartRepoMirrorService), new(*mirror.ChartRepoMirrorServiceImpl)),
 	chartRepo.NewChartRepositoryServiceImpl,
 	wire.Bind(new(chartRepo.ChartRepositoryService), new(*chartRepo.ChartRepositoryServiceImpl)),
 	NewChartRepositoryRestHandlerImpl,
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"github.com/devtron-labs/devtron/pkg/deployment/manifest/deploymentTemplate/chartRef"
 	"github.com/devtron-labs/devtron/pkg/deployment/manifest/deploymentTemplate/chartRef/bean"
+	"github.com/devtron-labs/devtron/pkg/deployment/manifest/deploymentTemplate/chartRef/lint"
 	"io/ioutil"
 	"net/http"
 	"os"
@@ -47,11 +48,12 @@ type DeploymentConfigRestHandler interface {
 }
 
 type DeploymentConfigRestHandlerImpl struct {
-	Logger          *zap.SugaredLogger
-	userAuthService user.UserService
-	enforcer        casbin.Enforcer
-	chartService    chart.ChartService
-	chartRefService chartRef.ChartRefService
+	Logger           *zap.SugaredLogger
+	userAuthService  user.UserService
+	enforcer         casbin.Enforcer
+	chartService     chart.ChartService
+	chartRefService  chartRef.ChartRefService
+	chartLintService lint.ChartLintService
 }
 
 type DeploymentChartInfo struct {
@@ -61,16 +63,20 @@ type DeploymentChartInfo struct {
 	FileId       string `json:"fileId"`
 	Action       string `json:"action"`
 	Message      string `json:"message"`
+	// LintWarnings are the non blocking findings of the custom chart lint on upload
+	LintWarnings []string `json:"lintWarnings,omitempty"`
 }
 
 func NewDeploymentConfigRestHandlerImpl(Logger *zap.SugaredLogger, userAuthService user.UserService, enforcer casbin.Enforcer,
-	chartService chart.ChartService, chartRefService chartRef.ChartRefService) *DeploymentConfigRestHandlerImpl {
+	chartService chart.ChartService, chartRefService chartRef.ChartRefService,
+	chartLintService lint.ChartLintService) *DeploymentConfigRestHandlerImpl {
 	return &DeploymentConfigRestHandlerImpl{
-		Logger:          Logger,
-		userAuthService: userAuthService,
-		enforcer:        enforcer,
-		chartService:    chartService,
-		chartRefService: chartRefService,
+		Logger:           Logger,
+		userAuthService:  userAuthService,
+		enforcer:         enforcer,
+		chartService:     chartService,
+		chartRefService:  chartRefService,
+		chartLintService: chartLintService,
 	}
 }
 
@@ -131,6 +137,17 @@ func (handler *DeploymentConfigRestHandlerImpl) CreateChartFromFile(w http.Respo
 		return
 	}
 
+	lintReport := handler.chartLintService.LintCustomChart(r.Context(), chartInfo.ChartWorkingDir)
+	if lintReport.HasErrors() {
+		handler.Logger.Errorw("custom chart lint failed", "chartName", chartInfo.ChartName, "chartVersion", chartInfo.ChartVersion, "lintErrors", lintReport.Errors)
+		err1 := os.RemoveAll(chartInfo.TemporaryFolder)
+		if err1 != nil {
+			handler.Logger.Errorw("error in deleting temp dir ", "err", err1)
+		}
+		common.WriteJsonResp(w, fmt.Errorf("chart lint failed: %s", strings.Join(lintReport.Errors, "; ")), lintReport, http.StatusBadRequest)
+		return
+	}
+
 	chartRefs := &bean.CustomChartRefDto{
 		Name:             chartInfo.ChartName,
 		Version:          chartInfo.ChartVersion,
@@ -162,6 +179,7 @@ func (handler *DeploymentConfigRestHandlerImpl) CreateChartFromFile(w http.Respo
 		Description:  chartInfo.Description,
 		FileId:       pathList[len(pathList)-1],
 		Message:      chartInfo.Message,
+		LintWarnings: lintReport.Warnings,
 	}
 
 	common.WriteJsonResp(w, err, chartData, http.StatusOK)
@@ -0,0 +1,223 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package deployment
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/devtron-labs/devtron/api/restHandler/common"
+	"github.com/devtron-labs/devtron/pkg/auth/authorisation/casbin"
+	"github.com/devtron-labs/devtron/pkg/auth/user"
+	"github.com/devtron-labs/devtron/pkg/deployment/manifest/policy"
+	"github.com/devtron-labs/devtron/pkg/deployment/manifest/policy/bean"
+	"go.uber.org/zap"
+	"gopkg.in/go-playground/validator.v9"
+)
+
+type ManifestPolicyRestHandler interface {
+	GetPolicies(w http.ResponseWriter, r *http.Request)
+	GetPolicy(w http.ResponseWriter, r *http.Request)
+	CreatePolicy(w http.ResponseWriter, r *http.Request)
+	UpdatePolicy(w http.ResponseWriter, r *http.Request)
+	DeletePolicy(w http.ResponseWriter, r *http.Request)
+	EvaluateManifest(w http.ResponseWriter, r *http.Request)
+}
+
+type ManifestPolicyRestHandlerImpl struct {
+	logger                *zap.SugaredLogger
+	userAuthService       user.UserService
+	validator             *validator.Validate
+	enforcer              casbin.Enforcer
+	manifestPolicyService policy.ManifestPolicyService
+}
+
+func NewManifestPolicyRestHandlerImpl(logger *zap.SugaredLogger, userAuthService user.UserService,
+	validator *validator.Validate, enforcer casbin.Enforcer,
+	manifestPolicyService policy.ManifestPolicyService) *ManifestPolicyRestHandlerImpl {
+	return &ManifestPolicyRestHandlerImpl{
+		logger:                logger,
+		userAuthService:       userAuthService,
+		validator:             validator,
+		enforcer:              enforcer,
+		manifestPolicyService: manifestPolicyService,
+	}
+}
+
+func (handler *ManifestPolicyRestHandlerImpl) GetPolicies(w http.ResponseWriter, r *http.Request) {
+	userId, err := handler.userAuthService.GetLoggedInUser(r)
+	if userId == 0 || err != nil {
+		common.HandleUnauthorized(w, r)
+		return
+	}
+	if !handler.isSuperAdmin(r, casbin.ActionGet) {
+		common.WriteJsonResp(w, nil, "Unauthorized User", http.StatusForbidden)
+		return
+	}
+	res, err := handler.manifestPolicyService.GetPolicies()
+	if err != nil {
+		handler.logger.Errorw("service err, GetPolicies", "err", err)
+		common.WriteJsonResp(w, err, nil, http.StatusInternalServerError)
+		return
+	}
+	common.WriteJsonResp(w, nil, res, http.StatusOK)
+}
+
+func (handler *ManifestPolicyRestHandlerImpl) GetPolicy(w http.ResponseWriter, r *http.Request) {
+	userId, err := handler.userAuthService.GetLoggedInUser(r)
+	if userId == 0 || err != nil {
+		common.HandleUnauthorized(w, r)
+		return
+	}
+	id, err := common.ExtractIntPathParamWithContext(w, r, "id")
+	if err != nil {
+		return
+	}
+	if !handler.isSuperAdmin(r, casbin.ActionGet) {
+		common.WriteJsonResp(w, nil, "Unauthorized User", http.StatusForbidden)
+		return
+	}
+	res, err := handler.manifestPolicyService.GetPolicy(id)
+	if err != nil {
+		handler.logger.Errorw("service err, GetPolicy", "id", id, "err", err)
+		common.WriteJsonResp(w, err, nil, http.StatusInternalServerError)
+		return
+	}
+	common.WriteJsonResp(w, nil, res, http.StatusOK)
+}
+
+func (handler *ManifestPolicyRestHandlerImpl) CreatePolicy(w http.ResponseWriter, r *http.Request) {
+	request, ok := handler.decodePolicyRequest(w, r)
+	if !ok {
+		return
+	}
+	res, err := handler.manifestPolicyService.CreatePolicy(request)
+	if err != nil {
+		handler.logger.Errorw("service err, CreatePolicy", "payload", request, "err", err)
+		common.WriteJsonResp(w, err, nil, http.StatusInternalServerError)
+		return
+	}
+	common.WriteJsonResp(w, nil, res, http.StatusOK)
+}
+
+func (handler *ManifestPolicyRestHandlerImpl) UpdatePolicy(w http.ResponseWriter, r *http.Request) {
+	request, ok := handler.decodePolicyRequest(w, r)
+	if !ok {
+		return
+	}
+	id, err := common.ExtractIntPathParamWithContext(w, r, "id")
+	if err != nil {
+		return
+	}
+	request.Id = id
+	res, err := handler.manifestPolicyService.UpdatePolicy(request)
+	if err != nil {
+		handler.logger.Errorw("service err, UpdatePolicy", "payload", request, "err", err)
+		common.WriteJsonResp(w, err, nil, http.StatusInternalServerError)
+		return
+	}
+	common.WriteJsonResp(w, nil, res, http.StatusOK)
+}
+
+func (handler *ManifestPolicyRestHandlerImpl) DeletePolicy(w http.ResponseWriter, r *http.Request) {
+	userId, err := handler.userAuthService.GetLoggedInUser(r)
+	if userId == 0 || err != nil {
+		common.HandleUnauthorized(w, r)
+		return
+	}
+	id, err := common.ExtractIntPathParamWithContext(w, r, "id")
+	if err != nil {
+		return
+	}
+	if !handler.isSuperAdmin(r, casbin.ActionDelete) {
+		common.WriteJsonResp(w, nil, "Unauthorized User", http.StatusForbidden)
+		return
+	}
+	err = handler.manifestPolicyService.DeletePolicy(id, userId)
+	if err != nil {
+		handler.logger.Errorw("service err, DeletePolicy", "id", id, "err", err)
+		common.WriteJsonResp(w, err, nil, http.StatusInternalServerError)
+		return
+	}
+	common.WriteJsonResp(w, nil, id, http.StatusOK)
+}
+
+// EvaluateManifest is a dry run of the policies against a supplied manifest, used to test rules before enabling them
+func (handler *ManifestPolicyRestHandlerImpl) EvaluateManifest(w http.ResponseWriter, r *http.Request) {
+	userId, err := handler.userAuthService.GetLoggedInUser(r)
+	if userId == 0 || err != nil {
+		common.HandleUnauthorized(w, r)
+		return
+	}
+	var request bean.ManifestPolicyEvaluateRequest
+	err = json.NewDecoder(r.Body).Decode(&request)
+	if err != nil {
+		handler.logger.Errorw("request err, EvaluateManifest", "err", err)
+		common.WriteJsonResp(w, err, nil, http.StatusBadRequest)
+		return
+	}
+	err = handler.validator.Struct(request)
+	if err != nil {
+		handler.logger.Errorw("validation err, EvaluateManifest", "err", err)
+		common.WriteJsonResp(w, err, nil, http.StatusBadRequest)
+		return
+	}
+	if !handler.isSuperAdmin(r, casbin.ActionGet) {
+		common.WriteJsonResp(w, nil, "Unauthorized User", http.StatusForbidden)
+		return
+	}
+	res, err := handler.manifestPolicyService.EvaluateManifestForRequest(&request)
+	if err != nil {
+		handler.logger.Errorw("service err, EvaluateManifest", "envId", request.EnvId, "policyId", request.PolicyId, "err", err)
+		common.WriteJsonResp(w, err, nil, http.StatusInternalServerError)
+		return
+	}
+	common.WriteJsonResp(w, nil, res, http.StatusOK)
+}
+
+func (handler *ManifestPolicyRestHandlerImpl) decodePolicyRequest(w http.ResponseWriter, r *http.Request) (*bean.ManifestPolicyDto, bool) {
+	userId, err := handler.userAuthService.GetLoggedInUser(r)
+	if userId == 0 || err != nil {
+		common.HandleUnauthorized(w, r)
+		return nil, false
+	}
+	var request bean.ManifestPolicyDto
+	err = json.NewDecoder(r.Body).Decode(&request)
+	if err != nil {
+		handler.logger.Errorw("request err, manifest policy", "err", err)
+		common.WriteJsonResp(w, err, nil, http.StatusBadRequest)
+		return nil, false
+	}
+	err = handler.validator.Struct(request)
+	if err != nil {
+		handler.logger.Errorw("validation err, manifest policy", "payload", request, "err", err)
+		common.WriteJsonResp(w, err, nil, http.StatusBadRequest)
+		return nil, false
+	}
+	if !handler.isSuperAdmin(r, casbin.ActionUpdate) {
+		common.WriteJsonResp(w, nil, "Unauthorized User", http.StatusForbidden)
+		return nil, false
+	}
+	request.UserId = userId
+	return &request, true
+}
+
+// isSuperAdmin manifest policies apply across applications, so they are managed by super admins only
+func (handler *ManifestPolicyRestHandlerImpl) isSuperAdmin(r *http.Request, action string) bool {
+	token := r.Header.Get("token")
+	return handler.enforcer.Enforce(token, casbin.ResourceGlobal, action, "*")
+}
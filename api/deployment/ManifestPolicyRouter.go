@@ -0,0 +1,50 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package deployment
+
+import (
+	"github.com/gorilla/mux"
+)
+
+type ManifestPolicyRouter interface {
+	Init(manifestPolicyRouter *mux.Router)
+}
+
+type ManifestPolicyRouterImpl struct {
+	manifestPolicyRestHandler ManifestPolicyRestHandler
+}
+
+func NewManifestPolicyRouterImpl(manifestPolicyRestHandler ManifestPolicyRestHandler) *ManifestPolicyRouterImpl {
+	return &ManifestPolicyRouterImpl{
+		manifestPolicyRestHandler: manifestPolicyRestHandler,
+	}
+}
+
+func (router ManifestPolicyRouterImpl) Init(manifestPolicyRouter *mux.Router) {
+	manifestPolicyRouter.Path("").
+		HandlerFunc(router.manifestPolicyRestHandler.GetPolicies).Methods("GET")
+	manifestPolicyRouter.Path("").
+		HandlerFunc(router.manifestPolicyRestHandler.CreatePolicy).Methods("POST")
+	manifestPolicyRouter.Path("/evaluate").
+		HandlerFunc(router.manifestPolicyRestHandler.EvaluateManifest).Methods("POST")
+	manifestPolicyRouter.Path("/{id}").
+		HandlerFunc(router.manifestPolicyRestHandler.GetPolicy).Methods("GET")
+	manifestPolicyRouter.Path("/{id}").
+		HandlerFunc(router.manifestPolicyRestHandler.UpdatePolicy).Methods("PUT")
+	manifestPolicyRouter.Path("/{id}").
+		HandlerFunc(router.manifestPolicyRestHandler.DeletePolicy).Methods("DELETE")
+}
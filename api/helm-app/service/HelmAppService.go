@@ -979,9 +979,9 @@ func (impl *HelmAppServiceImpl) TemplateChart(ctx context.Context, templateChart
 	} else {
 		chartRepository = &gRPC.ChartRepository{
 			Name:                    appStoreAppVersion.AppStore.ChartRepo.Name,
-			Url:                     appStoreAppVersion.AppStore.ChartRepo.Url,
-			Username:                appStoreAppVersion.AppStore.ChartRepo.UserName,
-			Password:                appStoreAppVersion.AppStore.ChartRepo.Password,
+			Url:                     appStoreAppVersion.AppStore.ChartRepo.GetChartPullUrl(),
+			Username:                appStoreAppVersion.AppStore.ChartRepo.GetChartPullUserName(),
+			Password:                appStoreAppVersion.AppStore.ChartRepo.GetChartPullPassword(),
 			AllowInsecureConnection: appStoreAppVersion.AppStore.ChartRepo.AllowInsecureConnection,
 		}
 	}
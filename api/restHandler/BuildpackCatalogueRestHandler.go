@@ -0,0 +1,236 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package restHandler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/devtron-labs/devtron/api/restHandler/common"
+	"github.com/devtron-labs/devtron/pkg/auth/authorisation/casbin"
+	"github.com/devtron-labs/devtron/pkg/auth/user"
+	"github.com/devtron-labs/devtron/pkg/build/buildpack"
+	"github.com/devtron-labs/devtron/pkg/build/buildpack/bean"
+	"go.uber.org/zap"
+	"gopkg.in/go-playground/validator.v9"
+)
+
+type BuildpackCatalogueRestHandler interface {
+	GetCatalogue(w http.ResponseWriter, r *http.Request)
+	SaveBuilder(w http.ResponseWriter, r *http.Request)
+	DeleteBuilder(w http.ResponseWriter, r *http.Request)
+	SaveBuildpack(w http.ResponseWriter, r *http.Request)
+	DeleteBuildpack(w http.ResponseWriter, r *http.Request)
+	SaveLanguageDefault(w http.ResponseWriter, r *http.Request)
+	DeleteLanguageDefault(w http.ResponseWriter, r *http.Request)
+	GetOutdatedBuilderUsages(w http.ResponseWriter, r *http.Request)
+}
+
+type BuildpackCatalogueRestHandlerImpl struct {
+	logger                    *zap.SugaredLogger
+	userAuthService           user.UserService
+	validator                 *validator.Validate
+	enforcer                  casbin.Enforcer
+	buildpackCatalogueService buildpack.BuildpackCatalogueService
+}
+
+func NewBuildpackCatalogueRestHandlerImpl(logger *zap.SugaredLogger, userAuthService user.UserService,
+	validator *validator.Validate, enforcer casbin.Enforcer,
+	buildpackCatalogueService buildpack.BuildpackCatalogueService) *BuildpackCatalogueRestHandlerImpl {
+	return &BuildpackCatalogueRestHandlerImpl{
+		logger:                    logger,
+		userAuthService:           userAuthService,
+		validator:                 validator,
+		enforcer:                  enforcer,
+		buildpackCatalogueService: buildpackCatalogueService,
+	}
+}
+
+// GetCatalogue is RBAC free as the catalogue is needed by every user configuring a buildpack build
+func (impl *BuildpackCatalogueRestHandlerImpl) GetCatalogue(w http.ResponseWriter, r *http.Request) {
+	userId, err := impl.userAuthService.GetLoggedInUser(r)
+	if userId == 0 || err != nil {
+		common.HandleUnauthorized(w, r)
+		return
+	}
+	res, err := impl.buildpackCatalogueService.GetCatalogue()
+	if err != nil {
+		impl.logger.Errorw("service err, GetCatalogue", "err", err)
+		common.WriteJsonResp(w, err, nil, http.StatusInternalServerError)
+		return
+	}
+	common.WriteJsonResp(w, nil, res, http.StatusOK)
+}
+
+func (impl *BuildpackCatalogueRestHandlerImpl) SaveBuilder(w http.ResponseWriter, r *http.Request) {
+	userId, ok := impl.authorizeCatalogueUpdate(w, r)
+	if !ok {
+		return
+	}
+	var builder bean.BuilderImage
+	if !impl.decodeAndValidate(w, r, &builder, "SaveBuilder") {
+		return
+	}
+	res, err := impl.buildpackCatalogueService.SaveBuilder(&builder, userId)
+	if err != nil {
+		impl.logger.Errorw("service err, SaveBuilder", "payload", builder, "err", err)
+		common.WriteJsonResp(w, err, nil, http.StatusInternalServerError)
+		return
+	}
+	common.WriteJsonResp(w, nil, res, http.StatusOK)
+}
+
+func (impl *BuildpackCatalogueRestHandlerImpl) DeleteBuilder(w http.ResponseWriter, r *http.Request) {
+	userId, ok := impl.authorizeCatalogueUpdate(w, r)
+	if !ok {
+		return
+	}
+	id, err := common.ExtractIntPathParamWithContext(w, r, "id")
+	if err != nil {
+		return
+	}
+	err = impl.buildpackCatalogueService.DeleteBuilder(id, userId)
+	if err != nil {
+		impl.logger.Errorw("service err, DeleteBuilder", "id", id, "err", err)
+		common.WriteJsonResp(w, err, nil, http.StatusInternalServerError)
+		return
+	}
+	common.WriteJsonResp(w, nil, id, http.StatusOK)
+}
+
+func (impl *BuildpackCatalogueRestHandlerImpl) SaveBuildpack(w http.ResponseWriter, r *http.Request) {
+	userId, ok := impl.authorizeCatalogueUpdate(w, r)
+	if !ok {
+		return
+	}
+	var buildpackBean bean.Buildpack
+	if !impl.decodeAndValidate(w, r, &buildpackBean, "SaveBuildpack") {
+		return
+	}
+	res, err := impl.buildpackCatalogueService.SaveBuildpack(&buildpackBean, userId)
+	if err != nil {
+		impl.logger.Errorw("service err, SaveBuildpack", "payload", buildpackBean, "err", err)
+		common.WriteJsonResp(w, err, nil, http.StatusInternalServerError)
+		return
+	}
+	common.WriteJsonResp(w, nil, res, http.StatusOK)
+}
+
+func (impl *BuildpackCatalogueRestHandlerImpl) DeleteBuildpack(w http.ResponseWriter, r *http.Request) {
+	userId, ok := impl.authorizeCatalogueUpdate(w, r)
+	if !ok {
+		return
+	}
+	id, err := common.ExtractIntPathParamWithContext(w, r, "id")
+	if err != nil {
+		return
+	}
+	err = impl.buildpackCatalogueService.DeleteBuildpack(id, userId)
+	if err != nil {
+		impl.logger.Errorw("service err, DeleteBuildpack", "id", id, "err", err)
+		common.WriteJsonResp(w, err, nil, http.StatusInternalServerError)
+		return
+	}
+	common.WriteJsonResp(w, nil, id, http.StatusOK)
+}
+
+func (impl *BuildpackCatalogueRestHandlerImpl) SaveLanguageDefault(w http.ResponseWriter, r *http.Request) {
+	userId, ok := impl.authorizeCatalogueUpdate(w, r)
+	if !ok {
+		return
+	}
+	var languageDefault bean.LanguageDefault
+	if !impl.decodeAndValidate(w, r, &languageDefault, "SaveLanguageDefault") {
+		return
+	}
+	res, err := impl.buildpackCatalogueService.SaveLanguageDefault(&languageDefault, userId)
+	if err != nil {
+		impl.logger.Errorw("service err, SaveLanguageDefault", "payload", languageDefault, "err", err)
+		common.WriteJsonResp(w, err, nil, http.StatusInternalServerError)
+		return
+	}
+	common.WriteJsonResp(w, nil, res, http.StatusOK)
+}
+
+func (impl *BuildpackCatalogueRestHandlerImpl) DeleteLanguageDefault(w http.ResponseWriter, r *http.Request) {
+	userId, ok := impl.authorizeCatalogueUpdate(w, r)
+	if !ok {
+		return
+	}
+	id, err := common.ExtractIntPathParamWithContext(w, r, "id")
+	if err != nil {
+		return
+	}
+	err = impl.buildpackCatalogueService.DeleteLanguageDefault(id, userId)
+	if err != nil {
+		impl.logger.Errorw("service err, DeleteLanguageDefault", "id", id, "err", err)
+		common.WriteJsonResp(w, err, nil, http.StatusInternalServerError)
+		return
+	}
+	common.WriteJsonResp(w, nil, id, http.StatusOK)
+}
+
+func (impl *BuildpackCatalogueRestHandlerImpl) GetOutdatedBuilderUsages(w http.ResponseWriter, r *http.Request) {
+	userId, err := impl.userAuthService.GetLoggedInUser(r)
+	if userId == 0 || err != nil {
+		common.HandleUnauthorized(w, r)
+		return
+	}
+	token := r.Header.Get("token")
+	if ok := impl.enforcer.Enforce(token, casbin.ResourceGlobal, casbin.ActionGet, "*"); !ok {
+		common.WriteJsonResp(w, nil, "Unauthorized User", http.StatusForbidden)
+		return
+	}
+	res, err := impl.buildpackCatalogueService.GetOutdatedBuilderUsages()
+	if err != nil {
+		impl.logger.Errorw("service err, GetOutdatedBuilderUsages", "err", err)
+		common.WriteJsonResp(w, err, nil, http.StatusInternalServerError)
+		return
+	}
+	common.WriteJsonResp(w, nil, res, http.StatusOK)
+}
+
+// authorizeCatalogueUpdate allows catalogue changes to super admins only, the response is written when not allowed
+func (impl *BuildpackCatalogueRestHandlerImpl) authorizeCatalogueUpdate(w http.ResponseWriter, r *http.Request) (int32, bool) {
+	userId, err := impl.userAuthService.GetLoggedInUser(r)
+	if userId == 0 || err != nil {
+		common.HandleUnauthorized(w, r)
+		return 0, false
+	}
+	token := r.Header.Get("token")
+	if ok := impl.enforcer.Enforce(token, casbin.ResourceGlobal, casbin.ActionUpdate, "*"); !ok {
+		common.WriteJsonResp(w, nil, "Unauthorized User", http.StatusForbidden)
+		return 0, false
+	}
+	return userId, true
+}
+
+func (impl *BuildpackCatalogueRestHandlerImpl) decodeAndValidate(w http.ResponseWriter, r *http.Request, payload interface{}, handlerName string) bool {
+	err := json.NewDecoder(r.Body).Decode(payload)
+	if err != nil {
+		impl.logger.Errorw("request err, "+handlerName, "err", err)
+		common.WriteJsonResp(w, err, nil, http.StatusBadRequest)
+		return false
+	}
+	err = impl.validator.Struct(payload)
+	if err != nil {
+		impl.logger.Errorw("validation err, "+handlerName, "payload", payload, "err", err)
+		common.WriteJsonResp(w, err, nil, http.StatusBadRequest)
+		return false
+	}
+	return true
+}
@@ -29,12 +29,15 @@ import (
 	"github.com/devtron-labs/devtron/pkg/auth/authorisation/casbin"
 	"github.com/devtron-labs/devtron/pkg/auth/user"
 	"github.com/devtron-labs/devtron/pkg/chart"
+	"github.com/devtron-labs/devtron/pkg/deployment/manifest/configMapAndSecret/rotation"
+	rotationBean "github.com/devtron-labs/devtron/pkg/deployment/manifest/configMapAndSecret/rotation/bean"
 	"github.com/devtron-labs/devtron/pkg/pipeline"
 	"github.com/devtron-labs/devtron/pkg/pipeline/bean"
 	"github.com/devtron-labs/devtron/pkg/team"
 	"github.com/devtron-labs/devtron/util/rbac"
 	"github.com/gorilla/mux"
 	"go.uber.org/zap"
+	"gopkg.in/go-playground/validator.v9"
 )
 
 type ConfigMapRestHandler interface {
@@ -62,6 +65,9 @@ type ConfigMapRestHandler interface {
 	AddEnvironmentToJob(w http.ResponseWriter, r *http.Request)
 	RemoveEnvironmentFromJob(w http.ResponseWriter, r *http.Request)
 	GetEnvironmentsForJob(w http.ResponseWriter, r *http.Request)
+
+	RotateConfig(w http.ResponseWriter, r *http.Request)
+	GetRotationHistory(w http.ResponseWriter, r *http.Request)
 }
 
 type ConfigMapRestHandlerImpl struct {
@@ -75,6 +81,8 @@ type ConfigMapRestHandlerImpl struct {
 	enforcerUtil              rbac.EnforcerUtil
 	configMapService          pipeline.ConfigMapService
 	draftAwareResourceService draftAwareConfigService.DraftAwareConfigService
+	cmCsRotationService       rotation.CmCsRotationService
+	validator                 *validator.Validate
 }
 
 func NewConfigMapRestHandlerImpl(pipelineBuilder pipeline.PipelineBuilder, Logger *zap.SugaredLogger,
@@ -82,6 +90,7 @@ func NewConfigMapRestHandlerImpl(pipelineBuilder pipeline.PipelineBuilder, Logge
 	enforcer casbin.Enforcer, pipelineRepository pipelineConfig.PipelineRepository,
 	enforcerUtil rbac.EnforcerUtil, configMapService pipeline.ConfigMapService,
 	draftAwareResourceService draftAwareConfigService.DraftAwareConfigService,
+	cmCsRotationService rotation.CmCsRotationService, validator *validator.Validate,
 ) *ConfigMapRestHandlerImpl {
 	return &ConfigMapRestHandlerImpl{
 		pipelineBuilder:           pipelineBuilder,
@@ -94,6 +103,8 @@ func NewConfigMapRestHandlerImpl(pipelineBuilder pipeline.PipelineBuilder, Logge
 		enforcerUtil:              enforcerUtil,
 		configMapService:          configMapService,
 		draftAwareResourceService: draftAwareResourceService,
+		cmCsRotationService:       cmCsRotationService,
+		validator:                 validator,
 	}
 }
 
@@ -983,3 +994,86 @@ func (handler ConfigMapRestHandlerImpl) GetEnvironmentsForJob(w http.ResponseWri
 
 	common.WriteJsonResp(w, err, resp, http.StatusOK)
 }
+
+func (handler ConfigMapRestHandlerImpl) RotateConfig(w http.ResponseWriter, r *http.Request) {
+	userId, err := handler.userAuthService.GetLoggedInUser(r)
+	if userId == 0 || err != nil {
+		common.HandleUnauthorized(w, r)
+		return
+	}
+	var request rotationBean.RotateRequest
+	err = json.NewDecoder(r.Body).Decode(&request)
+	if err != nil {
+		handler.Logger.Errorw("request err, RotateConfig", "err", err)
+		common.WriteJsonResp(w, err, nil, http.StatusBadRequest)
+		return
+	}
+	request.UserId = userId
+	err = handler.validator.Struct(request)
+	if err != nil {
+		handler.Logger.Errorw("validation err, RotateConfig", "err", err, "payload", request)
+		common.HandleValidationErrors(w, r, err)
+		return
+	}
+	// rotation restarts the workloads, same access as pod rotation is required
+	token := r.Header.Get("token")
+	object := handler.enforcerUtil.GetAppRBACNameByAppId(request.AppId)
+	if ok := handler.enforcer.Enforce(token, casbin.ResourceApplications, casbin.ActionTrigger, object); !ok {
+		common.WriteForbiddenError(w, "configmap/secret rotation", "application")
+		return
+	}
+	object = handler.enforcerUtil.GetEnvRBACNameByAppId(request.AppId, request.EnvironmentId)
+	if ok := handler.enforcer.Enforce(token, casbin.ResourceEnvironment, casbin.ActionTrigger, object); !ok {
+		common.WriteForbiddenError(w, "configmap/secret rotation", "environment")
+		return
+	}
+	res, err := handler.cmCsRotationService.Rotate(r.Context(), &request)
+	if err != nil {
+		handler.Logger.Errorw("service err, RotateConfig", "err", err, "payload", request)
+		common.WriteJsonResp(w, err, nil, http.StatusInternalServerError)
+		return
+	}
+	common.WriteJsonResp(w, nil, res, http.StatusOK)
+}
+
+func (handler ConfigMapRestHandlerImpl) GetRotationHistory(w http.ResponseWriter, r *http.Request) {
+	userId, err := handler.userAuthService.GetLoggedInUser(r)
+	if userId == 0 || err != nil {
+		common.HandleUnauthorized(w, r)
+		return
+	}
+	vars := mux.Vars(r)
+	appId, err := strconv.Atoi(vars["appId"])
+	if err != nil {
+		handler.Logger.Errorw("request err, GetRotationHistory", "err", err, "appId", vars["appId"])
+		common.WriteJsonResp(w, err, nil, http.StatusBadRequest)
+		return
+	}
+	envId, err := strconv.Atoi(vars["envId"])
+	if err != nil {
+		handler.Logger.Errorw("request err, GetRotationHistory", "err", err, "envId", vars["envId"])
+		common.WriteJsonResp(w, err, nil, http.StatusBadRequest)
+		return
+	}
+	resourceType := bean.ResourceType(vars["resourceType"])
+	if !resourceType.IsCM() && !resourceType.IsCS() {
+		common.WriteJsonResp(w, fmt.Errorf("invalid resourceType %s", resourceType), nil, http.StatusBadRequest)
+		return
+	}
+	//RBAC START
+	token := r.Header.Get("token")
+	object := handler.enforcerUtil.GetAppRBACNameByAppId(appId)
+	ok := handler.enforcerUtil.CheckAppRbacForAppOrJob(token, object, casbin.ActionGet)
+	if !ok {
+		common.WriteJsonResp(w, fmt.Errorf("unauthorized user"), nil, http.StatusForbidden)
+		return
+	}
+	//RBAC END
+	res, err := handler.cmCsRotationService.GetRotationHistory(appId, envId, resourceType, vars["name"])
+	if err != nil {
+		handler.Logger.Errorw("service err, GetRotationHistory", "err", err, "appId", appId, "envId", envId)
+		common.WriteJsonResp(w, err, nil, http.StatusInternalServerError)
+		return
+	}
+	common.WriteJsonResp(w, nil, res, http.StatusOK)
+}
@@ -0,0 +1,253 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package restHandler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/devtron-labs/devtron/api/restHandler/common"
+	"github.com/devtron-labs/devtron/pkg/auth/authorisation/casbin"
+	"github.com/devtron-labs/devtron/pkg/auth/user"
+	"github.com/devtron-labs/devtron/pkg/policyGovernance/security/imageScanning"
+	"github.com/devtron-labs/devtron/pkg/policyGovernance/security/imageScanning/bean"
+	securityBean "github.com/devtron-labs/devtron/pkg/policyGovernance/security/imageScanning/repository/bean"
+	"github.com/devtron-labs/devtron/util/rbac"
+	"go.uber.org/zap"
+	"gopkg.in/go-playground/validator.v9"
+)
+
+type CveExceptionRestHandler interface {
+	GetExceptions(w http.ResponseWriter, r *http.Request)
+	GetException(w http.ResponseWriter, r *http.Request)
+	RequestException(w http.ResponseWriter, r *http.Request)
+	ReviewException(w http.ResponseWriter, r *http.Request)
+	RevokeException(w http.ResponseWriter, r *http.Request)
+}
+
+type CveExceptionRestHandlerImpl struct {
+	logger              *zap.SugaredLogger
+	userAuthService     user.UserService
+	validator           *validator.Validate
+	enforcer            casbin.Enforcer
+	enforcerUtil        rbac.EnforcerUtil
+	cveExceptionService imageScanning.CveExceptionService
+}
+
+func NewCveExceptionRestHandlerImpl(logger *zap.SugaredLogger, userAuthService user.UserService,
+	validator *validator.Validate, enforcer casbin.Enforcer, enforcerUtil rbac.EnforcerUtil,
+	cveExceptionService imageScanning.CveExceptionService) *CveExceptionRestHandlerImpl {
+	return &CveExceptionRestHandlerImpl{
+		logger:              logger,
+		userAuthService:     userAuthService,
+		validator:           validator,
+		enforcer:            enforcer,
+		enforcerUtil:        enforcerUtil,
+		cveExceptionService: cveExceptionService,
+	}
+}
+
+func (impl *CveExceptionRestHandlerImpl) GetExceptions(w http.ResponseWriter, r *http.Request) {
+	userId, err := impl.userAuthService.GetLoggedInUser(r)
+	if userId == 0 || err != nil {
+		common.HandleUnauthorized(w, r)
+		return
+	}
+	v := r.URL.Query()
+	request := &bean.CveExceptionListRequest{
+		CveName: v.Get("cveName"),
+		Status:  securityBean.CveExceptionStatus(v.Get("status")),
+	}
+	if appId := v.Get("appId"); len(appId) > 0 {
+		request.AppId, err = strconv.Atoi(appId)
+		if err != nil {
+			common.WriteJsonResp(w, err, "invalid appId", http.StatusBadRequest)
+			return
+		}
+	}
+	if envId := v.Get("envId"); len(envId) > 0 {
+		request.EnvId, err = strconv.Atoi(envId)
+		if err != nil {
+			common.WriteJsonResp(w, err, "invalid envId", http.StatusBadRequest)
+			return
+		}
+	}
+	if !impl.isScopeAllowed(r, request.AppId, request.EnvId, casbin.ActionGet) {
+		common.WriteJsonResp(w, nil, "Unauthorized User", http.StatusForbidden)
+		return
+	}
+	res, err := impl.cveExceptionService.GetExceptions(request)
+	if err != nil {
+		impl.logger.Errorw("service err, GetExceptions", "request", request, "err", err)
+		common.WriteJsonResp(w, err, nil, http.StatusInternalServerError)
+		return
+	}
+	common.WriteJsonResp(w, nil, res, http.StatusOK)
+}
+
+func (impl *CveExceptionRestHandlerImpl) GetException(w http.ResponseWriter, r *http.Request) {
+	userId, err := impl.userAuthService.GetLoggedInUser(r)
+	if userId == 0 || err != nil {
+		common.HandleUnauthorized(w, r)
+		return
+	}
+	id, err := common.ExtractIntPathParamWithContext(w, r, "id")
+	if err != nil {
+		return
+	}
+	res, err := impl.cveExceptionService.GetException(id)
+	if err != nil {
+		impl.logger.Errorw("service err, GetException", "id", id, "err", err)
+		common.WriteJsonResp(w, err, nil, http.StatusInternalServerError)
+		return
+	}
+	if !impl.isScopeAllowed(r, res.AppId, res.EnvId, casbin.ActionGet) {
+		common.WriteJsonResp(w, nil, "Unauthorized User", http.StatusForbidden)
+		return
+	}
+	common.WriteJsonResp(w, nil, res, http.StatusOK)
+}
+
+// RequestException only needs view access on the scope, the exception has no effect until it is reviewed
+func (impl *CveExceptionRestHandlerImpl) RequestException(w http.ResponseWriter, r *http.Request) {
+	userId, err := impl.userAuthService.GetLoggedInUser(r)
+	if userId == 0 || err != nil {
+		common.HandleUnauthorized(w, r)
+		return
+	}
+	var request bean.CveExceptionRequest
+	err = json.NewDecoder(r.Body).Decode(&request)
+	if err != nil {
+		impl.logger.Errorw("request err, RequestException", "err", err)
+		common.WriteJsonResp(w, err, nil, http.StatusBadRequest)
+		return
+	}
+	err = impl.validator.Struct(request)
+	if err != nil {
+		impl.logger.Errorw("validation err, RequestException", "payload", request, "err", err)
+		common.WriteJsonResp(w, err, nil, http.StatusBadRequest)
+		return
+	}
+	if !impl.isScopeAllowed(r, request.AppId, request.EnvId, casbin.ActionGet) {
+		common.WriteJsonResp(w, nil, "Unauthorized User", http.StatusForbidden)
+		return
+	}
+	res, err := impl.cveExceptionService.RequestException(&request, userId)
+	if err != nil {
+		impl.logger.Errorw("service err, RequestException", "payload", request, "err", err)
+		common.WriteJsonResp(w, err, nil, http.StatusInternalServerError)
+		return
+	}
+	common.WriteJsonResp(w, nil, res, http.StatusOK)
+}
+
+// ReviewException needs the same access as creating a cve policy on the scope of the exception
+func (impl *CveExceptionRestHandlerImpl) ReviewException(w http.ResponseWriter, r *http.Request) {
+	userId, err := impl.userAuthService.GetLoggedInUser(r)
+	if userId == 0 || err != nil {
+		common.HandleUnauthorized(w, r)
+		return
+	}
+	id, err := common.ExtractIntPathParamWithContext(w, r, "id")
+	if err != nil {
+		return
+	}
+	var request bean.CveExceptionReviewRequest
+	err = json.NewDecoder(r.Body).Decode(&request)
+	if err != nil {
+		impl.logger.Errorw("request err, ReviewException", "err", err)
+		common.WriteJsonResp(w, err, nil, http.StatusBadRequest)
+		return
+	}
+	err = impl.validator.Struct(request)
+	if err != nil {
+		impl.logger.Errorw("validation err, ReviewException", "payload", request, "err", err)
+		common.WriteJsonResp(w, err, nil, http.StatusBadRequest)
+		return
+	}
+	request.Id = id
+	exception, err := impl.cveExceptionService.GetException(id)
+	if err != nil {
+		impl.logger.Errorw("service err, ReviewException", "id", id, "err", err)
+		common.WriteJsonResp(w, err, nil, http.StatusInternalServerError)
+		return
+	}
+	if !impl.isScopeAllowed(r, exception.AppId, exception.EnvId, casbin.ActionCreate) {
+		common.WriteJsonResp(w, nil, "Unauthorized User", http.StatusForbidden)
+		return
+	}
+	res, err := impl.cveExceptionService.ReviewException(&request, userId)
+	if err != nil {
+		impl.logger.Errorw("service err, ReviewException", "payload", request, "err", err)
+		common.WriteJsonResp(w, err, nil, http.StatusInternalServerError)
+		return
+	}
+	common.WriteJsonResp(w, nil, res, http.StatusOK)
+}
+
+// RevokeException can be done by the approver access of the scope or by the requester on their own exception
+func (impl *CveExceptionRestHandlerImpl) RevokeException(w http.ResponseWriter, r *http.Request) {
+	userId, err := impl.userAuthService.GetLoggedInUser(r)
+	if userId == 0 || err != nil {
+		common.HandleUnauthorized(w, r)
+		return
+	}
+	id, err := common.ExtractIntPathParamWithContext(w, r, "id")
+	if err != nil {
+		return
+	}
+	exception, err := impl.cveExceptionService.GetException(id)
+	if err != nil {
+		impl.logger.Errorw("service err, RevokeException", "id", id, "err", err)
+		common.WriteJsonResp(w, err, nil, http.StatusInternalServerError)
+		return
+	}
+	if !impl.isScopeAllowed(r, exception.AppId, exception.EnvId, casbin.ActionCreate) {
+		email, err := impl.userAuthService.GetEmailById(userId)
+		if err != nil || email != exception.RequestedBy || !impl.isScopeAllowed(r, exception.AppId, exception.EnvId, casbin.ActionGet) {
+			common.WriteJsonResp(w, nil, "Unauthorized User", http.StatusForbidden)
+			return
+		}
+	}
+	res, err := impl.cveExceptionService.RevokeException(id, userId)
+	if err != nil {
+		impl.logger.Errorw("service err, RevokeException", "id", id, "err", err)
+		common.WriteJsonResp(w, err, nil, http.StatusInternalServerError)
+		return
+	}
+	common.WriteJsonResp(w, nil, res, http.StatusOK)
+}
+
+// isScopeAllowed follows the cve policy access, app scoped exceptions are checked on the app and env,
+// environment only scopes need global environment access and image only scopes need super admin access
+func (impl *CveExceptionRestHandlerImpl) isScopeAllowed(r *http.Request, appId, envId int, action string) bool {
+	token := r.Header.Get("token")
+	if appId > 0 {
+		if ok := impl.enforcer.Enforce(token, casbin.ResourceApplications, action, impl.enforcerUtil.GetAppRBACNameByAppId(appId)); !ok {
+			return false
+		}
+		if envId > 0 {
+			return impl.enforcer.Enforce(token, casbin.ResourceEnvironment, action, impl.enforcerUtil.GetEnvRBACNameByAppId(appId, envId))
+		}
+		return true
+	}
+	if envId > 0 {
+		return impl.enforcer.Enforce(token, casbin.ResourceGlobalEnvironment, action, "*")
+	}
+	return impl.enforcer.Enforce(token, casbin.ResourceGlobal, casbin.ActionUpdate, "*")
+}
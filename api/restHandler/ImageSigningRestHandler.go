@@ -0,0 +1,269 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package restHandler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/devtron-labs/devtron/api/restHandler/common"
+	"github.com/devtron-labs/devtron/pkg/auth/authorisation/casbin"
+	"github.com/devtron-labs/devtron/pkg/auth/user"
+	"github.com/devtron-labs/devtron/pkg/policyGovernance/security/imageSigning"
+	"github.com/devtron-labs/devtron/pkg/policyGovernance/security/imageSigning/bean"
+	"github.com/devtron-labs/devtron/util/rbac"
+	"go.uber.org/zap"
+	"gopkg.in/go-playground/validator.v9"
+)
+
+type ImageSigningRestHandler interface {
+	GetSigningKeys(w http.ResponseWriter, r *http.Request)
+	SaveSigningKey(w http.ResponseWriter, r *http.Request)
+	DeleteSigningKey(w http.ResponseWriter, r *http.Request)
+	GetPolicies(w http.ResponseWriter, r *http.Request)
+	SavePolicy(w http.ResponseWriter, r *http.Request)
+	DeletePolicy(w http.ResponseWriter, r *http.Request)
+	VerifyArtifact(w http.ResponseWriter, r *http.Request)
+}
+
+type ImageSigningRestHandlerImpl struct {
+	logger              *zap.SugaredLogger
+	userAuthService     user.UserService
+	validator           *validator.Validate
+	enforcer            casbin.Enforcer
+	enforcerUtil        rbac.EnforcerUtil
+	imageSigningService imageSigning.ImageSigningService
+}
+
+func NewImageSigningRestHandlerImpl(logger *zap.SugaredLogger, userAuthService user.UserService,
+	validator *validator.Validate, enforcer casbin.Enforcer, enforcerUtil rbac.EnforcerUtil,
+	imageSigningService imageSigning.ImageSigningService) *ImageSigningRestHandlerImpl {
+	return &ImageSigningRestHandlerImpl{
+		logger:              logger,
+		userAuthService:     userAuthService,
+		validator:           validator,
+		enforcer:            enforcer,
+		enforcerUtil:        enforcerUtil,
+		imageSigningService: imageSigningService,
+	}
+}
+
+// GetSigningKeys is RBAC free, only the public keys are returned and they are needed to read the signature policies
+func (impl *ImageSigningRestHandlerImpl) GetSigningKeys(w http.ResponseWriter, r *http.Request) {
+	userId, err := impl.userAuthService.GetLoggedInUser(r)
+	if userId == 0 || err != nil {
+		common.HandleUnauthorized(w, r)
+		return
+	}
+	res, err := impl.imageSigningService.GetSigningKeys()
+	if err != nil {
+		impl.logger.Errorw("service err, GetSigningKeys", "err", err)
+		common.WriteJsonResp(w, err, nil, http.StatusInternalServerError)
+		return
+	}
+	common.WriteJsonResp(w, nil, res, http.StatusOK)
+}
+
+func (impl *ImageSigningRestHandlerImpl) SaveSigningKey(w http.ResponseWriter, r *http.Request) {
+	userId, ok := impl.authorizeSuperAdmin(w, r)
+	if !ok {
+		return
+	}
+	var request bean.SigningKeyDto
+	if !impl.decodeAndValidate(w, r, &request, "SaveSigningKey") {
+		return
+	}
+	request.UserId = userId
+	res, err := impl.imageSigningService.SaveSigningKey(&request)
+	if err != nil {
+		impl.logger.Errorw("service err, SaveSigningKey", "name", request.Name, "err", err)
+		common.WriteJsonResp(w, err, nil, http.StatusInternalServerError)
+		return
+	}
+	common.WriteJsonResp(w, nil, res, http.StatusOK)
+}
+
+func (impl *ImageSigningRestHandlerImpl) DeleteSigningKey(w http.ResponseWriter, r *http.Request) {
+	userId, ok := impl.authorizeSuperAdmin(w, r)
+	if !ok {
+		return
+	}
+	id, err := common.ExtractIntPathParamWithContext(w, r, "id")
+	if err != nil {
+		return
+	}
+	err = impl.imageSigningService.DeleteSigningKey(id, userId)
+	if err != nil {
+		impl.logger.Errorw("service err, DeleteSigningKey", "id", id, "err", err)
+		common.WriteJsonResp(w, err, nil, http.StatusInternalServerError)
+		return
+	}
+	common.WriteJsonResp(w, nil, id, http.StatusOK)
+}
+
+func (impl *ImageSigningRestHandlerImpl) GetPolicies(w http.ResponseWriter, r *http.Request) {
+	userId, err := impl.userAuthService.GetLoggedInUser(r)
+	if userId == 0 || err != nil {
+		common.HandleUnauthorized(w, r)
+		return
+	}
+	res, err := impl.imageSigningService.GetPolicies()
+	if err != nil {
+		impl.logger.Errorw("service err, GetPolicies", "err", err)
+		common.WriteJsonResp(w, err, nil, http.StatusInternalServerError)
+		return
+	}
+	common.WriteJsonResp(w, nil, res, http.StatusOK)
+}
+
+func (impl *ImageSigningRestHandlerImpl) SavePolicy(w http.ResponseWriter, r *http.Request) {
+	userId, err := impl.userAuthService.GetLoggedInUser(r)
+	if userId == 0 || err != nil {
+		common.HandleUnauthorized(w, r)
+		return
+	}
+	var request bean.SignaturePolicyDto
+	if !impl.decodeAndValidate(w, r, &request, "SavePolicy") {
+		return
+	}
+	if !impl.isPolicyUpdateAllowed(r, request.Level, casbin.ActionCreate) {
+		common.WriteJsonResp(w, nil, "Unauthorized User", http.StatusForbidden)
+		return
+	}
+	request.UserId = userId
+	res, err := impl.imageSigningService.SavePolicy(&request)
+	if err != nil {
+		impl.logger.Errorw("service err, SavePolicy", "payload", request, "err", err)
+		common.WriteJsonResp(w, err, nil, http.StatusInternalServerError)
+		return
+	}
+	common.WriteJsonResp(w, nil, res, http.StatusOK)
+}
+
+func (impl *ImageSigningRestHandlerImpl) DeletePolicy(w http.ResponseWriter, r *http.Request) {
+	userId, err := impl.userAuthService.GetLoggedInUser(r)
+	if userId == 0 || err != nil {
+		common.HandleUnauthorized(w, r)
+		return
+	}
+	id, err := common.ExtractIntPathParamWithContext(w, r, "id")
+	if err != nil {
+		return
+	}
+	policy, err := impl.imageSigningService.GetPolicyById(id)
+	if err != nil {
+		impl.logger.Errorw("service err, DeletePolicy", "id", id, "err", err)
+		common.WriteJsonResp(w, err, nil, http.StatusInternalServerError)
+		return
+	}
+	if !impl.isPolicyUpdateAllowed(r, policy.Level, casbin.ActionDelete) {
+		common.WriteJsonResp(w, nil, "Unauthorized User", http.StatusForbidden)
+		return
+	}
+	err = impl.imageSigningService.DeletePolicy(id, userId)
+	if err != nil {
+		impl.logger.Errorw("service err, DeletePolicy", "id", id, "err", err)
+		common.WriteJsonResp(w, err, nil, http.StatusInternalServerError)
+		return
+	}
+	common.WriteJsonResp(w, nil, id, http.StatusOK)
+}
+
+// VerifyArtifact checks the registry signatures of the artifact against the policy of the envId query param, when given
+func (impl *ImageSigningRestHandlerImpl) VerifyArtifact(w http.ResponseWriter, r *http.Request) {
+	userId, err := impl.userAuthService.GetLoggedInUser(r)
+	if userId == 0 || err != nil {
+		common.HandleUnauthorized(w, r)
+		return
+	}
+	artifactId, err := common.ExtractIntPathParamWithContext(w, r, "artifactId")
+	if err != nil {
+		return
+	}
+	envId := 0
+	if envIdParam := r.URL.Query().Get("envId"); len(envIdParam) > 0 {
+		envId, err = strconv.Atoi(envIdParam)
+		if err != nil {
+			common.WriteJsonResp(w, err, "invalid envId", http.StatusBadRequest)
+			return
+		}
+	}
+	appId, err := impl.imageSigningService.GetArtifactAppId(artifactId)
+	if err != nil {
+		impl.logger.Errorw("service err, VerifyArtifact", "artifactId", artifactId, "err", err)
+		common.WriteJsonResp(w, err, nil, http.StatusInternalServerError)
+		return
+	}
+	token := r.Header.Get("token")
+	if appId > 0 {
+		if ok := impl.enforcer.Enforce(token, casbin.ResourceApplications, casbin.ActionGet, impl.enforcerUtil.GetAppRBACNameByAppId(appId)); !ok {
+			common.WriteJsonResp(w, nil, "Unauthorized User", http.StatusForbidden)
+			return
+		}
+	} else if ok := impl.enforcer.Enforce(token, casbin.ResourceGlobal, casbin.ActionGet, "*"); !ok {
+		common.WriteJsonResp(w, nil, "Unauthorized User", http.StatusForbidden)
+		return
+	}
+	res, err := impl.imageSigningService.VerifyArtifact(r.Context(), artifactId, envId, userId)
+	if err != nil {
+		impl.logger.Errorw("service err, VerifyArtifact", "artifactId", artifactId, "envId", envId, "err", err)
+		common.WriteJsonResp(w, err, nil, http.StatusInternalServerError)
+		return
+	}
+	common.WriteJsonResp(w, nil, res, http.StatusOK)
+}
+
+// isPolicyUpdateAllowed follows the cve policy access, environment level policies need global environment access
+// and global and cluster level policies need super admin access
+func (impl *ImageSigningRestHandlerImpl) isPolicyUpdateAllowed(r *http.Request, level bean.PolicyLevel, action string) bool {
+	token := r.Header.Get("token")
+	if level == bean.PolicyLevelEnvironment {
+		return impl.enforcer.Enforce(token, casbin.ResourceGlobalEnvironment, action, "*")
+	}
+	return impl.enforcer.Enforce(token, casbin.ResourceGlobal, casbin.ActionUpdate, "*")
+}
+
+func (impl *ImageSigningRestHandlerImpl) authorizeSuperAdmin(w http.ResponseWriter, r *http.Request) (int32, bool) {
+	userId, err := impl.userAuthService.GetLoggedInUser(r)
+	if userId == 0 || err != nil {
+		common.HandleUnauthorized(w, r)
+		return 0, false
+	}
+	token := r.Header.Get("token")
+	if ok := impl.enforcer.Enforce(token, casbin.ResourceGlobal, casbin.ActionUpdate, "*"); !ok {
+		common.WriteJsonResp(w, nil, "Unauthorized User", http.StatusForbidden)
+		return 0, false
+	}
+	return userId, true
+}
+
+func (impl *ImageSigningRestHandlerImpl) decodeAndValidate(w http.ResponseWriter, r *http.Request, payload interface{}, handlerName string) bool {
+	err := json.NewDecoder(r.Body).Decode(payload)
+	if err != nil {
+		impl.logger.Errorw("request err, "+handlerName, "err", err)
+		common.WriteJsonResp(w, err, nil, http.StatusBadRequest)
+		return false
+	}
+	err = impl.validator.Struct(payload)
+	if err != nil {
+		impl.logger.Errorw("validation err, "+handlerName, "err", err)
+		common.WriteJsonResp(w, err, nil, http.StatusBadRequest)
+		return false
+	}
+	return true
+}
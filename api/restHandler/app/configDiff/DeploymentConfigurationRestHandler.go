@@ -23,6 +23,7 @@ type DeploymentConfigurationRestHandler interface {
 	GetConfigData(w http.ResponseWriter, r *http.Request)
 	CompareCategoryWiseConfigData(w http.ResponseWriter, r *http.Request)
 	GetManifest(w http.ResponseWriter, r *http.Request)
+	GetConfigDriftReport(w http.ResponseWriter, r *http.Request)
 }
 type DeploymentConfigurationRestHandlerImpl struct {
 	logger                         *zap.SugaredLogger
@@ -161,6 +162,50 @@ func (handler *DeploymentConfigurationRestHandlerImpl) GetManifest(w http.Respon
 	common.WriteJsonResp(w, nil, res, http.StatusOK)
 }
 
+func (handler *DeploymentConfigurationRestHandlerImpl) GetConfigDriftReport(w http.ResponseWriter, r *http.Request) {
+	userId, err := handler.userAuthService.GetLoggedInUser(r)
+	if userId == 0 || err != nil {
+		common.HandleUnauthorized(w, r)
+		return
+	}
+	var decoder = schema.NewDecoder()
+	decoder.IgnoreUnknownKeys(true)
+	queryParams := bean.ConfigDriftReportQueryParams{}
+	err = decoder.Decode(&queryParams, r.URL.Query())
+	if err != nil || len(queryParams.AppName) == 0 {
+		handler.logger.Errorw("request err, GetConfigDriftReport", "err", err, "query", r.URL.RawQuery)
+		common.WriteJsonResp(w, fmt.Errorf("invalid query params, appName is required"), nil, http.StatusBadRequest)
+		return
+	}
+	queryParams.UserId = userId
+
+	//RBAC START
+	token := r.Header.Get(common.TokenHeaderKey)
+	object := handler.enforcerUtil.GetAppRBACName(queryParams.AppName)
+	ok := handler.enforcerUtil.CheckAppRbacForAppOrJob(token, object, casbin.ActionGet)
+	if !ok {
+		common.WriteJsonResp(w, fmt.Errorf("unauthorized user"), nil, http.StatusForbidden)
+		return
+	}
+	//RBAC END
+	isSuperAdmin := handler.enforcer.Enforce(token, casbin.ResourceGlobal, casbin.ActionGet, "*")
+	ctx, cancel := context.WithTimeout(r.Context(), 60*time.Second)
+	defer cancel()
+	ctx = util2.SetSuperAdminInContext(ctx, isSuperAdmin)
+	// app access is enforced above, environments the user cannot view are left out of the report
+	validateEnvAccess := func(envName string) bool {
+		envObject := handler.enforcerUtil.GetEnvRBACNameByAppAndEnvName(queryParams.AppName, envName)
+		return handler.enforcer.Enforce(token, casbin.ResourceEnvironment, casbin.ActionGet, envObject)
+	}
+	res, err := handler.deploymentConfigurationService.GetConfigDriftReport(ctx, &queryParams, validateEnvAccess)
+	if err != nil {
+		handler.logger.Errorw("service err, GetConfigDriftReport", "appName", queryParams.AppName, "err", err)
+		common.WriteJsonResp(w, err, nil, http.StatusInternalServerError)
+		return
+	}
+	common.WriteJsonResp(w, nil, res, http.StatusOK)
+}
+
 func (handler *DeploymentConfigurationRestHandlerImpl) enforceForAppAndEnv(appName, envName string, token string, action string) bool {
 	object := handler.enforcerUtil.GetAppRBACNameByAppName(appName)
 	if ok := handler.enforcer.Enforce(token, casbin.ResourceApplications, action, object); !ok {
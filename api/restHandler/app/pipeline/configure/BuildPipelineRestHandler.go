@@ -30,6 +30,7 @@ import (
 	apiBean "github.com/devtron-labs/devtron/api/restHandler/app/pipeline/configure/bean"
 	"github.com/devtron-labs/devtron/internal/sql/constants"
 	"github.com/devtron-labs/devtron/pkg/build/artifacts/imageTagging"
+	cacheBean "github.com/devtron-labs/devtron/pkg/build/cache/bean"
 	bean2 "github.com/devtron-labs/devtron/pkg/build/pipeline/bean"
 	eventProcessorBean "github.com/devtron-labs/devtron/pkg/eventProcessor/bean"
 	constants2 "github.com/devtron-labs/devtron/pkg/pipeline/constants"
@@ -87,6 +88,11 @@ type DevtronAppBuildRestHandler interface {
 	GetSourceCiDownStreamFilters(w http.ResponseWriter, r *http.Request)
 	// GetSourceCiDownStreamInfo will fetch the deployment information of all the linked CIs for the given ciPipelineId
 	GetSourceCiDownStreamInfo(w http.ResponseWriter, r *http.Request)
+
+	GetBuildCacheConfig(w http.ResponseWriter, r *http.Request)
+	SaveBuildCacheConfig(w http.ResponseWriter, r *http.Request)
+	// InvalidateBuildCache makes the next build of the pipeline start with an empty buildx cache, the old cache is not deleted
+	InvalidateBuildCache(w http.ResponseWriter, r *http.Request)
 }
 
 type DevtronAppBuildMaterialRestHandler interface {
@@ -2350,3 +2356,78 @@ func (handler *PipelineConfigRestHandlerImpl) GetAppMetadataListByEnvironment(w
 	resp.AppCount = len(resp.Apps)
 	common.WriteJsonResp(w, err, resp, http.StatusOK)
 }
+
+func (handler *PipelineConfigRestHandlerImpl) GetBuildCacheConfig(w http.ResponseWriter, r *http.Request) {
+	appId, err := common.ExtractIntPathParamWithContext(w, r, "appId")
+	if err != nil {
+		return
+	}
+	pipelineId, err := common.ExtractIntPathParamWithContext(w, r, "pipelineId")
+	if err != nil {
+		return
+	}
+	token := r.Header.Get("token")
+	if !handler.checkAppRbacForAppOrJob(w, token, appId, casbin.ActionGet) {
+		return
+	}
+	config, err := handler.buildCacheService.GetBuildCacheConfig(appId, pipelineId)
+	if err != nil {
+		handler.Logger.Errorw("service err, GetBuildCacheConfig", "appId", appId, "pipelineId", pipelineId, "err", err)
+		common.WriteJsonResp(w, err, nil, http.StatusInternalServerError)
+		return
+	}
+	common.WriteJsonResp(w, nil, config, http.StatusOK)
+}
+
+func (handler *PipelineConfigRestHandlerImpl) SaveBuildCacheConfig(w http.ResponseWriter, r *http.Request) {
+	userId, ok := handler.getUserIdOrUnauthorized(w, r)
+	if !ok {
+		return
+	}
+	var config cacheBean.BuildCacheConfig
+	if !handler.decodeJsonBody(w, r, &config, "SaveBuildCacheConfig") {
+		return
+	}
+	handler.Logger.Infow("request payload, SaveBuildCacheConfig", "payload", config, "userId", userId)
+	if !handler.validateRequestBody(w, config, "SaveBuildCacheConfig") {
+		return
+	}
+	token := r.Header.Get("token")
+	if !handler.checkAppRbacForAppOrJob(w, token, config.AppId, casbin.ActionUpdate) {
+		return
+	}
+	resp, err := handler.buildCacheService.SaveBuildCacheConfig(&config, userId)
+	if err != nil {
+		handler.Logger.Errorw("service err, SaveBuildCacheConfig", "payload", config, "err", err)
+		common.WriteJsonResp(w, err, nil, http.StatusInternalServerError)
+		return
+	}
+	common.WriteJsonResp(w, nil, resp, http.StatusOK)
+}
+
+func (handler *PipelineConfigRestHandlerImpl) InvalidateBuildCache(w http.ResponseWriter, r *http.Request) {
+	userId, ok := handler.getUserIdOrUnauthorized(w, r)
+	if !ok {
+		return
+	}
+	appId, err := common.ExtractIntPathParamWithContext(w, r, "appId")
+	if err != nil {
+		return
+	}
+	pipelineId, err := common.ExtractIntPathParamWithContext(w, r, "pipelineId")
+	if err != nil {
+		return
+	}
+	handler.Logger.Infow("request payload, InvalidateBuildCache", "appId", appId, "pipelineId", pipelineId, "userId", userId)
+	token := r.Header.Get("token")
+	if !handler.checkAppRbacForAppOrJob(w, token, appId, casbin.ActionUpdate) {
+		return
+	}
+	resp, err := handler.buildCacheService.InvalidateBuildCache(appId, pipelineId, userId)
+	if err != nil {
+		handler.Logger.Errorw("service err, InvalidateBuildCache", "appId", appId, "pipelineId", pipelineId, "err", err)
+		common.WriteJsonResp(w, err, nil, http.StatusInternalServerError)
+		return
+	}
+	common.WriteJsonResp(w, nil, resp, http.StatusOK)
+}
@@ -84,6 +84,7 @@ type DevtronAppDeploymentRestHandler interface {
 	GetCdPipelinesByEnvironmentMin(w http.ResponseWriter, r *http.Request)
 
 	ChangeChartRef(w http.ResponseWriter, r *http.Request)
+	GetChartUpgradeReport(w http.ResponseWriter, r *http.Request)
 	ValidateExternalAppLinkRequest(w http.ResponseWriter, r *http.Request)
 }
 
@@ -618,6 +619,42 @@ func (handler *PipelineConfigRestHandlerImpl) ChangeChartRef(w http.ResponseWrit
 	return
 }
 
+// GetChartUpgradeReport returns the values keys removed or renamed on changing the chart of an environment override, without changing it
+func (handler *PipelineConfigRestHandlerImpl) GetChartUpgradeReport(w http.ResponseWriter, r *http.Request) {
+	userId, err := handler.userAuthService.GetLoggedInUser(r)
+	if userId == 0 || err != nil {
+		common.HandleUnauthorized(w, r)
+		return
+	}
+	decoder := json.NewDecoder(r.Body)
+	var request bean3.ChartRefChangeRequest
+	err = decoder.Decode(&request)
+	if err != nil || request.EnvId == 0 || request.TargetChartRefId == 0 || request.AppId == 0 {
+		handler.Logger.Errorw("request err, GetChartUpgradeReport", "err", err, "payload", request)
+		common.WriteJsonResp(w, err, request, http.StatusBadRequest)
+		return
+	}
+	token := r.Header.Get("token")
+	object := handler.enforcerUtil.GetEnvRBACNameByAppId(request.AppId, request.EnvId)
+	if ok := handler.enforcer.Enforce(token, casbin.ResourceEnvironment, casbin.ActionGet, object); !ok {
+		common.WriteJsonResp(w, fmt.Errorf("unauthorized user"), "Unauthorized User", http.StatusForbidden)
+		return
+	}
+	envConfigProperties, err := handler.propertiesConfigService.GetLatestEnvironmentProperties(request.AppId, request.EnvId)
+	if err != nil || envConfigProperties == nil {
+		handler.Logger.Errorw("env properties not found, GetChartUpgradeReport", "err", err, "payload", request)
+		common.WriteJsonResp(w, err, "env properties not found", http.StatusNotFound)
+		return
+	}
+	report, err := handler.chartRefService.GetChartUpgradeReport(envConfigProperties.ChartRefId, request.TargetChartRefId, envConfigProperties.EnvOverrideValues)
+	if err != nil {
+		handler.Logger.Errorw("service err, GetChartUpgradeReport", "err", err, "payload", request)
+		common.WriteJsonResp(w, err, nil, http.StatusInternalServerError)
+		return
+	}
+	common.WriteJsonResp(w, nil, report, http.StatusOK)
+}
+
 func (handler *PipelineConfigRestHandlerImpl) EnvConfigOverrideCreate(w http.ResponseWriter, r *http.Request) {
 	userId, err := handler.userAuthService.GetLoggedInUser(r)
 	if userId == 0 || err != nil {
@@ -1216,6 +1253,10 @@ func (handler *PipelineConfigRestHandlerImpl) GetArtifactsByCDPipeline(w http.Re
 		if err != nil {
 			handler.Logger.Errorw("service err, GetArtifactsByCDPipeline", "err", err, "cdPipelineId", cdPipelineId, "stage", stage)
 		}
+		cveExceptions, err := handler.policyService.GetActiveCveExceptions(pipeline.AppId, pipeline.EnvironmentId)
+		if err != nil {
+			handler.Logger.Errorw("service err, GetActiveCveExceptions", "err", err, "cdPipelineId", cdPipelineId, "stage", stage)
+		}
 
 		// get image scan results from DB for given digests
 		imageScanResults, err := handler.imageScanResultReadService.FindByImageDigests(digests)
@@ -1254,6 +1295,7 @@ func (handler *PipelineConfigRestHandlerImpl) GetArtifactsByCDPipeline(w http.Re
 			}
 
 			cveStores, _ := digestVsCveStores[item.ImageDigest]
+			cveStores = repository.RemoveExceptedCves(cveStores, cveExceptions, pipeline.AppId, pipeline.EnvironmentId, item.Image)
 			item.IsVulnerable = handler.policyService.HasBlockedCVE(cveStores, cvePolicy, severityPolicy)
 			ciArtifactsFinal = append(ciArtifactsFinal, item)
 		}
@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"github.com/devtron-labs/devtron/pkg/build/artifacts/imageTagging"
 	imageTaggingRead "github.com/devtron-labs/devtron/pkg/build/artifacts/imageTagging/read"
+	"github.com/devtron-labs/devtron/pkg/build/cache"
 	read2 "github.com/devtron-labs/devtron/pkg/build/git/gitMaterial/read"
 	gitProviderRead "github.com/devtron-labs/devtron/pkg/build/git/gitProvider/read"
 	bean3 "github.com/devtron-labs/devtron/pkg/build/pipeline/bean"
@@ -144,6 +145,7 @@ type PipelineConfigRestHandlerImpl struct {
 	draftAwareResourceService           draftAwareConfigService.DraftAwareConfigService
 	ciHandlerService                    trigger.HandlerService
 	cdHandlerService                    devtronApps.HandlerService
+	buildCacheService                   cache.BuildCacheService
 }
 
 func NewPipelineRestHandlerImpl(pipelineBuilder pipeline.PipelineBuilder, Logger *zap.SugaredLogger,
@@ -181,6 +183,7 @@ func NewPipelineRestHandlerImpl(pipelineBuilder pipeline.PipelineBuilder, Logger
 	draftAwareResourceService draftAwareConfigService.DraftAwareConfigService,
 	ciHandlerService trigger.HandlerService,
 	cdHandlerService devtronApps.HandlerService,
+	buildCacheService cache.BuildCacheService,
 ) *PipelineConfigRestHandlerImpl {
 	envConfig := &PipelineRestHandlerEnvConfig{}
 	err := env.Parse(envConfig)
@@ -226,6 +229,7 @@ func NewPipelineRestHandlerImpl(pipelineBuilder pipeline.PipelineBuilder, Logger
 		draftAwareResourceService:           draftAwareResourceService,
 		ciHandlerService:                    ciHandlerService,
 		cdHandlerService:                    cdHandlerService,
+		buildCacheService:                   buildCacheService,
 	}
 }
 
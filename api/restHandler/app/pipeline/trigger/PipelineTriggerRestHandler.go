@@ -47,6 +47,7 @@ import (
 
 type PipelineTriggerRestHandler interface {
 	OverrideConfig(w http.ResponseWriter, r *http.Request)
+	GetManifestDiffPreview(w http.ResponseWriter, r *http.Request)
 	ReleaseStatusUpdate(w http.ResponseWriter, r *http.Request)
 	StartStopApp(w http.ResponseWriter, r *http.Request)
 	StartStopDeploymentGroup(w http.ResponseWriter, r *http.Request)
@@ -171,10 +172,49 @@ func (handler PipelineTriggerRestHandlerImpl) OverrideConfig(w http.ResponseWrit
 	}
 
 	// 6. Success response
-	res := map[string]interface{}{"releaseId": mergeResp, "helmPackageName": helmPackageName}
+	res := map[string]interface{}{"releaseId": mergeResp, "helmPackageName": helmPackageName, "manifestPolicyViolations": overrideRequest.ManifestPolicyViolations}
 	common.WriteJsonResp(w, nil, res, http.StatusOK)
 }
 
+// GetManifestDiffPreview returns what a deploy trigger with the same payload would change in the cluster, without triggering it
+func (handler PipelineTriggerRestHandlerImpl) GetManifestDiffPreview(w http.ResponseWriter, r *http.Request) {
+	userId, err := handler.userAuthService.GetLoggedInUser(r)
+	if userId == 0 || err != nil {
+		common.HandleUnauthorized(w, r)
+		return
+	}
+	decoder := json.NewDecoder(r.Body)
+	var overrideRequest bean.ValuesOverrideRequest
+	err = decoder.Decode(&overrideRequest)
+	if err != nil {
+		handler.logger.Errorw("request parsing error", "err", err)
+		common.WriteJsonResp(w, err, nil, http.StatusBadRequest)
+		return
+	}
+	overrideRequest.UserId = userId
+	err = handler.validator.Struct(overrideRequest)
+	if err != nil {
+		handler.logger.Errorw("validation error", "err", err, "payload", overrideRequest)
+		common.HandleValidationErrors(w, r, err)
+		return
+	}
+	token := r.Header.Get("token")
+	if rbacErr := handler.validateCdTriggerRBAC(token, overrideRequest.AppId, overrideRequest.PipelineId); rbacErr != nil {
+		common.WriteJsonResp(w, rbacErr, nil, rbacErr.(*util2.ApiError).HttpStatusCode)
+		return
+	}
+	ctx := r.Context()
+	_, span := otel.Tracer("orchestrator").Start(ctx, "cdHandlerService.GetManifestDiffPreview")
+	resp, err := handler.cdHandlerService.GetManifestDiffPreview(ctx, &overrideRequest)
+	span.End()
+	if err != nil {
+		handler.logger.Errorw("service error, GetManifestDiffPreview", "err", err, "payload", overrideRequest)
+		common.WriteJsonResp(w, err, nil, http.StatusInternalServerError)
+		return
+	}
+	common.WriteJsonResp(w, nil, resp, http.StatusOK)
+}
+
 func (handler PipelineTriggerRestHandlerImpl) RotatePods(w http.ResponseWriter, r *http.Request) {
 	// 1. Authentication check
 	userId, err := handler.userAuthService.GetLoggedInUser(r)
@@ -0,0 +1,34 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package artifacts
+
+import (
+	"net/http"
+
+	"github.com/devtron-labs/devtron/pkg/auth/authorisation/casbin"
+	"github.com/devtron-labs/devtron/util/rbac"
+)
+
+// isArtifactAppViewAllowed checks that the user of the request can view the app the artifact was built for
+func isArtifactAppViewAllowed(enforcer casbin.Enforcer, enforcerUtil rbac.EnforcerUtil, r *http.Request, appId int) bool {
+	token := r.Header.Get("token")
+	if appId == 0 {
+		// artifacts not built by a ci pipeline of an app are visible to super admins only
+		return enforcer.Enforce(token, casbin.ResourceGlobal, casbin.ActionGet, "*")
+	}
+	return enforcer.Enforce(token, casbin.ResourceApplications, casbin.ActionGet, enforcerUtil.GetAppRBACNameByAppId(appId))
+}
@@ -0,0 +1,47 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package artifacts
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/devtron-labs/devtron/pkg/auth/authorisation/casbin"
+	casbinMocks "github.com/devtron-labs/devtron/pkg/auth/authorisation/casbin/mocks"
+	rbacMocks "github.com/devtron-labs/devtron/util/rbac/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsArtifactAppViewAllowed(t *testing.T) {
+	request := httptest.NewRequest(http.MethodGet, "/orchestrator/sbom/artifact/1", nil)
+	request.Header.Set("token", "user-token")
+
+	t.Run("artifact of an app needs view access on the app", func(t *testing.T) {
+		enforcerUtil := rbacMocks.NewEnforcerUtil(t)
+		enforcerUtil.On("GetAppRBACNameByAppId", 3).Return("team/payments")
+		enforcer := casbinMocks.NewEnforcer(t)
+		enforcer.On("Enforce", "user-token", casbin.ResourceApplications, casbin.ActionGet, "team/payments").Return(true).Once()
+		assert.True(t, isArtifactAppViewAllowed(enforcer, enforcerUtil, request, 3))
+	})
+
+	t.Run("artifact without an app is visible to super admins only", func(t *testing.T) {
+		enforcer := casbinMocks.NewEnforcer(t)
+		enforcer.On("Enforce", "user-token", casbin.ResourceGlobal, casbin.ActionGet, "*").Return(false).Once()
+		assert.False(t, isArtifactAppViewAllowed(enforcer, rbacMocks.NewEnforcerUtil(t), request, 0))
+	})
+}
@@ -0,0 +1,101 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package artifacts
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/devtron-labs/devtron/api/restHandler/common"
+	"github.com/devtron-labs/devtron/pkg/auth/authorisation/casbin"
+	"github.com/devtron-labs/devtron/pkg/auth/user"
+	"github.com/devtron-labs/devtron/pkg/build/artifacts/provenance"
+	"github.com/devtron-labs/devtron/pkg/build/artifacts/provenance/bean"
+	"github.com/devtron-labs/devtron/util/rbac"
+	"go.uber.org/zap"
+)
+
+type ProvenanceRestHandler interface {
+	GetArtifactProvenance(w http.ResponseWriter, r *http.Request)
+	DownloadArtifactProvenance(w http.ResponseWriter, r *http.Request)
+}
+
+type ProvenanceRestHandlerImpl struct {
+	logger            *zap.SugaredLogger
+	userAuthService   user.UserService
+	enforcer          casbin.Enforcer
+	enforcerUtil      rbac.EnforcerUtil
+	provenanceService provenance.ProvenanceService
+}
+
+func NewProvenanceRestHandlerImpl(logger *zap.SugaredLogger, userAuthService user.UserService,
+	enforcer casbin.Enforcer, enforcerUtil rbac.EnforcerUtil,
+	provenanceService provenance.ProvenanceService) *ProvenanceRestHandlerImpl {
+	return &ProvenanceRestHandlerImpl{
+		logger:            logger,
+		userAuthService:   userAuthService,
+		enforcer:          enforcer,
+		enforcerUtil:      enforcerUtil,
+		provenanceService: provenanceService,
+	}
+}
+
+func (impl *ProvenanceRestHandlerImpl) GetArtifactProvenance(w http.ResponseWriter, r *http.Request) {
+	res, ok := impl.getAuthorisedArtifactProvenance(w, r, "GetArtifactProvenance")
+	if !ok {
+		return
+	}
+	common.WriteJsonResp(w, nil, res, http.StatusOK)
+}
+
+// DownloadArtifactProvenance writes the bare in-toto statement, to be consumed by slsa verifiers
+func (impl *ProvenanceRestHandlerImpl) DownloadArtifactProvenance(w http.ResponseWriter, r *http.Request) {
+	res, ok := impl.getAuthorisedArtifactProvenance(w, r, "DownloadArtifactProvenance")
+	if !ok {
+		return
+	}
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=provenance-%d.intoto.json", res.CiArtifactId))
+	w.Header().Set("Content-Type", bean.InTotoContentType)
+	_, err := w.Write(res.Statement)
+	if err != nil {
+		impl.logger.Errorw("error in writing provenance response", "artifactId", res.CiArtifactId, "err", err)
+	}
+}
+
+func (impl *ProvenanceRestHandlerImpl) getAuthorisedArtifactProvenance(w http.ResponseWriter, r *http.Request, handlerName string) (*bean.ArtifactProvenanceResponse, bool) {
+	userId, err := impl.userAuthService.GetLoggedInUser(r)
+	if userId == 0 || err != nil {
+		common.HandleUnauthorized(w, r)
+		return nil, false
+	}
+	artifactId, err := common.ExtractIntPathParamWithContext(w, r, "artifactId")
+	if err != nil {
+		return nil, false
+	}
+	res, err := impl.provenanceService.GetArtifactProvenance(artifactId)
+	// the app id is returned along with the not found error of a missing provenance, rbac is checked before revealing it
+	if res != nil && !isArtifactAppViewAllowed(impl.enforcer, impl.enforcerUtil, r, res.AppId) {
+		common.WriteJsonResp(w, nil, "Unauthorized User", http.StatusForbidden)
+		return nil, false
+	}
+	if err != nil {
+		impl.logger.Errorw("service err, "+handlerName, "artifactId", artifactId, "err", err)
+		common.WriteJsonResp(w, err, nil, http.StatusInternalServerError)
+		return nil, false
+	}
+	return res, true
+}
@@ -0,0 +1,170 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package artifacts
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/devtron-labs/devtron/api/restHandler/common"
+	"github.com/devtron-labs/devtron/pkg/auth/authorisation/casbin"
+	"github.com/devtron-labs/devtron/pkg/auth/user"
+	"github.com/devtron-labs/devtron/pkg/build/artifacts/sbom"
+	"github.com/devtron-labs/devtron/pkg/build/artifacts/sbom/bean"
+	"github.com/devtron-labs/devtron/util/rbac"
+	"go.uber.org/zap"
+	"gopkg.in/go-playground/validator.v9"
+)
+
+type SbomRestHandler interface {
+	GetArtifactSboms(w http.ResponseWriter, r *http.Request)
+	DownloadArtifactSbom(w http.ResponseWriter, r *http.Request)
+	SearchComponent(w http.ResponseWriter, r *http.Request)
+}
+
+type SbomRestHandlerImpl struct {
+	logger          *zap.SugaredLogger
+	userAuthService user.UserService
+	validator       *validator.Validate
+	enforcer        casbin.Enforcer
+	enforcerUtil    rbac.EnforcerUtil
+	sbomService     sbom.SbomService
+}
+
+func NewSbomRestHandlerImpl(logger *zap.SugaredLogger, userAuthService user.UserService,
+	validator *validator.Validate, enforcer casbin.Enforcer, enforcerUtil rbac.EnforcerUtil,
+	sbomService sbom.SbomService) *SbomRestHandlerImpl {
+	return &SbomRestHandlerImpl{
+		logger:          logger,
+		userAuthService: userAuthService,
+		validator:       validator,
+		enforcer:        enforcer,
+		enforcerUtil:    enforcerUtil,
+		sbomService:     sbomService,
+	}
+}
+
+func (impl *SbomRestHandlerImpl) GetArtifactSboms(w http.ResponseWriter, r *http.Request) {
+	userId, err := impl.userAuthService.GetLoggedInUser(r)
+	if userId == 0 || err != nil {
+		common.HandleUnauthorized(w, r)
+		return
+	}
+	artifactId, err := common.ExtractIntPathParamWithContext(w, r, "artifactId")
+	if err != nil {
+		return
+	}
+	res, err := impl.sbomService.GetArtifactSboms(artifactId)
+	if err != nil {
+		impl.logger.Errorw("service err, GetArtifactSboms", "artifactId", artifactId, "err", err)
+		common.WriteJsonResp(w, err, nil, http.StatusInternalServerError)
+		return
+	}
+	if !isArtifactAppViewAllowed(impl.enforcer, impl.enforcerUtil, r, res.AppId) {
+		common.WriteJsonResp(w, nil, "Unauthorized User", http.StatusForbidden)
+		return
+	}
+	common.WriteJsonResp(w, nil, res, http.StatusOK)
+}
+
+func (impl *SbomRestHandlerImpl) DownloadArtifactSbom(w http.ResponseWriter, r *http.Request) {
+	userId, err := impl.userAuthService.GetLoggedInUser(r)
+	if userId == 0 || err != nil {
+		common.HandleUnauthorized(w, r)
+		return
+	}
+	artifactId, err := common.ExtractIntPathParamWithContext(w, r, "artifactId")
+	if err != nil {
+		return
+	}
+	sbomId, err := common.ExtractIntPathParamWithContext(w, r, "sbomId")
+	if err != nil {
+		return
+	}
+	artifactSboms, err := impl.sbomService.GetArtifactSboms(artifactId)
+	if err != nil {
+		impl.logger.Errorw("service err, DownloadArtifactSbom", "artifactId", artifactId, "err", err)
+		common.WriteJsonResp(w, err, nil, http.StatusInternalServerError)
+		return
+	}
+	if !isArtifactAppViewAllowed(impl.enforcer, impl.enforcerUtil, r, artifactSboms.AppId) {
+		common.WriteJsonResp(w, nil, "Unauthorized User", http.StatusForbidden)
+		return
+	}
+	content, artifactSbom, err := impl.sbomService.DownloadArtifactSbom(artifactId, sbomId)
+	if err != nil {
+		impl.logger.Errorw("service err, DownloadArtifactSbom", "artifactId", artifactId, "sbomId", sbomId, "err", err)
+		common.WriteJsonResp(w, err, nil, http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=sbom-%d-%s.json", artifactId, artifactSbom.Format))
+	w.Header().Set("Content-Type", "application/json")
+	_, err = w.Write(content)
+	if err != nil {
+		impl.logger.Errorw("error in writing sbom response", "artifactId", artifactId, "sbomId", sbomId, "err", err)
+	}
+}
+
+// SearchComponent lists the artifacts whose sbom contains a component, filtered to the apps the user can view
+func (impl *SbomRestHandlerImpl) SearchComponent(w http.ResponseWriter, r *http.Request) {
+	userId, err := impl.userAuthService.GetLoggedInUser(r)
+	if userId == 0 || err != nil {
+		common.HandleUnauthorized(w, r)
+		return
+	}
+	var request bean.SbomSearchRequest
+	err = json.NewDecoder(r.Body).Decode(&request)
+	if err != nil {
+		impl.logger.Errorw("request err, SearchComponent", "err", err)
+		common.WriteJsonResp(w, err, nil, http.StatusBadRequest)
+		return
+	}
+	err = impl.validator.Struct(request)
+	if err != nil {
+		impl.logger.Errorw("validation err, SearchComponent", "payload", request, "err", err)
+		common.WriteJsonResp(w, err, nil, http.StatusBadRequest)
+		return
+	}
+	appIds, err := impl.sbomService.GetComponentAppIds(&request)
+	if err != nil {
+		impl.logger.Errorw("service err, SearchComponent", "payload", request, "err", err)
+		common.WriteJsonResp(w, err, nil, http.StatusInternalServerError)
+		return
+	}
+	// rbac is applied on the apps before searching, so that the pages are not emptied by filtering
+	token := r.Header.Get("token")
+	rbacObjects := impl.enforcerUtil.GetRbacObjectsByAppIds(appIds)
+	rbacObjectList := make([]string, 0, len(rbacObjects))
+	for _, rbacObject := range rbacObjects {
+		rbacObjectList = append(rbacObjectList, rbacObject)
+	}
+	enforcedMap := impl.enforcer.EnforceInBatch(token, casbin.ResourceApplications, casbin.ActionGet, rbacObjectList)
+	request.AppIds = make([]int, 0, len(appIds))
+	for _, appId := range appIds {
+		if enforcedMap[rbacObjects[appId]] {
+			request.AppIds = append(request.AppIds, appId)
+		}
+	}
+	results, err := impl.sbomService.SearchComponent(&request)
+	if err != nil {
+		impl.logger.Errorw("service err, SearchComponent", "payload", request, "err", err)
+		common.WriteJsonResp(w, err, nil, http.StatusInternalServerError)
+		return
+	}
+	common.WriteJsonResp(w, nil, results, http.StatusOK)
+}
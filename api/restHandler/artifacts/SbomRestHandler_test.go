@@ -0,0 +1,59 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package artifacts
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/devtron-labs/devtron/pkg/auth/authorisation/casbin"
+	casbinMocks "github.com/devtron-labs/devtron/pkg/auth/authorisation/casbin/mocks"
+	mock_user "github.com/devtron-labs/devtron/pkg/auth/user/mocks"
+	"github.com/devtron-labs/devtron/pkg/build/artifacts/sbom/bean"
+	sbomMocks "github.com/devtron-labs/devtron/pkg/build/artifacts/sbom/mocks"
+	rbacMocks "github.com/devtron-labs/devtron/util/rbac/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+	"gopkg.in/go-playground/validator.v9"
+)
+
+func TestSearchComponentAppliesRbacBeforePagination(t *testing.T) {
+	userService := mock_user.NewUserService(t)
+	userService.On("GetLoggedInUser", mock.Anything).Return(int32(2), nil)
+	enforcerUtil := rbacMocks.NewEnforcerUtil(t)
+	enforcerUtil.On("GetRbacObjectsByAppIds", []int{1, 2, 3}).Return(map[int]string{1: "team/app-1", 2: "team/app-2", 3: "team/app-3"})
+	enforcer := casbinMocks.NewEnforcer(t)
+	enforcer.On("EnforceInBatch", mock.Anything, casbin.ResourceApplications, casbin.ActionGet, mock.MatchedBy(func(rbacObjects []string) bool {
+		sort.Strings(rbacObjects)
+		return assert.ObjectsAreEqual([]string{"team/app-1", "team/app-2", "team/app-3"}, rbacObjects)
+	})).Return(map[string]bool{"team/app-1": true, "team/app-3": true})
+	sbomService := sbomMocks.NewSbomService(t)
+	sbomService.On("GetComponentAppIds", mock.AnythingOfType("*bean.SbomSearchRequest")).Return([]int{1, 2, 3}, nil)
+	sbomService.On("SearchComponent", mock.MatchedBy(func(request *bean.SbomSearchRequest) bool {
+		return assert.ObjectsAreEqual([]int{1, 3}, request.AppIds) && request.Size == 10 && request.Offset == 20
+	})).Return([]*bean.SbomSearchResult{}, nil).Once()
+	handler := NewSbomRestHandlerImpl(zap.NewNop().Sugar(), userService, validator.New(), enforcer, enforcerUtil, sbomService)
+
+	request := httptest.NewRequest(http.MethodPost, "/orchestrator/sbom/search", strings.NewReader(`{"component":"log4j","size":10,"offset":20}`))
+	recorder := httptest.NewRecorder()
+	handler.SearchComponent(recorder, request)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+}
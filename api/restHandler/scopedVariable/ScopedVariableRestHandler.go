@@ -34,6 +34,7 @@ import (
 	"github.com/devtron-labs/devtron/pkg/variables/utils"
 	"github.com/devtron-labs/devtron/util"
 	"github.com/devtron-labs/devtron/util/rbac"
+	"github.com/gorilla/mux"
 	"go.uber.org/zap"
 	"gopkg.in/go-playground/validator.v9"
 )
@@ -42,6 +43,12 @@ type ScopedVariableRestHandler interface {
 	CreateVariables(w http.ResponseWriter, r *http.Request)
 	GetScopedVariables(w http.ResponseWriter, r *http.Request)
 	GetJsonForVariables(w http.ResponseWriter, r *http.Request)
+	GetVariableUsage(w http.ResponseWriter, r *http.Request)
+	GetVariableImpact(w http.ResponseWriter, r *http.Request)
+	GetVariableSetVersions(w http.ResponseWriter, r *http.Request)
+	GetVariableSetVersion(w http.ResponseWriter, r *http.Request)
+	ReviewVariableSetVersion(w http.ResponseWriter, r *http.Request)
+	RollbackVariableSetVersion(w http.ResponseWriter, r *http.Request)
 }
 
 type ScopedVariableRestHandlerImpl struct {
@@ -52,13 +59,19 @@ type ScopedVariableRestHandlerImpl struct {
 	enforcerUtil          rbac.EnforcerUtil
 	enforcer              casbin.Enforcer
 	scopedVariableService variables.ScopedVariableService
+	variableUsageService  variables.VariableUsageService
 }
 type JsonResponse struct {
 	Manifest   *models.ScopedVariableManifest `json:"manifest"`
 	JsonSchema string                         `json:"jsonSchema"`
 }
 
-func NewScopedVariableRestHandlerImpl(logger *zap.SugaredLogger, userAuthService user.UserService, validator *validator.Validate, pipelineBuilder pipeline.PipelineBuilder, enforcerUtil rbac.EnforcerUtil, enforcer casbin.Enforcer, scopedVariableService variables.ScopedVariableService) *ScopedVariableRestHandlerImpl {
+type VariableSetVersionResponse struct {
+	*models.VariableSetVersion
+	Manifest *models.ScopedVariableManifest `json:"manifest"`
+}
+
+func NewScopedVariableRestHandlerImpl(logger *zap.SugaredLogger, userAuthService user.UserService, validator *validator.Validate, pipelineBuilder pipeline.PipelineBuilder, enforcerUtil rbac.EnforcerUtil, enforcer casbin.Enforcer, scopedVariableService variables.ScopedVariableService, variableUsageService variables.VariableUsageService) *ScopedVariableRestHandlerImpl {
 	return &ScopedVariableRestHandlerImpl{
 		logger:                logger,
 		userAuthService:       userAuthService,
@@ -67,6 +80,7 @@ func NewScopedVariableRestHandlerImpl(logger *zap.SugaredLogger, userAuthService
 		enforcerUtil:          enforcerUtil,
 		enforcer:              enforcer,
 		scopedVariableService: scopedVariableService,
+		variableUsageService:  variableUsageService,
 	}
 }
 func (handler *ScopedVariableRestHandlerImpl) CreateVariables(w http.ResponseWriter, r *http.Request) {
@@ -106,7 +120,7 @@ func (handler *ScopedVariableRestHandlerImpl) CreateVariables(w http.ResponseWri
 		return
 	}
 	//RBAC enforcer Ends
-	err = handler.scopedVariableService.CreateVariables(payload)
+	version, err := handler.scopedVariableService.CreateVariableSetVersion(payload, request.Comment)
 	if err != nil {
 		if errors.As(err, &models.ValidationError{}) {
 			common.WriteJsonResp(w, err, nil, http.StatusNotAcceptable)
@@ -115,7 +129,7 @@ func (handler *ScopedVariableRestHandlerImpl) CreateVariables(w http.ResponseWri
 		}
 		return
 	}
-	common.WriteJsonResp(w, nil, nil, http.StatusOK)
+	common.WriteJsonResp(w, nil, version, http.StatusOK)
 }
 func (handler *ScopedVariableRestHandlerImpl) GetScopedVariables(w http.ResponseWriter, r *http.Request) {
 	token := r.Header.Get("token")
@@ -206,3 +220,215 @@ func (handler *ScopedVariableRestHandlerImpl) GetJsonForVariables(w http.Respons
 	}
 	common.WriteJsonResp(w, nil, jsonResponse, http.StatusOK)
 }
+
+func (handler *ScopedVariableRestHandlerImpl) GetVariableUsage(w http.ResponseWriter, r *http.Request) {
+	userId, err := handler.userAuthService.GetLoggedInUser(r)
+	if userId == 0 || err != nil {
+		common.HandleUnauthorized(w, r)
+		return
+	}
+	variableName := r.URL.Query().Get("name")
+	if variableName == "" {
+		common.WriteJsonResp(w, errors.New("variable name is required"), nil, http.StatusBadRequest)
+		return
+	}
+
+	// RBAC enforcer applying
+	token := r.Header.Get("token")
+	if isSuperAdmin := handler.enforcer.Enforce(token, casbin.ResourceGlobal, casbin.ActionGet, "*"); !isSuperAdmin {
+		common.WriteJsonResp(w, errors.New("unauthorized"), nil, http.StatusForbidden)
+		return
+	}
+	//RBAC enforcer Ends
+
+	variableUsage, err := handler.variableUsageService.GetVariableUsage(variableName)
+	if err != nil {
+		handler.logger.Errorw("service err, GetVariableUsage", "variableName", variableName, "err", err)
+		common.WriteJsonResp(w, err, nil, http.StatusInternalServerError)
+		return
+	}
+	common.WriteJsonResp(w, nil, variableUsage, http.StatusOK)
+}
+
+func (handler *ScopedVariableRestHandlerImpl) GetVariableImpact(w http.ResponseWriter, r *http.Request) {
+	decoder := json.NewDecoder(r.Body)
+	userId, err := handler.userAuthService.GetLoggedInUser(r)
+	if userId == 0 || err != nil {
+		common.HandleUnauthorized(w, r)
+		return
+	}
+	request := &models.VariableImpactRequest{}
+	decoder.UseNumber()
+	err = decoder.Decode(request)
+	if err != nil {
+		handler.logger.Errorw("request err, GetVariableImpact", "err", err)
+		common.WriteJsonResp(w, err, nil, http.StatusBadRequest)
+		return
+	}
+	request.UserId = userId
+
+	err = handler.validator.Struct(request)
+	if err != nil {
+		handler.logger.Errorw("struct validation err in GetVariableImpact", "err", err)
+		common.WriteJsonResp(w, err, nil, http.StatusNotAcceptable)
+		return
+	}
+
+	// RBAC enforcer applying
+	token := r.Header.Get("token")
+	if isSuperAdmin := handler.enforcer.Enforce(token, casbin.ResourceGlobal, casbin.ActionGet, "*"); !isSuperAdmin {
+		common.WriteJsonResp(w, errors.New("unauthorized"), nil, http.StatusForbidden)
+		return
+	}
+	//RBAC enforcer Ends
+
+	// not logging request as it contains sensitive data
+	variableUsage, err := handler.variableUsageService.GetVariableImpact(request)
+	if err != nil {
+		if errors.As(err, &models.ValidationError{}) {
+			common.WriteJsonResp(w, err, nil, http.StatusNotAcceptable)
+		} else {
+			common.WriteJsonResp(w, err, nil, http.StatusInternalServerError)
+		}
+		return
+	}
+	common.WriteJsonResp(w, nil, variableUsage, http.StatusOK)
+}
+
+func (handler *ScopedVariableRestHandlerImpl) GetVariableSetVersions(w http.ResponseWriter, r *http.Request) {
+	userId, err := handler.userAuthService.GetLoggedInUser(r)
+	if userId == 0 || err != nil {
+		common.HandleUnauthorized(w, r)
+		return
+	}
+	// RBAC enforcer applying
+	token := r.Header.Get("token")
+	if isSuperAdmin := handler.enforcer.Enforce(token, casbin.ResourceGlobal, casbin.ActionGet, "*"); !isSuperAdmin {
+		common.WriteJsonResp(w, errors.New("unauthorized"), nil, http.StatusForbidden)
+		return
+	}
+	//RBAC enforcer Ends
+
+	versions, err := handler.scopedVariableService.GetVariableSetVersions()
+	if err != nil {
+		handler.logger.Errorw("service err, GetVariableSetVersions", "err", err)
+		common.WriteJsonResp(w, err, nil, http.StatusInternalServerError)
+		return
+	}
+	common.WriteJsonResp(w, nil, versions, http.StatusOK)
+}
+
+func (handler *ScopedVariableRestHandlerImpl) GetVariableSetVersion(w http.ResponseWriter, r *http.Request) {
+	userId, err := handler.userAuthService.GetLoggedInUser(r)
+	if userId == 0 || err != nil {
+		common.HandleUnauthorized(w, r)
+		return
+	}
+	id, err := strconv.Atoi(mux.Vars(r)["id"])
+	if err != nil {
+		common.WriteJsonResp(w, err, "invalid version id", http.StatusBadRequest)
+		return
+	}
+	// RBAC enforcer applying
+	token := r.Header.Get("token")
+	if isSuperAdmin := handler.enforcer.Enforce(token, casbin.ResourceGlobal, casbin.ActionGet, "*"); !isSuperAdmin {
+		common.WriteJsonResp(w, errors.New("unauthorized"), nil, http.StatusForbidden)
+		return
+	}
+	//RBAC enforcer Ends
+
+	version, err := handler.scopedVariableService.GetVariableSetVersion(id)
+	if err != nil {
+		handler.logger.Errorw("service err, GetVariableSetVersion", "id", id, "err", err)
+		common.WriteJsonResp(w, err, nil, http.StatusInternalServerError)
+		return
+	}
+	response := VariableSetVersionResponse{VariableSetVersion: version}
+	if version.Payload != nil {
+		manifest := utils.PayloadToManifest(*version.Payload)
+		response.Manifest = &manifest
+	}
+	common.WriteJsonResp(w, nil, response, http.StatusOK)
+}
+
+func (handler *ScopedVariableRestHandlerImpl) ReviewVariableSetVersion(w http.ResponseWriter, r *http.Request) {
+	decoder := json.NewDecoder(r.Body)
+	userId, err := handler.userAuthService.GetLoggedInUser(r)
+	if userId == 0 || err != nil {
+		common.HandleUnauthorized(w, r)
+		return
+	}
+	request := &models.VariableSetReviewRequest{}
+	err = decoder.Decode(request)
+	if err != nil {
+		handler.logger.Errorw("request err, ReviewVariableSetVersion", "err", err, "request", request)
+		common.WriteJsonResp(w, err, nil, http.StatusBadRequest)
+		return
+	}
+	err = handler.validator.Struct(request)
+	if err != nil {
+		handler.logger.Errorw("struct validation err in ReviewVariableSetVersion", "err", err, "request", request)
+		common.WriteJsonResp(w, err, nil, http.StatusBadRequest)
+		return
+	}
+	// RBAC enforcer applying
+	token := r.Header.Get("token")
+	if isSuperAdmin := handler.enforcer.Enforce(token, casbin.ResourceGlobal, casbin.ActionCreate, "*"); !isSuperAdmin {
+		common.WriteJsonResp(w, errors.New("unauthorized"), nil, http.StatusForbidden)
+		return
+	}
+	//RBAC enforcer Ends
+
+	version, err := handler.scopedVariableService.ReviewVariableSetVersion(request, userId)
+	if err != nil {
+		handler.logger.Errorw("service err, ReviewVariableSetVersion", "request", request, "err", err)
+		if errors.As(err, &models.ValidationError{}) {
+			common.WriteJsonResp(w, err, nil, http.StatusNotAcceptable)
+		} else {
+			common.WriteJsonResp(w, err, nil, http.StatusInternalServerError)
+		}
+		return
+	}
+	common.WriteJsonResp(w, nil, version, http.StatusOK)
+}
+
+func (handler *ScopedVariableRestHandlerImpl) RollbackVariableSetVersion(w http.ResponseWriter, r *http.Request) {
+	decoder := json.NewDecoder(r.Body)
+	userId, err := handler.userAuthService.GetLoggedInUser(r)
+	if userId == 0 || err != nil {
+		common.HandleUnauthorized(w, r)
+		return
+	}
+	request := &models.VariableSetRollbackRequest{}
+	err = decoder.Decode(request)
+	if err != nil {
+		handler.logger.Errorw("request err, RollbackVariableSetVersion", "err", err, "request", request)
+		common.WriteJsonResp(w, err, nil, http.StatusBadRequest)
+		return
+	}
+	err = handler.validator.Struct(request)
+	if err != nil {
+		handler.logger.Errorw("struct validation err in RollbackVariableSetVersion", "err", err, "request", request)
+		common.WriteJsonResp(w, err, nil, http.StatusBadRequest)
+		return
+	}
+	// RBAC enforcer applying
+	token := r.Header.Get("token")
+	if isSuperAdmin := handler.enforcer.Enforce(token, casbin.ResourceGlobal, casbin.ActionCreate, "*"); !isSuperAdmin {
+		common.WriteJsonResp(w, errors.New("unauthorized"), nil, http.StatusForbidden)
+		return
+	}
+	//RBAC enforcer Ends
+
+	version, err := handler.scopedVariableService.RollbackVariableSetVersion(request, userId)
+	if err != nil {
+		handler.logger.Errorw("service err, RollbackVariableSetVersion", "request", request, "err", err)
+		if errors.As(err, &models.ValidationError{}) {
+			common.WriteJsonResp(w, err, nil, http.StatusNotAcceptable)
+		} else {
+			common.WriteJsonResp(w, err, nil, http.StatusInternalServerError)
+		}
+		return
+	}
+	common.WriteJsonResp(w, nil, version, http.StatusOK)
+}
@@ -0,0 +1,61 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package router
+
+import (
+	"github.com/devtron-labs/devtron/api/restHandler"
+	"github.com/gorilla/mux"
+)
+
+type BuildpackCatalogueRouter interface {
+	InitBuildpackCatalogueRouter(buildpackRouter *mux.Router)
+}
+
+type BuildpackCatalogueRouterImpl struct {
+	buildpackCatalogueRestHandler restHandler.BuildpackCatalogueRestHandler
+}
+
+func NewBuildpackCatalogueRouterImpl(buildpackCatalogueRestHandler restHandler.BuildpackCatalogueRestHandler) *BuildpackCatalogueRouterImpl {
+	return &BuildpackCatalogueRouterImpl{buildpackCatalogueRestHandler: buildpackCatalogueRestHandler}
+}
+
+func (impl BuildpackCatalogueRouterImpl) InitBuildpackCatalogueRouter(buildpackRouter *mux.Router) {
+	buildpackRouter.Path("/catalogue").
+		HandlerFunc(impl.buildpackCatalogueRestHandler.GetCatalogue).
+		Methods("GET")
+	buildpackRouter.Path("/catalogue/builder").
+		HandlerFunc(impl.buildpackCatalogueRestHandler.SaveBuilder).
+		Methods("POST")
+	buildpackRouter.Path("/catalogue/builder/{id}").
+		HandlerFunc(impl.buildpackCatalogueRestHandler.DeleteBuilder).
+		Methods("DELETE")
+	buildpackRouter.Path("/catalogue/buildpack").
+		HandlerFunc(impl.buildpackCatalogueRestHandler.SaveBuildpack).
+		Methods("POST")
+	buildpackRouter.Path("/catalogue/buildpack/{id}").
+		HandlerFunc(impl.buildpackCatalogueRestHandler.DeleteBuildpack).
+		Methods("DELETE")
+	buildpackRouter.Path("/catalogue/language-default").
+		HandlerFunc(impl.buildpackCatalogueRestHandler.SaveLanguageDefault).
+		Methods("POST")
+	buildpackRouter.Path("/catalogue/language-default/{id}").
+		HandlerFunc(impl.buildpackCatalogueRestHandler.DeleteLanguageDefault).
+		Methods("DELETE")
+	buildpackRouter.Path("/outdated-builders").
+		HandlerFunc(impl.buildpackCatalogueRestHandler.GetOutdatedBuilderUsages).
+		Methods("GET")
+}
@@ -88,4 +88,10 @@ func (router ConfigMapRouterImpl) initConfigMapRouter(configRouter *mux.Router)
 	configRouter.Path("/environment/{appId}").
 		HandlerFunc(router.restHandler.GetEnvironmentsForJob).Methods("GET")
 
+	configRouter.Path("/rotate").
+		HandlerFunc(router.restHandler.RotateConfig).Methods("POST")
+	configRouter.Path("/rotation/history/{appId}/{envId}").
+		Queries("name", "{name}", "resourceType", "{resourceType}").
+		HandlerFunc(router.restHandler.GetRotationHistory).Methods("GET")
+
 }
@@ -0,0 +1,58 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package router
+
+import (
+	"github.com/devtron-labs/devtron/api/restHandler"
+	"github.com/gorilla/mux"
+)
+
+type ImageSigningRouter interface {
+	InitImageSigningRouter(imageSigningRouter *mux.Router)
+}
+
+type ImageSigningRouterImpl struct {
+	imageSigningRestHandler restHandler.ImageSigningRestHandler
+}
+
+func NewImageSigningRouterImpl(imageSigningRestHandler restHandler.ImageSigningRestHandler) *ImageSigningRouterImpl {
+	return &ImageSigningRouterImpl{imageSigningRestHandler: imageSigningRestHandler}
+}
+
+func (impl ImageSigningRouterImpl) InitImageSigningRouter(imageSigningRouter *mux.Router) {
+	imageSigningRouter.Path("/key").
+		HandlerFunc(impl.imageSigningRestHandler.GetSigningKeys).
+		Methods("GET")
+	imageSigningRouter.Path("/key").
+		HandlerFunc(impl.imageSigningRestHandler.SaveSigningKey).
+		Methods("POST")
+	imageSigningRouter.Path("/key/{id}").
+		HandlerFunc(impl.imageSigningRestHandler.DeleteSigningKey).
+		Methods("DELETE")
+	imageSigningRouter.Path("/policy").
+		HandlerFunc(impl.imageSigningRestHandler.GetPolicies).
+		Methods("GET")
+	imageSigningRouter.Path("/policy").
+		HandlerFunc(impl.imageSigningRestHandler.SavePolicy).
+		Methods("POST")
+	imageSigningRouter.Path("/policy/{id}").
+		HandlerFunc(impl.imageSigningRestHandler.DeletePolicy).
+		Methods("DELETE")
+	imageSigningRouter.Path("/artifact/{artifactId}/verify").
+		HandlerFunc(impl.imageSigningRestHandler.VerifyArtifact).
+		Methods("GET")
+}
@@ -0,0 +1,43 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package router
+
+import (
+	"github.com/devtron-labs/devtron/api/restHandler/artifacts"
+	"github.com/gorilla/mux"
+)
+
+type ProvenanceRouter interface {
+	InitProvenanceRouter(provenanceRouter *mux.Router)
+}
+
+type ProvenanceRouterImpl struct {
+	provenanceRestHandler artifacts.ProvenanceRestHandler
+}
+
+func NewProvenanceRouterImpl(provenanceRestHandler artifacts.ProvenanceRestHandler) *ProvenanceRouterImpl {
+	return &ProvenanceRouterImpl{provenanceRestHandler: provenanceRestHandler}
+}
+
+func (impl ProvenanceRouterImpl) InitProvenanceRouter(provenanceRouter *mux.Router) {
+	provenanceRouter.Path("/artifact/{artifactId}").
+		HandlerFunc(impl.provenanceRestHandler.GetArtifactProvenance).
+		Methods("GET")
+	provenanceRouter.Path("/artifact/{artifactId}/download").
+		HandlerFunc(impl.provenanceRestHandler.DownloadArtifactProvenance).
+		Methods("GET")
+}
@@ -46,5 +46,24 @@ func (impl ScopedVariableRouterImpl) InitScopedVariableRouter(router *mux.Router
 	router.Path("/variables/detail").
 		HandlerFunc(impl.scopedVariableRestHandler.GetJsonForVariables).
 		Methods("GET")
+	router.Path("/variables/usage").
+		HandlerFunc(impl.scopedVariableRestHandler.GetVariableUsage).
+		Queries("name", "{name}").
+		Methods("GET")
+	router.Path("/variables/impact").
+		HandlerFunc(impl.scopedVariableRestHandler.GetVariableImpact).
+		Methods("POST")
+	router.Path("/variables/versions").
+		HandlerFunc(impl.scopedVariableRestHandler.GetVariableSetVersions).
+		Methods("GET")
+	router.Path("/variables/versions/review").
+		HandlerFunc(impl.scopedVariableRestHandler.ReviewVariableSetVersion).
+		Methods("POST")
+	router.Path("/variables/versions/rollback").
+		HandlerFunc(impl.scopedVariableRestHandler.RollbackVariableSetVersion).
+		Methods("POST")
+	router.Path("/variables/versions/{id}").
+		HandlerFunc(impl.scopedVariableRestHandler.GetVariableSetVersion).
+		Methods("GET")
 
 }
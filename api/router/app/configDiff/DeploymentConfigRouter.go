@@ -30,6 +30,9 @@ func (router DeploymentConfigurationRouterImpl) InitDeploymentConfigurationRoute
 	configRouter.Path("/compare/{resource}").
 		HandlerFunc(router.deploymentGroupRestHandler.CompareCategoryWiseConfigData).
 		Methods("GET")
+	configRouter.Path("/drift-report").
+		HandlerFunc(router.deploymentGroupRestHandler.GetConfigDriftReport).
+		Methods("GET")
 
 	configRouter.Path("/manifest").
 		HandlerFunc(router.deploymentGroupRestHandler.GetManifest).
@@ -53,6 +53,7 @@ import (
 	"github.com/devtron-labs/devtron/client/dashboard"
 	"github.com/devtron-labs/devtron/client/proxy"
 	"github.com/devtron-labs/devtron/client/telemetry"
+	"github.com/devtron-labs/devtron/pkg/policyGovernance/security/imageScanning"
 	"github.com/devtron-labs/devtron/pkg/terminal"
 	"github.com/devtron-labs/devtron/util"
 	"github.com/gorilla/mux"
@@ -128,6 +129,13 @@ type MuxRouter struct {
 	userResourceRouter                 userResource.Router
 	overviewRouter                     OverviewRouter
 	globalAuthorisationConfigRouter    globalConfig.AuthorisationConfigRouter
+	buildpackCatalogueRouter           BuildpackCatalogueRouter
+	sbomRouter                         SbomRouter
+	imageSigningRouter                 ImageSigningRouter
+	provenanceRouter                   ProvenanceRouter
+	cveExceptionRouter                 CveExceptionRouter
+	imageRescanService                 imageScanning.ImageRescanService
+	manifestPolicyRouter               deployment.ManifestPolicyRouter
 }
 
 func NewMuxRouter(logger *zap.SugaredLogger,
@@ -165,6 +173,13 @@ func NewMuxRouter(logger *zap.SugaredLogger,
 	userResourceRouter userResource.Router,
 	overviewRouter OverviewRouter,
 	globalAuthorisationConfigRouter globalConfig.AuthorisationConfigRouter,
+	buildpackCatalogueRouter BuildpackCatalogueRouter,
+	sbomRouter SbomRouter,
+	imageSigningRouter ImageSigningRouter,
+	provenanceRouter ProvenanceRouter,
+	cveExceptionRouter CveExceptionRouter,
+	imageRescanService imageScanning.ImageRescanService,
+	manifestPolicyRouter deployment.ManifestPolicyRouter,
 ) *MuxRouter {
 	r := &MuxRouter{
 		Router:                             mux.NewRouter(),
@@ -234,6 +249,13 @@ func NewMuxRouter(logger *zap.SugaredLogger,
 		userResourceRouter:                 userResourceRouter,
 		overviewRouter:                     overviewRouter,
 		globalAuthorisationConfigRouter:    globalAuthorisationConfigRouter,
+		buildpackCatalogueRouter:           buildpackCatalogueRouter,
+		sbomRouter:                         sbomRouter,
+		imageSigningRouter:                 imageSigningRouter,
+		provenanceRouter:                   provenanceRouter,
+		cveExceptionRouter:                 cveExceptionRouter,
+		imageRescanService:                 imageRescanService,
+		manifestPolicyRouter:               manifestPolicyRouter,
 	}
 	return r
 }
@@ -301,6 +323,18 @@ func (r MuxRouter) Init() {
 	dockerRouter := r.Router.PathPrefix("/orchestrator/docker").Subrouter()
 	r.DockerRegRouter.InitDockerRegRouter(dockerRouter)
 
+	buildpackRouter := r.Router.PathPrefix("/orchestrator/buildpack").Subrouter()
+	r.buildpackCatalogueRouter.InitBuildpackCatalogueRouter(buildpackRouter)
+
+	sbomRouter := r.Router.PathPrefix("/orchestrator/sbom").Subrouter()
+	r.sbomRouter.InitSbomRouter(sbomRouter)
+
+	imageSigningRouter := r.Router.PathPrefix("/orchestrator/image-signing").Subrouter()
+	r.imageSigningRouter.InitImageSigningRouter(imageSigningRouter)
+
+	provenanceRouter := r.Router.PathPrefix("/orchestrator/provenance").Subrouter()
+	r.provenanceRouter.InitProvenanceRouter(provenanceRouter)
+
 	notificationRouter := r.Router.PathPrefix("/orchestrator/notification").Subrouter()
 	r.NotificationRouter.InitNotificationRegRouter(notificationRouter)
 
@@ -346,6 +380,9 @@ func (r MuxRouter) Init() {
 	policyRouter := r.Router.PathPrefix("/orchestrator/security/policy").Subrouter()
 	r.policyRouter.InitPolicyRouter(policyRouter)
 
+	cveExceptionRouter := r.Router.PathPrefix("/orchestrator/security/cve-exception").Subrouter()
+	r.cveExceptionRouter.InitCveExceptionRouter(cveExceptionRouter)
+
 	gitOpsRouter := r.Router.PathPrefix("/orchestrator/gitops").Subrouter()
 	r.gitOpsConfigRouter.InitGitOpsConfigRouter(gitOpsRouter)
 
@@ -396,6 +433,8 @@ func (r MuxRouter) Init() {
 	//  deployment router starts
 	deploymentConfigSubRouter := r.Router.PathPrefix("/orchestrator/deployment/template").Subrouter()
 	r.deploymentConfigRouter.Init(deploymentConfigSubRouter)
+	manifestPolicySubRouter := r.Router.PathPrefix("/orchestrator/deployment/manifest-policy").Subrouter()
+	r.manifestPolicyRouter.Init(manifestPolicySubRouter)
 	// deployment router ends
 
 	//  dashboard event router starts
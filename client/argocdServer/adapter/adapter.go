@@ -35,5 +35,8 @@ func GetArgoCdPatchReqFromDto(dto *bean.ArgoCdAppPatchReqDto) v1alpha1.Applicati
 	if len(dto.GitRepoUrl) > 0 {
 		app.Spec.Source.RepoURL = dto.GitRepoUrl
 	}
+	if len(dto.Chart) > 0 {
+		app.Spec.Source.Chart = dto.Chart
+	}
 	return app
 }
@@ -36,6 +36,7 @@ type ArgoCdAppPatchReqDto struct {
 	ChartLocation  string
 	GitRepoUrl     string
 	TargetRevision string
+	Chart          string // set for helm OCI sources only
 	PatchType      string
 }
 
@@ -49,11 +49,15 @@ type AppTemplate struct {
 	RepoPath        string
 	RepoUrl         string
 	AutoSyncEnabled bool
+	// Chart and TargetRevision are used for helm OCI sources, RepoUrl is the registry host in that case
+	Chart          string
+	TargetRevision string
 }
 
 const (
-	TimeoutSlow                 = 30 * time.Second
-	ARGOCD_APPLICATION_TEMPLATE = "./scripts/argo-assets/APPLICATION_TEMPLATE.tmpl"
+	TimeoutSlow                     = 30 * time.Second
+	ARGOCD_APPLICATION_TEMPLATE     = "./scripts/argo-assets/APPLICATION_TEMPLATE.tmpl"
+	ARGOCD_OCI_APPLICATION_TEMPLATE = "./scripts/argo-assets/OCI_APPLICATION_TEMPLATE.tmpl"
 )
 
 type ArgoK8sClient interface {
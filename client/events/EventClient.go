@@ -34,6 +34,7 @@ import (
 	util "github.com/devtron-labs/devtron/util/event"
 	"go.uber.org/zap"
 	"net/http"
+	"time"
 )
 
 type EventClientConfig struct {
@@ -94,6 +95,33 @@ type Payload struct {
 	BuildHistoryLink      string                         `json:"buildHistoryLink"`
 	MaterialTriggerInfo   *buildBean.MaterialTriggerInfo `json:"material"`
 	FailureReason         string                         `json:"failureReason"`
+	CveException          *CveExceptionPayload           `json:"cveException,omitempty"`
+	ImageRescan           *ImageRescanPayload            `json:"imageRescan,omitempty"`
+}
+
+type CveExceptionPayload struct {
+	ExceptionId   int       `json:"exceptionId"`
+	CveName       string    `json:"cveName"`
+	Image         string    `json:"image,omitempty"`
+	Justification string    `json:"justification"`
+	ExpiresOn     time.Time `json:"expiresOn"`
+	Expired       bool      `json:"expired"`
+	RequestedBy   string    `json:"requestedBy"`
+	ApprovedBy    string    `json:"approvedBy"`
+}
+
+type ImageRescanPayload struct {
+	Image              string                      `json:"image"`
+	ImageDigest        string                      `json:"imageDigest,omitempty"`
+	NewVulnerabilities []*RescanVulnerabilityEntry `json:"newVulnerabilities"`
+}
+
+type RescanVulnerabilityEntry struct {
+	CveName      string `json:"cveName"`
+	Severity     string `json:"severity"`
+	Package      string `json:"package"`
+	Version      string `json:"version"`
+	FixedVersion string `json:"fixedVersion"`
 }
 
 type EventRESTClientImpl struct {
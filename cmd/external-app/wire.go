@@ -147,6 +147,8 @@ func InitializeApp() (*App, error) {
 		scanTool.ScanToolWireSet,
 
 		sql.NewTransactionUtilImpl,
+		sql.NewCronLeaseImpl,
+		wire.Bind(new(sql.CronLease), new(*sql.CronLeaseImpl)),
 
 		// appStatus
 		appStatus.NewAppStatusRepositoryImpl,
@@ -44,24 +44,28 @@ type GitOpsConfigRepositoryImpl struct {
 }
 
 type GitOpsConfig struct {
-	tableName             struct{}                    `sql:"gitops_config" pg:",discard_unknown_columns"`
-	Id                    int                         `sql:"id,pk"`
-	Provider              string                      `sql:"provider"`
-	Username              string                      `sql:"username"`
-	Token                 securestore.EncryptedString `sql:"token"`
-	GitLabGroupId         string                      `sql:"gitlab_group_id"`
-	GitHubOrgId           string                      `sql:"github_org_id"`
-	AzureProject          string                      `sql:"azure_project"`
-	Host                  string                      `sql:"host"`
-	Active                bool                        `sql:"active,notnull"`
-	AllowCustomRepository bool                        `sql:"allow_custom_repository,notnull"`
-	BitBucketWorkspaceId  string                      `sql:"bitbucket_workspace_id"`
-	BitBucketProjectKey   string                      `sql:"bitbucket_project_key"`
-	EmailId               string                      `sql:"email_id"`
-	EnableTLSVerification bool                        `sql:"enable_tls_verification"`
-	TlsCert               string                      `sql:"tls_cert"`
-	TlsKey                string                      `sql:"tls_key"`
-	CaCert                string                      `sql:"ca_cert"`
+	tableName               struct{}                    `sql:"gitops_config" pg:",discard_unknown_columns"`
+	Id                      int                         `sql:"id,pk"`
+	Provider                string                      `sql:"provider"`
+	Username                string                      `sql:"username"`
+	Token                   securestore.EncryptedString `sql:"token"`
+	GitLabGroupId           string                      `sql:"gitlab_group_id"`
+	GitHubOrgId             string                      `sql:"github_org_id"`
+	AzureProject            string                      `sql:"azure_project"`
+	Host                    string                      `sql:"host"`
+	Active                  bool                        `sql:"active,notnull"`
+	AllowCustomRepository   bool                        `sql:"allow_custom_repository,notnull"`
+	BitBucketWorkspaceId    string                      `sql:"bitbucket_workspace_id"`
+	BitBucketProjectKey     string                      `sql:"bitbucket_project_key"`
+	EmailId                 string                      `sql:"email_id"`
+	EnableTLSVerification   bool                        `sql:"enable_tls_verification"`
+	TlsCert                 string                      `sql:"tls_cert"`
+	TlsKey                  string                      `sql:"tls_key"`
+	CaCert                  string                      `sql:"ca_cert"`
+	CommitSigningEnabled    bool                        `sql:"commit_signing_enabled,notnull"`
+	CommitSigningFormat     string                      `sql:"commit_signing_format"`
+	CommitSigningKey        securestore.EncryptedString `sql:"commit_signing_key"`
+	CommitSigningPassphrase securestore.EncryptedString `sql:"commit_signing_passphrase"`
 	sql.AuditLog
 }
 
@@ -80,6 +84,10 @@ func (impl *GitOpsConfigRepositoryImpl) CreateGitOpsConfig(model *GitOpsConfig,
 		if err != nil {
 			return model, err
 		}
+		err = encryptCommitSigningSecrets(model)
+		if err != nil {
+			return model, err
+		}
 	}
 	err = tx.Insert(model)
 	if err != nil {
@@ -94,6 +102,10 @@ func (impl *GitOpsConfigRepositoryImpl) UpdateGitOpsConfig(model *GitOpsConfig,
 		if err != nil {
 			return err
 		}
+		err = encryptCommitSigningSecrets(model)
+		if err != nil {
+			return err
+		}
 	}
 	err = tx.Update(model)
 	if err != nil {
@@ -141,3 +153,19 @@ func (impl *GitOpsConfigRepositoryImpl) GetEmailIdFromActiveGitOpsConfig() (stri
 		Where("active = ?", true).Select(&emailId)
 	return emailId, err
 }
+
+func encryptCommitSigningSecrets(model *GitOpsConfig) (err error) {
+	if len(model.CommitSigningKey) > 0 {
+		model.CommitSigningKey, err = securestore.EncryptString(model.CommitSigningKey.String())
+		if err != nil {
+			return err
+		}
+	}
+	if len(model.CommitSigningPassphrase) > 0 {
+		model.CommitSigningPassphrase, err = securestore.EncryptString(model.CommitSigningPassphrase.String())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -40,6 +40,8 @@ type ConfigMapRepository interface {
 	GetByAppIdAndEnvIdEnvLevel(appId int, envId int) (*ConfigMapEnvModel, error)
 	GetEnvLevelByAppId(appId int) ([]*ConfigMapEnvModel, error)
 	GetConfigNamesForAppAndEnvLevel(appId int, envId int) ([]bean.ConfigNameAndType, error)
+	GetAllAppLevelWithRotationPolicy() ([]*ConfigMapAppModel, error)
+	GetAllEnvLevelWithRotationPolicy() ([]*ConfigMapEnvModel, error)
 }
 
 type ConfigModel interface {
@@ -158,6 +160,18 @@ func (impl ConfigMapRepositoryImpl) GetAllAppLevel() ([]ConfigMapAppModel, error
 	return models, err
 }
 
+func (impl ConfigMapRepositoryImpl) GetAllAppLevelWithRotationPolicy() ([]*ConfigMapAppModel, error) {
+	var models []*ConfigMapAppModel
+	err := impl.dbConnection.Model(&models).
+		WhereGroup(func(query *orm.Query) (*orm.Query, error) {
+			query = query.WhereOr("config_map_data LIKE ?", bean.RotationPolicyEnabledPattern).
+				WhereOr("secret_data LIKE ?", bean.RotationPolicyEnabledPattern)
+			return query, nil
+		}).
+		Select()
+	return models, err
+}
+
 func (impl ConfigMapRepositoryImpl) UpdateAppLevel(model *ConfigMapAppModel) (*ConfigMapAppModel, error) {
 	err := impl.dbConnection.Update(model)
 	if err != nil {
@@ -225,6 +239,22 @@ func (impl ConfigMapRepositoryImpl) GetAllEnvLevel() ([]ConfigMapEnvModel, error
 	return models, err
 }
 
+func (impl ConfigMapRepositoryImpl) GetAllEnvLevelWithRotationPolicy() ([]*ConfigMapEnvModel, error) {
+	var models []*ConfigMapEnvModel
+	err := impl.dbConnection.Model(&models).
+		WhereGroup(func(query *orm.Query) (*orm.Query, error) {
+			query = query.WhereOr("config_map_data LIKE ?", bean.RotationPolicyEnabledPattern).
+				WhereOr("secret_data LIKE ?", bean.RotationPolicyEnabledPattern)
+			return query, nil
+		}).
+		WhereGroup(func(query *orm.Query) (*orm.Query, error) {
+			query = query.WhereOr("deleted = ? ", false).WhereOr("deleted IS NULL")
+			return query, nil
+		}).
+		Select()
+	return models, err
+}
+
 func (impl ConfigMapRepositoryImpl) UpdateEnvLevel(model *ConfigMapEnvModel) (*ConfigMapEnvModel, error) {
 	model.UpdatedOn = time.Now()
 	err := impl.dbConnection.Update(model)
@@ -1,9 +1,10 @@
-// Code generated by mockery v2.20.0. DO NOT EDIT.
+// Code generated by mockery v2.53.5. DO NOT EDIT.
 
 package mocks
 
 import (
 	chartConfig "github.com/devtron-labs/devtron/internal/sql/repository/chartConfig"
+	bean "github.com/devtron-labs/devtron/pkg/pipeline/bean"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -16,6 +17,10 @@ type ConfigMapRepository struct {
 func (_m *ConfigMapRepository) CreateAppLevel(model *chartConfig.ConfigMapAppModel) (*chartConfig.ConfigMapAppModel, error) {
 	ret := _m.Called(model)
 
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAppLevel")
+	}
+
 	var r0 *chartConfig.ConfigMapAppModel
 	var r1 error
 	if rf, ok := ret.Get(0).(func(*chartConfig.ConfigMapAppModel) (*chartConfig.ConfigMapAppModel, error)); ok {
@@ -42,6 +47,10 @@ func (_m *ConfigMapRepository) CreateAppLevel(model *chartConfig.ConfigMapAppMod
 func (_m *ConfigMapRepository) CreateEnvLevel(model *chartConfig.ConfigMapEnvModel) (*chartConfig.ConfigMapEnvModel, error) {
 	ret := _m.Called(model)
 
+	if len(ret) == 0 {
+		panic("no return value specified for CreateEnvLevel")
+	}
+
 	var r0 *chartConfig.ConfigMapEnvModel
 	var r1 error
 	if rf, ok := ret.Get(0).(func(*chartConfig.ConfigMapEnvModel) (*chartConfig.ConfigMapEnvModel, error)); ok {
@@ -64,10 +73,14 @@ func (_m *ConfigMapRepository) CreateEnvLevel(model *chartConfig.ConfigMapEnvMod
 	return r0, r1
 }
 
-// GetAllAppLevel provides a mock function with given fields:
+// GetAllAppLevel provides a mock function with no fields
 func (_m *ConfigMapRepository) GetAllAppLevel() ([]chartConfig.ConfigMapAppModel, error) {
 	ret := _m.Called()
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllAppLevel")
+	}
+
 	var r0 []chartConfig.ConfigMapAppModel
 	var r1 error
 	if rf, ok := ret.Get(0).(func() ([]chartConfig.ConfigMapAppModel, error)); ok {
@@ -90,10 +103,44 @@ func (_m *ConfigMapRepository) GetAllAppLevel() ([]chartConfig.ConfigMapAppModel
 	return r0, r1
 }
 
-// GetAllEnvLevel provides a mock function with given fields:
+// GetAllAppLevelWithRotationPolicy provides a mock function with no fields
+func (_m *ConfigMapRepository) GetAllAppLevelWithRotationPolicy() ([]*chartConfig.ConfigMapAppModel, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllAppLevelWithRotationPolicy")
+	}
+
+	var r0 []*chartConfig.ConfigMapAppModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]*chartConfig.ConfigMapAppModel, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []*chartConfig.ConfigMapAppModel); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*chartConfig.ConfigMapAppModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAllEnvLevel provides a mock function with no fields
 func (_m *ConfigMapRepository) GetAllEnvLevel() ([]chartConfig.ConfigMapEnvModel, error) {
 	ret := _m.Called()
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllEnvLevel")
+	}
+
 	var r0 []chartConfig.ConfigMapEnvModel
 	var r1 error
 	if rf, ok := ret.Get(0).(func() ([]chartConfig.ConfigMapEnvModel, error)); ok {
@@ -116,10 +163,44 @@ func (_m *ConfigMapRepository) GetAllEnvLevel() ([]chartConfig.ConfigMapEnvModel
 	return r0, r1
 }
 
+// GetAllEnvLevelWithRotationPolicy provides a mock function with no fields
+func (_m *ConfigMapRepository) GetAllEnvLevelWithRotationPolicy() ([]*chartConfig.ConfigMapEnvModel, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllEnvLevelWithRotationPolicy")
+	}
+
+	var r0 []*chartConfig.ConfigMapEnvModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]*chartConfig.ConfigMapEnvModel, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []*chartConfig.ConfigMapEnvModel); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*chartConfig.ConfigMapEnvModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetByAppIdAndEnvIdEnvLevel provides a mock function with given fields: appId, envId
 func (_m *ConfigMapRepository) GetByAppIdAndEnvIdEnvLevel(appId int, envId int) (*chartConfig.ConfigMapEnvModel, error) {
 	ret := _m.Called(appId, envId)
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetByAppIdAndEnvIdEnvLevel")
+	}
+
 	var r0 *chartConfig.ConfigMapEnvModel
 	var r1 error
 	if rf, ok := ret.Get(0).(func(int, int) (*chartConfig.ConfigMapEnvModel, error)); ok {
@@ -146,6 +227,10 @@ func (_m *ConfigMapRepository) GetByAppIdAndEnvIdEnvLevel(appId int, envId int)
 func (_m *ConfigMapRepository) GetByAppIdAppLevel(appId int) (*chartConfig.ConfigMapAppModel, error) {
 	ret := _m.Called(appId)
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetByAppIdAppLevel")
+	}
+
 	var r0 *chartConfig.ConfigMapAppModel
 	var r1 error
 	if rf, ok := ret.Get(0).(func(int) (*chartConfig.ConfigMapAppModel, error)); ok {
@@ -172,6 +257,10 @@ func (_m *ConfigMapRepository) GetByAppIdAppLevel(appId int) (*chartConfig.Confi
 func (_m *ConfigMapRepository) GetByIdAppLevel(id int) (*chartConfig.ConfigMapAppModel, error) {
 	ret := _m.Called(id)
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetByIdAppLevel")
+	}
+
 	var r0 *chartConfig.ConfigMapAppModel
 	var r1 error
 	if rf, ok := ret.Get(0).(func(int) (*chartConfig.ConfigMapAppModel, error)); ok {
@@ -198,6 +287,10 @@ func (_m *ConfigMapRepository) GetByIdAppLevel(id int) (*chartConfig.ConfigMapAp
 func (_m *ConfigMapRepository) GetByIdEnvLevel(id int) (*chartConfig.ConfigMapEnvModel, error) {
 	ret := _m.Called(id)
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetByIdEnvLevel")
+	}
+
 	var r0 *chartConfig.ConfigMapEnvModel
 	var r1 error
 	if rf, ok := ret.Get(0).(func(int) (*chartConfig.ConfigMapEnvModel, error)); ok {
@@ -220,10 +313,44 @@ func (_m *ConfigMapRepository) GetByIdEnvLevel(id int) (*chartConfig.ConfigMapEn
 	return r0, r1
 }
 
+// GetConfigNamesForAppAndEnvLevel provides a mock function with given fields: appId, envId
+func (_m *ConfigMapRepository) GetConfigNamesForAppAndEnvLevel(appId int, envId int) ([]bean.ConfigNameAndType, error) {
+	ret := _m.Called(appId, envId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetConfigNamesForAppAndEnvLevel")
+	}
+
+	var r0 []bean.ConfigNameAndType
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, int) ([]bean.ConfigNameAndType, error)); ok {
+		return rf(appId, envId)
+	}
+	if rf, ok := ret.Get(0).(func(int, int) []bean.ConfigNameAndType); ok {
+		r0 = rf(appId, envId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]bean.ConfigNameAndType)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int) error); ok {
+		r1 = rf(appId, envId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetEnvLevelByAppId provides a mock function with given fields: appId
 func (_m *ConfigMapRepository) GetEnvLevelByAppId(appId int) ([]*chartConfig.ConfigMapEnvModel, error) {
 	ret := _m.Called(appId)
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetEnvLevelByAppId")
+	}
+
 	var r0 []*chartConfig.ConfigMapEnvModel
 	var r1 error
 	if rf, ok := ret.Get(0).(func(int) ([]*chartConfig.ConfigMapEnvModel, error)); ok {
@@ -250,6 +377,10 @@ func (_m *ConfigMapRepository) GetEnvLevelByAppId(appId int) ([]*chartConfig.Con
 func (_m *ConfigMapRepository) UpdateAppLevel(model *chartConfig.ConfigMapAppModel) (*chartConfig.ConfigMapAppModel, error) {
 	ret := _m.Called(model)
 
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAppLevel")
+	}
+
 	var r0 *chartConfig.ConfigMapAppModel
 	var r1 error
 	if rf, ok := ret.Get(0).(func(*chartConfig.ConfigMapAppModel) (*chartConfig.ConfigMapAppModel, error)); ok {
@@ -276,6 +407,10 @@ func (_m *ConfigMapRepository) UpdateAppLevel(model *chartConfig.ConfigMapAppMod
 func (_m *ConfigMapRepository) UpdateEnvLevel(model *chartConfig.ConfigMapEnvModel) (*chartConfig.ConfigMapEnvModel, error) {
 	ret := _m.Called(model)
 
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateEnvLevel")
+	}
+
 	var r0 *chartConfig.ConfigMapEnvModel
 	var r1 error
 	if rf, ok := ret.Get(0).(func(*chartConfig.ConfigMapEnvModel) (*chartConfig.ConfigMapEnvModel, error)); ok {
@@ -298,13 +433,12 @@ func (_m *ConfigMapRepository) UpdateEnvLevel(model *chartConfig.ConfigMapEnvMod
 	return r0, r1
 }
 
-type mockConstructorTestingTNewConfigMapRepository interface {
+// NewConfigMapRepository creates a new instance of ConfigMapRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewConfigMapRepository(t interface {
 	mock.TestingT
 	Cleanup(func())
-}
-
-// NewConfigMapRepository creates a new instance of ConfigMapRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-func NewConfigMapRepository(t mockConstructorTestingTNewConfigMapRepository) *ConfigMapRepository {
+}) *ConfigMapRepository {
 	mock := &ConfigMapRepository{}
 	mock.Mock.Test(t)
 
@@ -34,6 +34,9 @@ type CiWorkflowRepository interface {
 	UpdateWorkFlowWithTx(wf *CiWorkflow, tx *pg.Tx) error
 	UpdateArtifactUploaded(id int, isUploaded workflow.ArtifactUploadedType) error
 	FindByStatusesIn(activeStatuses []string) ([]*CiWorkflow, error)
+	FindByPipelineIdAndStatusesIn(pipelineId int, statuses []string) ([]*CiWorkflow, error)
+	UpdateMessage(id int, message string) error
+	UpdateBuildCacheMetrics(id int, backend string, cachedSteps int, totalSteps int) error
 	FindByPipelineId(pipelineId int, offset int, size int) ([]WorkflowWithArtifact, error)
 	FindById(id int) (*CiWorkflow, error)
 	FindRetriedWorkflowCountByReferenceId(id int) (int, error)
@@ -130,6 +133,9 @@ type CiWorkflow struct {
 	ExecutorType            cdWorkflow.WorkflowExecutorType `sql:"executor_type"` //awf, system
 	ImagePathReservationId  int                             `sql:"image_path_reservation_id"`
 	ImagePathReservationIds []int                           `sql:"image_path_reservation_ids" pg:",array"`
+	BuildCacheBackend       string                          `sql:"build_cache_backend"`
+	BuildCacheCachedSteps   int                             `sql:"build_cache_cached_steps"`
+	BuildCacheTotalSteps    int                             `sql:"build_cache_total_steps"`
 	CiPipeline              *CiPipeline
 }
 
@@ -232,6 +238,16 @@ func (impl *CiWorkflowRepositoryImpl) FindByStatusesIn(activeStatuses []string)
 	return ciWorkFlows, err
 }
 
+func (impl *CiWorkflowRepositoryImpl) FindByPipelineIdAndStatusesIn(pipelineId int, statuses []string) ([]*CiWorkflow, error) {
+	var ciWorkFlows []*CiWorkflow
+	err := impl.dbConnection.Model(&ciWorkFlows).
+		Column("ci_workflow.*").
+		Where("ci_workflow.ci_pipeline_id = ?", pipelineId).
+		Where("ci_workflow.status in (?)", pg.In(statuses)).
+		Select()
+	return ciWorkFlows, err
+}
+
 // FindByPipelineId gets only those workflowWithArtifact whose parent_ci_workflow_id is null, this is done to accommodate multiple ci_artifacts through a single workflow(parent), making child workflows for other ci_artifacts (this has been done due to design understanding and db constraint) single workflow single ci-artifact
 func (impl *CiWorkflowRepositoryImpl) FindByPipelineId(pipelineId int, offset int, limit int) ([]WorkflowWithArtifact, error) {
 	var wfs []WorkflowWithArtifact
@@ -329,6 +345,24 @@ func (impl *CiWorkflowRepositoryImpl) UpdateArtifactUploaded(id int, isUploaded
 	return err
 }
 
+func (impl *CiWorkflowRepositoryImpl) UpdateMessage(id int, message string) error {
+	_, err := impl.dbConnection.Model(&CiWorkflow{}).
+		Set("message = ?", message).
+		Where("id = ?", id).
+		Update()
+	return err
+}
+
+func (impl *CiWorkflowRepositoryImpl) UpdateBuildCacheMetrics(id int, backend string, cachedSteps int, totalSteps int) error {
+	_, err := impl.dbConnection.Model(&CiWorkflow{}).
+		Set("build_cache_backend = ?", backend).
+		Set("build_cache_cached_steps = ?", cachedSteps).
+		Set("build_cache_total_steps = ?", totalSteps).
+		Where("id = ?", id).
+		Update()
+	return err
+}
+
 func (impl *CiWorkflowRepositoryImpl) FindLastTriggeredWorkflowByCiIds(pipelineId []int) (ciWorkflow []*CiWorkflow, err error) {
 	err = impl.dbConnection.Model(&ciWorkflow).
 		Column("ci_workflow.*", "CiPipeline").
@@ -42,6 +42,8 @@ const (
 	// TIMELINE_STATUS_DEPLOYMENT_TRIGGERED - is not a terminal status.
 	// It indicates that the deployment request has been served to Kubernetes CD agents (helm/ ArgoCD).
 	TIMELINE_STATUS_DEPLOYMENT_TRIGGERED TimelineStatus = "DEPLOYMENT_TRIGGERED"
+	// TIMELINE_STATUS_MANIFEST_POLICY_VIOLATED - is not a terminal status, enforced violations are followed by TIMELINE_STATUS_DEPLOYMENT_FAILED.
+	TIMELINE_STATUS_MANIFEST_POLICY_VIOLATED TimelineStatus = "MANIFEST_POLICY_VIOLATED"
 
 	TIMELINE_STATUS_KUBECTL_APPLY_STARTED  TimelineStatus = "KUBECTL_APPLY_STARTED"
 	TIMELINE_STATUS_KUBECTL_APPLY_SYNCED   TimelineStatus = "KUBECTL_APPLY_SYNCED"
@@ -56,8 +58,11 @@ const (
 const (
 	TIMELINE_DESCRIPTION_DEPLOYMENT_INITIATED         string = "Deployment initiated successfully."
 	TIMELINE_DESCRIPTION_VULNERABLE_IMAGE             string = "Deployment failed: Vulnerability policy violated."
+	TIMELINE_DESCRIPTION_MANIFEST_POLICY_AUDIT        string = "Manifest policy violations found, deployment continued in audit mode:"
+	TIMELINE_DESCRIPTION_MANIFEST_POLICY_ENFORCED     string = "Manifest policy violations found, deployment blocked:"
 	TIMELINE_DESCRIPTION_DEPLOYMENT_REQUEST_VALIDATED string = "Deployment trigger request has been validated successfully."
 	TIMELINE_DESCRIPTION_ARGOCD_GIT_COMMIT            string = "Git commit done successfully."
+	TIMELINE_DESCRIPTION_OCI_CHART_PUSH               string = "Helm chart pushed to OCI registry successfully."
 	TIMELINE_DESCRIPTION_ARGOCD_SYNC_INITIATED        string = "ArgoCD sync initiated."
 	TIMELINE_DESCRIPTION_ARGOCD_SYNC_COMPLETED        string = "ArgoCD sync completed."
 	TIMELINE_DESCRIPTION_DEPLOYMENT_COMPLETED         string = "Deployment has been performed successfully. Waiting for application to be healthy..."
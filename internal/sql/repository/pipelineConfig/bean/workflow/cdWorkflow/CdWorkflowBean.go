@@ -46,6 +46,7 @@ var ErrorDeploymentSuperseded = errors.New(NEW_DEPLOYMENT_INITIATED)
 const (
 	WORKFLOW_EXECUTOR_TYPE_AWF    = "AWF"
 	WORKFLOW_EXECUTOR_TYPE_SYSTEM = "SYSTEM"
+	WORKFLOW_EXECUTOR_TYPE_TEKTON = "TEKTON"
 	NEW_DEPLOYMENT_INITIATED      = "A new deployment was initiated before this deployment completed!"
 	PIPELINE_DELETED              = "The pipeline has been deleted!"
 	FOUND_VULNERABILITY           = "Found vulnerability on image"
@@ -54,6 +55,12 @@ const (
 
 type WorkflowExecutorType string
 
+// IsArgoWorkflowLess returns true for executors which run stages without the argo workflow controller,
+// such executors neither archive logs nor mark the workflow on termination
+func (executorType WorkflowExecutorType) IsArgoWorkflowLess() bool {
+	return executorType == WORKFLOW_EXECUTOR_TYPE_SYSTEM || executorType == WORKFLOW_EXECUTOR_TYPE_TEKTON
+}
+
 type CdWorkflowRunnerArtifactMetadata struct {
 	AppId            int  `pg:"app_id"`
 	EnvId            int  `pg:"env_id"`
@@ -16,11 +16,17 @@
 
 package bean
 
-import "time"
+import (
+	"fmt"
+	"github.com/devtron-labs/devtron/pkg/bean"
+	"time"
+)
 
 const WORKFLOW_EXIST_ERROR = "workflow with this name already exist in this app"
 const Workflows = "workflows"
 
+const DefaultOCIChartBaseVersion = "1.0.0"
+
 type ManifestPushTemplate struct {
 	WorkflowRunnerId       int
 	AppId                  int
@@ -44,6 +50,14 @@ type ManifestPushTemplate struct {
 	BuiltChartBytes        *[]byte
 	MergedValues           string
 	ArgoSyncNeeded         bool
+	StorageType            string
+	HelmRepositoryConfig   *HelmRepositoryConfig
+}
+
+// IsOCIStorage returns true when the chart is pushed as an OCI artifact to a container registry,
+// in that case RepoUrl holds the registry host and ChartLocation the full repository path of the chart.
+func (m *ManifestPushTemplate) IsOCIStorage() bool {
+	return bean.IsOCIStorage(m.StorageType)
 }
 
 type ManifestPushResponse struct {
@@ -57,9 +71,18 @@ func (m ManifestPushResponse) IsNewGitRepoConfigured() bool {
 	return len(m.NewGitRepoUrl) != 0
 }
 
+// HelmRepositoryConfig is stored as credentials_config in manifest_push_config for ManifestStorageOCI
 type HelmRepositoryConfig struct {
-	repositoryName        string
-	containerRegistryName string
+	RepositoryName        string `json:"repositoryName"`
+	ContainerRegistryName string `json:"containerRegistryName"`
+}
+
+// GetOCIChartVersion returns a unique chart version for each release pushed to the OCI registry
+func GetOCIChartVersion(chartBaseVersion string, pipelineOverrideId int) string {
+	if len(chartBaseVersion) == 0 {
+		chartBaseVersion = DefaultOCIChartBaseVersion
+	}
+	return fmt.Sprintf("%s-%d", chartBaseVersion, pipelineOverrideId)
 }
 
 type GitRepositoryConfig struct {
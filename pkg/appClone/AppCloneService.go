@@ -574,6 +574,7 @@ func (impl *AppCloneServiceImpl) configDataClone(cfData []*bean3.ConfigData) []*
 			ExternalSecret:        refdata.ExternalSecret,
 			DefaultExternalSecret: refdata.DefaultExternalSecret,
 			RoleARN:               refdata.RoleARN,
+			RotationPolicy:        refdata.RotationPolicy,
 		}
 		copiedData = append(copiedData, data)
 	}
@@ -912,6 +913,7 @@ func (impl *AppCloneServiceImpl) CreateCiPipeline(req *cloneCiPipelineRequest) (
 			AfterDockerBuildScripts:  afterDockerBuildScripts,
 			ParentCiPipeline:         refCiPipeline.ParentCiPipeline,
 			IsDockerConfigOverridden: refCiPipeline.IsDockerConfigOverridden,
+			AutoCancelSuperseded:     refCiPipeline.AutoCancelSuperseded,
 			PreBuildStage:            preStageDetail,
 			PostBuildStage:           postStageDetail,
 			EnvironmentId:            refCiPipeline.EnvironmentId,
@@ -148,9 +148,9 @@ func GenerateInstallAppVersionDTO(installedApp *repository.InstalledApps, instal
 	if chartVersionApp.AppStore.ChartRepoId != 0 {
 		chartRepo := chartVersionApp.AppStore.ChartRepo
 		chartRepoName = chartRepo.Name
-		chartRepoUrl = chartRepo.Url
-		Username = chartRepo.UserName
-		Password = chartRepo.Password
+		chartRepoUrl = chartRepo.GetChartPullUrl()
+		Username = chartRepo.GetChartPullUserName()
+		Password = chartRepo.GetChartPullPassword()
 	} else {
 		chartRepo := chartVersionApp.AppStore.DockerArtifactStore
 		chartRepoName = chartRepo.Id
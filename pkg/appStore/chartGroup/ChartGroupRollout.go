@@ -0,0 +1,491 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package chartGroup
+
+import (
+	"context"
+	"crypto/sha1"
+	"fmt"
+	"github.com/argoproj/gitops-engine/pkg/health"
+	"github.com/devtron-labs/devtron/internal/sql/repository/pipelineConfig/bean/workflow/cdWorkflow"
+	"github.com/devtron-labs/devtron/internal/util"
+	appStoreBean "github.com/devtron-labs/devtron/pkg/appStore/bean"
+	repository2 "github.com/devtron-labs/devtron/pkg/appStore/chartGroup/repository"
+	userBean "github.com/devtron-labs/devtron/pkg/auth/user/bean"
+	"github.com/devtron-labs/devtron/pkg/sql"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// isOrderedRollout defaults the install order of charts to the install order of their chart group entries
+// and tells if the request needs an ordered rollout instead of a bulk install
+func (impl *ChartGroupServiceImpl) isOrderedRollout(request *ChartGroupInstallRequest) (bool, error) {
+	if request.ChartGroupId > 0 {
+		group, err := impl.chartGroupRepository.FindByIdWithEntries(request.ChartGroupId)
+		if err != nil && !util.IsErrNoRows(err) {
+			return false, err
+		}
+		entryInstallOrder := make(map[int]int)
+		if group != nil {
+			for _, entry := range group.ChartGroupEntries {
+				entryInstallOrder[entry.Id] = entry.InstallOrder
+			}
+		}
+		for _, chart := range request.ChartGroupInstallChartRequest {
+			if chart.InstallOrder == 0 && chart.ChartGroupEntryId > 0 {
+				chart.InstallOrder = entryInstallOrder[chart.ChartGroupEntryId]
+			}
+		}
+	}
+	if request.WaitForHealthy || request.RollbackOnFailure {
+		return true, nil
+	}
+	installOrders := make(map[int]bool)
+	for _, chart := range request.ChartGroupInstallChartRequest {
+		installOrders[chart.InstallOrder] = true
+	}
+	return len(installOrders) > 1, nil
+}
+
+// deployInOrder saves the rollout and installs the charts in the background, installAppVersions are index aligned with the charts of the request
+func (impl *ChartGroupServiceImpl) deployInOrder(request *ChartGroupInstallRequest, installAppVersions []*appStoreBean.InstallAppVersionDTO) (*ChartGroupInstallAppRes, error) {
+	healthTimeoutSeconds := request.HealthTimeoutSeconds
+	if healthTimeoutSeconds <= 0 {
+		healthTimeoutSeconds = impl.rolloutConfig.HealthTimeoutSeconds
+	}
+	rollout := &repository2.ChartGroupRollout{
+		ChartGroupId:         request.ChartGroupId,
+		ProjectId:            request.ProjectId,
+		Status:               string(RolloutStatusRunning),
+		WaitForHealthy:       request.WaitForHealthy,
+		HealthTimeoutSeconds: healthTimeoutSeconds,
+		RollbackOnFailure:    request.RollbackOnFailure,
+		AuditLog:             sql.NewDefaultAuditLog(request.UserId),
+	}
+	members := make([]*repository2.ChartGroupRolloutMember, 0, len(request.ChartGroupInstallChartRequest))
+	installAppVersionByMember := make(map[*repository2.ChartGroupRolloutMember]*appStoreBean.InstallAppVersionDTO)
+	for i, chart := range request.ChartGroupInstallChartRequest {
+		member := &repository2.ChartGroupRolloutMember{
+			AppName:           chart.AppName,
+			EnvironmentId:     chart.EnvironmentId,
+			ChartGroupEntryId: chart.ChartGroupEntryId,
+			InstallOrder:      chart.InstallOrder,
+			Status:            string(RolloutMemberStatusPending),
+			AuditLog:          sql.NewDefaultAuditLog(request.UserId),
+		}
+		members = append(members, member)
+		installAppVersionByMember[member] = installAppVersions[i]
+	}
+	dbConnection := impl.installedAppRepository.GetConnection()
+	tx, err := dbConnection.Begin()
+	if err != nil {
+		return nil, err
+	}
+	// Rollback tx on error.
+	defer tx.Rollback()
+	err = impl.chartGroupRolloutRepository.Save(rollout, members, tx)
+	if err != nil {
+		impl.logger.Errorw("error in saving chart group rollout", "chartGroupId", request.ChartGroupId, "err", err)
+		return nil, err
+	}
+	err = tx.Commit()
+	if err != nil {
+		impl.logger.Errorw("error in committing chart group rollout", "chartGroupId", request.ChartGroupId, "err", err)
+		return nil, err
+	}
+	impl.asyncRunnable.Execute(func() {
+		impl.executeRollout(rollout, members, installAppVersionByMember, request.UserId)
+	})
+	return &ChartGroupInstallAppRes{RolloutId: rollout.Id}, nil
+}
+
+func (impl *ChartGroupServiceImpl) executeRollout(rollout *repository2.ChartGroupRollout, members []*repository2.ChartGroupRolloutMember,
+	installAppVersionByMember map[*repository2.ChartGroupRolloutMember]*appStoreBean.InstallAppVersionDTO, userId int32) {
+	stopHeartbeat := impl.startRolloutHeartbeat(rollout.Id)
+	defer stopHeartbeat()
+	stages := getRolloutStages(members)
+	groupInstallationId := getRolloutInstallationId(rollout.Id)
+	var rolloutErr error
+	for _, stage := range stages {
+		rolloutErr = impl.executeRolloutStage(rollout, stage, installAppVersionByMember, groupInstallationId, userId)
+		if rolloutErr != nil {
+			break
+		}
+	}
+	if rolloutErr == nil {
+		stopHeartbeat()
+		impl.updateRolloutStatus(rollout, RolloutStatusSucceeded, "", userId)
+		return
+	}
+	impl.logger.Errorw("chart group rollout failed", "rolloutId", rollout.Id, "err", rolloutErr)
+	for _, member := range members {
+		if member.Status == string(RolloutMemberStatusPending) {
+			impl.updateRolloutMemberStatus(member, RolloutMemberStatusSkipped, RolloutMemberSkippedMessage, userId)
+		}
+	}
+	if !rollout.RollbackOnFailure {
+		stopHeartbeat()
+		impl.updateRolloutStatus(rollout, RolloutStatusFailed, rolloutErr.Error(), userId)
+		return
+	}
+	rollbackErr := impl.rollbackRollout(members, userId)
+	stopHeartbeat()
+	if rollbackErr != nil {
+		impl.updateRolloutStatus(rollout, RolloutStatusRollbackFailed, fmt.Sprintf("%s, rollback failed: %s", rolloutErr.Error(), rollbackErr.Error()), userId)
+		return
+	}
+	impl.updateRolloutStatus(rollout, RolloutStatusRolledBack, rolloutErr.Error(), userId)
+}
+
+// executeRolloutStage installs all charts of an install order and waits for them to become healthy if requested
+func (impl *ChartGroupServiceImpl) executeRolloutStage(rollout *repository2.ChartGroupRollout, stage []*repository2.ChartGroupRolloutMember,
+	installAppVersionByMember map[*repository2.ChartGroupRolloutMember]*appStoreBean.InstallAppVersionDTO, groupInstallationId string, userId int32) error {
+	dbConnection := impl.installedAppRepository.GetConnection()
+	tx, err := dbConnection.Begin()
+	if err != nil {
+		return err
+	}
+	// Rollback tx on error.
+	defer tx.Rollback()
+	for _, member := range stage {
+		installAppVersion, err := impl.appStoreDeploymentDBService.AppStoreDeployOperationDB(installAppVersionByMember[member], tx, appStoreBean.BULK_DEPLOY_REQUEST)
+		if err != nil {
+			impl.logger.Errorw("error in app store deploy db operation for chart group rollout", "rolloutId", rollout.Id, "appName", member.AppName, "err", err)
+			impl.updateRolloutMemberStatus(member, RolloutMemberStatusFailed, err.Error(), userId)
+			return fmt.Errorf("error in installing %s: %s", member.AppName, err.Error())
+		}
+		installAppVersionByMember[member] = installAppVersion
+		if rollout.ChartGroupId > 0 {
+			err = impl.chartGroupDeploymentRepository.Save(tx, createChartGroupEntryObject(installAppVersion, rollout.ChartGroupId, groupInstallationId))
+			if err != nil {
+				impl.logger.Errorw("error in saving chart group deployment for chart group rollout", "rolloutId", rollout.Id, "appName", member.AppName, "err", err)
+				impl.updateRolloutMemberStatus(member, RolloutMemberStatusFailed, err.Error(), userId)
+				return fmt.Errorf("error in installing %s: %s", member.AppName, err.Error())
+			}
+		}
+	}
+	err = tx.Commit()
+	if err != nil {
+		impl.logger.Errorw("error in committing chart group rollout stage", "rolloutId", rollout.Id, "err", err)
+		return err
+	}
+
+	for _, member := range stage {
+		installAppVersion := installAppVersionByMember[member]
+		member.InstalledAppId = installAppVersion.InstalledAppId
+		member.InstalledAppVersionId = installAppVersion.InstalledAppVersionId
+		member.InstalledAppVersionHistoryId = installAppVersion.InstalledAppVersionHistoryId
+		impl.updateRolloutMemberStatus(member, RolloutMemberStatusDeploying, "", userId)
+	}
+	var stageErr error
+	for _, member := range stage {
+		err = impl.deployRolloutMember(member, userId)
+		if err != nil {
+			impl.logger.Errorw("error in performing deploy stage for chart group rollout", "rolloutId", rollout.Id, "appName", member.AppName, "err", err)
+			impl.updateRolloutMemberStatus(member, RolloutMemberStatusFailed, err.Error(), userId)
+			if stageErr == nil {
+				stageErr = fmt.Errorf("error in deploying %s: %s", member.AppName, err.Error())
+			}
+			continue
+		}
+		if !rollout.WaitForHealthy {
+			impl.updateRolloutMemberStatus(member, RolloutMemberStatusDeployed, "", userId)
+		}
+	}
+	if stageErr == nil && rollout.WaitForHealthy {
+		stageErr = impl.waitForHealthyStage(rollout, stage, userId)
+	}
+	if stageErr != nil {
+		// charts of the failed install order which were installed are not health checked any further
+		for _, member := range stage {
+			if member.Status == string(RolloutMemberStatusDeploying) {
+				impl.updateRolloutMemberStatus(member, RolloutMemberStatusDeployed, RolloutStageFailedMessage, userId)
+			}
+		}
+	}
+	return stageErr
+}
+
+// deployRolloutMember installs the chart and checks the install status recorded on the installed app,
+// as PerformDeployStage does not return every failed install as an error
+func (impl *ChartGroupServiceImpl) deployRolloutMember(member *repository2.ChartGroupRolloutMember, userId int32) error {
+	installedAppVersion, err := impl.PerformDeployStage(member.InstalledAppVersionId, member.InstalledAppVersionHistoryId, userId)
+	if err != nil {
+		return err
+	}
+	installedApp, err := impl.installedAppRepository.GetInstalledApp(member.InstalledAppId)
+	if err != nil {
+		impl.logger.Errorw("error in fetching installed app for chart group rollout", "installedAppId", member.InstalledAppId, "err", err)
+		return err
+	}
+	if isFailedInstallStatus(installedApp.Status) {
+		return fmt.Errorf("installation failed with status %s", installedApp.Status.String())
+	}
+	if installedAppVersion == nil {
+		return fmt.Errorf("installation of %s did not complete", member.AppName)
+	}
+	return nil
+}
+
+// startRolloutHeartbeat keeps the rollout fresh while this process runs it, the returned func stops it and is safe to call more than once
+func (impl *ChartGroupServiceImpl) startRolloutHeartbeat(rolloutId int) func() {
+	done := make(chan struct{})
+	ticker := time.NewTicker(impl.rolloutConfig.GetHeartbeatInterval())
+	go func() {
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				err := impl.chartGroupRolloutRepository.UpdateHeartbeat(rolloutId, string(RolloutStatusRunning))
+				if err != nil {
+					impl.logger.Errorw("error in updating chart group rollout heartbeat", "rolloutId", rolloutId, "err", err)
+				}
+			}
+		}
+	}()
+	var once sync.Once
+	return func() {
+		once.Do(func() { close(done) })
+	}
+}
+
+// markInterruptedRolloutsFailed fails running rollouts without heartbeat, rollouts are not resumed as they only run in the process which started them
+func (impl *ChartGroupServiceImpl) markInterruptedRolloutsFailed() {
+	staleBefore := time.Now().Add(-time.Duration(impl.rolloutConfig.StaleTimeoutSeconds) * time.Second)
+	rollouts, err := impl.chartGroupRolloutRepository.FindByStatusUpdatedBefore(string(RolloutStatusRunning), staleBefore)
+	if err != nil {
+		impl.logger.Errorw("error in fetching interrupted chart group rollouts", "err", err)
+		return
+	}
+	for _, rollout := range rollouts {
+		marked, err := impl.chartGroupRolloutRepository.UpdateStatusIfStale(rollout.Id, string(RolloutStatusRunning), string(RolloutStatusFailed),
+			RolloutInterruptedMessage, staleBefore, userBean.SYSTEM_USER_ID)
+		if err != nil {
+			impl.logger.Errorw("error in marking interrupted chart group rollout failed", "rolloutId", rollout.Id, "err", err)
+			continue
+		}
+		if !marked {
+			continue
+		}
+		impl.logger.Infow("marked interrupted chart group rollout failed", "rolloutId", rollout.Id)
+		err = impl.chartGroupRolloutRepository.UpdateMembersStatus(rollout.Id, string(RolloutMemberStatusPending), string(RolloutMemberStatusSkipped),
+			RolloutInterruptedMessage, userBean.SYSTEM_USER_ID)
+		if err != nil {
+			impl.logger.Errorw("error in marking pending members of interrupted chart group rollout skipped", "rolloutId", rollout.Id, "err", err)
+		}
+		err = impl.chartGroupRolloutRepository.UpdateMembersStatus(rollout.Id, string(RolloutMemberStatusDeploying), string(RolloutMemberStatusFailed),
+			RolloutInterruptedMessage, userBean.SYSTEM_USER_ID)
+		if err != nil {
+			impl.logger.Errorw("error in marking deploying members of interrupted chart group rollout failed", "rolloutId", rollout.Id, "err", err)
+		}
+	}
+}
+
+func (impl *ChartGroupServiceImpl) waitForHealthyStage(rollout *repository2.ChartGroupRollout, stage []*repository2.ChartGroupRolloutMember, userId int32) error {
+	pollInterval := time.Duration(impl.rolloutConfig.HealthPollIntervalSeconds) * time.Second
+	deadline := time.Now().Add(time.Duration(rollout.HealthTimeoutSeconds) * time.Second)
+	for {
+		pending := 0
+		for _, member := range stage {
+			if member.Status != string(RolloutMemberStatusDeploying) {
+				continue
+			}
+			history, err := impl.installedAppVersionHistoryRepository.GetInstalledAppVersionHistory(member.InstalledAppVersionHistoryId)
+			if err != nil {
+				impl.logger.Errorw("error in fetching deployment status for chart group rollout", "rolloutId", rollout.Id, "appName", member.AppName, "err", err)
+				pending++
+				continue
+			}
+			switch history.Status {
+			case string(health.HealthStatusHealthy), cdWorkflow.WorkflowSucceeded:
+				impl.updateRolloutMemberStatus(member, RolloutMemberStatusHealthy, "", userId)
+			case cdWorkflow.WorkflowFailed, cdWorkflow.WorkflowAborted:
+				impl.updateRolloutMemberStatus(member, RolloutMemberStatusFailed, fmt.Sprintf("deployment %s", history.Status), userId)
+				return fmt.Errorf("deployment of %s %s", member.AppName, history.Status)
+			default:
+				// degraded is not terminal, the release may still recover before the timeout
+				pending++
+			}
+		}
+		if pending == 0 {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			for _, member := range stage {
+				if member.Status == string(RolloutMemberStatusDeploying) {
+					impl.updateRolloutMemberStatus(member, RolloutMemberStatusFailed, "timed out waiting for healthy status", userId)
+				}
+			}
+			return fmt.Errorf("timed out after %d seconds waiting for charts of install order %d to become healthy", rollout.HealthTimeoutSeconds, stage[0].InstallOrder)
+		}
+		time.Sleep(pollInterval)
+	}
+}
+
+// rollbackRollout uninstalls the charts installed by the rollout in the reverse install order
+func (impl *ChartGroupServiceImpl) rollbackRollout(members []*repository2.ChartGroupRolloutMember, userId int32) error {
+	var rollbackErr error
+	for _, member := range getRollbackOrder(members) {
+		err := impl.uninstallRolloutMember(member, userId)
+		if err != nil {
+			impl.logger.Errorw("error in rolling back chart group rollout member", "appName", member.AppName, "installedAppId", member.InstalledAppId, "err", err)
+			impl.updateRolloutMemberStatus(member, RolloutMemberStatusRollbackFailed, err.Error(), userId)
+			if rollbackErr == nil {
+				rollbackErr = fmt.Errorf("error in uninstalling %s: %s", member.AppName, err.Error())
+			}
+			continue
+		}
+		impl.updateRolloutMemberStatus(member, RolloutMemberStatusRolledBack, member.Message, userId)
+	}
+	return rollbackErr
+}
+
+func (impl *ChartGroupServiceImpl) uninstallRolloutMember(member *repository2.ChartGroupRolloutMember, userId int32) error {
+	installedApp, err := impl.installedAppRepository.GetInstalledApp(member.InstalledAppId)
+	if err != nil {
+		if util.IsErrNoRows(err) {
+			// already uninstalled
+			return nil
+		}
+		return err
+	}
+	deleteRequest := &appStoreBean.InstallAppVersionDTO{}
+	deleteRequest.InstalledAppId = installedApp.Id
+	deleteRequest.AppId = installedApp.AppId
+	deleteRequest.AppName = installedApp.App.AppName
+	deleteRequest.Namespace = installedApp.Environment.Namespace
+	deleteRequest.ClusterId = installedApp.Environment.ClusterId
+	deleteRequest.EnvironmentId = installedApp.EnvironmentId
+	deleteRequest.AppOfferingMode = installedApp.App.AppOfferingMode
+	deleteRequest.UserId = userId
+	_, err = impl.appStoreDeploymentService.DeleteInstalledApp(context.Background(), deleteRequest)
+	return err
+}
+
+func (impl *ChartGroupServiceImpl) updateRolloutStatus(rollout *repository2.ChartGroupRollout, status RolloutStatus, message string, userId int32) {
+	rollout.Status = string(status)
+	rollout.Message = message
+	rollout.UpdateAuditLog(userId)
+	err := impl.chartGroupRolloutRepository.Update(rollout)
+	if err != nil {
+		impl.logger.Errorw("error in updating chart group rollout status", "rolloutId", rollout.Id, "status", status, "err", err)
+	}
+}
+
+func (impl *ChartGroupServiceImpl) updateRolloutMemberStatus(member *repository2.ChartGroupRolloutMember, status RolloutMemberStatus, message string, userId int32) {
+	member.Status = string(status)
+	member.Message = message
+	member.UpdateAuditLog(userId)
+	err := impl.chartGroupRolloutRepository.UpdateMember(member)
+	if err != nil {
+		impl.logger.Errorw("error in updating chart group rollout member status", "rolloutId", member.ChartGroupRolloutId, "appName", member.AppName, "status", status, "err", err)
+	}
+}
+
+func (impl *ChartGroupServiceImpl) GetRollout(rolloutId int) (*ChartGroupRolloutDto, error) {
+	rollout, err := impl.chartGroupRolloutRepository.FindById(rolloutId)
+	if err != nil {
+		impl.logger.Errorw("error in fetching chart group rollout", "rolloutId", rolloutId, "err", err)
+		if util.IsErrNoRows(err) {
+			return nil, util.NewApiError(http.StatusNotFound, "chart group rollout not found", err.Error())
+		}
+		return nil, err
+	}
+	members, err := impl.chartGroupRolloutRepository.FindMembersByRolloutId(rolloutId)
+	if err != nil && !util.IsErrNoRows(err) {
+		impl.logger.Errorw("error in fetching chart group rollout members", "rolloutId", rolloutId, "err", err)
+		return nil, err
+	}
+	rolloutDto := &ChartGroupRolloutDto{
+		Id:                   rollout.Id,
+		ChartGroupId:         rollout.ChartGroupId,
+		ProjectId:            rollout.ProjectId,
+		Status:               RolloutStatus(rollout.Status),
+		Message:              rollout.Message,
+		WaitForHealthy:       rollout.WaitForHealthy,
+		HealthTimeoutSeconds: rollout.HealthTimeoutSeconds,
+		RollbackOnFailure:    rollout.RollbackOnFailure,
+		StartedOn:            rollout.CreatedOn,
+		UpdatedOn:            rollout.UpdatedOn,
+		Members:              make([]*ChartGroupRolloutMemberDto, 0, len(members)),
+	}
+	for _, member := range members {
+		rolloutDto.Members = append(rolloutDto.Members, &ChartGroupRolloutMemberDto{
+			AppName:        member.AppName,
+			EnvironmentId:  member.EnvironmentId,
+			InstallOrder:   member.InstallOrder,
+			InstalledAppId: member.InstalledAppId,
+			Status:         RolloutMemberStatus(member.Status),
+			Message:        member.Message,
+		})
+	}
+	return rolloutDto, nil
+}
+
+// getRolloutStages groups members by install order, stages are sorted by install order
+func getRolloutStages(members []*repository2.ChartGroupRolloutMember) [][]*repository2.ChartGroupRolloutMember {
+	membersByInstallOrder := make(map[int][]*repository2.ChartGroupRolloutMember)
+	installOrders := make([]int, 0)
+	for _, member := range members {
+		if _, ok := membersByInstallOrder[member.InstallOrder]; !ok {
+			installOrders = append(installOrders, member.InstallOrder)
+		}
+		membersByInstallOrder[member.InstallOrder] = append(membersByInstallOrder[member.InstallOrder], member)
+	}
+	sort.Ints(installOrders)
+	stages := make([][]*repository2.ChartGroupRolloutMember, 0, len(installOrders))
+	for _, installOrder := range installOrders {
+		stages = append(stages, membersByInstallOrder[installOrder])
+	}
+	return stages
+}
+
+// getRollbackOrder gives the installed members stage by stage starting from the last install order,
+// so charts are uninstalled before the charts they were installed after
+func getRollbackOrder(members []*repository2.ChartGroupRolloutMember) []*repository2.ChartGroupRolloutMember {
+	stages := getRolloutStages(members)
+	rollbackOrder := make([]*repository2.ChartGroupRolloutMember, 0, len(members))
+	for i := len(stages) - 1; i >= 0; i-- {
+		for _, member := range stages[i] {
+			if member.InstalledAppId == 0 {
+				continue
+			}
+			rollbackOrder = append(rollbackOrder, member)
+		}
+	}
+	return rollbackOrder
+}
+
+func isFailedInstallStatus(status appStoreBean.AppstoreDeploymentStatus) bool {
+	switch status {
+	case appStoreBean.QUE_ERROR, appStoreBean.DEQUE_ERROR, appStoreBean.TRIGGER_ERROR,
+		appStoreBean.GIT_ERROR, appStoreBean.ACD_ERROR, appStoreBean.HELM_ERROR:
+		return true
+	}
+	return false
+}
+
+// getRolloutInstallationId groups the charts of a rollout as one chart group installation, app ids are not known upfront in an ordered rollout
+func getRolloutInstallationId(rolloutId int) string {
+	/* #nosec */
+	return fmt.Sprintf("%x", sha1.Sum([]byte("chart-group-rollout-"+strconv.Itoa(rolloutId))))
+}
@@ -0,0 +1,102 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package chartGroup
+
+import (
+	"testing"
+
+	appStoreBean "github.com/devtron-labs/devtron/pkg/appStore/bean"
+	repository2 "github.com/devtron-labs/devtron/pkg/appStore/chartGroup/repository"
+	"github.com/stretchr/testify/assert"
+)
+
+func rolloutMember(appName string, installOrder int) *repository2.ChartGroupRolloutMember {
+	return &repository2.ChartGroupRolloutMember{AppName: appName, InstallOrder: installOrder}
+}
+
+func stageAppNames(stages [][]*repository2.ChartGroupRolloutMember) [][]string {
+	names := make([][]string, 0, len(stages))
+	for _, stage := range stages {
+		stageNames := make([]string, 0, len(stage))
+		for _, member := range stage {
+			stageNames = append(stageNames, member.AppName)
+		}
+		names = append(names, stageNames)
+	}
+	return names
+}
+
+func TestGetRolloutStages(t *testing.T) {
+	t.Run("no members gives no stages", func(t *testing.T) {
+		assert.Empty(t, getRolloutStages(nil))
+	})
+	t.Run("same install order is a single stage", func(t *testing.T) {
+		stages := getRolloutStages([]*repository2.ChartGroupRolloutMember{rolloutMember("a", 0), rolloutMember("b", 0)})
+		assert.Equal(t, [][]string{{"a", "b"}}, stageAppNames(stages))
+	})
+	t.Run("stages are sorted by install order and keep request order within a stage", func(t *testing.T) {
+		stages := getRolloutStages([]*repository2.ChartGroupRolloutMember{
+			rolloutMember("app", 2), rolloutMember("db", 0), rolloutMember("cache", 1), rolloutMember("worker", 2), rolloutMember("queue", 1),
+		})
+		assert.Equal(t, [][]string{{"db"}, {"cache", "queue"}, {"app", "worker"}}, stageAppNames(stages))
+	})
+	t.Run("negative install orders go first", func(t *testing.T) {
+		stages := getRolloutStages([]*repository2.ChartGroupRolloutMember{rolloutMember("b", 0), rolloutMember("a", -1)})
+		assert.Equal(t, [][]string{{"a"}, {"b"}}, stageAppNames(stages))
+	})
+}
+
+func TestGetRollbackOrder(t *testing.T) {
+	installed := func(appName string, installOrder int) *repository2.ChartGroupRolloutMember {
+		member := rolloutMember(appName, installOrder)
+		member.InstalledAppId = len(appName)
+		return member
+	}
+	appNames := func(members []*repository2.ChartGroupRolloutMember) []string {
+		names := make([]string, 0, len(members))
+		for _, member := range members {
+			names = append(names, member.AppName)
+		}
+		return names
+	}
+	t.Run("uninstalls the last install order first regardless of request order", func(t *testing.T) {
+		members := getRollbackOrder([]*repository2.ChartGroupRolloutMember{
+			installed("db", 0), installed("app", 2), installed("cache", 1), installed("worker", 2), installed("queue", 1),
+		})
+		assert.Equal(t, []string{"app", "worker", "cache", "queue", "db"}, appNames(members))
+	})
+	t.Run("members that were never installed are skipped", func(t *testing.T) {
+		members := getRollbackOrder([]*repository2.ChartGroupRolloutMember{
+			installed("db", 0), rolloutMember("app", 1), installed("cache", 1),
+		})
+		assert.Equal(t, []string{"cache", "db"}, appNames(members))
+	})
+	t.Run("no members gives nothing to uninstall", func(t *testing.T) {
+		assert.Empty(t, getRollbackOrder(nil))
+	})
+}
+
+func TestIsFailedInstallStatus(t *testing.T) {
+	for _, status := range []appStoreBean.AppstoreDeploymentStatus{appStoreBean.QUE_ERROR, appStoreBean.DEQUE_ERROR, appStoreBean.TRIGGER_ERROR,
+		appStoreBean.GIT_ERROR, appStoreBean.ACD_ERROR, appStoreBean.HELM_ERROR} {
+		assert.True(t, isFailedInstallStatus(status), status.String())
+	}
+	for _, status := range []appStoreBean.AppstoreDeploymentStatus{appStoreBean.DEPLOY_SUCCESS, appStoreBean.DEPLOY_INIT, appStoreBean.GIT_SUCCESS,
+		appStoreBean.ACD_SUCCESS, appStoreBean.HELM_SUCCESS} {
+		assert.False(t, isFailedInstallStatus(status), status.String())
+	}
+}
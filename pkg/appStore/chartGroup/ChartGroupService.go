@@ -22,6 +22,8 @@ import (
 	"crypto/sha1"
 	"errors"
 	"fmt"
+	"github.com/caarlos0/env"
+	"github.com/devtron-labs/common-lib/async"
 	"github.com/devtron-labs/devtron/client/argocdServer"
 	"github.com/devtron-labs/devtron/internal/sql/repository/pipelineConfig"
 	"github.com/devtron-labs/devtron/internal/sql/repository/pipelineConfig/bean/timelineStatus"
@@ -41,6 +43,7 @@ import (
 	"github.com/devtron-labs/devtron/pkg/eventProcessor/out"
 	"github.com/devtron-labs/devtron/pkg/team/read"
 	repository3 "github.com/devtron-labs/devtron/pkg/team/repository"
+	cron2 "github.com/devtron-labs/devtron/util/cron"
 	"io/ioutil"
 	"os"
 	"strconv"
@@ -55,6 +58,7 @@ import (
 	"github.com/devtron-labs/devtron/pkg/auth/user/bean"
 	"github.com/devtron-labs/devtron/pkg/sql"
 	"github.com/go-pg/pg"
+	"github.com/robfig/cron/v3"
 	"go.uber.org/zap"
 )
 
@@ -81,6 +85,11 @@ type ChartGroupServiceImpl struct {
 	installAppService                    FullMode.InstalledAppDBExtendedService
 	appStoreAppsEventPublishService      out.AppStoreAppsEventPublishService
 	teamReadService                      read.TeamReadService
+	installedAppVersionHistoryRepository repository.InstalledAppVersionHistoryRepository
+	chartGroupRolloutRepository          repository2.ChartGroupRolloutRepository
+	asyncRunnable                        *async.Runnable
+	rolloutConfig                        *ChartGroupRolloutConfig
+	cron                                 *cron.Cron
 }
 
 func NewChartGroupServiceImpl(logger *zap.SugaredLogger,
@@ -104,7 +113,17 @@ func NewChartGroupServiceImpl(logger *zap.SugaredLogger,
 	gitOperationService git.GitOperationService,
 	installAppService FullMode.InstalledAppDBExtendedService,
 	appStoreAppsEventPublishService out.AppStoreAppsEventPublishService,
-	teamReadService read.TeamReadService) (*ChartGroupServiceImpl, error) {
+	teamReadService read.TeamReadService,
+	installedAppVersionHistoryRepository repository.InstalledAppVersionHistoryRepository,
+	chartGroupRolloutRepository repository2.ChartGroupRolloutRepository,
+	asyncRunnable *async.Runnable,
+	cronLogger *cron2.CronLoggerImpl) (*ChartGroupServiceImpl, error) {
+	rolloutConfig := &ChartGroupRolloutConfig{}
+	err := env.Parse(rolloutConfig)
+	if err != nil {
+		logger.Errorw("error in parsing chart group rollout config", "err", err)
+		return nil, err
+	}
 	impl := &ChartGroupServiceImpl{
 		logger:                               logger,
 		chartGroupEntriesRepository:          chartGroupEntriesRepository,
@@ -128,6 +147,21 @@ func NewChartGroupServiceImpl(logger *zap.SugaredLogger,
 		appStoreAppsEventPublishService:      appStoreAppsEventPublishService,
 		appStoreRepository:                   appStoreRepository,
 		teamReadService:                      teamReadService,
+		installedAppVersionHistoryRepository: installedAppVersionHistoryRepository,
+		chartGroupRolloutRepository:          chartGroupRolloutRepository,
+		asyncRunnable:                        asyncRunnable,
+		rolloutConfig:                        rolloutConfig,
+	}
+	// rollouts only run in the process which started them, the ones left behind by a restarted process are failed
+	if len(rolloutConfig.StaleCheckCron) > 0 {
+		impl.cron = cron.New(cron.WithChain(cron.SkipIfStillRunning(cronLogger), cron.Recover(cronLogger)))
+		_, err = impl.cron.AddFunc(rolloutConfig.StaleCheckCron, impl.markInterruptedRolloutsFailed)
+		if err != nil {
+			logger.Errorw("error in adding chart group rollout stale check cron", "cronExpression", rolloutConfig.StaleCheckCron, "err", err)
+			return nil, err
+		}
+		impl.cron.Start()
+		asyncRunnable.Execute(impl.markInterruptedRolloutsFailed)
 	}
 	return impl, nil
 }
@@ -142,7 +176,10 @@ type ChartGroupService interface {
 	ChartGroupListMin(max int) ([]*ChartGroupBean, error)
 	DeleteChartGroup(req *ChartGroupBean) error
 
+	// DeployBulk installs all charts at once, unless install orders, health checks or rollback are requested
+	// in which case the charts are installed in order in the background and tracked as a rollout
 	DeployBulk(chartGroupInstallRequest *ChartGroupInstallRequest) (*ChartGroupInstallAppRes, error)
+	GetRollout(rolloutId int) (*ChartGroupRolloutDto, error)
 	DeployDefaultChartOnCluster(bean *bean3.ClusterBean, userId int32) (bool, error)
 	TriggerDeploymentEventAndHandleStatusUpdate(installAppVersions []*appStoreBean.InstallAppVersionDTO)
 
@@ -169,6 +206,7 @@ type ChartGroupEntryBean struct {
 	AppStoreApplicationVersionId int            `json:"appStoreApplicationVersionId,omitempty"` //AppStoreApplicationVersionId
 	ChartMetaData                *ChartMetaData `json:"chartMetaData,omitempty"`
 	ReferenceType                string         `json:"referenceType, omitempty"`
+	InstallOrder                 int            `json:"installOrder"`
 }
 
 type ChartMetaData struct {
@@ -270,6 +308,7 @@ func (impl *ChartGroupServiceImpl) SaveChartGroupEntries(req *ChartGroupBean) (*
 			//update
 			existingEntry.AppStoreApplicationVersionId = entry.AppStoreApplicationVersionId
 			existingEntry.AppStoreValuesVersionId = entry.AppStoreValuesVersionId
+			existingEntry.InstallOrder = entry.InstallOrder
 		} else {
 			//delete
 			existingEntry.Deleted = true
@@ -285,6 +324,7 @@ func (impl *ChartGroupServiceImpl) SaveChartGroupEntries(req *ChartGroupBean) (*
 			AppStoreValuesVersionId:      entryBean.AppStoreValuesVersionId,
 			AppStoreApplicationVersionId: entryBean.AppStoreApplicationVersionId,
 			ChartGroupId:                 group.Id,
+			InstallOrder:                 entryBean.InstallOrder,
 			Deleted:                      false,
 			AuditLog: sql.AuditLog{
 				CreatedOn: time.Now(),
@@ -363,6 +403,7 @@ func (impl *ChartGroupServiceImpl) charterEntryAdopter(chartGroupEntry *reposito
 		ReferenceType:                referenceType,
 		AppStoreValuesVersionName:    valueVersionName,
 		AppStoreValuesChartVersion:   appStoreValuesChartVersion,
+		InstallOrder:                 chartGroupEntry.InstallOrder,
 		ChartMetaData: &ChartMetaData{
 			ChartName:                  chartGroupEntry.AppStoreApplicationVersion.Name,
 			ChartRepoName:              chartRepoName,
@@ -575,6 +616,14 @@ func (impl *ChartGroupServiceImpl) DeployBulk(chartGroupInstallRequest *ChartGro
 		}
 		installAppVersionDTOList = append(installAppVersionDTOList, installAppVersionDTO)
 	}
+	orderedRollout, err := impl.isOrderedRollout(chartGroupInstallRequest)
+	if err != nil {
+		impl.logger.Errorw("DeployBulk, error in resolving install order", "chartGroupId", chartGroupInstallRequest.ChartGroupId, "err", err)
+		return nil, err
+	}
+	if orderedRollout {
+		return impl.deployInOrder(chartGroupInstallRequest, installAppVersionDTOList)
+	}
 	dbConnection := impl.installedAppRepository.GetConnection()
 	tx, err := dbConnection.Begin()
 	if err != nil {
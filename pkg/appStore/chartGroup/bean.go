@@ -16,12 +16,18 @@
 
 package chartGroup
 
+import "time"
+
 // / bean for v2
 type ChartGroupInstallRequest struct {
 	ProjectId                     int                              `json:"projectId"  validate:"required,number"`
 	ChartGroupInstallChartRequest []*ChartGroupInstallChartRequest `json:"charts" validate:"dive,required"`
 	ChartGroupId                  int                              `json:"chartGroupId"` //optional
-	UserId                        int32                            `json:"-"`
+	// WaitForHealthy holds back the next install order till all charts of the current one are healthy
+	WaitForHealthy       bool  `json:"waitForHealthy"`
+	HealthTimeoutSeconds int   `json:"healthTimeoutSeconds"` //optional, defaults to CHART_GROUP_ROLLOUT_HEALTH_TIMEOUT
+	RollbackOnFailure    bool  `json:"rollbackOnFailure"`
+	UserId               int32 `json:"-"`
 }
 
 type ChartGroupInstallChartRequest struct {
@@ -32,6 +38,8 @@ type ChartGroupInstallChartRequest struct {
 	ReferenceValueId   int    `json:"referenceValueId, omitempty" validate:"required,number"`
 	ReferenceValueKind string `json:"referenceValueKind, omitempty" validate:"oneof=DEFAULT TEMPLATE DEPLOYED"`
 	ChartGroupEntryId  int    `json:"chartGroupEntryId"` //optional
+	// InstallOrder defaults to the install order of the chart group entry, charts with a lower order are installed first
+	InstallOrder int `json:"installOrder"`
 }
 type ChartGroupInstallMetadata struct {
 	AppName       string `json:"appName"`
@@ -42,6 +50,7 @@ type ChartGroupInstallMetadata struct {
 type ChartGroupInstallAppRes struct {
 	ChartGroupInstallMetadata []ChartGroupInstallMetadata `json:"chartGroupInstallMetadata"`
 	Summary                   string                      `json:"summary"`
+	RolloutId                 int                         `json:"rolloutId,omitempty"`
 }
 type TriggerStatus string
 type Reason string
@@ -53,3 +62,71 @@ const (
 	ReasonNotAuthorize Reason        = "not authorized"
 	ReasonTriggered    Reason        = "triggered"
 )
+
+type ChartGroupRolloutConfig struct {
+	HealthPollIntervalSeconds int    `env:"CHART_GROUP_ROLLOUT_HEALTH_POLL_INTERVAL" envDefault:"10" description:"Interval in seconds for checking the health of charts in an ordered chart group installation"`
+	HealthTimeoutSeconds      int    `env:"CHART_GROUP_ROLLOUT_HEALTH_TIMEOUT" envDefault:"600" description:"Default time in seconds to wait for charts of an install order to become healthy"`
+	StaleTimeoutSeconds       int    `env:"CHART_GROUP_ROLLOUT_STALE_TIMEOUT" envDefault:"300" description:"Time in seconds after which a running chart group rollout without heartbeat, e.g. left behind by a restart, is marked failed"`
+	StaleCheckCron            string `env:"CHART_GROUP_ROLLOUT_STALE_CHECK_CRON" envDefault:"@every 2m" description:"Cron for marking interrupted chart group rollouts failed, empty disables it"`
+}
+
+// GetHeartbeatInterval keeps the heartbeat of a running rollout well within the stale timeout
+func (c *ChartGroupRolloutConfig) GetHeartbeatInterval() time.Duration {
+	interval := c.StaleTimeoutSeconds / 5
+	if interval <= 0 {
+		interval = 1
+	}
+	return time.Duration(interval) * time.Second
+}
+
+type RolloutStatus string
+
+const (
+	RolloutStatusRunning        RolloutStatus = "Running"
+	RolloutStatusSucceeded      RolloutStatus = "Succeeded"
+	RolloutStatusFailed         RolloutStatus = "Failed"
+	RolloutStatusRolledBack     RolloutStatus = "RolledBack"
+	RolloutStatusRollbackFailed RolloutStatus = "RollbackFailed"
+)
+
+type RolloutMemberStatus string
+
+const (
+	RolloutMemberStatusPending        RolloutMemberStatus = "Pending"
+	RolloutMemberStatusDeploying      RolloutMemberStatus = "Deploying"
+	RolloutMemberStatusDeployed       RolloutMemberStatus = "Deployed"
+	RolloutMemberStatusHealthy        RolloutMemberStatus = "Healthy"
+	RolloutMemberStatusFailed         RolloutMemberStatus = "Failed"
+	RolloutMemberStatusSkipped        RolloutMemberStatus = "Skipped"
+	RolloutMemberStatusRolledBack     RolloutMemberStatus = "RolledBack"
+	RolloutMemberStatusRollbackFailed RolloutMemberStatus = "RollbackFailed"
+)
+
+const (
+	RolloutInterruptedMessage   = "rollout interrupted as the process running it stopped, charts installed so far were not rolled back"
+	RolloutStageFailedMessage   = "health not checked as another chart of the install order failed"
+	RolloutMemberSkippedMessage = "skipped as an earlier chart failed"
+)
+
+type ChartGroupRolloutDto struct {
+	Id                   int                           `json:"id"`
+	ChartGroupId         int                           `json:"chartGroupId,omitempty"`
+	ProjectId            int                           `json:"projectId"`
+	Status               RolloutStatus                 `json:"status"`
+	Message              string                        `json:"message,omitempty"`
+	WaitForHealthy       bool                          `json:"waitForHealthy"`
+	HealthTimeoutSeconds int                           `json:"healthTimeoutSeconds"`
+	RollbackOnFailure    bool                          `json:"rollbackOnFailure"`
+	StartedOn            time.Time                     `json:"startedOn"`
+	UpdatedOn            time.Time                     `json:"updatedOn"`
+	Members              []*ChartGroupRolloutMemberDto `json:"members"`
+}
+
+type ChartGroupRolloutMemberDto struct {
+	AppName        string              `json:"appName"`
+	EnvironmentId  int                 `json:"environmentId"`
+	InstallOrder   int                 `json:"installOrder"`
+	InstalledAppId int                 `json:"installedAppId,omitempty"`
+	Status         RolloutMemberStatus `json:"status"`
+	Message        string              `json:"message,omitempty"`
+}
@@ -31,6 +31,7 @@ type ChartGroupEntry struct {
 	AppStoreValuesVersionId      int      `sql:"app_store_values_version_id"`      //AppStoreVersionValuesId
 	AppStoreApplicationVersionId int      `sql:"app_store_application_version_id"` //AppStoreApplicationVersionId
 	ChartGroupId                 int      `sql:"chart_group_id"`
+	InstallOrder                 int      `sql:"install_order,notnull"`
 	Deleted                      bool     `sql:"deleted,notnull"`
 	sql.AuditLog
 	AppStoreApplicationVersion *appStoreDiscoverRepository.AppStoreApplicationVersion
@@ -0,0 +1,168 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package repository
+
+import (
+	"github.com/devtron-labs/devtron/pkg/sql"
+	"github.com/go-pg/pg"
+	"go.uber.org/zap"
+	"time"
+)
+
+// ChartGroupRollout tracks an ordered installation of a chart group, members are installed stage by stage in install order
+type ChartGroupRollout struct {
+	TableName            struct{} `sql:"chart_group_rollout" pg:",discard_unknown_columns"`
+	Id                   int      `sql:"id,pk"`
+	ChartGroupId         int      `sql:"chart_group_id"`
+	ProjectId            int      `sql:"project_id,notnull"`
+	Status               string   `sql:"status,notnull"`
+	Message              string   `sql:"message"`
+	WaitForHealthy       bool     `sql:"wait_for_healthy,notnull"`
+	HealthTimeoutSeconds int      `sql:"health_timeout_seconds,notnull"`
+	RollbackOnFailure    bool     `sql:"rollback_on_failure,notnull"`
+	sql.AuditLog
+}
+
+type ChartGroupRolloutMember struct {
+	TableName                    struct{} `sql:"chart_group_rollout_member" pg:",discard_unknown_columns"`
+	Id                           int      `sql:"id,pk"`
+	ChartGroupRolloutId          int      `sql:"chart_group_rollout_id,notnull"`
+	AppName                      string   `sql:"app_name,notnull"`
+	EnvironmentId                int      `sql:"environment_id,notnull"`
+	ChartGroupEntryId            int      `sql:"chart_group_entry_id"`
+	InstallOrder                 int      `sql:"install_order,notnull"`
+	InstalledAppId               int      `sql:"installed_app_id"`
+	InstalledAppVersionId        int      `sql:"installed_app_version_id"`
+	InstalledAppVersionHistoryId int      `sql:"installed_app_version_history_id"`
+	Status                       string   `sql:"status,notnull"`
+	Message                      string   `sql:"message"`
+	sql.AuditLog
+}
+
+type ChartGroupRolloutRepository interface {
+	Save(rollout *ChartGroupRollout, members []*ChartGroupRolloutMember, tx *pg.Tx) error
+	Update(rollout *ChartGroupRollout) error
+	UpdateMember(member *ChartGroupRolloutMember) error
+	FindById(id int) (*ChartGroupRollout, error)
+	FindMembersByRolloutId(rolloutId int) ([]*ChartGroupRolloutMember, error)
+	// UpdateHeartbeat bumps updated_on of a rollout still in status, the process running a rollout keeps it fresh
+	UpdateHeartbeat(rolloutId int, status string) error
+	FindByStatusUpdatedBefore(status string, updatedBefore time.Time) ([]*ChartGroupRollout, error)
+	// UpdateStatusIfStale moves a rollout from fromStatus to toStatus unless it was updated after updatedBefore,
+	// returns false when another process updated it in between
+	UpdateStatusIfStale(rolloutId int, fromStatus, toStatus, message string, updatedBefore time.Time, userId int32) (bool, error)
+	UpdateMembersStatus(rolloutId int, fromStatus, toStatus, message string, userId int32) error
+}
+
+type ChartGroupRolloutRepositoryImpl struct {
+	dbConnection *pg.DB
+	Logger       *zap.SugaredLogger
+}
+
+func NewChartGroupRolloutRepositoryImpl(dbConnection *pg.DB, Logger *zap.SugaredLogger) *ChartGroupRolloutRepositoryImpl {
+	return &ChartGroupRolloutRepositoryImpl{
+		dbConnection: dbConnection,
+		Logger:       Logger,
+	}
+}
+
+func (impl *ChartGroupRolloutRepositoryImpl) Save(rollout *ChartGroupRollout, members []*ChartGroupRolloutMember, tx *pg.Tx) error {
+	_, err := tx.Model(rollout).Insert()
+	if err != nil {
+		return err
+	}
+	for _, member := range members {
+		member.ChartGroupRolloutId = rollout.Id
+	}
+	if len(members) > 0 {
+		_, err = tx.Model(&members).Insert()
+	}
+	return err
+}
+
+func (impl *ChartGroupRolloutRepositoryImpl) Update(rollout *ChartGroupRollout) error {
+	_, err := impl.dbConnection.Model(rollout).WherePK().Update()
+	return err
+}
+
+func (impl *ChartGroupRolloutRepositoryImpl) UpdateMember(member *ChartGroupRolloutMember) error {
+	_, err := impl.dbConnection.Model(member).WherePK().Update()
+	return err
+}
+
+func (impl *ChartGroupRolloutRepositoryImpl) FindById(id int) (*ChartGroupRollout, error) {
+	rollout := &ChartGroupRollout{}
+	err := impl.dbConnection.Model(rollout).
+		Where("id = ?", id).
+		Select()
+	return rollout, err
+}
+
+func (impl *ChartGroupRolloutRepositoryImpl) FindMembersByRolloutId(rolloutId int) ([]*ChartGroupRolloutMember, error) {
+	var members []*ChartGroupRolloutMember
+	err := impl.dbConnection.Model(&members).
+		Where("chart_group_rollout_id = ?", rolloutId).
+		Order("install_order ASC", "id ASC").
+		Select()
+	return members, err
+}
+
+func (impl *ChartGroupRolloutRepositoryImpl) UpdateHeartbeat(rolloutId int, status string) error {
+	_, err := impl.dbConnection.Model(&ChartGroupRollout{}).
+		Set("updated_on = ?", time.Now()).
+		Where("id = ?", rolloutId).
+		Where("status = ?", status).
+		Update()
+	return err
+}
+
+func (impl *ChartGroupRolloutRepositoryImpl) FindByStatusUpdatedBefore(status string, updatedBefore time.Time) ([]*ChartGroupRollout, error) {
+	var rollouts []*ChartGroupRollout
+	err := impl.dbConnection.Model(&rollouts).
+		Where("status = ?", status).
+		Where("updated_on < ?", updatedBefore).
+		Select()
+	return rollouts, err
+}
+
+func (impl *ChartGroupRolloutRepositoryImpl) UpdateStatusIfStale(rolloutId int, fromStatus, toStatus, message string, updatedBefore time.Time, userId int32) (bool, error) {
+	res, err := impl.dbConnection.Model(&ChartGroupRollout{}).
+		Set("status = ?", toStatus).
+		Set("message = ?", message).
+		Set("updated_on = ?", time.Now()).
+		Set("updated_by = ?", userId).
+		Where("id = ?", rolloutId).
+		Where("status = ?", fromStatus).
+		Where("updated_on < ?", updatedBefore).
+		Update()
+	if err != nil {
+		return false, err
+	}
+	return res.RowsAffected() > 0, nil
+}
+
+func (impl *ChartGroupRolloutRepositoryImpl) UpdateMembersStatus(rolloutId int, fromStatus, toStatus, message string, userId int32) error {
+	_, err := impl.dbConnection.Model(&ChartGroupRolloutMember{}).
+		Set("status = ?", toStatus).
+		Set("message = ?", message).
+		Set("updated_on = ?", time.Now()).
+		Set("updated_by = ?", userId).
+		Where("chart_group_rollout_id = ?", rolloutId).
+		Where("status = ?", fromStatus).
+		Update()
+	return err
+}
@@ -37,6 +37,8 @@ type AppStoreApplicationVersionRepository interface {
 	FindLatestVersionByAppStoreIdForChartRepo(id int) (int, error)
 	FindLatestVersionByAppStoreIdForOCIRepo(id int) (int, error)
 	SearchAppStoreChartByName(chartName string) ([]*appStoreBean.ChartRepoSearch, error)
+	// FindVersionsMetadataByAppStoreId returns all versions of a chart with their Chart.yaml, latest first
+	FindVersionsMetadataByAppStoreId(appStoreId int) ([]*AppStoreApplicationVersion, error)
 }
 
 type AppStoreApplicationVersionRepositoryImpl struct {
@@ -255,6 +257,19 @@ func (impl AppStoreApplicationVersionRepositoryImpl) FindVersionsByAppStoreId(id
 	return appStoreApplicationVersions, err
 }
 
+func (impl AppStoreApplicationVersionRepositoryImpl) FindVersionsMetadataByAppStoreId(appStoreId int) ([]*AppStoreApplicationVersion, error) {
+	var appStoreApplicationVersions []*AppStoreApplicationVersion
+	err := impl.dbConnection.
+		Model(&appStoreApplicationVersions).
+		Column("app_store_application_version.id", "app_store_application_version.version", "app_store_application_version.app_version",
+			"app_store_application_version.created", "app_store_application_version.deprecated", "app_store_application_version.chart_yaml").
+		Where("app_store_id = ?", appStoreId).
+		Order("created DESC").
+		Order("id DESC").
+		Select()
+	return appStoreApplicationVersions, err
+}
+
 func (impl *AppStoreApplicationVersionRepositoryImpl) FindLatestVersionByAppStoreIdForChartRepo(id int) (int, error) {
 	var appStoreApplicationVersionId int
 	queryTemp := "SELECT asv.id AS app_store_application_version_id  FROM app_store_application_version AS asv  JOIN app_store AS ap ON asv.app_store_id = ap.id WHERE ap.id = ? order by created desc limit 1;"
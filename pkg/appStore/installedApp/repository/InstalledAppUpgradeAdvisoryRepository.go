@@ -0,0 +1,123 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package repository
+
+import (
+	"github.com/devtron-labs/devtron/pkg/sql"
+	"github.com/go-pg/pg"
+	"go.uber.org/zap"
+	"time"
+)
+
+type InstalledAppUpgradeAdvisoryRepository interface {
+	// GetActiveInstalledAppChartVersions returns the chart version of the active installed app version of all active installed apps
+	GetActiveInstalledAppChartVersions() ([]*InstalledAppChartVersion, error)
+	SaveOrUpdate(model *InstalledAppUpgradeAdvisory) error
+	FindByInstalledAppId(installedAppId int) (*InstalledAppUpgradeAdvisory, error)
+	FindAllWithUpgradeAvailable() ([]*InstalledAppUpgradeAdvisoryWithAppDetails, error)
+}
+
+type InstalledAppUpgradeAdvisoryRepositoryImpl struct {
+	dbConnection *pg.DB
+	logger       *zap.SugaredLogger
+}
+
+func NewInstalledAppUpgradeAdvisoryRepositoryImpl(logger *zap.SugaredLogger, dbConnection *pg.DB) *InstalledAppUpgradeAdvisoryRepositoryImpl {
+	return &InstalledAppUpgradeAdvisoryRepositoryImpl{dbConnection: dbConnection, logger: logger}
+}
+
+type InstalledAppUpgradeAdvisory struct {
+	TableName                           struct{}  `sql:"installed_app_upgrade_advisory" pg:",discard_unknown_columns"`
+	Id                                  int       `sql:"id,pk"`
+	InstalledAppId                      int       `sql:"installed_app_id,notnull"`
+	InstalledAppVersionId               int       `sql:"installed_app_version_id,notnull"`
+	InstalledAppStoreApplicationVersion int       `sql:"installed_app_store_application_version_id,notnull"`
+	LatestAppStoreApplicationVersion    int       `sql:"latest_app_store_application_version_id,notnull"`
+	LatestVersion                       string    `sql:"latest_version"`
+	UpgradeAvailable                    bool      `sql:"upgrade_available,notnull"`
+	CheckedOn                           time.Time `sql:"checked_on"`
+	sql.AuditLog
+}
+
+type InstalledAppChartVersion struct {
+	InstalledAppId               int    `sql:"installed_app_id"`
+	InstalledAppVersionId        int    `sql:"installed_app_version_id"`
+	AppStoreApplicationVersionId int    `sql:"app_store_application_version_id"`
+	AppStoreId                   int    `sql:"app_store_id"`
+	Version                      string `sql:"version"`
+}
+
+type InstalledAppUpgradeAdvisoryWithAppDetails struct {
+	InstalledAppUpgradeAdvisory
+	AppName          string `sql:"app_name"`
+	EnvironmentId    int    `sql:"environment_id"`
+	EnvironmentName  string `sql:"environment_name"`
+	ChartName        string `sql:"chart_name"`
+	InstalledVersion string `sql:"installed_version"`
+	AppOfferingMode  string `sql:"app_offering_mode"`
+	ClusterId        int    `sql:"cluster_id"`
+	Namespace        string `sql:"namespace"`
+}
+
+func (impl *InstalledAppUpgradeAdvisoryRepositoryImpl) GetActiveInstalledAppChartVersions() ([]*InstalledAppChartVersion, error) {
+	var chartVersions []*InstalledAppChartVersion
+	query := "SELECT ia.id AS installed_app_id, iav.id AS installed_app_version_id, iav.app_store_application_version_id," +
+		" asav.app_store_id, asav.version" +
+		" FROM installed_apps ia" +
+		" INNER JOIN installed_app_versions iav ON iav.installed_app_id = ia.id AND iav.active = true" +
+		" INNER JOIN app_store_application_version asav ON asav.id = iav.app_store_application_version_id" +
+		" WHERE ia.active = true;"
+	_, err := impl.dbConnection.Query(&chartVersions, query)
+	return chartVersions, err
+}
+
+func (impl *InstalledAppUpgradeAdvisoryRepositoryImpl) SaveOrUpdate(model *InstalledAppUpgradeAdvisory) error {
+	_, err := impl.dbConnection.Model(model).
+		OnConflict("(installed_app_id) DO UPDATE").
+		Set("installed_app_version_id = EXCLUDED.installed_app_version_id").
+		Set("installed_app_store_application_version_id = EXCLUDED.installed_app_store_application_version_id").
+		Set("latest_app_store_application_version_id = EXCLUDED.latest_app_store_application_version_id").
+		Set("latest_version = EXCLUDED.latest_version").
+		Set("upgrade_available = EXCLUDED.upgrade_available").
+		Set("checked_on = EXCLUDED.checked_on").
+		Set("updated_on = EXCLUDED.updated_on").
+		Set("updated_by = EXCLUDED.updated_by").
+		Insert()
+	return err
+}
+
+func (impl *InstalledAppUpgradeAdvisoryRepositoryImpl) FindByInstalledAppId(installedAppId int) (*InstalledAppUpgradeAdvisory, error) {
+	model := &InstalledAppUpgradeAdvisory{}
+	err := impl.dbConnection.Model(model).
+		Where("installed_app_id = ?", installedAppId).
+		Select()
+	return model, err
+}
+
+func (impl *InstalledAppUpgradeAdvisoryRepositoryImpl) FindAllWithUpgradeAvailable() ([]*InstalledAppUpgradeAdvisoryWithAppDetails, error) {
+	var advisories []*InstalledAppUpgradeAdvisoryWithAppDetails
+	query := "SELECT adv.*, app.app_name, app.app_offering_mode, env.id AS environment_id, env.environment_name," +
+		" env.cluster_id, env.namespace, asav.name AS chart_name, asav.version AS installed_version" +
+		" FROM installed_app_upgrade_advisory adv" +
+		" INNER JOIN installed_apps ia ON ia.id = adv.installed_app_id AND ia.active = true" +
+		" INNER JOIN app ON app.id = ia.app_id AND app.active = true" +
+		" INNER JOIN environment env ON env.id = ia.environment_id" +
+		" INNER JOIN app_store_application_version asav ON asav.id = adv.installed_app_store_application_version_id" +
+		" WHERE adv.upgrade_available = true ORDER BY app.app_name;"
+	_, err := impl.dbConnection.Query(&advisories, query)
+	return advisories, err
+}
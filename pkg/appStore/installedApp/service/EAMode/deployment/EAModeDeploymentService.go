@@ -138,9 +138,9 @@ func (impl *EAModeDeploymentServiceImpl) InstallApp(installAppVersionRequest *ap
 	} else {
 		chartRepository = &gRPC.ChartRepository{
 			Name:                    appStoreAppVersion.AppStore.ChartRepo.Name,
-			Url:                     appStoreAppVersion.AppStore.ChartRepo.Url,
-			Username:                appStoreAppVersion.AppStore.ChartRepo.UserName,
-			Password:                appStoreAppVersion.AppStore.ChartRepo.Password,
+			Url:                     appStoreAppVersion.AppStore.ChartRepo.GetChartPullUrl(),
+			Username:                appStoreAppVersion.AppStore.ChartRepo.GetChartPullUserName(),
+			Password:                appStoreAppVersion.AppStore.ChartRepo.GetChartPullPassword(),
 			AllowInsecureConnection: appStoreAppVersion.AppStore.ChartRepo.AllowInsecureConnection,
 		}
 	}
@@ -370,9 +370,9 @@ func (impl *EAModeDeploymentServiceImpl) updateApplicationWithChartInfo(ctx cont
 	} else {
 		chartRepository = &gRPC.ChartRepository{
 			Name:                    appStoreApplicationVersion.AppStore.ChartRepo.Name,
-			Url:                     appStoreApplicationVersion.AppStore.ChartRepo.Url,
-			Username:                appStoreApplicationVersion.AppStore.ChartRepo.UserName,
-			Password:                appStoreApplicationVersion.AppStore.ChartRepo.Password,
+			Url:                     appStoreApplicationVersion.AppStore.ChartRepo.GetChartPullUrl(),
+			Username:                appStoreApplicationVersion.AppStore.ChartRepo.GetChartPullUserName(),
+			Password:                appStoreApplicationVersion.AppStore.ChartRepo.GetChartPullPassword(),
 			AllowInsecureConnection: appStoreApplicationVersion.AppStore.ChartRepo.AllowInsecureConnection,
 		}
 	}
@@ -138,9 +138,9 @@ func (impl *InstalledAppResourceServiceImpl) findNotesForArgoApplication(install
 		} else {
 			chartRepository = &gRPC.ChartRepository{
 				Name:                    appStoreAppVersion.AppStore.ChartRepo.Name,
-				Url:                     appStoreAppVersion.AppStore.ChartRepo.Url,
-				Username:                appStoreAppVersion.AppStore.ChartRepo.UserName,
-				Password:                appStoreAppVersion.AppStore.ChartRepo.Password,
+				Url:                     appStoreAppVersion.AppStore.ChartRepo.GetChartPullUrl(),
+				Username:                appStoreAppVersion.AppStore.ChartRepo.GetChartPullUserName(),
+				Password:                appStoreAppVersion.AppStore.ChartRepo.GetChartPullPassword(),
 				AllowInsecureConnection: appStoreAppVersion.AppStore.ChartRepo.AllowInsecureConnection,
 			}
 		}
@@ -202,7 +202,7 @@ func (impl *AppStoreDeploymentCommonServiceImpl) GetProxyChartRequirements(appSt
 		Version: appStoreAppVersion.Version,
 	}
 	if appStoreAppVersion.AppStore.ChartRepo != nil {
-		dependency.Repository = appStoreAppVersion.AppStore.ChartRepo.Url
+		dependency.Repository = appStoreAppVersion.AppStore.ChartRepo.GetChartPullUrl()
 	} else if appStoreAppVersion.AppStore.DockerArtifactStore != nil {
 		repositoryURL, repositoryName, err := sanitizeRepoNameAndURLForOCIRepo(appStoreAppVersion.AppStore.DockerArtifactStore.RegistryURL, appStoreAppVersion.AppStore.Name)
 		if err != nil {
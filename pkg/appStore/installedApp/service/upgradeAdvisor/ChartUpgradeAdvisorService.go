@@ -0,0 +1,490 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package upgradeAdvisor
+
+import (
+	"fmt"
+	"github.com/Masterminds/semver"
+	"github.com/caarlos0/env"
+	"github.com/devtron-labs/devtron/internal/util"
+	appStoreBean "github.com/devtron-labs/devtron/pkg/appStore/bean"
+	appStoreDiscoverRepository "github.com/devtron-labs/devtron/pkg/appStore/discover/repository"
+	"github.com/devtron-labs/devtron/pkg/appStore/installedApp/repository"
+	"github.com/devtron-labs/devtron/pkg/sql"
+	cron2 "github.com/devtron-labs/devtron/util/cron"
+	"github.com/robfig/cron/v3"
+	"go.uber.org/zap"
+	"net/http"
+	"sigs.k8s.io/yaml"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ChartUpgradeAdvisorService keeps apps installed from the chart store from silently falling behind their source repos
+type ChartUpgradeAdvisorService interface {
+	// CheckForChartUpgrades compares the installed chart version of all active installed apps with the latest synced version
+	CheckForChartUpgrades()
+	GetOutdatedInstalledApps() ([]*OutdatedInstalledApp, error)
+	// GetUpgradeReport returns the changelog and values/schema differences between the installed and the latest chart version
+	GetUpgradeReport(installedAppVersionId int) (*ChartUpgradeReport, error)
+	// GetUpgradePreview three-way-merges the user's customized values onto the default values of the target chart version
+	GetUpgradePreview(request *UpgradePreviewRequest) (*UpgradePreviewResponse, error)
+	// SetUpgradeInInstallAppVersion sets the target chart version and the reviewed (or merged) values of the upgrade in the
+	// installed app version, which is then deployed through the existing installed app update flow
+	SetUpgradeInInstallAppVersion(request *UpgradeRequest, installAppVersion *appStoreBean.InstallAppVersionDTO) error
+}
+
+type ChartUpgradeAdvisorServiceImpl struct {
+	logger                               *zap.SugaredLogger
+	installedAppRepository               repository.InstalledAppRepository
+	upgradeAdvisoryRepository            repository.InstalledAppUpgradeAdvisoryRepository
+	appStoreApplicationVersionRepository appStoreDiscoverRepository.AppStoreApplicationVersionRepository
+	cronLease                            sql.CronLease
+	config                               *ChartUpgradeAdvisorConfig
+	cron                                 *cron.Cron
+}
+
+func NewChartUpgradeAdvisorServiceImpl(logger *zap.SugaredLogger,
+	installedAppRepository repository.InstalledAppRepository,
+	upgradeAdvisoryRepository repository.InstalledAppUpgradeAdvisoryRepository,
+	appStoreApplicationVersionRepository appStoreDiscoverRepository.AppStoreApplicationVersionRepository,
+	cronLease sql.CronLease,
+	cronLogger *cron2.CronLoggerImpl) (*ChartUpgradeAdvisorServiceImpl, error) {
+	config := &ChartUpgradeAdvisorConfig{}
+	err := env.Parse(config)
+	if err != nil {
+		logger.Errorw("error in parsing chart upgrade advisor config", "err", err)
+		return nil, err
+	}
+	impl := &ChartUpgradeAdvisorServiceImpl{
+		logger:                               logger,
+		installedAppRepository:               installedAppRepository,
+		upgradeAdvisoryRepository:            upgradeAdvisoryRepository,
+		appStoreApplicationVersionRepository: appStoreApplicationVersionRepository,
+		cronLease:                            cronLease,
+		config:                               config,
+	}
+	if len(config.CheckCronExpression) > 0 {
+		impl.cron = cron.New(cron.WithChain(cron.SkipIfStillRunning(cronLogger), cron.Recover(cronLogger)))
+		_, err = impl.cron.AddFunc(config.CheckCronExpression, impl.CheckForChartUpgrades)
+		if err != nil {
+			logger.Errorw("error in adding chart upgrade check cron", "cronExpression", config.CheckCronExpression, "err", err)
+			return nil, err
+		}
+		impl.cron.Start()
+	}
+	return impl, nil
+}
+
+func (impl *ChartUpgradeAdvisorServiceImpl) CheckForChartUpgrades() {
+	// the check writes the same advisories from every replica, so only one replica runs it at a time
+	acquired, err := impl.cronLease.TryAcquire(ChartUpgradeCheckLeaseKey, ChartUpgradeCheckLeaseTtl)
+	if err != nil {
+		impl.logger.Errorw("error in taking lease for chart upgrade check", "err", err)
+		return
+	}
+	if !acquired {
+		impl.logger.Debugw("chart upgrade check is running on another replica, skipping")
+		return
+	}
+	defer func() {
+		err := impl.cronLease.Release(ChartUpgradeCheckLeaseKey)
+		if err != nil {
+			impl.logger.Errorw("error in releasing lease of chart upgrade check", "err", err)
+		}
+	}()
+	installedAppChartVersions, err := impl.upgradeAdvisoryRepository.GetActiveInstalledAppChartVersions()
+	if err != nil {
+		impl.logger.Errorw("error in fetching chart versions of installed apps", "err", err)
+		return
+	}
+	// charts are shared across installed apps, latest version is resolved once per chart in a run
+	latestVersionByAppStoreId := make(map[int]*appStoreDiscoverRepository.AppStoreApplicationVersion)
+	for _, installedAppChartVersion := range installedAppChartVersions {
+		latestVersion, found := latestVersionByAppStoreId[installedAppChartVersion.AppStoreId]
+		if !found {
+			latestVersion, err = impl.getLatestVersion(installedAppChartVersion.AppStoreId)
+			if err != nil {
+				impl.logger.Errorw("error in fetching latest chart version", "appStoreId", installedAppChartVersion.AppStoreId, "err", err)
+				continue
+			}
+			latestVersionByAppStoreId[installedAppChartVersion.AppStoreId] = latestVersion
+		}
+		if latestVersion == nil {
+			continue
+		}
+		installedVersion := &appStoreDiscoverRepository.AppStoreApplicationVersion{
+			Id:      installedAppChartVersion.AppStoreApplicationVersionId,
+			Version: installedAppChartVersion.Version,
+		}
+		advisory := &repository.InstalledAppUpgradeAdvisory{
+			InstalledAppId:                      installedAppChartVersion.InstalledAppId,
+			InstalledAppVersionId:               installedAppChartVersion.InstalledAppVersionId,
+			InstalledAppStoreApplicationVersion: installedAppChartVersion.AppStoreApplicationVersionId,
+			LatestAppStoreApplicationVersion:    latestVersion.Id,
+			LatestVersion:                       latestVersion.Version,
+			UpgradeAvailable:                    isNewerVersion(latestVersion, installedVersion),
+			CheckedOn:                           time.Now(),
+			AuditLog:                            sql.NewDefaultAuditLog(1),
+		}
+		err = impl.upgradeAdvisoryRepository.SaveOrUpdate(advisory)
+		if err != nil {
+			impl.logger.Errorw("error in saving installed app upgrade advisory", "installedAppId", installedAppChartVersion.InstalledAppId, "err", err)
+		}
+	}
+}
+
+func (impl *ChartUpgradeAdvisorServiceImpl) GetOutdatedInstalledApps() ([]*OutdatedInstalledApp, error) {
+	advisories, err := impl.upgradeAdvisoryRepository.FindAllWithUpgradeAvailable()
+	if err != nil && !util.IsErrNoRows(err) {
+		impl.logger.Errorw("error in fetching installed app upgrade advisories", "err", err)
+		return nil, err
+	}
+	outdatedApps := make([]*OutdatedInstalledApp, 0, len(advisories))
+	for _, advisory := range advisories {
+		outdatedApps = append(outdatedApps, &OutdatedInstalledApp{
+			InstalledAppId:          advisory.InstalledAppId,
+			InstalledAppVersionId:   advisory.InstalledAppVersionId,
+			AppName:                 advisory.AppName,
+			EnvironmentId:           advisory.EnvironmentId,
+			EnvironmentName:         advisory.EnvironmentName,
+			ChartName:               advisory.ChartName,
+			InstalledVersion:        advisory.InstalledVersion,
+			LatestVersion:           advisory.LatestVersion,
+			LatestAppStoreVersionId: advisory.LatestAppStoreApplicationVersion,
+			CheckedOn:               advisory.CheckedOn,
+			AppOfferingMode:         advisory.AppOfferingMode,
+			ClusterId:               advisory.ClusterId,
+			Namespace:               advisory.Namespace,
+		})
+	}
+	return outdatedApps, nil
+}
+
+func (impl *ChartUpgradeAdvisorServiceImpl) GetUpgradeReport(installedAppVersionId int) (*ChartUpgradeReport, error) {
+	installedAppVersion, err := impl.getInstalledAppVersion(installedAppVersionId)
+	if err != nil {
+		return nil, err
+	}
+	installedChartVersion := installedAppVersion.AppStoreApplicationVersion
+	versions, err := impl.getVersionsLatestFirst(installedChartVersion.AppStoreId)
+	if err != nil {
+		impl.logger.Errorw("error in fetching chart versions", "appStoreId", installedChartVersion.AppStoreId, "err", err)
+		return nil, err
+	}
+	report := &ChartUpgradeReport{
+		InstalledAppId:             installedAppVersion.InstalledAppId,
+		InstalledAppVersionId:      installedAppVersion.Id,
+		AppName:                    installedAppVersion.InstalledApp.App.AppName,
+		EnvironmentId:              installedAppVersion.InstalledApp.EnvironmentId,
+		ChartName:                  installedChartVersion.Name,
+		InstalledVersion:           installedChartVersion.Version,
+		InstalledAppStoreVersionId: installedChartVersion.Id,
+		LatestVersion:              installedChartVersion.Version,
+		LatestAppStoreVersionId:    installedChartVersion.Id,
+		Changelog:                  make([]*ChartVersionChangelog, 0),
+	}
+	latestIndex := getLatestVersionIndex(versions)
+	if latestIndex < 0 || !isNewerVersion(versions[latestIndex], &installedChartVersion) {
+		return report, nil
+	}
+	latestVersion, err := impl.appStoreApplicationVersionRepository.FindById(versions[latestIndex].Id)
+	if err != nil {
+		impl.logger.Errorw("error in fetching latest chart version", "appStoreVersionId", versions[latestIndex].Id, "err", err)
+		return nil, err
+	}
+	report.LatestVersion = latestVersion.Version
+	report.LatestAppStoreVersionId = latestVersion.Id
+	report.UpgradeAvailable = true
+	for _, version := range getNewerVersions(versions[latestIndex:], &installedChartVersion) {
+		report.Changelog = append(report.Changelog, impl.getChangelog(version))
+	}
+
+	installedDefaults, err := parseValues(installedChartVersion.RawValues)
+	if err != nil {
+		impl.logger.Errorw("error in parsing default values of installed chart version", "appStoreVersionId", installedChartVersion.Id, "err", err)
+		return nil, err
+	}
+	latestDefaults, err := parseValues(latestVersion.RawValues)
+	if err != nil {
+		impl.logger.Errorw("error in parsing default values of latest chart version", "appStoreVersionId", latestVersion.Id, "err", err)
+		return nil, err
+	}
+	report.DefaultValuesDiff = diffValues(installedDefaults, latestDefaults)
+	report.ValuesSchemaDiff, err = diffValuesSchema(installedChartVersion.ValuesSchemaJson, latestVersion.ValuesSchemaJson)
+	if err != nil {
+		// schema is informational, report is still useful without it
+		impl.logger.Warnw("error in comparing values schema of chart versions", "installedAppVersionId", installedAppVersionId, "err", err)
+		report.ValuesSchemaDiff = nil
+	}
+	advisory, err := impl.upgradeAdvisoryRepository.FindByInstalledAppId(installedAppVersion.InstalledAppId)
+	if err != nil && !util.IsErrNoRows(err) {
+		impl.logger.Errorw("error in fetching installed app upgrade advisory", "installedAppId", installedAppVersion.InstalledAppId, "err", err)
+		return nil, err
+	} else if err == nil {
+		report.CheckedOn = advisory.CheckedOn
+	}
+	return report, nil
+}
+
+func (impl *ChartUpgradeAdvisorServiceImpl) GetUpgradePreview(request *UpgradePreviewRequest) (*UpgradePreviewResponse, error) {
+	installedAppVersion, err := impl.getInstalledAppVersion(request.InstalledAppVersionId)
+	if err != nil {
+		return nil, err
+	}
+	installedChartVersion := installedAppVersion.AppStoreApplicationVersion
+	targetVersionId := request.TargetAppStoreVersionId
+	if targetVersionId == 0 {
+		latestVersion, err := impl.getLatestVersion(installedChartVersion.AppStoreId)
+		if err != nil {
+			impl.logger.Errorw("error in fetching latest chart version", "appStoreId", installedChartVersion.AppStoreId, "err", err)
+			return nil, err
+		}
+		if latestVersion == nil {
+			return nil, util.NewApiError(http.StatusNotFound, "no version found for the installed chart", "no version found for the installed chart")
+		}
+		targetVersionId = latestVersion.Id
+	}
+	targetVersion, err := impl.appStoreApplicationVersionRepository.FindById(targetVersionId)
+	if err != nil {
+		impl.logger.Errorw("error in fetching target chart version", "appStoreVersionId", targetVersionId, "err", err)
+		if util.IsErrNoRows(err) {
+			return nil, util.NewApiError(http.StatusNotFound, "target chart version not found", err.Error())
+		}
+		return nil, err
+	}
+	if targetVersion.AppStoreId != installedChartVersion.AppStoreId {
+		return nil, util.NewApiError(http.StatusBadRequest, "target version does not belong to the installed chart", "target version does not belong to the installed chart")
+	}
+
+	installedDefaults, err := parseValues(installedChartVersion.RawValues)
+	if err != nil {
+		impl.logger.Errorw("error in parsing default values of installed chart version", "appStoreVersionId", installedChartVersion.Id, "err", err)
+		return nil, err
+	}
+	userValues, err := parseValues(installedAppVersion.ValuesYaml)
+	if err != nil {
+		impl.logger.Errorw("error in parsing values of installed app", "installedAppVersionId", installedAppVersion.Id, "err", err)
+		return nil, err
+	}
+	targetDefaults, err := parseValues(targetVersion.RawValues)
+	if err != nil {
+		impl.logger.Errorw("error in parsing default values of target chart version", "appStoreVersionId", targetVersion.Id, "err", err)
+		return nil, err
+	}
+	mergedValues, conflicts := threeWayMergeValues(installedDefaults, userValues, targetDefaults)
+	mergedValuesYaml, err := yaml.Marshal(mergedValues)
+	if err != nil {
+		impl.logger.Errorw("error in marshalling merged values", "installedAppVersionId", installedAppVersion.Id, "err", err)
+		return nil, err
+	}
+	return &UpgradePreviewResponse{
+		InstalledAppVersionId:   installedAppVersion.Id,
+		TargetAppStoreVersionId: targetVersion.Id,
+		TargetVersion:           targetVersion.Version,
+		MergedValuesYaml:        string(mergedValuesYaml),
+		Conflicts:               conflicts,
+	}, nil
+}
+
+func (impl *ChartUpgradeAdvisorServiceImpl) SetUpgradeInInstallAppVersion(request *UpgradeRequest, installAppVersion *appStoreBean.InstallAppVersionDTO) error {
+	if request.TargetAppStoreVersionId == installAppVersion.AppStoreVersion {
+		return util.NewApiError(http.StatusBadRequest, "target version is already installed", "target version is already installed")
+	}
+	valuesOverrideYaml := request.ValuesOverrideYaml
+	if len(strings.TrimSpace(valuesOverrideYaml)) == 0 {
+		preview, err := impl.GetUpgradePreview(&UpgradePreviewRequest{
+			InstalledAppVersionId:   request.InstalledAppVersionId,
+			TargetAppStoreVersionId: request.TargetAppStoreVersionId,
+		})
+		if err != nil {
+			return err
+		}
+		valuesOverrideYaml = preview.MergedValuesYaml
+	} else {
+		targetVersion, err := impl.appStoreApplicationVersionRepository.FindById(request.TargetAppStoreVersionId)
+		if err != nil {
+			impl.logger.Errorw("error in fetching target chart version", "appStoreVersionId", request.TargetAppStoreVersionId, "err", err)
+			if util.IsErrNoRows(err) {
+				return util.NewApiError(http.StatusNotFound, "target chart version not found", err.Error())
+			}
+			return err
+		}
+		if targetVersion.AppStoreId != installAppVersion.AppStoreId {
+			return util.NewApiError(http.StatusBadRequest, "target version does not belong to the installed chart", "target version does not belong to the installed chart")
+		}
+		if _, err = parseValues(valuesOverrideYaml); err != nil {
+			return util.NewApiError(http.StatusBadRequest, "invalid values yaml", err.Error())
+		}
+	}
+	installAppVersion.AppStoreVersion = request.TargetAppStoreVersionId
+	installAppVersion.ValuesOverrideYaml = valuesOverrideYaml
+	installAppVersion.ReferenceValueKind = appStoreBean.REFERENCE_TYPE_DEFAULT
+	installAppVersion.ReferenceValueId = request.TargetAppStoreVersionId
+	return nil
+}
+
+func (impl *ChartUpgradeAdvisorServiceImpl) getInstalledAppVersion(installedAppVersionId int) (*repository.InstalledAppVersions, error) {
+	installedAppVersion, err := impl.installedAppRepository.GetInstalledAppVersion(installedAppVersionId)
+	if err != nil {
+		impl.logger.Errorw("error in fetching installed app version", "installedAppVersionId", installedAppVersionId, "err", err)
+		if util.IsErrNoRows(err) {
+			return nil, util.NewApiError(http.StatusNotFound, "installed app version not found", err.Error())
+		}
+		return nil, err
+	}
+	return installedAppVersion, nil
+}
+
+// getLatestVersion returns the latest synced version of a chart, nil if no version is found
+func (impl *ChartUpgradeAdvisorServiceImpl) getLatestVersion(appStoreId int) (*appStoreDiscoverRepository.AppStoreApplicationVersion, error) {
+	versions, err := impl.getVersionsLatestFirst(appStoreId)
+	if err != nil {
+		return nil, err
+	}
+	latestIndex := getLatestVersionIndex(versions)
+	if latestIndex < 0 {
+		return nil, nil
+	}
+	return versions[latestIndex], nil
+}
+
+func (impl *ChartUpgradeAdvisorServiceImpl) getVersionsLatestFirst(appStoreId int) ([]*appStoreDiscoverRepository.AppStoreApplicationVersion, error) {
+	versions, err := impl.appStoreApplicationVersionRepository.FindVersionsMetadataByAppStoreId(appStoreId)
+	if err != nil && !util.IsErrNoRows(err) {
+		return nil, err
+	}
+	sortVersionsLatestFirst(versions)
+	return versions, nil
+}
+
+// sortVersionsLatestFirst orders the chart versions by semver, a re-published or back-ported older version is synced
+// after the newer ones. Versions keep the order of creation if any of them is not semver.
+func sortVersionsLatestFirst(versions []*appStoreDiscoverRepository.AppStoreApplicationVersion) {
+	semVersions := make(map[int]*semver.Version, len(versions))
+	for _, version := range versions {
+		semVersion, err := semver.NewVersion(version.Version)
+		if err != nil {
+			return
+		}
+		semVersions[version.Id] = semVersion
+	}
+	sort.SliceStable(versions, func(i, j int) bool {
+		return semVersions[versions[i].Id].GreaterThan(semVersions[versions[j].Id])
+	})
+}
+
+// getLatestVersionIndex returns the index of the latest stable version in versions ordered latest first,
+// pre-releases are only considered when no stable version is synced. Returns -1 for no versions.
+func getLatestVersionIndex(versions []*appStoreDiscoverRepository.AppStoreApplicationVersion) int {
+	for i, version := range versions {
+		semVersion, err := semver.NewVersion(version.Version)
+		if err != nil || len(semVersion.Prerelease()) == 0 {
+			return i
+		}
+	}
+	if len(versions) > 0 {
+		return 0
+	}
+	return -1
+}
+
+// isNewerVersion tells if version is strictly greater than installed by semver, an installed pre-release can be ahead
+// of the latest stable version. Versions which are not semver can not be compared and any other version is newer.
+func isNewerVersion(version, installed *appStoreDiscoverRepository.AppStoreApplicationVersion) bool {
+	semVersion, err := semver.NewVersion(version.Version)
+	if err != nil {
+		return version.Id != installed.Id
+	}
+	installedSemVersion, err := semver.NewVersion(installed.Version)
+	if err != nil {
+		return version.Id != installed.Id
+	}
+	return semVersion.GreaterThan(installedSemVersion)
+}
+
+// getNewerVersions returns the versions strictly newer than installed from versions ordered latest first
+func getNewerVersions(versions []*appStoreDiscoverRepository.AppStoreApplicationVersion,
+	installed *appStoreDiscoverRepository.AppStoreApplicationVersion) []*appStoreDiscoverRepository.AppStoreApplicationVersion {
+	newerVersions := make([]*appStoreDiscoverRepository.AppStoreApplicationVersion, 0)
+	for _, version := range versions {
+		if version.Id == installed.Id {
+			break
+		}
+		if isNewerVersion(version, installed) {
+			newerVersions = append(newerVersions, version)
+		}
+	}
+	return newerVersions
+}
+
+func (impl *ChartUpgradeAdvisorServiceImpl) getChangelog(version *appStoreDiscoverRepository.AppStoreApplicationVersion) *ChartVersionChangelog {
+	changelog := &ChartVersionChangelog{
+		AppStoreVersionId: version.Id,
+		Version:           version.Version,
+		AppVersion:        version.AppVersion,
+		Created:           version.Created,
+		Deprecated:        version.Deprecated,
+		Changes:           make([]string, 0),
+	}
+	changes, err := getChangesFromChartYaml(version.ChartYaml)
+	if err != nil {
+		impl.logger.Debugw("error in reading changes from chart yaml", "appStoreVersionId", version.Id, "err", err)
+		return changelog
+	}
+	changelog.Changes = changes
+	return changelog
+}
+
+// getChangesFromChartYaml reads the changelog published in the artifacthub.io/changes annotation,
+// the annotation is either a list of descriptions or a list of objects with kind and description
+func getChangesFromChartYaml(chartYaml string) ([]string, error) {
+	changes := make([]string, 0)
+	if len(strings.TrimSpace(chartYaml)) == 0 {
+		return changes, nil
+	}
+	chartMetadata := struct {
+		Annotations map[string]string `json:"annotations"`
+	}{}
+	err := yaml.Unmarshal([]byte(chartYaml), &chartMetadata)
+	if err != nil {
+		return changes, err
+	}
+	changesAnnotation := chartMetadata.Annotations[artifactHubChangesAnnotation]
+	if len(strings.TrimSpace(changesAnnotation)) == 0 {
+		return changes, nil
+	}
+	var entries []interface{}
+	err = yaml.Unmarshal([]byte(changesAnnotation), &entries)
+	if err != nil {
+		return changes, err
+	}
+	for _, entry := range entries {
+		switch change := entry.(type) {
+		case string:
+			changes = append(changes, change)
+		case map[string]interface{}:
+			description := fmt.Sprint(change["description"])
+			if kind, ok := change["kind"].(string); ok && len(kind) > 0 {
+				description = fmt.Sprintf("%s: %s", kind, description)
+			}
+			changes = append(changes, description)
+		}
+	}
+	return changes, nil
+}
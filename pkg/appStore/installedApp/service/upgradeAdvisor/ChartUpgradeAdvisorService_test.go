@@ -0,0 +1,129 @@
+package upgradeAdvisor
+
+import (
+	"testing"
+
+	appStoreDiscoverRepository "github.com/devtron-labs/devtron/pkg/appStore/discover/repository"
+	"github.com/devtron-labs/devtron/pkg/appStore/installedApp/repository"
+	"github.com/devtron-labs/devtron/pkg/appStore/installedApp/repository/mocks"
+	mocks2 "github.com/devtron-labs/devtron/pkg/sql/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func TestSortVersionsLatestFirst(t *testing.T) {
+	newVersions := func(versions ...string) []*appStoreDiscoverRepository.AppStoreApplicationVersion {
+		result := make([]*appStoreDiscoverRepository.AppStoreApplicationVersion, 0, len(versions))
+		for i, version := range versions {
+			result = append(result, &appStoreDiscoverRepository.AppStoreApplicationVersion{Id: i + 1, Version: version})
+		}
+		return result
+	}
+	getVersions := func(versions []*appStoreDiscoverRepository.AppStoreApplicationVersion) []string {
+		result := make([]string, 0, len(versions))
+		for _, version := range versions {
+			result = append(result, version.Version)
+		}
+		return result
+	}
+	tests := []struct {
+		name           string
+		versions       []string
+		wantOrder      []string
+		wantLatestName string
+	}{
+		{
+			name:           "back-ported version synced after newer ones",
+			versions:       []string{"1.2.9", "2.1.0", "2.0.0"},
+			wantOrder:      []string{"2.1.0", "2.0.0", "1.2.9"},
+			wantLatestName: "2.1.0",
+		},
+		{
+			name:           "pre-release is not the latest",
+			versions:       []string{"3.0.0-rc.1", "2.1.0"},
+			wantOrder:      []string{"3.0.0-rc.1", "2.1.0"},
+			wantLatestName: "2.1.0",
+		},
+		{
+			name:           "only pre-releases",
+			versions:       []string{"1.0.0-beta.1", "1.0.0-beta.2"},
+			wantOrder:      []string{"1.0.0-beta.2", "1.0.0-beta.1"},
+			wantLatestName: "1.0.0-beta.2",
+		},
+		{
+			name:           "non semver versions keep the order of creation",
+			versions:       []string{"latest", "2.0.0", "1.0.0"},
+			wantOrder:      []string{"latest", "2.0.0", "1.0.0"},
+			wantLatestName: "latest",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			versions := newVersions(tt.versions...)
+			sortVersionsLatestFirst(versions)
+			assert.Equal(t, tt.wantOrder, getVersions(versions))
+			latestIndex := getLatestVersionIndex(versions)
+			assert.Equal(t, tt.wantLatestName, versions[latestIndex].Version)
+		})
+	}
+	assert.Equal(t, -1, getLatestVersionIndex(nil))
+}
+
+func TestGetNewerVersions(t *testing.T) {
+	newVersion := func(id int, version string) *appStoreDiscoverRepository.AppStoreApplicationVersion {
+		return &appStoreDiscoverRepository.AppStoreApplicationVersion{Id: id, Version: version}
+	}
+	getVersions := func(versions []*appStoreDiscoverRepository.AppStoreApplicationVersion) []string {
+		result := make([]string, 0, len(versions))
+		for _, version := range versions {
+			result = append(result, version.Version)
+		}
+		return result
+	}
+	t.Run("installed pre-release ahead of the latest stable version", func(t *testing.T) {
+		installed := newVersion(4, "2.0.0-rc.1")
+		versions := []*appStoreDiscoverRepository.AppStoreApplicationVersion{newVersion(1, "1.8.0"), newVersion(2, "1.9.0"), installed}
+		sortVersionsLatestFirst(versions)
+		latestIndex := getLatestVersionIndex(versions)
+		assert.Equal(t, "1.9.0", versions[latestIndex].Version)
+		assert.False(t, isNewerVersion(versions[latestIndex], installed))
+		assert.Empty(t, getNewerVersions(versions[latestIndex:], installed))
+	})
+	t.Run("changelog has only the versions newer than the installed one", func(t *testing.T) {
+		installed := newVersion(2, "1.1.0")
+		versions := []*appStoreDiscoverRepository.AppStoreApplicationVersion{newVersion(1, "1.0.0"), installed,
+			newVersion(3, "1.2.0"), newVersion(4, "1.3.0"), newVersion(5, "1.4.0-beta.1")}
+		sortVersionsLatestFirst(versions)
+		latestIndex := getLatestVersionIndex(versions)
+		assert.True(t, isNewerVersion(versions[latestIndex], installed))
+		assert.Equal(t, []string{"1.3.0", "1.2.0"}, getVersions(getNewerVersions(versions[latestIndex:], installed)))
+	})
+	t.Run("same semver published again is not an upgrade", func(t *testing.T) {
+		assert.False(t, isNewerVersion(newVersion(2, "v1.0.0"), newVersion(1, "1.0.0")))
+	})
+	t.Run("versions which are not semver are newer when they are a different version", func(t *testing.T) {
+		assert.True(t, isNewerVersion(newVersion(2, "latest"), newVersion(1, "stable")))
+		assert.False(t, isNewerVersion(newVersion(1, "stable"), newVersion(1, "stable")))
+	})
+}
+
+func TestCheckForChartUpgradesRunsUnderLease(t *testing.T) {
+	t.Run("check is skipped when another replica holds the lease", func(t *testing.T) {
+		upgradeAdvisoryRepository := mocks.NewInstalledAppUpgradeAdvisoryRepository(t)
+		cronLease := mocks2.NewCronLease(t)
+		cronLease.On("TryAcquire", ChartUpgradeCheckLeaseKey, ChartUpgradeCheckLeaseTtl).Return(false, nil)
+		impl := &ChartUpgradeAdvisorServiceImpl{logger: zap.NewNop().Sugar(), upgradeAdvisoryRepository: upgradeAdvisoryRepository, cronLease: cronLease}
+		impl.CheckForChartUpgrades()
+		upgradeAdvisoryRepository.AssertNotCalled(t, "GetActiveInstalledAppChartVersions")
+	})
+
+	t.Run("lease is released after the check", func(t *testing.T) {
+		upgradeAdvisoryRepository := mocks.NewInstalledAppUpgradeAdvisoryRepository(t)
+		upgradeAdvisoryRepository.On("GetActiveInstalledAppChartVersions").Return([]*repository.InstalledAppChartVersion{}, nil).Once()
+		cronLease := mocks2.NewCronLease(t)
+		cronLease.On("TryAcquire", ChartUpgradeCheckLeaseKey, ChartUpgradeCheckLeaseTtl).Return(true, nil)
+		cronLease.On("Release", ChartUpgradeCheckLeaseKey).Return(nil).Once()
+		impl := &ChartUpgradeAdvisorServiceImpl{logger: zap.NewNop().Sugar(), upgradeAdvisoryRepository: upgradeAdvisoryRepository, cronLease: cronLease}
+		impl.CheckForChartUpgrades()
+	})
+}
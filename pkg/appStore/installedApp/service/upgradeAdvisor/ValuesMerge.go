@@ -0,0 +1,236 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package upgradeAdvisor
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+)
+
+const keyPathSeparator = "."
+
+// parseValues parses a values yaml (or json) into a map, empty input is treated as empty values
+func parseValues(values string) (map[string]interface{}, error) {
+	parsed := make(map[string]interface{})
+	if len(strings.TrimSpace(values)) == 0 {
+		return parsed, nil
+	}
+	err := yaml.Unmarshal([]byte(values), &parsed)
+	if err != nil {
+		return nil, err
+	}
+	if parsed == nil {
+		parsed = make(map[string]interface{})
+	}
+	return parsed, nil
+}
+
+// threeWayMergeValues carries the user's customisations (ours compared to base) onto the new chart defaults (theirs).
+// base is the default values of the installed chart version, ours is the values deployed by the user and
+// theirs is the default values of the target chart version. Lists are merged as a whole.
+func threeWayMergeValues(base, ours, theirs map[string]interface{}) (map[string]interface{}, []*ValuesConflict) {
+	conflicts := make([]*ValuesConflict, 0)
+	merged := mergeMaps("", base, ours, theirs, &conflicts)
+	sort.Slice(conflicts, func(i, j int) bool {
+		return conflicts[i].Key < conflicts[j].Key
+	})
+	return merged, conflicts
+}
+
+func mergeMaps(prefix string, base, ours, theirs map[string]interface{}, conflicts *[]*ValuesConflict) map[string]interface{} {
+	merged := make(map[string]interface{})
+	for _, key := range unionKeys(base, ours, theirs) {
+		keyPath := joinKeyPath(prefix, key)
+		baseValue, inBase := base[key]
+		ourValue, inOurs := ours[key]
+		theirValue, inTheirs := theirs[key]
+
+		baseMap, baseIsMap := asMap(baseValue, inBase)
+		ourMap, ourIsMap := asMap(ourValue, inOurs)
+		theirMap, theirIsMap := asMap(theirValue, inTheirs)
+		if baseIsMap && ourIsMap && theirIsMap {
+			nestedValues := mergeMaps(keyPath, baseMap, ourMap, theirMap, conflicts)
+			// an empty map is kept unless it was removed by the user or by the new version
+			if len(nestedValues) > 0 || (inOurs && inTheirs) || (!inBase && (inOurs || inTheirs)) {
+				merged[key] = nestedValues
+			}
+			continue
+		}
+
+		userCustomised := inOurs != inBase || !reflect.DeepEqual(ourValue, baseValue)
+		defaultChanged := inTheirs != inBase || !reflect.DeepEqual(theirValue, baseValue)
+		switch {
+		case !userCustomised:
+			// value untouched by the user, take the new default
+			if inTheirs {
+				merged[key] = theirValue
+			}
+		case !defaultChanged:
+			if inOurs {
+				merged[key] = ourValue
+			}
+		case inOurs == inTheirs && reflect.DeepEqual(ourValue, theirValue):
+			// user and new version agree
+			if inOurs {
+				merged[key] = ourValue
+			}
+		default:
+			// both the user and the new version changed this key, the user's value is retained
+			if inOurs {
+				merged[key] = ourValue
+			}
+			*conflicts = append(*conflicts, &ValuesConflict{
+				Key:              keyPath,
+				InstalledDefault: baseValue,
+				UserValue:        ourValue,
+				NewDefault:       theirValue,
+				Reason:           getConflictReason(inOurs, inTheirs),
+			})
+		}
+	}
+	return merged
+}
+
+func getConflictReason(inOurs, inTheirs bool) string {
+	switch {
+	case !inTheirs:
+		return ConflictReasonRemovedInNewVersion
+	case !inOurs:
+		return ConflictReasonRemovedByUser
+	default:
+		return ConflictReasonChangedInBoth
+	}
+}
+
+// diffValues compares the leaf keys of two values maps
+func diffValues(oldValues, newValues map[string]interface{}) *ValuesKeyDiff {
+	oldLeaves := flattenValues("", oldValues, make(map[string]interface{}))
+	newLeaves := flattenValues("", newValues, make(map[string]interface{}))
+	diff := &ValuesKeyDiff{Added: make([]string, 0), Removed: make([]string, 0), Changed: make([]string, 0)}
+	for key, newValue := range newLeaves {
+		oldValue, found := oldLeaves[key]
+		if !found {
+			diff.Added = append(diff.Added, key)
+		} else if !reflect.DeepEqual(oldValue, newValue) {
+			diff.Changed = append(diff.Changed, key)
+		}
+	}
+	for key := range oldLeaves {
+		if _, found := newLeaves[key]; !found {
+			diff.Removed = append(diff.Removed, key)
+		}
+	}
+	sort.Strings(diff.Added)
+	sort.Strings(diff.Removed)
+	sort.Strings(diff.Changed)
+	return diff
+}
+
+// diffValuesSchema compares the properties declared in two values json schemas,
+// a property is considered changed when its type or default differs
+func diffValuesSchema(oldSchema, newSchema string) (*ValuesKeyDiff, error) {
+	oldProperties, err := flattenSchemaProperties(oldSchema)
+	if err != nil {
+		return nil, err
+	}
+	newProperties, err := flattenSchemaProperties(newSchema)
+	if err != nil {
+		return nil, err
+	}
+	return diffValues(oldProperties, newProperties), nil
+}
+
+func flattenSchemaProperties(schema string) (map[string]interface{}, error) {
+	properties := make(map[string]interface{})
+	if len(strings.TrimSpace(schema)) == 0 {
+		return properties, nil
+	}
+	parsedSchema := make(map[string]interface{})
+	err := json.Unmarshal([]byte(schema), &parsedSchema)
+	if err != nil {
+		return nil, err
+	}
+	collectSchemaProperties("", parsedSchema, properties)
+	return properties, nil
+}
+
+func collectSchemaProperties(prefix string, schema map[string]interface{}, properties map[string]interface{}) {
+	schemaProperties, ok := schema["properties"].(map[string]interface{})
+	if !ok {
+		return
+	}
+	for name, property := range schemaProperties {
+		propertySchema, ok := property.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		keyPath := joinKeyPath(prefix, name)
+		// leaf entry holds the type and default, nested properties are flattened separately
+		properties[keyPath] = map[string]interface{}{
+			"type":    propertySchema["type"],
+			"default": propertySchema["default"],
+		}
+		collectSchemaProperties(keyPath, propertySchema, properties)
+	}
+}
+
+func flattenValues(prefix string, values map[string]interface{}, leaves map[string]interface{}) map[string]interface{} {
+	for key, value := range values {
+		keyPath := joinKeyPath(prefix, key)
+		if nestedValues, ok := value.(map[string]interface{}); ok && len(nestedValues) > 0 {
+			flattenValues(keyPath, nestedValues, leaves)
+			continue
+		}
+		leaves[keyPath] = value
+	}
+	return leaves
+}
+
+func unionKeys(maps ...map[string]interface{}) []string {
+	keySet := make(map[string]bool)
+	for _, m := range maps {
+		for key := range m {
+			keySet[key] = true
+		}
+	}
+	keys := make([]string, 0, len(keySet))
+	for key := range keySet {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func asMap(value interface{}, present bool) (map[string]interface{}, bool) {
+	if !present {
+		// absent keys are merged as empty maps so that nested keys are compared individually
+		return map[string]interface{}{}, true
+	}
+	m, ok := value.(map[string]interface{})
+	return m, ok
+}
+
+func joinKeyPath(prefix, key string) string {
+	if len(prefix) == 0 {
+		return key
+	}
+	return prefix + keyPathSeparator + key
+}
@@ -0,0 +1,98 @@
+package upgradeAdvisor
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestThreeWayMergeValues(t *testing.T) {
+	base := `
+replicaCount: 1
+image:
+  repository: nginx
+  tag: "1.0"
+service:
+  type: ClusterIP
+  port: 80
+legacy: true
+`
+	ours := `
+replicaCount: 3
+image:
+  repository: nginx
+  tag: "1.1"
+service:
+  type: ClusterIP
+  port: 80
+legacy: false
+`
+	theirs := `
+replicaCount: 1
+image:
+  repository: nginx
+  tag: "2.0"
+service:
+  type: ClusterIP
+  port: 8080
+resources: {}
+`
+	baseValues, err := parseValues(base)
+	assert.Nil(t, err)
+	ourValues, err := parseValues(ours)
+	assert.Nil(t, err)
+	theirValues, err := parseValues(theirs)
+	assert.Nil(t, err)
+
+	merged, conflicts := threeWayMergeValues(baseValues, ourValues, theirValues)
+
+	// customised by user only
+	assert.Equal(t, float64(3), merged["replicaCount"])
+	// changed in new version only
+	assert.Equal(t, float64(8080), merged["service"].(map[string]interface{})["port"])
+	assert.Equal(t, map[string]interface{}{}, merged["resources"])
+	// changed in both, user's value retained
+	assert.Equal(t, "1.1", merged["image"].(map[string]interface{})["tag"])
+	assert.Equal(t, false, merged["legacy"])
+
+	assert.Equal(t, 2, len(conflicts))
+	assert.Equal(t, "image.tag", conflicts[0].Key)
+	assert.Equal(t, ConflictReasonChangedInBoth, conflicts[0].Reason)
+	assert.Equal(t, "legacy", conflicts[1].Key)
+	assert.Equal(t, ConflictReasonRemovedInNewVersion, conflicts[1].Reason)
+}
+
+func TestThreeWayMergeValuesWithoutCustomisation(t *testing.T) {
+	baseValues, _ := parseValues("a: 1\nb:\n  c: 2\n")
+	theirValues, _ := parseValues("a: 5\nb:\n  d: 3\n")
+
+	merged, conflicts := threeWayMergeValues(baseValues, baseValues, theirValues)
+
+	assert.Equal(t, 0, len(conflicts))
+	assert.Equal(t, theirValues, merged)
+}
+
+func TestDiffValues(t *testing.T) {
+	oldValues, _ := parseValues("a: 1\nb:\n  c: 2\n  d: 3\n")
+	newValues, _ := parseValues("a: 2\nb:\n  c: 2\ne: true\n")
+
+	diff := diffValues(oldValues, newValues)
+
+	assert.Equal(t, []string{"e"}, diff.Added)
+	assert.Equal(t, []string{"b.d"}, diff.Removed)
+	assert.Equal(t, []string{"a"}, diff.Changed)
+}
+
+func TestGetChangesFromChartYaml(t *testing.T) {
+	chartYaml := `
+name: nginx
+version: 2.0.0
+annotations:
+  artifacthub.io/changes: |
+    - kind: added
+      description: support for ingress class
+    - removed deprecated values
+`
+	changes, err := getChangesFromChartYaml(chartYaml)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"added: support for ingress class", "removed deprecated values"}, changes)
+}
@@ -0,0 +1,121 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package upgradeAdvisor
+
+import "time"
+
+const (
+	ConflictReasonChangedInBoth       = "ChangedInBoth"
+	ConflictReasonRemovedInNewVersion = "RemovedInNewVersion"
+	ConflictReasonRemovedByUser       = "RemovedByUser"
+)
+
+// artifactHubChangesAnnotation is the Chart.yaml annotation used by chart maintainers to publish the changelog of a version
+const artifactHubChangesAnnotation = "artifacthub.io/changes"
+
+const (
+	// ChartUpgradeCheckLeaseKey is the cron lease taken by the upgrade check so that it runs on one replica at a time
+	ChartUpgradeCheckLeaseKey = "chart_upgrade_check"
+	// ChartUpgradeCheckLeaseTtl bounds an upgrade check, another replica can take the check over once it expires
+	ChartUpgradeCheckLeaseTtl = 30 * time.Minute
+)
+
+type ChartUpgradeAdvisorConfig struct {
+	CheckCronExpression string `env:"CHART_UPGRADE_CHECK_CRON" envDefault:"@every 6h" description:"Cron expression for checking newer chart versions of apps installed from the chart store, empty value disables the check"`
+}
+
+type ChartUpgradeReport struct {
+	InstalledAppId             int                      `json:"installedAppId"`
+	InstalledAppVersionId      int                      `json:"installedAppVersionId"`
+	AppName                    string                   `json:"appName"`
+	EnvironmentId              int                      `json:"environmentId"`
+	ChartName                  string                   `json:"chartName"`
+	InstalledVersion           string                   `json:"installedVersion"`
+	InstalledAppStoreVersionId int                      `json:"installedAppStoreVersionId"`
+	LatestVersion              string                   `json:"latestVersion"`
+	LatestAppStoreVersionId    int                      `json:"latestAppStoreVersionId"`
+	UpgradeAvailable           bool                     `json:"upgradeAvailable"`
+	Changelog                  []*ChartVersionChangelog `json:"changelog"`
+	DefaultValuesDiff          *ValuesKeyDiff           `json:"defaultValuesDiff,omitempty"`
+	ValuesSchemaDiff           *ValuesKeyDiff           `json:"valuesSchemaDiff,omitempty"`
+	CheckedOn                  time.Time                `json:"checkedOn,omitempty"`
+}
+
+type ChartVersionChangelog struct {
+	AppStoreVersionId int       `json:"appStoreVersionId"`
+	Version           string    `json:"version"`
+	AppVersion        string    `json:"appVersion"`
+	Created           time.Time `json:"created,omitempty"`
+	Deprecated        bool      `json:"deprecated"`
+	Changes           []string  `json:"changes"`
+}
+
+// ValuesKeyDiff lists the dot separated key paths added, removed or changed between two versions
+type ValuesKeyDiff struct {
+	Added   []string `json:"added"`
+	Removed []string `json:"removed"`
+	Changed []string `json:"changed"`
+}
+
+type UpgradePreviewRequest struct {
+	InstalledAppVersionId int `json:"installedAppVersionId" validate:"required"`
+	// TargetAppStoreVersionId defaults to the latest version of the chart
+	TargetAppStoreVersionId int `json:"targetAppStoreVersionId"`
+}
+
+// UpgradeRequest upgrades the installed app to the target chart version with the values reviewed in the upgrade preview
+type UpgradeRequest struct {
+	InstalledAppVersionId   int `json:"installedAppVersionId" validate:"required"`
+	TargetAppStoreVersionId int `json:"targetAppStoreVersionId" validate:"required"`
+	// ValuesOverrideYaml is the reviewed values, the merged values of the upgrade preview are deployed when empty
+	ValuesOverrideYaml string `json:"valuesOverrideYaml"`
+}
+
+type UpgradePreviewResponse struct {
+	InstalledAppVersionId   int               `json:"installedAppVersionId"`
+	TargetAppStoreVersionId int               `json:"targetAppStoreVersionId"`
+	TargetVersion           string            `json:"targetVersion"`
+	MergedValuesYaml        string            `json:"mergedValuesYaml"`
+	Conflicts               []*ValuesConflict `json:"conflicts"`
+}
+
+// ValuesConflict is a key customised by the user which has also been changed by the new chart version,
+// the user's value is retained in the merged values
+type ValuesConflict struct {
+	Key              string      `json:"key"`
+	InstalledDefault interface{} `json:"installedDefault"`
+	UserValue        interface{} `json:"userValue"`
+	NewDefault       interface{} `json:"newDefault"`
+	Reason           string      `json:"reason"`
+}
+
+type OutdatedInstalledApp struct {
+	InstalledAppId          int       `json:"installedAppId"`
+	InstalledAppVersionId   int       `json:"installedAppVersionId"`
+	AppName                 string    `json:"appName"`
+	EnvironmentId           int       `json:"environmentId"`
+	EnvironmentName         string    `json:"environmentName"`
+	ChartName               string    `json:"chartName"`
+	InstalledVersion        string    `json:"installedVersion"`
+	LatestVersion           string    `json:"latestVersion"`
+	LatestAppStoreVersionId int       `json:"latestAppStoreVersionId"`
+	CheckedOn               time.Time `json:"checkedOn"`
+	// rbac fields
+	AppOfferingMode string `json:"-"`
+	ClusterId       int    `json:"-"`
+	Namespace       string `json:"-"`
+}
@@ -400,6 +400,7 @@ func WhitelistChecker(url string) bool {
 		"/orchestrator/auth/login",
 		"/dashboard",
 		"/orchestrator/webhook/git",
+		"/orchestrator/chart-repo/mirror/serve/",
 	}
 	for _, a := range prefixUrls {
 		if strings.Contains(url, a) {
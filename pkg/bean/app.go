@@ -146,6 +146,7 @@ type CiPipeline struct {
 	TargetPlatform           string                 `json:"targetPlatform,omitempty"`
 	IsDockerConfigOverridden bool                   `json:"isDockerConfigOverridden"`
 	DockerConfigOverride     DockerConfigOverride   `json:"dockerConfigOverride,omitempty"`
+	AutoCancelSuperseded     bool                   `json:"autoCancelSuperseded"` // abort the running build of an older commit when a newer commit of the same branch triggers the pipeline
 	EnvironmentId            int                    `json:"environmentId,omitempty"`
 	LastTriggeredEnvId       int                    `json:"lastTriggeredEnvId"`
 	CustomTagObject          *CustomTagData         `json:"customTag,omitempty"`
@@ -636,6 +637,7 @@ type CDPipelineConfigObject struct {
 	ChartBaseVersion              string                                 `json:"chartBaseVersion"`
 	ContainerRegistryId           int                                    `json:"containerRegistryId"`
 	RepoUrl                       string                                 `json:"repoUrl"`
+	ContainerRegistryName         string                                 `json:"containerRegistryName"`
 	ManifestStorageType           string                                 `json:"manifestStorageType"`
 	PreDeployStage                *bean.PipelineStageDto                 `json:"preDeployStage,omitempty"`
 	PostDeployStage               *bean.PipelineStageDto                 `json:"postDeployStage,omitempty"`
@@ -661,6 +663,10 @@ func (cdPipelineConfig *CDPipelineConfigObject) IsFluxDeploymentAppType() bool {
 	return cdPipelineConfig.DeploymentAppType == util.PIPELINE_DEPLOYMENT_TYPE_FLUX
 }
 
+func (cdPipelineConfig *CDPipelineConfigObject) IsOCIManifestStorageType() bool {
+	return IsOCIStorage(cdPipelineConfig.ManifestStorageType)
+}
+
 func (cdPipelineConfig *CDPipelineConfigObject) IsAcdDeploymentAppType() bool {
 	return cdPipelineConfig.DeploymentAppType == util.PIPELINE_DEPLOYMENT_TYPE_ACD
 }
@@ -1030,12 +1036,17 @@ type ManifestStorage = string
 
 const (
 	ManifestStorageGit ManifestStorage = "git"
+	ManifestStorageOCI ManifestStorage = "oci"
 )
 
 func IsGitStorage(storageType string) bool {
 	return storageType == ManifestStorageGit
 }
 
+func IsOCIStorage(storageType string) bool {
+	return storageType == ManifestStorageOCI
+}
+
 const CustomAutoScalingEnabledPathKey = "CUSTOM_AUTOSCALING_ENABLED_PATH"
 const CustomAutoscalingReplicaCountPathKey = "CUSTOM_AUTOSCALING_REPLICA_COUNT_PATH"
 const CustomAutoscalingMinPathKey = "CUSTOM_AUTOSCALING_MIN_PATH"
@@ -52,12 +52,18 @@ type ConfigData struct {
 	ESOSubPath            []string         `json:"esoSubPath"`
 	FilePermission        string           `json:"filePermission"`
 	Overridden            bool             `json:"overridden"`
+	RotationPolicy        *RotationPolicy  `json:"rotationPolicy,omitempty"`
 }
 
 func (c *ConfigData) IsESOExternalSecretType() bool {
 	return strings.HasPrefix(c.ExternalSecretType, "ESO")
 }
 
+type RotationPolicy struct {
+	Enabled     bool `json:"enabled"`
+	AutoRestart bool `json:"autoRestart"`
+}
+
 type ExternalSecret struct {
 	Key      string `json:"key"`
 	Name     string `json:"name"`
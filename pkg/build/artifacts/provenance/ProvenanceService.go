@@ -0,0 +1,356 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package provenance
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/caarlos0/env"
+	"github.com/devtron-labs/devtron/internal/sql/constants"
+	repository3 "github.com/devtron-labs/devtron/internal/sql/repository"
+	"github.com/devtron-labs/devtron/internal/sql/repository/pipelineConfig"
+	"github.com/devtron-labs/devtron/internal/util"
+	bean2 "github.com/devtron-labs/devtron/pkg/bean"
+	"github.com/devtron-labs/devtron/pkg/build/artifacts/provenance/bean"
+	"github.com/devtron-labs/devtron/pkg/build/artifacts/provenance/repository"
+	pipelineBean "github.com/devtron-labs/devtron/pkg/build/pipeline/bean"
+	"github.com/devtron-labs/devtron/pkg/pipeline/types"
+	"github.com/devtron-labs/devtron/pkg/sql"
+	"github.com/devtron-labs/devtron/pkg/workflow/trigger/audit/service"
+	"go.uber.org/zap"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type ProvenanceService interface {
+	// GenerateArtifactProvenance records the SLSA provenance of an artifact built by the ci workflow
+	GenerateArtifactProvenance(ciArtifactId, ciWorkflowId int, userId int32) error
+	GetArtifactProvenance(ciArtifactId int) (*bean.ArtifactProvenanceResponse, error)
+}
+
+type ProvenanceServiceImpl struct {
+	logger                         *zap.SugaredLogger
+	config                         *bean.ProvenanceConfig
+	ciArtifactProvenanceRepository repository.CiArtifactProvenanceRepository
+	ciArtifactRepository           repository3.CiArtifactRepository
+	ciWorkflowRepository           pipelineConfig.CiWorkflowRepository
+	ciPipelineRepository           pipelineConfig.CiPipelineRepository
+	workflowTriggerAuditService    service.WorkflowTriggerAuditService
+}
+
+func NewProvenanceServiceImpl(logger *zap.SugaredLogger,
+	ciArtifactProvenanceRepository repository.CiArtifactProvenanceRepository,
+	ciArtifactRepository repository3.CiArtifactRepository,
+	ciWorkflowRepository pipelineConfig.CiWorkflowRepository,
+	ciPipelineRepository pipelineConfig.CiPipelineRepository,
+	workflowTriggerAuditService service.WorkflowTriggerAuditService) (*ProvenanceServiceImpl, error) {
+	config := &bean.ProvenanceConfig{}
+	err := env.Parse(config)
+	if err != nil {
+		logger.Errorw("error in parsing provenance config", "err", err)
+		return nil, err
+	}
+	return &ProvenanceServiceImpl{
+		logger:                         logger,
+		config:                         config,
+		ciArtifactProvenanceRepository: ciArtifactProvenanceRepository,
+		ciArtifactRepository:           ciArtifactRepository,
+		ciWorkflowRepository:           ciWorkflowRepository,
+		ciPipelineRepository:           ciPipelineRepository,
+		workflowTriggerAuditService:    workflowTriggerAuditService,
+	}, nil
+}
+
+func (impl *ProvenanceServiceImpl) GenerateArtifactProvenance(ciArtifactId, ciWorkflowId int, userId int32) error {
+	if !impl.config.GenerationEnabled {
+		return nil
+	}
+	ciArtifact, err := impl.ciArtifactRepository.Get(ciArtifactId)
+	if err != nil {
+		impl.logger.Errorw("error in fetching ci artifact", "ciArtifactId", ciArtifactId, "err", err)
+		return err
+	}
+	ciWorkflow, err := impl.ciWorkflowRepository.FindById(ciWorkflowId)
+	if err != nil {
+		impl.logger.Errorw("error in fetching ci workflow", "ciWorkflowId", ciWorkflowId, "err", err)
+		return err
+	}
+	// the build parameters are read from the config snapshot taken on trigger, builds triggered
+	// before the snapshots were recorded get a provenance with their git materials only
+	workflowRequest, err := impl.workflowTriggerAuditService.GetSanitizedWorkflowRequestFromSnapshot(ciWorkflowId, types.CI_WORKFLOW_TYPE)
+	if err != nil && !util.IsErrNoRows(err) {
+		impl.logger.Errorw("error in fetching workflow request of ci workflow", "ciWorkflowId", ciWorkflowId, "err", err)
+		return err
+	}
+	statement := impl.buildStatement(ciArtifact, ciWorkflow, workflowRequest)
+	content, err := json.Marshal(statement)
+	if err != nil {
+		impl.logger.Errorw("error in marshalling provenance statement", "ciArtifactId", ciArtifactId, "err", err)
+		return err
+	}
+	model := &repository.CiArtifactProvenance{
+		CiArtifactId:  ciArtifactId,
+		CiWorkflowId:  ciWorkflowId,
+		PredicateType: statement.PredicateType,
+		ImageDigest:   ciArtifact.ImageDigest,
+		Content:       string(content),
+		AuditLog:      sql.NewDefaultAuditLog(userId),
+	}
+	err = impl.ciArtifactProvenanceRepository.Upsert(model)
+	if err != nil {
+		impl.logger.Errorw("error in saving artifact provenance", "ciArtifactId", ciArtifactId, "ciWorkflowId", ciWorkflowId, "err", err)
+		return err
+	}
+	return nil
+}
+
+func (impl *ProvenanceServiceImpl) GetArtifactProvenance(ciArtifactId int) (*bean.ArtifactProvenanceResponse, error) {
+	ciArtifact, err := impl.ciArtifactRepository.Get(ciArtifactId)
+	if util.IsErrNoRows(err) {
+		errMsg := fmt.Sprintf("artifact %d not found", ciArtifactId)
+		return nil, util.NewApiError(http.StatusNotFound, errMsg, errMsg)
+	} else if err != nil {
+		impl.logger.Errorw("error in fetching ci artifact", "ciArtifactId", ciArtifactId, "err", err)
+		return nil, err
+	}
+	response := &bean.ArtifactProvenanceResponse{
+		CiArtifactId: ciArtifactId,
+		Image:        ciArtifact.Image,
+		ImageDigest:  ciArtifact.ImageDigest,
+	}
+	if ciArtifact.PipelineId > 0 {
+		ciPipeline, err := impl.ciPipelineRepository.FindByIdIncludingInActive(ciArtifact.PipelineId)
+		if err != nil {
+			impl.logger.Errorw("error in fetching ci pipeline of artifact", "ciArtifactId", ciArtifactId, "ciPipelineId", ciArtifact.PipelineId, "err", err)
+			return nil, err
+		}
+		response.AppId = ciPipeline.AppId
+	}
+	model, err := impl.ciArtifactProvenanceRepository.FindByCiArtifactId(ciArtifactId)
+	if util.IsErrNoRows(err) {
+		// the app id is still returned for the rbac check of the caller
+		errMsg := fmt.Sprintf("provenance is not recorded for artifact %d", ciArtifactId)
+		return response, util.NewApiError(http.StatusNotFound, errMsg, errMsg)
+	} else if err != nil {
+		impl.logger.Errorw("error in fetching artifact provenance", "ciArtifactId", ciArtifactId, "err", err)
+		return nil, err
+	}
+	response.CiWorkflowId = model.CiWorkflowId
+	response.PredicateType = model.PredicateType
+	response.Statement = json.RawMessage(model.Content)
+	response.CreatedOn = model.CreatedOn
+	return response, nil
+}
+
+func (impl *ProvenanceServiceImpl) buildStatement(ciArtifact *repository3.CiArtifact, ciWorkflow *pipelineConfig.CiWorkflow,
+	workflowRequest *types.WorkflowRequest) *bean.Statement {
+	sources, dependencies := impl.getSourcesAndDependencies(ciWorkflow, workflowRequest)
+	buildDefinition := &bean.BuildDefinition{
+		BuildType: fmt.Sprintf(bean.BuildTypePattern, ciWorkflow.CiBuildType),
+		ExternalParameters: &bean.ExternalParameters{
+			Sources: sources,
+			Image:   ciArtifact.Image,
+		},
+		ResolvedDependencies: dependencies,
+	}
+	if ciPipeline := ciWorkflow.CiPipeline; ciPipeline != nil {
+		buildDefinition.InternalParameters = &bean.InternalParameters{
+			AppId:          ciPipeline.AppId,
+			CiPipelineId:   ciPipeline.Id,
+			CiPipelineName: ciPipeline.Name,
+			TriggeredBy:    ciWorkflow.TriggeredBy,
+		}
+		if ciPipeline.App != nil {
+			buildDefinition.InternalParameters.AppName = ciPipeline.App.AppName
+		}
+	}
+	builder := &bean.Builder{Id: impl.config.BuilderId}
+	if workflowRequest != nil {
+		if buildParameters := impl.getBuildParameters(workflowRequest.CiBuildConfig); buildParameters != nil {
+			buildDefinition.BuildType = fmt.Sprintf(bean.BuildTypePattern, buildParameters.CiBuildType)
+			buildDefinition.ExternalParameters.Build = buildParameters
+			if len(buildParameters.BuilderImage) > 0 {
+				buildDefinition.ResolvedDependencies = append(buildDefinition.ResolvedDependencies, &bean.ResourceDescriptor{
+					Name: "builder",
+					Uri:  buildParameters.BuilderImage,
+				})
+			}
+		}
+		if len(workflowRequest.CiImage) > 0 {
+			builder.Version = map[string]string{"ciRunner": workflowRequest.CiImage}
+		}
+	}
+	return &bean.Statement{
+		Type:          bean.InTotoStatementType,
+		Subject:       []*bean.ResourceDescriptor{getSubject(ciArtifact)},
+		PredicateType: bean.SlsaProvenancePredicateType,
+		Predicate: &bean.Provenance{
+			BuildDefinition: buildDefinition,
+			RunDetails: &bean.RunDetails{
+				Builder:  builder,
+				Metadata: getBuildMetadata(ciArtifact, ciWorkflow),
+			},
+		},
+	}
+}
+
+// getSourcesAndDependencies lists the git materials of the build in material id order, with the commits checked out
+func (impl *ProvenanceServiceImpl) getSourcesAndDependencies(ciWorkflow *pipelineConfig.CiWorkflow,
+	workflowRequest *types.WorkflowRequest) ([]*bean.SourceParameter, []*bean.ResourceDescriptor) {
+	checkoutPaths := make(map[string]string)
+	if workflowRequest != nil {
+		for _, projectDetail := range workflowRequest.CiProjectDetails {
+			checkoutPaths[projectDetail.GitRepository] = projectDetail.CheckoutPath
+		}
+	}
+	materialIds := make([]int, 0, len(ciWorkflow.GitTriggers))
+	for materialId := range ciWorkflow.GitTriggers {
+		materialIds = append(materialIds, materialId)
+	}
+	sort.Ints(materialIds)
+	sources := make([]*bean.SourceParameter, 0, len(materialIds))
+	dependencies := make([]*bean.ResourceDescriptor, 0, len(materialIds))
+	for _, materialId := range materialIds {
+		gitCommit := ciWorkflow.GitTriggers[materialId]
+		commit, ref := getCommitAndRef(gitCommit)
+		sources = append(sources, &bean.SourceParameter{
+			Repository:   gitCommit.GitRepoUrl,
+			Ref:          ref,
+			CheckoutPath: checkoutPaths[gitCommit.GitRepoUrl],
+		})
+		if len(commit) == 0 {
+			continue
+		}
+		uri := "git+" + gitCommit.GitRepoUrl
+		if len(ref) > 0 {
+			uri = fmt.Sprintf("%s@%s", uri, ref)
+		}
+		dependency := &bean.ResourceDescriptor{
+			Name:   gitCommit.GitRepoName,
+			Uri:    uri,
+			Digest: map[string]string{bean.DigestGitCommit: commit},
+		}
+		if sourceCheckout := gitCommit.WebhookData.Data[bean2.WEBHOOK_SELECTOR_SOURCE_CHECKOUT_NAME]; len(sourceCheckout) > 0 && gitCommit.WebhookData.Id != 0 {
+			dependency.Annotations = map[string]string{bean2.WEBHOOK_SELECTOR_SOURCE_CHECKOUT_NAME: sourceCheckout}
+		}
+		dependencies = append(dependencies, dependency)
+	}
+	return sources, dependencies
+}
+
+func (impl *ProvenanceServiceImpl) getBuildParameters(ciBuildConfig *pipelineBean.CiBuildConfigBean) *bean.BuildParameters {
+	if ciBuildConfig == nil {
+		return nil
+	}
+	buildParameters := &bean.BuildParameters{
+		CiBuildType: string(ciBuildConfig.CiBuildType),
+	}
+	if dockerBuildConfig := ciBuildConfig.DockerBuildConfig; dockerBuildConfig != nil {
+		buildParameters.DockerfilePath = dockerBuildConfig.DockerfilePath
+		buildParameters.BuildContext = dockerBuildConfig.BuildContext
+		buildParameters.TargetPlatform = dockerBuildConfig.TargetPlatform
+		buildParameters.UseBuildx = dockerBuildConfig.UseBuildx
+		buildParameters.BuildArgs = impl.getBuildArgs(dockerBuildConfig.Args)
+		buildParameters.DockerBuildOptions = dockerBuildConfig.DockerBuildOptions
+	}
+	if buildPackConfig := ciBuildConfig.BuildPackConfig; buildPackConfig != nil && ciBuildConfig.CiBuildType == pipelineBean.BUILDPACK_BUILD_TYPE {
+		buildParameters.BuilderImage = buildPackConfig.BuilderId
+		buildParameters.Buildpacks = buildPackConfig.BuildPacks
+		buildParameters.ProjectPath = buildPackConfig.ProjectPath
+		buildParameters.BuildArgs = impl.getBuildArgs(buildPackConfig.Args)
+	}
+	return buildParameters
+}
+
+// getBuildArgs redacts the build arg values unless they are configured to be recorded, build args commonly carry tokens
+func (impl *ProvenanceServiceImpl) getBuildArgs(args map[string]string) map[string]string {
+	if len(args) == 0 || impl.config.RecordBuildArgValues {
+		return args
+	}
+	redactedArgs := make(map[string]string, len(args))
+	for key := range args {
+		redactedArgs[key] = bean.RedactedValue
+	}
+	return redactedArgs
+}
+
+// commitShaRegex matches a full sha-1 or sha-256 git commit hash
+var commitShaRegex = regexp.MustCompile("^[0-9a-f]{40}([0-9a-f]{24})?$")
+
+// getCommitAndRef returns the commit sha checked out for the material and the ref it was resolved from, commit is
+// empty when no sha was resolved so that a tag or branch name never ends up in the gitCommit digest
+func getCommitAndRef(gitCommit pipelineConfig.GitCommit) (commit string, ref string) {
+	if gitCommit.WebhookData.Id != 0 {
+		targetCheckout := gitCommit.WebhookData.Data[bean2.WEBHOOK_SELECTOR_TARGET_CHECKOUT_NAME]
+		commit = gitCommit.Commit
+		if gitCommit.WebhookData.EventActionType == bean2.WEBHOOK_EVENT_MERGED_ACTION_TYPE {
+			// target checkout of a pull request build is resolved to the head of the target branch while triggering
+			if len(commit) == 0 && commitShaRegex.MatchString(targetCheckout) {
+				commit = targetCheckout
+			}
+			if targetBranch := gitCommit.WebhookData.Data[bean2.WEBHOOK_SELECTOR_TARGET_BRANCH_NAME_NAME]; len(targetBranch) > 0 {
+				ref = "refs/heads/" + targetBranch
+			}
+		} else if len(targetCheckout) > 0 {
+			// webhook builds which are not pull requests are tag builds, target checkout is the tag name
+			ref = "refs/tags/" + targetCheckout
+		}
+		return commit, ref
+	}
+	switch gitCommit.CiConfigureSourceType {
+	case constants.SOURCE_TYPE_BRANCH_FIXED, constants.SOURCE_TYPE_BRANCH_REGEX:
+		if len(gitCommit.CiConfigureSourceValue) > 0 {
+			ref = "refs/heads/" + gitCommit.CiConfigureSourceValue
+		}
+	}
+	return gitCommit.Commit, ref
+}
+
+func getSubject(ciArtifact *repository3.CiArtifact) *bean.ResourceDescriptor {
+	subject := &bean.ResourceDescriptor{Name: ciArtifact.Image}
+	if repo, tag, err := ciArtifact.ExtractImageRepoAndTag(); err == nil && len(repo) > 0 {
+		subject.Name = repo
+		subject.Annotations = map[string]string{"tag": tag}
+	}
+	if algorithm, digest, found := strings.Cut(ciArtifact.ImageDigest, ":"); found && len(digest) > 0 {
+		subject.Digest = map[string]string{algorithm: digest}
+	}
+	return subject
+}
+
+func getBuildMetadata(ciArtifact *repository3.CiArtifact, ciWorkflow *pipelineConfig.CiWorkflow) *bean.BuildMetadata {
+	metadata := &bean.BuildMetadata{InvocationId: strconv.Itoa(ciWorkflow.Id)}
+	if len(ciWorkflow.Name) > 0 {
+		metadata.InvocationId = fmt.Sprintf("%s-%d", ciWorkflow.Name, ciWorkflow.Id)
+	}
+	if !ciWorkflow.StartedOn.IsZero() {
+		startedOn := ciWorkflow.StartedOn
+		metadata.StartedOn = &startedOn
+	}
+	// the workflow status, with its finish time, may be updated after the artifact is created
+	finishedOn := ciWorkflow.FinishedOn
+	if finishedOn.IsZero() {
+		finishedOn = ciArtifact.CreatedOn
+	}
+	if !finishedOn.IsZero() {
+		metadata.FinishedOn = &finishedOn
+	}
+	return metadata
+}
@@ -0,0 +1,132 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package provenance
+
+import (
+	"testing"
+	"time"
+
+	"github.com/devtron-labs/devtron/internal/sql/constants"
+	repository3 "github.com/devtron-labs/devtron/internal/sql/repository"
+	"github.com/devtron-labs/devtron/internal/sql/repository/pipelineConfig"
+	bean2 "github.com/devtron-labs/devtron/pkg/bean"
+	"github.com/devtron-labs/devtron/pkg/build/artifacts/provenance/bean"
+	pipelineBean "github.com/devtron-labs/devtron/pkg/build/pipeline/bean"
+	"github.com/devtron-labs/devtron/pkg/pipeline/types"
+	"github.com/devtron-labs/devtron/pkg/sql"
+)
+
+func TestBuildStatement(t *testing.T) {
+	ciArtifact := &repository3.CiArtifact{
+		Id:          11,
+		Image:       "registry.local/team/app:abc123-7",
+		ImageDigest: "sha256:4c3e1f2c",
+		AuditLog:    sql.AuditLog{CreatedOn: time.Now()},
+	}
+	ciWorkflow := &pipelineConfig.CiWorkflow{
+		Id:          7,
+		Name:        "app-ci",
+		CiBuildType: string(pipelineBean.SELF_DOCKERFILE_BUILD_TYPE),
+		StartedOn:   time.Now().Add(-time.Minute),
+		GitTriggers: map[int]pipelineConfig.GitCommit{
+			2: {Commit: "def456", GitRepoUrl: "https://github.com/org/lib.git", GitRepoName: "lib", CiConfigureSourceType: constants.SOURCE_TYPE_TAG_ANY},
+			1: {Commit: "abc123", GitRepoUrl: "https://github.com/org/app.git", GitRepoName: "app", CiConfigureSourceType: constants.SOURCE_TYPE_BRANCH_FIXED, CiConfigureSourceValue: "main"},
+		},
+		CiPipeline: &pipelineConfig.CiPipeline{Id: 3, AppId: 5, Name: "app-ci"},
+	}
+	workflowRequest := &types.WorkflowRequest{
+		CiImage: "quay.io/devtron/ci-runner:v1",
+		CiBuildConfig: &pipelineBean.CiBuildConfigBean{
+			CiBuildType: pipelineBean.SELF_DOCKERFILE_BUILD_TYPE,
+			DockerBuildConfig: &pipelineBean.DockerBuildConfig{
+				DockerfilePath: "Dockerfile",
+				Args:           map[string]string{"NPM_TOKEN": "secret"},
+			},
+		},
+	}
+	impl := &ProvenanceServiceImpl{config: &bean.ProvenanceConfig{BuilderId: "https://devtron.ai/ci-runner"}}
+
+	statement := impl.buildStatement(ciArtifact, ciWorkflow, workflowRequest)
+
+	if statement.Subject[0].Name != "registry.local/team/app" || statement.Subject[0].Digest["sha256"] != "4c3e1f2c" {
+		t.Errorf("unexpected subject %+v", statement.Subject[0])
+	}
+	dependencies := statement.Predicate.BuildDefinition.ResolvedDependencies
+	if len(dependencies) != 2 || dependencies[0].Uri != "git+https://github.com/org/app.git@refs/heads/main" ||
+		dependencies[0].Digest[bean.DigestGitCommit] != "abc123" || dependencies[1].Uri != "git+https://github.com/org/lib.git" {
+		t.Errorf("unexpected resolved dependencies %+v %+v", dependencies[0], dependencies[1])
+	}
+	build := statement.Predicate.BuildDefinition.ExternalParameters.Build
+	if build == nil || build.BuildArgs["NPM_TOKEN"] != bean.RedactedValue {
+		t.Errorf("build arg values are expected to be redacted, got %+v", build)
+	}
+	if statement.Predicate.RunDetails.Builder.Version["ciRunner"] != workflowRequest.CiImage {
+		t.Errorf("unexpected builder %+v", statement.Predicate.RunDetails.Builder)
+	}
+
+	// builds without a config snapshot still record their git materials
+	statement = impl.buildStatement(ciArtifact, ciWorkflow, nil)
+	if len(statement.Predicate.BuildDefinition.ResolvedDependencies) != 2 || statement.Predicate.BuildDefinition.ExternalParameters.Build != nil {
+		t.Errorf("unexpected build definition without workflow request %+v", statement.Predicate.BuildDefinition)
+	}
+}
+
+func TestGetCommitAndRef(t *testing.T) {
+	const commitSha = "9fceb02d0ae598e95dc970b74767f19372d61af8"
+	tests := []struct {
+		name       string
+		gitCommit  pipelineConfig.GitCommit
+		wantCommit string
+		wantRef    string
+	}{
+		{
+			name:       "branch build",
+			gitCommit:  pipelineConfig.GitCommit{Commit: commitSha, CiConfigureSourceType: constants.SOURCE_TYPE_BRANCH_FIXED, CiConfigureSourceValue: "main"},
+			wantCommit: commitSha,
+			wantRef:    "refs/heads/main",
+		},
+		{
+			name: "tag webhook build without resolved commit leaves the digest out",
+			gitCommit: pipelineConfig.GitCommit{WebhookData: pipelineConfig.WebhookData{Id: 4, EventActionType: bean2.WEBHOOK_EVENT_NON_MERGED_ACTION_TYPE,
+				Data: map[string]string{bean2.WEBHOOK_SELECTOR_TARGET_CHECKOUT_NAME: "v1.2.0"}}},
+			wantCommit: "",
+			wantRef:    "refs/tags/v1.2.0",
+		},
+		{
+			name: "tag webhook build with resolved commit",
+			gitCommit: pipelineConfig.GitCommit{Commit: commitSha, WebhookData: pipelineConfig.WebhookData{Id: 4, EventActionType: bean2.WEBHOOK_EVENT_NON_MERGED_ACTION_TYPE,
+				Data: map[string]string{bean2.WEBHOOK_SELECTOR_TARGET_CHECKOUT_NAME: "v1.2.0"}}},
+			wantCommit: commitSha,
+			wantRef:    "refs/tags/v1.2.0",
+		},
+		{
+			name: "pull request webhook build uses the resolved target branch head",
+			gitCommit: pipelineConfig.GitCommit{WebhookData: pipelineConfig.WebhookData{Id: 5, EventActionType: bean2.WEBHOOK_EVENT_MERGED_ACTION_TYPE,
+				Data: map[string]string{bean2.WEBHOOK_SELECTOR_TARGET_CHECKOUT_NAME: commitSha, bean2.WEBHOOK_SELECTOR_TARGET_BRANCH_NAME_NAME: "main"}}},
+			wantCommit: commitSha,
+			wantRef:    "refs/heads/main",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			commit, ref := getCommitAndRef(tt.gitCommit)
+			if commit != tt.wantCommit || ref != tt.wantRef {
+				t.Errorf("getCommitAndRef() = %q, %q, want %q, %q", commit, ref, tt.wantCommit, tt.wantRef)
+			}
+		})
+	}
+}
@@ -0,0 +1,130 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package bean
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type ProvenanceConfig struct {
+	GenerationEnabled    bool   `env:"SLSA_PROVENANCE_GENERATION_ENABLED" envDefault:"true" description:"Record a SLSA provenance attestation for every image built in ci" deprecated:"false"`
+	BuilderId            string `env:"SLSA_PROVENANCE_BUILDER_ID" envDefault:"https://devtron.ai/ci-runner" description:"Builder id recorded in the provenance, identifies the trusted build platform" deprecated:"false"`
+	RecordBuildArgValues bool   `env:"SLSA_PROVENANCE_RECORD_BUILD_ARG_VALUES" envDefault:"false" description:"Record the values of the docker and buildpack build args in the provenance, only their names are recorded otherwise" deprecated:"false"`
+}
+
+const (
+	InTotoStatementType         = "https://in-toto.io/Statement/v1"
+	SlsaProvenancePredicateType = "https://slsa.dev/provenance/v1"
+	// BuildTypePattern is the build type of the provenance, suffixed with the ci build type e.g. self-dockerfile-build
+	BuildTypePattern  = "https://devtron.ai/ci/%s/v1"
+	InTotoContentType = "application/vnd.in-toto+json"
+
+	RedactedValue = "<redacted>"
+
+	DigestAlgorithmSha256 = "sha256"
+	DigestGitCommit       = "gitCommit"
+)
+
+// Statement is an in-toto v1 statement with the SLSA v1 provenance predicate
+type Statement struct {
+	Type          string                `json:"_type"`
+	Subject       []*ResourceDescriptor `json:"subject"`
+	PredicateType string                `json:"predicateType"`
+	Predicate     *Provenance           `json:"predicate"`
+}
+
+type ResourceDescriptor struct {
+	Name        string            `json:"name,omitempty"`
+	Uri         string            `json:"uri,omitempty"`
+	Digest      map[string]string `json:"digest,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+type Provenance struct {
+	BuildDefinition *BuildDefinition `json:"buildDefinition"`
+	RunDetails      *RunDetails      `json:"runDetails"`
+}
+
+type BuildDefinition struct {
+	BuildType            string                `json:"buildType"`
+	ExternalParameters   *ExternalParameters   `json:"externalParameters"`
+	InternalParameters   *InternalParameters   `json:"internalParameters,omitempty"`
+	ResolvedDependencies []*ResourceDescriptor `json:"resolvedDependencies,omitempty"`
+}
+
+// ExternalParameters are the inputs of the build controlled by the app owners
+type ExternalParameters struct {
+	Sources []*SourceParameter `json:"sources"`
+	Build   *BuildParameters   `json:"build,omitempty"`
+	// Image is the repository and tag the build pushed to
+	Image string `json:"image"`
+}
+
+type SourceParameter struct {
+	Repository   string `json:"repository"`
+	Ref          string `json:"ref,omitempty"`
+	CheckoutPath string `json:"checkoutPath,omitempty"`
+}
+
+type BuildParameters struct {
+	CiBuildType        string            `json:"ciBuildType"`
+	DockerfilePath     string            `json:"dockerfilePath,omitempty"`
+	BuildContext       string            `json:"buildContext,omitempty"`
+	TargetPlatform     string            `json:"targetPlatform,omitempty"`
+	UseBuildx          bool              `json:"useBuildx,omitempty"`
+	BuildArgs          map[string]string `json:"buildArgs,omitempty"`
+	DockerBuildOptions map[string]string `json:"dockerBuildOptions,omitempty"`
+	BuilderImage       string            `json:"builderImage,omitempty"`
+	Buildpacks         []string          `json:"buildpacks,omitempty"`
+	ProjectPath        string            `json:"projectPath,omitempty"`
+}
+
+type InternalParameters struct {
+	AppId          int    `json:"appId"`
+	AppName        string `json:"appName"`
+	CiPipelineId   int    `json:"ciPipelineId"`
+	CiPipelineName string `json:"ciPipelineName"`
+	TriggeredBy    int32  `json:"triggeredBy"`
+}
+
+type RunDetails struct {
+	Builder  *Builder       `json:"builder"`
+	Metadata *BuildMetadata `json:"metadata"`
+}
+
+type Builder struct {
+	Id      string            `json:"id"`
+	Version map[string]string `json:"version,omitempty"`
+}
+
+type BuildMetadata struct {
+	InvocationId string     `json:"invocationId"`
+	StartedOn    *time.Time `json:"startedOn,omitempty"`
+	FinishedOn   *time.Time `json:"finishedOn,omitempty"`
+}
+
+type ArtifactProvenanceResponse struct {
+	CiArtifactId  int             `json:"ciArtifactId"`
+	CiWorkflowId  int             `json:"ciWorkflowId"`
+	AppId         int             `json:"appId"`
+	Image         string          `json:"image"`
+	ImageDigest   string          `json:"imageDigest"`
+	PredicateType string          `json:"predicateType"`
+	Statement     json.RawMessage `json:"statement"`
+	CreatedOn     time.Time       `json:"createdOn"`
+}
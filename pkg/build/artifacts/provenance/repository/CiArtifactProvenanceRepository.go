@@ -0,0 +1,73 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package repository
+
+import (
+	"github.com/devtron-labs/devtron/pkg/sql"
+	"github.com/go-pg/pg"
+	"go.uber.org/zap"
+)
+
+type CiArtifactProvenance struct {
+	TableName     struct{} `sql:"ci_artifact_provenance" pg:",discard_unknown_columns"`
+	Id            int      `sql:"id,pk"`
+	CiArtifactId  int      `sql:"ci_artifact_id,notnull"`
+	CiWorkflowId  int      `sql:"ci_workflow_id"`
+	PredicateType string   `sql:"predicate_type,notnull"`
+	ImageDigest   string   `sql:"image_digest"`
+	Content       string   `sql:"content,notnull"`
+	sql.AuditLog
+}
+
+type CiArtifactProvenanceRepository interface {
+	// Upsert replaces the provenance of the artifact, the ci complete event of a build may be redelivered
+	Upsert(provenance *CiArtifactProvenance) error
+	FindByCiArtifactId(ciArtifactId int) (*CiArtifactProvenance, error)
+}
+
+type CiArtifactProvenanceRepositoryImpl struct {
+	dbConnection *pg.DB
+	logger       *zap.SugaredLogger
+}
+
+func NewCiArtifactProvenanceRepositoryImpl(dbConnection *pg.DB, logger *zap.SugaredLogger) *CiArtifactProvenanceRepositoryImpl {
+	return &CiArtifactProvenanceRepositoryImpl{
+		dbConnection: dbConnection,
+		logger:       logger,
+	}
+}
+
+func (impl *CiArtifactProvenanceRepositoryImpl) Upsert(provenance *CiArtifactProvenance) error {
+	_, err := impl.dbConnection.Model(provenance).
+		OnConflict("(ci_artifact_id) DO UPDATE").
+		Set("ci_workflow_id = EXCLUDED.ci_workflow_id").
+		Set("predicate_type = EXCLUDED.predicate_type").
+		Set("image_digest = EXCLUDED.image_digest").
+		Set("content = EXCLUDED.content").
+		Set("updated_on = EXCLUDED.updated_on").
+		Set("updated_by = EXCLUDED.updated_by").
+		Insert()
+	return err
+}
+
+func (impl *CiArtifactProvenanceRepositoryImpl) FindByCiArtifactId(ciArtifactId int) (*CiArtifactProvenance, error) {
+	provenance := &CiArtifactProvenance{}
+	err := impl.dbConnection.Model(provenance).
+		Where("ci_artifact_id = ?", ciArtifactId).
+		Select()
+	return provenance, err
+}
@@ -0,0 +1,343 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sbom
+
+import (
+	"errors"
+	"fmt"
+	"github.com/caarlos0/env"
+	blob_storage "github.com/devtron-labs/common-lib/blob-storage"
+	repository2 "github.com/devtron-labs/devtron/internal/sql/repository"
+	"github.com/devtron-labs/devtron/internal/sql/repository/pipelineConfig"
+	"github.com/devtron-labs/devtron/internal/util"
+	"github.com/devtron-labs/devtron/pkg/build/artifacts/sbom/bean"
+	"github.com/devtron-labs/devtron/pkg/build/artifacts/sbom/repository"
+	"github.com/devtron-labs/devtron/pkg/pipeline/types"
+	util2 "github.com/devtron-labs/devtron/pkg/pipeline/util"
+	"github.com/devtron-labs/devtron/pkg/sql"
+	"go.uber.org/zap"
+	"net/http"
+	"os"
+	"slices"
+)
+
+const defaultSbomSearchSize = 100
+
+type SbomService interface {
+	// GetSbomGenerationConfig returns the SBOM generation instructions for a ci build, nil when generation is disabled
+	GetSbomGenerationConfig(ciWorkflowId int, blobStorageEnabled bool) *bean.SbomGenerationConfig
+	// SaveArtifactSbom attaches the SBOM reported for a build to the ci artifact created from it
+	SaveArtifactSbom(ciArtifactId int, sbom *bean.Sbom, userId int32) error
+	GetArtifactSboms(ciArtifactId int) (*bean.ArtifactSbomResponse, error)
+	// DownloadArtifactSbom returns the SBOM document, from blob storage when it was uploaded there by ci runner
+	DownloadArtifactSbom(ciArtifactId, sbomId int) ([]byte, *bean.ArtifactSbom, error)
+	// GetComponentAppIds returns the apps having an artifact with a component matching the search, to be filtered by rbac
+	GetComponentAppIds(request *bean.SbomSearchRequest) ([]int, error)
+	// SearchComponent returns a page of the artifacts of request.AppIds having a matching component
+	SearchComponent(request *bean.SbomSearchRequest) ([]*bean.SbomSearchResult, error)
+}
+
+type SbomServiceImpl struct {
+	logger                   *zap.SugaredLogger
+	config                   *bean.SbomConfig
+	ciCdConfig               *types.CiCdConfig
+	ciArtifactSbomRepository repository.CiArtifactSbomRepository
+	ciArtifactRepository     repository2.CiArtifactRepository
+	ciPipelineRepository     pipelineConfig.CiPipelineRepository
+}
+
+func NewSbomServiceImpl(logger *zap.SugaredLogger,
+	ciArtifactSbomRepository repository.CiArtifactSbomRepository,
+	ciArtifactRepository repository2.CiArtifactRepository,
+	ciPipelineRepository pipelineConfig.CiPipelineRepository) (*SbomServiceImpl, error) {
+	config := &bean.SbomConfig{}
+	err := env.Parse(config)
+	if err != nil {
+		logger.Errorw("error in parsing sbom config", "err", err)
+		return nil, err
+	}
+	ciConfig, err := types.GetCiConfig()
+	if err != nil {
+		logger.Errorw("error in parsing ci config", "err", err)
+		return nil, err
+	}
+	return &SbomServiceImpl{
+		logger:                   logger,
+		config:                   config,
+		ciCdConfig:               ciConfig.CiCdConfig,
+		ciArtifactSbomRepository: ciArtifactSbomRepository,
+		ciArtifactRepository:     ciArtifactRepository,
+		ciPipelineRepository:     ciPipelineRepository,
+	}, nil
+}
+
+func (impl *SbomServiceImpl) GetSbomGenerationConfig(ciWorkflowId int, blobStorageEnabled bool) *bean.SbomGenerationConfig {
+	if !impl.config.GenerationEnabled {
+		return nil
+	}
+	generationConfig := &bean.SbomGenerationConfig{
+		Format: impl.config.Format,
+	}
+	if blobStorageEnabled {
+		generationConfig.BlobStorageKey = fmt.Sprintf(bean.BlobStorageKeyPattern, impl.config.BlobStorageKeyPrefix, ciWorkflowId)
+	}
+	return generationConfig
+}
+
+func (impl *SbomServiceImpl) SaveArtifactSbom(ciArtifactId int, sbom *bean.Sbom, userId int32) error {
+	if len(sbom.Content) == 0 && len(sbom.BlobStorageKey) == 0 {
+		return nil
+	}
+	model := &repository.CiArtifactSbom{
+		CiArtifactId:   ciArtifactId,
+		Format:         string(sbom.Format),
+		Source:         string(sbom.Source),
+		BlobStorageKey: sbom.BlobStorageKey,
+		ComponentCount: len(sbom.Components),
+		AuditLog:       sql.NewDefaultAuditLog(userId),
+	}
+	if len(sbom.BlobStorageKey) == 0 {
+		model.Content = string(sbom.Content)
+	}
+	tx, err := impl.ciArtifactSbomRepository.StartTx()
+	if err != nil {
+		impl.logger.Errorw("error in starting transaction", "err", err)
+		return err
+	}
+	defer impl.ciArtifactSbomRepository.RollbackTx(tx)
+	err = impl.ciArtifactSbomRepository.Save(model, tx)
+	if err != nil {
+		impl.logger.Errorw("error in saving artifact sbom", "ciArtifactId", ciArtifactId, "format", sbom.Format, "err", err)
+		return err
+	}
+	components := make([]*repository.CiArtifactSbomComponent, 0, len(sbom.Components))
+	for _, component := range sbom.Components {
+		if component == nil || len(component.Name) == 0 {
+			continue
+		}
+		components = append(components, &repository.CiArtifactSbomComponent{
+			CiArtifactSbomId: model.Id,
+			CiArtifactId:     ciArtifactId,
+			Name:             component.Name,
+			Version:          component.Version,
+			Purl:             component.Purl,
+			Type:             component.Type,
+		})
+	}
+	err = impl.ciArtifactSbomRepository.SaveComponents(components, tx)
+	if err != nil {
+		impl.logger.Errorw("error in saving artifact sbom components", "ciArtifactId", ciArtifactId, "err", err)
+		return err
+	}
+	return impl.ciArtifactSbomRepository.CommitTx(tx)
+}
+
+func (impl *SbomServiceImpl) GetArtifactSboms(ciArtifactId int) (*bean.ArtifactSbomResponse, error) {
+	ciArtifact, err := impl.ciArtifactRepository.Get(ciArtifactId)
+	if util.IsErrNoRows(err) {
+		errMsg := fmt.Sprintf("artifact %d not found", ciArtifactId)
+		return nil, util.NewApiError(http.StatusNotFound, errMsg, errMsg)
+	} else if err != nil {
+		impl.logger.Errorw("error in fetching ci artifact", "ciArtifactId", ciArtifactId, "err", err)
+		return nil, err
+	}
+	response := &bean.ArtifactSbomResponse{
+		CiArtifactId: ciArtifactId,
+		Image:        ciArtifact.Image,
+		Sboms:        make([]*bean.ArtifactSbom, 0),
+	}
+	if ciArtifact.PipelineId > 0 {
+		ciPipeline, err := impl.ciPipelineRepository.FindByIdIncludingInActive(ciArtifact.PipelineId)
+		if err != nil {
+			impl.logger.Errorw("error in fetching ci pipeline of artifact", "ciArtifactId", ciArtifactId, "ciPipelineId", ciArtifact.PipelineId, "err", err)
+			return nil, err
+		}
+		response.AppId = ciPipeline.AppId
+	}
+	models, err := impl.ciArtifactSbomRepository.FindByCiArtifactId(ciArtifactId)
+	if err != nil {
+		impl.logger.Errorw("error in fetching artifact sboms", "ciArtifactId", ciArtifactId, "err", err)
+		return nil, err
+	}
+	for _, model := range models {
+		response.Sboms = append(response.Sboms, toArtifactSbom(model))
+	}
+	return response, nil
+}
+
+func (impl *SbomServiceImpl) DownloadArtifactSbom(ciArtifactId, sbomId int) ([]byte, *bean.ArtifactSbom, error) {
+	model, err := impl.ciArtifactSbomRepository.FindById(sbomId)
+	if util.IsErrNoRows(err) || (err == nil && model.CiArtifactId != ciArtifactId) {
+		errMsg := fmt.Sprintf("sbom %d not found for artifact %d", sbomId, ciArtifactId)
+		return nil, nil, util.NewApiError(http.StatusNotFound, errMsg, errMsg)
+	} else if err != nil {
+		impl.logger.Errorw("error in fetching artifact sbom", "sbomId", sbomId, "err", err)
+		return nil, nil, err
+	}
+	if len(model.BlobStorageKey) == 0 {
+		return []byte(model.Content), toArtifactSbom(model), nil
+	}
+	content, err := impl.downloadFromBlobStorage(model)
+	if err != nil {
+		return nil, nil, err
+	}
+	return content, toArtifactSbom(model), nil
+}
+
+func (impl *SbomServiceImpl) downloadFromBlobStorage(model *repository.CiArtifactSbom) ([]byte, error) {
+	if !util2.IsValidUrlSubPath(model.BlobStorageKey) {
+		impl.logger.Errorw("invalid sbom blob storage key", "sbomId", model.Id, "key", model.BlobStorageKey)
+		return nil, errors.New("invalid sbom location")
+	}
+	bucket := impl.ciCdConfig.GetDefaultBuildLogsBucket()
+	// a file per download so that concurrent downloads of the same sbom do not read each other's partial writes
+	destinationFile, err := os.CreateTemp(impl.ciCdConfig.BaseLogLocationPath, "sbom-*.json")
+	if err != nil {
+		impl.logger.Errorw("error in creating file to download sbom", "sbomId", model.Id, "err", err)
+		return nil, err
+	}
+	destinationKey := destinationFile.Name()
+	defer func() {
+		if removeErr := os.Remove(destinationKey); removeErr != nil && !os.IsNotExist(removeErr) {
+			impl.logger.Warnw("error in removing downloaded sbom file", "file", destinationKey, "err", removeErr)
+		}
+	}()
+	if err = destinationFile.Close(); err != nil {
+		impl.logger.Errorw("error in closing file to download sbom", "file", destinationKey, "err", err)
+		return nil, err
+	}
+	request := &blob_storage.BlobStorageRequest{
+		StorageType:    impl.ciCdConfig.CloudProvider,
+		SourceKey:      model.BlobStorageKey,
+		DestinationKey: destinationKey,
+		AzureBlobBaseConfig: &blob_storage.AzureBlobBaseConfig{
+			Enabled:           impl.ciCdConfig.CloudProvider == types.BLOB_STORAGE_AZURE,
+			AccountName:       impl.ciCdConfig.AzureAccountName,
+			BlobContainerName: impl.ciCdConfig.AzureBlobContainerCiLog,
+			AccountKey:        impl.ciCdConfig.AzureAccountKey,
+		},
+		AwsS3BaseConfig: &blob_storage.AwsS3BaseConfig{
+			AccessKey:         impl.ciCdConfig.BlobStorageS3AccessKey,
+			Passkey:           impl.ciCdConfig.BlobStorageS3SecretKey,
+			EndpointUrl:       impl.ciCdConfig.BlobStorageS3Endpoint,
+			IsInSecure:        impl.ciCdConfig.BlobStorageS3EndpointInsecure,
+			BucketName:        bucket,
+			Region:            impl.ciCdConfig.DefaultCacheBucketRegion,
+			VersioningEnabled: impl.ciCdConfig.BlobStorageS3BucketVersioned,
+		},
+		GcpBlobBaseConfig: &blob_storage.GcpBlobBaseConfig{
+			BucketName:             bucket,
+			CredentialFileJsonData: impl.ciCdConfig.BlobStorageGcpCredentialJson,
+		},
+	}
+	blobStorageService := blob_storage.NewBlobStorageServiceImpl(impl.logger)
+	_, _, err = blobStorageService.Get(request)
+	if err != nil {
+		impl.logger.Errorw("error in downloading sbom from blob storage", "sbomId", model.Id, "key", model.BlobStorageKey, "err", err)
+		return nil, errors.New("failed to download sbom")
+	}
+	content, err := os.ReadFile(destinationKey)
+	if err != nil {
+		impl.logger.Errorw("error in reading downloaded sbom file", "file", destinationKey, "err", err)
+		return nil, err
+	}
+	return content, nil
+}
+
+func (impl *SbomServiceImpl) GetComponentAppIds(request *bean.SbomSearchRequest) ([]int, error) {
+	appIds, err := impl.ciArtifactSbomRepository.FindComponentAppIds(request.Component, request.Version)
+	if err != nil {
+		impl.logger.Errorw("error in finding apps having sbom components", "request", request, "err", err)
+		return nil, err
+	}
+	return appIds, nil
+}
+
+func (impl *SbomServiceImpl) SearchComponent(request *bean.SbomSearchRequest) ([]*bean.SbomSearchResult, error) {
+	size := request.Size
+	if size == 0 {
+		size = defaultSbomSearchSize
+	}
+	matches, err := impl.ciArtifactSbomRepository.FindComponentMatches(&repository.SbomComponentSearchFilter{
+		Component:    request.Component,
+		Version:      request.Version,
+		AppIds:       request.AppIds,
+		DeployedOnly: request.DeployedOnly,
+		EnvIds:       request.EnvIds,
+		Limit:        size,
+		Offset:       request.Offset,
+	})
+	if err != nil {
+		impl.logger.Errorw("error in searching sbom components", "request", request, "err", err)
+		return nil, err
+	}
+	results := make([]*bean.SbomSearchResult, 0)
+	resultMap := make(map[int]*bean.SbomSearchResult)
+	for _, match := range matches {
+		result, ok := resultMap[match.CiArtifactId]
+		if !ok {
+			result = &bean.SbomSearchResult{
+				CiArtifactId: match.CiArtifactId,
+				Image:        match.Image,
+				AppId:        match.AppId,
+				AppName:      match.AppName,
+				CiPipelineId: match.CiPipelineId,
+				Components:   make([]*bean.SbomComponent, 0),
+				Deployments:  make([]*bean.SbomArtifactDeployment, 0),
+			}
+			resultMap[match.CiArtifactId] = result
+			results = append(results, result)
+		}
+		result.Components = append(result.Components, &bean.SbomComponent{
+			Name:    match.Name,
+			Version: match.Version,
+			Purl:    match.Purl,
+			Type:    match.Type,
+		})
+	}
+	ciArtifactIds := make([]int, 0, len(results))
+	for _, result := range results {
+		ciArtifactIds = append(ciArtifactIds, result.CiArtifactId)
+	}
+	deployments, err := impl.ciArtifactSbomRepository.FindDeploymentsByCiArtifactIds(ciArtifactIds)
+	if err != nil {
+		impl.logger.Errorw("error in fetching deployments of artifacts", "ciArtifactIds", ciArtifactIds, "err", err)
+		return nil, err
+	}
+	for _, deployment := range deployments {
+		if len(request.EnvIds) > 0 && !slices.Contains(request.EnvIds, deployment.EnvId) {
+			continue
+		}
+		resultMap[deployment.CiArtifactId].Deployments = append(resultMap[deployment.CiArtifactId].Deployments, &bean.SbomArtifactDeployment{
+			AppId:           deployment.AppId,
+			EnvId:           deployment.EnvId,
+			EnvironmentName: deployment.EnvironmentName,
+			ClusterId:       deployment.ClusterId,
+		})
+	}
+	return results, nil
+}
+
+func toArtifactSbom(model *repository.CiArtifactSbom) *bean.ArtifactSbom {
+	return &bean.ArtifactSbom{
+		Id:             model.Id,
+		Format:         bean.SbomFormat(model.Format),
+		Source:         bean.SbomSource(model.Source),
+		ComponentCount: model.ComponentCount,
+		CreatedOn:      model.CreatedOn,
+	}
+}
@@ -0,0 +1,56 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sbom
+
+import (
+	"testing"
+
+	"github.com/devtron-labs/devtron/pkg/build/artifacts/sbom/bean"
+	"github.com/devtron-labs/devtron/pkg/build/artifacts/sbom/repository"
+	"github.com/devtron-labs/devtron/pkg/build/artifacts/sbom/repository/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func TestSearchComponent(t *testing.T) {
+	// authorised apps and deployment filters are pushed into the paginated query
+	filter := &repository.SbomComponentSearchFilter{
+		Component: "log4j", Version: "2.14", AppIds: []int{1, 2}, DeployedOnly: true, EnvIds: []int{4}, Limit: defaultSbomSearchSize, Offset: 100,
+	}
+	sbomRepository := mocks.NewCiArtifactSbomRepository(t)
+	sbomRepository.On("FindComponentMatches", filter).Return([]*repository.SbomComponentMatch{
+		{CiArtifactId: 12, AppId: 1, AppName: "payments", Name: "log4j-core", Version: "2.14.1"},
+		{CiArtifactId: 12, AppId: 1, AppName: "payments", Name: "log4j-api", Version: "2.14.1"},
+		{CiArtifactId: 9, AppId: 2, AppName: "orders", Name: "log4j-core", Version: "2.14.0"},
+	}, nil).Once()
+	sbomRepository.On("FindDeploymentsByCiArtifactIds", []int{12, 9}).Return([]*repository.SbomArtifactDeployment{
+		{CiArtifactId: 12, AppId: 1, EnvId: 3},
+		{CiArtifactId: 12, AppId: 1, EnvId: 4},
+		{CiArtifactId: 9, AppId: 2, EnvId: 4},
+	}, nil).Once()
+	impl := &SbomServiceImpl{logger: zap.NewNop().Sugar(), ciArtifactSbomRepository: sbomRepository}
+
+	results, err := impl.SearchComponent(&bean.SbomSearchRequest{Component: "log4j", Version: "2.14", DeployedOnly: true, EnvIds: []int{4}, Offset: 100, AppIds: []int{1, 2}})
+	assert.NoError(t, err)
+	assert.Len(t, results, 2)
+	assert.Equal(t, 12, results[0].CiArtifactId)
+	assert.Len(t, results[0].Components, 2)
+	assert.Len(t, results[0].Deployments, 1)
+	assert.Equal(t, 4, results[0].Deployments[0].EnvId)
+	assert.Equal(t, 9, results[1].CiArtifactId)
+	assert.Len(t, results[1].Components, 1)
+}
@@ -0,0 +1,116 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package bean
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type SbomConfig struct {
+	GenerationEnabled    bool       `env:"SBOM_GENERATION_ENABLED" envDefault:"false" description:"Generate an SBOM of every image built in ci and attach it to the artifact" deprecated:"false"`
+	Format               SbomFormat `env:"SBOM_FORMAT" envDefault:"cyclonedx-json" description:"Format of the generated SBOM, cyclonedx-json or spdx-json" deprecated:"false"`
+	BlobStorageKeyPrefix string     `env:"SBOM_BLOB_STORAGE_KEY_PREFIX" envDefault:"sbom" description:"Key prefix of the SBOMs uploaded to the ci blob storage" deprecated:"false"`
+}
+
+type SbomFormat string
+
+const (
+	SbomFormatCycloneDxJson SbomFormat = "cyclonedx-json"
+	SbomFormatSpdxJson      SbomFormat = "spdx-json"
+	SbomFormatSyftJson      SbomFormat = "syft-json"
+)
+
+type SbomSource string
+
+const (
+	// SbomSourceBuildpack is the SBOM written by the buildpacks in the image layers during the build
+	SbomSourceBuildpack SbomSource = "buildpack"
+	// SbomSourceGenerated is the SBOM generated by ci runner from the built image
+	SbomSourceGenerated SbomSource = "generated"
+)
+
+// BlobStorageKeyPattern is the key ci runner uploads the SBOM to, <prefix>/<ciWorkflowId>/sbom.json
+const BlobStorageKeyPattern = "%s/%d/sbom.json"
+
+// SbomGenerationConfig tells ci runner to generate an SBOM of the built image
+type SbomGenerationConfig struct {
+	Format SbomFormat `json:"format"`
+	// BlobStorageKey is empty when blob storage is not configured, the SBOM is then sent inline in the ci complete event
+	BlobStorageKey string `json:"blobStorageKey,omitempty"`
+}
+
+// Sbom is the SBOM reported by the ci runner on build completion
+type Sbom struct {
+	Format         SbomFormat       `json:"format"`
+	Source         SbomSource       `json:"source"`
+	Content        json.RawMessage  `json:"content,omitempty"`
+	BlobStorageKey string           `json:"blobStorageKey,omitempty"`
+	Components     []*SbomComponent `json:"components,omitempty"`
+}
+
+type SbomComponent struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	Purl    string `json:"purl,omitempty"`
+	Type    string `json:"type,omitempty"`
+}
+
+type ArtifactSbom struct {
+	Id             int        `json:"id"`
+	Format         SbomFormat `json:"format"`
+	Source         SbomSource `json:"source"`
+	ComponentCount int        `json:"componentCount"`
+	CreatedOn      time.Time  `json:"createdOn"`
+}
+
+type ArtifactSbomResponse struct {
+	CiArtifactId int             `json:"ciArtifactId"`
+	AppId        int             `json:"appId"`
+	Image        string          `json:"image"`
+	Sboms        []*ArtifactSbom `json:"sboms"`
+}
+
+type SbomSearchRequest struct {
+	// Component matches a part of the package name, case insensitive
+	Component string `json:"component" validate:"required,min=2"`
+	// Version matches the exact version or the versions it is a prefix of, 2.14 matches 2.14.1
+	Version      string `json:"version,omitempty"`
+	DeployedOnly bool   `json:"deployedOnly"`
+	EnvIds       []int  `json:"envIds,omitempty"`
+	Size         int    `json:"size,omitempty" validate:"omitempty,min=1,max=500"`
+	Offset       int    `json:"offset,omitempty" validate:"omitempty,min=0"`
+	// AppIds are the apps the user can view, artifacts of other apps are left out before pagination
+	AppIds []int `json:"-"`
+}
+
+type SbomSearchResult struct {
+	CiArtifactId int                       `json:"ciArtifactId"`
+	Image        string                    `json:"image"`
+	AppId        int                       `json:"appId"`
+	AppName      string                    `json:"appName"`
+	CiPipelineId int                       `json:"ciPipelineId"`
+	Components   []*SbomComponent          `json:"components"`
+	Deployments  []*SbomArtifactDeployment `json:"deployments"`
+}
+
+type SbomArtifactDeployment struct {
+	AppId           int    `json:"appId"`
+	EnvId           int    `json:"envId"`
+	EnvironmentName string `json:"environmentName"`
+	ClusterId       int    `json:"clusterId"`
+}
@@ -0,0 +1,223 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package repository
+
+import (
+	"github.com/devtron-labs/devtron/pkg/policyGovernance/security/imageScanning/repository"
+	"github.com/devtron-labs/devtron/pkg/sql"
+	"github.com/go-pg/pg"
+	"go.uber.org/zap"
+	"strings"
+)
+
+type CiArtifactSbom struct {
+	TableName      struct{} `sql:"ci_artifact_sbom" pg:",discard_unknown_columns"`
+	Id             int      `sql:"id,pk"`
+	CiArtifactId   int      `sql:"ci_artifact_id,notnull"`
+	Format         string   `sql:"format,notnull"`
+	Source         string   `sql:"source,notnull"`
+	Content        string   `sql:"content"`
+	BlobStorageKey string   `sql:"blob_storage_key"`
+	ComponentCount int      `sql:"component_count,notnull"`
+	sql.AuditLog
+}
+
+type CiArtifactSbomComponent struct {
+	TableName        struct{} `sql:"ci_artifact_sbom_component" pg:",discard_unknown_columns"`
+	Id               int      `sql:"id,pk"`
+	CiArtifactSbomId int      `sql:"ci_artifact_sbom_id,notnull"`
+	CiArtifactId     int      `sql:"ci_artifact_id,notnull"`
+	Name             string   `sql:"name,notnull"`
+	Version          string   `sql:"version"`
+	Purl             string   `sql:"purl"`
+	Type             string   `sql:"type"`
+}
+
+type SbomComponentMatch struct {
+	CiArtifactId int    `sql:"ci_artifact_id"`
+	Image        string `sql:"image"`
+	CiPipelineId int    `sql:"ci_pipeline_id"`
+	AppId        int    `sql:"app_id"`
+	AppName      string `sql:"app_name"`
+	Name         string `sql:"name"`
+	Version      string `sql:"version"`
+	Purl         string `sql:"purl"`
+	Type         string `sql:"type"`
+}
+
+// SbomComponentSearchFilter selects the artifacts having a matching component, the artifacts are paginated after
+// the app and deployment filters are applied so that a page is never emptied by filtering
+type SbomComponentSearchFilter struct {
+	Component string
+	Version   string
+	// AppIds limits the artifacts to the ones built by these apps, no artifacts are matched if it is empty
+	AppIds []int
+	// DeployedOnly limits the artifacts to the ones running in an environment, in one of EnvIds if set
+	DeployedOnly bool
+	EnvIds       []int
+	Limit        int
+	Offset       int
+}
+
+type SbomArtifactDeployment struct {
+	CiArtifactId    int    `sql:"ci_artifact_id"`
+	AppId           int    `sql:"app_id"`
+	EnvId           int    `sql:"env_id"`
+	EnvironmentName string `sql:"environment_name"`
+	ClusterId       int    `sql:"cluster_id"`
+}
+
+type CiArtifactSbomRepository interface {
+	sql.TransactionWrapper
+	Save(sbom *CiArtifactSbom, tx *pg.Tx) error
+	SaveComponents(components []*CiArtifactSbomComponent, tx *pg.Tx) error
+	FindById(id int) (*CiArtifactSbom, error)
+	// FindByCiArtifactId returns the sboms of an artifact without their inline content
+	FindByCiArtifactId(ciArtifactId int) ([]*CiArtifactSbom, error)
+	// FindComponentAppIds returns the ids of the active apps having an artifact with a matching component
+	FindComponentAppIds(component, version string) ([]int, error)
+	FindComponentMatches(filter *SbomComponentSearchFilter) ([]*SbomComponentMatch, error)
+	// FindDeploymentsByCiArtifactIds returns the app environments currently running the artifacts, as recorded in image scan deploy info
+	FindDeploymentsByCiArtifactIds(ciArtifactIds []int) ([]*SbomArtifactDeployment, error)
+}
+
+type CiArtifactSbomRepositoryImpl struct {
+	*sql.TransactionUtilImpl
+	dbConnection *pg.DB
+	logger       *zap.SugaredLogger
+}
+
+func NewCiArtifactSbomRepositoryImpl(dbConnection *pg.DB, logger *zap.SugaredLogger, TransactionUtilImpl *sql.TransactionUtilImpl) *CiArtifactSbomRepositoryImpl {
+	return &CiArtifactSbomRepositoryImpl{
+		TransactionUtilImpl: TransactionUtilImpl,
+		dbConnection:        dbConnection,
+		logger:              logger,
+	}
+}
+
+func (impl *CiArtifactSbomRepositoryImpl) Save(sbom *CiArtifactSbom, tx *pg.Tx) error {
+	return tx.Insert(sbom)
+}
+
+func (impl *CiArtifactSbomRepositoryImpl) SaveComponents(components []*CiArtifactSbomComponent, tx *pg.Tx) error {
+	if len(components) == 0 {
+		return nil
+	}
+	_, err := tx.Model(&components).Insert()
+	return err
+}
+
+func (impl *CiArtifactSbomRepositoryImpl) FindById(id int) (*CiArtifactSbom, error) {
+	sbom := &CiArtifactSbom{}
+	err := impl.dbConnection.Model(sbom).
+		Where("id = ?", id).
+		Select()
+	return sbom, err
+}
+
+func (impl *CiArtifactSbomRepositoryImpl) FindByCiArtifactId(ciArtifactId int) ([]*CiArtifactSbom, error) {
+	var sboms []*CiArtifactSbom
+	err := impl.dbConnection.Model(&sboms).
+		Column("id", "ci_artifact_id", "format", "source", "blob_storage_key", "component_count", "created_on", "created_by", "updated_on", "updated_by").
+		Where("ci_artifact_id = ?", ciArtifactId).
+		Order("id DESC").
+		Select()
+	return sboms, err
+}
+
+func (impl *CiArtifactSbomRepositoryImpl) FindComponentAppIds(component, version string) ([]int, error) {
+	var appIds []int
+	query := "SELECT DISTINCT cp.app_id" +
+		" FROM ci_artifact_sbom_component c" +
+		" INNER JOIN ci_artifact ca ON ca.id = c.ci_artifact_id" +
+		" INNER JOIN ci_pipeline cp ON cp.id = ca.pipeline_id" +
+		" INNER JOIN app a ON a.id = cp.app_id AND a.active = true" +
+		" WHERE c.name ILIKE ?"
+	params := []interface{}{"%" + escapeLikePattern(component) + "%"}
+	if len(version) > 0 {
+		query += " AND (c.version = ? OR c.version LIKE ?)"
+		params = append(params, version, escapeLikePattern(version)+".%")
+	}
+	query += ";"
+	_, err := impl.dbConnection.Query(&appIds, query, params...)
+	return appIds, err
+}
+
+func (impl *CiArtifactSbomRepositoryImpl) FindComponentMatches(filter *SbomComponentSearchFilter) ([]*SbomComponentMatch, error) {
+	var matches []*SbomComponentMatch
+	if len(filter.AppIds) == 0 {
+		return matches, nil
+	}
+	componentCondition := " AND c.name ILIKE ?"
+	componentParams := []interface{}{"%" + escapeLikePattern(filter.Component) + "%"}
+	if len(filter.Version) > 0 {
+		componentCondition += " AND (c.version = ? OR c.version LIKE ?)"
+		componentParams = append(componentParams, filter.Version, escapeLikePattern(filter.Version)+".%")
+	}
+	// artifacts are filtered by app and deployment before the page is cut
+	artifactQuery := "SELECT DISTINCT c.ci_artifact_id" +
+		" FROM ci_artifact_sbom_component c" +
+		" INNER JOIN ci_artifact ca ON ca.id = c.ci_artifact_id" +
+		" INNER JOIN ci_pipeline cp ON cp.id = ca.pipeline_id" +
+		" INNER JOIN app a ON a.id = cp.app_id AND a.active = true" +
+		" WHERE cp.app_id IN (?)" + componentCondition
+	params := append([]interface{}{pg.In(filter.AppIds)}, componentParams...)
+	if filter.DeployedOnly || len(filter.EnvIds) > 0 {
+		artifactQuery += " AND EXISTS (SELECT 1 FROM image_scan_execution_history h" +
+			" INNER JOIN image_scan_deploy_info idi ON idi.object_type = ? AND h.id = ANY(idi.image_scan_execution_history_id)" +
+			" INNER JOIN environment e ON e.id = idi.env_id AND e.active = true" +
+			" WHERE h.image = ca.image"
+		params = append(params, repository.ScanObjectType_APP)
+		if len(filter.EnvIds) > 0 {
+			artifactQuery += " AND idi.env_id IN (?)"
+			params = append(params, pg.In(filter.EnvIds))
+		}
+		artifactQuery += ")"
+	}
+	artifactQuery += " ORDER BY c.ci_artifact_id DESC LIMIT ? OFFSET ?"
+	params = append(params, filter.Limit, filter.Offset)
+
+	query := "SELECT c.ci_artifact_id, ca.image, ca.pipeline_id AS ci_pipeline_id, cp.app_id, a.app_name, c.name, c.version, c.purl, c.type" +
+		" FROM ci_artifact_sbom_component c" +
+		" INNER JOIN ci_artifact ca ON ca.id = c.ci_artifact_id" +
+		" INNER JOIN ci_pipeline cp ON cp.id = ca.pipeline_id" +
+		" INNER JOIN app a ON a.id = cp.app_id" +
+		" WHERE c.ci_artifact_id IN (" + artifactQuery + ")" + componentCondition +
+		" ORDER BY c.ci_artifact_id DESC, c.name;"
+	params = append(params, componentParams...)
+	_, err := impl.dbConnection.Query(&matches, query, params...)
+	return matches, err
+}
+
+func (impl *CiArtifactSbomRepositoryImpl) FindDeploymentsByCiArtifactIds(ciArtifactIds []int) ([]*SbomArtifactDeployment, error) {
+	var deployments []*SbomArtifactDeployment
+	if len(ciArtifactIds) == 0 {
+		return deployments, nil
+	}
+	query := "SELECT DISTINCT ca.id AS ci_artifact_id, idi.scan_object_meta_id AS app_id, idi.env_id, e.environment_name, idi.cluster_id" +
+		" FROM ci_artifact ca" +
+		" INNER JOIN image_scan_execution_history h ON h.image = ca.image" +
+		" INNER JOIN image_scan_deploy_info idi ON idi.object_type = ? AND h.id = ANY(idi.image_scan_execution_history_id)" +
+		" INNER JOIN environment e ON e.id = idi.env_id AND e.active = true" +
+		" WHERE ca.id IN (?);"
+	_, err := impl.dbConnection.Query(&deployments, query, repository.ScanObjectType_APP, pg.In(ciArtifactIds))
+	return deployments, err
+}
+
+func escapeLikePattern(value string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(value)
+}
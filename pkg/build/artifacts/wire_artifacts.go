@@ -16,9 +16,23 @@
 
 package artifacts
 
-import "github.com/google/wire"
+import (
+	"github.com/devtron-labs/devtron/pkg/build/artifacts/provenance"
+	repository2 "github.com/devtron-labs/devtron/pkg/build/artifacts/provenance/repository"
+	"github.com/devtron-labs/devtron/pkg/build/artifacts/sbom"
+	"github.com/devtron-labs/devtron/pkg/build/artifacts/sbom/repository"
+	"github.com/google/wire"
+)
 
 var WireSet = wire.NewSet(
+	repository.NewCiArtifactSbomRepositoryImpl,
+	wire.Bind(new(repository.CiArtifactSbomRepository), new(*repository.CiArtifactSbomRepositoryImpl)),
+	sbom.NewSbomServiceImpl,
+	wire.Bind(new(sbom.SbomService), new(*sbom.SbomServiceImpl)),
+	repository2.NewCiArtifactProvenanceRepositoryImpl,
+	wire.Bind(new(repository2.CiArtifactProvenanceRepository), new(*repository2.CiArtifactProvenanceRepositoryImpl)),
+	provenance.NewProvenanceServiceImpl,
+	wire.Bind(new(provenance.ProvenanceService), new(*provenance.ProvenanceServiceImpl)),
 	NewCommonArtifactServiceImpl,
 	wire.Bind(new(CommonArtifactService), new(*CommonArtifactServiceImpl)),
 )
@@ -0,0 +1,521 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package buildpack
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/Masterminds/semver"
+	"github.com/caarlos0/env"
+	"github.com/devtron-labs/devtron/internal/util"
+	"github.com/devtron-labs/devtron/pkg/build/buildpack/bean"
+	"github.com/devtron-labs/devtron/pkg/build/buildpack/repository"
+	buildBean "github.com/devtron-labs/devtron/pkg/build/pipeline/bean"
+	"github.com/devtron-labs/devtron/pkg/sql"
+	"go.uber.org/zap"
+	"net/http"
+	"strings"
+)
+
+// BuildpackCatalogueService manages the approved builder images and buildpacks and the per language defaults
+// applied to buildpack ci build configs
+type BuildpackCatalogueService interface {
+	GetCatalogue() (*bean.BuildpackCatalogue, error)
+	SaveBuilder(builder *bean.BuilderImage, userId int32) (*bean.BuilderImage, error)
+	DeleteBuilder(id int, userId int32) error
+	SaveBuildpack(buildpack *bean.Buildpack, userId int32) (*bean.Buildpack, error)
+	DeleteBuildpack(id int, userId int32) error
+	SaveLanguageDefault(languageDefault *bean.LanguageDefault, userId int32) (*bean.LanguageDefault, error)
+	DeleteLanguageDefault(id int, userId int32) error
+	// ApplyCatalogue fills the builder and buildpacks of the config from the language default when the builder is not set,
+	// and validates them against the catalogue when approved builders are enforced
+	ApplyCatalogue(buildPackConfig *buildBean.BuildPackConfig) error
+	// GetOutdatedBuilderUsages lists the ci pipelines building with a deprecated, outdated or unknown builder
+	GetOutdatedBuilderUsages() ([]*bean.OutdatedBuilderUsage, error)
+	IsSbomExtractionEnabled() bool
+}
+
+type BuildpackCatalogueServiceImpl struct {
+	logger                       *zap.SugaredLogger
+	config                       *bean.BuildpackCatalogueConfig
+	buildpackCatalogueRepository repository.BuildpackCatalogueRepository
+}
+
+func NewBuildpackCatalogueServiceImpl(logger *zap.SugaredLogger,
+	buildpackCatalogueRepository repository.BuildpackCatalogueRepository) (*BuildpackCatalogueServiceImpl, error) {
+	config := &bean.BuildpackCatalogueConfig{}
+	err := env.Parse(config)
+	if err != nil {
+		logger.Errorw("error in parsing buildpack catalogue config", "err", err)
+		return nil, err
+	}
+	return &BuildpackCatalogueServiceImpl{
+		logger:                       logger,
+		config:                       config,
+		buildpackCatalogueRepository: buildpackCatalogueRepository,
+	}, nil
+}
+
+func (impl *BuildpackCatalogueServiceImpl) IsSbomExtractionEnabled() bool {
+	return impl.config.ExtractSbom
+}
+
+func (impl *BuildpackCatalogueServiceImpl) GetCatalogue() (*bean.BuildpackCatalogue, error) {
+	builderModels, err := impl.buildpackCatalogueRepository.FindAllBuilders()
+	if err != nil {
+		impl.logger.Errorw("error in fetching buildpack builders", "err", err)
+		return nil, err
+	}
+	buildpackModels, err := impl.buildpackCatalogueRepository.FindAllBuildpacks()
+	if err != nil {
+		impl.logger.Errorw("error in fetching buildpacks", "err", err)
+		return nil, err
+	}
+	languageDefaultModels, err := impl.buildpackCatalogueRepository.FindAllLanguageDefaults()
+	if err != nil {
+		impl.logger.Errorw("error in fetching buildpack language defaults", "err", err)
+		return nil, err
+	}
+	catalogue := &bean.BuildpackCatalogue{
+		Builders:         make([]*bean.BuilderImage, 0, len(builderModels)),
+		Buildpacks:       make([]*bean.Buildpack, 0, len(buildpackModels)),
+		LanguageDefaults: make([]*bean.LanguageDefault, 0, len(languageDefaultModels)),
+	}
+	builderIdMap := make(map[int]*bean.BuilderImage, len(builderModels))
+	for _, builderModel := range builderModels {
+		builder := toBuilderImage(builderModel)
+		builderIdMap[builder.Id] = builder
+		catalogue.Builders = append(catalogue.Builders, builder)
+	}
+	for _, buildpackModel := range buildpackModels {
+		catalogue.Buildpacks = append(catalogue.Buildpacks, toBuildpack(buildpackModel))
+	}
+	for _, languageDefaultModel := range languageDefaultModels {
+		languageDefault := toLanguageDefault(languageDefaultModel)
+		if builder, ok := builderIdMap[languageDefault.BuilderId]; ok {
+			languageDefault.Builder = builder.Reference()
+		}
+		catalogue.LanguageDefaults = append(catalogue.LanguageDefaults, languageDefault)
+	}
+	return catalogue, nil
+}
+
+func (impl *BuildpackCatalogueServiceImpl) SaveBuilder(builder *bean.BuilderImage, userId int32) (*bean.BuilderImage, error) {
+	builder.Image = strings.TrimSpace(builder.Image)
+	builder.Version = strings.TrimSpace(builder.Version)
+	builderModels, err := impl.buildpackCatalogueRepository.FindAllBuilders()
+	if err != nil {
+		impl.logger.Errorw("error in fetching buildpack builders", "err", err)
+		return nil, err
+	}
+	var model *repository.BuildpackBuilder
+	for _, builderModel := range builderModels {
+		if builderModel.Id == builder.Id {
+			model = builderModel
+		} else if builderModel.Image == builder.Image && builderModel.Version == builder.Version {
+			errMsg := fmt.Sprintf("builder %s is already in the catalogue", builder.Reference())
+			return nil, util.NewApiError(http.StatusConflict, errMsg, errMsg)
+		}
+	}
+	if builder.Id > 0 && model == nil {
+		errMsg := fmt.Sprintf("builder %d not found", builder.Id)
+		return nil, util.NewApiError(http.StatusNotFound, errMsg, errMsg)
+	}
+	isNew := model == nil
+	if isNew {
+		model = &repository.BuildpackBuilder{
+			Active:   true,
+			AuditLog: sql.NewDefaultAuditLog(userId),
+		}
+	}
+	model.Name = builder.Name
+	model.Image = builder.Image
+	model.Version = builder.Version
+	model.Description = builder.Description
+	model.Deprecated = builder.Deprecated
+	model.UpdateAuditLog(userId)
+	if isNew {
+		err = impl.buildpackCatalogueRepository.SaveBuilder(model)
+	} else {
+		err = impl.buildpackCatalogueRepository.UpdateBuilder(model)
+	}
+	if err != nil {
+		impl.logger.Errorw("error in saving buildpack builder", "builder", builder, "err", err)
+		return nil, err
+	}
+	return toBuilderImage(model), nil
+}
+
+func (impl *BuildpackCatalogueServiceImpl) DeleteBuilder(id int, userId int32) error {
+	model, err := impl.buildpackCatalogueRepository.FindBuilderById(id)
+	if util.IsErrNoRows(err) {
+		errMsg := fmt.Sprintf("builder %d not found", id)
+		return util.NewApiError(http.StatusNotFound, errMsg, errMsg)
+	} else if err != nil {
+		impl.logger.Errorw("error in fetching buildpack builder", "id", id, "err", err)
+		return err
+	}
+	languageDefaultCount, err := impl.buildpackCatalogueRepository.CountLanguageDefaultsByBuilderId(id)
+	if err != nil {
+		impl.logger.Errorw("error in fetching language defaults of builder", "id", id, "err", err)
+		return err
+	}
+	if languageDefaultCount > 0 {
+		errMsg := fmt.Sprintf("builder %s is the default of %d language(s), change the language defaults before deleting it", toBuilderImage(model).Reference(), languageDefaultCount)
+		return util.NewApiError(http.StatusConflict, errMsg, errMsg)
+	}
+	model.Active = false
+	model.UpdateAuditLog(userId)
+	err = impl.buildpackCatalogueRepository.UpdateBuilder(model)
+	if err != nil {
+		impl.logger.Errorw("error in deleting buildpack builder", "id", id, "err", err)
+		return err
+	}
+	return nil
+}
+
+func (impl *BuildpackCatalogueServiceImpl) SaveBuildpack(buildpack *bean.Buildpack, userId int32) (*bean.Buildpack, error) {
+	buildpack.BuildpackId = strings.TrimSpace(buildpack.BuildpackId)
+	buildpack.Version = strings.TrimSpace(buildpack.Version)
+	buildpackModels, err := impl.buildpackCatalogueRepository.FindAllBuildpacks()
+	if err != nil {
+		impl.logger.Errorw("error in fetching buildpacks", "err", err)
+		return nil, err
+	}
+	var model *repository.Buildpack
+	for _, buildpackModel := range buildpackModels {
+		if buildpackModel.Id == buildpack.Id {
+			model = buildpackModel
+		} else if buildpackModel.BuildpackId == buildpack.BuildpackId && buildpackModel.Version == buildpack.Version {
+			errMsg := fmt.Sprintf("buildpack %s is already in the catalogue", buildpack.Reference())
+			return nil, util.NewApiError(http.StatusConflict, errMsg, errMsg)
+		}
+	}
+	if buildpack.Id > 0 && model == nil {
+		errMsg := fmt.Sprintf("buildpack %d not found", buildpack.Id)
+		return nil, util.NewApiError(http.StatusNotFound, errMsg, errMsg)
+	}
+	isNew := model == nil
+	if isNew {
+		model = &repository.Buildpack{
+			Active:   true,
+			AuditLog: sql.NewDefaultAuditLog(userId),
+		}
+	}
+	model.BuildpackId = buildpack.BuildpackId
+	model.Version = buildpack.Version
+	model.Deprecated = buildpack.Deprecated
+	model.UpdateAuditLog(userId)
+	if isNew {
+		err = impl.buildpackCatalogueRepository.SaveBuildpack(model)
+	} else {
+		err = impl.buildpackCatalogueRepository.UpdateBuildpack(model)
+	}
+	if err != nil {
+		impl.logger.Errorw("error in saving buildpack", "buildpack", buildpack, "err", err)
+		return nil, err
+	}
+	return toBuildpack(model), nil
+}
+
+func (impl *BuildpackCatalogueServiceImpl) DeleteBuildpack(id int, userId int32) error {
+	model, err := impl.buildpackCatalogueRepository.FindBuildpackById(id)
+	if util.IsErrNoRows(err) {
+		errMsg := fmt.Sprintf("buildpack %d not found", id)
+		return util.NewApiError(http.StatusNotFound, errMsg, errMsg)
+	} else if err != nil {
+		impl.logger.Errorw("error in fetching buildpack", "id", id, "err", err)
+		return err
+	}
+	model.Active = false
+	model.UpdateAuditLog(userId)
+	err = impl.buildpackCatalogueRepository.UpdateBuildpack(model)
+	if err != nil {
+		impl.logger.Errorw("error in deleting buildpack", "id", id, "err", err)
+		return err
+	}
+	return nil
+}
+
+func (impl *BuildpackCatalogueServiceImpl) SaveLanguageDefault(languageDefault *bean.LanguageDefault, userId int32) (*bean.LanguageDefault, error) {
+	builderModel, err := impl.buildpackCatalogueRepository.FindBuilderById(languageDefault.BuilderId)
+	if util.IsErrNoRows(err) {
+		errMsg := fmt.Sprintf("builder %d not found", languageDefault.BuilderId)
+		return nil, util.NewApiError(http.StatusBadRequest, errMsg, errMsg)
+	} else if err != nil {
+		impl.logger.Errorw("error in fetching buildpack builder", "id", languageDefault.BuilderId, "err", err)
+		return nil, err
+	}
+	err = impl.validateBuildpacks(languageDefault.BuildPacks)
+	if err != nil {
+		return nil, err
+	}
+	model, err := impl.buildpackCatalogueRepository.FindLanguageDefaultByLanguage(languageDefault.Language, languageDefault.LanguageVersion)
+	if err != nil && !util.IsErrNoRows(err) {
+		impl.logger.Errorw("error in fetching buildpack language default", "language", languageDefault.Language, "err", err)
+		return nil, err
+	}
+	isNew := util.IsErrNoRows(err)
+	if isNew {
+		model = &repository.BuildpackLanguageDefault{
+			Language:        languageDefault.Language,
+			LanguageVersion: languageDefault.LanguageVersion,
+			Active:          true,
+			AuditLog:        sql.NewDefaultAuditLog(userId),
+		}
+	}
+	model.BuilderId = builderModel.Id
+	model.Buildpacks = strings.Join(languageDefault.BuildPacks, ",")
+	model.UpdateAuditLog(userId)
+	if isNew {
+		err = impl.buildpackCatalogueRepository.SaveLanguageDefault(model)
+	} else {
+		err = impl.buildpackCatalogueRepository.UpdateLanguageDefault(model)
+	}
+	if err != nil {
+		impl.logger.Errorw("error in saving buildpack language default", "languageDefault", languageDefault, "err", err)
+		return nil, err
+	}
+	savedLanguageDefault := toLanguageDefault(model)
+	savedLanguageDefault.Builder = toBuilderImage(builderModel).Reference()
+	return savedLanguageDefault, nil
+}
+
+func (impl *BuildpackCatalogueServiceImpl) DeleteLanguageDefault(id int, userId int32) error {
+	model, err := impl.buildpackCatalogueRepository.FindLanguageDefaultById(id)
+	if util.IsErrNoRows(err) {
+		errMsg := fmt.Sprintf("language default %d not found", id)
+		return util.NewApiError(http.StatusNotFound, errMsg, errMsg)
+	} else if err != nil {
+		impl.logger.Errorw("error in fetching buildpack language default", "id", id, "err", err)
+		return err
+	}
+	model.Active = false
+	model.UpdateAuditLog(userId)
+	err = impl.buildpackCatalogueRepository.UpdateLanguageDefault(model)
+	if err != nil {
+		impl.logger.Errorw("error in deleting buildpack language default", "id", id, "err", err)
+		return err
+	}
+	return nil
+}
+
+func (impl *BuildpackCatalogueServiceImpl) ApplyCatalogue(buildPackConfig *buildBean.BuildPackConfig) error {
+	if buildPackConfig == nil {
+		return nil
+	}
+	if len(strings.TrimSpace(buildPackConfig.BuilderId)) == 0 {
+		err := impl.applyLanguageDefault(buildPackConfig)
+		if err != nil {
+			return err
+		}
+	}
+	if !impl.config.EnforceApprovedBuilders {
+		return nil
+	}
+	if len(buildPackConfig.BuilderId) == 0 {
+		errMsg := fmt.Sprintf("builder is required, no default builder is configured for language %s", buildPackConfig.Language)
+		return util.NewApiError(http.StatusBadRequest, errMsg, errMsg)
+	}
+	builderModels, err := impl.buildpackCatalogueRepository.FindAllBuilders()
+	if err != nil {
+		impl.logger.Errorw("error in fetching buildpack builders", "err", err)
+		return err
+	}
+	if findBuilder(builderModels, buildPackConfig.BuilderId) == nil {
+		errMsg := fmt.Sprintf("builder %s is not in the approved builder catalogue", buildPackConfig.BuilderId)
+		return util.NewApiError(http.StatusBadRequest, errMsg, errMsg)
+	}
+	return impl.validateBuildpacks(buildPackConfig.BuildPacks)
+}
+
+func (impl *BuildpackCatalogueServiceImpl) applyLanguageDefault(buildPackConfig *buildBean.BuildPackConfig) error {
+	if len(buildPackConfig.Language) == 0 {
+		return nil
+	}
+	// a default pinned to the language version takes precedence over the one for all versions
+	languageDefault, err := impl.buildpackCatalogueRepository.FindLanguageDefaultByLanguage(buildPackConfig.Language, buildPackConfig.LanguageVersion)
+	if util.IsErrNoRows(err) && len(buildPackConfig.LanguageVersion) > 0 {
+		languageDefault, err = impl.buildpackCatalogueRepository.FindLanguageDefaultByLanguage(buildPackConfig.Language, "")
+	}
+	if util.IsErrNoRows(err) {
+		return nil
+	} else if err != nil {
+		impl.logger.Errorw("error in fetching buildpack language default", "language", buildPackConfig.Language, "err", err)
+		return err
+	}
+	builderModel, err := impl.buildpackCatalogueRepository.FindBuilderById(languageDefault.BuilderId)
+	if err != nil {
+		impl.logger.Errorw("error in fetching builder of language default", "language", buildPackConfig.Language, "builderId", languageDefault.BuilderId, "err", err)
+		return err
+	}
+	buildPackConfig.BuilderId = toBuilderImage(builderModel).Reference()
+	if len(buildPackConfig.BuildPacks) == 0 {
+		buildPackConfig.BuildPacks = splitBuildpacks(languageDefault.Buildpacks)
+	}
+	return nil
+}
+
+// validateBuildpacks checks that every buildpack is in the catalogue, an unpinned buildpack matches any approved version of it
+func (impl *BuildpackCatalogueServiceImpl) validateBuildpacks(buildpacks []string) error {
+	if len(buildpacks) == 0 {
+		return nil
+	}
+	buildpackModels, err := impl.buildpackCatalogueRepository.FindAllBuildpacks()
+	if err != nil {
+		impl.logger.Errorw("error in fetching buildpacks", "err", err)
+		return err
+	}
+	for _, buildpackRef := range buildpacks {
+		buildpackId, version := bean.ParseBuildpackReference(buildpackRef)
+		approved := false
+		for _, buildpackModel := range buildpackModels {
+			if buildpackModel.BuildpackId == buildpackId && (len(version) == 0 || buildpackModel.Version == version) {
+				approved = true
+				break
+			}
+		}
+		if !approved {
+			errMsg := fmt.Sprintf("buildpack %s is not in the approved buildpack catalogue", buildpackRef)
+			return util.NewApiError(http.StatusBadRequest, errMsg, errMsg)
+		}
+	}
+	return nil
+}
+
+func (impl *BuildpackCatalogueServiceImpl) GetOutdatedBuilderUsages() ([]*bean.OutdatedBuilderUsage, error) {
+	builderModels, err := impl.buildpackCatalogueRepository.FindAllBuilders()
+	if err != nil {
+		impl.logger.Errorw("error in fetching buildpack builders", "err", err)
+		return nil, err
+	}
+	buildConfigs, err := impl.buildpackCatalogueRepository.FindBuildpackPipelineBuildConfigs(string(buildBean.BUILDPACK_BUILD_TYPE))
+	if err != nil {
+		impl.logger.Errorw("error in fetching buildpack pipeline build configs", "err", err)
+		return nil, err
+	}
+	latestBuilders := getLatestBuilderPerImage(builderModels)
+	usages := make([]*bean.OutdatedBuilderUsage, 0)
+	for _, buildConfig := range buildConfigs {
+		buildPackConfig := &buildBean.BuildPackConfig{}
+		err = json.Unmarshal([]byte(buildConfig.BuildMetadata), buildPackConfig)
+		if err != nil {
+			impl.logger.Warnw("skipping ci pipeline with invalid buildpack config", "ciPipelineId", buildConfig.CiPipelineId, "err", err)
+			continue
+		}
+		image, _ := bean.ParseBuilderReference(buildPackConfig.BuilderId)
+		latestBuilder := latestBuilders[image]
+		usage := &bean.OutdatedBuilderUsage{
+			AppId:          buildConfig.AppId,
+			AppName:        buildConfig.AppName,
+			CiPipelineId:   buildConfig.CiPipelineId,
+			CiPipelineName: buildConfig.CiPipelineName,
+			Builder:        buildPackConfig.BuilderId,
+		}
+		if latestBuilder != nil {
+			usage.RecommendedBuilder = toBuilderImage(latestBuilder).Reference()
+		}
+		builderModel := findBuilder(builderModels, buildPackConfig.BuilderId)
+		switch {
+		case builderModel == nil:
+			usage.Status = bean.BuilderUsageStatusNotInCatalogue
+		case builderModel.Deprecated:
+			usage.Status = bean.BuilderUsageStatusDeprecated
+		case latestBuilder != nil && latestBuilder.Id != builderModel.Id:
+			usage.Status = bean.BuilderUsageStatusOutdated
+		default:
+			continue
+		}
+		usages = append(usages, usage)
+	}
+	return usages, nil
+}
+
+func findBuilder(builderModels []*repository.BuildpackBuilder, builderRef string) *repository.BuildpackBuilder {
+	image, version := bean.ParseBuilderReference(builderRef)
+	for _, builderModel := range builderModels {
+		if builderModel.Image == image && builderModel.Version == version {
+			return builderModel
+		}
+	}
+	return nil
+}
+
+// getLatestBuilderPerImage returns the highest non deprecated version of every builder image, versions which are not
+// semver are ordered by the time they were added to the catalogue
+func getLatestBuilderPerImage(builderModels []*repository.BuildpackBuilder) map[string]*repository.BuildpackBuilder {
+	latestBuilders := make(map[string]*repository.BuildpackBuilder)
+	for _, builderModel := range builderModels {
+		if builderModel.Deprecated {
+			continue
+		}
+		latestBuilder, ok := latestBuilders[builderModel.Image]
+		if !ok || isNewerBuilder(builderModel, latestBuilder) {
+			latestBuilders[builderModel.Image] = builderModel
+		}
+	}
+	return latestBuilders
+}
+
+func isNewerBuilder(builder, other *repository.BuildpackBuilder) bool {
+	version, err := semver.NewVersion(builder.Version)
+	if err != nil {
+		return builder.Id > other.Id
+	}
+	otherVersion, err := semver.NewVersion(other.Version)
+	if err != nil {
+		return builder.Id > other.Id
+	}
+	return version.GreaterThan(otherVersion)
+}
+
+func splitBuildpacks(buildpacks string) []string {
+	if len(buildpacks) == 0 {
+		return nil
+	}
+	return strings.Split(buildpacks, ",")
+}
+
+func toBuilderImage(model *repository.BuildpackBuilder) *bean.BuilderImage {
+	return &bean.BuilderImage{
+		Id:          model.Id,
+		Name:        model.Name,
+		Image:       model.Image,
+		Version:     model.Version,
+		Description: model.Description,
+		Deprecated:  model.Deprecated,
+	}
+}
+
+func toBuildpack(model *repository.Buildpack) *bean.Buildpack {
+	return &bean.Buildpack{
+		Id:          model.Id,
+		BuildpackId: model.BuildpackId,
+		Version:     model.Version,
+		Deprecated:  model.Deprecated,
+	}
+}
+
+func toLanguageDefault(model *repository.BuildpackLanguageDefault) *bean.LanguageDefault {
+	return &bean.LanguageDefault{
+		Id:              model.Id,
+		Language:        model.Language,
+		LanguageVersion: model.LanguageVersion,
+		BuilderId:       model.BuilderId,
+		BuildPacks:      splitBuildpacks(model.Buildpacks),
+	}
+}
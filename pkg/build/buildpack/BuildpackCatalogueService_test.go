@@ -0,0 +1,51 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package buildpack
+
+import (
+	"testing"
+
+	"github.com/devtron-labs/devtron/pkg/build/buildpack/bean"
+	"github.com/devtron-labs/devtron/pkg/build/buildpack/repository"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseBuilderReference(t *testing.T) {
+	image, version := bean.ParseBuilderReference("paketobuildpacks/builder-jammy-base:0.4.300")
+	assert.Equal(t, "paketobuildpacks/builder-jammy-base", image)
+	assert.Equal(t, "0.4.300", version)
+
+	image, version = bean.ParseBuilderReference("localhost:5000/builder")
+	assert.Equal(t, "localhost:5000/builder", image)
+	assert.Equal(t, "", version)
+}
+
+func TestGetLatestBuilderPerImage(t *testing.T) {
+	builders := []*repository.BuildpackBuilder{
+		{Id: 1, Image: "paketobuildpacks/builder-jammy-base", Version: "0.4.300"},
+		{Id: 2, Image: "paketobuildpacks/builder-jammy-base", Version: "0.4.310"},
+		{Id: 3, Image: "paketobuildpacks/builder-jammy-base", Version: "0.5.0", Deprecated: true},
+		{Id: 4, Image: "gcr.io/buildpacks/builder", Version: "v1"},
+		{Id: 5, Image: "gcr.io/buildpacks/builder", Version: "google-22"},
+	}
+	latestBuilders := getLatestBuilderPerImage(builders)
+	assert.Equal(t, 2, latestBuilders["paketobuildpacks/builder-jammy-base"].Id)
+	// non semver versions fall back to the catalogue order
+	assert.Equal(t, 5, latestBuilders["gcr.io/buildpacks/builder"].Id)
+	assert.Equal(t, 2, findBuilder(builders, "paketobuildpacks/builder-jammy-base:0.4.310").Id)
+	assert.Nil(t, findBuilder(builders, "paketobuildpacks/builder-jammy-base:0.3.0"))
+}
@@ -0,0 +1,111 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package bean
+
+import (
+	"fmt"
+	"strings"
+)
+
+type BuildpackCatalogueConfig struct {
+	// EnforceApprovedBuilders rejects buildpack ci build configs using a builder or buildpack missing from the catalogue
+	EnforceApprovedBuilders bool `env:"BUILDPACK_ENFORCE_APPROVED_BUILDERS" envDefault:"false" description:"Reject buildpack builds whose builder image or buildpacks are not in the approved catalogue" deprecated:"false"`
+	// ExtractSbom asks the ci runner to export the SBOM layers written by the buildpacks
+	ExtractSbom bool `env:"BUILDPACK_SBOM_EXTRACTION_ENABLED" envDefault:"true" description:"Export the SBOM produced by buildpacks and attach it to the built artifact" deprecated:"false"`
+}
+
+type BuilderUsageStatus string
+
+const (
+	BuilderUsageStatusDeprecated     BuilderUsageStatus = "DEPRECATED"
+	BuilderUsageStatusOutdated       BuilderUsageStatus = "OUTDATED"
+	BuilderUsageStatusNotInCatalogue BuilderUsageStatus = "NOT_IN_CATALOGUE"
+)
+
+type BuilderImage struct {
+	Id          int    `json:"id"`
+	Name        string `json:"name" validate:"required,max=250"`
+	Image       string `json:"image" validate:"required"`
+	Version     string `json:"version" validate:"required,max=100"`
+	Description string `json:"description,omitempty"`
+	Deprecated  bool   `json:"deprecated"`
+}
+
+// Reference is the builder reference as used in the build pack config, <image>:<version>
+func (builder *BuilderImage) Reference() string {
+	return fmt.Sprintf("%s:%s", builder.Image, builder.Version)
+}
+
+type Buildpack struct {
+	Id          int    `json:"id"`
+	BuildpackId string `json:"buildpackId" validate:"required"`
+	Version     string `json:"version" validate:"required,max=100"`
+	Deprecated  bool   `json:"deprecated"`
+}
+
+// Reference is the buildpack reference as used in the build pack config, <buildpackId>@<version>
+func (buildpack *Buildpack) Reference() string {
+	return fmt.Sprintf("%s@%s", buildpack.BuildpackId, buildpack.Version)
+}
+
+type LanguageDefault struct {
+	Id       int    `json:"id"`
+	Language string `json:"language" validate:"required,max=100"`
+	// LanguageVersion is optional, a default without version applies to every version of the language
+	LanguageVersion string   `json:"languageVersion,omitempty" validate:"max=100"`
+	BuilderId       int      `json:"builderId" validate:"required,number,gt=0"`
+	Builder         string   `json:"builder,omitempty"`
+	BuildPacks      []string `json:"buildPacks,omitempty"`
+}
+
+type BuildpackCatalogue struct {
+	Builders         []*BuilderImage    `json:"builders"`
+	Buildpacks       []*Buildpack       `json:"buildpacks"`
+	LanguageDefaults []*LanguageDefault `json:"languageDefaults"`
+}
+
+// OutdatedBuilderUsage is a ci pipeline building with a builder which needs an upgrade
+type OutdatedBuilderUsage struct {
+	AppId          int                `json:"appId"`
+	AppName        string             `json:"appName"`
+	CiPipelineId   int                `json:"ciPipelineId"`
+	CiPipelineName string             `json:"ciPipelineName"`
+	Builder        string             `json:"builder"`
+	Status         BuilderUsageStatus `json:"status"`
+	// RecommendedBuilder is the latest approved version of the same builder image, empty when there is none
+	RecommendedBuilder string `json:"recommendedBuilder,omitempty"`
+}
+
+// ParseBuilderReference splits a builder reference in image and version, version is empty for untagged references
+func ParseBuilderReference(builder string) (image string, version string) {
+	builder = strings.TrimSpace(builder)
+	tagSeparatorIndex := strings.LastIndex(builder, ":")
+	if tagSeparatorIndex < 0 || tagSeparatorIndex < strings.LastIndex(builder, "/") {
+		return builder, ""
+	}
+	return builder[:tagSeparatorIndex], builder[tagSeparatorIndex+1:]
+}
+
+// ParseBuildpackReference splits a buildpack reference in id and version, version is empty for unpinned references
+func ParseBuildpackReference(buildpack string) (buildpackId string, version string) {
+	buildpack = strings.TrimSpace(buildpack)
+	versionSeparatorIndex := strings.LastIndex(buildpack, "@")
+	if versionSeparatorIndex < 0 {
+		return buildpack, ""
+	}
+	return buildpack[:versionSeparatorIndex], buildpack[versionSeparatorIndex+1:]
+}
@@ -0,0 +1,208 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package repository
+
+import (
+	"github.com/devtron-labs/devtron/pkg/sql"
+	"github.com/go-pg/pg"
+	"go.uber.org/zap"
+)
+
+type BuildpackBuilder struct {
+	TableName   struct{} `sql:"buildpack_builder" pg:",discard_unknown_columns"`
+	Id          int      `sql:"id,pk"`
+	Name        string   `sql:"name,notnull"`
+	Image       string   `sql:"image,notnull"`
+	Version     string   `sql:"version,notnull"`
+	Description string   `sql:"description"`
+	Deprecated  bool     `sql:"deprecated,notnull"`
+	Active      bool     `sql:"active,notnull"`
+	sql.AuditLog
+}
+
+type Buildpack struct {
+	TableName   struct{} `sql:"buildpack" pg:",discard_unknown_columns"`
+	Id          int      `sql:"id,pk"`
+	BuildpackId string   `sql:"buildpack_id,notnull"`
+	Version     string   `sql:"version,notnull"`
+	Deprecated  bool     `sql:"deprecated,notnull"`
+	Active      bool     `sql:"active,notnull"`
+	sql.AuditLog
+}
+
+type BuildpackLanguageDefault struct {
+	TableName       struct{} `sql:"buildpack_language_default" pg:",discard_unknown_columns"`
+	Id              int      `sql:"id,pk"`
+	Language        string   `sql:"language,notnull"`
+	LanguageVersion string   `sql:"language_version,notnull"`
+	BuilderId       int      `sql:"builder_id,notnull"`
+	Buildpacks      string   `sql:"buildpacks"` // comma separated <buildpack_id>@<version>
+	Active          bool     `sql:"active,notnull"`
+	sql.AuditLog
+}
+
+// BuildpackPipelineBuildConfig is the effective build config of an active ci pipeline building with buildpacks
+type BuildpackPipelineBuildConfig struct {
+	AppId          int    `sql:"app_id"`
+	AppName        string `sql:"app_name"`
+	CiPipelineId   int    `sql:"ci_pipeline_id"`
+	CiPipelineName string `sql:"ci_pipeline_name"`
+	BuildMetadata  string `sql:"build_metadata"`
+}
+
+type BuildpackCatalogueRepository interface {
+	SaveBuilder(builder *BuildpackBuilder) error
+	UpdateBuilder(builder *BuildpackBuilder) error
+	FindBuilderById(id int) (*BuildpackBuilder, error)
+	FindAllBuilders() ([]*BuildpackBuilder, error)
+
+	SaveBuildpack(buildpack *Buildpack) error
+	UpdateBuildpack(buildpack *Buildpack) error
+	FindBuildpackById(id int) (*Buildpack, error)
+	FindAllBuildpacks() ([]*Buildpack, error)
+
+	SaveLanguageDefault(languageDefault *BuildpackLanguageDefault) error
+	UpdateLanguageDefault(languageDefault *BuildpackLanguageDefault) error
+	FindLanguageDefaultById(id int) (*BuildpackLanguageDefault, error)
+	FindLanguageDefaultByLanguage(language, languageVersion string) (*BuildpackLanguageDefault, error)
+	FindAllLanguageDefaults() ([]*BuildpackLanguageDefault, error)
+	CountLanguageDefaultsByBuilderId(builderId int) (int, error)
+
+	// FindBuildpackPipelineBuildConfigs resolves the pipeline level override and falls back to the app ci template
+	FindBuildpackPipelineBuildConfigs(buildType string) ([]*BuildpackPipelineBuildConfig, error)
+}
+
+type BuildpackCatalogueRepositoryImpl struct {
+	dbConnection *pg.DB
+	logger       *zap.SugaredLogger
+}
+
+func NewBuildpackCatalogueRepositoryImpl(dbConnection *pg.DB, logger *zap.SugaredLogger) *BuildpackCatalogueRepositoryImpl {
+	return &BuildpackCatalogueRepositoryImpl{
+		dbConnection: dbConnection,
+		logger:       logger,
+	}
+}
+
+func (impl *BuildpackCatalogueRepositoryImpl) SaveBuilder(builder *BuildpackBuilder) error {
+	return impl.dbConnection.Insert(builder)
+}
+
+func (impl *BuildpackCatalogueRepositoryImpl) UpdateBuilder(builder *BuildpackBuilder) error {
+	return impl.dbConnection.Update(builder)
+}
+
+func (impl *BuildpackCatalogueRepositoryImpl) FindBuilderById(id int) (*BuildpackBuilder, error) {
+	builder := &BuildpackBuilder{}
+	err := impl.dbConnection.Model(builder).
+		Where("id = ?", id).
+		Where("active = ?", true).
+		Select()
+	return builder, err
+}
+
+func (impl *BuildpackCatalogueRepositoryImpl) FindAllBuilders() ([]*BuildpackBuilder, error) {
+	var builders []*BuildpackBuilder
+	err := impl.dbConnection.Model(&builders).
+		Where("active = ?", true).
+		Order("image ASC", "id ASC").
+		Select()
+	return builders, err
+}
+
+func (impl *BuildpackCatalogueRepositoryImpl) SaveBuildpack(buildpack *Buildpack) error {
+	return impl.dbConnection.Insert(buildpack)
+}
+
+func (impl *BuildpackCatalogueRepositoryImpl) UpdateBuildpack(buildpack *Buildpack) error {
+	return impl.dbConnection.Update(buildpack)
+}
+
+func (impl *BuildpackCatalogueRepositoryImpl) FindBuildpackById(id int) (*Buildpack, error) {
+	buildpack := &Buildpack{}
+	err := impl.dbConnection.Model(buildpack).
+		Where("id = ?", id).
+		Where("active = ?", true).
+		Select()
+	return buildpack, err
+}
+
+func (impl *BuildpackCatalogueRepositoryImpl) FindAllBuildpacks() ([]*Buildpack, error) {
+	var buildpacks []*Buildpack
+	err := impl.dbConnection.Model(&buildpacks).
+		Where("active = ?", true).
+		Order("buildpack_id ASC", "id ASC").
+		Select()
+	return buildpacks, err
+}
+
+func (impl *BuildpackCatalogueRepositoryImpl) SaveLanguageDefault(languageDefault *BuildpackLanguageDefault) error {
+	return impl.dbConnection.Insert(languageDefault)
+}
+
+func (impl *BuildpackCatalogueRepositoryImpl) UpdateLanguageDefault(languageDefault *BuildpackLanguageDefault) error {
+	return impl.dbConnection.Update(languageDefault)
+}
+
+func (impl *BuildpackCatalogueRepositoryImpl) FindLanguageDefaultById(id int) (*BuildpackLanguageDefault, error) {
+	languageDefault := &BuildpackLanguageDefault{}
+	err := impl.dbConnection.Model(languageDefault).
+		Where("id = ?", id).
+		Where("active = ?", true).
+		Select()
+	return languageDefault, err
+}
+
+func (impl *BuildpackCatalogueRepositoryImpl) FindLanguageDefaultByLanguage(language, languageVersion string) (*BuildpackLanguageDefault, error) {
+	languageDefault := &BuildpackLanguageDefault{}
+	err := impl.dbConnection.Model(languageDefault).
+		Where("language = ?", language).
+		Where("language_version = ?", languageVersion).
+		Where("active = ?", true).
+		Select()
+	return languageDefault, err
+}
+
+func (impl *BuildpackCatalogueRepositoryImpl) FindAllLanguageDefaults() ([]*BuildpackLanguageDefault, error) {
+	var languageDefaults []*BuildpackLanguageDefault
+	err := impl.dbConnection.Model(&languageDefaults).
+		Where("active = ?", true).
+		Order("language ASC", "language_version ASC").
+		Select()
+	return languageDefaults, err
+}
+
+func (impl *BuildpackCatalogueRepositoryImpl) CountLanguageDefaultsByBuilderId(builderId int) (int, error) {
+	return impl.dbConnection.Model(&BuildpackLanguageDefault{}).
+		Where("builder_id = ?", builderId).
+		Where("active = ?", true).
+		Count()
+}
+
+func (impl *BuildpackCatalogueRepositoryImpl) FindBuildpackPipelineBuildConfigs(buildType string) ([]*BuildpackPipelineBuildConfig, error) {
+	var buildConfigs []*BuildpackPipelineBuildConfig
+	query := "SELECT a.id AS app_id, a.app_name, cp.id AS ci_pipeline_id, cp.name AS ci_pipeline_name, cbc.build_metadata" +
+		" FROM ci_pipeline cp" +
+		" INNER JOIN app a ON a.id = cp.app_id AND a.active = true" +
+		" INNER JOIN ci_template ct ON ct.app_id = cp.app_id AND ct.active = true" +
+		" LEFT JOIN ci_template_override cto ON cto.ci_pipeline_id = cp.id AND cto.active = true AND cp.is_docker_config_overridden = true" +
+		" INNER JOIN ci_build_config cbc ON cbc.id = COALESCE(cto.ci_build_config_id, ct.ci_build_config_id)" +
+		" WHERE cp.active = true AND cp.deleted = false AND cbc.type = ?" +
+		" ORDER BY a.app_name, cp.name;"
+	_, err := impl.dbConnection.Query(&buildConfigs, query, buildType)
+	return buildConfigs, err
+}
@@ -0,0 +1,212 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cache
+
+import (
+	"fmt"
+	"github.com/devtron-labs/devtron/internal/sql/repository/pipelineConfig"
+	"github.com/devtron-labs/devtron/internal/util"
+	"github.com/devtron-labs/devtron/pkg/build/cache/bean"
+	"github.com/devtron-labs/devtron/pkg/build/cache/repository"
+	"github.com/devtron-labs/devtron/pkg/sql"
+	"go.uber.org/zap"
+	"net/http"
+	"time"
+)
+
+// BuildCacheService manages the buildx cache backend of ci pipelines. Blob storage and registries are not
+// cleaned up from here, an invalidation moves the pipeline to a new cache version which leaves the old cache unreferenced
+// for the bucket lifecycle or registry retention policies to remove.
+type BuildCacheService interface {
+	GetBuildCacheConfig(appId, ciPipelineId int) (*bean.BuildCacheConfig, error)
+	SaveBuildCacheConfig(config *bean.BuildCacheConfig, userId int32) (*bean.BuildCacheConfig, error)
+	// InvalidateBuildCache moves the pipeline to a new cache version so that the next build starts cold,
+	// the cache of the previous version is left in place and is not deleted
+	InvalidateBuildCache(appId, ciPipelineId int, userId int32) (*bean.BuildCacheConfig, error)
+	// GetBuildxCacheConfig returns the cache references for a build of the pipeline, nil when the pipeline has no managed cache
+	GetBuildxCacheConfig(ciPipelineId int, imageRepository string, defaultModeMin bool) (*bean.BuildxCacheConfig, error)
+	SaveBuildCacheMetrics(ciWorkflowId int, metrics *bean.BuildCacheMetrics) error
+}
+
+type BuildCacheServiceImpl struct {
+	logger                     *zap.SugaredLogger
+	buildCacheConfigRepository repository.CiPipelineBuildCacheConfigRepository
+	ciPipelineRepository       pipelineConfig.CiPipelineRepository
+	ciWorkflowRepository       pipelineConfig.CiWorkflowRepository
+}
+
+func NewBuildCacheServiceImpl(logger *zap.SugaredLogger,
+	buildCacheConfigRepository repository.CiPipelineBuildCacheConfigRepository,
+	ciPipelineRepository pipelineConfig.CiPipelineRepository,
+	ciWorkflowRepository pipelineConfig.CiWorkflowRepository) *BuildCacheServiceImpl {
+	return &BuildCacheServiceImpl{
+		logger:                     logger,
+		buildCacheConfigRepository: buildCacheConfigRepository,
+		ciPipelineRepository:       ciPipelineRepository,
+		ciWorkflowRepository:       ciWorkflowRepository,
+	}
+}
+
+func (impl *BuildCacheServiceImpl) GetBuildCacheConfig(appId, ciPipelineId int) (*bean.BuildCacheConfig, error) {
+	err := impl.validateCiPipeline(appId, ciPipelineId)
+	if err != nil {
+		return nil, err
+	}
+	model, err := impl.buildCacheConfigRepository.FindByCiPipelineId(ciPipelineId)
+	if util.IsErrNoRows(err) {
+		return &bean.BuildCacheConfig{
+			AppId:        appId,
+			CiPipelineId: ciPipelineId,
+			Backend:      bean.CacheBackendNone,
+		}, nil
+	} else if err != nil {
+		impl.logger.Errorw("error in fetching build cache config", "ciPipelineId", ciPipelineId, "err", err)
+		return nil, err
+	}
+	return toBuildCacheConfig(appId, model), nil
+}
+
+func (impl *BuildCacheServiceImpl) SaveBuildCacheConfig(config *bean.BuildCacheConfig, userId int32) (*bean.BuildCacheConfig, error) {
+	err := impl.validateCiPipeline(config.AppId, config.CiPipelineId)
+	if err != nil {
+		return nil, err
+	}
+	model, err := impl.buildCacheConfigRepository.FindByCiPipelineId(config.CiPipelineId)
+	if err != nil && !util.IsErrNoRows(err) {
+		impl.logger.Errorw("error in fetching build cache config", "ciPipelineId", config.CiPipelineId, "err", err)
+		return nil, err
+	}
+	isNew := util.IsErrNoRows(err)
+	if isNew {
+		model = &repository.CiPipelineBuildCacheConfig{
+			CiPipelineId: config.CiPipelineId,
+			CacheVersion: 1,
+			Active:       true,
+			AuditLog:     sql.NewDefaultAuditLog(userId),
+		}
+	}
+	model.Backend = string(config.Backend)
+	model.Mode = string(config.Mode)
+	model.RegistryCacheRepository = config.RegistryCacheRepository
+	model.UpdateAuditLog(userId)
+	if isNew {
+		err = impl.buildCacheConfigRepository.Save(model)
+	} else {
+		err = impl.buildCacheConfigRepository.Update(model)
+	}
+	if err != nil {
+		impl.logger.Errorw("error in saving build cache config", "ciPipelineId", config.CiPipelineId, "err", err)
+		return nil, err
+	}
+	return toBuildCacheConfig(config.AppId, model), nil
+}
+
+func (impl *BuildCacheServiceImpl) InvalidateBuildCache(appId, ciPipelineId int, userId int32) (*bean.BuildCacheConfig, error) {
+	err := impl.validateCiPipeline(appId, ciPipelineId)
+	if err != nil {
+		return nil, err
+	}
+	model, err := impl.buildCacheConfigRepository.FindByCiPipelineId(ciPipelineId)
+	if util.IsErrNoRows(err) || (err == nil && !bean.CacheBackend(model.Backend).IsManaged()) {
+		errMsg := "build cache of this pipeline is not managed, configure a cache backend to invalidate it"
+		return nil, util.NewApiError(http.StatusBadRequest, errMsg, errMsg)
+	} else if err != nil {
+		impl.logger.Errorw("error in fetching build cache config", "ciPipelineId", ciPipelineId, "err", err)
+		return nil, err
+	}
+	model.CacheVersion++
+	model.LastInvalidatedOn = time.Now()
+	model.UpdateAuditLog(userId)
+	err = impl.buildCacheConfigRepository.Update(model)
+	if err != nil {
+		impl.logger.Errorw("error in invalidating build cache", "ciPipelineId", ciPipelineId, "err", err)
+		return nil, err
+	}
+	impl.logger.Infow("invalidated build cache", "ciPipelineId", ciPipelineId, "cacheVersion", model.CacheVersion, "userId", userId)
+	return toBuildCacheConfig(appId, model), nil
+}
+
+func (impl *BuildCacheServiceImpl) GetBuildxCacheConfig(ciPipelineId int, imageRepository string, defaultModeMin bool) (*bean.BuildxCacheConfig, error) {
+	model, err := impl.buildCacheConfigRepository.FindByCiPipelineId(ciPipelineId)
+	if util.IsErrNoRows(err) {
+		return nil, nil
+	} else if err != nil {
+		impl.logger.Errorw("error in fetching build cache config", "ciPipelineId", ciPipelineId, "err", err)
+		return nil, err
+	}
+	backend := bean.CacheBackend(model.Backend)
+	if !backend.IsManaged() {
+		return nil, nil
+	}
+	cacheConfig := &bean.BuildxCacheConfig{
+		Backend: backend,
+		Mode:    bean.CacheMode(model.Mode),
+	}
+	if len(cacheConfig.Mode) == 0 {
+		cacheConfig.Mode = bean.CacheModeMax
+		if defaultModeMin {
+			cacheConfig.Mode = bean.CacheModeMin
+		}
+	}
+	switch backend {
+	case bean.CacheBackendRegistry:
+		cacheRepository := imageRepository
+		if len(model.RegistryCacheRepository) > 0 {
+			cacheRepository = model.RegistryCacheRepository
+		}
+		cacheConfig.RegistryCacheRef = fmt.Sprintf("%s:"+bean.RegistryCacheTagPattern, cacheRepository, ciPipelineId, model.CacheVersion)
+	case bean.CacheBackendBlobStorage:
+		cacheConfig.BlobCacheKey = fmt.Sprintf(bean.BlobCacheKeyPattern, ciPipelineId, model.CacheVersion)
+	}
+	return cacheConfig, nil
+}
+
+func (impl *BuildCacheServiceImpl) SaveBuildCacheMetrics(ciWorkflowId int, metrics *bean.BuildCacheMetrics) error {
+	err := impl.ciWorkflowRepository.UpdateBuildCacheMetrics(ciWorkflowId, string(metrics.Backend), metrics.CachedSteps, metrics.TotalSteps)
+	if err != nil {
+		impl.logger.Errorw("error in saving build cache metrics", "ciWorkflowId", ciWorkflowId, "metrics", metrics, "err", err)
+		return err
+	}
+	return nil
+}
+
+func (impl *BuildCacheServiceImpl) validateCiPipeline(appId, ciPipelineId int) error {
+	ciPipeline, err := impl.ciPipelineRepository.FindById(ciPipelineId)
+	if util.IsErrNoRows(err) || (err == nil && ciPipeline.AppId != appId) {
+		errMsg := fmt.Sprintf("ci pipeline %d not found in app %d", ciPipelineId, appId)
+		return util.NewApiError(http.StatusNotFound, errMsg, errMsg)
+	} else if err != nil {
+		impl.logger.Errorw("error in fetching ci pipeline", "ciPipelineId", ciPipelineId, "err", err)
+		return err
+	}
+	return nil
+}
+
+func toBuildCacheConfig(appId int, model *repository.CiPipelineBuildCacheConfig) *bean.BuildCacheConfig {
+	config := &bean.BuildCacheConfig{
+		AppId:                   appId,
+		CiPipelineId:            model.CiPipelineId,
+		Backend:                 bean.CacheBackend(model.Backend),
+		Mode:                    bean.CacheMode(model.Mode),
+		RegistryCacheRepository: model.RegistryCacheRepository,
+		CacheVersion:            model.CacheVersion,
+	}
+	if !model.LastInvalidatedOn.IsZero() {
+		config.LastInvalidatedOn = &model.LastInvalidatedOn
+	}
+	return config
+}
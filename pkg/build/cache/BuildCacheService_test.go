@@ -0,0 +1,193 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cache
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/devtron-labs/devtron/internal/sql/repository/pipelineConfig"
+	"github.com/devtron-labs/devtron/internal/sql/repository/pipelineConfig/mocks"
+	"github.com/devtron-labs/devtron/internal/util"
+	"github.com/devtron-labs/devtron/pkg/build/cache/bean"
+	"github.com/devtron-labs/devtron/pkg/build/cache/repository"
+	repositoryMocks "github.com/devtron-labs/devtron/pkg/build/cache/repository/mocks"
+	"github.com/go-pg/pg"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+const (
+	testAppId        = 1
+	testCiPipelineId = 7
+)
+
+// newTestBuildCacheService returns the service over mocks, the pipeline under test belongs to the test app and the
+// build cache config repository returns the given config for it or no rows when it is nil
+func newTestBuildCacheService(t *testing.T, config *repository.CiPipelineBuildCacheConfig) (*BuildCacheServiceImpl, *repositoryMocks.CiPipelineBuildCacheConfigRepository, *mocks.CiWorkflowRepository) {
+	configRepository := repositoryMocks.NewCiPipelineBuildCacheConfigRepository(t)
+	if config != nil {
+		configRepository.On("FindByCiPipelineId", testCiPipelineId).Return(config, nil).Maybe()
+	} else {
+		configRepository.On("FindByCiPipelineId", testCiPipelineId).Return(&repository.CiPipelineBuildCacheConfig{}, pg.ErrNoRows).Maybe()
+	}
+	ciPipelineRepository := mocks.NewCiPipelineRepository(t)
+	ciPipelineRepository.On("FindById", testCiPipelineId).Return(&pipelineConfig.CiPipeline{Id: testCiPipelineId, AppId: testAppId}, nil).Maybe()
+	ciPipelineRepository.On("FindById", mock.Anything).Return(&pipelineConfig.CiPipeline{}, pg.ErrNoRows).Maybe()
+	ciWorkflowRepository := mocks.NewCiWorkflowRepository(t)
+	impl := NewBuildCacheServiceImpl(zap.NewNop().Sugar(), configRepository, ciPipelineRepository, ciWorkflowRepository)
+	return impl, configRepository, ciWorkflowRepository
+}
+
+func assertApiErrorStatus(t *testing.T, err error, status int) {
+	apiErr, ok := err.(*util.ApiError)
+	if assert.True(t, ok, "expected api error, got %v", err) {
+		assert.Equal(t, status, apiErr.HttpStatusCode)
+	}
+}
+
+func TestBuildCacheConfig(t *testing.T) {
+	t.Run("pipeline without config has no managed cache", func(t *testing.T) {
+		impl, _, _ := newTestBuildCacheService(t, nil)
+		config, err := impl.GetBuildCacheConfig(testAppId, testCiPipelineId)
+		assert.Nil(t, err)
+		assert.Equal(t, bean.CacheBackendNone, config.Backend)
+		assert.Equal(t, testCiPipelineId, config.CiPipelineId)
+	})
+
+	t.Run("pipeline of another app is not found", func(t *testing.T) {
+		impl, _, _ := newTestBuildCacheService(t, nil)
+		_, err := impl.GetBuildCacheConfig(testAppId+1, testCiPipelineId)
+		assertApiErrorStatus(t, err, http.StatusNotFound)
+		_, err = impl.SaveBuildCacheConfig(&bean.BuildCacheConfig{AppId: testAppId, CiPipelineId: testCiPipelineId + 1, Backend: bean.CacheBackendInline}, 1)
+		assertApiErrorStatus(t, err, http.StatusNotFound)
+	})
+
+	t.Run("save creates the config with the first cache version", func(t *testing.T) {
+		impl, configRepository, _ := newTestBuildCacheService(t, nil)
+		configRepository.On("Save", mock.MatchedBy(func(model *repository.CiPipelineBuildCacheConfig) bool {
+			return model.CiPipelineId == testCiPipelineId && model.CacheVersion == 1 && model.Backend == string(bean.CacheBackendRegistry)
+		})).Return(nil).Once()
+		config, err := impl.SaveBuildCacheConfig(&bean.BuildCacheConfig{AppId: testAppId, CiPipelineId: testCiPipelineId, Backend: bean.CacheBackendRegistry}, 1)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, config.CacheVersion)
+	})
+
+	t.Run("save updates the existing config and keeps its cache version", func(t *testing.T) {
+		impl, configRepository, _ := newTestBuildCacheService(t, &repository.CiPipelineBuildCacheConfig{Id: 3, CiPipelineId: testCiPipelineId, Backend: string(bean.CacheBackendRegistry), CacheVersion: 2, Active: true})
+		configRepository.On("Update", mock.MatchedBy(func(model *repository.CiPipelineBuildCacheConfig) bool {
+			return model.Id == 3 && model.Backend == string(bean.CacheBackendBlobStorage) && model.Mode == string(bean.CacheModeMin)
+		})).Return(nil).Once()
+		config, err := impl.SaveBuildCacheConfig(&bean.BuildCacheConfig{AppId: testAppId, CiPipelineId: testCiPipelineId, Backend: bean.CacheBackendBlobStorage, Mode: bean.CacheModeMin}, 1)
+		assert.Nil(t, err)
+		assert.Equal(t, bean.CacheBackendBlobStorage, config.Backend)
+		assert.Equal(t, bean.CacheModeMin, config.Mode)
+		assert.Equal(t, 2, config.CacheVersion)
+	})
+}
+
+func TestInvalidateBuildCache(t *testing.T) {
+	t.Run("invalidation moves the pipeline to a new cache version", func(t *testing.T) {
+		impl, configRepository, _ := newTestBuildCacheService(t, &repository.CiPipelineBuildCacheConfig{CiPipelineId: testCiPipelineId, Backend: string(bean.CacheBackendRegistry), CacheVersion: 3, Active: true})
+		configRepository.On("Update", mock.AnythingOfType("*repository.CiPipelineBuildCacheConfig")).Return(nil).Once()
+		config, err := impl.InvalidateBuildCache(testAppId, testCiPipelineId, 1)
+		assert.Nil(t, err)
+		assert.Equal(t, 4, config.CacheVersion)
+		assert.NotNil(t, config.LastInvalidatedOn)
+
+		cacheConfig, err := impl.GetBuildxCacheConfig(testCiPipelineId, "docker.io/devtron/app", false)
+		assert.Nil(t, err)
+		assert.Equal(t, "docker.io/devtron/app:buildcache-7-v4", cacheConfig.RegistryCacheRef)
+	})
+
+	t.Run("invalidation of an unmanaged cache is a bad request", func(t *testing.T) {
+		impl, _, _ := newTestBuildCacheService(t, nil)
+		_, err := impl.InvalidateBuildCache(testAppId, testCiPipelineId, 1)
+		assertApiErrorStatus(t, err, http.StatusBadRequest)
+
+		impl, _, _ = newTestBuildCacheService(t, &repository.CiPipelineBuildCacheConfig{CiPipelineId: testCiPipelineId, Backend: string(bean.CacheBackendNone), CacheVersion: 1, Active: true})
+		_, err = impl.InvalidateBuildCache(testAppId, testCiPipelineId, 1)
+		assertApiErrorStatus(t, err, http.StatusBadRequest)
+	})
+}
+
+func TestGetBuildxCacheConfig(t *testing.T) {
+	newConfig := func(backend bean.CacheBackend, mode bean.CacheMode, registryCacheRepository string) *repository.CiPipelineBuildCacheConfig {
+		return &repository.CiPipelineBuildCacheConfig{
+			CiPipelineId:            testCiPipelineId,
+			Backend:                 string(backend),
+			Mode:                    string(mode),
+			RegistryCacheRepository: registryCacheRepository,
+			CacheVersion:            2,
+			Active:                  true,
+		}
+	}
+	testCases := []struct {
+		name           string
+		config         *repository.CiPipelineBuildCacheConfig
+		defaultModeMin bool
+		expected       *bean.BuildxCacheConfig
+	}{
+		{
+			name:     "no config",
+			expected: nil,
+		},
+		{
+			name:     "unmanaged backend",
+			config:   newConfig(bean.CacheBackendNone, "", ""),
+			expected: nil,
+		},
+		{
+			name:     "registry cache next to the build image",
+			config:   newConfig(bean.CacheBackendRegistry, "", ""),
+			expected: &bean.BuildxCacheConfig{Backend: bean.CacheBackendRegistry, Mode: bean.CacheModeMax, RegistryCacheRef: "docker.io/devtron/app:buildcache-7-v2"},
+		},
+		{
+			name:           "registry cache in the configured repository and global min mode",
+			config:         newConfig(bean.CacheBackendRegistry, "", "docker.io/devtron/cache"),
+			defaultModeMin: true,
+			expected:       &bean.BuildxCacheConfig{Backend: bean.CacheBackendRegistry, Mode: bean.CacheModeMin, RegistryCacheRef: "docker.io/devtron/cache:buildcache-7-v2"},
+		},
+		{
+			name:           "blob storage cache with the pipeline mode",
+			config:         newConfig(bean.CacheBackendBlobStorage, bean.CacheModeMax, ""),
+			defaultModeMin: true,
+			expected:       &bean.BuildxCacheConfig{Backend: bean.CacheBackendBlobStorage, Mode: bean.CacheModeMax, BlobCacheKey: "buildx-cache/7/v2"},
+		},
+		{
+			name:     "inline cache",
+			config:   newConfig(bean.CacheBackendInline, bean.CacheModeMin, ""),
+			expected: &bean.BuildxCacheConfig{Backend: bean.CacheBackendInline, Mode: bean.CacheModeMin},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			impl, _, _ := newTestBuildCacheService(t, tc.config)
+			cacheConfig, err := impl.GetBuildxCacheConfig(testCiPipelineId, "docker.io/devtron/app", tc.defaultModeMin)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expected, cacheConfig)
+		})
+	}
+}
+
+func TestSaveBuildCacheMetrics(t *testing.T) {
+	impl, _, ciWorkflowRepository := newTestBuildCacheService(t, nil)
+	ciWorkflowRepository.On("UpdateBuildCacheMetrics", 11, "REGISTRY", 4, 9).Return(nil).Once()
+	err := impl.SaveBuildCacheMetrics(11, &bean.BuildCacheMetrics{Backend: bean.CacheBackendRegistry, CachedSteps: 4, TotalSteps: 9})
+	assert.Nil(t, err)
+}
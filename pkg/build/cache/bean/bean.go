@@ -0,0 +1,76 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package bean
+
+import "time"
+
+type CacheBackend string
+
+const (
+	// CacheBackendNone keeps the ci runner defaults, cache is then driven by the global buildx flags only
+	CacheBackendNone        CacheBackend = "NONE"
+	CacheBackendRegistry    CacheBackend = "REGISTRY"
+	CacheBackendBlobStorage CacheBackend = "BLOB_STORAGE"
+	CacheBackendInline      CacheBackend = "INLINE"
+)
+
+func (backend CacheBackend) IsManaged() bool {
+	return len(backend) > 0 && backend != CacheBackendNone
+}
+
+type CacheMode string
+
+const (
+	CacheModeMin CacheMode = "min"
+	CacheModeMax CacheMode = "max"
+)
+
+const (
+	// RegistryCacheTagPattern is the tag of the registry cache image, buildcache-<ciPipelineId>-v<cacheVersion>
+	RegistryCacheTagPattern = "buildcache-%d-v%d"
+	// BlobCacheKeyPattern is the key prefix of the cache in the configured blob storage, buildx-cache/<ciPipelineId>/v<cacheVersion>
+	BlobCacheKeyPattern = "buildx-cache/%d/v%d"
+)
+
+// BuildCacheConfig is the build cache configuration of a ci pipeline
+type BuildCacheConfig struct {
+	AppId        int          `json:"appId" validate:"required,number,gt=0"`
+	CiPipelineId int          `json:"ciPipelineId" validate:"required,number,gt=0"`
+	Backend      CacheBackend `json:"backend" validate:"oneof=NONE REGISTRY BLOB_STORAGE INLINE"`
+	Mode         CacheMode    `json:"mode,omitempty" validate:"omitempty,oneof=min max"`
+	// RegistryCacheRepository overrides the repository the registry cache is pushed to, the build image repository is used by default
+	RegistryCacheRepository string     `json:"registryCacheRepository,omitempty"`
+	CacheVersion            int        `json:"cacheVersion"`
+	LastInvalidatedOn       *time.Time `json:"lastInvalidatedOn,omitempty"`
+}
+
+// BuildxCacheConfig tells the ci runner where buildx imports the cache from and exports it to
+type BuildxCacheConfig struct {
+	Backend          CacheBackend `json:"backend"`
+	Mode             CacheMode    `json:"mode"`
+	RegistryCacheRef string       `json:"registryCacheRef,omitempty"`
+	BlobCacheKey     string       `json:"blobCacheKey,omitempty"`
+}
+
+// BuildCacheMetrics is reported by the ci runner on build completion
+type BuildCacheMetrics struct {
+	Backend     CacheBackend `json:"backend"`
+	CachedSteps int          `json:"cachedSteps"`
+	TotalSteps  int          `json:"totalSteps"`
+	// CacheUsed is derived, true when at least one build step was served from the cache
+	CacheUsed bool `json:"cacheUsed"`
+}
@@ -0,0 +1,72 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package repository
+
+import (
+	"github.com/devtron-labs/devtron/pkg/sql"
+	"github.com/go-pg/pg"
+	"go.uber.org/zap"
+	"time"
+)
+
+type CiPipelineBuildCacheConfig struct {
+	TableName               struct{}  `sql:"ci_pipeline_build_cache_config" pg:",discard_unknown_columns"`
+	Id                      int       `sql:"id,pk"`
+	CiPipelineId            int       `sql:"ci_pipeline_id,notnull"`
+	Backend                 string    `sql:"backend,notnull"`
+	Mode                    string    `sql:"mode"`
+	RegistryCacheRepository string    `sql:"registry_cache_repository"`
+	CacheVersion            int       `sql:"cache_version,notnull"`
+	LastInvalidatedOn       time.Time `sql:"last_invalidated_on"`
+	Active                  bool      `sql:"active,notnull"`
+	sql.AuditLog
+}
+
+type CiPipelineBuildCacheConfigRepository interface {
+	Save(config *CiPipelineBuildCacheConfig) error
+	Update(config *CiPipelineBuildCacheConfig) error
+	FindByCiPipelineId(ciPipelineId int) (*CiPipelineBuildCacheConfig, error)
+}
+
+type CiPipelineBuildCacheConfigRepositoryImpl struct {
+	dbConnection *pg.DB
+	logger       *zap.SugaredLogger
+}
+
+func NewCiPipelineBuildCacheConfigRepositoryImpl(dbConnection *pg.DB, logger *zap.SugaredLogger) *CiPipelineBuildCacheConfigRepositoryImpl {
+	return &CiPipelineBuildCacheConfigRepositoryImpl{
+		dbConnection: dbConnection,
+		logger:       logger,
+	}
+}
+
+func (impl *CiPipelineBuildCacheConfigRepositoryImpl) Save(config *CiPipelineBuildCacheConfig) error {
+	return impl.dbConnection.Insert(config)
+}
+
+func (impl *CiPipelineBuildCacheConfigRepositoryImpl) Update(config *CiPipelineBuildCacheConfig) error {
+	return impl.dbConnection.Update(config)
+}
+
+func (impl *CiPipelineBuildCacheConfigRepositoryImpl) FindByCiPipelineId(ciPipelineId int) (*CiPipelineBuildCacheConfig, error) {
+	config := &CiPipelineBuildCacheConfig{}
+	err := impl.dbConnection.Model(config).
+		Where("ci_pipeline_id = ?", ciPipelineId).
+		Where("active = ?", true).
+		Select()
+	return config, err
+}
@@ -0,0 +1,93 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	repository "github.com/devtron-labs/devtron/pkg/build/cache/repository"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CiPipelineBuildCacheConfigRepository is an autogenerated mock type for the CiPipelineBuildCacheConfigRepository type
+type CiPipelineBuildCacheConfigRepository struct {
+	mock.Mock
+}
+
+// FindByCiPipelineId provides a mock function with given fields: ciPipelineId
+func (_m *CiPipelineBuildCacheConfigRepository) FindByCiPipelineId(ciPipelineId int) (*repository.CiPipelineBuildCacheConfig, error) {
+	ret := _m.Called(ciPipelineId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByCiPipelineId")
+	}
+
+	var r0 *repository.CiPipelineBuildCacheConfig
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (*repository.CiPipelineBuildCacheConfig, error)); ok {
+		return rf(ciPipelineId)
+	}
+	if rf, ok := ret.Get(0).(func(int) *repository.CiPipelineBuildCacheConfig); ok {
+		r0 = rf(ciPipelineId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*repository.CiPipelineBuildCacheConfig)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(ciPipelineId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Save provides a mock function with given fields: config
+func (_m *CiPipelineBuildCacheConfigRepository) Save(config *repository.CiPipelineBuildCacheConfig) error {
+	ret := _m.Called(config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Save")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*repository.CiPipelineBuildCacheConfig) error); ok {
+		r0 = rf(config)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Update provides a mock function with given fields: config
+func (_m *CiPipelineBuildCacheConfigRepository) Update(config *repository.CiPipelineBuildCacheConfig) error {
+	ret := _m.Called(config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*repository.CiPipelineBuildCacheConfig) error); ok {
+		r0 = rf(config)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewCiPipelineBuildCacheConfigRepository creates a new instance of CiPipelineBuildCacheConfigRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCiPipelineBuildCacheConfigRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CiPipelineBuildCacheConfigRepository {
+	mock := &CiPipelineBuildCacheConfigRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
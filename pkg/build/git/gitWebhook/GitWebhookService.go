@@ -17,9 +17,12 @@
 package gitWebhook
 
 import (
+	"fmt"
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	"github.com/devtron-labs/devtron/client/gitSensor"
 	"github.com/devtron-labs/devtron/internal/sql/constants"
 	"github.com/devtron-labs/devtron/internal/sql/repository/pipelineConfig"
+	"github.com/devtron-labs/devtron/internal/sql/repository/pipelineConfig/bean/workflow/cdWorkflow"
 	bean2 "github.com/devtron-labs/devtron/pkg/auth/user/bean"
 	"github.com/devtron-labs/devtron/pkg/bean"
 	"github.com/devtron-labs/devtron/pkg/build/git/gitWebhook/repository"
@@ -31,18 +34,22 @@ type GitWebhookService interface {
 	HandleGitWebhook(gitWebhookRequest gitSensor.CiPipelineMaterial) (int, error)
 }
 
+const supersededBuildMessage = "superseded by build %d for commit %s"
+
 type GitWebhookServiceImpl struct {
 	logger               *zap.SugaredLogger
 	gitWebhookRepository repository.GitWebhookRepository
 	ciHandlerService     trigger.HandlerService
+	ciWorkflowRepository pipelineConfig.CiWorkflowRepository
 }
 
 func NewGitWebhookServiceImpl(Logger *zap.SugaredLogger, gitWebhookRepository repository.GitWebhookRepository,
-	ciHandlerService trigger.HandlerService) *GitWebhookServiceImpl {
+	ciHandlerService trigger.HandlerService, ciWorkflowRepository pipelineConfig.CiWorkflowRepository) *GitWebhookServiceImpl {
 	return &GitWebhookServiceImpl{
 		logger:               Logger,
 		gitWebhookRepository: gitWebhookRepository,
 		ciHandlerService:     ciHandlerService,
+		ciWorkflowRepository: ciWorkflowRepository,
 	}
 }
 
@@ -80,5 +87,49 @@ func (impl *GitWebhookServiceImpl) HandleGitWebhook(gitWebhookRequest gitSensor.
 		impl.logger.Errorw("failed HandleCIWebhook", "err", err)
 		return 0, err
 	}
+	if resp > 0 && ciPipelineMaterial.Type == string(constants.SOURCE_TYPE_BRANCH_FIXED) {
+		impl.cancelSupersededBuilds(resp, ciPipelineMaterial.Id)
+	}
 	return resp, nil
 }
+
+// cancelSupersededBuilds aborts the in-progress builds of the pipeline which were triggered before the given
+// build for an older commit of the same branch if the pipeline opted in, failures are logged as the new build is already triggered
+func (impl *GitWebhookServiceImpl) cancelSupersededBuilds(ciWorkflowId int, ciPipelineMaterialId int) {
+	ciWorkflow, err := impl.ciWorkflowRepository.FindById(ciWorkflowId)
+	if err != nil {
+		impl.logger.Errorw("error in fetching ci workflow", "ciWorkflowId", ciWorkflowId, "err", err)
+		return
+	}
+	if ciWorkflow.CiPipeline == nil || !ciWorkflow.CiPipeline.AutoCancelSuperseded {
+		return
+	}
+	gitTrigger, ok := ciWorkflow.GitTriggers[ciPipelineMaterialId]
+	if !ok {
+		return
+	}
+	inProgressStatuses := []string{cdWorkflow.WorkflowInQueue, cdWorkflow.WorkflowStarting, string(v1alpha1.NodePending), string(v1alpha1.NodeRunning)}
+	inProgressWorkflows, err := impl.ciWorkflowRepository.FindByPipelineIdAndStatusesIn(ciWorkflow.CiPipelineId, inProgressStatuses)
+	if err != nil {
+		impl.logger.Errorw("error in fetching in progress ci workflows", "ciPipelineId", ciWorkflow.CiPipelineId, "err", err)
+		return
+	}
+	for _, inProgressWorkflow := range inProgressWorkflows {
+		olderGitTrigger, ok := inProgressWorkflow.GitTriggers[ciPipelineMaterialId]
+		if !ok || inProgressWorkflow.Id >= ciWorkflow.Id ||
+			olderGitTrigger.CiConfigureSourceValue != gitTrigger.CiConfigureSourceValue || olderGitTrigger.Commit == gitTrigger.Commit {
+			continue
+		}
+		_, err = impl.ciHandlerService.CancelBuild(inProgressWorkflow.Id, false)
+		if err != nil {
+			impl.logger.Errorw("error in cancelling superseded ci build", "ciWorkflowId", inProgressWorkflow.Id, "supersededBy", ciWorkflow.Id, "err", err)
+			continue
+		}
+		err = impl.ciWorkflowRepository.UpdateMessage(inProgressWorkflow.Id, fmt.Sprintf(supersededBuildMessage, ciWorkflow.Id, gitTrigger.Commit))
+		if err != nil {
+			impl.logger.Errorw("error in marking ci build superseded", "ciWorkflowId", inProgressWorkflow.Id, "err", err)
+			continue
+		}
+		impl.logger.Infow("cancelled superseded ci build", "ciWorkflowId", inProgressWorkflow.Id, "supersededBy", ciWorkflow.Id, "branch", gitTrigger.CiConfigureSourceValue)
+	}
+}
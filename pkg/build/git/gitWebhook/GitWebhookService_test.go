@@ -0,0 +1,99 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gitWebhook
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/devtron-labs/devtron/client/gitSensor"
+	"github.com/devtron-labs/devtron/internal/sql/constants"
+	"github.com/devtron-labs/devtron/internal/sql/repository/pipelineConfig"
+	"github.com/devtron-labs/devtron/internal/sql/repository/pipelineConfig/mocks"
+	triggerMocks "github.com/devtron-labs/devtron/pkg/build/trigger/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+const (
+	testCiPipelineId         = 1
+	testCiPipelineMaterialId = 10
+)
+
+func newTestCiWorkflow(id int, status, branch, commit string, ciPipeline *pipelineConfig.CiPipeline) *pipelineConfig.CiWorkflow {
+	return &pipelineConfig.CiWorkflow{
+		Id:           id,
+		Status:       status,
+		CiPipelineId: testCiPipelineId,
+		CiPipeline:   ciPipeline,
+		GitTriggers: map[int]pipelineConfig.GitCommit{
+			testCiPipelineMaterialId: {Commit: commit, CiConfigureSourceValue: branch},
+		},
+	}
+}
+
+func TestHandleGitWebhookCancelsSupersededBuilds(t *testing.T) {
+	newService := func(t *testing.T, autoCancelSuperseded bool) (*GitWebhookServiceImpl, *triggerMocks.HandlerService, *mocks.CiWorkflowRepository) {
+		ciPipeline := &pipelineConfig.CiPipeline{Id: testCiPipelineId, AutoCancelSuperseded: autoCancelSuperseded}
+		handlerService := triggerMocks.NewHandlerService(t)
+		handlerService.On("HandleCIWebhook", mock.AnythingOfType("bean.GitCiTriggerRequest")).Return(4, nil)
+		ciWorkflowRepository := mocks.NewCiWorkflowRepository(t)
+		ciWorkflowRepository.On("FindById", 4).Return(newTestCiWorkflow(4, "Running", "main", "ddd", ciPipeline), nil).Maybe()
+		ciWorkflowRepository.On("FindByPipelineIdAndStatusesIn", testCiPipelineId, mock.Anything).Return([]*pipelineConfig.CiWorkflow{
+			newTestCiWorkflow(1, "Running", "main", "aaa", ciPipeline),
+			newTestCiWorkflow(2, "Running", "feature", "bbb", ciPipeline),
+			newTestCiWorkflow(4, "Running", "main", "ddd", ciPipeline),
+		}, nil).Maybe()
+		return NewGitWebhookServiceImpl(zap.NewNop().Sugar(), nil, handlerService, ciWorkflowRepository), handlerService, ciWorkflowRepository
+	}
+	webhookRequest := gitSensor.CiPipelineMaterial{
+		Id:        testCiPipelineMaterialId,
+		Type:      gitSensor.SourceType(constants.SOURCE_TYPE_BRANCH_FIXED),
+		Value:     "main",
+		GitCommit: gitSensor.GitCommit{Commit: "ddd"},
+	}
+
+	t.Run("opted in pipeline cancels older builds of the same branch", func(t *testing.T) {
+		impl, handlerService, ciWorkflowRepository := newService(t, true)
+		handlerService.On("CancelBuild", 1, false).Return(1, nil).Once()
+		ciWorkflowRepository.On("UpdateMessage", 1, fmt.Sprintf(supersededBuildMessage, 4, "ddd")).Return(nil).Once()
+		resp, err := impl.HandleGitWebhook(webhookRequest)
+		assert.Nil(t, err)
+		assert.Equal(t, 4, resp)
+	})
+
+	t.Run("pipeline not opted in keeps older builds running", func(t *testing.T) {
+		impl, handlerService, ciWorkflowRepository := newService(t, false)
+		resp, err := impl.HandleGitWebhook(webhookRequest)
+		assert.Nil(t, err)
+		assert.Equal(t, 4, resp)
+		handlerService.AssertNotCalled(t, "CancelBuild", mock.Anything, mock.Anything)
+		ciWorkflowRepository.AssertNotCalled(t, "UpdateMessage", mock.Anything, mock.Anything)
+	})
+
+	t.Run("webhook materials are not cancelled", func(t *testing.T) {
+		impl, handlerService, ciWorkflowRepository := newService(t, true)
+		request := webhookRequest
+		request.Type = gitSensor.SourceType(constants.SOURCE_TYPE_WEBHOOK)
+		request.GitCommit = gitSensor.GitCommit{WebhookData: &gitSensor.WebhookData{Id: 1}}
+		_, err := impl.HandleGitWebhook(request)
+		assert.Nil(t, err)
+		handlerService.AssertNotCalled(t, "CancelBuild", mock.Anything, mock.Anything)
+		ciWorkflowRepository.AssertNotCalled(t, "FindById", mock.Anything)
+	})
+}
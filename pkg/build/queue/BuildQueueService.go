@@ -0,0 +1,536 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package queue
+
+import (
+	"fmt"
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/caarlos0/env"
+	appRepository "github.com/devtron-labs/devtron/internal/sql/repository/app"
+	"github.com/devtron-labs/devtron/internal/sql/repository/pipelineConfig"
+	"github.com/devtron-labs/devtron/internal/sql/repository/pipelineConfig/bean/workflow/cdWorkflow"
+	"github.com/devtron-labs/devtron/internal/util"
+	bean2 "github.com/devtron-labs/devtron/pkg/auth/user/bean"
+	"github.com/devtron-labs/devtron/pkg/build/queue/bean"
+	"github.com/devtron-labs/devtron/pkg/build/queue/repository"
+	clusterBean "github.com/devtron-labs/devtron/pkg/cluster/bean"
+	"github.com/devtron-labs/devtron/pkg/executor"
+	"github.com/devtron-labs/devtron/pkg/pipeline"
+	"github.com/devtron-labs/devtron/pkg/pipeline/types"
+	"github.com/devtron-labs/devtron/pkg/sql"
+	auditService "github.com/devtron-labs/devtron/pkg/workflow/trigger/audit/service"
+	cron2 "github.com/devtron-labs/devtron/util/cron"
+	"github.com/robfig/cron/v3"
+	"go.uber.org/zap"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// BuildQueueService holds triggered ci builds in a persistent queue and starts them once a slot is free
+// within the global, cluster and project concurrency limits
+type BuildQueueService interface {
+	IsEnabled() bool
+	// Enqueue persists the prepared workflow request of a triggered build and marks the ci workflow as queued,
+	// older queued builds of the same pipeline and branch are cancelled if configured
+	Enqueue(ciWorkflow *pipelineConfig.CiWorkflow, workflowRequest *types.WorkflowRequest) error
+	// Dispatch starts queued builds in priority order while slots are available
+	Dispatch()
+	// CancelQueuedBuild removes a build from the queue, returns false if the build is not waiting in the queue
+	CancelQueuedBuild(ciWorkflowId int) (bool, error)
+}
+
+type BuildQueueServiceImpl struct {
+	logger                      *zap.SugaredLogger
+	ciBuildQueueRepository      repository.CiBuildQueueRepository
+	ciWorkflowRepository        pipelineConfig.CiWorkflowRepository
+	ciService                   pipeline.CiService
+	workflowService             executor.WorkflowService
+	workflowTriggerAuditService auditService.WorkflowTriggerAuditService
+	appRepository               appRepository.AppRepository
+	customTagService            pipeline.CustomTagService
+	config                      *bean.BuildQueueConfig
+	limits                      *concurrencyLimits
+	highPriorityBranchRegex     *regexp.Regexp
+	cron                        *cron.Cron
+}
+
+func NewBuildQueueServiceImpl(logger *zap.SugaredLogger,
+	ciBuildQueueRepository repository.CiBuildQueueRepository,
+	ciWorkflowRepository pipelineConfig.CiWorkflowRepository,
+	ciService pipeline.CiService,
+	workflowService executor.WorkflowService,
+	workflowTriggerAuditService auditService.WorkflowTriggerAuditService,
+	appRepository appRepository.AppRepository,
+	customTagService pipeline.CustomTagService,
+	cronLogger *cron2.CronLoggerImpl) (*BuildQueueServiceImpl, error) {
+	config := &bean.BuildQueueConfig{}
+	err := env.Parse(config)
+	if err != nil {
+		logger.Errorw("error in parsing build queue config", "err", err)
+		return nil, err
+	}
+	impl := &BuildQueueServiceImpl{
+		logger:                      logger,
+		ciBuildQueueRepository:      ciBuildQueueRepository,
+		ciWorkflowRepository:        ciWorkflowRepository,
+		ciService:                   ciService,
+		workflowService:             workflowService,
+		workflowTriggerAuditService: workflowTriggerAuditService,
+		appRepository:               appRepository,
+		customTagService:            customTagService,
+		config:                      config,
+	}
+	if !config.Enabled {
+		return impl, nil
+	}
+	impl.limits, err = newConcurrencyLimits(config)
+	if err != nil {
+		logger.Errorw("error in parsing build queue concurrency limits", "config", config, "err", err)
+		return nil, err
+	}
+	if len(config.HighPriorityBranchRegex) > 0 {
+		impl.highPriorityBranchRegex, err = regexp.Compile(config.HighPriorityBranchRegex)
+		if err != nil {
+			logger.Errorw("error in compiling build queue high priority branch regex", "regex", config.HighPriorityBranchRegex, "err", err)
+			return nil, err
+		}
+	}
+	// dispatching on trigger alone is not enough, slots are freed when builds finish
+	impl.cron = cron.New(cron.WithChain(cron.SkipIfStillRunning(cronLogger), cron.Recover(cronLogger)))
+	_, err = impl.cron.AddFunc(config.DispatchCron, impl.Dispatch)
+	if err != nil {
+		logger.Errorw("error in adding build queue dispatch cron", "cronExpression", config.DispatchCron, "err", err)
+		return nil, err
+	}
+	impl.cron.Start()
+	return impl, nil
+}
+
+func (impl *BuildQueueServiceImpl) IsEnabled() bool {
+	return impl.config.Enabled
+}
+
+func (impl *BuildQueueServiceImpl) Enqueue(ciWorkflow *pipelineConfig.CiWorkflow, workflowRequest *types.WorkflowRequest) error {
+	sanitizedWorkflowRequest, err := impl.workflowTriggerAuditService.GetSanitizedCompressedWorkflowRequest(workflowRequest)
+	if err != nil {
+		impl.logger.Errorw("error in compressing workflow request for build queue", "ciWorkflowId", ciWorkflow.Id, "err", err)
+		return err
+	}
+	app, err := impl.appRepository.FindById(workflowRequest.AppId)
+	if err != nil {
+		impl.logger.Errorw("error in fetching app", "appId", workflowRequest.AppId, "err", err)
+		return err
+	}
+	clusterId := clusterBean.DefaultClusterId
+	if workflowRequest.Env != nil {
+		clusterId = workflowRequest.Env.ClusterId
+	}
+	branchKey, branches := getBranchKey(ciWorkflow)
+	item := &repository.CiBuildQueueItem{
+		CiWorkflowId:    ciWorkflow.Id,
+		CiPipelineId:    ciWorkflow.CiPipelineId,
+		AppId:           app.Id,
+		TeamId:          app.TeamId,
+		ClusterId:       clusterId,
+		BranchKey:       branchKey,
+		Priority:        impl.getPriority(branches),
+		Status:          repository.QueueItemStatusQueued,
+		WorkflowStatus:  ciWorkflow.Status,
+		WorkflowRequest: sanitizedWorkflowRequest,
+		AuditLog:        sql.NewDefaultAuditLog(workflowRequest.TriggeredBy),
+	}
+
+	tx, err := impl.ciBuildQueueRepository.StartTx()
+	if err != nil {
+		impl.logger.Errorw("error in starting transaction", "err", err)
+		return err
+	}
+	defer impl.ciBuildQueueRepository.RollbackTx(tx)
+	err = impl.ciBuildQueueRepository.AcquireLock(tx)
+	if err != nil {
+		impl.logger.Errorw("error in acquiring build queue lock", "err", err)
+		return err
+	}
+	var supersededItems []*repository.CiBuildQueueItem
+	if impl.config.CancelSupersededBuilds {
+		supersededItems, err = impl.ciBuildQueueRepository.FindQueuedByPipelineAndBranch(ciWorkflow.CiPipelineId, branchKey, tx)
+		if err != nil && !util.IsErrNoRows(err) {
+			impl.logger.Errorw("error in fetching queued builds of branch", "ciPipelineId", ciWorkflow.CiPipelineId, "branchKey", branchKey, "err", err)
+			return err
+		}
+		for _, supersededItem := range supersededItems {
+			supersededItem.Status = repository.QueueItemStatusSuperseded
+			supersededItem.Message = fmt.Sprintf("%s (workflow %d)", bean.SupersededMessage, ciWorkflow.Id)
+			supersededItem.UpdateAuditLog(workflowRequest.TriggeredBy)
+			err = impl.ciBuildQueueRepository.Update(supersededItem, tx)
+			if err != nil {
+				impl.logger.Errorw("error in marking queued build superseded", "ciWorkflowId", supersededItem.CiWorkflowId, "err", err)
+				return err
+			}
+		}
+	}
+	err = impl.ciBuildQueueRepository.Save(item, tx)
+	if err != nil {
+		impl.logger.Errorw("error in saving build queue item", "ciWorkflowId", ciWorkflow.Id, "err", err)
+		return err
+	}
+	ciWorkflow.Status = cdWorkflow.WorkflowInQueue
+	err = impl.ciWorkflowRepository.UpdateWorkFlowWithTx(ciWorkflow, tx)
+	if err != nil {
+		impl.logger.Errorw("error in marking ci workflow queued", "ciWorkflowId", ciWorkflow.Id, "err", err)
+		return err
+	}
+	err = impl.ciBuildQueueRepository.CommitTx(tx)
+	if err != nil {
+		impl.logger.Errorw("error in committing transaction", "err", err)
+		return err
+	}
+
+	for _, supersededItem := range supersededItems {
+		err = impl.cancelCiWorkflow(supersededItem.CiWorkflowId, supersededItem.Message)
+		if err != nil {
+			impl.logger.Errorw("error in cancelling superseded ci workflow", "ciWorkflowId", supersededItem.CiWorkflowId, "err", err)
+		}
+	}
+	impl.Dispatch()
+	return nil
+}
+
+func (impl *BuildQueueServiceImpl) Dispatch() {
+	items, err := impl.reserveSlots()
+	if err != nil {
+		impl.logger.Errorw("error in reserving slots for queued builds", "err", err)
+		return
+	}
+	for _, item := range items {
+		impl.startBuild(item)
+	}
+}
+
+// reserveSlots marks the queued builds which fit in the free slots as dispatched and restores the status
+// their ci workflows had before being queued, the builds are started after the lock is released
+func (impl *BuildQueueServiceImpl) reserveSlots() ([]*repository.CiBuildQueueItem, error) {
+	tx, err := impl.ciBuildQueueRepository.StartTx()
+	if err != nil {
+		impl.logger.Errorw("error in starting transaction", "err", err)
+		return nil, err
+	}
+	defer impl.ciBuildQueueRepository.RollbackTx(tx)
+	err = impl.ciBuildQueueRepository.AcquireLock(tx)
+	if err != nil {
+		impl.logger.Errorw("error in acquiring build queue lock", "err", err)
+		return nil, err
+	}
+	terminalStatuses := append([]string{string(v1alpha1.WorkflowError)}, cdWorkflow.WfrTerminalStatusList...)
+	staleBefore := time.Now().Add(-time.Duration(impl.config.StaleDispatchMinutes) * time.Minute)
+	err = impl.ciBuildQueueRepository.MarkFinishedDispatchedItemsCompleted(terminalStatuses, staleBefore, tx)
+	if err != nil {
+		impl.logger.Errorw("error in releasing slots of finished builds", "err", err)
+		return nil, err
+	}
+	runningCounts, err := impl.ciBuildQueueRepository.FindRunningCounts(tx)
+	if err != nil && !util.IsErrNoRows(err) {
+		impl.logger.Errorw("error in fetching running build counts", "err", err)
+		return nil, err
+	}
+	queuedItems, err := impl.ciBuildQueueRepository.FindQueued(tx)
+	if err != nil && !util.IsErrNoRows(err) {
+		impl.logger.Errorw("error in fetching queued builds", "err", err)
+		return nil, err
+	}
+	items := selectItemsToDispatch(queuedItems, runningCounts, impl.limits)
+	for _, item := range items {
+		item.Status = repository.QueueItemStatusDispatched
+		item.DispatchedOn = time.Now()
+		item.UpdateAuditLog(bean2.SYSTEM_USER_ID)
+		err = impl.ciBuildQueueRepository.Update(item, tx)
+		if err != nil {
+			impl.logger.Errorw("error in marking queued build dispatched", "ciWorkflowId", item.CiWorkflowId, "err", err)
+			return nil, err
+		}
+		ciWorkflow, err := impl.ciWorkflowRepository.FindById(item.CiWorkflowId)
+		if err != nil {
+			impl.logger.Errorw("error in fetching ci workflow", "ciWorkflowId", item.CiWorkflowId, "err", err)
+			return nil, err
+		}
+		ciWorkflow.Status = item.WorkflowStatus
+		ciWorkflow.StartedOn = time.Now()
+		err = impl.ciWorkflowRepository.UpdateWorkFlowWithTx(ciWorkflow, tx)
+		if err != nil {
+			impl.logger.Errorw("error in updating status of dispatched ci workflow", "ciWorkflowId", item.CiWorkflowId, "err", err)
+			return nil, err
+		}
+	}
+	err = impl.ciBuildQueueRepository.CommitTx(tx)
+	if err != nil {
+		impl.logger.Errorw("error in committing transaction", "err", err)
+		return nil, err
+	}
+	return items, nil
+}
+
+func (impl *BuildQueueServiceImpl) startBuild(item *repository.CiBuildQueueItem) {
+	workflowRequest, err := impl.workflowTriggerAuditService.GetWorkflowRequestFromSanitizedData(item.WorkflowRequest)
+	if err == nil {
+		_, _, err = impl.workflowService.SubmitWorkflow(workflowRequest)
+	}
+	if err == nil {
+		impl.logger.Infow("started queued build", "ciWorkflowId", item.CiWorkflowId, "ciPipelineId", item.CiPipelineId)
+		return
+	}
+	impl.logger.Errorw("error in starting queued build", "ciWorkflowId", item.CiWorkflowId, "err", err)
+	err1 := impl.ciBuildQueueRepository.UpdateStatus(item.Id, repository.QueueItemStatusFailed, err.Error(), bean2.SYSTEM_USER_ID)
+	if err1 != nil {
+		impl.logger.Errorw("error in marking queued build failed", "ciWorkflowId", item.CiWorkflowId, "err", err1)
+	}
+	ciWorkflow, err1 := impl.ciWorkflowRepository.FindById(item.CiWorkflowId)
+	if err1 != nil {
+		impl.logger.Errorw("error in fetching ci workflow", "ciWorkflowId", item.CiWorkflowId, "err", err1)
+		return
+	}
+	ciWorkflow.Status = cdWorkflow.WorkflowFailed
+	ciWorkflow.Message = err.Error()
+	ciWorkflow.FinishedOn = time.Now()
+	err1 = impl.ciService.UpdateCiWorkflowWithStage(ciWorkflow)
+	if err1 != nil {
+		impl.logger.Errorw("error in marking ci workflow failed", "ciWorkflowId", item.CiWorkflowId, "err", err1)
+	}
+}
+
+func (impl *BuildQueueServiceImpl) CancelQueuedBuild(ciWorkflowId int) (bool, error) {
+	tx, err := impl.ciBuildQueueRepository.StartTx()
+	if err != nil {
+		impl.logger.Errorw("error in starting transaction", "err", err)
+		return false, err
+	}
+	defer impl.ciBuildQueueRepository.RollbackTx(tx)
+	err = impl.ciBuildQueueRepository.AcquireLock(tx)
+	if err != nil {
+		impl.logger.Errorw("error in acquiring build queue lock", "err", err)
+		return false, err
+	}
+	item, err := impl.ciBuildQueueRepository.FindByCiWorkflowId(ciWorkflowId)
+	if util.IsErrNoRows(err) {
+		return false, nil
+	} else if err != nil {
+		impl.logger.Errorw("error in fetching build queue item", "ciWorkflowId", ciWorkflowId, "err", err)
+		return false, err
+	}
+	if item.Status != repository.QueueItemStatusQueued {
+		return false, nil
+	}
+	item.Status = repository.QueueItemStatusCancelled
+	item.Message = bean.QueueCancelledMessage
+	item.UpdateAuditLog(bean2.SYSTEM_USER_ID)
+	err = impl.ciBuildQueueRepository.Update(item, tx)
+	if err != nil {
+		impl.logger.Errorw("error in marking queued build cancelled", "ciWorkflowId", ciWorkflowId, "err", err)
+		return false, err
+	}
+	err = impl.ciBuildQueueRepository.CommitTx(tx)
+	if err != nil {
+		impl.logger.Errorw("error in committing transaction", "err", err)
+		return false, err
+	}
+	err = impl.cancelCiWorkflow(ciWorkflowId, bean.QueueCancelledMessage)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// cancelCiWorkflow marks a ci workflow which never left the queue cancelled and releases its image tag reservations
+func (impl *BuildQueueServiceImpl) cancelCiWorkflow(ciWorkflowId int, message string) error {
+	ciWorkflow, err := impl.ciWorkflowRepository.FindById(ciWorkflowId)
+	if err != nil {
+		impl.logger.Errorw("error in fetching ci workflow", "ciWorkflowId", ciWorkflowId, "err", err)
+		return err
+	}
+	ciWorkflow.Status = cdWorkflow.WorkflowCancel
+	ciWorkflow.Message = message
+	ciWorkflow.FinishedOn = time.Now()
+	err = impl.ciService.UpdateCiWorkflowWithStage(ciWorkflow)
+	if err != nil {
+		impl.logger.Errorw("error in marking ci workflow cancelled", "ciWorkflowId", ciWorkflowId, "err", err)
+		return err
+	}
+	err = impl.customTagService.DeactivateImagePathReservation(ciWorkflow.ImagePathReservationId)
+	if err != nil {
+		impl.logger.Errorw("error in marking image tag unreserved", "ciWorkflowId", ciWorkflowId, "err", err)
+		return err
+	}
+	if len(ciWorkflow.ImagePathReservationIds) > 0 {
+		err = impl.customTagService.DeactivateImagePathReservationByImageIds(ciWorkflow.ImagePathReservationIds)
+		if err != nil {
+			impl.logger.Errorw("error in marking image tag unreserved", "ciWorkflowId", ciWorkflowId, "err", err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (impl *BuildQueueServiceImpl) getPriority(branches []string) int {
+	if impl.highPriorityBranchRegex == nil {
+		return bean.PriorityNormal
+	}
+	for _, branch := range branches {
+		if impl.highPriorityBranchRegex.MatchString(branch) {
+			return bean.PriorityHigh
+		}
+	}
+	return bean.PriorityNormal
+}
+
+// getBranchKey identifies the source revision line of a build as the sorted materialId:branch pairs of its git triggers
+func getBranchKey(ciWorkflow *pipelineConfig.CiWorkflow) (string, []string) {
+	materialIds := make([]int, 0, len(ciWorkflow.GitTriggers))
+	for materialId := range ciWorkflow.GitTriggers {
+		materialIds = append(materialIds, materialId)
+	}
+	sort.Ints(materialIds)
+	keys := make([]string, 0, len(materialIds))
+	branches := make([]string, 0, len(materialIds))
+	for _, materialId := range materialIds {
+		branch := ciWorkflow.GitTriggers[materialId].CiConfigureSourceValue
+		keys = append(keys, fmt.Sprintf("%d:%s", materialId, branch))
+		branches = append(branches, branch)
+	}
+	return strings.Join(keys, ","), branches
+}
+
+type concurrencyLimits struct {
+	global          int
+	cluster         int
+	project         int
+	clusterOverride map[int]int
+	projectOverride map[int]int
+}
+
+func newConcurrencyLimits(config *bean.BuildQueueConfig) (*concurrencyLimits, error) {
+	clusterOverride, err := parseConcurrencyOverride(config.ClusterConcurrencyOverride)
+	if err != nil {
+		return nil, err
+	}
+	projectOverride, err := parseConcurrencyOverride(config.ProjectConcurrencyOverride)
+	if err != nil {
+		return nil, err
+	}
+	return &concurrencyLimits{
+		global:          config.GlobalConcurrency,
+		cluster:         config.ClusterConcurrency,
+		project:         config.ProjectConcurrency,
+		clusterOverride: clusterOverride,
+		projectOverride: projectOverride,
+	}, nil
+}
+
+// parseConcurrencyOverride parses comma separated id=limit pairs
+func parseConcurrencyOverride(override string) (map[int]int, error) {
+	limits := make(map[int]int)
+	for _, pair := range strings.Split(override, ",") {
+		pair = strings.TrimSpace(pair)
+		if len(pair) == 0 {
+			continue
+		}
+		idAndLimit := strings.SplitN(pair, "=", 2)
+		if len(idAndLimit) != 2 {
+			return nil, fmt.Errorf("invalid concurrency override %q, expected id=limit", pair)
+		}
+		id, err := strconv.Atoi(strings.TrimSpace(idAndLimit[0]))
+		if err != nil {
+			return nil, fmt.Errorf("invalid id in concurrency override %q", pair)
+		}
+		limit, err := strconv.Atoi(strings.TrimSpace(idAndLimit[1]))
+		if err != nil {
+			return nil, fmt.Errorf("invalid limit in concurrency override %q", pair)
+		}
+		limits[id] = limit
+	}
+	return limits, nil
+}
+
+func (limits *concurrencyLimits) clusterLimit(clusterId int) int {
+	if limit, ok := limits.clusterOverride[clusterId]; ok {
+		return limit
+	}
+	return limits.cluster
+}
+
+func (limits *concurrencyLimits) projectLimit(teamId int) int {
+	if limit, ok := limits.projectOverride[teamId]; ok {
+		return limit
+	}
+	return limits.project
+}
+
+// selectItemsToDispatch picks queued builds, ordered by priority and queue time, that fit in the free slots.
+// Within a priority class the next slot goes to the project with the fewest running builds so that one busy
+// project cannot starve the others, ties are broken by queue time. A limit of 0 means unlimited.
+func selectItemsToDispatch(queuedItems []*repository.CiBuildQueueItem, runningCounts []*repository.RunningBuildCount, limits *concurrencyLimits) []*repository.CiBuildQueueItem {
+	totalRunning := 0
+	runningByCluster := make(map[int]int)
+	runningByProject := make(map[int]int)
+	for _, runningCount := range runningCounts {
+		totalRunning += runningCount.Count
+		runningByCluster[runningCount.ClusterId] += runningCount.Count
+		runningByProject[runningCount.TeamId] += runningCount.Count
+	}
+	fitsInSlot := func(item *repository.CiBuildQueueItem) bool {
+		if limit := limits.clusterLimit(item.ClusterId); limit > 0 && runningByCluster[item.ClusterId] >= limit {
+			return false
+		}
+		if limit := limits.projectLimit(item.TeamId); limit > 0 && runningByProject[item.TeamId] >= limit {
+			return false
+		}
+		return true
+	}
+	selected := make([]*repository.CiBuildQueueItem, 0)
+	isSelected := make([]bool, len(queuedItems))
+	for limits.global <= 0 || totalRunning < limits.global {
+		bestIndex := -1
+		for i, item := range queuedItems {
+			if isSelected[i] || !fitsInSlot(item) {
+				continue
+			}
+			if bestIndex == -1 {
+				bestIndex = i
+				continue
+			}
+			best := queuedItems[bestIndex]
+			if item.Priority != best.Priority {
+				// items are ordered by priority, the rest belong to a lower class
+				break
+			}
+			if runningByProject[item.TeamId] < runningByProject[best.TeamId] {
+				bestIndex = i
+			}
+		}
+		if bestIndex == -1 {
+			break
+		}
+		item := queuedItems[bestIndex]
+		isSelected[bestIndex] = true
+		selected = append(selected, item)
+		totalRunning++
+		runningByCluster[item.ClusterId]++
+		runningByProject[item.TeamId]++
+	}
+	return selected
+}
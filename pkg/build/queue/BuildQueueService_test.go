@@ -0,0 +1,75 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package queue
+
+import (
+	"testing"
+
+	"github.com/devtron-labs/devtron/pkg/build/queue/repository"
+	"github.com/stretchr/testify/assert"
+)
+
+func queuedItem(id, teamId, clusterId, priority int) *repository.CiBuildQueueItem {
+	return &repository.CiBuildQueueItem{Id: id, CiWorkflowId: id, TeamId: teamId, ClusterId: clusterId, Priority: priority}
+}
+
+func selectedIds(items []*repository.CiBuildQueueItem) []int {
+	ids := make([]int, 0, len(items))
+	for _, item := range items {
+		ids = append(ids, item.Id)
+	}
+	return ids
+}
+
+func TestSelectItemsToDispatch(t *testing.T) {
+	t.Run("unlimited dispatches everything in queue order", func(t *testing.T) {
+		queued := []*repository.CiBuildQueueItem{queuedItem(1, 1, 1, 0), queuedItem(2, 2, 1, 0)}
+		selected := selectItemsToDispatch(queued, nil, &concurrencyLimits{})
+		assert.Equal(t, []int{1, 2}, selectedIds(selected))
+	})
+	t.Run("global limit counts running builds", func(t *testing.T) {
+		queued := []*repository.CiBuildQueueItem{queuedItem(1, 1, 1, 0), queuedItem(2, 1, 1, 0)}
+		running := []*repository.RunningBuildCount{{ClusterId: 1, TeamId: 3, Count: 2}}
+		selected := selectItemsToDispatch(queued, running, &concurrencyLimits{global: 3})
+		assert.Equal(t, []int{1}, selectedIds(selected))
+	})
+	t.Run("high priority class is dispatched first", func(t *testing.T) {
+		queued := []*repository.CiBuildQueueItem{queuedItem(3, 1, 1, 1), queuedItem(1, 1, 1, 0)}
+		selected := selectItemsToDispatch(queued, nil, &concurrencyLimits{global: 1})
+		assert.Equal(t, []int{3}, selectedIds(selected))
+	})
+	t.Run("project with fewer running builds gets the slot", func(t *testing.T) {
+		queued := []*repository.CiBuildQueueItem{queuedItem(1, 1, 1, 0), queuedItem(2, 1, 1, 0), queuedItem(3, 2, 1, 0)}
+		running := []*repository.RunningBuildCount{{ClusterId: 1, TeamId: 1, Count: 1}}
+		selected := selectItemsToDispatch(queued, running, &concurrencyLimits{global: 3})
+		assert.Equal(t, []int{3, 1}, selectedIds(selected))
+	})
+	t.Run("cluster and project overrides are applied", func(t *testing.T) {
+		queued := []*repository.CiBuildQueueItem{queuedItem(1, 1, 1, 0), queuedItem(2, 1, 2, 0), queuedItem(3, 2, 2, 0), queuedItem(4, 2, 2, 0)}
+		limits := &concurrencyLimits{cluster: 5, project: 1, clusterOverride: map[int]int{1: 0, 2: 1}, projectOverride: map[int]int{1: 2}}
+		selected := selectItemsToDispatch(queued, nil, limits)
+		assert.Equal(t, []int{1, 3}, selectedIds(selected))
+	})
+}
+
+func TestParseConcurrencyOverride(t *testing.T) {
+	limits, err := parseConcurrencyOverride(" 1=20, 3=5 ,")
+	assert.Nil(t, err)
+	assert.Equal(t, map[int]int{1: 20, 3: 5}, limits)
+	_, err = parseConcurrencyOverride("1:20")
+	assert.NotNil(t, err)
+}
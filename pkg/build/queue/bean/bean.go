@@ -0,0 +1,39 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package bean
+
+const (
+	PriorityNormal = 0
+	PriorityHigh   = 1
+
+	SupersededMessage     = "superseded by a newer build of the same branch"
+	QueueCancelledMessage = "build cancelled while waiting in the build queue"
+)
+
+// CATEGORY=CI_RUNNER
+type BuildQueueConfig struct {
+	Enabled                    bool   `env:"CI_BUILD_QUEUE_ENABLED" envDefault:"false" description:"Hold ci builds in a persistent queue and start them as per the configured concurrency limits"`
+	GlobalConcurrency          int    `env:"CI_BUILD_QUEUE_GLOBAL_CONCURRENCY" envDefault:"0" description:"Maximum ci builds running at a time across all clusters, 0 means unlimited"`
+	ClusterConcurrency         int    `env:"CI_BUILD_QUEUE_CLUSTER_CONCURRENCY" envDefault:"0" description:"Maximum ci builds running at a time in a cluster, 0 means unlimited"`
+	ClusterConcurrencyOverride string `env:"CI_BUILD_QUEUE_CLUSTER_CONCURRENCY_OVERRIDE" envDefault:"" description:"Per cluster concurrency as comma separated clusterId=limit pairs, overrides CI_BUILD_QUEUE_CLUSTER_CONCURRENCY" example:"1=20,3=5"`
+	ProjectConcurrency         int    `env:"CI_BUILD_QUEUE_PROJECT_CONCURRENCY" envDefault:"0" description:"Maximum ci builds running at a time for apps of a project, 0 means unlimited"`
+	ProjectConcurrencyOverride string `env:"CI_BUILD_QUEUE_PROJECT_CONCURRENCY_OVERRIDE" envDefault:"" description:"Per project concurrency as comma separated projectId=limit pairs, overrides CI_BUILD_QUEUE_PROJECT_CONCURRENCY" example:"2=10"`
+	HighPriorityBranchRegex    string `env:"CI_BUILD_QUEUE_HIGH_PRIORITY_BRANCH_REGEX" envDefault:"^(main|master|release.*|hotfix.*)$" description:"Builds of branches matching this regex are started before other queued builds"`
+	CancelSupersededBuilds     bool   `env:"CI_BUILD_QUEUE_CANCEL_SUPERSEDED" envDefault:"true" description:"Cancel a queued build when a newer build of the same pipeline and branch is queued"`
+	DispatchCron               string `env:"CI_BUILD_QUEUE_DISPATCH_CRON" envDefault:"@every 15s" description:"Cron at which queued builds are checked for free slots"`
+	StaleDispatchMinutes       int    `env:"CI_BUILD_QUEUE_STALE_DISPATCH_MINUTES" envDefault:"240" description:"A started build stops holding a concurrency slot after these minutes even if its status was never updated"`
+}
@@ -0,0 +1,169 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package repository
+
+import (
+	"github.com/devtron-labs/devtron/pkg/sql"
+	"github.com/go-pg/pg"
+	"go.uber.org/zap"
+	"time"
+)
+
+type QueueItemStatus string
+
+const (
+	QueueItemStatusQueued     QueueItemStatus = "QUEUED"
+	QueueItemStatusDispatched QueueItemStatus = "DISPATCHED"
+	QueueItemStatusCompleted  QueueItemStatus = "COMPLETED"
+	QueueItemStatusSuperseded QueueItemStatus = "SUPERSEDED"
+	QueueItemStatusCancelled  QueueItemStatus = "CANCELLED"
+	QueueItemStatusFailed     QueueItemStatus = "FAILED"
+)
+
+// buildQueueLockId is the postgres advisory lock key held while queue items change state, so that
+// concurrency caps are evaluated against a consistent view across orchestrator replicas
+const buildQueueLockId = 736604600
+
+type CiBuildQueueItem struct {
+	TableName       struct{}        `sql:"ci_build_queue" pg:",discard_unknown_columns"`
+	Id              int             `sql:"id,pk"`
+	CiWorkflowId    int             `sql:"ci_workflow_id,notnull"`
+	CiPipelineId    int             `sql:"ci_pipeline_id,notnull"`
+	AppId           int             `sql:"app_id,notnull"`
+	TeamId          int             `sql:"team_id,notnull"`
+	ClusterId       int             `sql:"cluster_id,notnull"`
+	BranchKey       string          `sql:"branch_key"`
+	Priority        int             `sql:"priority,notnull"`
+	Status          QueueItemStatus `sql:"status,notnull"`
+	WorkflowStatus  string          `sql:"workflow_status"`
+	WorkflowRequest string          `sql:"workflow_request"`
+	Message         string          `sql:"message"`
+	DispatchedOn    time.Time       `sql:"dispatched_on"`
+	sql.AuditLog
+}
+
+type RunningBuildCount struct {
+	ClusterId int `sql:"cluster_id"`
+	TeamId    int `sql:"team_id"`
+	Count     int `sql:"count"`
+}
+
+type CiBuildQueueRepository interface {
+	sql.TransactionWrapper
+	// AcquireLock blocks till the queue lock is held by the transaction, it is released on commit or rollback
+	AcquireLock(tx *pg.Tx) error
+	Save(item *CiBuildQueueItem, tx *pg.Tx) error
+	Update(item *CiBuildQueueItem, tx *pg.Tx) error
+	UpdateStatus(id int, status QueueItemStatus, message string, userId int32) error
+	FindByCiWorkflowId(ciWorkflowId int) (*CiBuildQueueItem, error)
+	FindQueued(tx *pg.Tx) ([]*CiBuildQueueItem, error)
+	FindQueuedByPipelineAndBranch(ciPipelineId int, branchKey string, tx *pg.Tx) ([]*CiBuildQueueItem, error)
+	// MarkFinishedDispatchedItemsCompleted releases the slot of dispatched builds whose workflow reached one of
+	// the terminal statuses or which were dispatched before staleBefore
+	MarkFinishedDispatchedItemsCompleted(terminalStatuses []string, staleBefore time.Time, tx *pg.Tx) error
+	FindRunningCounts(tx *pg.Tx) ([]*RunningBuildCount, error)
+	FindQueuePosition(ciWorkflowId int) (int, error)
+}
+
+type CiBuildQueueRepositoryImpl struct {
+	*sql.TransactionUtilImpl
+	dbConnection *pg.DB
+	logger       *zap.SugaredLogger
+}
+
+func NewCiBuildQueueRepositoryImpl(dbConnection *pg.DB, logger *zap.SugaredLogger, TransactionUtilImpl *sql.TransactionUtilImpl) *CiBuildQueueRepositoryImpl {
+	return &CiBuildQueueRepositoryImpl{
+		TransactionUtilImpl: TransactionUtilImpl,
+		dbConnection:        dbConnection,
+		logger:              logger,
+	}
+}
+
+func (impl *CiBuildQueueRepositoryImpl) AcquireLock(tx *pg.Tx) error {
+	_, err := tx.Exec("SELECT pg_advisory_xact_lock(?)", buildQueueLockId)
+	return err
+}
+
+func (impl *CiBuildQueueRepositoryImpl) Save(item *CiBuildQueueItem, tx *pg.Tx) error {
+	return tx.Insert(item)
+}
+
+func (impl *CiBuildQueueRepositoryImpl) Update(item *CiBuildQueueItem, tx *pg.Tx) error {
+	return tx.Update(item)
+}
+
+func (impl *CiBuildQueueRepositoryImpl) UpdateStatus(id int, status QueueItemStatus, message string, userId int32) error {
+	_, err := impl.dbConnection.Model(&CiBuildQueueItem{}).
+		Set("status = ?", status).
+		Set("message = ?", message).
+		Set("updated_on = ?", time.Now()).
+		Set("updated_by = ?", userId).
+		Where("id = ?", id).
+		Update()
+	return err
+}
+
+func (impl *CiBuildQueueRepositoryImpl) FindByCiWorkflowId(ciWorkflowId int) (*CiBuildQueueItem, error) {
+	item := &CiBuildQueueItem{}
+	err := impl.dbConnection.Model(item).
+		Where("ci_workflow_id = ?", ciWorkflowId).
+		Select()
+	return item, err
+}
+
+func (impl *CiBuildQueueRepositoryImpl) FindQueued(tx *pg.Tx) ([]*CiBuildQueueItem, error) {
+	var items []*CiBuildQueueItem
+	err := tx.Model(&items).
+		Where("status = ?", QueueItemStatusQueued).
+		Order("priority DESC", "id ASC").
+		Select()
+	return items, err
+}
+
+func (impl *CiBuildQueueRepositoryImpl) FindQueuedByPipelineAndBranch(ciPipelineId int, branchKey string, tx *pg.Tx) ([]*CiBuildQueueItem, error) {
+	var items []*CiBuildQueueItem
+	err := tx.Model(&items).
+		Where("ci_pipeline_id = ?", ciPipelineId).
+		Where("branch_key = ?", branchKey).
+		Where("status = ?", QueueItemStatusQueued).
+		Select()
+	return items, err
+}
+
+func (impl *CiBuildQueueRepositoryImpl) MarkFinishedDispatchedItemsCompleted(terminalStatuses []string, staleBefore time.Time, tx *pg.Tx) error {
+	query := "UPDATE ci_build_queue SET status = ?, updated_on = ? FROM ci_workflow wf" +
+		" WHERE ci_build_queue.ci_workflow_id = wf.id AND ci_build_queue.status = ?" +
+		" AND (wf.status IN (?) OR ci_build_queue.dispatched_on < ?);"
+	_, err := tx.Exec(query, QueueItemStatusCompleted, time.Now(), QueueItemStatusDispatched, pg.In(terminalStatuses), staleBefore)
+	return err
+}
+
+func (impl *CiBuildQueueRepositoryImpl) FindRunningCounts(tx *pg.Tx) ([]*RunningBuildCount, error) {
+	var counts []*RunningBuildCount
+	query := "SELECT cluster_id, team_id, count(id) AS count FROM ci_build_queue WHERE status = ? GROUP BY cluster_id, team_id;"
+	_, err := tx.Query(&counts, query, QueueItemStatusDispatched)
+	return counts, err
+}
+
+// FindQueuePosition returns the 1 based position of a queued build in dispatch order, 0 when the build is not queued
+func (impl *CiBuildQueueRepositoryImpl) FindQueuePosition(ciWorkflowId int) (int, error) {
+	var position int
+	query := "SELECT count(q.id) FROM ci_build_queue q INNER JOIN ci_build_queue cur ON cur.ci_workflow_id = ? AND cur.status = ?" +
+		" WHERE q.status = ? AND (q.priority > cur.priority OR (q.priority = cur.priority AND q.id <= cur.id));"
+	_, err := impl.dbConnection.Query(pg.Scan(&position), query, ciWorkflowId, QueueItemStatusQueued, QueueItemStatusQueued)
+	return position, err
+}
@@ -41,9 +41,13 @@ import (
 	bean6 "github.com/devtron-labs/devtron/pkg/auth/user/bean"
 	"github.com/devtron-labs/devtron/pkg/bean"
 	"github.com/devtron-labs/devtron/pkg/bean/common"
+	"github.com/devtron-labs/devtron/pkg/build/artifacts/sbom"
+	"github.com/devtron-labs/devtron/pkg/build/buildpack"
+	"github.com/devtron-labs/devtron/pkg/build/cache"
 	"github.com/devtron-labs/devtron/pkg/build/pipeline"
 	buildBean "github.com/devtron-labs/devtron/pkg/build/pipeline/bean"
 	buildCommonBean "github.com/devtron-labs/devtron/pkg/build/pipeline/bean/common"
+	"github.com/devtron-labs/devtron/pkg/build/queue"
 	"github.com/devtron-labs/devtron/pkg/build/trigger/adaptor"
 	"github.com/devtron-labs/devtron/pkg/cluster"
 	adapter2 "github.com/devtron-labs/devtron/pkg/cluster/adapter"
@@ -52,6 +56,7 @@ import (
 	repository6 "github.com/devtron-labs/devtron/pkg/cluster/environment/repository"
 	eventProcessorBean "github.com/devtron-labs/devtron/pkg/eventProcessor/bean"
 	"github.com/devtron-labs/devtron/pkg/executor"
+	infraConfigService "github.com/devtron-labs/devtron/pkg/infraConfig/service"
 	pipeline2 "github.com/devtron-labs/devtron/pkg/pipeline"
 	"github.com/devtron-labs/devtron/pkg/pipeline/adapter"
 	pipelineConfigBean "github.com/devtron-labs/devtron/pkg/pipeline/bean"
@@ -63,6 +68,7 @@ import (
 	"github.com/devtron-labs/devtron/pkg/plugin"
 	bean2 "github.com/devtron-labs/devtron/pkg/plugin/bean"
 	repository2 "github.com/devtron-labs/devtron/pkg/plugin/repository"
+	"github.com/devtron-labs/devtron/pkg/policyGovernance/security/imageSigning"
 	"github.com/devtron-labs/devtron/pkg/resourceQualifiers"
 	"github.com/devtron-labs/devtron/pkg/variables"
 	repository4 "github.com/devtron-labs/devtron/pkg/variables/repository"
@@ -125,6 +131,12 @@ type HandlerServiceImpl struct {
 	K8sUtil                      *k8s.K8sServiceImpl
 	asyncRunnable                *async.Runnable
 	workflowTriggerAuditService  auditService.WorkflowTriggerAuditService
+	buildQueueService            queue.BuildQueueService
+	buildCacheService            cache.BuildCacheService
+	buildpackCatalogueService    buildpack.BuildpackCatalogueService
+	sbomService                  sbom.SbomService
+	imageSigningService          imageSigning.ImageSigningService
+	infraConfigService           infraConfigService.InfraConfigService
 }
 
 func NewHandlerServiceImpl(Logger *zap.SugaredLogger, workflowService executor.WorkflowService,
@@ -152,6 +164,12 @@ func NewHandlerServiceImpl(Logger *zap.SugaredLogger, workflowService executor.W
 	K8sUtil *k8s.K8sServiceImpl,
 	asyncRunnable *async.Runnable,
 	workflowTriggerAuditService auditService.WorkflowTriggerAuditService,
+	buildQueueService queue.BuildQueueService,
+	buildCacheService cache.BuildCacheService,
+	buildpackCatalogueService buildpack.BuildpackCatalogueService,
+	sbomService sbom.SbomService,
+	imageSigningService imageSigning.ImageSigningService,
+	infraConfigService infraConfigService.InfraConfigService,
 ) *HandlerServiceImpl {
 	buildxCacheFlags := &BuildxGlobalFlags{}
 	err := env.Parse(buildxCacheFlags)
@@ -187,6 +205,12 @@ func NewHandlerServiceImpl(Logger *zap.SugaredLogger, workflowService executor.W
 		K8sUtil:                      K8sUtil,
 		asyncRunnable:                asyncRunnable,
 		workflowTriggerAuditService:  workflowTriggerAuditService,
+		buildQueueService:            buildQueueService,
+		buildCacheService:            buildCacheService,
+		buildpackCatalogueService:    buildpackCatalogueService,
+		sbomService:                  sbomService,
+		imageSigningService:          imageSigningService,
+		infraConfigService:           infraConfigService,
 	}
 	config, err := types.GetCiConfig()
 	if err != nil {
@@ -709,7 +733,11 @@ func (impl *HandlerServiceImpl) triggerCiPipeline(trigger *types.CiTriggerReques
 		return 0, err
 	}
 
-	err = impl.executeCiPipeline(workflowRequest)
+	if impl.buildQueueService.IsEnabled() {
+		err = impl.buildQueueService.Enqueue(savedCiWf, workflowRequest)
+	} else {
+		err = impl.executeCiPipeline(workflowRequest)
+	}
 	if err != nil {
 		impl.Logger.Errorw("error in executing ci pipeline", "err", err)
 		dbErr := impl.markCurrentCiWorkflowFailed(savedCiWf, err)
@@ -808,7 +836,8 @@ func (impl *HandlerServiceImpl) StartCiWorkflowAndPrepareWfRequest(trigger *type
 			AppName:   pipeline.App.AppName,
 		}
 	}
-	savedCiWf, err := impl.saveNewWorkflowForCITrigger(pipeline, ciWorkflowConfigNamespace, trigger.CommitHashes, trigger.TriggeredBy, ciMaterials, trigger.EnvironmentId, isJob, trigger.ReferenceCiWorkflowId)
+	workflowExecutorType := impl.getWorkflowExecutorType(envModal, scope)
+	savedCiWf, err := impl.saveNewWorkflowForCITrigger(pipeline, ciWorkflowConfigNamespace, trigger.CommitHashes, trigger.TriggeredBy, ciMaterials, trigger.EnvironmentId, isJob, trigger.ReferenceCiWorkflowId, workflowExecutorType)
 	if err != nil {
 		impl.Logger.Errorw("could not save new workflow", "err", err)
 		return nil, nil, nil, err
@@ -946,8 +975,33 @@ func (impl *HandlerServiceImpl) BuildPayload(trigger types.CiTriggerRequest, pip
 	return payload
 }
 
+// getWorkflowExecutorType returns the executor configured on the cluster the build runs in, i.e. the cluster of the environment
+// a job runs in or the default cluster, else the executor of the infra profile applied to the scope, else the global executor
+func (impl *HandlerServiceImpl) getWorkflowExecutorType(envModal *repository6.Environment, scope resourceQualifiers.Scope) cdWorkflow.WorkflowExecutorType {
+	if envModal != nil && envModal.Cluster != nil {
+		if len(envModal.Cluster.WorkflowExecutorType) > 0 {
+			return cdWorkflow.WorkflowExecutorType(envModal.Cluster.WorkflowExecutorType)
+		}
+	} else {
+		defaultCluster, err := impl.clusterService.FindByIdWithoutConfig(clusterBean.DefaultClusterId)
+		if err != nil {
+			impl.Logger.Errorw("error in fetching default cluster, skipping cluster workflow executor", "clusterId", clusterBean.DefaultClusterId, "err", err)
+		} else if len(defaultCluster.WorkflowExecutorType) > 0 {
+			return cdWorkflow.WorkflowExecutorType(defaultCluster.WorkflowExecutorType)
+		}
+	}
+	profileExecutorType, err := impl.infraConfigService.GetWorkflowExecutorTypeByScope(scope)
+	if err != nil {
+		impl.Logger.Errorw("error in fetching infra profile workflow executor, using global executor", "scope", scope, "err", err)
+	} else if len(profileExecutorType) > 0 {
+		return cdWorkflow.WorkflowExecutorType(profileExecutorType)
+	}
+	return impl.config.GetWorkflowExecutorType()
+}
+
 func (impl *HandlerServiceImpl) saveNewWorkflowForCITrigger(pipeline *pipelineConfig.CiPipeline, ciWorkflowConfigNamespace string,
-	commitHashes map[int]pipelineConfig.GitCommit, userId int32, ciMaterials []*pipelineConfig.CiPipelineMaterial, EnvironmentId int, isJob bool, refCiWorkflowId int) (*pipelineConfig.CiWorkflow, error) {
+	commitHashes map[int]pipelineConfig.GitCommit, userId int32, ciMaterials []*pipelineConfig.CiPipelineMaterial, EnvironmentId int, isJob bool, refCiWorkflowId int,
+	workflowExecutorType cdWorkflow.WorkflowExecutorType) (*pipelineConfig.CiWorkflow, error) {
 
 	isCiTriggerBlocked, err := impl.checkIfCITriggerIsBlocked(pipeline, ciMaterials, isJob)
 	if err != nil {
@@ -966,7 +1020,7 @@ func (impl *HandlerServiceImpl) saveNewWorkflowForCITrigger(pipeline *pipelineCo
 		LogLocation:           "",
 		TriggeredBy:           userId,
 		ReferenceCiWorkflowId: refCiWorkflowId,
-		ExecutorType:          impl.config.GetWorkflowExecutorType(),
+		ExecutorType:          workflowExecutorType,
 	}
 	if isJob {
 		ciWorkflow.Namespace = ciWorkflowConfigNamespace
@@ -1232,6 +1286,11 @@ func (impl *HandlerServiceImpl) buildWfRequestForCiPipeline(pipeline *pipelineCo
 		checkoutPath = dockerfilePath[:strings.LastIndex(dockerfilePath, "/")+1]
 	} else if ciBuildConfigBean.CiBuildType == buildBean.BUILDPACK_BUILD_TYPE {
 		buildPackConfig := ciBuildConfigBean.BuildPackConfig
+		err = impl.buildpackCatalogueService.ApplyCatalogue(buildPackConfig)
+		if err != nil {
+			impl.Logger.Errorw("error in applying buildpack catalogue", "ciPipelineId", pipeline.Id, "buildPackConfig", buildPackConfig, "err", err)
+			return nil, err
+		}
 		checkoutPath = filepath.Join(checkoutPath, buildPackConfig.ProjectPath)
 	}
 
@@ -1270,7 +1329,7 @@ func (impl *HandlerServiceImpl) buildWfRequestForCiPipeline(pipeline *pipelineCo
 		OrchestratorToken:           impl.config.OrchestratorToken,
 		ImageRetryCount:             impl.config.ImageRetryCount,
 		ImageRetryInterval:          impl.config.ImageRetryInterval,
-		WorkflowExecutor:            impl.config.GetWorkflowExecutorType(),
+		WorkflowExecutor:            savedWf.ExecutorType,
 		Type:                        pipelineConfigBean.CI_WORKFLOW_PIPELINE_TYPE,
 		CiArtifactLastFetch:         trigger.CiArtifactLastFetch,
 		RegistryCredentialMap:       registryCredentialMap,
@@ -1326,6 +1385,25 @@ func (impl *HandlerServiceImpl) buildWfRequestForCiPipeline(pipeline *pipelineCo
 		workflowRequest.DockerCert = dockerRegistry.Cert
 
 	}
+	if dockerRegistry != nil && ciBuildConfigBean.DockerBuildConfig != nil && !workflowRequest.IgnoreDockerCachePush {
+		imageRepository := fmt.Sprintf("%s/%s", dockerRegistry.RegistryURL, dockerRepository)
+		workflowRequest.BuildxCacheConfig, err = impl.buildCacheService.GetBuildxCacheConfig(pipeline.Id, imageRepository, impl.buildxGlobalFlags.BuildxCacheModeMin)
+		if err != nil {
+			impl.Logger.Errorw("error in fetching buildx cache config", "ciPipelineId", pipeline.Id, "err", err)
+			return nil, err
+		}
+	}
+	if ciBuildConfigBean.CiBuildType == buildBean.BUILDPACK_BUILD_TYPE {
+		workflowRequest.ExtractBuildpackSbom = impl.buildpackCatalogueService.IsSbomExtractionEnabled()
+	}
+	if !isJob && ciBuildConfigBean.CiBuildType != buildBean.SKIP_BUILD_TYPE {
+		workflowRequest.SbomGenerationConfig = impl.sbomService.GetSbomGenerationConfig(savedWf.Id, savedWf.BlobStorageEnabled)
+		workflowRequest.ImageSigningConfig, err = impl.imageSigningService.GetCiSigningConfig()
+		if err != nil {
+			impl.Logger.Errorw("error in fetching image signing config", "ciPipelineId", pipeline.Id, "err", err)
+			return nil, err
+		}
+	}
 	ciWorkflowConfigLogsBucket := impl.config.GetDefaultBuildLogsBucket()
 
 	switch workflowRequest.CloudProvider {
@@ -1690,6 +1768,17 @@ func (impl *HandlerServiceImpl) CancelBuild(workflowId int, forceAbort bool) (in
 		impl.Logger.Errorw("error in finding ci-workflow by workflow id", "ciWorkflowId", workflowId, "err", err)
 		return 0, err
 	}
+	if workflow.Status == cdWorkflow.WorkflowInQueue {
+		// build is still waiting in the build queue, no workflow exists to be terminated
+		isDequeued, err := impl.buildQueueService.CancelQueuedBuild(workflowId)
+		if err != nil {
+			impl.Logger.Errorw("error in cancelling queued build", "ciWorkflowId", workflowId, "err", err)
+			return 0, err
+		}
+		if isDequeued {
+			return workflow.Id, nil
+		}
+	}
 	isExt := workflow.Namespace != constants2.DefaultCiWorkflowNamespace
 	var env *repository6.Environment
 	var restConfig *rest.Config
@@ -1735,7 +1824,7 @@ func (impl *HandlerServiceImpl) CancelBuild(workflowId int, forceAbort bool) (in
 	}
 
 	workflow.Status = cdWorkflow.WorkflowCancel
-	if workflow.ExecutorType == cdWorkflow.WORKFLOW_EXECUTOR_TYPE_SYSTEM {
+	if workflow.ExecutorType.IsArgoWorkflowLess() {
 		workflow.PodStatus = "Failed"
 		workflow.Message = constants2.TERMINATE_MESSAGE
 	}
@@ -0,0 +1,81 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package trigger
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/devtron-labs/devtron/internal/sql/repository/pipelineConfig/bean/workflow/cdWorkflow"
+	clusterBean "github.com/devtron-labs/devtron/pkg/cluster/bean"
+	repository6 "github.com/devtron-labs/devtron/pkg/cluster/environment/repository"
+	clusterMocks "github.com/devtron-labs/devtron/pkg/cluster/mocks"
+	clusterRepository "github.com/devtron-labs/devtron/pkg/cluster/repository"
+	infraConfigMocks "github.com/devtron-labs/devtron/pkg/infraConfig/service/mocks"
+	"github.com/devtron-labs/devtron/pkg/pipeline/types"
+	"github.com/devtron-labs/devtron/pkg/resourceQualifiers"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func TestGetWorkflowExecutorType(t *testing.T) {
+	newHandlerService := func(clusterService *clusterMocks.ClusterService, infraService *infraConfigMocks.InfraConfigService) *HandlerServiceImpl {
+		return &HandlerServiceImpl{
+			Logger:             zap.NewNop().Sugar(),
+			clusterService:     clusterService,
+			infraConfigService: infraService,
+			config:             &types.CiConfig{CiCdConfig: &types.CiCdConfig{Type: types.CiConfigType, CiWorkflowExecutorType: cdWorkflow.WORKFLOW_EXECUTOR_TYPE_AWF}},
+		}
+	}
+	envModal := func(executorType string) *repository6.Environment {
+		return &repository6.Environment{Cluster: &clusterRepository.Cluster{WorkflowExecutorType: executorType}}
+	}
+	defaultCluster := func(executorType string) *clusterBean.ClusterBean {
+		return &clusterBean.ClusterBean{Id: clusterBean.DefaultClusterId, WorkflowExecutorType: executorType}
+	}
+	scope := resourceQualifiers.Scope{AppId: 1}
+	t.Run("job cluster executor takes precedence", func(t *testing.T) {
+		clusterService := clusterMocks.NewClusterService(t)
+		infraService := infraConfigMocks.NewInfraConfigService(t)
+		impl := newHandlerService(clusterService, infraService)
+		assert.Equal(t, cdWorkflow.WorkflowExecutorType("TEKTON"), impl.getWorkflowExecutorType(envModal("TEKTON"), scope))
+	})
+	t.Run("plain ci uses the default cluster executor", func(t *testing.T) {
+		clusterService := clusterMocks.NewClusterService(t)
+		clusterService.On("FindByIdWithoutConfig", clusterBean.DefaultClusterId).Return(defaultCluster("TEKTON"), nil)
+		infraService := infraConfigMocks.NewInfraConfigService(t)
+		impl := newHandlerService(clusterService, infraService)
+		assert.Equal(t, cdWorkflow.WorkflowExecutorType("TEKTON"), impl.getWorkflowExecutorType(nil, scope))
+	})
+	t.Run("infra profile executor is used when the cluster has none", func(t *testing.T) {
+		clusterService := clusterMocks.NewClusterService(t)
+		clusterService.On("FindByIdWithoutConfig", clusterBean.DefaultClusterId).Return(defaultCluster(""), nil).Once()
+		infraService := infraConfigMocks.NewInfraConfigService(t)
+		infraService.On("GetWorkflowExecutorTypeByScope", scope).Return("TEKTON", nil).Twice()
+		impl := newHandlerService(clusterService, infraService)
+		assert.Equal(t, cdWorkflow.WorkflowExecutorType("TEKTON"), impl.getWorkflowExecutorType(nil, scope))
+		assert.Equal(t, cdWorkflow.WorkflowExecutorType("TEKTON"), impl.getWorkflowExecutorType(envModal(""), scope))
+	})
+	t.Run("global executor is the fallback", func(t *testing.T) {
+		clusterService := clusterMocks.NewClusterService(t)
+		clusterService.On("FindByIdWithoutConfig", clusterBean.DefaultClusterId).Return(nil, errors.New("cluster not found"))
+		infraService := infraConfigMocks.NewInfraConfigService(t)
+		infraService.On("GetWorkflowExecutorTypeByScope", scope).Return("", nil)
+		impl := newHandlerService(clusterService, infraService)
+		assert.Equal(t, cdWorkflow.WorkflowExecutorType(cdWorkflow.WORKFLOW_EXECUTOR_TYPE_AWF), impl.getWorkflowExecutorType(nil, scope))
+	})
+}
@@ -18,15 +18,21 @@ package build
 
 import (
 	"github.com/devtron-labs/devtron/pkg/build/artifacts"
+	"github.com/devtron-labs/devtron/pkg/build/buildpack"
+	"github.com/devtron-labs/devtron/pkg/build/cache"
 	"github.com/devtron-labs/devtron/pkg/build/git"
 	"github.com/devtron-labs/devtron/pkg/build/pipeline"
+	"github.com/devtron-labs/devtron/pkg/build/queue"
 	"github.com/devtron-labs/devtron/pkg/build/trigger"
 	"github.com/google/wire"
 )
 
 var WireSet = wire.NewSet(
 	artifacts.WireSet,
+	buildpack.WireSet,
+	cache.WireSet,
 	pipeline.WireSet,
 	git.GitWireSet,
+	queue.WireSet,
 	trigger.WireSet,
 )
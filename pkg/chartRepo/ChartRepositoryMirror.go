@@ -0,0 +1,44 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package chartRepo
+
+import (
+	chartRepoRepository "github.com/devtron-labs/devtron/pkg/chartRepo/repository"
+)
+
+// SyncChartRepoMirror triggers the download of the index file and chart archives of a mirror enabled chart repository
+// into devtron managed storage, once synced the repository is served by devtron as an internal helm repository.
+// Sync runs in background, its progress is reported in the mirror status of the repository.
+func (impl *ChartRepositoryServiceImpl) SyncChartRepoMirror(request *MirrorSyncRequest) error {
+	return impl.chartRepoMirrorService.SyncMirror(request.Id, request.UserId)
+}
+
+// GetMirroredChartRepoFile returns a file (index.yaml or chart archive) of a mirrored chart repository to a client
+// presenting the pull credentials of the repository
+func (impl *ChartRepositoryServiceImpl) GetMirroredChartRepoFile(repoName, filePath, userName, password string) (*chartRepoRepository.ChartRepoMirrorFile, error) {
+	return impl.chartRepoMirrorService.GetMirroredFile(repoName, filePath, userName, password)
+}
+
+func (impl *ChartRepositoryServiceImpl) triggerChartRepoMirrorSync(chartRepo *chartRepoRepository.ChartRepo, userId int32) {
+	if !chartRepo.MirrorEnabled {
+		return
+	}
+	err := impl.chartRepoMirrorService.SyncMirror(chartRepo.Id, userId)
+	if err != nil {
+		impl.logger.Errorw("error in triggering chart repo mirror sync", "chartRepoId", chartRepo.Id, "err", err)
+	}
+}
@@ -34,6 +34,7 @@ import (
 	"time"
 
 	"github.com/devtron-labs/devtron/internal/util"
+	"github.com/devtron-labs/devtron/pkg/chartRepo/mirror"
 	chartRepoRepository "github.com/devtron-labs/devtron/pkg/chartRepo/repository"
 	serverEnvConfig "github.com/devtron-labs/devtron/pkg/server/config"
 	"github.com/devtron-labs/devtron/pkg/sql"
@@ -74,6 +75,8 @@ type ChartRepositoryService interface {
 	ValidateAndUpdateChartRepo(request *ChartRepoDto) (*chartRepoRepository.ChartRepo, error, *DetailedErrorHelmRepoValidation)
 	TriggerChartSyncManual(chartProviderConfig *ChartProviderConfig) error
 	DeleteChartRepo(request *ChartRepoDto) error
+	SyncChartRepoMirror(request *MirrorSyncRequest) error
+	GetMirroredChartRepoFile(repoName, filePath, userName, password string) (*chartRepoRepository.ChartRepoMirrorFile, error)
 }
 
 type ChartRepositoryServiceImpl struct {
@@ -85,12 +88,14 @@ type ChartRepositoryServiceImpl struct {
 	serverEnvConfig          *serverEnvConfig.ServerEnvConfig
 	argoClientWrapperService argocdServer.ArgoClientWrapperService
 	clusterReadService       read.ClusterReadService
+	chartRepoMirrorService   mirror.ChartRepoMirrorService
 }
 
 func NewChartRepositoryServiceImpl(logger *zap.SugaredLogger, repoRepository chartRepoRepository.ChartRepoRepository, K8sUtil *util3.K8sServiceImpl,
 	aCDAuthConfig *util2.ACDAuthConfig, client *http.Client, serverEnvConfig *serverEnvConfig.ServerEnvConfig,
 	argoClientWrapperService argocdServer.ArgoClientWrapperService,
-	clusterReadService read.ClusterReadService) *ChartRepositoryServiceImpl {
+	clusterReadService read.ClusterReadService,
+	chartRepoMirrorService mirror.ChartRepoMirrorService) *ChartRepositoryServiceImpl {
 	return &ChartRepositoryServiceImpl{
 		logger:                   logger,
 		repoRepository:           repoRepository,
@@ -100,6 +105,7 @@ func NewChartRepositoryServiceImpl(logger *zap.SugaredLogger, repoRepository cha
 		serverEnvConfig:          serverEnvConfig,
 		argoClientWrapperService: argoClientWrapperService,
 		clusterReadService:       clusterReadService,
+		chartRepoMirrorService:   chartRepoMirrorService,
 	}
 }
 
@@ -142,6 +148,11 @@ func (impl *ChartRepositoryServiceImpl) CreateChartRepo(request *ChartRepoDto) (
 	chartRepo.Default = false
 	chartRepo.External = true
 	chartRepo.AllowInsecureConnection = request.AllowInsecureConnection
+	chartRepo.MirrorEnabled = request.MirrorEnabled
+	err = impl.chartRepoMirrorService.UpdateMirrorConfig(chartRepo)
+	if err != nil {
+		return nil, err
+	}
 	err = impl.repoRepository.Save(chartRepo, tx)
 	if err != nil && !util.IsErrNoRows(err) {
 		impl.logger.Errorw("error in saving chart repo in DB", "err", err)
@@ -198,6 +209,7 @@ func (impl *ChartRepositoryServiceImpl) UpdateData(request *ChartRepoDto) (*char
 	}
 	previousName := chartRepo.Name
 	previousUrl := chartRepo.Url
+	previousPullUrl := chartRepo.GetChartPullUrl()
 	//metadata.name label in Secret doesn't support uppercase hence returning if user enters uppercase letters in repo name
 	if request.Name != previousName && strings.ToLower(request.Name) != request.Name {
 		return nil, errors.New("invalid repo name: please use lowercase")
@@ -226,25 +238,40 @@ func (impl *ChartRepositoryServiceImpl) UpdateData(request *ChartRepoDto) (*char
 	chartRepo.UpdatedBy = request.UserId
 	chartRepo.UpdatedOn = time.Now()
 	chartRepo.AllowInsecureConnection = request.AllowInsecureConnection
+	if request.MirrorEnabled != chartRepo.MirrorEnabled || request.Name != previousName || request.Url != previousUrl {
+		// mirror is rebuilt from the new source, charts are pulled from upstream until the next successful sync
+		chartRepo.MirrorStatus = ""
+		chartRepo.MirrorStatusMessage = ""
+		chartRepo.MirrorSyncedOn = time.Time{}
+		err = impl.chartRepoMirrorService.DeleteMirror(chartRepo.Id, tx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	chartRepo.MirrorEnabled = request.MirrorEnabled
+	err = impl.chartRepoMirrorService.UpdateMirrorConfig(chartRepo)
+	if err != nil {
+		return nil, err
+	}
 	err = impl.repoRepository.Update(chartRepo, tx)
 	if err != nil && !util.IsErrNoRows(err) {
 		return nil, err
 	}
 
 	isPrivateChart := false
-	if len(chartRepo.UserName) > 0 && len(chartRepo.Password) > 0 {
+	if len(chartRepo.GetChartPullUserName()) > 0 && len(chartRepo.GetChartPullPassword()) > 0 {
 		isPrivateChart = true
 	}
 
 	argoServerUpdateRequest := bean.ChartRepositoryUpdateRequest{
 		PreviousName:            previousName,
-		PreviousURL:             previousUrl,
+		PreviousURL:             previousPullUrl,
 		Name:                    chartRepo.Name,
 		AuthMode:                string(chartRepo.AuthMode),
-		Username:                chartRepo.UserName,
-		Password:                chartRepo.Password,
+		Username:                chartRepo.GetChartPullUserName(),
+		Password:                chartRepo.GetChartPullPassword(),
 		SSHKey:                  chartRepo.SshKey,
-		URL:                     chartRepo.Url,
+		URL:                     chartRepo.GetChartPullUrl(),
 		AllowInsecureConnection: chartRepo.AllowInsecureConnection,
 		IsPrivateChart:          isPrivateChart,
 	}
@@ -292,9 +319,15 @@ func (impl *ChartRepositoryServiceImpl) DeleteChartRepo(request *ChartRepoDto) e
 		impl.logger.Errorw("error in deleting chart repo", "err", err)
 		return err
 	}
+	if chartRepo.MirrorEnabled {
+		err = impl.chartRepoMirrorService.DeleteMirror(chartRepo.Id, tx)
+		if err != nil {
+			return err
+		}
+	}
 
 	// modify configmap
-	err = impl.argoClientWrapperService.DeleteChartRepository(request.Name, request.Url)
+	err = impl.argoClientWrapperService.DeleteChartRepository(request.Name, chartRepo.GetChartPullUrl())
 	if err != nil {
 		impl.logger.Errorw("error in deleting chart repository from argocd", "name", request.Name, "err", err)
 		return err
@@ -338,9 +371,30 @@ func (impl *ChartRepositoryServiceImpl) convertFromDbResponse(model *chartRepoRe
 	chartRepo.Default = model.Default
 	chartRepo.Active = model.Active
 	chartRepo.AllowInsecureConnection = model.AllowInsecureConnection
+	chartRepo.MirrorEnabled = model.MirrorEnabled
+	chartRepo.MirrorStatus = getMirrorStatusDto(&model.ChartRepoFields)
+	chartRepo.MirrorPullToken = model.MirrorPullToken
 	return chartRepo
 }
 
+func getMirrorStatusDto(model *chartRepoRepository.ChartRepoFields) *MirrorStatusDto {
+	if !model.MirrorEnabled {
+		return nil
+	}
+	mirrorStatus := &MirrorStatusDto{
+		MirrorUrl: model.MirrorUrl,
+		Status:    model.MirrorStatus,
+		Message:   model.MirrorStatusMessage,
+		SyncedOn:  model.MirrorSyncedOn,
+	}
+	if model.IsMirrorSyncInterrupted() {
+		// not failed in db until the next startup sweep, a new sync can be triggered already
+		mirrorStatus.Status = chartRepoRepository.MirrorSyncFailed
+		mirrorStatus.Message = mirror.MirrorSyncInterruptedMessage
+	}
+	return mirrorStatus
+}
+
 func (impl *ChartRepositoryServiceImpl) GetChartRepoList() ([]*ChartRepoWithIsEditableDto, error) {
 	var chartRepos []*ChartRepoWithIsEditableDto
 	models, err := impl.repoRepository.FindAllWithDeploymentCount()
@@ -364,6 +418,8 @@ func (impl *ChartRepositoryServiceImpl) GetChartRepoList() ([]*ChartRepoWithIsEd
 			chartRepo.IsEditable = false
 		}
 		chartRepo.AllowInsecureConnection = model.AllowInsecureConnection
+		chartRepo.MirrorEnabled = model.MirrorEnabled
+		chartRepo.MirrorStatus = getMirrorStatusDto(&model.ChartRepoFields)
 		chartRepos = append(chartRepos, chartRepo)
 	}
 	return chartRepos, nil
@@ -388,6 +444,7 @@ func (impl *ChartRepositoryServiceImpl) GetChartRepoListMin() ([]*ChartRepoDto,
 			Default:                 model.Default,
 			Active:                  model.Active,
 			AllowInsecureConnection: model.AllowInsecureConnection,
+			MirrorEnabled:           model.MirrorEnabled,
 		}
 		chartRepos = append(chartRepos, chartRepo)
 	}
@@ -473,6 +530,7 @@ func (impl *ChartRepositoryServiceImpl) ValidateAndCreateChartRepo(request *Char
 	if err != nil {
 		impl.logger.Errorw("Error in triggering chart sync job manually ", "err", err)
 	}
+	impl.triggerChartRepoMirrorSync(chartRepo, request.UserId)
 
 	return chartRepo, err, validationResult
 }
@@ -496,6 +554,7 @@ func (impl *ChartRepositoryServiceImpl) ValidateAndUpdateChartRepo(request *Char
 	if err != nil {
 		impl.logger.Errorw("Error in triggering chart sync job manually", "err", err)
 	}
+	impl.triggerChartRepoMirrorSync(chartRepo, request.UserId)
 
 	return chartRepo, nil, validationResult
 }
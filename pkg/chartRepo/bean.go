@@ -18,6 +18,8 @@ package chartRepo
 
 import (
 	"github.com/devtron-labs/devtron/internal/sql/constants"
+	chartRepoRepository "github.com/devtron-labs/devtron/pkg/chartRepo/repository"
+	"time"
 )
 
 const ValidationSuccessMsg = "Configurations are validated successfully"
@@ -35,6 +37,49 @@ type ChartRepoDto struct {
 	Default                 bool               `json:"default"`
 	UserId                  int32              `json:"-"`
 	AllowInsecureConnection bool               `json:"allow_insecure_connection"`
+	MirrorEnabled           bool               `json:"mirrorEnabled"`
+	MirrorStatus            *MirrorStatusDto   `json:"mirrorStatus,omitempty"`
+	MirrorPullToken         string             `json:"-"`
+}
+
+type MirrorStatusDto struct {
+	MirrorUrl string    `json:"mirrorUrl"`
+	Status    string    `json:"status"`
+	Message   string    `json:"message,omitempty"`
+	SyncedOn  time.Time `json:"syncedOn"`
+}
+
+type MirrorSyncRequest struct {
+	Id     int   `json:"id" validate:"required"`
+	UserId int32 `json:"-"`
+}
+
+func (dto *ChartRepoDto) isMirrorSynced() bool {
+	return dto.MirrorEnabled && dto.MirrorStatus != nil && len(dto.MirrorStatus.MirrorUrl) > 0 && !dto.MirrorStatus.SyncedOn.IsZero()
+}
+
+// GetChartPullUrl returns the mirror url once the repository has been mirrored, upstream url otherwise
+func (dto *ChartRepoDto) GetChartPullUrl() string {
+	if dto.isMirrorSynced() {
+		return dto.MirrorStatus.MirrorUrl
+	}
+	return dto.Url
+}
+
+// GetChartPullUserName returns the user name to be used along with GetChartPullUrl
+func (dto *ChartRepoDto) GetChartPullUserName() string {
+	if dto.isMirrorSynced() {
+		return chartRepoRepository.MirrorPullUserName
+	}
+	return dto.UserName
+}
+
+// GetChartPullPassword returns the password to be used along with GetChartPullUrl
+func (dto *ChartRepoDto) GetChartPullPassword() string {
+	if dto.isMirrorSynced() {
+		return dto.MirrorPullToken
+	}
+	return dto.Password
 }
 
 type ChartRepoWithIsEditableDto struct {
@@ -0,0 +1,396 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mirror
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/devtron-labs/devtron/client/argocdServer"
+	"github.com/devtron-labs/devtron/client/argocdServer/repoCredsK8sClient/bean"
+	"github.com/devtron-labs/devtron/internal/util"
+	chartRepoRepository "github.com/devtron-labs/devtron/pkg/chartRepo/repository"
+	serverEnvConfig "github.com/devtron-labs/devtron/pkg/server/config"
+	"github.com/devtron-labs/devtron/pkg/sql"
+	"github.com/go-pg/pg"
+	"go.uber.org/zap"
+	"io"
+	"k8s.io/helm/pkg/repo"
+	"k8s.io/helm/pkg/version"
+	"net/http"
+	"net/url"
+	"path"
+	"sigs.k8s.io/yaml"
+	"strings"
+	"time"
+)
+
+// ChartRepoMirrorService mirrors the index file and chart archives of mirror enabled chart repositories into the db,
+// from where any replica of devtron serves them as an internal helm repository to clusters without internet egress
+type ChartRepoMirrorService interface {
+	// UpdateMirrorConfig sets the mirror url and pull token of the chart repo as per its mirror enabled flag
+	UpdateMirrorConfig(chartRepo *chartRepoRepository.ChartRepo) error
+	// SyncMirror starts the sync of the mirror in background, its progress is reported in the mirror status of the chart repo
+	SyncMirror(chartRepoId int, userId int32) error
+	// GetMirroredFile returns the index file or a chart archive of a mirrored chart repo to a client presenting its pull credentials
+	GetMirroredFile(repoName, filePath, userName, password string) (*chartRepoRepository.ChartRepoMirrorFile, error)
+	DeleteMirror(chartRepoId int, tx *pg.Tx) error
+}
+
+type ChartRepoMirrorServiceImpl struct {
+	logger                   *zap.SugaredLogger
+	repoRepository           chartRepoRepository.ChartRepoRepository
+	mirrorFileRepository     chartRepoRepository.ChartRepoMirrorFileRepository
+	argoClientWrapperService argocdServer.ArgoClientWrapperService
+	serverEnvConfig          *serverEnvConfig.ServerEnvConfig
+	client                   *http.Client
+	cronLease                sql.CronLease
+}
+
+func NewChartRepoMirrorServiceImpl(logger *zap.SugaredLogger, repoRepository chartRepoRepository.ChartRepoRepository,
+	mirrorFileRepository chartRepoRepository.ChartRepoMirrorFileRepository,
+	argoClientWrapperService argocdServer.ArgoClientWrapperService,
+	serverEnvConfig *serverEnvConfig.ServerEnvConfig, client *http.Client, cronLease sql.CronLease) *ChartRepoMirrorServiceImpl {
+	impl := &ChartRepoMirrorServiceImpl{
+		logger:                   logger,
+		repoRepository:           repoRepository,
+		mirrorFileRepository:     mirrorFileRepository,
+		argoClientWrapperService: argoClientWrapperService,
+		serverEnvConfig:          serverEnvConfig,
+		client:                   client,
+		cronLease:                cronLease,
+	}
+	impl.markInterruptedMirrorSyncsFailed()
+	return impl
+}
+
+func (impl *ChartRepoMirrorServiceImpl) UpdateMirrorConfig(chartRepo *chartRepoRepository.ChartRepo) error {
+	if !chartRepo.MirrorEnabled {
+		chartRepo.MirrorUrl = ""
+		chartRepo.MirrorPullToken = ""
+		return nil
+	}
+	chartRepo.MirrorUrl = strings.TrimSuffix(impl.serverEnvConfig.ChartRepoMirrorBaseUrl, "/") + "/" + chartRepo.Name
+	if len(chartRepo.MirrorPullToken) == 0 {
+		pullToken, err := newPullToken()
+		if err != nil {
+			impl.logger.Errorw("error in generating mirror pull token", "chartRepo", chartRepo.Name, "err", err)
+			return err
+		}
+		chartRepo.MirrorPullToken = pullToken
+	}
+	return nil
+}
+
+func (impl *ChartRepoMirrorServiceImpl) SyncMirror(chartRepoId int, userId int32) error {
+	chartRepo, err := impl.repoRepository.FindById(chartRepoId)
+	if err != nil {
+		impl.logger.Errorw("error in finding chart repo by id", "chartRepoId", chartRepoId, "err", err)
+		if util.IsErrNoRows(err) {
+			return util.NewApiError(http.StatusNotFound, "chart repository not found", err.Error())
+		}
+		return err
+	}
+	if !chartRepo.MirrorEnabled {
+		return util.NewApiError(http.StatusBadRequest, "mirror is not enabled for this chart repository", "mirror is not enabled for this chart repository")
+	}
+	// the claim is made in db so that the same repo is not synced by two replicas at once
+	chartRepo.MirrorStatus = chartRepoRepository.MirrorSyncInProgress
+	chartRepo.MirrorStatusMessage = ""
+	chartRepo.MirrorSyncHeartbeatOn = time.Now()
+	chartRepo.UpdateAuditLog(userId)
+	claimed, err := impl.repoRepository.ClaimMirrorSync(chartRepo)
+	if err != nil {
+		impl.logger.Errorw("error in claiming chart repo mirror sync", "chartRepoId", chartRepoId, "err", err)
+		return err
+	}
+	if !claimed {
+		return util.NewApiError(http.StatusConflict, "mirror sync is already in progress for this chart repository", "mirror sync is already in progress for this chart repository")
+	}
+	go func() {
+		err := impl.syncMirror(chartRepo, userId)
+		if err != nil {
+			impl.logger.Errorw("error in syncing chart repo mirror", "chartRepoId", chartRepo.Id, "err", err)
+		}
+	}()
+	return nil
+}
+
+func (impl *ChartRepoMirrorServiceImpl) syncMirror(chartRepo *chartRepoRepository.ChartRepo, userId int32) error {
+	previousPullUrl := chartRepo.GetChartPullUrl()
+	err := impl.mirrorChartRepo(chartRepo, userId)
+	if errors.Is(err, errMirrorSyncReset) {
+		impl.logger.Infow("abandoning chart repo mirror sync", "chartRepoId", chartRepo.Id, "reason", err)
+		return nil
+	} else if err != nil {
+		impl.logger.Errorw("error in mirroring chart repository", "chartRepoId", chartRepo.Id, "url", chartRepo.Url, "err", err)
+		impl.updateMirrorStatus(chartRepo, chartRepoRepository.MirrorSyncFailed, err.Error(), userId)
+		return err
+	}
+	chartRepo.MirrorSyncedOn = time.Now()
+	impl.updateMirrorStatus(chartRepo, chartRepoRepository.MirrorSyncSucceeded, "", userId)
+	if previousPullUrl != chartRepo.GetChartPullUrl() {
+		// first successful sync, argocd should pull the charts from the mirror with the pull token from now on
+		err = impl.argoClientWrapperService.UpdateChartRepository(bean.ChartRepositoryUpdateRequest{
+			PreviousName:            chartRepo.Name,
+			PreviousURL:             previousPullUrl,
+			Name:                    chartRepo.Name,
+			AuthMode:                string(chartRepo.AuthMode),
+			Username:                chartRepo.GetChartPullUserName(),
+			Password:                chartRepo.GetChartPullPassword(),
+			URL:                     chartRepo.GetChartPullUrl(),
+			AllowInsecureConnection: chartRepo.AllowInsecureConnection,
+			IsPrivateChart:          true,
+		})
+		if err != nil {
+			impl.logger.Errorw("error in updating mirrored chart repository in argocd", "chartRepoId", chartRepo.Id, "err", err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (impl *ChartRepoMirrorServiceImpl) GetMirroredFile(repoName, filePath, userName, password string) (*chartRepoRepository.ChartRepoMirrorFile, error) {
+	chartRepo, err := impl.repoRepository.FindByName(repoName)
+	if err != nil && !util.IsErrNoRows(err) {
+		impl.logger.Errorw("error in finding chart repo by name", "name", repoName, "err", err)
+		return nil, err
+	}
+	if util.IsErrNoRows(err) || !chartRepo.MirrorEnabled || !isValidPullCredential(chartRepo, userName, password) {
+		return nil, util.NewApiError(http.StatusUnauthorized, "invalid pull credentials for mirrored chart repository", "invalid pull credentials for mirrored chart repository")
+	}
+	if !chartRepo.IsMirrorSynced() {
+		return nil, util.NewApiError(http.StatusNotFound, "mirrored chart repository not found", "mirrored chart repository not found")
+	}
+	cleanPath := strings.TrimPrefix(path.Clean("/"+filePath), "/")
+	if cleanPath != mirrorIndexFileName && path.Dir(cleanPath) != mirrorChartsDir {
+		return nil, util.NewApiError(http.StatusNotFound, "file not found in mirrored chart repository", "file not found in mirrored chart repository")
+	}
+	file, err := impl.mirrorFileRepository.FindByChartRepoIdAndFilePath(chartRepo.Id, cleanPath)
+	if util.IsErrNoRows(err) {
+		return nil, util.NewApiError(http.StatusNotFound, "file not found in mirrored chart repository", err.Error())
+	} else if err != nil {
+		impl.logger.Errorw("error in finding mirrored chart repo file", "chartRepoId", chartRepo.Id, "filePath", cleanPath, "err", err)
+		return nil, err
+	}
+	return file, nil
+}
+
+func (impl *ChartRepoMirrorServiceImpl) DeleteMirror(chartRepoId int, tx *pg.Tx) error {
+	err := impl.mirrorFileRepository.DeleteByChartRepoId(chartRepoId, tx)
+	if err != nil {
+		impl.logger.Errorw("error in deleting chart repo mirror", "chartRepoId", chartRepoId, "err", err)
+		return err
+	}
+	return nil
+}
+
+// markInterruptedMirrorSyncsFailed fails the syncs which stopped sending heartbeats, the replica running them was
+// restarted or is gone. A new sync can be triggered for these repositories.
+func (impl *ChartRepoMirrorServiceImpl) markInterruptedMirrorSyncsFailed() {
+	acquired, err := impl.cronLease.TryAcquire(interruptedSyncSweepLeaseKey, interruptedSyncSweepLeaseTtl)
+	if err != nil {
+		impl.logger.Errorw("error in taking lease for sweeping interrupted chart repo mirror syncs", "err", err)
+		return
+	}
+	if !acquired {
+		impl.logger.Debugw("interrupted chart repo mirror syncs are being swept by another replica")
+		return
+	}
+	defer func() {
+		err := impl.cronLease.Release(interruptedSyncSweepLeaseKey)
+		if err != nil {
+			impl.logger.Errorw("error in releasing lease of interrupted chart repo mirror sync sweep", "err", err)
+		}
+	}()
+	chartRepos, err := impl.repoRepository.FindAllByMirrorStatus(chartRepoRepository.MirrorSyncInProgress)
+	if err != nil {
+		impl.logger.Errorw("error in finding chart repos with mirror sync in progress", "err", err)
+		return
+	}
+	for _, chartRepo := range chartRepos {
+		if !chartRepo.IsMirrorSyncInterrupted() {
+			// still running on another replica
+			continue
+		}
+		impl.logger.Infow("marking interrupted chart repo mirror sync as failed", "chartRepoId", chartRepo.Id)
+		impl.updateMirrorStatus(chartRepo, chartRepoRepository.MirrorSyncFailed, MirrorSyncInterruptedMessage, chartRepo.UpdatedBy)
+	}
+}
+
+func (impl *ChartRepoMirrorServiceImpl) updateMirrorStatus(chartRepo *chartRepoRepository.ChartRepo, status, message string, userId int32) {
+	chartRepo.MirrorStatus = status
+	chartRepo.MirrorStatusMessage = message
+	chartRepo.UpdateAuditLog(userId)
+	err := impl.repoRepository.UpdateMirrorStatus(chartRepo)
+	if err != nil {
+		impl.logger.Errorw("error in updating chart repo mirror status", "chartRepoId", chartRepo.Id, "status", status, "err", err)
+	}
+}
+
+func (impl *ChartRepoMirrorServiceImpl) mirrorChartRepo(chartRepo *chartRepoRepository.ChartRepo, userId int32) error {
+	repoUrl, err := url.Parse(strings.TrimSuffix(chartRepo.Url, "/") + "/")
+	if err != nil {
+		return err
+	}
+	indexUrl, _ := repoUrl.Parse(mirrorIndexFileName)
+	indexBytes, err := impl.fetch(indexUrl.String(), chartRepo)
+	if err != nil {
+		return fmt.Errorf("error in fetching index file: %w", err)
+	}
+	upstreamIndex := &repo.IndexFile{}
+	err = yaml.Unmarshal(indexBytes, upstreamIndex)
+	if err != nil {
+		return fmt.Errorf("error in parsing index file: %w", err)
+	}
+	upstreamIndex.SortEntries()
+
+	existingFilePaths, err := impl.mirrorFileRepository.FindFilePathsByChartRepoId(chartRepo.Id)
+	if err != nil {
+		return err
+	}
+	alreadyMirrored := make(map[string]bool, len(existingFilePaths))
+	for _, filePath := range existingFilePaths {
+		alreadyMirrored[filePath] = true
+	}
+	mirroredFilePaths := []string{mirrorIndexFileName}
+	mirroredIndex := repo.NewIndexFile()
+	for chartName, chartVersions := range upstreamIndex.Entries {
+		mirroredVersions := make(repo.ChartVersions, 0)
+		for _, chartVersion := range chartVersions {
+			if impl.serverEnvConfig.ChartRepoMirrorMaxVersionsPerChart > 0 && len(mirroredVersions) >= impl.serverEnvConfig.ChartRepoMirrorMaxVersionsPerChart {
+				break
+			}
+			if chartVersion.Removed || len(chartVersion.URLs) == 0 || chartVersion.Metadata == nil {
+				continue
+			}
+			archiveName := fmt.Sprintf("%s-%s.tgz", chartName, chartVersion.Version)
+			if archiveName != path.Base(archiveName) || strings.Contains(archiveName, "..") {
+				impl.logger.Warnw("skipping chart version with invalid name while mirroring", "chartRepo", chartRepo.Name, "chart", chartName, "version", chartVersion.Version)
+				continue
+			}
+			archivePath := path.Join(mirrorChartsDir, archiveName)
+			// chart archives are immutable for a version, already mirrored ones are not downloaded again
+			if !alreadyMirrored[archivePath] {
+				archiveUrl, err := repoUrl.Parse(chartVersion.URLs[0])
+				if err != nil {
+					return err
+				}
+				err = impl.mirrorChartArchive(chartRepo, archiveUrl.String(), chartVersion.Digest, archivePath, userId)
+				if err != nil {
+					return fmt.Errorf("error in mirroring chart %s version %s: %w", chartName, chartVersion.Version, err)
+				}
+				stillSyncing, err := impl.repoRepository.UpdateMirrorSyncHeartbeat(chartRepo.Id, time.Now())
+				if err != nil {
+					return err
+				} else if !stillSyncing {
+					return errMirrorSyncReset
+				}
+			}
+			mirroredVersion := *chartVersion
+			// relative urls are resolved against the mirror url by helm
+			mirroredVersion.URLs = []string{archivePath}
+			mirroredVersions = append(mirroredVersions, &mirroredVersion)
+			mirroredFilePaths = append(mirroredFilePaths, archivePath)
+		}
+		if len(mirroredVersions) > 0 {
+			mirroredIndex.Entries[chartName] = mirroredVersions
+		}
+	}
+	mirroredIndex.Generated = time.Now()
+	mirroredIndexBytes, err := yaml.Marshal(mirroredIndex)
+	if err != nil {
+		return err
+	}
+	// index is saved after all the archives it refers to, archives dropped from the index are deleted after it
+	err = impl.mirrorFileRepository.Upsert(newMirrorFile(chartRepo.Id, mirrorIndexFileName, mirroredIndexBytes, userId))
+	if err != nil {
+		return err
+	}
+	return impl.mirrorFileRepository.DeleteByChartRepoIdAndFilePathNotIn(chartRepo.Id, mirroredFilePaths)
+}
+
+func (impl *ChartRepoMirrorServiceImpl) mirrorChartArchive(chartRepo *chartRepoRepository.ChartRepo, archiveUrl, digest, archivePath string, userId int32) error {
+	archiveBytes, err := impl.fetch(archiveUrl, chartRepo)
+	if err != nil {
+		return err
+	}
+	if len(digest) > 0 {
+		checksum := sha256.Sum256(archiveBytes)
+		if hex.EncodeToString(checksum[:]) != digest {
+			return fmt.Errorf("digest mismatch for %s", archiveUrl)
+		}
+	}
+	return impl.mirrorFileRepository.Upsert(newMirrorFile(chartRepo.Id, archivePath, archiveBytes, userId))
+}
+
+// fetch downloads a file of the upstream chart repository with its credentials
+func (impl *ChartRepoMirrorServiceImpl) fetch(href string, chartRepo *chartRepoRepository.ChartRepo) ([]byte, error) {
+	req, err := http.NewRequest(http.MethodGet, href, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", "Helm/"+strings.TrimPrefix(version.GetVersion(), "v"))
+	if chartRepo.UserName != "" && chartRepo.Password != "" {
+		req.SetBasicAuth(chartRepo.UserName, chartRepo.Password)
+	}
+	resp, err := impl.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch %s : %s", href, resp.Status)
+	}
+	buf := bytes.NewBuffer(nil)
+	_, err = io.Copy(buf, resp.Body)
+	return buf.Bytes(), err
+}
+
+func newMirrorFile(chartRepoId int, filePath string, content []byte, userId int32) *chartRepoRepository.ChartRepoMirrorFile {
+	checksum := sha256.Sum256(content)
+	file := &chartRepoRepository.ChartRepoMirrorFile{
+		ChartRepoId: chartRepoId,
+		FilePath:    filePath,
+		Content:     content,
+		Digest:      hex.EncodeToString(checksum[:]),
+	}
+	file.CreateAuditLog(userId)
+	return file
+}
+
+func isValidPullCredential(chartRepo *chartRepoRepository.ChartRepo, userName, password string) bool {
+	if len(chartRepo.MirrorPullToken) == 0 {
+		return false
+	}
+	validUserName := subtle.ConstantTimeCompare([]byte(userName), []byte(chartRepoRepository.MirrorPullUserName)) == 1
+	validPassword := subtle.ConstantTimeCompare([]byte(password), []byte(chartRepo.MirrorPullToken)) == 1
+	return validUserName && validPassword
+}
+
+func newPullToken() (string, error) {
+	token := make([]byte, pullTokenLength)
+	_, err := rand.Read(token)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(token), nil
+}
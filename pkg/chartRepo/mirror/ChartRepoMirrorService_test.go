@@ -0,0 +1,251 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mirror
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/devtron-labs/devtron/client/argocdServer/mocks"
+	"github.com/devtron-labs/devtron/client/argocdServer/repoCredsK8sClient/bean"
+	"github.com/devtron-labs/devtron/internal/util"
+	chartRepoRepository "github.com/devtron-labs/devtron/pkg/chartRepo/repository"
+	mocks2 "github.com/devtron-labs/devtron/pkg/chartRepo/repository/mocks"
+	serverEnvConfig "github.com/devtron-labs/devtron/pkg/server/config"
+	mocks3 "github.com/devtron-labs/devtron/pkg/sql/mocks"
+	"github.com/go-pg/pg"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+const testPullToken = "pull-token"
+
+func newMirroredChartRepo(id int, name, status string, syncedOn, heartbeatOn time.Time) *chartRepoRepository.ChartRepo {
+	return &chartRepoRepository.ChartRepo{
+		ChartRepoFields: chartRepoRepository.ChartRepoFields{
+			Id:                    id,
+			Name:                  name,
+			Url:                   "https://charts.example.com",
+			MirrorEnabled:         true,
+			MirrorUrl:             "http://devtron-service/orchestrator/chart-repo/mirror/serve/" + name,
+			MirrorStatus:          status,
+			MirrorSyncedOn:        syncedOn,
+			MirrorPullToken:       testPullToken,
+			MirrorSyncHeartbeatOn: heartbeatOn,
+		},
+	}
+}
+
+func apiErrStatus(err error) int {
+	if apiErr, ok := err.(*util.ApiError); ok {
+		return apiErr.HttpStatusCode
+	}
+	return 0
+}
+
+func TestMarkInterruptedMirrorSyncsFailed(t *testing.T) {
+	t.Run("sweep is skipped when another replica holds the lease", func(t *testing.T) {
+		repoRepository := mocks2.NewChartRepoRepository(t)
+		cronLease := mocks3.NewCronLease(t)
+		cronLease.On("TryAcquire", interruptedSyncSweepLeaseKey, interruptedSyncSweepLeaseTtl).Return(false, nil)
+		NewChartRepoMirrorServiceImpl(zap.NewNop().Sugar(), repoRepository, nil, nil, &serverEnvConfig.ServerEnvConfig{}, http.DefaultClient, cronLease)
+		repoRepository.AssertNotCalled(t, "FindAllByMirrorStatus", mock.Anything)
+	})
+
+	t.Run("only syncs without a recent heartbeat are failed", func(t *testing.T) {
+		interrupted := newMirroredChartRepo(1, "stable", chartRepoRepository.MirrorSyncInProgress, time.Time{}, time.Now().Add(-time.Hour))
+		running := newMirroredChartRepo(2, "bitnami", chartRepoRepository.MirrorSyncInProgress, time.Time{}, time.Now())
+		repoRepository := mocks2.NewChartRepoRepository(t)
+		repoRepository.On("FindAllByMirrorStatus", chartRepoRepository.MirrorSyncInProgress).Return([]*chartRepoRepository.ChartRepo{interrupted, running}, nil)
+		repoRepository.On("UpdateMirrorStatus", interrupted).Return(nil).Once()
+		cronLease := mocks3.NewCronLease(t)
+		cronLease.On("TryAcquire", interruptedSyncSweepLeaseKey, interruptedSyncSweepLeaseTtl).Return(true, nil)
+		cronLease.On("Release", interruptedSyncSweepLeaseKey).Return(nil).Once()
+
+		NewChartRepoMirrorServiceImpl(zap.NewNop().Sugar(), repoRepository, nil, nil, &serverEnvConfig.ServerEnvConfig{}, http.DefaultClient, cronLease)
+		assert.Equal(t, chartRepoRepository.MirrorSyncFailed, interrupted.MirrorStatus)
+		assert.Equal(t, MirrorSyncInterruptedMessage, interrupted.MirrorStatusMessage)
+		assert.Equal(t, chartRepoRepository.MirrorSyncInProgress, running.MirrorStatus)
+	})
+}
+
+func TestGetMirroredFile(t *testing.T) {
+	repoRepository := mocks2.NewChartRepoRepository(t)
+	repoRepository.On("FindByName", "stable").Return(newMirroredChartRepo(1, "stable", chartRepoRepository.MirrorSyncSucceeded, time.Now(), time.Now()), nil)
+	repoRepository.On("FindByName", "unsynced").Return(newMirroredChartRepo(2, "unsynced", chartRepoRepository.MirrorSyncInProgress, time.Time{}, time.Now()), nil)
+	repoRepository.On("FindByName", "missing").Return(&chartRepoRepository.ChartRepo{}, pg.ErrNoRows)
+	mirrorFileRepository := mocks2.NewChartRepoMirrorFileRepository(t)
+	indexFile := &chartRepoRepository.ChartRepoMirrorFile{ChartRepoId: 1, FilePath: mirrorIndexFileName, Content: []byte("apiVersion: v1")}
+	mirrorFileRepository.On("FindByChartRepoIdAndFilePath", 1, mirrorIndexFileName).Return(indexFile, nil)
+	mirrorFileRepository.On("FindByChartRepoIdAndFilePath", 1, "charts/nginx-2.0.0.tgz").Return(&chartRepoRepository.ChartRepoMirrorFile{}, pg.ErrNoRows)
+	impl := &ChartRepoMirrorServiceImpl{
+		logger:               zap.NewNop().Sugar(),
+		repoRepository:       repoRepository,
+		mirrorFileRepository: mirrorFileRepository,
+	}
+
+	testCases := []struct {
+		name           string
+		repoName       string
+		filePath       string
+		userName       string
+		password       string
+		expectedStatus int
+	}{
+		{name: "index file", repoName: "stable", filePath: "index.yaml", userName: chartRepoRepository.MirrorPullUserName, password: testPullToken},
+		{name: "path is cleaned", repoName: "stable", filePath: "charts/../index.yaml", userName: chartRepoRepository.MirrorPullUserName, password: testPullToken},
+		{name: "wrong token", repoName: "stable", filePath: "index.yaml", userName: chartRepoRepository.MirrorPullUserName, password: "wrong", expectedStatus: http.StatusUnauthorized},
+		{name: "upstream user name", repoName: "stable", filePath: "index.yaml", userName: "admin", password: testPullToken, expectedStatus: http.StatusUnauthorized},
+		{name: "no credentials", repoName: "stable", filePath: "index.yaml", expectedStatus: http.StatusUnauthorized},
+		{name: "unknown repo", repoName: "missing", filePath: "index.yaml", userName: chartRepoRepository.MirrorPullUserName, password: testPullToken, expectedStatus: http.StatusUnauthorized},
+		{name: "repo not synced yet", repoName: "unsynced", filePath: "index.yaml", userName: chartRepoRepository.MirrorPullUserName, password: testPullToken, expectedStatus: http.StatusNotFound},
+		{name: "file outside the mirror", repoName: "stable", filePath: "../secret.yaml", userName: chartRepoRepository.MirrorPullUserName, password: testPullToken, expectedStatus: http.StatusNotFound},
+		{name: "archive not mirrored", repoName: "stable", filePath: "charts/nginx-2.0.0.tgz", userName: chartRepoRepository.MirrorPullUserName, password: testPullToken, expectedStatus: http.StatusNotFound},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			file, err := impl.GetMirroredFile(tc.repoName, tc.filePath, tc.userName, tc.password)
+			if tc.expectedStatus != 0 {
+				assert.Equal(t, tc.expectedStatus, apiErrStatus(err))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, indexFile, file)
+		})
+	}
+}
+
+func TestSyncMirror(t *testing.T) {
+	t.Run("sync already claimed by another replica is a conflict", func(t *testing.T) {
+		chartRepo := newMirroredChartRepo(1, "stable", chartRepoRepository.MirrorSyncInProgress, time.Time{}, time.Now())
+		repoRepository := mocks2.NewChartRepoRepository(t)
+		repoRepository.On("FindById", 1).Return(chartRepo, nil)
+		repoRepository.On("ClaimMirrorSync", chartRepo).Return(false, nil)
+		impl := &ChartRepoMirrorServiceImpl{logger: zap.NewNop().Sugar(), repoRepository: repoRepository}
+		err := impl.SyncMirror(1, 1)
+		assert.Equal(t, http.StatusConflict, apiErrStatus(err))
+	})
+
+	t.Run("sync of a repo without mirror is a bad request", func(t *testing.T) {
+		chartRepo := newMirroredChartRepo(1, "stable", "", time.Time{}, time.Time{})
+		chartRepo.MirrorEnabled = false
+		repoRepository := mocks2.NewChartRepoRepository(t)
+		repoRepository.On("FindById", 1).Return(chartRepo, nil)
+		impl := &ChartRepoMirrorServiceImpl{logger: zap.NewNop().Sugar(), repoRepository: repoRepository}
+		err := impl.SyncMirror(1, 1)
+		assert.Equal(t, http.StatusBadRequest, apiErrStatus(err))
+	})
+}
+
+func TestSyncMirrorDownloadsCharts(t *testing.T) {
+	archive := []byte("nginx chart archive")
+	checksum := sha256.Sum256(archive)
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/index.yaml":
+			fmt.Fprintf(w, `apiVersion: v1
+entries:
+  nginx:
+  - name: nginx
+    version: 1.1.0
+    digest: %s
+    urls:
+    - nginx-1.1.0.tgz
+  - name: nginx
+    version: 1.0.0
+    urls:
+    - nginx-1.0.0.tgz
+  redis:
+  - name: redis
+    version: 2.0.0
+    urls:
+    - redis-2.0.0.tgz
+`, hex.EncodeToString(checksum[:]))
+		case "/nginx-1.1.0.tgz":
+			w.Write(archive)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer upstream.Close()
+
+	newService := func(chartRepo *chartRepoRepository.ChartRepo) (*ChartRepoMirrorServiceImpl, *mocks2.ChartRepoRepository, *mocks2.ChartRepoMirrorFileRepository, *mocks.ArgoClientWrapperService) {
+		repoRepository := mocks2.NewChartRepoRepository(t)
+		mirrorFileRepository := mocks2.NewChartRepoMirrorFileRepository(t)
+		mirrorFileRepository.On("FindFilePathsByChartRepoId", chartRepo.Id).Return([]string{mirrorIndexFileName, "charts/redis-2.0.0.tgz", "charts/nginx-0.9.0.tgz"}, nil)
+		argoClientWrapperService := mocks.NewArgoClientWrapperService(t)
+		impl := &ChartRepoMirrorServiceImpl{
+			logger:                   zap.NewNop().Sugar(),
+			repoRepository:           repoRepository,
+			mirrorFileRepository:     mirrorFileRepository,
+			argoClientWrapperService: argoClientWrapperService,
+			serverEnvConfig:          &serverEnvConfig.ServerEnvConfig{ChartRepoMirrorMaxVersionsPerChart: 1},
+			client:                   upstream.Client(),
+		}
+		return impl, repoRepository, mirrorFileRepository, argoClientWrapperService
+	}
+
+	t.Run("first sync mirrors the latest versions and moves argocd to the mirror", func(t *testing.T) {
+		chartRepo := newMirroredChartRepo(1, "stable", chartRepoRepository.MirrorSyncInProgress, time.Time{}, time.Now())
+		chartRepo.Url = upstream.URL
+		impl, repoRepository, mirrorFileRepository, argoClientWrapperService := newService(chartRepo)
+		mirrorFileRepository.On("Upsert", mock.MatchedBy(func(file *chartRepoRepository.ChartRepoMirrorFile) bool {
+			return file.FilePath == "charts/nginx-1.1.0.tgz" && string(file.Content) == string(archive)
+		})).Return(nil).Once()
+		mirrorFileRepository.On("Upsert", mock.MatchedBy(func(file *chartRepoRepository.ChartRepoMirrorFile) bool {
+			return file.FilePath == mirrorIndexFileName
+		})).Return(nil).Once()
+		mirrorFileRepository.On("DeleteByChartRepoIdAndFilePathNotIn", 1, mock.MatchedBy(func(filePaths []string) bool {
+			return assert.ElementsMatch(t, []string{mirrorIndexFileName, "charts/nginx-1.1.0.tgz", "charts/redis-2.0.0.tgz"}, filePaths)
+		})).Return(nil).Once()
+		repoRepository.On("UpdateMirrorSyncHeartbeat", 1, mock.Anything).Return(true, nil).Once()
+		repoRepository.On("UpdateMirrorStatus", chartRepo).Return(nil).Once()
+		argoClientWrapperService.On("UpdateChartRepository", bean.ChartRepositoryUpdateRequest{
+			PreviousName:   "stable",
+			PreviousURL:    upstream.URL,
+			Name:           "stable",
+			Username:       chartRepoRepository.MirrorPullUserName,
+			Password:       testPullToken,
+			URL:            chartRepo.MirrorUrl,
+			IsPrivateChart: true,
+		}).Return(nil).Once()
+
+		err := impl.syncMirror(chartRepo, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, chartRepoRepository.MirrorSyncSucceeded, chartRepo.MirrorStatus)
+		assert.True(t, chartRepo.IsMirrorSynced())
+	})
+
+	t.Run("sync reset by a change of the repo is abandoned", func(t *testing.T) {
+		chartRepo := newMirroredChartRepo(1, "stable", chartRepoRepository.MirrorSyncInProgress, time.Time{}, time.Now())
+		chartRepo.Url = upstream.URL
+		impl, repoRepository, mirrorFileRepository, _ := newService(chartRepo)
+		mirrorFileRepository.On("Upsert", mock.Anything).Return(nil).Once()
+		repoRepository.On("UpdateMirrorSyncHeartbeat", 1, mock.Anything).Return(false, nil).Once()
+
+		err := impl.syncMirror(chartRepo, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, chartRepoRepository.MirrorSyncInProgress, chartRepo.MirrorStatus)
+		mirrorFileRepository.AssertNotCalled(t, "DeleteByChartRepoIdAndFilePathNotIn", mock.Anything, mock.Anything)
+	})
+}
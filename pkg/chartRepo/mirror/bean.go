@@ -0,0 +1,40 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mirror
+
+import (
+	"errors"
+	"time"
+)
+
+const (
+	MirrorSyncInterruptedMessage = "mirror sync was interrupted by a restart of devtron, please sync again"
+
+	// interruptedSyncSweepLeaseKey is the cron lease taken by the startup sweep so that it runs on one replica at a time
+	interruptedSyncSweepLeaseKey = "chart_repo_mirror_interrupted_sync_sweep"
+	// interruptedSyncSweepLeaseTtl bounds a sweep, another replica can sweep again once it expires
+	interruptedSyncSweepLeaseTtl = 5 * time.Minute
+)
+
+const (
+	mirrorIndexFileName = "index.yaml"
+	mirrorChartsDir     = "charts"
+	pullTokenLength     = 32
+)
+
+// errMirrorSyncReset is returned when the chart repo was changed while it was being mirrored, the sync is abandoned
+var errMirrorSyncReset = errors.New("mirror sync was reset by a change of the chart repository")
@@ -0,0 +1,96 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package chartRepoRepository
+
+import (
+	"github.com/devtron-labs/devtron/pkg/sql"
+	"github.com/go-pg/pg"
+)
+
+// ChartRepoMirrorFile is the index file or a chart archive of a mirrored chart repository, file path is relative to the mirror url
+type ChartRepoMirrorFile struct {
+	tableName   struct{} `sql:"chart_repo_mirror_file" pg:",discard_unknown_columns"`
+	Id          int      `sql:"id,pk"`
+	ChartRepoId int      `sql:"chart_repo_id,notnull"`
+	FilePath    string   `sql:"file_path,notnull"`
+	Content     []byte   `sql:"content,notnull"`
+	Digest      string   `sql:"digest"`
+	sql.AuditLog
+}
+
+type ChartRepoMirrorFileRepository interface {
+	// Upsert saves the file, replacing the content of the file already mirrored at the same path
+	Upsert(file *ChartRepoMirrorFile) error
+	FindByChartRepoIdAndFilePath(chartRepoId int, filePath string) (*ChartRepoMirrorFile, error)
+	FindFilePathsByChartRepoId(chartRepoId int) ([]string, error)
+	DeleteByChartRepoIdAndFilePathNotIn(chartRepoId int, filePaths []string) error
+	DeleteByChartRepoId(chartRepoId int, tx *pg.Tx) error
+}
+
+type ChartRepoMirrorFileRepositoryImpl struct {
+	dbConnection *pg.DB
+}
+
+func NewChartRepoMirrorFileRepositoryImpl(dbConnection *pg.DB) *ChartRepoMirrorFileRepositoryImpl {
+	return &ChartRepoMirrorFileRepositoryImpl{
+		dbConnection: dbConnection,
+	}
+}
+
+func (impl *ChartRepoMirrorFileRepositoryImpl) Upsert(file *ChartRepoMirrorFile) error {
+	_, err := impl.dbConnection.Model(file).
+		OnConflict("(chart_repo_id, file_path) DO UPDATE").
+		Set("content = EXCLUDED.content").
+		Set("digest = EXCLUDED.digest").
+		Set("updated_on = EXCLUDED.updated_on").
+		Set("updated_by = EXCLUDED.updated_by").
+		Insert()
+	return err
+}
+
+func (impl *ChartRepoMirrorFileRepositoryImpl) FindByChartRepoIdAndFilePath(chartRepoId int, filePath string) (*ChartRepoMirrorFile, error) {
+	file := &ChartRepoMirrorFile{}
+	err := impl.dbConnection.Model(file).
+		Where("chart_repo_id = ?", chartRepoId).
+		Where("file_path = ?", filePath).
+		Select()
+	return file, err
+}
+
+func (impl *ChartRepoMirrorFileRepositoryImpl) FindFilePathsByChartRepoId(chartRepoId int) ([]string, error) {
+	var filePaths []string
+	err := impl.dbConnection.Model((*ChartRepoMirrorFile)(nil)).
+		Column("file_path").
+		Where("chart_repo_id = ?", chartRepoId).
+		Select(&filePaths)
+	return filePaths, err
+}
+
+func (impl *ChartRepoMirrorFileRepositoryImpl) DeleteByChartRepoIdAndFilePathNotIn(chartRepoId int, filePaths []string) error {
+	_, err := impl.dbConnection.Model((*ChartRepoMirrorFile)(nil)).
+		Where("chart_repo_id = ?", chartRepoId).
+		Where("file_path NOT IN (?)", pg.In(filePaths)).
+		Delete()
+	return err
+}
+
+func (impl *ChartRepoMirrorFileRepositoryImpl) DeleteByChartRepoId(chartRepoId int, tx *pg.Tx) error {
+	_, err := tx.Model((*ChartRepoMirrorFile)(nil)).
+		Where("chart_repo_id = ?", chartRepoId).
+		Delete()
+	return err
+}
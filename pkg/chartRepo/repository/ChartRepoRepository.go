@@ -21,6 +21,7 @@ import (
 	"github.com/devtron-labs/devtron/pkg/sql"
 	"github.com/go-pg/pg"
 	"strconv"
+	"time"
 )
 
 type ChartRepoFields struct {
@@ -37,7 +38,64 @@ type ChartRepoFields struct {
 	External                bool               `sql:"external,notnull"`
 	Deleted                 bool               `sql:"deleted,notnull"`
 	AllowInsecureConnection bool               `sql:"allow_insecure_connection"`
+	MirrorEnabled           bool               `sql:"mirror_enabled,notnull"`
+	MirrorUrl               string             `sql:"mirror_url"`
+	MirrorStatus            string             `sql:"mirror_status"`
+	MirrorStatusMessage     string             `sql:"mirror_status_message"`
+	MirrorSyncedOn          time.Time          `sql:"mirror_synced_on"`
+	MirrorPullToken         string             `sql:"mirror_pull_token"`
+	MirrorSyncHeartbeatOn   time.Time          `sql:"mirror_sync_heartbeat_on"`
 }
+
+const (
+	MirrorSyncInProgress = "InProgress"
+	MirrorSyncSucceeded  = "Succeeded"
+	MirrorSyncFailed     = "Failed"
+)
+
+const (
+	// MirrorPullUserName is the user name with which helm clients present the pull token of a mirrored repository
+	MirrorPullUserName = "devtron-mirror"
+	// MirrorSyncHeartbeatTimeout is the time after which a sync in progress without a heartbeat is considered interrupted
+	MirrorSyncHeartbeatTimeout = 10 * time.Minute
+)
+
+// IsMirrorSynced returns true when the charts of a mirror enabled repository have been synced at least once
+func (c ChartRepoFields) IsMirrorSynced() bool {
+	return c.MirrorEnabled && len(c.MirrorUrl) > 0 && !c.MirrorSyncedOn.IsZero()
+}
+
+// GetChartPullUrl returns the url from which helm and argocd should pull the charts of this repository,
+// mirrored repositories are served by devtron so that clusters without internet egress can install them
+func (c ChartRepoFields) GetChartPullUrl() string {
+	if c.IsMirrorSynced() {
+		return c.MirrorUrl
+	}
+	return c.Url
+}
+
+// GetChartPullUserName returns the user name to be used along with GetChartPullUrl
+func (c ChartRepoFields) GetChartPullUserName() string {
+	if c.IsMirrorSynced() {
+		return MirrorPullUserName
+	}
+	return c.UserName
+}
+
+// GetChartPullPassword returns the password to be used along with GetChartPullUrl
+func (c ChartRepoFields) GetChartPullPassword() string {
+	if c.IsMirrorSynced() {
+		return c.MirrorPullToken
+	}
+	return c.Password
+}
+
+// IsMirrorSyncInterrupted returns true when a sync in progress has stopped sending heartbeats, the replica running it
+// has been restarted or is gone
+func (c ChartRepoFields) IsMirrorSyncInterrupted() bool {
+	return c.MirrorStatus == MirrorSyncInProgress && time.Since(c.MirrorSyncHeartbeatOn) > MirrorSyncHeartbeatTimeout
+}
+
 type ChartRepo struct {
 	tableName struct{} `sql:"chart_repo" pg:",discard_unknown_columns"`
 	ChartRepoFields
@@ -60,6 +118,12 @@ type ChartRepoRepository interface {
 	GetConnection() *pg.DB
 	MarkChartRepoDeleted(chartRepo *ChartRepo, tx *pg.Tx) error
 	FindByName(name string) (*ChartRepo, error)
+	UpdateMirrorStatus(chartRepo *ChartRepo) error
+	FindAllByMirrorStatus(mirrorStatus string) ([]*ChartRepo, error)
+	// ClaimMirrorSync saves the mirror status of the chart repo only if no other sync of it is in progress
+	ClaimMirrorSync(chartRepo *ChartRepo) (bool, error)
+	// UpdateMirrorSyncHeartbeat returns false when the sync in progress has been reset by a change of the chart repo
+	UpdateMirrorSyncHeartbeat(chartRepoId int, heartbeatOn time.Time) (bool, error)
 }
 type ChartRepoRepositoryImpl struct {
 	dbConnection *pg.DB
@@ -149,3 +213,48 @@ func (impl ChartRepoRepositoryImpl) FindByName(name string) (*ChartRepo, error)
 		Select()
 	return repo, err
 }
+
+func (impl ChartRepoRepositoryImpl) UpdateMirrorStatus(chartRepo *ChartRepo) error {
+	_, err := impl.dbConnection.Model(chartRepo).
+		Column("mirror_status", "mirror_status_message", "mirror_synced_on", "updated_on", "updated_by").
+		WherePK().
+		Update()
+	return err
+}
+
+func (impl ChartRepoRepositoryImpl) ClaimMirrorSync(chartRepo *ChartRepo) (bool, error) {
+	res, err := impl.dbConnection.Model(chartRepo).
+		Column("mirror_status", "mirror_status_message", "mirror_sync_heartbeat_on", "updated_on", "updated_by").
+		WherePK().
+		Where("deleted = ?", false).
+		Where("(mirror_status IS DISTINCT FROM ? OR mirror_sync_heartbeat_on IS NULL OR mirror_sync_heartbeat_on < ?)",
+			MirrorSyncInProgress, time.Now().Add(-MirrorSyncHeartbeatTimeout)).
+		Update()
+	if err != nil {
+		return false, err
+	}
+	return res.RowsAffected() == 1, nil
+}
+
+func (impl ChartRepoRepositoryImpl) UpdateMirrorSyncHeartbeat(chartRepoId int, heartbeatOn time.Time) (bool, error) {
+	res, err := impl.dbConnection.Model((*ChartRepo)(nil)).
+		Set("mirror_sync_heartbeat_on = ?", heartbeatOn).
+		Where("id = ?", chartRepoId).
+		Where("mirror_status = ?", MirrorSyncInProgress).
+		Where("deleted = ?", false).
+		Update()
+	if err != nil {
+		return false, err
+	}
+	return res.RowsAffected() == 1, nil
+}
+
+func (impl ChartRepoRepositoryImpl) FindAllByMirrorStatus(mirrorStatus string) ([]*ChartRepo, error) {
+	var repos []*ChartRepo
+	err := impl.dbConnection.Model(&repos).
+		Where("mirror_enabled = ?", true).
+		Where("mirror_status = ?", mirrorStatus).
+		Where("deleted = ?", false).
+		Select()
+	return repos, err
+}
@@ -36,6 +36,7 @@ func GetClusterBean(model repository.Cluster) bean.ClusterBean {
 	clusterBean.IsVirtualCluster = model.IsVirtualCluster
 	clusterBean.ErrorInConnecting = model.ErrorInConnecting
 	clusterBean.IsProd = model.IsProd
+	clusterBean.WorkflowExecutorType = model.WorkflowExecutorType
 	clusterBean.PrometheusAuth = &bean.PrometheusAuth{
 		UserName:      model.PUserName,
 		Password:      model.PPassword,
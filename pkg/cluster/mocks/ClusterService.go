@@ -1,19 +1,23 @@
-// Code generated by mockery v2.18.0. DO NOT EDIT.
+// Code generated by mockery v2.53.5. DO NOT EDIT.
 
 package mocks
 
 import (
+	bean "github.com/devtron-labs/devtron/pkg/cluster/bean"
+
 	context "context"
-	userrepository "github.com/devtron-labs/devtron/pkg/auth/user/repository"
-	"github.com/devtron-labs/devtron/pkg/cluster/bean"
-	repository2 "github.com/devtron-labs/devtron/pkg/cluster/environment/repository"
+
+	environmentrepository "github.com/devtron-labs/devtron/pkg/cluster/environment/repository"
+
+	k8s "github.com/devtron-labs/common-lib/utils/k8s"
+
 	mock "github.com/stretchr/testify/mock"
 
 	repository "github.com/devtron-labs/devtron/pkg/cluster/repository"
 
-	util "github.com/devtron-labs/devtron/internal/util"
+	sync "sync"
 
-	v1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	userrepository "github.com/devtron-labs/devtron/pkg/auth/user/repository"
 
 	v1alpha1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 )
@@ -28,13 +32,17 @@ func (_m *ClusterService) ConnectClustersInBatch(clusters []*bean.ClusterBean, c
 	_m.Called(clusters, clusterExistInDb)
 }
 
-// ConvertClusterBeanObjectToCluster provides a mock function with given fields: bean
-func (_m *ClusterService) ConvertClusterBeanObjectToCluster(bean *bean.ClusterBean) *v1alpha1.Cluster {
-	ret := _m.Called(bean)
+// ConvertClusterBeanObjectToCluster provides a mock function with given fields: _a0
+func (_m *ClusterService) ConvertClusterBeanObjectToCluster(_a0 *bean.ClusterBean) *v1alpha1.Cluster {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConvertClusterBeanObjectToCluster")
+	}
 
 	var r0 *v1alpha1.Cluster
 	if rf, ok := ret.Get(0).(func(*bean.ClusterBean) *v1alpha1.Cluster); ok {
-		r0 = rf(bean)
+		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*v1alpha1.Cluster)
@@ -48,6 +56,10 @@ func (_m *ClusterService) ConvertClusterBeanObjectToCluster(bean *bean.ClusterBe
 func (_m *ClusterService) ConvertClusterBeanToCluster(clusterBean *bean.ClusterBean, userId int32) *repository.Cluster {
 	ret := _m.Called(clusterBean, userId)
 
+	if len(ret) == 0 {
+		panic("no return value specified for ConvertClusterBeanToCluster")
+	}
+
 	var r0 *repository.Cluster
 	if rf, ok := ret.Get(0).(func(*bean.ClusterBean, int32) *repository.Cluster); ok {
 		r0 = rf(clusterBean, userId)
@@ -61,18 +73,25 @@ func (_m *ClusterService) ConvertClusterBeanToCluster(clusterBean *bean.ClusterB
 }
 
 // CreateGrafanaDataSource provides a mock function with given fields: clusterBean, env
-func (_m *ClusterService) CreateGrafanaDataSource(clusterBean *bean.ClusterBean, env *repository2.Environment) (int, error) {
+func (_m *ClusterService) CreateGrafanaDataSource(clusterBean *bean.ClusterBean, env *environmentrepository.Environment) (int, error) {
 	ret := _m.Called(clusterBean, env)
 
+	if len(ret) == 0 {
+		panic("no return value specified for CreateGrafanaDataSource")
+	}
+
 	var r0 int
-	if rf, ok := ret.Get(0).(func(*bean.ClusterBean, *repository2.Environment) int); ok {
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*bean.ClusterBean, *environmentrepository.Environment) (int, error)); ok {
+		return rf(clusterBean, env)
+	}
+	if rf, ok := ret.Get(0).(func(*bean.ClusterBean, *environmentrepository.Environment) int); ok {
 		r0 = rf(clusterBean, env)
 	} else {
 		r0 = ret.Get(0).(int)
 	}
 
-	var r1 error
-	if rf, ok := ret.Get(1).(func(*bean.ClusterBean, *repository2.Environment) error); ok {
+	if rf, ok := ret.Get(1).(func(*bean.ClusterBean, *environmentrepository.Environment) error); ok {
 		r1 = rf(clusterBean, env)
 	} else {
 		r1 = ret.Error(1)
@@ -81,13 +100,17 @@ func (_m *ClusterService) CreateGrafanaDataSource(clusterBean *bean.ClusterBean,
 	return r0, r1
 }
 
-// Delete provides a mock function with given fields: bean, userId
-func (_m *ClusterService) Delete(bean *bean.ClusterBean, userId int32) error {
-	ret := _m.Called(bean, userId)
+// Delete provides a mock function with given fields: _a0, userId
+func (_m *ClusterService) Delete(_a0 *bean.ClusterBean, userId int32) error {
+	ret := _m.Called(_a0, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
 
 	var r0 error
 	if rf, ok := ret.Get(0).(func(*bean.ClusterBean, int32) error); ok {
-		r0 = rf(bean, userId)
+		r0 = rf(_a0, userId)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -95,36 +118,87 @@ func (_m *ClusterService) Delete(bean *bean.ClusterBean, userId int32) error {
 	return r0
 }
 
-// DeleteFromDb provides a mock function with given fields: bean, userId
-func (_m *ClusterService) DeleteFromDb(bean *bean.ClusterBean, userId int32) error {
-	ret := _m.Called(bean, userId)
+// DeleteFromDb provides a mock function with given fields: _a0, userId
+func (_m *ClusterService) DeleteFromDb(_a0 *bean.DeleteClusterBean, userId int32) (string, error) {
+	ret := _m.Called(_a0, userId)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(*bean.ClusterBean, int32) error); ok {
-		r0 = rf(bean, userId)
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteFromDb")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*bean.DeleteClusterBean, int32) (string, error)); ok {
+		return rf(_a0, userId)
+	}
+	if rf, ok := ret.Get(0).(func(*bean.DeleteClusterBean, int32) string); ok {
+		r0 = rf(_a0, userId)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(string)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(*bean.DeleteClusterBean, int32) error); ok {
+		r1 = rf(_a0, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// FetchRolesFromGroup provides a mock function with given fields: userId
-func (_m *ClusterService) FetchRolesFromGroup(userId int32) ([]*userrepository.RoleModel, error) {
-	ret := _m.Called(userId)
+// FetchRolesFromGroup provides a mock function with given fields: userId, token
+func (_m *ClusterService) FetchRolesFromGroup(userId int32, token string) ([]*userrepository.RoleModel, error) {
+	ret := _m.Called(userId, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchRolesFromGroup")
+	}
 
 	var r0 []*userrepository.RoleModel
-	if rf, ok := ret.Get(0).(func(int32) []*userrepository.RoleModel); ok {
-		r0 = rf(userId)
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int32, string) ([]*userrepository.RoleModel, error)); ok {
+		return rf(userId, token)
+	}
+	if rf, ok := ret.Get(0).(func(int32, string) []*userrepository.RoleModel); ok {
+		r0 = rf(userId, token)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*userrepository.RoleModel)
 		}
 	}
 
+	if rf, ok := ret.Get(1).(func(int32, string) error); ok {
+		r1 = rf(userId, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindActiveClustersExcludingVirtual provides a mock function with no fields
+func (_m *ClusterService) FindActiveClustersExcludingVirtual() ([]bean.ClusterBean, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindActiveClustersExcludingVirtual")
+	}
+
+	var r0 []bean.ClusterBean
 	var r1 error
-	if rf, ok := ret.Get(1).(func(int32) error); ok {
-		r1 = rf(userId)
+	if rf, ok := ret.Get(0).(func() ([]bean.ClusterBean, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []bean.ClusterBean); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]bean.ClusterBean)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -132,11 +206,19 @@ func (_m *ClusterService) FetchRolesFromGroup(userId int32) ([]*userrepository.R
 	return r0, r1
 }
 
-// FindAll provides a mock function with given fields:
+// FindAll provides a mock function with no fields
 func (_m *ClusterService) FindAll() ([]*bean.ClusterBean, error) {
 	ret := _m.Called()
 
+	if len(ret) == 0 {
+		panic("no return value specified for FindAll")
+	}
+
 	var r0 []*bean.ClusterBean
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]*bean.ClusterBean, error)); ok {
+		return rf()
+	}
 	if rf, ok := ret.Get(0).(func() []*bean.ClusterBean); ok {
 		r0 = rf()
 	} else {
@@ -145,7 +227,6 @@ func (_m *ClusterService) FindAll() ([]*bean.ClusterBean, error) {
 		}
 	}
 
-	var r1 error
 	if rf, ok := ret.Get(1).(func() error); ok {
 		r1 = rf()
 	} else {
@@ -155,11 +236,19 @@ func (_m *ClusterService) FindAll() ([]*bean.ClusterBean, error) {
 	return r0, r1
 }
 
-// FindAllActive provides a mock function with given fields:
+// FindAllActive provides a mock function with no fields
 func (_m *ClusterService) FindAllActive() ([]bean.ClusterBean, error) {
 	ret := _m.Called()
 
+	if len(ret) == 0 {
+		panic("no return value specified for FindAllActive")
+	}
+
 	var r0 []bean.ClusterBean
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]bean.ClusterBean, error)); ok {
+		return rf()
+	}
 	if rf, ok := ret.Get(0).(func() []bean.ClusterBean); ok {
 		r0 = rf()
 	} else {
@@ -168,7 +257,36 @@ func (_m *ClusterService) FindAllActive() ([]bean.ClusterBean, error) {
 		}
 	}
 
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindAllExceptVirtual provides a mock function with no fields
+func (_m *ClusterService) FindAllExceptVirtual() ([]*bean.ClusterBean, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAllExceptVirtual")
+	}
+
+	var r0 []*bean.ClusterBean
 	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]*bean.ClusterBean, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []*bean.ClusterBean); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*bean.ClusterBean)
+		}
+	}
+
 	if rf, ok := ret.Get(1).(func() error); ok {
 		r1 = rf()
 	} else {
@@ -178,11 +296,19 @@ func (_m *ClusterService) FindAllActive() ([]bean.ClusterBean, error) {
 	return r0, r1
 }
 
-// FindAllForAutoComplete provides a mock function with given fields:
+// FindAllForAutoComplete provides a mock function with no fields
 func (_m *ClusterService) FindAllForAutoComplete() ([]bean.ClusterBean, error) {
 	ret := _m.Called()
 
+	if len(ret) == 0 {
+		panic("no return value specified for FindAllForAutoComplete")
+	}
+
 	var r0 []bean.ClusterBean
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]bean.ClusterBean, error)); ok {
+		return rf()
+	}
 	if rf, ok := ret.Get(0).(func() []bean.ClusterBean); ok {
 		r0 = rf()
 	} else {
@@ -191,7 +317,6 @@ func (_m *ClusterService) FindAllForAutoComplete() ([]bean.ClusterBean, error) {
 		}
 	}
 
-	var r1 error
 	if rf, ok := ret.Get(1).(func() error); ok {
 		r1 = rf()
 	} else {
@@ -201,22 +326,29 @@ func (_m *ClusterService) FindAllForAutoComplete() ([]bean.ClusterBean, error) {
 	return r0, r1
 }
 
-// FindAllForClusterByUserId provides a mock function with given fields: userId, isActionUserSuperAdmin
-func (_m *ClusterService) FindAllForClusterByUserId(userId int32, isActionUserSuperAdmin bool) ([]bean.ClusterBean, error) {
-	ret := _m.Called(userId, isActionUserSuperAdmin)
+// FindAllForClusterByUserId provides a mock function with given fields: userId, isActionUserSuperAdmin, token
+func (_m *ClusterService) FindAllForClusterByUserId(userId int32, isActionUserSuperAdmin bool, token string) ([]bean.ClusterBean, error) {
+	ret := _m.Called(userId, isActionUserSuperAdmin, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAllForClusterByUserId")
+	}
 
 	var r0 []bean.ClusterBean
-	if rf, ok := ret.Get(0).(func(int32, bool) []bean.ClusterBean); ok {
-		r0 = rf(userId, isActionUserSuperAdmin)
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int32, bool, string) ([]bean.ClusterBean, error)); ok {
+		return rf(userId, isActionUserSuperAdmin, token)
+	}
+	if rf, ok := ret.Get(0).(func(int32, bool, string) []bean.ClusterBean); ok {
+		r0 = rf(userId, isActionUserSuperAdmin, token)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]bean.ClusterBean)
 		}
 	}
 
-	var r1 error
-	if rf, ok := ret.Get(1).(func(int32, bool) error); ok {
-		r1 = rf(userId, isActionUserSuperAdmin)
+	if rf, ok := ret.Get(1).(func(int32, bool, string) error); ok {
+		r1 = rf(userId, isActionUserSuperAdmin, token)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -224,22 +356,29 @@ func (_m *ClusterService) FindAllForClusterByUserId(userId int32, isActionUserSu
 	return r0, r1
 }
 
-// FindAllNamespacesByUserIdAndClusterId provides a mock function with given fields: userId, clusterId, isActionUserSuperAdmin
-func (_m *ClusterService) FindAllNamespacesByUserIdAndClusterId(userId int32, clusterId int, isActionUserSuperAdmin bool) ([]string, error) {
-	ret := _m.Called(userId, clusterId, isActionUserSuperAdmin)
+// FindAllNamespacesByUserIdAndClusterId provides a mock function with given fields: userId, clusterId, isActionUserSuperAdmin, token
+func (_m *ClusterService) FindAllNamespacesByUserIdAndClusterId(userId int32, clusterId int, isActionUserSuperAdmin bool, token string) ([]string, error) {
+	ret := _m.Called(userId, clusterId, isActionUserSuperAdmin, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAllNamespacesByUserIdAndClusterId")
+	}
 
 	var r0 []string
-	if rf, ok := ret.Get(0).(func(int32, int, bool) []string); ok {
-		r0 = rf(userId, clusterId, isActionUserSuperAdmin)
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int32, int, bool, string) ([]string, error)); ok {
+		return rf(userId, clusterId, isActionUserSuperAdmin, token)
+	}
+	if rf, ok := ret.Get(0).(func(int32, int, bool, string) []string); ok {
+		r0 = rf(userId, clusterId, isActionUserSuperAdmin, token)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]string)
 		}
 	}
 
-	var r1 error
-	if rf, ok := ret.Get(1).(func(int32, int, bool) error); ok {
-		r1 = rf(userId, clusterId, isActionUserSuperAdmin)
+	if rf, ok := ret.Get(1).(func(int32, int, bool, string) error); ok {
+		r1 = rf(userId, clusterId, isActionUserSuperAdmin, token)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -247,11 +386,19 @@ func (_m *ClusterService) FindAllNamespacesByUserIdAndClusterId(userId int32, cl
 	return r0, r1
 }
 
-// FindAllWithoutConfig provides a mock function with given fields:
+// FindAllWithoutConfig provides a mock function with no fields
 func (_m *ClusterService) FindAllWithoutConfig() ([]*bean.ClusterBean, error) {
 	ret := _m.Called()
 
+	if len(ret) == 0 {
+		panic("no return value specified for FindAllWithoutConfig")
+	}
+
 	var r0 []*bean.ClusterBean
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]*bean.ClusterBean, error)); ok {
+		return rf()
+	}
 	if rf, ok := ret.Get(0).(func() []*bean.ClusterBean); ok {
 		r0 = rf()
 	} else {
@@ -260,7 +407,6 @@ func (_m *ClusterService) FindAllWithoutConfig() ([]*bean.ClusterBean, error) {
 		}
 	}
 
-	var r1 error
 	if rf, ok := ret.Get(1).(func() error); ok {
 		r1 = rf()
 	} else {
@@ -274,7 +420,15 @@ func (_m *ClusterService) FindAllWithoutConfig() ([]*bean.ClusterBean, error) {
 func (_m *ClusterService) FindById(id int) (*bean.ClusterBean, error) {
 	ret := _m.Called(id)
 
+	if len(ret) == 0 {
+		panic("no return value specified for FindById")
+	}
+
 	var r0 *bean.ClusterBean
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (*bean.ClusterBean, error)); ok {
+		return rf(id)
+	}
 	if rf, ok := ret.Get(0).(func(int) *bean.ClusterBean); ok {
 		r0 = rf(id)
 	} else {
@@ -283,7 +437,6 @@ func (_m *ClusterService) FindById(id int) (*bean.ClusterBean, error) {
 		}
 	}
 
-	var r1 error
 	if rf, ok := ret.Get(1).(func(int) error); ok {
 		r1 = rf(id)
 	} else {
@@ -297,7 +450,15 @@ func (_m *ClusterService) FindById(id int) (*bean.ClusterBean, error) {
 func (_m *ClusterService) FindByIdWithoutConfig(id int) (*bean.ClusterBean, error) {
 	ret := _m.Called(id)
 
+	if len(ret) == 0 {
+		panic("no return value specified for FindByIdWithoutConfig")
+	}
+
 	var r0 *bean.ClusterBean
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (*bean.ClusterBean, error)); ok {
+		return rf(id)
+	}
 	if rf, ok := ret.Get(0).(func(int) *bean.ClusterBean); ok {
 		r0 = rf(id)
 	} else {
@@ -306,7 +467,6 @@ func (_m *ClusterService) FindByIdWithoutConfig(id int) (*bean.ClusterBean, erro
 		}
 	}
 
-	var r1 error
 	if rf, ok := ret.Get(1).(func(int) error); ok {
 		r1 = rf(id)
 	} else {
@@ -320,7 +480,15 @@ func (_m *ClusterService) FindByIdWithoutConfig(id int) (*bean.ClusterBean, erro
 func (_m *ClusterService) FindByIds(id []int) ([]bean.ClusterBean, error) {
 	ret := _m.Called(id)
 
+	if len(ret) == 0 {
+		panic("no return value specified for FindByIds")
+	}
+
 	var r0 []bean.ClusterBean
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]int) ([]bean.ClusterBean, error)); ok {
+		return rf(id)
+	}
 	if rf, ok := ret.Get(0).(func([]int) []bean.ClusterBean); ok {
 		r0 = rf(id)
 	} else {
@@ -329,7 +497,6 @@ func (_m *ClusterService) FindByIds(id []int) ([]bean.ClusterBean, error) {
 		}
 	}
 
-	var r1 error
 	if rf, ok := ret.Get(1).(func([]int) error); ok {
 		r1 = rf(id)
 	} else {
@@ -339,11 +506,49 @@ func (_m *ClusterService) FindByIds(id []int) ([]bean.ClusterBean, error) {
 	return r0, r1
 }
 
+// FindByIdsWithoutConfig provides a mock function with given fields: ids
+func (_m *ClusterService) FindByIdsWithoutConfig(ids []int) ([]*bean.ClusterBean, error) {
+	ret := _m.Called(ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByIdsWithoutConfig")
+	}
+
+	var r0 []*bean.ClusterBean
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]int) ([]*bean.ClusterBean, error)); ok {
+		return rf(ids)
+	}
+	if rf, ok := ret.Get(0).(func([]int) []*bean.ClusterBean); ok {
+		r0 = rf(ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*bean.ClusterBean)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]int) error); ok {
+		r1 = rf(ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // FindOne provides a mock function with given fields: clusterName
 func (_m *ClusterService) FindOne(clusterName string) (*bean.ClusterBean, error) {
 	ret := _m.Called(clusterName)
 
+	if len(ret) == 0 {
+		panic("no return value specified for FindOne")
+	}
+
 	var r0 *bean.ClusterBean
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*bean.ClusterBean, error)); ok {
+		return rf(clusterName)
+	}
 	if rf, ok := ret.Get(0).(func(string) *bean.ClusterBean); ok {
 		r0 = rf(clusterName)
 	} else {
@@ -352,7 +557,6 @@ func (_m *ClusterService) FindOne(clusterName string) (*bean.ClusterBean, error)
 		}
 	}
 
-	var r1 error
 	if rf, ok := ret.Get(1).(func(string) error); ok {
 		r1 = rf(clusterName)
 	} else {
@@ -366,7 +570,15 @@ func (_m *ClusterService) FindOne(clusterName string) (*bean.ClusterBean, error)
 func (_m *ClusterService) FindOneActive(clusterName string) (*bean.ClusterBean, error) {
 	ret := _m.Called(clusterName)
 
+	if len(ret) == 0 {
+		panic("no return value specified for FindOneActive")
+	}
+
 	var r0 *bean.ClusterBean
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*bean.ClusterBean, error)); ok {
+		return rf(clusterName)
+	}
 	if rf, ok := ret.Get(0).(func(string) *bean.ClusterBean); ok {
 		r0 = rf(clusterName)
 	} else {
@@ -375,7 +587,6 @@ func (_m *ClusterService) FindOneActive(clusterName string) (*bean.ClusterBean,
 		}
 	}
 
-	var r1 error
 	if rf, ok := ret.Get(1).(func(string) error); ok {
 		r1 = rf(clusterName)
 	} else {
@@ -385,10 +596,14 @@ func (_m *ClusterService) FindOneActive(clusterName string) (*bean.ClusterBean,
 	return r0, r1
 }
 
-// GetAllClusterNamespaces provides a mock function with given fields:
+// GetAllClusterNamespaces provides a mock function with no fields
 func (_m *ClusterService) GetAllClusterNamespaces() map[string][]string {
 	ret := _m.Called()
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllClusterNamespaces")
+	}
+
 	var r0 map[string][]string
 	if rf, ok := ret.Get(0).(func() map[string][]string); ok {
 		r0 = rf()
@@ -401,45 +616,29 @@ func (_m *ClusterService) GetAllClusterNamespaces() map[string][]string {
 	return r0
 }
 
-// GetClusterConfig provides a mock function with given fields: _a0
-func (_m *ClusterService) GetClusterConfig(_a0 *bean.ClusterBean) (*util.ClusterConfig, error) {
-	ret := _m.Called(_a0)
+// GetClusterConfigByClusterId provides a mock function with given fields: clusterId
+func (_m *ClusterService) GetClusterConfigByClusterId(clusterId int) (*k8s.ClusterConfig, error) {
+	ret := _m.Called(clusterId)
 
-	var r0 *util.ClusterConfig
-	if rf, ok := ret.Get(0).(func(*bean.ClusterBean) *util.ClusterConfig); ok {
-		r0 = rf(_a0)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*util.ClusterConfig)
-		}
+	if len(ret) == 0 {
+		panic("no return value specified for GetClusterConfigByClusterId")
 	}
 
+	var r0 *k8s.ClusterConfig
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*bean.ClusterBean) error); ok {
-		r1 = rf(_a0)
-	} else {
-		r1 = ret.Error(1)
+	if rf, ok := ret.Get(0).(func(int) (*k8s.ClusterConfig, error)); ok {
+		return rf(clusterId)
 	}
-
-	return r0, r1
-}
-
-// GetK8sClient provides a mock function with given fields:
-func (_m *ClusterService) GetK8sClient() (*v1.CoreV1Client, error) {
-	ret := _m.Called()
-
-	var r0 *v1.CoreV1Client
-	if rf, ok := ret.Get(0).(func() *v1.CoreV1Client); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(int) *k8s.ClusterConfig); ok {
+		r0 = rf(clusterId)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*v1.CoreV1Client)
+			r0 = ret.Get(0).(*k8s.ClusterConfig)
 		}
 	}
 
-	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(clusterId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -448,26 +647,33 @@ func (_m *ClusterService) GetK8sClient() (*v1.CoreV1Client, error) {
 }
 
 // HandleErrorInClusterConnections provides a mock function with given fields: clusters, respMap, clusterExistInDb
-func (_m *ClusterService) HandleErrorInClusterConnections(clusters []*bean.ClusterBean, respMap map[int]error, clusterExistInDb bool) {
+func (_m *ClusterService) HandleErrorInClusterConnections(clusters []*bean.ClusterBean, respMap *sync.Map, clusterExistInDb bool) {
 	_m.Called(clusters, respMap, clusterExistInDb)
 }
 
-// Save provides a mock function with given fields: parent, bean, userId
-func (_m *ClusterService) Save(parent context.Context, bean *bean.ClusterBean, userId int32) (*bean.ClusterBean, error) {
-	ret := _m.Called(parent, bean, userId)
+// Save provides a mock function with given fields: parent, _a1, userId
+func (_m *ClusterService) Save(parent context.Context, _a1 *bean.ClusterBean, userId int32) (*bean.ClusterBean, error) {
+	ret := _m.Called(parent, _a1, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Save")
+	}
 
 	var r0 *bean.ClusterBean
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *bean.ClusterBean, int32) (*bean.ClusterBean, error)); ok {
+		return rf(parent, _a1, userId)
+	}
 	if rf, ok := ret.Get(0).(func(context.Context, *bean.ClusterBean, int32) *bean.ClusterBean); ok {
-		r0 = rf(parent, bean, userId)
+		r0 = rf(parent, _a1, userId)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*bean.ClusterBean)
 		}
 	}
 
-	var r1 error
 	if rf, ok := ret.Get(1).(func(context.Context, *bean.ClusterBean, int32) error); ok {
-		r1 = rf(parent, bean, userId)
+		r1 = rf(parent, _a1, userId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -475,22 +681,29 @@ func (_m *ClusterService) Save(parent context.Context, bean *bean.ClusterBean, u
 	return r0, r1
 }
 
-// Update provides a mock function with given fields: ctx, bean, userId
-func (_m *ClusterService) Update(ctx context.Context, bean *bean.ClusterBean, userId int32) (*bean.ClusterBean, error) {
-	ret := _m.Called(ctx, bean, userId)
+// Update provides a mock function with given fields: ctx, _a1, userId
+func (_m *ClusterService) Update(ctx context.Context, _a1 *bean.ClusterBean, userId int32) (*bean.ClusterBean, error) {
+	ret := _m.Called(ctx, _a1, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
 
 	var r0 *bean.ClusterBean
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *bean.ClusterBean, int32) (*bean.ClusterBean, error)); ok {
+		return rf(ctx, _a1, userId)
+	}
 	if rf, ok := ret.Get(0).(func(context.Context, *bean.ClusterBean, int32) *bean.ClusterBean); ok {
-		r0 = rf(ctx, bean, userId)
+		r0 = rf(ctx, _a1, userId)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*bean.ClusterBean)
 		}
 	}
 
-	var r1 error
 	if rf, ok := ret.Get(1).(func(context.Context, *bean.ClusterBean, int32) error); ok {
-		r1 = rf(ctx, bean, userId)
+		r1 = rf(ctx, _a1, userId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -498,11 +711,37 @@ func (_m *ClusterService) Update(ctx context.Context, bean *bean.ClusterBean, us
 	return r0, r1
 }
 
+// UpdateClusterDescription provides a mock function with given fields: _a0, userId
+func (_m *ClusterService) UpdateClusterDescription(_a0 *bean.ClusterBean, userId int32) error {
+	ret := _m.Called(_a0, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateClusterDescription")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*bean.ClusterBean, int32) error); ok {
+		r0 = rf(_a0, userId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // ValidateKubeconfig provides a mock function with given fields: kubeConfig
 func (_m *ClusterService) ValidateKubeconfig(kubeConfig string) (map[string]*bean.ValidateClusterBean, error) {
 	ret := _m.Called(kubeConfig)
 
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateKubeconfig")
+	}
+
 	var r0 map[string]*bean.ValidateClusterBean
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (map[string]*bean.ValidateClusterBean, error)); ok {
+		return rf(kubeConfig)
+	}
 	if rf, ok := ret.Get(0).(func(string) map[string]*bean.ValidateClusterBean); ok {
 		r0 = rf(kubeConfig)
 	} else {
@@ -511,7 +750,6 @@ func (_m *ClusterService) ValidateKubeconfig(kubeConfig string) (map[string]*bea
 		}
 	}
 
-	var r1 error
 	if rf, ok := ret.Get(1).(func(string) error); ok {
 		r1 = rf(kubeConfig)
 	} else {
@@ -521,13 +759,12 @@ func (_m *ClusterService) ValidateKubeconfig(kubeConfig string) (map[string]*bea
 	return r0, r1
 }
 
-type mockConstructorTestingTNewClusterService interface {
+// NewClusterService creates a new instance of ClusterService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewClusterService(t interface {
 	mock.TestingT
 	Cleanup(func())
-}
-
-// NewClusterService creates a new instance of ClusterService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-func NewClusterService(t mockConstructorTestingTNewClusterService) *ClusterService {
+}) *ClusterService {
 	mock := &ClusterService{}
 	mock.Mock.Test(t)
 
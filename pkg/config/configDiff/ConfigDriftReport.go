@@ -0,0 +1,255 @@
+package configDiff
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/devtron-labs/devtron/internal/util"
+	bean3 "github.com/devtron-labs/devtron/pkg/bean"
+	bean2 "github.com/devtron-labs/devtron/pkg/config/configDiff/bean"
+	"github.com/devtron-labs/devtron/pkg/config/configDiff/utils"
+	"github.com/devtron-labs/devtron/pkg/resourceQualifiers"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+func (impl *DeploymentConfigurationServiceImpl) GetConfigDriftReport(ctx context.Context, queryParams *bean2.ConfigDriftReportQueryParams,
+	validateEnvAccess func(envName string) bool) (*bean2.ConfigDriftReport, error) {
+	appId, err := impl.appRepository.FindAppIdByName(queryParams.AppName)
+	if err != nil {
+		impl.logger.Errorw("GetConfigDriftReport, error in getting app id by appName", "appName", queryParams.AppName, "err", err)
+		return nil, err
+	}
+	environments, err := impl.getConfigDriftEnvironments(appId, queryParams.EnvNames, validateEnvAccess)
+	if err != nil {
+		impl.logger.Errorw("GetConfigDriftReport, error in getting environments", "appId", appId, "envNames", queryParams.EnvNames, "err", err)
+		return nil, err
+	}
+	deploymentTemplateDrift, err := impl.getDeploymentTemplateDrift(ctx, appId, environments)
+	if err != nil {
+		impl.logger.Errorw("GetConfigDriftReport, error in getting deployment template drift", "appId", appId, "err", err)
+		return nil, err
+	}
+	configMapDrift, secretDrift, err := impl.getCmCsDrift(ctx, appId, queryParams.AppName, environments)
+	if err != nil {
+		impl.logger.Errorw("GetConfigDriftReport, error in getting cm cs drift", "appId", appId, "err", err)
+		return nil, err
+	}
+	report := &bean2.ConfigDriftReport{
+		AppId:              appId,
+		AppName:            queryParams.AppName,
+		Environments:       environments,
+		DeploymentTemplate: deploymentTemplateDrift,
+		ConfigMaps:         configMapDrift,
+		Secrets:            secretDrift,
+	}
+	if queryParams.OnlyDrifted {
+		filterDriftedOnly(report)
+	}
+	return report, nil
+}
+
+// getConfigDriftEnvironments returns the base config column followed by the environments having an active cd pipeline for the app,
+// in the requested order if envNames is provided else sorted by name. Environments the user cannot view are left out,
+// requesting one of them explicitly is forbidden.
+func (impl *DeploymentConfigurationServiceImpl) getConfigDriftEnvironments(appId int, envNames string, validateEnvAccess func(envName string) bool) ([]*bean2.ConfigDriftEnvironment, error) {
+	pipelines, err := impl.pipelineRepository.FindActiveByAppId(appId)
+	if err != nil {
+		impl.logger.Errorw("error in getting active pipelines by appId", "appId", appId, "err", err)
+		return nil, err
+	}
+	envByName := make(map[string]*bean2.ConfigDriftEnvironment, len(pipelines))
+	for _, p := range pipelines {
+		envByName[p.Environment.Name] = &bean2.ConfigDriftEnvironment{
+			EnvId:        p.EnvironmentId,
+			EnvName:      p.Environment.Name,
+			IsProduction: p.Environment.Default,
+			ClusterId:    p.Environment.ClusterId,
+			Namespace:    p.Environment.Namespace,
+		}
+	}
+	environments := []*bean2.ConfigDriftEnvironment{{IsBaseConfig: true}}
+	if len(envNames) == 0 {
+		names := make([]string, 0, len(envByName))
+		for name := range envByName {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			if validateEnvAccess(name) {
+				environments = append(environments, envByName[name])
+			}
+		}
+		return environments, nil
+	}
+	for _, name := range strings.Split(envNames, ",") {
+		name = strings.TrimSpace(name)
+		if len(name) == 0 {
+			continue
+		}
+		env, ok := envByName[name]
+		if !ok {
+			errMsg := fmt.Sprintf("app has no deployment pipeline in environment %q", name)
+			return nil, util.NewApiError(http.StatusBadRequest, errMsg, errMsg)
+		}
+		if !validateEnvAccess(name) {
+			errMsg := fmt.Sprintf("unauthorized user for environment %q", name)
+			return nil, util.NewApiError(http.StatusForbidden, errMsg, errMsg)
+		}
+		environments = append(environments, env)
+	}
+	return environments, nil
+}
+
+func (impl *DeploymentConfigurationServiceImpl) getDeploymentTemplateDrift(ctx context.Context, appId int, environments []*bean2.ConfigDriftEnvironment) (*bean2.ConfigDriftResource, error) {
+	columns := make([]map[string]interface{}, 0, len(environments))
+	presentIn := make([]bool, 0, len(environments))
+	for _, env := range environments {
+		deploymentConfig, err := impl.getPublishedDeploymentConfig(ctx, appId, env.EnvId)
+		if err != nil {
+			impl.logger.Errorw("error in getting published deployment template", "appId", appId, "envId", env.EnvId, "err", err)
+			return nil, err
+		}
+		// resolved values are compared so that scoped variables resolving differently per env are reported as drift
+		data := deploymentConfig.ResolvedValue
+		if len(data) == 0 {
+			data = deploymentConfig.Data
+		}
+		var values interface{}
+		if err = json.Unmarshal(data, &values); err != nil {
+			impl.logger.Errorw("error in unmarshalling deployment template", "appId", appId, "envId", env.EnvId, "err", err)
+			return nil, err
+		}
+		leaves := make(map[string]interface{})
+		utils.FlattenConfigValues("", values, leaves)
+		columns = append(columns, leaves)
+		presentIn = append(presentIn, true)
+	}
+	return newConfigDriftResource(bean2.ConfigDriftDeploymentTemplateName, presentIn, false, utils.GetConfigDriftKeys(columns, false)), nil
+}
+
+func (impl *DeploymentConfigurationServiceImpl) getCmCsDrift(ctx context.Context, appId int, appName string,
+	environments []*bean2.ConfigDriftEnvironment) ([]*bean2.ConfigDriftResource, []*bean2.ConfigDriftResource, error) {
+	cmColumns := make([]map[string]*bean3.ConfigData, 0, len(environments))
+	secretColumns := make([]map[string]*bean3.ConfigData, 0, len(environments))
+	for _, env := range environments {
+		cmcsMetadataDto, err := impl.getMergedCmCs(env.EnvId, appId)
+		if err != nil {
+			impl.logger.Errorw("error in getting merged cm cs", "appId", appId, "envId", env.EnvId, "err", err)
+			return nil, nil, err
+		}
+		// resolved data is compared like the deployment template, scoped variables resolving differently per env are reported as drift
+		scope := resourceQualifiers.Scope{
+			AppId:     appId,
+			EnvId:     env.EnvId,
+			ClusterId: env.ClusterId,
+			SystemMetadata: &resourceQualifiers.SystemMetadata{
+				AppName:         appName,
+				EnvironmentName: env.EnvName,
+				Namespace:       env.Namespace,
+			},
+		}
+		resolvedConfigMap, resolvedSecret, _, _, err := impl.scopedVariableManager.ResolveCMCS(ctx, scope, cmcsMetadataDto.ConfigAppLevelId, cmcsMetadataDto.ConfigEnvLevelId, cmcsMetadataDto.CmMap, cmcsMetadataDto.SecretMap)
+		if err != nil {
+			impl.logger.Errorw("error in resolving cm cs", "appId", appId, "envId", env.EnvId, "err", err)
+			return nil, nil, err
+		}
+		cmColumns = append(cmColumns, resolvedConfigMap)
+		secretColumns = append(secretColumns, resolvedSecret)
+	}
+	configMapDrift, err := getCmCsResourceDrift(cmColumns, false)
+	if err != nil {
+		impl.logger.Errorw("error in computing configmap drift", "appId", appId, "err", err)
+		return nil, nil, err
+	}
+	secretDrift, err := getCmCsResourceDrift(secretColumns, true)
+	if err != nil {
+		impl.logger.Errorw("error in computing secret drift", "appId", appId, "err", err)
+		return nil, nil, err
+	}
+	return configMapDrift, secretDrift, nil
+}
+
+func getCmCsResourceDrift(columns []map[string]*bean3.ConfigData, isSecret bool) ([]*bean2.ConfigDriftResource, error) {
+	nameSet := make(map[string]bool)
+	for _, column := range columns {
+		for name := range column {
+			nameSet[name] = true
+		}
+	}
+	names := make([]string, 0, len(nameSet))
+	for name := range nameSet {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	resources := make([]*bean2.ConfigDriftResource, 0, len(names))
+	for _, name := range names {
+		keyColumns := make([]map[string]interface{}, 0, len(columns))
+		presentIn := make([]bool, 0, len(columns))
+		external := false
+		for _, column := range columns {
+			configData, ok := column[name]
+			if !ok || configData == nil {
+				keyColumns = append(keyColumns, nil)
+				presentIn = append(presentIn, false)
+				continue
+			}
+			keyValues, err := utils.GetCmCsDataKeyValues(configData)
+			if err != nil {
+				return nil, err
+			}
+			keyColumns = append(keyColumns, keyValues)
+			presentIn = append(presentIn, true)
+			external = external || configData.External
+		}
+		resources = append(resources, newConfigDriftResource(name, presentIn, external, utils.GetConfigDriftKeys(keyColumns, isSecret)))
+	}
+	return resources, nil
+}
+
+func newConfigDriftResource(name string, presentIn []bool, external bool, keys []*bean2.ConfigDriftKey) *bean2.ConfigDriftResource {
+	resource := &bean2.ConfigDriftResource{
+		Name:      name,
+		PresentIn: presentIn,
+		External:  external,
+		Keys:      keys,
+	}
+	for _, present := range presentIn {
+		if present != presentIn[0] {
+			resource.Drifted = true
+		}
+	}
+	for _, key := range keys {
+		if key.IsDrifted() {
+			resource.Drifted = true
+		}
+	}
+	return resource
+}
+
+func filterDriftedOnly(report *bean2.ConfigDriftReport) {
+	filterKeys := func(resource *bean2.ConfigDriftResource) {
+		driftedKeys := make([]*bean2.ConfigDriftKey, 0, len(resource.Keys))
+		for _, key := range resource.Keys {
+			if key.IsDrifted() {
+				driftedKeys = append(driftedKeys, key)
+			}
+		}
+		resource.Keys = driftedKeys
+	}
+	filterResources := func(resources []*bean2.ConfigDriftResource) []*bean2.ConfigDriftResource {
+		driftedResources := make([]*bean2.ConfigDriftResource, 0, len(resources))
+		for _, resource := range resources {
+			if resource.Drifted {
+				filterKeys(resource)
+				driftedResources = append(driftedResources, resource)
+			}
+		}
+		return driftedResources
+	}
+	filterKeys(report.DeploymentTemplate)
+	report.ConfigMaps = filterResources(report.ConfigMaps)
+	report.Secrets = filterResources(report.Secrets)
+}
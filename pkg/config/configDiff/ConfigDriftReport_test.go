@@ -0,0 +1,150 @@
+package configDiff
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/devtron-labs/devtron/internal/sql/repository/chartConfig"
+	chartConfigMocks "github.com/devtron-labs/devtron/internal/sql/repository/chartConfig/mocks"
+	"github.com/devtron-labs/devtron/internal/sql/repository/pipelineConfig"
+	pipelineConfigMocks "github.com/devtron-labs/devtron/internal/sql/repository/pipelineConfig/mocks"
+	"github.com/devtron-labs/devtron/internal/util"
+	bean3 "github.com/devtron-labs/devtron/pkg/bean"
+	"github.com/devtron-labs/devtron/pkg/cluster/environment/repository"
+	repository3 "github.com/devtron-labs/devtron/pkg/pipeline/history/repository"
+	pipelineMocks "github.com/devtron-labs/devtron/pkg/pipeline/mocks"
+	"github.com/devtron-labs/devtron/pkg/resourceQualifiers"
+	"github.com/devtron-labs/devtron/pkg/variables"
+	variableMocks "github.com/devtron-labs/devtron/pkg/variables/mocks"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"testing"
+)
+
+func newConfigDriftTestService(t *testing.T, variableManager variables.ScopedVariableCMCSManager) *DeploymentConfigurationServiceImpl {
+	logger, err := util.NewSugardLogger()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pipelineRepository := pipelineConfigMocks.NewPipelineRepository(t)
+	pipelineRepository.On("FindActiveByAppId", mock.Anything).Return([]*pipelineConfig.Pipeline{
+		{EnvironmentId: 2, Environment: repository.Environment{Id: 2, Name: "staging", ClusterId: 1, Namespace: "staging-ns"}},
+		{EnvironmentId: 3, Environment: repository.Environment{Id: 3, Name: "prod", ClusterId: 5, Namespace: "prod-ns", Default: true}},
+	}, nil).Maybe()
+	configMapRepository := chartConfigMocks.NewConfigMapRepository(t)
+	configMapRepository.On("GetByAppIdAppLevel", mock.Anything).Return(func(appId int) *chartConfig.ConfigMapAppModel {
+		return &chartConfig.ConfigMapAppModel{Id: 1, AppId: appId}
+	}, nil).Maybe()
+	configMapRepository.On("GetByAppIdAndEnvIdEnvLevel", mock.Anything, mock.Anything).Return(func(appId int, envId int) *chartConfig.ConfigMapEnvModel {
+		return &chartConfig.ConfigMapEnvModel{Id: envId, AppId: appId, EnvironmentId: envId}
+	}, nil).Maybe()
+	// the same unresolved cm and secret for every environment
+	deploymentConfigService := pipelineMocks.NewPipelineDeploymentConfigService(t)
+	deploymentConfigService.On("GetMergedCMCSConfigMap", mock.Anything, mock.Anything, repository3.SECRET_TYPE).Return(map[string]*bean3.ConfigData{
+		"db-secret": {Name: "db-secret", Data: json.RawMessage(`{"PASSWORD":"@{{password}}","TOKEN":"@{{token}}"}`)},
+	}, nil).Maybe()
+	deploymentConfigService.On("GetMergedCMCSConfigMap", mock.Anything, mock.Anything, repository3.CONFIGMAP_TYPE).Return(map[string]*bean3.ConfigData{
+		"app-cm": {Name: "app-cm", Data: json.RawMessage(`{"HOST":"@{{host}}","PORT":"8080"}`)},
+	}, nil).Maybe()
+	return &DeploymentConfigurationServiceImpl{
+		logger:                  logger,
+		pipelineRepository:      pipelineRepository,
+		configMapRepository:     configMapRepository,
+		deploymentConfigService: deploymentConfigService,
+		scopedVariableManager:   variableManager,
+	}
+}
+
+func TestGetConfigDriftEnvironments(t *testing.T) {
+	impl := newConfigDriftTestService(t, nil)
+	onlyStaging := func(envName string) bool { return envName == "staging" }
+
+	environments, err := impl.getConfigDriftEnvironments(1, "", onlyStaging)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(environments) != 2 || !environments[0].IsBaseConfig || environments[1].EnvName != "staging" {
+		t.Fatalf("expected base config and staging only, got %+v", environments)
+	}
+	if environments[1].ClusterId != 1 || environments[1].Namespace != "staging-ns" {
+		t.Errorf("expected cluster and namespace of staging, got %+v", environments[1])
+	}
+
+	_, err = impl.getConfigDriftEnvironments(1, "staging,prod", onlyStaging)
+	apiErr, ok := err.(*util.ApiError)
+	if !ok || apiErr.HttpStatusCode != http.StatusForbidden {
+		t.Fatalf("expected forbidden for requested prod, got %v", err)
+	}
+
+	environments, err = impl.getConfigDriftEnvironments(1, "prod, staging", func(string) bool { return true })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(environments) != 3 || environments[1].EnvName != "prod" || environments[2].EnvName != "staging" {
+		t.Fatalf("expected requested order, got %+v", environments)
+	}
+}
+
+func TestGetCmCsDriftComparesResolvedData(t *testing.T) {
+	// the variables resolve to a value specific to the environment in scope, except for the password
+	var scopes []resourceQualifiers.Scope
+	variableManager := variableMocks.NewScopedVariableCMCSManager(t)
+	variableManager.On("ResolveCMCS", mock.Anything, mock.AnythingOfType("resourceQualifiers.Scope"), mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			scopes = append(scopes, args.Get(1).(resourceQualifiers.Scope))
+		}).
+		Return(func(ctx context.Context, scope resourceQualifiers.Scope, configAppLevelId int, configEnvLevelId int,
+			mergedConfigMap map[string]*bean3.ConfigData, mergedSecret map[string]*bean3.ConfigData) map[string]*bean3.ConfigData {
+			return map[string]*bean3.ConfigData{
+				"app-cm": {Name: "app-cm", Data: json.RawMessage(fmt.Sprintf(`{"HOST":"host-%d","PORT":"8080"}`, scope.EnvId))},
+			}
+		}, func(ctx context.Context, scope resourceQualifiers.Scope, configAppLevelId int, configEnvLevelId int,
+			mergedConfigMap map[string]*bean3.ConfigData, mergedSecret map[string]*bean3.ConfigData) map[string]*bean3.ConfigData {
+			return map[string]*bean3.ConfigData{
+				"db-secret": {Name: "db-secret", Data: json.RawMessage(fmt.Sprintf(`{"PASSWORD":"same-everywhere","TOKEN":"token-%d"}`, scope.EnvId))},
+			}
+		}, nil, nil, nil).Times(3)
+	impl := newConfigDriftTestService(t, variableManager)
+	environments, err := impl.getConfigDriftEnvironments(1, "", func(string) bool { return true })
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	configMapDrift, secretDrift, err := impl.getCmCsDrift(context.Background(), 1, "demo-app", environments)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(configMapDrift) != 1 || !configMapDrift[0].Drifted {
+		t.Fatalf("expected app-cm to drift on resolved values, got %+v", configMapDrift)
+	}
+	for _, key := range configMapDrift[0].Keys {
+		drifted := key.Key == "HOST"
+		if key.IsDrifted() != drifted {
+			t.Errorf("key %s: drifted=%v, want %v", key.Key, key.IsDrifted(), drifted)
+		}
+	}
+	if len(secretDrift) != 1 || !secretDrift[0].Drifted {
+		t.Fatalf("expected db-secret to drift on the resolved token, got %+v", secretDrift)
+	}
+	for _, key := range secretDrift[0].Keys {
+		drifted := key.Key == "TOKEN"
+		if key.IsDrifted() != drifted {
+			t.Errorf("secret key %s: drifted=%v, want %v", key.Key, key.IsDrifted(), drifted)
+		}
+		// only an equal/different flag is reported for the values of secrets
+		for _, value := range key.Values {
+			if value.Value != nil {
+				t.Errorf("secret key %s: expected no value, got %v", key.Key, value.Value)
+			}
+		}
+	}
+
+	if len(scopes) != 3 {
+		t.Fatalf("expected cm cs to be resolved once per column, got %d", len(scopes))
+	}
+	prodScope := scopes[1]
+	if prodScope.AppId != 1 || prodScope.EnvId != 3 || prodScope.ClusterId != 5 || prodScope.SystemMetadata.AppName != "demo-app" ||
+		prodScope.SystemMetadata.EnvironmentName != "prod" || prodScope.SystemMetadata.Namespace != "prod-ns" {
+		t.Errorf("unexpected scope for prod: %+v %+v", prodScope, prodScope.SystemMetadata)
+	}
+}
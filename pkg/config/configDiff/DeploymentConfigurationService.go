@@ -54,6 +54,9 @@ type DeploymentConfigurationService interface {
 	GetAllConfigData(ctx context.Context, configDataQueryParams *bean2.ConfigDataQueryParams, userHasAdminAccess bool) (*bean2.DeploymentAndCmCsConfigDto, error)
 	CompareCategoryWiseConfigData(ctx context.Context, comparisonRequestDto bean2.ComparisonRequestDto, userHasAdminAccess bool) (*bean2.ComparisonResponseDto, error)
 	GetManifest(ctx context.Context, manifestRequest *bean2.ManifestRequest) (*bean2.ManifestResponse, error)
+	// GetConfigDriftReport lines up deployment template, cm and cs keys of an app across its environments
+	// environments failing validateEnvAccess are left out of the report
+	GetConfigDriftReport(ctx context.Context, queryParams *bean2.ConfigDriftReportQueryParams, validateEnvAccess func(envName string) bool) (*bean2.ConfigDriftReport, error)
 }
 
 type DeploymentConfigurationServiceImpl struct {
@@ -282,7 +285,7 @@ func (impl *DeploymentConfigurationServiceImpl) GetManifest(ctx context.Context,
 		decodedValuesByte = []byte(values)
 	}
 
-	resolvedTemplate, _, err := impl.scopedVariableManager.ExtractVariablesAndResolveTemplate(scope, string(decodedValuesByte), parsers.JsonVariableTemplate, isSuperAdmin, true)
+	resolvedTemplate, _, err := impl.scopedVariableManager.ExtractVariablesAndResolveTemplate(scope, string(decodedValuesByte), parsers.JsonVariableTemplate, isSuperAdmin, true, true)
 	if err != nil {
 		return nil, err
 	}
@@ -537,7 +540,7 @@ func (impl *DeploymentConfigurationServiceImpl) getDeploymentHistoryConfig(ctx c
 		HistoryReferenceId:   deploymentHistory.Id,
 		HistoryReferenceType: repository6.HistoryReferenceTypeDeploymentTemplate,
 	}
-	variableSnapshotMap, resolvedTemplate, err := impl.scopedVariableManager.GetVariableSnapshotAndResolveTemplate(deploymentHistory.Template, parsers.JsonVariableTemplate, reference, isSuperAdmin, false)
+	variableSnapshotMap, resolvedTemplate, err := impl.scopedVariableManager.GetVariableSnapshotAndResolveTemplate(deploymentHistory.Template, parsers.JsonVariableTemplate, reference, isSuperAdmin, false, true)
 	if err != nil {
 		impl.logger.Errorw("error while resolving template from history", "deploymentHistoryId", deploymentHistory.Id, "pipelineId", configDataQueryParams.PipelineId, "err", err)
 	}
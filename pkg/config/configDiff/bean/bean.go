@@ -322,3 +322,57 @@ const (
 func (r Resource) ToString() string {
 	return string(r)
 }
+
+type ConfigDriftReportQueryParams struct {
+	AppName     string `schema:"appName" json:"appName"`
+	EnvNames    string `schema:"envNames" json:"envNames"` // comma separated, all environments of the app are reported if empty
+	OnlyDrifted bool   `schema:"onlyDrifted" json:"onlyDrifted"`
+	UserId      int32  `schema:"-"`
+}
+
+// ConfigDriftReport lines up deployment template, cm and cs keys of an app across its environments,
+// Environments[0] is always the base (app level) config and every Values slice is aligned with Environments.
+type ConfigDriftReport struct {
+	AppId              int                       `json:"appId"`
+	AppName            string                    `json:"appName"`
+	Environments       []*ConfigDriftEnvironment `json:"environments"`
+	DeploymentTemplate *ConfigDriftResource      `json:"deploymentTemplate"`
+	ConfigMaps         []*ConfigDriftResource    `json:"configMaps"`
+	Secrets            []*ConfigDriftResource    `json:"secrets"`
+}
+
+type ConfigDriftEnvironment struct {
+	EnvId        int    `json:"envId"`
+	EnvName      string `json:"envName"`
+	IsProduction bool   `json:"isProduction"`
+	IsBaseConfig bool   `json:"isBaseConfig"`
+	ClusterId    int    `json:"-"`
+	Namespace    string `json:"-"`
+}
+
+type ConfigDriftResource struct {
+	Name      string            `json:"name"`
+	PresentIn []bool            `json:"presentIn"`
+	External  bool              `json:"external"`
+	Drifted   bool              `json:"drifted"`
+	Keys      []*ConfigDriftKey `json:"keys"`
+}
+
+type ConfigDriftKey struct {
+	Key               string              `json:"key"`
+	Values            []*ConfigDriftValue `json:"values"`
+	DivergesFromBase  bool                `json:"divergesFromBase"`
+	DiffersAcrossEnvs bool                `json:"differsAcrossEnvs"`
+}
+
+func (k *ConfigDriftKey) IsDrifted() bool {
+	return k.DivergesFromBase || k.DiffersAcrossEnvs
+}
+
+type ConfigDriftValue struct {
+	Present    bool        `json:"present"`
+	SameAsBase bool        `json:"sameAsBase"`
+	Value      interface{} `json:"value,omitempty"` // never set for secrets
+}
+
+const ConfigDriftDeploymentTemplateName = "deployment-template"
@@ -0,0 +1,110 @@
+package utils
+
+import (
+	"encoding/json"
+	bean3 "github.com/devtron-labs/devtron/pkg/bean"
+	bean2 "github.com/devtron-labs/devtron/pkg/config/configDiff/bean"
+	"reflect"
+	"sort"
+)
+
+// FlattenConfigValues flattens nested maps into dot separated leaf keys, lists are kept as a single leaf
+// so that a reordered or resized list shows up as one drifted key.
+func FlattenConfigValues(prefix string, value interface{}, leaves map[string]interface{}) {
+	nested, ok := value.(map[string]interface{})
+	if !ok || len(nested) == 0 {
+		if len(prefix) > 0 {
+			leaves[prefix] = value
+		}
+		return
+	}
+	for key, nestedValue := range nested {
+		path := key
+		if len(prefix) > 0 {
+			path = prefix + "." + key
+		}
+		FlattenConfigValues(path, nestedValue, leaves)
+	}
+}
+
+// GetCmCsDataKeyValues returns the key value pairs of a cm/cs for drift comparison. Values of secrets are only
+// compared in memory, GetConfigDriftKeys with maskValues reports an equal/different flag per key in their place.
+func GetCmCsDataKeyValues(configData *bean3.ConfigData) (map[string]interface{}, error) {
+	keyValues := make(map[string]interface{})
+	if configData.IsESOExternalSecretType() {
+		for _, esoData := range configData.ESOSecretData.ESOData {
+			keyValues[esoData.SecretKey] = esoData
+		}
+		return keyValues, nil
+	}
+	if len(configData.ExternalSecret) > 0 {
+		for _, externalSecret := range configData.ExternalSecret {
+			keyValues[externalSecret.Name] = externalSecret
+		}
+		return keyValues, nil
+	}
+	rawData := configData.DefaultData
+	if len(configData.Data) > 0 {
+		rawData = configData.Data
+	}
+	if len(rawData) == 0 {
+		return keyValues, nil
+	}
+	data := make(map[string]string)
+	if err := json.Unmarshal(rawData, &data); err != nil {
+		return nil, err
+	}
+	for key, value := range data {
+		keyValues[key] = value
+	}
+	return keyValues, nil
+}
+
+// GetConfigDriftKeys lines up the key values of every column, columns[0] being the base config.
+// A nil column means the resource is not present in that environment.
+func GetConfigDriftKeys(columns []map[string]interface{}, maskValues bool) []*bean2.ConfigDriftKey {
+	if len(columns) == 0 {
+		return make([]*bean2.ConfigDriftKey, 0)
+	}
+	keySet := make(map[string]bool)
+	for _, column := range columns {
+		for key := range column {
+			keySet[key] = true
+		}
+	}
+	keys := make([]string, 0, len(keySet))
+	for key := range keySet {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	driftKeys := make([]*bean2.ConfigDriftKey, 0, len(keys))
+	for _, key := range keys {
+		driftKey := &bean2.ConfigDriftKey{Key: key, Values: make([]*bean2.ConfigDriftValue, 0, len(columns))}
+		for _, column := range columns {
+			value, present := column[key]
+			driftValue := &bean2.ConfigDriftValue{Present: present}
+			if !maskValues {
+				driftValue.Value = value
+			}
+			driftKey.Values = append(driftKey.Values, driftValue)
+		}
+		baseValue, presentInBase := columns[0][key]
+		for i := 1; i < len(columns); i++ {
+			value, present := columns[i][key]
+			driftKey.Values[i].SameAsBase = present == presentInBase && reflect.DeepEqual(value, baseValue)
+			if !driftKey.Values[i].SameAsBase {
+				driftKey.DivergesFromBase = true
+			}
+			if i > 1 {
+				prevValue, prevPresent := columns[i-1][key]
+				if present != prevPresent || !reflect.DeepEqual(value, prevValue) {
+					driftKey.DiffersAcrossEnvs = true
+				}
+			}
+		}
+		driftKey.Values[0].SameAsBase = true
+		driftKeys = append(driftKeys, driftKey)
+	}
+	return driftKeys
+}
@@ -0,0 +1,42 @@
+package utils
+
+import (
+	"testing"
+)
+
+func TestGetConfigDriftKeys(t *testing.T) {
+	base := map[string]interface{}{}
+	FlattenConfigValues("", map[string]interface{}{
+		"replicaCount": float64(1),
+		"resources":    map[string]interface{}{"limits": map[string]interface{}{"cpu": "1"}},
+	}, base)
+	staging := map[string]interface{}{"replicaCount": float64(1), "resources.limits.cpu": "1"}
+	prod := map[string]interface{}{"replicaCount": float64(3), "resources.limits.cpu": "1", "autoscaling.enabled": true}
+
+	keys := GetConfigDriftKeys([]map[string]interface{}{base, staging, prod}, false)
+	if len(keys) != 3 {
+		t.Fatalf("expected 3 keys, got %d", len(keys))
+	}
+	expected := map[string][2]bool{
+		"autoscaling.enabled":  {true, true},
+		"replicaCount":         {true, true},
+		"resources.limits.cpu": {false, false},
+	}
+	for _, key := range keys {
+		want, ok := expected[key.Key]
+		if !ok {
+			t.Fatalf("unexpected key %s", key.Key)
+		}
+		if key.DivergesFromBase != want[0] || key.DiffersAcrossEnvs != want[1] {
+			t.Errorf("key %s: divergesFromBase=%v differsAcrossEnvs=%v, want %v", key.Key, key.DivergesFromBase, key.DiffersAcrossEnvs, want)
+		}
+	}
+	if keys[1].Values[1].SameAsBase != true || keys[1].Values[2].SameAsBase != false {
+		t.Errorf("unexpected sameAsBase for replicaCount: %v, %v", keys[1].Values[1].SameAsBase, keys[1].Values[2].SameAsBase)
+	}
+
+	masked := GetConfigDriftKeys([]map[string]interface{}{{"password": "a"}, nil}, true)
+	if masked[0].Values[0].Value != nil || masked[0].Values[1].Present || !masked[0].DivergesFromBase {
+		t.Errorf("unexpected masked secret drift: %+v %+v", masked[0].Values[0], masked[0].Values[1])
+	}
+}
@@ -43,5 +43,18 @@ func GetGitOpsConfigBean(model *repository.GitOpsConfig) *apiGitOpsBean.GitOpsCo
 			TLSCertData: model.TlsCert,
 			TLSKeyData:  model.TlsKey,
 		},
+		CommitSigningConfig: GetCommitSigningConfigBean(model),
+	}
+}
+
+// GetCommitSigningConfigBean returns the commit signing config along with the key material,
+// it must not be used for building API responses.
+func GetCommitSigningConfigBean(model *repository.GitOpsConfig) *apiGitOpsBean.CommitSigningConfig {
+	return &apiGitOpsBean.CommitSigningConfig{
+		Enabled:             model.CommitSigningEnabled,
+		Format:              model.CommitSigningFormat,
+		SigningKey:          model.CommitSigningKey.String(),
+		Passphrase:          model.CommitSigningPassphrase.String(),
+		IsSigningKeyPresent: len(model.CommitSigningKey) > 0,
 	}
 }
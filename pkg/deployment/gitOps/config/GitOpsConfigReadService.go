@@ -191,6 +191,7 @@ func (impl *GitOpsConfigReadServiceImpl) GetAllGitOpsConfig() ([]*bean2.GitOpsCo
 				TLSKeyData:  model.TlsKey,
 			},
 			EnableTLSVerification: model.EnableTLSVerification,
+			CommitSigningConfig:   adapter.GetCommitSigningConfigBean(model),
 		})
 	}
 	return configs, err
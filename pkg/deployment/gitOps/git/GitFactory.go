@@ -58,6 +58,7 @@ func (factory *GitFactory) Reload(gitOpsConfigReadService config.GitOpsConfigRea
 	clientHelperMap := make(map[string]*ClientHelperObject, len(cfgs))
 	for _, cfg := range cfgs {
 		gitOpsHelper, _ := NewGitOpsHelperImpl(cfg.GetAuth(), factory.logger, cfg.GetTLSConfig(), cfg.EnableTLSVerification)
+		gitOpsHelper.SetCommitSigningConfig(cfg.GetCommitSigningConfig())
 		client, clientCreationError := NewGitOpsClient(cfg, factory.logger, gitOpsHelper)
 		if clientCreationError != nil && cfg.IsActiveConfig { // only passing error in case of active config
 			return err
@@ -122,6 +123,7 @@ func (factory *GitFactory) NewClientForValidation(gitOpsConfig *gitOps.GitOpsCon
 		factory.logger.Errorw("error in creating gitOps helper", "gitProvider", cfg.GitProvider, "err", err)
 		return nil, gitOpsHelper, err
 	}
+	gitOpsHelper.SetCommitSigningConfig(cfg.GetCommitSigningConfig())
 	client, err := NewGitOpsClient(cfg, factory.logger, gitOpsHelper)
 	if err != nil {
 		factory.logger.Errorw("error in creating gitOps client", "gitProvider", cfg.GitProvider, "err", err)
@@ -129,7 +131,8 @@ func (factory *GitFactory) NewClientForValidation(gitOpsConfig *gitOps.GitOpsCon
 	}
 
 	//factory.Client = client
-	factory.logger.Infow("client changed successfully", "cfg", cfg)
+	// cfg holds the git token, tls key and commit signing key, only non secret fields are logged
+	factory.logger.Infow("client changed successfully", "gitProvider", cfg.GitProvider, "gitHost", cfg.GitHost, "commitSigningFormat", cfg.CommitSigningFormat)
 	return client, gitOpsHelper, nil
 }
 
@@ -48,7 +48,7 @@ func GetGitConfigAll(gitOpsConfigReadService config.GitOpsConfigReadService) ([]
 	}
 	cfgs := make([]*bean.GitConfig, 0, len(gitOpsConfigs))
 	for _, gitOpsConfig := range gitOpsConfigs {
-		cfgs = append(cfgs, &bean.GitConfig{
+		cfg := &bean.GitConfig{
 			GitlabGroupId:         gitOpsConfig.GitLabGroupId,
 			GitToken:              gitOpsConfig.Token,
 			GitUserName:           gitOpsConfig.Username,
@@ -64,7 +64,13 @@ func GetGitConfigAll(gitOpsConfigReadService config.GitOpsConfigReadService) ([]
 			TLSCert:               gitOpsConfig.TLSConfig.TLSCertData,
 			TLSKey:                gitOpsConfig.TLSConfig.TLSKeyData,
 			EnableTLSVerification: gitOpsConfig.EnableTLSVerification,
-		})
+		}
+		if gitOpsConfig.CommitSigningConfig != nil && gitOpsConfig.CommitSigningConfig.Enabled {
+			cfg.CommitSigningFormat = gitOpsConfig.CommitSigningConfig.Format
+			cfg.CommitSigningKey = gitOpsConfig.CommitSigningConfig.SigningKey
+			cfg.CommitSigningPassphrase = gitOpsConfig.CommitSigningConfig.Passphrase
+		}
+		cfgs = append(cfgs, cfg)
 	}
 	return cfgs, nil
 }
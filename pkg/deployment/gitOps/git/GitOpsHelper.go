@@ -18,6 +18,7 @@ package git
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/devtron-labs/devtron/api/bean"
 	apiGitOpsBean "github.com/devtron-labs/devtron/api/bean/gitOps"
@@ -42,6 +43,7 @@ type GitOpsHelper struct {
 	gitCommandManager git.GitCommandManager
 	tlsConfig         *bean.TLSConfig
 	isTlsEnabled      bool
+	signingConfig     *git.CommitSigningConfig
 }
 
 func NewGitOpsHelperImpl(auth *git.BasicAuth, logger *zap.SugaredLogger, tlsConfig *bean.TLSConfig, isTlsEnabled bool) (*GitOpsHelper, error) {
@@ -59,6 +61,12 @@ func (impl *GitOpsHelper) SetAuth(auth *git.BasicAuth) {
 	impl.Auth = auth
 }
 
+// SetCommitSigningConfig sets the key used for signing the commits pushed by CommitAndPushAllChanges,
+// commits are left unsigned for nil config.
+func (impl *GitOpsHelper) SetCommitSigningConfig(signingConfig *git.CommitSigningConfig) {
+	impl.signingConfig = signingConfig
+}
+
 func (impl *GitOpsHelper) GetCloneDirectory(targetDir string) (clonedDir string) {
 	start := time.Now()
 	defer func() {
@@ -147,10 +155,14 @@ func (impl *GitOpsHelper) CommitAndPushAllChanges(ctx context.Context, repoRoot,
 		span.End()
 	}()
 	gitCtx := git.BuildGitContext(newCtx).WithCredentials(impl.Auth).
-		WithTLSData(impl.tlsConfig.CaData, impl.tlsConfig.TLSKeyData, impl.tlsConfig.TLSCertData, impl.isTlsEnabled)
+		WithTLSData(impl.tlsConfig.CaData, impl.tlsConfig.TLSKeyData, impl.tlsConfig.TLSCertData, impl.isTlsEnabled).
+		WithCommitSigningConfig(impl.signingConfig)
 	commitHash, err = impl.gitCommandManager.CommitAndPush(gitCtx, repoRoot, targetRevision, commitMsg, name, emailId)
 	if err != nil && strings.Contains(err.Error(), PushErrorMessage) {
 		return commitHash, fmt.Errorf("%s %v", "push failed due to conflicts", err)
+	} else if errors.Is(err, git.ErrCommitSigning) {
+		impl.logger.Errorw("error in signing gitOps commit", "repoRoot", repoRoot, "err", err)
+		return commitHash, err
 	}
 	return commitHash, nil
 }
@@ -40,5 +40,10 @@ func ConvertGitOpsConfigToGitConfig(dto *bean2.GitOpsConfigDto) *bean.GitConfig
 		config.TLSCert = dto.TLSConfig.TLSCertData
 		config.TLSKey = dto.TLSConfig.TLSKeyData
 	}
+	if dto.CommitSigningConfig != nil && dto.CommitSigningConfig.Enabled {
+		config.CommitSigningFormat = dto.CommitSigningConfig.Format
+		config.CommitSigningKey = dto.CommitSigningConfig.SigningKey
+		config.CommitSigningPassphrase = dto.CommitSigningConfig.Passphrase
+	}
 	return config
 }
@@ -40,6 +40,22 @@ type GitConfig struct {
 	CaCert                string
 	TLSCert               string
 	TLSKey                string
+
+	CommitSigningFormat     string
+	CommitSigningKey        string
+	CommitSigningPassphrase string
+}
+
+// GetCommitSigningConfig returns nil if commit signing is not configured for the gitOps provider
+func (cfg GitConfig) GetCommitSigningConfig() *git.CommitSigningConfig {
+	if len(cfg.CommitSigningKey) == 0 {
+		return nil
+	}
+	return &git.CommitSigningConfig{
+		Format:     git.SigningFormat(cfg.CommitSigningFormat),
+		SigningKey: cfg.CommitSigningKey,
+		Passphrase: cfg.CommitSigningPassphrase,
+	}
 }
 
 type PushChartToGitRequestDTO struct {
@@ -0,0 +1,249 @@
+/*
+ * Copyright (c) 2024. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package commandManager
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/go-git/go-git/v5"
+	"golang.org/x/crypto/ssh"
+	"io"
+	"strings"
+)
+
+type SigningFormat string
+
+const (
+	SigningFormatGPG SigningFormat = "GPG"
+	SigningFormatSSH SigningFormat = "SSH"
+)
+
+const (
+	sshSignatureMagic     = "SSHSIG"
+	sshSignatureVersion   = 1
+	sshSignatureNamespace = "git"
+	sshSignatureHashAlgo  = "sha512"
+	sshSignatureLineWidth = 70
+)
+
+// ErrCommitSigning is returned when the commit could not be signed with the configured key,
+// the commit is not pushed in that case.
+var ErrCommitSigning = errors.New("commit signing failed")
+
+// git cli reports a failed gpg/ssh signature with these messages, the commit object is not written in that case
+var commitSigningErrorMessages = []string{"failed to sign the data", "failed to write commit object"}
+
+// IsCommitSigningErrorMessage checks the stderr of a failed `git commit -S` for a signing failure
+func IsCommitSigningErrorMessage(errMsg string) bool {
+	for _, message := range commitSigningErrorMessages {
+		if strings.Contains(errMsg, message) {
+			return true
+		}
+	}
+	return false
+}
+
+// CommitSigningConfig holds the private key material used to sign the gitOps commits.
+// SigningKey is an armored GPG private key for SigningFormatGPG and an OpenSSH/PEM private key for SigningFormatSSH.
+type CommitSigningConfig struct {
+	Format     SigningFormat
+	SigningKey string
+	Passphrase string
+}
+
+func (cfg *CommitSigningConfig) IsEnabled() bool {
+	return cfg != nil && len(cfg.SigningKey) > 0
+}
+
+// NewCommitSigner returns the signer for the configured signing format,
+// it is used by go-git to sign the encoded commit object.
+func NewCommitSigner(cfg *CommitSigningConfig) (git.Signer, error) {
+	switch cfg.Format {
+	case SigningFormatGPG:
+		entity, err := parseGpgSigningKey(cfg)
+		if err != nil {
+			return nil, err
+		}
+		return &gpgCommitSigner{entity: entity}, nil
+	case SigningFormatSSH:
+		signer, err := parseSshSigningKey(cfg)
+		if err != nil {
+			return nil, err
+		}
+		return &sshCommitSigner{signer: signer}, nil
+	default:
+		return nil, fmt.Errorf("unsupported commit signing format: %q", cfg.Format)
+	}
+}
+
+type gpgCommitSigner struct {
+	entity *openpgp.Entity
+}
+
+func (s *gpgCommitSigner) Sign(message io.Reader) ([]byte, error) {
+	var b bytes.Buffer
+	err := openpgp.ArmoredDetachSign(&b, s.entity, message, nil)
+	if err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+type sshCommitSigner struct {
+	signer ssh.Signer
+}
+
+type sshSignedData struct {
+	Namespace string
+	Reserved  string
+	HashAlgo  string
+	Hash      []byte
+}
+
+type sshSignatureBlob struct {
+	Version   uint32
+	PublicKey []byte
+	Namespace string
+	Reserved  string
+	HashAlgo  string
+	Signature []byte
+}
+
+// Sign creates an armored SSHSIG signature (same as `ssh-keygen -Y sign -n git`)
+func (s *sshCommitSigner) Sign(message io.Reader) ([]byte, error) {
+	h := sha512.New()
+	if _, err := io.Copy(h, message); err != nil {
+		return nil, err
+	}
+	signedData := append([]byte(sshSignatureMagic), ssh.Marshal(sshSignedData{
+		Namespace: sshSignatureNamespace,
+		HashAlgo:  sshSignatureHashAlgo,
+		Hash:      h.Sum(nil),
+	})...)
+	var sig *ssh.Signature
+	var err error
+	if algoSigner, ok := s.signer.(ssh.AlgorithmSigner); ok && s.signer.PublicKey().Type() == ssh.KeyAlgoRSA {
+		// ssh-rsa (sha1) signatures are rejected by git, rsa keys must sign with sha512
+		sig, err = algoSigner.SignWithAlgorithm(rand.Reader, signedData, ssh.KeyAlgoRSASHA512)
+	} else {
+		sig, err = s.signer.Sign(rand.Reader, signedData)
+	}
+	if err != nil {
+		return nil, err
+	}
+	blob := append([]byte(sshSignatureMagic), ssh.Marshal(sshSignatureBlob{
+		Version:   sshSignatureVersion,
+		PublicKey: s.signer.PublicKey().Marshal(),
+		Namespace: sshSignatureNamespace,
+		HashAlgo:  sshSignatureHashAlgo,
+		Signature: ssh.Marshal(sig),
+	})...)
+	encoded := base64.StdEncoding.EncodeToString(blob)
+	var b strings.Builder
+	b.WriteString("-----BEGIN SSH SIGNATURE-----\n")
+	for len(encoded) > sshSignatureLineWidth {
+		b.WriteString(encoded[:sshSignatureLineWidth] + "\n")
+		encoded = encoded[sshSignatureLineWidth:]
+	}
+	b.WriteString(encoded + "\n")
+	b.WriteString("-----END SSH SIGNATURE-----\n")
+	return []byte(b.String()), nil
+}
+
+func parseGpgSigningKey(cfg *CommitSigningConfig) (*openpgp.Entity, error) {
+	entities, err := openpgp.ReadArmoredKeyRing(strings.NewReader(cfg.SigningKey))
+	if err != nil {
+		return nil, fmt.Errorf("invalid gpg signing key: %w", err)
+	}
+	for _, entity := range entities {
+		if entity.PrivateKey == nil {
+			continue
+		}
+		if entity.PrivateKey.Encrypted {
+			if len(cfg.Passphrase) == 0 {
+				return nil, errors.New("gpg signing key is encrypted, passphrase is required")
+			}
+			if err = entity.DecryptPrivateKeys([]byte(cfg.Passphrase)); err != nil {
+				return nil, fmt.Errorf("error in decrypting gpg signing key: %w", err)
+			}
+		}
+		return entity, nil
+	}
+	return nil, errors.New("no private key found in gpg signing key")
+}
+
+func parseSshSigningKey(cfg *CommitSigningConfig) (ssh.Signer, error) {
+	var signer ssh.Signer
+	var err error
+	if len(cfg.Passphrase) > 0 {
+		signer, err = ssh.ParsePrivateKeyWithPassphrase([]byte(cfg.SigningKey), []byte(cfg.Passphrase))
+	} else {
+		signer, err = ssh.ParsePrivateKey([]byte(cfg.SigningKey))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid ssh signing key: %w", err)
+	}
+	return signer, nil
+}
+
+// getDecryptedGpgSigningKey returns the armored gpg private key without passphrase protection,
+// to be imported in a temporary gpg keyring. It also returns the fingerprint of the signing key.
+func getDecryptedGpgSigningKey(cfg *CommitSigningConfig) (armoredKey []byte, fingerprint string, err error) {
+	entity, err := parseGpgSigningKey(cfg)
+	if err != nil {
+		return nil, "", err
+	}
+	var b bytes.Buffer
+	w, err := armor.Encode(&b, openpgp.PrivateKeyType, nil)
+	if err != nil {
+		return nil, "", err
+	}
+	if err = entity.SerializePrivateWithoutSigning(w, nil); err != nil {
+		return nil, "", err
+	}
+	if err = w.Close(); err != nil {
+		return nil, "", err
+	}
+	return b.Bytes(), fmt.Sprintf("%X", entity.PrimaryKey.Fingerprint), nil
+}
+
+// getDecryptedSshSigningKey returns the OpenSSH private key without passphrase protection,
+// to be used as user.signingkey by git cli.
+func getDecryptedSshSigningKey(cfg *CommitSigningConfig) ([]byte, error) {
+	if len(cfg.Passphrase) == 0 {
+		if _, err := parseSshSigningKey(cfg); err != nil {
+			return nil, err
+		}
+		return []byte(cfg.SigningKey), nil
+	}
+	rawKey, err := ssh.ParseRawPrivateKeyWithPassphrase([]byte(cfg.SigningKey), []byte(cfg.Passphrase))
+	if err != nil {
+		return nil, fmt.Errorf("invalid ssh signing key: %w", err)
+	}
+	block, err := ssh.MarshalPrivateKey(rawKey, "")
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(block), nil
+}
@@ -0,0 +1,99 @@
+/*
+ * Copyright (c) 2024. Devtron Inc.
+ */
+
+package commandManager
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/pem"
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/ssh"
+	"strings"
+	"testing"
+)
+
+const testCommitPayload = "tree 4b825dc642cb6eb9a060e54bf8d69288fbee4904\nauthor devtron <devtron@example.com> 1700000000 +0000\n\nfirst commit\n"
+
+func TestSshCommitSigner(t *testing.T) {
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+	block, err := ssh.MarshalPrivateKeyWithPassphrase(privateKey, "", []byte("secret"))
+	assert.NoError(t, err)
+	encryptedKey := string(pem.EncodeToMemory(block))
+
+	t.Run("encrypted key without passphrase", func(t *testing.T) {
+		_, err := NewCommitSigner(&CommitSigningConfig{Format: SigningFormatSSH, SigningKey: encryptedKey})
+		assert.Error(t, err)
+	})
+
+	t.Run("signature is verifiable", func(t *testing.T) {
+		signer, err := NewCommitSigner(&CommitSigningConfig{Format: SigningFormatSSH, SigningKey: encryptedKey, Passphrase: "secret"})
+		assert.NoError(t, err)
+		armored, err := signer.Sign(strings.NewReader(testCommitPayload))
+		assert.NoError(t, err)
+
+		lines := strings.Split(strings.TrimSpace(string(armored)), "\n")
+		assert.Equal(t, "-----BEGIN SSH SIGNATURE-----", lines[0])
+		assert.Equal(t, "-----END SSH SIGNATURE-----", lines[len(lines)-1])
+		blob, err := base64.StdEncoding.DecodeString(strings.Join(lines[1:len(lines)-1], ""))
+		assert.NoError(t, err)
+		assert.True(t, bytes.HasPrefix(blob, []byte(sshSignatureMagic)))
+
+		sigBlob := sshSignatureBlob{}
+		assert.NoError(t, ssh.Unmarshal(blob[len(sshSignatureMagic):], &sigBlob))
+		assert.Equal(t, sshSignatureNamespace, sigBlob.Namespace)
+		publicKey, err := ssh.ParsePublicKey(sigBlob.PublicKey)
+		assert.NoError(t, err)
+		sig := &ssh.Signature{}
+		assert.NoError(t, ssh.Unmarshal(sigBlob.Signature, sig))
+
+		hash := sha512.Sum512([]byte(testCommitPayload))
+		signedData := append([]byte(sshSignatureMagic), ssh.Marshal(sshSignedData{
+			Namespace: sshSignatureNamespace,
+			HashAlgo:  sshSignatureHashAlgo,
+			Hash:      hash[:],
+		})...)
+		assert.NoError(t, publicKey.Verify(signedData, sig))
+	})
+}
+
+func TestGpgCommitSigner(t *testing.T) {
+	entity, err := openpgp.NewEntity("devtron", "", "devtron@example.com", nil)
+	assert.NoError(t, err)
+	var privateKey bytes.Buffer
+	w, err := armor.Encode(&privateKey, openpgp.PrivateKeyType, nil)
+	assert.NoError(t, err)
+	assert.NoError(t, entity.SerializePrivate(w, nil))
+	assert.NoError(t, w.Close())
+
+	signer, err := NewCommitSigner(&CommitSigningConfig{Format: SigningFormatGPG, SigningKey: privateKey.String()})
+	assert.NoError(t, err)
+	signature, err := signer.Sign(strings.NewReader(testCommitPayload))
+	assert.NoError(t, err)
+
+	_, err = openpgp.CheckArmoredDetachedSignature(openpgp.EntityList{entity}, strings.NewReader(testCommitPayload), bytes.NewReader(signature), nil)
+	assert.NoError(t, err)
+
+	_, fingerprint, err := getDecryptedGpgSigningKey(&CommitSigningConfig{Format: SigningFormatGPG, SigningKey: privateKey.String()})
+	assert.NoError(t, err)
+	assert.Len(t, fingerprint, 40)
+}
+
+func TestNewCommitSignerUnsupportedFormat(t *testing.T) {
+	_, err := NewCommitSigner(&CommitSigningConfig{Format: "X509", SigningKey: "key"})
+	assert.Error(t, err)
+}
+
+func TestIsCommitSigningErrorMessage(t *testing.T) {
+	assert.True(t, IsCommitSigningErrorMessage("error: gpg failed to sign the data\nfatal: failed to write commit object"))
+	assert.True(t, IsCommitSigningErrorMessage("error: Load key \"/tmp/signing_key\": invalid format?\n\nfatal: failed to write commit object"))
+	assert.False(t, IsCommitSigningErrorMessage("fatal: unable to auto-detect email address"))
+	assert.False(t, IsCommitSigningErrorMessage(""))
+}
@@ -20,7 +20,9 @@ import (
 	"fmt"
 	git_manager "github.com/devtron-labs/common-lib/git-manager"
 	"github.com/devtron-labs/devtron/util"
+	"os"
 	"os/exec"
+	"path/filepath"
 	"time"
 )
 
@@ -115,8 +117,17 @@ func (impl *GitCliManagerImpl) setConfig(ctx GitContext, rootDir string, email s
 func (impl *GitCliManagerImpl) commit(ctx GitContext, rootDir string, commitMsg string, user string, email string) (response, errMsg string, err error) {
 	impl.logger.Debugw("git commit", "location", rootDir)
 	author := fmt.Sprintf("%s <%s>", user, email)
-	cmd, cancel := impl.createCmdWithContext(ctx, "git", "-C", rootDir, "commit", "--allow-empty", "-m", commitMsg, "--author", author)
+	signingArgs, signingEnv, cleanup, err := impl.prepareCommitSigning(ctx)
+	if err != nil {
+		impl.logger.Errorw("error in preparing commit signing", "location", rootDir, "err", err)
+		return "", "", fmt.Errorf("%w: %v", ErrCommitSigning, err)
+	}
+	defer cleanup()
+	args := append([]string{"-C", rootDir}, signingArgs...)
+	args = append(args, "commit", "--allow-empty", "-m", commitMsg, "--author", author)
+	cmd, cancel := impl.createCmdWithContext(ctx, "git", args...)
 	defer cancel()
+	cmd.Env = signingEnv
 	tlsPathInfo, err := git_manager.CreateFilesForTlsData(git_manager.BuildTlsData(ctx.TLSKey, ctx.TLSCertificate, ctx.CACert, ctx.TLSVerificationEnabled), TLS_FOLDER)
 	if err != nil {
 		//making it non-blocking
@@ -125,6 +136,10 @@ func (impl *GitCliManagerImpl) commit(ctx GitContext, rootDir string, commitMsg
 	defer git_manager.DeleteTlsFiles(tlsPathInfo)
 	output, errMsg, err := impl.runCommandWithCred(cmd, ctx.auth, tlsPathInfo)
 	impl.logger.Debugw("git commit output", "root", rootDir, "opt", output, "errMsg", errMsg, "error", err)
+	if err != nil && ctx.signingConfig.IsEnabled() && IsCommitSigningErrorMessage(errMsg) {
+		impl.logger.Errorw("error in signing commit", "location", rootDir, "errMsg", errMsg, "err", err)
+		return output, errMsg, fmt.Errorf("%w: %s", ErrCommitSigning, errMsg)
+	}
 	return output, errMsg, err
 }
 
@@ -187,3 +202,73 @@ func (impl *GitCliManagerImpl) gitCreateRemote(ctx GitContext, rootDir string, u
 	impl.logger.Debugw("git remote output", "url", url, "opt", output, "errMsg", errMsg, "error", err)
 	return err
 }
+
+// prepareCommitSigning writes the signing key into a temporary directory and returns the git config args
+// and the env required by git cli to sign the commit. cleanup removes the key material once the commit is done.
+func (impl *GitCliManagerImpl) prepareCommitSigning(ctx GitContext) (args []string, env []string, cleanup func(), err error) {
+	cleanup = func() {}
+	if !ctx.signingConfig.IsEnabled() {
+		return nil, nil, cleanup, nil
+	}
+	signingDir, err := os.MkdirTemp("", COMMIT_SIGNING_DIR_PREFIX)
+	if err != nil {
+		return nil, nil, cleanup, err
+	}
+	gnupgHome := filepath.Join(signingDir, "gnupg")
+	cleanup = func() {
+		if ctx.signingConfig.Format == SigningFormatGPG {
+			// stopping the gpg-agent spawned for the temporary keyring
+			killCmd := exec.CommandContext(ctx, "gpgconf", "--homedir", gnupgHome, "--kill", "gpg-agent")
+			impl.runCommand(killCmd)
+		}
+		if rmErr := os.RemoveAll(signingDir); rmErr != nil {
+			impl.logger.Errorw("error in removing commit signing dir", "dir", signingDir, "err", rmErr)
+		}
+	}
+	defer func() {
+		if err != nil {
+			cleanup()
+			cleanup = func() {}
+		}
+	}()
+	switch ctx.signingConfig.Format {
+	case SigningFormatSSH:
+		var signingKey []byte
+		signingKey, err = getDecryptedSshSigningKey(ctx.signingConfig)
+		if err != nil {
+			return nil, nil, cleanup, err
+		}
+		keyPath := filepath.Join(signingDir, "signing_key")
+		if err = os.WriteFile(keyPath, signingKey, 0600); err != nil {
+			return nil, nil, cleanup, err
+		}
+		args = []string{"-c", "gpg.format=ssh", "-c", fmt.Sprintf("user.signingkey=%s", keyPath), "-c", "commit.gpgsign=true"}
+	case SigningFormatGPG:
+		var signingKey []byte
+		var fingerprint string
+		signingKey, fingerprint, err = getDecryptedGpgSigningKey(ctx.signingConfig)
+		if err != nil {
+			return nil, nil, cleanup, err
+		}
+		keyPath := filepath.Join(signingDir, "signing_key.asc")
+		if err = os.WriteFile(keyPath, signingKey, 0600); err != nil {
+			return nil, nil, cleanup, err
+		}
+		if err = os.Mkdir(gnupgHome, 0700); err != nil {
+			return nil, nil, cleanup, err
+		}
+		importCmd := exec.CommandContext(ctx, "gpg", "--homedir", gnupgHome, "--batch", "--import", keyPath)
+		output, errMsg, importErr := impl.runCommand(importCmd)
+		impl.logger.Debugw("gpg import output", "opt", output, "errMsg", errMsg, "error", importErr)
+		if importErr != nil {
+			err = fmt.Errorf("error in importing gpg signing key: %s %v", errMsg, importErr)
+			return nil, nil, cleanup, err
+		}
+		args = []string{"-c", "gpg.format=openpgp", "-c", fmt.Sprintf("user.signingkey=%s", fingerprint), "-c", "commit.gpgsign=true"}
+		env = []string{fmt.Sprintf("GNUPGHOME=%s", gnupgHome)}
+	default:
+		err = fmt.Errorf("unsupported commit signing format: %q", ctx.signingConfig.Format)
+		return nil, nil, cleanup, err
+	}
+	return args, env, cleanup, nil
+}
@@ -124,7 +124,8 @@ func (impl *GitManagerBaseImpl) PullCli(ctx GitContext, rootDir string, branch s
 }
 
 func (impl *GitManagerBaseImpl) runCommandWithCred(cmd *exec.Cmd, auth *BasicAuth, tlsPathInfo *git_manager.TlsPathInfo) (response, errMsg string, err error) {
-	cmd.Env = append(os.Environ(),
+	// env already set on the command (e.g. GNUPGHOME for commit signing) is retained
+	cmd.Env = append(append(os.Environ(), cmd.Env...),
 		fmt.Sprintf("GIT_ASKPASS=%s", GIT_ASK_PASS),
 		fmt.Sprintf("GIT_USERNAME=%s", auth.Username),
 		fmt.Sprintf("GIT_PASSWORD=%s", auth.Password),
@@ -151,6 +152,10 @@ func (impl *GitManagerBaseImpl) runCommand(cmd *exec.Cmd) (response, errMsg stri
 			return "", "", fmt.Errorf("%s %v", outBytes, err)
 		}
 		errOutput := string(exErr.Stderr)
+		if len(errOutput) == 0 {
+			// stderr is only captured in the ExitError by cmd.Output, CombinedOutput holds it along with stdout
+			errOutput = string(outBytes)
+		}
 		return "", errOutput, err
 	}
 	output := string(outBytes)
@@ -56,3 +56,5 @@ func ParseConfiguration() (*configuration, error) {
 }
 
 const GIT_ASK_PASS = "/git-ask-pass.sh"
+
+const COMMIT_SIGNING_DIR_PREFIX = "gitops-commit-signing-"
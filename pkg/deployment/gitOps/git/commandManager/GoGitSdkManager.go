@@ -17,6 +17,7 @@
 package commandManager
 
 import (
+	"fmt"
 	"github.com/devtron-labs/devtron/util"
 	"github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/config"
@@ -90,7 +91,7 @@ func (impl *GoGitSDKManagerImpl) CommitAndPush(ctx GitContext, repoRoot, targetR
 		return "", err
 	}
 	//--  commit
-	commit, err := workTree.Commit(commitMsg, &git.CommitOptions{
+	commitOptions := &git.CommitOptions{
 		Author: &object.Signature{
 			Name:  name,
 			Email: emailId,
@@ -101,7 +102,15 @@ func (impl *GoGitSDKManagerImpl) CommitAndPush(ctx GitContext, repoRoot, targetR
 			Email: emailId,
 			When:  time.Now(),
 		},
-	})
+	}
+	if ctx.signingConfig.IsEnabled() {
+		commitOptions.Signer, err = NewCommitSigner(ctx.signingConfig)
+		if err != nil {
+			impl.logger.Errorw("error in creating commit signer", "repo", repoRoot, "format", ctx.signingConfig.Format, "err", err)
+			return "", fmt.Errorf("%w: %v", ErrCommitSigning, err)
+		}
+	}
+	commit, err := workTree.Commit(commitMsg, commitOptions)
 	if err != nil {
 		return "", err
 	}
@@ -30,6 +30,7 @@ type GitContext struct {
 	TLSKey                 string
 	TLSCertificate         string
 	TLSVerificationEnabled bool
+	signingConfig          *CommitSigningConfig
 }
 
 func (gitCtx GitContext) WithCredentials(auth *BasicAuth) GitContext {
@@ -45,6 +46,11 @@ func (gitCtx GitContext) WithTLSData(caData string, tlsKey string, tlsCertificat
 	return gitCtx
 }
 
+func (gitCtx GitContext) WithCommitSigningConfig(signingConfig *CommitSigningConfig) GitContext {
+	gitCtx.signingConfig = signingConfig
+	return gitCtx
+}
+
 func BuildGitContext(ctx context.Context) GitContext {
 	return GitContext{
 		Context: ctx,
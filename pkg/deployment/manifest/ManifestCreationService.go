@@ -49,6 +49,8 @@ import (
 	"github.com/devtron-labs/devtron/pkg/k8s"
 	bean4 "github.com/devtron-labs/devtron/pkg/k8s/bean"
 	repository3 "github.com/devtron-labs/devtron/pkg/pipeline/history/repository"
+	"github.com/devtron-labs/devtron/pkg/policyGovernance/security/imageSigning"
+	imageSigningBean "github.com/devtron-labs/devtron/pkg/policyGovernance/security/imageSigning/bean"
 	"github.com/devtron-labs/devtron/pkg/sql"
 	"github.com/devtron-labs/devtron/pkg/variables"
 	"github.com/devtron-labs/devtron/pkg/variables/parsers"
@@ -81,6 +83,7 @@ type ManifestCreationServiceImpl struct {
 	k8sCommonService               k8s.K8sCommonService
 	deployedAppMetricsService      deployedAppMetrics.DeployedAppMetricsService
 	imageDigestPolicyService       imageDigestPolicy.ImageDigestPolicyService
+	imageSigningService            imageSigning.ImageSigningService
 	mergeUtil                      *util.MergeUtil
 	appCrudOperationService        app.AppCrudOperationService
 	deploymentTemplateService      deploymentTemplate.DeploymentTemplateService
@@ -109,6 +112,7 @@ func NewManifestCreationServiceImpl(logger *zap.SugaredLogger,
 	k8sCommonService k8s.K8sCommonService,
 	deployedAppMetricsService deployedAppMetrics.DeployedAppMetricsService,
 	imageDigestPolicyService imageDigestPolicy.ImageDigestPolicyService,
+	imageSigningService imageSigning.ImageSigningService,
 	mergeUtil *util.MergeUtil,
 	appCrudOperationService app.AppCrudOperationService,
 	deploymentTemplateService deploymentTemplate.DeploymentTemplateService,
@@ -134,6 +138,7 @@ func NewManifestCreationServiceImpl(logger *zap.SugaredLogger,
 		k8sCommonService:                    k8sCommonService,
 		deployedAppMetricsService:           deployedAppMetricsService,
 		imageDigestPolicyService:            imageDigestPolicyService,
+		imageSigningService:                 imageSigningService,
 		mergeUtil:                           mergeUtil,
 		appCrudOperationService:             appCrudOperationService,
 		deploymentTemplateService:           deploymentTemplateService,
@@ -233,7 +238,11 @@ func (impl *ManifestCreationServiceImpl) GetValuesOverrideForTrigger(ctx context
 
 	// Conditional Block based on PipelineOverrideCreated --> start
 	if !isPipelineOverrideCreated {
-		pipelineOverride, err = impl.savePipelineOverride(newCtx, overrideRequest, envOverride.Id, triggeredAt)
+		if overrideRequest.IsDryRun {
+			pipelineOverride, err = impl.getDryRunPipelineOverride(overrideRequest, envOverride.Id, triggeredAt)
+		} else {
+			pipelineOverride, err = impl.savePipelineOverride(newCtx, overrideRequest, envOverride.Id, triggeredAt)
+		}
 		if err != nil {
 			return valuesOverrideResponse, err
 		}
@@ -290,16 +299,22 @@ func (impl *ManifestCreationServiceImpl) GetValuesOverrideForTrigger(ctx context
 				return valuesOverrideResponse, err
 			}
 		}
-		// handle image pull secret if access given
-		mergedValues, err = impl.dockerRegistryIpsConfigService.HandleImagePullSecretOnApplicationDeployment(newCtx, envOverride.Environment, artifact, pipeline.CiPipelineId, mergedValues)
+		// handle image pull secret if access given, a dry run only renders its name as nothing is applied to the cluster
+		if overrideRequest.IsDryRun {
+			mergedValues, err = impl.dockerRegistryIpsConfigService.SetImagePullSecretNameInValues(newCtx, envOverride.Environment, artifact, pipeline.CiPipelineId, mergedValues)
+		} else {
+			mergedValues, err = impl.dockerRegistryIpsConfigService.HandleImagePullSecretOnApplicationDeployment(newCtx, envOverride.Environment, artifact, pipeline.CiPipelineId, mergedValues)
+		}
 		if err != nil {
 			return valuesOverrideResponse, err
 		}
 
 		valuesOverrideResponse.MergedValues = string(mergedValues)
-		err = impl.pipelineOverrideRepository.UpdatePipelineMergedValues(newCtx, nil, pipelineOverride.Id, string(mergedValues), overrideRequest.UserId)
-		if err != nil {
-			return valuesOverrideResponse, err
+		if !overrideRequest.IsDryRun {
+			err = impl.pipelineOverrideRepository.UpdatePipelineMergedValues(newCtx, nil, pipelineOverride.Id, string(mergedValues), overrideRequest.UserId)
+			if err != nil {
+				return valuesOverrideResponse, err
+			}
 		}
 		pipelineOverride.PipelineMergedValues = string(mergedValues)
 		valuesOverrideResponse.PipelineOverride = pipelineOverride
@@ -408,7 +423,7 @@ func (impl *ManifestCreationServiceImpl) GetEnvOverrideForSpecificConfigTrigger(
 		HistoryReferenceId:   deploymentTemplateHistory.Id,
 		HistoryReferenceType: repository5.HistoryReferenceTypeDeploymentTemplate,
 	}
-	variableMap, resolvedTemplate, err := impl.scopedVariableManager.GetVariableSnapshotAndResolveTemplate(envOverride.EnvOverrideValues, parsers.JsonVariableTemplate, reference, true, false)
+	variableMap, resolvedTemplate, err := impl.scopedVariableManager.GetVariableSnapshotAndResolveTemplate(envOverride.EnvOverrideValues, parsers.JsonVariableTemplate, reference, true, false, false)
 	envOverride.ResolvedEnvOverrideValues = resolvedTemplate
 	envOverride.VariableSnapshot = variableMap
 	if err != nil {
@@ -468,12 +483,15 @@ func (impl *ManifestCreationServiceImpl) getEnvOverrideForLastSavedConfigTrigger
 				IsBasicViewLocked: chart.IsBasicViewLocked,
 				CurrentViewEditor: chart.CurrentViewEditor,
 			}
-			_, span = otel.Tracer("orchestrator").Start(ctx, "environmentConfigRepository.Save")
-			err = impl.environmentConfigRepository.Save(envOverrideDBObj)
-			span.End()
-			if err != nil {
-				impl.logger.Errorw("error in creating envConfig", "data", envOverride, "error", err)
-				return nil, err
+			// dry run renders with an unsaved env override, it gets persisted on the actual trigger
+			if !overrideRequest.IsDryRun {
+				_, span = otel.Tracer("orchestrator").Start(ctx, "environmentConfigRepository.Save")
+				err = impl.environmentConfigRepository.Save(envOverrideDBObj)
+				span.End()
+				if err != nil {
+					impl.logger.Errorw("error in creating envConfig", "data", envOverride, "error", err)
+					return nil, err
+				}
 			}
 			envOverride = adapter.EnvOverrideDBToDTO(envOverrideDBObj)
 		}
@@ -735,7 +753,19 @@ func (impl *ManifestCreationServiceImpl) getReleaseOverride(envOverride *bean2.E
 			return "", err
 		}
 
-		if digestPolicyConfigurations.UseDigestForTrigger() {
+		// the image verified against the signature policy of the environment is deployed by its digest,
+		// the tag could since have been moved to another image
+		isSignatureRequired, err := impl.imageSigningService.IsSignatureRequired(overrideRequest.EnvId)
+		if err != nil {
+			impl.logger.Errorw("error in checking if image signature is required", "envId", overrideRequest.EnvId, "err", err)
+			return "", err
+		}
+		if isSignatureRequired && len(artifact.ImageDigest) == 0 {
+			errMsg := fmt.Sprintf("%s for image %s, %s", imageSigningBean.SignatureVerificationFailedMessage, artifact.Image, imageSigningBean.ImageDigestNotFoundMessage)
+			return "", util.NewApiError(http.StatusPreconditionFailed, errMsg, errMsg)
+		}
+
+		if digestPolicyConfigurations.UseDigestForTrigger() || isSignatureRequired {
 			imageTag[imageTagLen-1] = fmt.Sprintf("%s@%s", imageTag[imageTagLen-1], artifact.ImageDigest)
 		}
 
@@ -792,6 +822,24 @@ func (impl *ManifestCreationServiceImpl) savePipelineOverride(ctx context.Contex
 	return po, nil
 }
 
+// getDryRunPipelineOverride builds the pipeline override that savePipelineOverride would persist, without saving it
+func (impl *ManifestCreationServiceImpl) getDryRunPipelineOverride(overrideRequest *bean.ValuesOverrideRequest, envOverrideId int, triggeredAt time.Time) (*chartConfig.PipelineOverride, error) {
+	currentReleaseNo, err := impl.pipelineOverrideRepository.GetCurrentPipelineReleaseCounter(overrideRequest.PipelineId)
+	if err != nil {
+		impl.logger.Errorw("error in getting current pipeline release counter", "pipelineId", overrideRequest.PipelineId, "err", err)
+		return nil, err
+	}
+	return &chartConfig.PipelineOverride{
+		EnvConfigOverrideId:    envOverrideId,
+		Status:                 models.CHARTSTATUS_NEW,
+		PipelineId:             overrideRequest.PipelineId,
+		CiArtifactId:           overrideRequest.CiArtifactId,
+		PipelineReleaseCounter: currentReleaseNo + 1,
+		AuditLog:               sql.AuditLog{CreatedBy: overrideRequest.UserId, CreatedOn: triggeredAt, UpdatedOn: triggeredAt, UpdatedBy: overrideRequest.UserId},
+		DeploymentType:         overrideRequest.DeploymentType,
+	}, nil
+}
+
 func (impl *ManifestCreationServiceImpl) checkAndFixDuplicateReleaseNo(override *chartConfig.PipelineOverride) error {
 
 	uniqueVerified := false
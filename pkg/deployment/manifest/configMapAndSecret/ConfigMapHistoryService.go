@@ -36,6 +36,8 @@ type ConfigMapHistoryService interface {
 	CreateHistoryFromEnvLevelConfig(envLevelConfig *chartConfig.ConfigMapEnvModel, configType repository.ConfigType) error
 	CreateCMCSHistoryForDeploymentTrigger(pipeline *pipelineConfig.Pipeline, deployedOn time.Time, deployedBy int32) (int, int, error)
 	MergeAppLevelAndEnvLevelConfigs(appLevelConfig *chartConfig.ConfigMapAppModel, envLevelConfig *chartConfig.ConfigMapEnvModel, configType repository.ConfigType, configMapSecretNames []string) (string, error)
+	// CreateHistoryForRotation records the rotation of configmap/secret name for the pipeline, entry is never marked deployed
+	CreateHistoryForRotation(pipeline *pipelineConfig.Pipeline, configType repository.ConfigType, name, rotationTrigger, restartedWorkloads string, userId int32) (int, error)
 }
 
 type ConfigMapHistoryServiceImpl struct {
@@ -232,6 +234,41 @@ func (impl ConfigMapHistoryServiceImpl) CreateCMCSHistoryForDeploymentTrigger(pi
 	return cmHistory.Id, csHistory.Id, nil
 }
 
+func (impl ConfigMapHistoryServiceImpl) CreateHistoryForRotation(pipeline *pipelineConfig.Pipeline, configType repository.ConfigType, name, rotationTrigger, restartedWorkloads string, userId int32) (int, error) {
+	appLevelConfig, err := impl.configMapRepository.GetByAppIdAppLevel(pipeline.AppId)
+	if err != nil && err != pg.ErrNoRows {
+		impl.logger.Errorw("err in getting app level config", "err", err, "appId", pipeline.AppId)
+		return 0, err
+	}
+	envLevelConfig, err := impl.configMapRepository.GetByAppIdAndEnvIdEnvLevel(pipeline.AppId, pipeline.EnvironmentId)
+	if err != nil && err != pg.ErrNoRows {
+		impl.logger.Errorw("err in getting env level config", "err", err, "appId", pipeline.AppId)
+		return 0, err
+	}
+	configData, err := impl.MergeAppLevelAndEnvLevelConfigs(appLevelConfig, envLevelConfig, configType, []string{name})
+	if err != nil {
+		impl.logger.Errorw("err in merging app and env level configs", "err", err)
+		return 0, err
+	}
+	historyModel := &repository.ConfigmapAndSecretHistory{
+		AppId:               pipeline.AppId,
+		PipelineId:          pipeline.Id,
+		DataType:            configType,
+		Deployed:            false,
+		Data:                configData,
+		RotationTrigger:     rotationTrigger,
+		RotatedResourceName: name,
+		RestartedWorkloads:  restartedWorkloads,
+		AuditLog:            sql.NewDefaultAuditLog(userId),
+	}
+	_, err = impl.configMapHistoryRepository.CreateHistory(nil, historyModel)
+	if err != nil {
+		impl.logger.Errorw("error in creating new entry for CM/CS rotation history", "historyModel", historyModel)
+		return 0, err
+	}
+	return historyModel.Id, nil
+}
+
 func (impl ConfigMapHistoryServiceImpl) MergeAppLevelAndEnvLevelConfigs(appLevelConfig *chartConfig.ConfigMapAppModel, envLevelConfig *chartConfig.ConfigMapEnvModel, configType repository.ConfigType, configMapSecretNames []string) (string, error) {
 	var err error
 	var appLevelConfigData []*bean.ConfigData
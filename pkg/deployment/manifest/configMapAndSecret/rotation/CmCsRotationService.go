@@ -0,0 +1,662 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rotation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/caarlos0/env"
+	"github.com/devtron-labs/common-lib/async"
+	k8sUtil "github.com/devtron-labs/common-lib/utils/k8s"
+	k8sCommonBean "github.com/devtron-labs/common-lib/utils/k8s/commonBean"
+	"github.com/devtron-labs/devtron/internal/sql/repository/chartConfig"
+	"github.com/devtron-labs/devtron/internal/sql/repository/pipelineConfig"
+	"github.com/devtron-labs/devtron/internal/util"
+	"github.com/devtron-labs/devtron/pkg/auth/user"
+	userBean "github.com/devtron-labs/devtron/pkg/auth/user/bean"
+	"github.com/devtron-labs/devtron/pkg/deployment/manifest/configMapAndSecret"
+	"github.com/devtron-labs/devtron/pkg/deployment/manifest/configMapAndSecret/rotation/bean"
+	"github.com/devtron-labs/devtron/pkg/deployment/manifest/configMapAndSecret/rotation/helper"
+	"github.com/devtron-labs/devtron/pkg/deployment/manifest/configMapAndSecret/rotation/repository"
+	"github.com/devtron-labs/devtron/pkg/k8s"
+	pipelineBean "github.com/devtron-labs/devtron/pkg/pipeline/bean"
+	historyRepository "github.com/devtron-labs/devtron/pkg/pipeline/history/repository"
+	"github.com/devtron-labs/devtron/pkg/sql"
+	cron2 "github.com/devtron-labs/devtron/util/cron"
+	"github.com/go-pg/pg"
+	"github.com/robfig/cron/v3"
+	"go.uber.org/zap"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+)
+
+type CmCsRotationService interface {
+	// Rotate restarts the workloads consuming a configmap/secret, ESO secrets are refreshed from the provider first
+	// and rotated in background once synced. Workloads are patched in the cluster directly, so argocd reports
+	// the apps as OutOfSync till the next deployment and reverts the restart annotations if self heal is enabled
+	Rotate(ctx context.Context, request *bean.RotateRequest) (*bean.RotationResponse, error)
+	GetRotationHistory(appId, envId int, resourceType pipelineBean.ResourceType, name string) ([]*bean.RotationHistoryDto, error)
+	// ProcessRotationWatch checks configmaps/secrets having an enabled rotation policy for in-cluster value changes
+	ProcessRotationWatch()
+}
+
+type CmCsRotationServiceImpl struct {
+	logger                      *zap.SugaredLogger
+	cmCsRotationStateRepository repository.CmCsRotationStateRepository
+	configMapRepository         chartConfig.ConfigMapRepository
+	pipelineRepository          pipelineConfig.PipelineRepository
+	configMapHistoryRepository  historyRepository.ConfigMapHistoryRepository
+	configMapHistoryService     configMapAndSecret.ConfigMapHistoryService
+	k8sCommonService            k8s.K8sCommonService
+	K8sUtil                     *k8sUtil.K8sServiceImpl
+	userService                 user.UserService
+	cronLease                   sql.CronLease
+	asyncRunnable               *async.Runnable
+	config                      *bean.CmCsRotationConfig
+	cron                        *cron.Cron
+}
+
+func NewCmCsRotationServiceImpl(logger *zap.SugaredLogger,
+	cmCsRotationStateRepository repository.CmCsRotationStateRepository,
+	configMapRepository chartConfig.ConfigMapRepository,
+	pipelineRepository pipelineConfig.PipelineRepository,
+	configMapHistoryRepository historyRepository.ConfigMapHistoryRepository,
+	configMapHistoryService configMapAndSecret.ConfigMapHistoryService,
+	k8sCommonService k8s.K8sCommonService,
+	K8sUtil *k8sUtil.K8sServiceImpl,
+	userService user.UserService,
+	cronLease sql.CronLease,
+	asyncRunnable *async.Runnable,
+	cronLogger *cron2.CronLoggerImpl) (*CmCsRotationServiceImpl, error) {
+	config := &bean.CmCsRotationConfig{}
+	err := env.Parse(config)
+	if err != nil {
+		logger.Errorw("error in parsing cm cs rotation config", "err", err)
+		return nil, err
+	}
+	impl := &CmCsRotationServiceImpl{
+		logger:                      logger,
+		cmCsRotationStateRepository: cmCsRotationStateRepository,
+		configMapRepository:         configMapRepository,
+		pipelineRepository:          pipelineRepository,
+		configMapHistoryRepository:  configMapHistoryRepository,
+		configMapHistoryService:     configMapHistoryService,
+		k8sCommonService:            k8sCommonService,
+		K8sUtil:                     K8sUtil,
+		userService:                 userService,
+		cronLease:                   cronLease,
+		asyncRunnable:               asyncRunnable,
+		config:                      config,
+	}
+	if len(config.WatchCron) > 0 {
+		impl.cron = cron.New(cron.WithChain(cron.SkipIfStillRunning(cronLogger), cron.Recover(cronLogger)))
+		_, err = impl.cron.AddFunc(config.WatchCron, impl.ProcessRotationWatch)
+		if err != nil {
+			logger.Errorw("error in adding cm cs rotation watch cron", "cronExpression", config.WatchCron, "err", err)
+			return nil, err
+		}
+		impl.cron.Start()
+	}
+	return impl, nil
+}
+
+// rotationWorkloadGvks are the kinds restarted on rotation, same as the ones supported for pod rotation
+var rotationWorkloadGvks = []schema.GroupVersionKind{
+	{Group: k8sCommonBean.AppsGroup, Version: k8sCommonBean.V1VERSION, Kind: k8sCommonBean.DeploymentKind},
+	{Group: k8sCommonBean.AppsGroup, Version: k8sCommonBean.V1VERSION, Kind: k8sCommonBean.StatefulSetKind},
+	{Group: k8sCommonBean.AppsGroup, Version: k8sCommonBean.V1VERSION, Kind: k8sCommonBean.DaemonSetKind},
+	{Group: k8sCommonBean.K8sClusterResourceRolloutGroup, Version: "v1alpha1", Kind: k8sCommonBean.K8sClusterResourceRolloutKind},
+}
+
+func (impl *CmCsRotationServiceImpl) Rotate(ctx context.Context, request *bean.RotateRequest) (*bean.RotationResponse, error) {
+	pipeline, err := impl.getPipeline(request.AppId, request.EnvironmentId)
+	if err != nil {
+		return nil, err
+	}
+	if pipeline.Environment.IsVirtualEnvironment {
+		return nil, util.NewApiError(http.StatusBadRequest, "rotation is not supported for virtual environments", "rotation requested for virtual environment")
+	}
+	configData, err := impl.getEffectiveConfigData(pipeline.AppId, pipeline.EnvironmentId, request.ResourceType, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	if configData == nil {
+		return nil, util.NewApiError(http.StatusNotFound, fmt.Sprintf("%s '%s' not found", request.ResourceType, request.Name), "configmap/secret not found")
+	}
+	target := newRotationTarget(pipeline, request.ResourceType, configData)
+	dataHash, err := impl.getInClusterDataHash(ctx, target)
+	if err != nil {
+		return nil, err
+	}
+	if request.ResourceType.IsCS() && configData.External && configData.IsESOExternalSecretType() {
+		err = impl.requestExternalSecretSync(ctx, target)
+		if err != nil {
+			return nil, err
+		}
+		impl.asyncRunnable.Execute(func() { impl.rotateAfterExternalSecretSync(target, dataHash, request.UserId) })
+		return &bean.RotationResponse{
+			Name:                 configData.Name,
+			ResourceType:         request.ResourceType,
+			DataHash:             dataHash,
+			ExternalSecretSynced: true,
+			RotationInProgress:   true,
+			RestartedWorkloads:   make([]*bean.RestartedWorkload, 0),
+		}, nil
+	}
+	state, err := impl.getRotationState(target)
+	if err != nil {
+		return nil, err
+	}
+	return impl.rotate(ctx, target, state, dataHash, bean.RotationTriggerManual, request.UserId)
+}
+
+// rotateAfterExternalSecretSync waits for external secrets operator to refresh the secret and rotates it,
+// it runs in background as the sync can take up to the configured wait
+func (impl *CmCsRotationServiceImpl) rotateAfterExternalSecretSync(target *bean.RotationTarget, previousHash string, userId int32) {
+	ctx := context.Background()
+	dataHash, err := impl.waitForExternalSecretSync(ctx, target, previousHash)
+	if err != nil {
+		impl.logger.Errorw("error in waiting for external secret sync", "pipelineId", target.PipelineId, "name", target.ConfigData.Name, "err", err)
+		return
+	}
+	state, err := impl.getRotationState(target)
+	if err != nil {
+		return
+	}
+	_, err = impl.rotate(ctx, target, state, dataHash, bean.RotationTriggerManual, userId)
+	if err != nil {
+		impl.logger.Errorw("error in rotating external secret after sync", "pipelineId", target.PipelineId, "name", target.ConfigData.Name, "err", err)
+	}
+}
+
+func (impl *CmCsRotationServiceImpl) GetRotationHistory(appId, envId int, resourceType pipelineBean.ResourceType, name string) ([]*bean.RotationHistoryDto, error) {
+	pipeline, err := impl.getPipeline(appId, envId)
+	if err != nil {
+		return nil, err
+	}
+	histories, err := impl.configMapHistoryRepository.GetRotationHistoryList(pipeline.Id, getHistoryConfigType(resourceType), name)
+	if err != nil && err != pg.ErrNoRows {
+		return nil, err
+	}
+	emailById := make(map[int32]string)
+	result := make([]*bean.RotationHistoryDto, 0, len(histories))
+	for _, history := range histories {
+		dto := &bean.RotationHistoryDto{
+			Id:                 history.Id,
+			Trigger:            bean.RotationTrigger(history.RotationTrigger),
+			RotatedOn:          history.CreatedOn,
+			RestartedWorkloads: make([]*bean.RestartedWorkload, 0),
+		}
+		if len(history.RestartedWorkloads) > 0 {
+			err = json.Unmarshal([]byte(history.RestartedWorkloads), &dto.RestartedWorkloads)
+			if err != nil {
+				impl.logger.Errorw("error in unmarshalling restarted workloads of rotation history", "historyId", history.Id, "err", err)
+			}
+		}
+		if _, ok := emailById[history.CreatedBy]; !ok {
+			emailById[history.CreatedBy], err = impl.userService.GetEmailById(history.CreatedBy)
+			if err != nil {
+				impl.logger.Errorw("error in getting email of user", "userId", history.CreatedBy, "err", err)
+			}
+		}
+		dto.RotatedBy = emailById[history.CreatedBy]
+		result = append(result, dto)
+	}
+	return result, nil
+}
+
+func (impl *CmCsRotationServiceImpl) ProcessRotationWatch() {
+	acquired, err := impl.cronLease.TryAcquire(bean.RotationWatchLeaseKey, bean.RotationWatchLeaseTtl)
+	if err != nil {
+		impl.logger.Errorw("error in taking lease for cm cs rotation watch", "err", err)
+		return
+	}
+	if !acquired {
+		impl.logger.Debugw("cm cs rotation watch is running on another replica, skipping")
+		return
+	}
+	defer func() {
+		err := impl.cronLease.Release(bean.RotationWatchLeaseKey)
+		if err != nil {
+			impl.logger.Errorw("error in releasing lease of cm cs rotation watch", "err", err)
+		}
+	}()
+	targets, err := impl.getRotationTargets()
+	if err != nil {
+		impl.logger.Errorw("error in getting cm cs rotation targets", "err", err)
+		return
+	}
+	ctx := context.Background()
+	for _, target := range targets {
+		impl.processRotationTarget(ctx, target)
+	}
+}
+
+func (impl *CmCsRotationServiceImpl) processRotationTarget(ctx context.Context, target *bean.RotationTarget) {
+	dataHash, err := impl.getInClusterDataHash(ctx, target)
+	if err != nil {
+		// config may not be created in the cluster yet, checked again in next run
+		impl.logger.Warnw("error in getting in cluster data hash for rotation", "pipelineId", target.PipelineId, "name", target.ConfigData.Name, "err", err)
+		return
+	}
+	state, err := impl.getRotationState(target)
+	if err != nil {
+		return
+	}
+	if state.Id == 0 {
+		// first observation is only the baseline, there is no change to roll yet
+		state.DataHash = dataHash
+		err = impl.cmCsRotationStateRepository.Save(state)
+		if err != nil {
+			impl.logger.Errorw("error in saving cm cs rotation state", "pipelineId", target.PipelineId, "name", target.ConfigData.Name, "err", err)
+		}
+		return
+	}
+	if state.DataHash == dataHash {
+		return
+	}
+	impl.logger.Infow("in cluster value changed for configmap/secret with rotation policy", "pipelineId", target.PipelineId, "resourceType", target.ResourceType, "name", target.ConfigData.Name)
+	_, err = impl.rotate(ctx, target, state, dataHash, bean.RotationTriggerExternalChange, userBean.SystemUserId)
+	if err != nil {
+		impl.logger.Errorw("error in rotating configmap/secret", "pipelineId", target.PipelineId, "name", target.ConfigData.Name, "err", err)
+	}
+}
+
+// rotate restarts the dependent workloads if required, records the rotation in configmap/secret history and saves the observed hash
+func (impl *CmCsRotationServiceImpl) rotate(ctx context.Context, target *bean.RotationTarget, state *repository.CmCsRotationState,
+	dataHash string, trigger bean.RotationTrigger, userId int32) (*bean.RotationResponse, error) {
+	name := target.ConfigData.Name
+	response := &bean.RotationResponse{
+		Name:               name,
+		ResourceType:       target.ResourceType,
+		DataHash:           dataHash,
+		DataChanged:        state.Id == 0 || state.DataHash != dataHash,
+		RestartedWorkloads: make([]*bean.RestartedWorkload, 0),
+	}
+	autoRestart := target.ConfigData.RotationPolicy != nil && target.ConfigData.RotationPolicy.AutoRestart
+	if trigger == bean.RotationTriggerManual || autoRestart {
+		restartedWorkloads, err := impl.restartDependentWorkloads(ctx, target, dataHash)
+		if err != nil {
+			return nil, err
+		}
+		response.RestartedWorkloads = restartedWorkloads
+	}
+	restartedWorkloadsJson, err := json.Marshal(response.RestartedWorkloads)
+	if err != nil {
+		impl.logger.Errorw("error in marshalling restarted workloads", "err", err)
+		return nil, err
+	}
+	pipeline := &pipelineConfig.Pipeline{Id: target.PipelineId, AppId: target.AppId, EnvironmentId: target.EnvId}
+	response.HistoryId, err = impl.configMapHistoryService.CreateHistoryForRotation(pipeline, getHistoryConfigType(target.ResourceType), name, trigger.String(), string(restartedWorkloadsJson), userId)
+	if err != nil {
+		impl.logger.Errorw("error in creating rotation history", "pipelineId", target.PipelineId, "name", name, "err", err)
+		return nil, err
+	}
+	now := time.Now()
+	state.DataHash = dataHash
+	state.LastRotatedOn = now
+	state.UpdatedOn = now
+	state.UpdatedBy = userId
+	if state.Id == 0 {
+		err = impl.cmCsRotationStateRepository.Save(state)
+	} else {
+		err = impl.cmCsRotationStateRepository.Update(state)
+	}
+	if err != nil {
+		impl.logger.Errorw("error in saving cm cs rotation state", "pipelineId", target.PipelineId, "name", name, "err", err)
+		return nil, err
+	}
+	return response, nil
+}
+
+// restartDependentWorkloads bumps the checksum annotation in pod template of the workloads of the release consuming the configmap/secret.
+// The patch is applied on the cluster and not through the deployment, for gitops apps argocd shows the workloads as
+// OutOfSync till the next deployment, with self heal enabled it reverts the annotations without restarting the pods again.
+func (impl *CmCsRotationServiceImpl) restartDependentWorkloads(ctx context.Context, target *bean.RotationTarget, dataHash string) ([]*bean.RestartedWorkload, error) {
+	restConfig, err, _ := impl.k8sCommonService.GetRestConfigByClusterId(ctx, target.ClusterId)
+	if err != nil {
+		impl.logger.Errorw("error in getting rest config by cluster", "clusterId", target.ClusterId, "err", err)
+		return nil, err
+	}
+	patch, err := json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{
+			"template": map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"annotations": map[string]string{
+						helper.GetChecksumAnnotationKey(target.ResourceType, target.InClusterName): dataHash,
+						bean.RotatedAtAnnotation: time.Now().Format(time.RFC3339),
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	restartedWorkloads := make([]*bean.RestartedWorkload, 0)
+	for _, gvk := range rotationWorkloadGvks {
+		workloads, err := impl.getDependentWorkloads(ctx, restConfig, gvk, target)
+		if err != nil {
+			if gvk.Kind == k8sCommonBean.K8sClusterResourceRolloutKind {
+				// argo rollouts may not be installed in the cluster
+				impl.logger.Debugw("skipping rollouts for rotation", "clusterId", target.ClusterId, "err", err)
+				continue
+			}
+			return nil, k8s.ParseK8sClientErrorToApiError(err)
+		}
+		patchType := types.StrategicMergePatchType
+		if gvk.Kind == k8sCommonBean.K8sClusterResourceRolloutKind {
+			// rollout does not support strategic merge type
+			patchType = types.MergePatchType
+		}
+		for _, workload := range workloads {
+			restartedWorkload := &bean.RestartedWorkload{
+				Kind: gvk.Kind,
+				Name: workload.GetName(),
+			}
+			_, err = impl.K8sUtil.PatchResourceRequest(ctx, restConfig, patchType, string(patch), workload.GetName(), target.Namespace, gvk)
+			if err != nil {
+				impl.logger.Errorw("error in restarting workload on rotation", "kind", gvk.Kind, "name", workload.GetName(), "namespace", target.Namespace, "err", err)
+				restartedWorkload.ErrorMessage = err.Error()
+			}
+			restartedWorkloads = append(restartedWorkloads, restartedWorkload)
+		}
+	}
+	return restartedWorkloads, nil
+}
+
+func (impl *CmCsRotationServiceImpl) getDependentWorkloads(ctx context.Context, restConfig *rest.Config, gvk schema.GroupVersionKind, target *bean.RotationTarget) ([]unstructured.Unstructured, error) {
+	resourceIf, _, err := impl.K8sUtil.GetResourceIf(restConfig, gvk)
+	if err != nil {
+		return nil, err
+	}
+	list, err := resourceIf.Namespace(target.Namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	dependentWorkloads := make([]unstructured.Unstructured, 0)
+	for _, item := range list.Items {
+		if !isReleaseWorkload(item, target.ReleaseName) {
+			continue
+		}
+		podSpecMap, found, err := unstructured.NestedMap(item.Object, "spec", "template", "spec")
+		if err != nil || !found {
+			continue
+		}
+		podSpec := &v1.PodSpec{}
+		err = runtime.DefaultUnstructuredConverter.FromUnstructured(podSpecMap, podSpec)
+		if err != nil {
+			impl.logger.Errorw("error in converting pod spec of workload", "kind", gvk.Kind, "name", item.GetName(), "err", err)
+			continue
+		}
+		if helper.IsPodSpecReferencing(podSpec, target.ResourceType, target.InClusterName) {
+			dependentWorkloads = append(dependentWorkloads, item)
+		}
+	}
+	return dependentWorkloads, nil
+}
+
+// isReleaseWorkload restricts rotation to the workloads deployed by the pipeline, identified by the helm release annotation or argocd instance label
+func isReleaseWorkload(workload unstructured.Unstructured, releaseName string) bool {
+	return workload.GetAnnotations()["meta.helm.sh/release-name"] == releaseName ||
+		workload.GetLabels()["app.kubernetes.io/instance"] == releaseName
+}
+
+func (impl *CmCsRotationServiceImpl) getInClusterDataHash(ctx context.Context, target *bean.RotationTarget) (string, error) {
+	name := target.InClusterName
+	request := k8s.NewCmCsRequestBean(target.ClusterId, target.Namespace)
+	if target.ResourceType.IsCM() {
+		configMaps, err := impl.k8sCommonService.GetDataFromConfigMaps(ctx, request.SetExternalCmList(name))
+		if err != nil {
+			return "", k8s.ParseK8sClientErrorToApiError(err)
+		}
+		return helper.GetConfigMapDataHash(configMaps[name])
+	}
+	secrets, err := impl.k8sCommonService.GetDataFromSecrets(ctx, request.SetExternalCsList(name))
+	if err != nil {
+		return "", k8s.ParseK8sClientErrorToApiError(err)
+	}
+	return helper.GetSecretDataHash(secrets[name])
+}
+
+// requestExternalSecretSync annotates the ExternalSecret for external secrets operator to refresh it from the provider
+func (impl *CmCsRotationServiceImpl) requestExternalSecretSync(ctx context.Context, target *bean.RotationTarget) error {
+	restConfig, err, _ := impl.k8sCommonService.GetRestConfigByClusterId(ctx, target.ClusterId)
+	if err != nil {
+		impl.logger.Errorw("error in getting rest config by cluster", "clusterId", target.ClusterId, "err", err)
+		return err
+	}
+	patch := fmt.Sprintf(`{"metadata":{"annotations":{%q:%q}}}`, bean.ExternalSecretForceSyncAnnotation, fmt.Sprint(time.Now().Unix()))
+	gvk := schema.GroupVersionKind{Group: bean.ExternalSecretGroup, Version: bean.ExternalSecretVersion, Kind: bean.ExternalSecretKind}
+	_, err = impl.K8sUtil.PatchResourceRequest(ctx, restConfig, types.MergePatchType, patch, target.ConfigData.Name, target.Namespace, gvk)
+	if err != nil {
+		impl.logger.Errorw("error in requesting external secret sync", "name", target.ConfigData.Name, "namespace", target.Namespace, "err", err)
+		return k8s.ParseK8sClientErrorToApiError(err)
+	}
+	return nil
+}
+
+// waitForExternalSecretSync polls the secret till its data changes or the configured wait is over, returns the latest hash
+func (impl *CmCsRotationServiceImpl) waitForExternalSecretSync(ctx context.Context, target *bean.RotationTarget, previousHash string) (string, error) {
+	deadline := time.Now().Add(time.Duration(impl.config.ExternalSecretSyncWaitSeconds) * time.Second)
+	for time.Now().Before(deadline) {
+		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
+		case <-time.After(2 * time.Second):
+		}
+		dataHash, err := impl.getInClusterDataHash(ctx, target)
+		if err != nil {
+			return "", err
+		}
+		if dataHash != previousHash {
+			return dataHash, nil
+		}
+	}
+	impl.logger.Infow("external secret data unchanged after sync request", "name", target.ConfigData.Name, "namespace", target.Namespace)
+	return previousHash, nil
+}
+
+func (impl *CmCsRotationServiceImpl) getRotationState(target *bean.RotationTarget) (*repository.CmCsRotationState, error) {
+	state, err := impl.cmCsRotationStateRepository.FindByPipelineIdAndName(target.PipelineId, target.ResourceType.ToString(), target.ConfigData.Name)
+	if err != nil && err != pg.ErrNoRows {
+		impl.logger.Errorw("error in getting cm cs rotation state", "pipelineId", target.PipelineId, "name", target.ConfigData.Name, "err", err)
+		return nil, err
+	}
+	if err == pg.ErrNoRows {
+		state = &repository.CmCsRotationState{
+			PipelineId:   target.PipelineId,
+			AppId:        target.AppId,
+			EnvId:        target.EnvId,
+			ResourceType: target.ResourceType.ToString(),
+			Name:         target.ConfigData.Name,
+			AuditLog:     sql.NewDefaultAuditLog(userBean.SystemUserId),
+		}
+	}
+	return state, nil
+}
+
+// getRotationTargets returns the configmaps/secrets with an enabled rotation policy for every active pipeline,
+// env level configs take precedence over app level configs of the same name
+func (impl *CmCsRotationServiceImpl) getRotationTargets() ([]*bean.RotationTarget, error) {
+	targets := make([]*bean.RotationTarget, 0)
+	envModels, err := impl.configMapRepository.GetAllEnvLevelWithRotationPolicy()
+	if err != nil && err != pg.ErrNoRows {
+		impl.logger.Errorw("error in getting env level configs with rotation policy", "err", err)
+		return nil, err
+	}
+	for _, envModel := range envModels {
+		pipelines, err := impl.pipelineRepository.FindActiveByAppIdAndEnvironmentId(envModel.AppId, envModel.EnvironmentId)
+		if err != nil && err != pg.ErrNoRows {
+			impl.logger.Errorw("error in getting pipelines", "appId", envModel.AppId, "envId", envModel.EnvironmentId, "err", err)
+			return nil, err
+		}
+		for _, pipeline := range pipelines {
+			targets = append(targets, getPipelineRotationTargets(pipeline, envModel.ConfigMapData, envModel.SecretData, nil)...)
+		}
+	}
+	appModels, err := impl.configMapRepository.GetAllAppLevelWithRotationPolicy()
+	if err != nil && err != pg.ErrNoRows {
+		impl.logger.Errorw("error in getting app level configs with rotation policy", "err", err)
+		return nil, err
+	}
+	for _, appModel := range appModels {
+		pipelines, err := impl.pipelineRepository.FindActiveByAppId(appModel.AppId)
+		if err != nil && err != pg.ErrNoRows {
+			impl.logger.Errorw("error in getting pipelines", "appId", appModel.AppId, "err", err)
+			return nil, err
+		}
+		for _, pipeline := range pipelines {
+			envModel, err := impl.configMapRepository.GetByAppIdAndEnvIdEnvLevel(pipeline.AppId, pipeline.EnvironmentId)
+			if err != nil && err != pg.ErrNoRows {
+				impl.logger.Errorw("error in getting env level config", "appId", pipeline.AppId, "envId", pipeline.EnvironmentId, "err", err)
+				return nil, err
+			}
+			targets = append(targets, getPipelineRotationTargets(pipeline, appModel.ConfigMapData, appModel.SecretData, getOverriddenConfigNames(envModel))...)
+		}
+	}
+	return targets, nil
+}
+
+// getOverriddenConfigNames returns the keys of configmaps/secrets defined at env level, these take precedence over app level ones
+func getOverriddenConfigNames(envModel *chartConfig.ConfigMapEnvModel) map[string]bool {
+	overriddenNames := make(map[string]bool)
+	if envModel == nil || envModel.Id == 0 || envModel.Deleted {
+		return overriddenNames
+	}
+	for _, resourceType := range []pipelineBean.ResourceType{pipelineBean.CM, pipelineBean.CS} {
+		for _, configData := range getConfigDataList(resourceType, envModel.ConfigMapData, envModel.SecretData) {
+			overriddenNames[getConfigKey(resourceType, configData.Name)] = true
+		}
+	}
+	return overriddenNames
+}
+
+func getPipelineRotationTargets(pipeline *pipelineConfig.Pipeline, configMapData, secretData string, overriddenNames map[string]bool) []*bean.RotationTarget {
+	targets := make([]*bean.RotationTarget, 0)
+	if pipeline.Environment.IsVirtualEnvironment {
+		return targets
+	}
+	for _, resourceType := range []pipelineBean.ResourceType{pipelineBean.CM, pipelineBean.CS} {
+		for _, configData := range getConfigDataList(resourceType, configMapData, secretData) {
+			if !configData.IsRotationPolicyEnabled() || overriddenNames[getConfigKey(resourceType, configData.Name)] {
+				continue
+			}
+			targets = append(targets, newRotationTarget(pipeline, resourceType, configData))
+		}
+	}
+	return targets
+}
+
+// getEffectiveConfigData returns the configmap/secret of the given name as applied on the environment
+func (impl *CmCsRotationServiceImpl) getEffectiveConfigData(appId, envId int, resourceType pipelineBean.ResourceType, name string) (*pipelineBean.ConfigData, error) {
+	envModel, err := impl.configMapRepository.GetByAppIdAndEnvIdEnvLevel(appId, envId)
+	if err != nil && err != pg.ErrNoRows {
+		impl.logger.Errorw("error in getting env level config", "appId", appId, "envId", envId, "err", err)
+		return nil, err
+	}
+	if envModel != nil && envModel.Id > 0 && !envModel.Deleted {
+		for _, configData := range getConfigDataList(resourceType, envModel.ConfigMapData, envModel.SecretData) {
+			if configData.Name == name {
+				return configData, nil
+			}
+		}
+	}
+	appModel, err := impl.configMapRepository.GetByAppIdAppLevel(appId)
+	if err != nil && err != pg.ErrNoRows {
+		impl.logger.Errorw("error in getting app level config", "appId", appId, "err", err)
+		return nil, err
+	}
+	if appModel != nil && appModel.Id > 0 {
+		for _, configData := range getConfigDataList(resourceType, appModel.ConfigMapData, appModel.SecretData) {
+			if configData.Name == name {
+				return configData, nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+func (impl *CmCsRotationServiceImpl) getPipeline(appId, envId int) (*pipelineConfig.Pipeline, error) {
+	pipelines, err := impl.pipelineRepository.FindActiveByAppIdAndEnvironmentId(appId, envId)
+	if err != nil && err != pg.ErrNoRows {
+		impl.logger.Errorw("error in getting pipelines", "appId", appId, "envId", envId, "err", err)
+		return nil, err
+	}
+	if len(pipelines) == 0 {
+		return nil, util.NewApiError(http.StatusNotFound, "no cd pipeline found for the app in the environment", "pipeline not found")
+	}
+	return pipelines[0], nil
+}
+
+func getConfigDataList(resourceType pipelineBean.ResourceType, configMapData, secretData string) []*pipelineBean.ConfigData {
+	if resourceType.IsCM() {
+		configsList := &pipelineBean.ConfigsList{}
+		if len(configMapData) > 0 && json.Unmarshal([]byte(configMapData), configsList) == nil {
+			return configsList.ConfigData
+		}
+		return nil
+	}
+	secretsList := &pipelineBean.SecretsList{}
+	if len(secretData) > 0 && json.Unmarshal([]byte(secretData), secretsList) == nil {
+		return secretsList.ConfigData
+	}
+	return nil
+}
+
+func getConfigKey(resourceType pipelineBean.ResourceType, name string) string {
+	return fmt.Sprintf("%s/%s", resourceType, name)
+}
+
+func getHistoryConfigType(resourceType pipelineBean.ResourceType) historyRepository.ConfigType {
+	if resourceType.IsCS() {
+		return historyRepository.SECRET_TYPE
+	}
+	return historyRepository.CONFIGMAP_TYPE
+}
+
+func newRotationTarget(pipeline *pipelineConfig.Pipeline, resourceType pipelineBean.ResourceType, configData *pipelineBean.ConfigData) *bean.RotationTarget {
+	return &bean.RotationTarget{
+		InClusterName: getInClusterName(pipeline.AppId, configData),
+		PipelineId:    pipeline.Id,
+		AppId:         pipeline.AppId,
+		EnvId:         pipeline.EnvironmentId,
+		ClusterId:     pipeline.Environment.ClusterId,
+		Namespace:     pipeline.Environment.Namespace,
+		ReleaseName:   pipeline.DeploymentAppName,
+		ResourceType:  resourceType,
+		ConfigData:    configData,
+	}
+}
+
+// getInClusterName returns the name of the configmap/secret in the cluster, devtron managed ones are
+// created by the chart with app id as suffix while external ones are used by their name
+func getInClusterName(appId int, configData *pipelineBean.ConfigData) string {
+	if configData.External {
+		return configData.Name
+	}
+	return fmt.Sprintf("%s-%d", configData.Name, appId)
+}
@@ -0,0 +1,103 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rotation
+
+import (
+	"testing"
+
+	"github.com/devtron-labs/devtron/internal/sql/repository/chartConfig"
+	"github.com/devtron-labs/devtron/internal/sql/repository/pipelineConfig"
+	"github.com/devtron-labs/devtron/pkg/cluster/environment/repository"
+	pipelineBean "github.com/devtron-labs/devtron/pkg/pipeline/bean"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetPipelineRotationTargets(t *testing.T) {
+	pipeline := &pipelineConfig.Pipeline{
+		Id:                1,
+		AppId:             10,
+		EnvironmentId:     20,
+		DeploymentAppName: "app-dev",
+		Environment:       repository.Environment{ClusterId: 2, Namespace: "dev"},
+	}
+	appConfigMapData := `{"maps":[{"name":"app-config","external":true,"rotationPolicy":{"enabled":true}},{"name":"feature-flags","rotationPolicy":{"enabled":false}}]}`
+	appSecretData := `{"secrets":[{"name":"db-creds","external":true,"rotationPolicy":{"enabled":true}},{"name":"api-key","rotationPolicy":{"enabled":true,"autoRestart":true}}]}`
+	envModel := &chartConfig.ConfigMapEnvModel{
+		Id:         5,
+		SecretData: `{"secrets":[{"name":"db-creds","external":true}]}`,
+	}
+	tests := []struct {
+		name            string
+		pipeline        *pipelineConfig.Pipeline
+		overriddenNames map[string]bool
+		wantNames       []string
+	}{
+		{
+			name:      "app level configs with enabled policy",
+			pipeline:  pipeline,
+			wantNames: []string{"app-config", "db-creds", "api-key-10"},
+		},
+		{
+			name:            "env level config takes precedence over app level",
+			pipeline:        pipeline,
+			overriddenNames: getOverriddenConfigNames(envModel),
+			wantNames:       []string{"app-config", "api-key-10"},
+		},
+		{
+			name:            "deleted env level config is not an override",
+			pipeline:        pipeline,
+			overriddenNames: getOverriddenConfigNames(&chartConfig.ConfigMapEnvModel{Id: 5, Deleted: true, SecretData: envModel.SecretData}),
+			wantNames:       []string{"app-config", "db-creds", "api-key-10"},
+		},
+		{
+			name: "virtual environment",
+			pipeline: &pipelineConfig.Pipeline{
+				Id:          2,
+				AppId:       10,
+				Environment: repository.Environment{IsVirtualEnvironment: true},
+			},
+			wantNames: []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			targets := getPipelineRotationTargets(tt.pipeline, appConfigMapData, appSecretData, tt.overriddenNames)
+			names := make([]string, 0, len(targets))
+			for _, target := range targets {
+				assert.Equal(t, tt.pipeline.Id, target.PipelineId)
+				assert.Equal(t, tt.pipeline.Environment.Namespace, target.Namespace)
+				names = append(names, target.InClusterName)
+			}
+			assert.Equal(t, tt.wantNames, names)
+		})
+	}
+}
+
+func TestGetOverriddenConfigNames(t *testing.T) {
+	envModel := &chartConfig.ConfigMapEnvModel{
+		Id:            5,
+		ConfigMapData: `{"maps":[{"name":"app-config"}]}`,
+		SecretData:    `{"secrets":[{"name":"db-creds"}]}`,
+	}
+	overriddenNames := getOverriddenConfigNames(envModel)
+	assert.Equal(t, map[string]bool{
+		getConfigKey(pipelineBean.CM, "app-config"): true,
+		getConfigKey(pipelineBean.CS, "db-creds"):   true,
+	}, overriddenNames)
+	assert.Empty(t, getOverriddenConfigNames(nil))
+	assert.Empty(t, getOverriddenConfigNames(&chartConfig.ConfigMapEnvModel{}))
+}
@@ -0,0 +1,109 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package bean
+
+import (
+	"time"
+
+	"github.com/devtron-labs/devtron/pkg/pipeline/bean"
+)
+
+type CmCsRotationConfig struct {
+	WatchCron                     string `env:"CM_CS_ROTATION_WATCH_CRON" envDefault:"@every 5m" description:"Cron expression at which configmaps/secrets having a rotation policy are checked for in-cluster value changes, empty value disables the watcher"`
+	ExternalSecretSyncWaitSeconds int    `env:"CM_CS_ROTATION_ESO_SYNC_WAIT_SECONDS" envDefault:"30" description:"Max time to wait in background for external secrets operator to refresh a secret on manual rotation before restarting the workloads"`
+}
+
+type RotationTrigger string
+
+const (
+	// RotationTriggerManual is set when rotation is requested by the user
+	RotationTriggerManual RotationTrigger = "MANUAL"
+	// RotationTriggerExternalChange is set when the watcher finds the in-cluster value changed
+	RotationTriggerExternalChange RotationTrigger = "EXTERNAL_CHANGE"
+)
+
+func (t RotationTrigger) String() string {
+	return string(t)
+}
+
+const (
+	// ChecksumAnnotationPrefix is used for the pod template annotation bumped on rotation,
+	// complete key is <prefix><configmap|secret>-<name>
+	ChecksumAnnotationPrefix = "checksum.devtron.ai/"
+	// ExternalSecretForceSyncAnnotation makes external secrets operator refresh the secret from the provider
+	ExternalSecretForceSyncAnnotation = "force-sync"
+	ExternalSecretGroup               = "external-secrets.io"
+	ExternalSecretVersion             = "v1beta1"
+	ExternalSecretKind                = "ExternalSecret"
+	// RotatedAtAnnotation is bumped on every rotation so that the pods roll even when the data hash is unchanged
+	RotatedAtAnnotation = "devtron.ai/rotatedAt"
+	// RotationWatchLeaseKey is the cron lease taken by the watcher so that it runs on one replica at a time
+	RotationWatchLeaseKey = "cm_cs_rotation_watch"
+	// RotationWatchLeaseTtl bounds a watch run, another replica can take the watch over once it expires
+	RotationWatchLeaseTtl = 30 * time.Minute
+)
+
+type RotateRequest struct {
+	AppId         int               `json:"appId" validate:"required,number,gt=0"`
+	EnvironmentId int               `json:"environmentId" validate:"required,number,gt=0"`
+	Name          string            `json:"name" validate:"required"`
+	ResourceType  bean.ResourceType `json:"resourceType" validate:"required,oneof=ConfigMap Secret"`
+	UserId        int32             `json:"-"`
+}
+
+type RotationResponse struct {
+	Name         string            `json:"name"`
+	ResourceType bean.ResourceType `json:"resourceType"`
+	DataHash     string            `json:"dataHash"`
+	// DataChanged is false when the in-cluster value is same as last observed
+	DataChanged bool `json:"dataChanged"`
+	// ExternalSecretSynced is set for ESO secrets on which a refresh from the provider was requested
+	ExternalSecretSynced bool `json:"externalSecretSynced"`
+	// RotationInProgress is set when the workloads are restarted in background once the ESO secret is synced,
+	// the result is recorded in rotation history
+	RotationInProgress bool                 `json:"rotationInProgress"`
+	RestartedWorkloads []*RestartedWorkload `json:"restartedWorkloads"`
+	HistoryId          int                  `json:"historyId,omitempty"`
+}
+
+type RestartedWorkload struct {
+	Kind         string `json:"kind"`
+	Name         string `json:"name"`
+	ErrorMessage string `json:"errorMessage,omitempty"`
+}
+
+type RotationHistoryDto struct {
+	Id                 int                  `json:"id"`
+	Trigger            RotationTrigger      `json:"trigger"`
+	RestartedWorkloads []*RestartedWorkload `json:"restartedWorkloads"`
+	RotatedOn          time.Time            `json:"rotatedOn"`
+	RotatedBy          string               `json:"rotatedBy"`
+}
+
+// RotationTarget is a configmap/secret with an enabled rotation policy as seen by one cd pipeline
+type RotationTarget struct {
+	// InClusterName is the name of the configmap/secret in the cluster, suffixed with app id for devtron managed ones
+	InClusterName string
+	PipelineId    int
+	AppId         int
+	EnvId         int
+	ClusterId     int
+	Namespace     string
+	ReleaseName   string
+	ResourceType  bean.ResourceType
+	ConfigData    *bean.ConfigData
+}
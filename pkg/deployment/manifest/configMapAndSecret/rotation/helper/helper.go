@@ -0,0 +1,139 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package helper
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+
+	"github.com/devtron-labs/devtron/pkg/deployment/manifest/configMapAndSecret/rotation/bean"
+	pipelineBean "github.com/devtron-labs/devtron/pkg/pipeline/bean"
+	v1 "k8s.io/api/core/v1"
+)
+
+// maxAnnotationNameLength is the max length of the name part of an annotation key
+const maxAnnotationNameLength = 63
+
+// GetConfigMapDataHash hashes the data of the configmap in the same shape as the deployment time config hash
+func GetConfigMapDataHash(configMap *v1.ConfigMap) (string, error) {
+	return getDataHash(struct {
+		Data       map[string]string `json:"data,omitempty"`
+		BinaryData map[string][]byte `json:"binaryData,omitempty"`
+	}{
+		Data:       configMap.Data,
+		BinaryData: configMap.BinaryData,
+	})
+}
+
+// GetSecretDataHash hashes the data of the secret in the same shape as the deployment time secret hash
+func GetSecretDataHash(secret *v1.Secret) (string, error) {
+	return getDataHash(struct {
+		Data       map[string][]byte `json:"data,omitempty"`
+		StringData map[string]string `json:"stringData,omitempty"`
+	}{
+		Data:       secret.Data,
+		StringData: secret.StringData,
+	})
+}
+
+func getDataHash(data interface{}) (string, error) {
+	dataBytes, err := json.Marshal(data)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%x", sha256.Sum256(dataBytes)), nil
+}
+
+// GetChecksumAnnotationKey returns the pod template annotation key holding the data hash of the configmap/secret,
+// names exceeding the annotation length limit are truncated and suffixed with a short hash to keep keys unique
+func GetChecksumAnnotationKey(resourceType pipelineBean.ResourceType, name string) string {
+	keyName := "configmap-" + name
+	if resourceType.IsCS() {
+		keyName = "secret-" + name
+	}
+	if len(keyName) > maxAnnotationNameLength {
+		nameHash := fmt.Sprintf("%x", sha256.Sum256([]byte(keyName)))
+		keyName = keyName[:maxAnnotationNameLength-9] + "-" + nameHash[:8]
+	}
+	return bean.ChecksumAnnotationPrefix + keyName
+}
+
+// IsPodSpecReferencing checks if the configmap/secret is consumed by the pod spec through volumes, envFrom or env
+func IsPodSpecReferencing(podSpec *v1.PodSpec, resourceType pipelineBean.ResourceType, name string) bool {
+	if podSpec == nil {
+		return false
+	}
+	for _, volume := range podSpec.Volumes {
+		if isVolumeReferencing(volume.VolumeSource, resourceType, name) {
+			return true
+		}
+	}
+	containers := make([]v1.Container, 0, len(podSpec.InitContainers)+len(podSpec.Containers))
+	containers = append(containers, podSpec.InitContainers...)
+	containers = append(containers, podSpec.Containers...)
+	for _, container := range containers {
+		if isContainerReferencing(container, resourceType, name) {
+			return true
+		}
+	}
+	return false
+}
+
+func isVolumeReferencing(volumeSource v1.VolumeSource, resourceType pipelineBean.ResourceType, name string) bool {
+	if resourceType.IsCM() && volumeSource.ConfigMap != nil && volumeSource.ConfigMap.Name == name {
+		return true
+	}
+	if resourceType.IsCS() && volumeSource.Secret != nil && volumeSource.Secret.SecretName == name {
+		return true
+	}
+	if volumeSource.Projected == nil {
+		return false
+	}
+	for _, source := range volumeSource.Projected.Sources {
+		if resourceType.IsCM() && source.ConfigMap != nil && source.ConfigMap.Name == name {
+			return true
+		}
+		if resourceType.IsCS() && source.Secret != nil && source.Secret.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func isContainerReferencing(container v1.Container, resourceType pipelineBean.ResourceType, name string) bool {
+	for _, envFrom := range container.EnvFrom {
+		if resourceType.IsCM() && envFrom.ConfigMapRef != nil && envFrom.ConfigMapRef.Name == name {
+			return true
+		}
+		if resourceType.IsCS() && envFrom.SecretRef != nil && envFrom.SecretRef.Name == name {
+			return true
+		}
+	}
+	for _, env := range container.Env {
+		if env.ValueFrom == nil {
+			continue
+		}
+		if resourceType.IsCM() && env.ValueFrom.ConfigMapKeyRef != nil && env.ValueFrom.ConfigMapKeyRef.Name == name {
+			return true
+		}
+		if resourceType.IsCS() && env.ValueFrom.SecretKeyRef != nil && env.ValueFrom.SecretKeyRef.Name == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,81 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package helper
+
+import (
+	"strings"
+	"testing"
+
+	pipelineBean "github.com/devtron-labs/devtron/pkg/pipeline/bean"
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestIsPodSpecReferencing(t *testing.T) {
+	podSpec := &v1.PodSpec{
+		Volumes: []v1.Volume{
+			{Name: "certs", VolumeSource: v1.VolumeSource{Secret: &v1.SecretVolumeSource{SecretName: "tls-certs"}}},
+			{Name: "projected", VolumeSource: v1.VolumeSource{Projected: &v1.ProjectedVolumeSource{
+				Sources: []v1.VolumeProjection{{ConfigMap: &v1.ConfigMapProjection{LocalObjectReference: v1.LocalObjectReference{Name: "app-config"}}}},
+			}}},
+		},
+		InitContainers: []v1.Container{{
+			Name:    "migrate",
+			EnvFrom: []v1.EnvFromSource{{SecretRef: &v1.SecretEnvSource{LocalObjectReference: v1.LocalObjectReference{Name: "db-admin"}}}},
+		}},
+		Containers: []v1.Container{{
+			Name: "app",
+			Env: []v1.EnvVar{{Name: "DB_PASSWORD", ValueFrom: &v1.EnvVarSource{
+				SecretKeyRef: &v1.SecretKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: "db-creds"}, Key: "password"},
+			}}},
+		}},
+	}
+	tests := []struct {
+		name         string
+		resourceType pipelineBean.ResourceType
+		resourceName string
+		want         bool
+	}{
+		{name: "secret volume", resourceType: pipelineBean.CS, resourceName: "tls-certs", want: true},
+		{name: "projected configmap", resourceType: pipelineBean.CM, resourceName: "app-config", want: true},
+		{name: "init container envFrom", resourceType: pipelineBean.CS, resourceName: "db-admin", want: true},
+		{name: "env secret key ref", resourceType: pipelineBean.CS, resourceName: "db-creds", want: true},
+		{name: "same name of other type", resourceType: pipelineBean.CM, resourceName: "db-creds", want: false},
+		{name: "not referenced", resourceType: pipelineBean.CS, resourceName: "unused", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsPodSpecReferencing(podSpec, tt.resourceType, tt.resourceName); got != tt.want {
+				t.Errorf("IsPodSpecReferencing() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetChecksumAnnotationKey(t *testing.T) {
+	if got := GetChecksumAnnotationKey(pipelineBean.CS, "db-creds"); got != "checksum.devtron.ai/secret-db-creds" {
+		t.Errorf("GetChecksumAnnotationKey() = %s", got)
+	}
+	longName := strings.Repeat("a", 70)
+	first := GetChecksumAnnotationKey(pipelineBean.CM, longName+"1")
+	second := GetChecksumAnnotationKey(pipelineBean.CM, longName+"2")
+	if len(strings.TrimPrefix(first, "checksum.devtron.ai/")) != maxAnnotationNameLength {
+		t.Errorf("GetChecksumAnnotationKey() length = %d, want %d", len(first), maxAnnotationNameLength)
+	}
+	if first == second {
+		t.Errorf("GetChecksumAnnotationKey() returned same key for different names: %s", first)
+	}
+}
@@ -0,0 +1,74 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package repository
+
+import (
+	"time"
+
+	"github.com/devtron-labs/devtron/pkg/sql"
+	"github.com/go-pg/pg"
+	"go.uber.org/zap"
+)
+
+type CmCsRotationState struct {
+	tableName     struct{}  `sql:"cm_cs_rotation_state" pg:",discard_unknown_columns"`
+	Id            int       `sql:"id,pk"`
+	PipelineId    int       `sql:"pipeline_id,notnull"`
+	AppId         int       `sql:"app_id,notnull"`
+	EnvId         int       `sql:"env_id,notnull"`
+	ResourceType  string    `sql:"resource_type,notnull"`
+	Name          string    `sql:"name,notnull"`
+	DataHash      string    `sql:"data_hash,notnull"`
+	LastRotatedOn time.Time `sql:"last_rotated_on"`
+	sql.AuditLog
+}
+
+type CmCsRotationStateRepository interface {
+	FindByPipelineIdAndName(pipelineId int, resourceType, name string) (*CmCsRotationState, error)
+	Save(state *CmCsRotationState) error
+	Update(state *CmCsRotationState) error
+}
+
+type CmCsRotationStateRepositoryImpl struct {
+	dbConnection *pg.DB
+	logger       *zap.SugaredLogger
+}
+
+func NewCmCsRotationStateRepositoryImpl(dbConnection *pg.DB, logger *zap.SugaredLogger) *CmCsRotationStateRepositoryImpl {
+	return &CmCsRotationStateRepositoryImpl{
+		dbConnection: dbConnection,
+		logger:       logger,
+	}
+}
+
+func (impl *CmCsRotationStateRepositoryImpl) FindByPipelineIdAndName(pipelineId int, resourceType, name string) (*CmCsRotationState, error) {
+	state := &CmCsRotationState{}
+	err := impl.dbConnection.Model(state).
+		Where("pipeline_id = ?", pipelineId).
+		Where("resource_type = ?", resourceType).
+		Where("name = ?", name).
+		Select()
+	return state, err
+}
+
+func (impl *CmCsRotationStateRepositoryImpl) Save(state *CmCsRotationState) error {
+	return impl.dbConnection.Insert(state)
+}
+
+func (impl *CmCsRotationStateRepositoryImpl) Update(state *CmCsRotationState) error {
+	return impl.dbConnection.Update(state)
+}
@@ -79,6 +79,7 @@ type ChartRefFileOpService interface {
 	GetChartInBytes(chartRefId int, deleteChart bool) ([]byte, error)
 	GetChartBytesForApps(ctx context.Context, appIdToAppName map[int]string) (map[int][]byte, error)
 	GetChartLocation(chartRefLocation string, chartData []byte) (string, error)
+	GetChartUpgradeReport(oldChartRefId, newChartRefId int, values json.RawMessage) (*bean.ChartUpgradeReport, error)
 }
 
 type ChartRefServiceImpl struct {
@@ -506,6 +507,47 @@ func (impl *ChartRefServiceImpl) GetAppOverrideForDefaultTemplate(chartRefId int
 	return messages, string(merged), nil
 }
 
+func (impl *ChartRefServiceImpl) GetChartUpgradeReport(oldChartRefId, newChartRefId int, values json.RawMessage) (*bean.ChartUpgradeReport, error) {
+	oldDefaults, err := impl.getDefaultValuesMap(oldChartRefId)
+	if err != nil {
+		impl.logger.Errorw("error in getting default values of chart", "chartRefId", oldChartRefId, "err", err)
+		return nil, err
+	}
+	newDefaults, err := impl.getDefaultValuesMap(newChartRefId)
+	if err != nil {
+		impl.logger.Errorw("error in getting default values of chart", "chartRefId", newChartRefId, "err", err)
+		return nil, err
+	}
+	var valuesMap map[string]interface{}
+	if len(values) > 0 {
+		if err = json.Unmarshal(values, &valuesMap); err != nil {
+			impl.logger.Errorw("error in unmarshalling values", "err", err)
+			return nil, err
+		}
+	}
+	report, err := GetChartUpgradeReport(oldDefaults, newDefaults, valuesMap)
+	if err != nil {
+		impl.logger.Errorw("error in computing chart upgrade report", "oldChartRefId", oldChartRefId, "newChartRefId", newChartRefId, "err", err)
+		return nil, err
+	}
+	report.OldChartRefId = oldChartRefId
+	report.NewChartRefId = newChartRefId
+	return report, nil
+}
+
+func (impl *ChartRefServiceImpl) getDefaultValuesMap(chartRefId int) (map[string]interface{}, error) {
+	_, defaultValues, err := impl.GetAppOverrideForDefaultTemplate(chartRefId)
+	if err != nil {
+		return nil, err
+	}
+	defaultValuesMap := make(map[string]interface{})
+	if len(defaultValues) == 0 {
+		return defaultValuesMap, nil
+	}
+	err = json.Unmarshal([]byte(defaultValues), &defaultValuesMap)
+	return defaultValuesMap, err
+}
+
 func (impl *ChartRefServiceImpl) JsonSchemaExtractFromFile(chartRefId int) (map[string]interface{}, string, error) {
 	err := impl.CheckChartExists(chartRefId)
 	if err != nil {
@@ -578,6 +620,7 @@ func (impl *ChartRefServiceImpl) ExtractChartIfMissing(chartData []byte, refChar
 	}
 
 	currentChartWorkingDir := filepath.Clean(filepath.Join(temporaryChartWorkingDir, fileName))
+	chartInfo.ChartWorkingDir = currentChartWorkingDir
 
 	if location == "" {
 		chartYaml, err := impl.readChartMetaDataForLocation(temporaryChartWorkingDir, fileName)
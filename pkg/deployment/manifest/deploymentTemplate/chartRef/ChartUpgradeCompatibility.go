@@ -0,0 +1,277 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package chartRef
+
+import (
+	"encoding/json"
+	"github.com/devtron-labs/devtron/pkg/deployment/manifest/deploymentTemplate/chartRef/bean"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+type valuesLeaf struct {
+	segments []string
+	value    interface{}
+}
+
+// flattenValues returns the leaf keys of values by their dot separated path. Lists and empty maps are leaves.
+func flattenValues(values map[string]interface{}) map[string]*valuesLeaf {
+	leaves := make(map[string]*valuesLeaf)
+	flattenValuesInto(nil, values, leaves)
+	return leaves
+}
+
+func flattenValuesInto(segments []string, value interface{}, leaves map[string]*valuesLeaf) {
+	valueMap, ok := value.(map[string]interface{})
+	if !ok || (len(valueMap) == 0 && len(segments) > 0) {
+		leaves[strings.Join(segments, ".")] = &valuesLeaf{segments: segments, value: value}
+		return
+	}
+	for key, child := range valueMap {
+		childSegments := make([]string, len(segments), len(segments)+1)
+		copy(childSegments, segments)
+		flattenValuesInto(append(childSegments, key), child, leaves)
+	}
+}
+
+// isCoveredBy returns true if the key, one of its parents or one of its children is a leaf in leaves.
+// A key under a free form map, or a free form map which got structured, is not considered as changed.
+func isCoveredBy(leaf *valuesLeaf, leaves map[string]*valuesLeaf) bool {
+	for i := len(leaf.segments); i > 0; i-- {
+		if _, ok := leaves[strings.Join(leaf.segments[:i], ".")]; ok {
+			return true
+		}
+	}
+	path := strings.Join(leaf.segments, ".")
+	for otherPath := range leaves {
+		if strings.HasPrefix(otherPath, path+".") {
+			return true
+		}
+	}
+	return false
+}
+
+func normalizeKey(key string) string {
+	return strings.ToLower(strings.NewReplacer("-", "", "_", "").Replace(key))
+}
+
+func parentPath(leaf *valuesLeaf) string {
+	return strings.Join(leaf.segments[:len(leaf.segments)-1], ".")
+}
+
+func lastSegment(leaf *valuesLeaf) string {
+	return leaf.segments[len(leaf.segments)-1]
+}
+
+// findRenamedKey looks for the new location of a removed key. A key is matched only when the match is unambiguous:
+// the same key spelled differently under the same parent, the same key moved under or out of a parent keeping its
+// full path (e.g. resources.limits -> deployment.resources.limits), or a single key of the same parent with the same
+// default value. Keys of the same name under an unrelated parent (e.g. service.enabled and serviceMonitor.enabled)
+// are not matched.
+func findRenamedKey(removed *valuesLeaf, added []*valuesLeaf, matched map[string]bool) *valuesLeaf {
+	var movedCandidates, sameValueCandidates []*valuesLeaf
+	for _, candidate := range added {
+		candidatePath := strings.Join(candidate.segments, ".")
+		if matched[candidatePath] {
+			continue
+		}
+		sameParent := parentPath(candidate) == parentPath(removed)
+		if normalizeKey(lastSegment(candidate)) == normalizeKey(lastSegment(removed)) {
+			if sameParent {
+				return candidate
+			}
+			if isMovedKey(removed, candidate) {
+				movedCandidates = append(movedCandidates, candidate)
+			}
+			continue
+		}
+		if sameParent && isDistinctiveValue(removed.value) && reflect.DeepEqual(candidate.value, removed.value) {
+			sameValueCandidates = append(sameValueCandidates, candidate)
+		}
+	}
+	if len(movedCandidates) == 1 {
+		return movedCandidates[0]
+	} else if len(movedCandidates) == 0 && len(sameValueCandidates) == 1 {
+		return sameValueCandidates[0]
+	}
+	return nil
+}
+
+// isMovedKey returns true if the complete path of one key is the trailing path of the other
+func isMovedKey(removed, candidate *valuesLeaf) bool {
+	shorter, longer := removed.segments, candidate.segments
+	if len(shorter) > len(longer) {
+		shorter, longer = longer, shorter
+	}
+	offset := len(longer) - len(shorter)
+	for i, segment := range shorter {
+		if normalizeKey(segment) != normalizeKey(longer[offset+i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// isDistinctiveValue returns false for values like booleans and empty values which are too common to identify a renamed key
+func isDistinctiveValue(value interface{}) bool {
+	if value == nil {
+		return false
+	}
+	if _, isBool := value.(bool); isBool {
+		return false
+	}
+	reflectValue := reflect.ValueOf(value)
+	switch reflectValue.Kind() {
+	case reflect.Map, reflect.Slice:
+		return reflectValue.Len() > 0
+	default:
+		return !reflectValue.IsZero()
+	}
+}
+
+// GetChartUpgradeReport compares the default values of two chart versions and reports the keys of the old version
+// which are removed or renamed in the new one, along with the values migrated to the new keys
+func GetChartUpgradeReport(oldDefaults, newDefaults, values map[string]interface{}) (*bean.ChartUpgradeReport, error) {
+	oldLeaves, newLeaves, usedLeaves := flattenValues(oldDefaults), flattenValues(newDefaults), flattenValues(values)
+	removed := make([]*valuesLeaf, 0)
+	for _, leaf := range oldLeaves {
+		if !isCoveredBy(leaf, newLeaves) {
+			removed = append(removed, leaf)
+		}
+	}
+	added := make([]*valuesLeaf, 0)
+	for _, leaf := range newLeaves {
+		if !isCoveredBy(leaf, oldLeaves) {
+			added = append(added, leaf)
+		}
+	}
+	sortLeaves(removed)
+	sortLeaves(added)
+
+	report := &bean.ChartUpgradeReport{
+		Changes:   make([]*bean.ValuesKeyChange, 0, len(removed)),
+		AddedKeys: make([]string, 0, len(added)),
+	}
+	suggestedValues := deepCopyValues(values)
+	matched := make(map[string]bool)
+	for _, leaf := range removed {
+		change := &bean.ValuesKeyChange{
+			Path:  strings.Join(leaf.segments, "."),
+			Type:  bean.ValuesKeyRemoved,
+			InUse: isCoveredBy(leaf, usedLeaves),
+		}
+		renamed := findRenamedKey(leaf, added, matched)
+		if renamed != nil {
+			change.Type = bean.ValuesKeyRenamed
+			change.NewPath = strings.Join(renamed.segments, ".")
+			matched[change.NewPath] = true
+		}
+		value, found := getValueAtPath(suggestedValues, leaf.segments)
+		deleteValueAtPath(suggestedValues, leaf.segments)
+		if found && renamed != nil {
+			setValueAtPath(suggestedValues, renamed.segments, value)
+		}
+		report.Changes = append(report.Changes, change)
+	}
+	for _, leaf := range added {
+		if path := strings.Join(leaf.segments, "."); !matched[path] {
+			report.AddedKeys = append(report.AddedKeys, path)
+		}
+	}
+	if values != nil {
+		suggestedValuesJson, err := json.Marshal(suggestedValues)
+		if err != nil {
+			return nil, err
+		}
+		report.SuggestedValues = suggestedValuesJson
+	}
+	return report, nil
+}
+
+func sortLeaves(leaves []*valuesLeaf) {
+	sort.Slice(leaves, func(i, j int) bool {
+		return strings.Join(leaves[i].segments, ".") < strings.Join(leaves[j].segments, ".")
+	})
+}
+
+func deepCopyValues(values map[string]interface{}) map[string]interface{} {
+	if values == nil {
+		return nil
+	}
+	copied := make(map[string]interface{}, len(values))
+	for key, value := range values {
+		if valueMap, ok := value.(map[string]interface{}); ok {
+			copied[key] = deepCopyValues(valueMap)
+		} else {
+			copied[key] = value
+		}
+	}
+	return copied
+}
+
+func getValueAtPath(values map[string]interface{}, segments []string) (interface{}, bool) {
+	current := values
+	for i, segment := range segments {
+		value, ok := current[segment]
+		if !ok {
+			return nil, false
+		}
+		if i == len(segments)-1 {
+			return value, true
+		}
+		if current, ok = value.(map[string]interface{}); !ok {
+			return nil, false
+		}
+	}
+	return nil, false
+}
+
+func setValueAtPath(values map[string]interface{}, segments []string, value interface{}) {
+	if values == nil {
+		return
+	}
+	current := values
+	for _, segment := range segments[:len(segments)-1] {
+		child, ok := current[segment].(map[string]interface{})
+		if !ok {
+			child = make(map[string]interface{})
+			current[segment] = child
+		}
+		current = child
+	}
+	current[segments[len(segments)-1]] = value
+}
+
+// deleteValueAtPath deletes the key and the parents left empty by it
+func deleteValueAtPath(values map[string]interface{}, segments []string) {
+	if len(segments) == 0 || values == nil {
+		return
+	}
+	if len(segments) == 1 {
+		delete(values, segments[0])
+		return
+	}
+	child, ok := values[segments[0]].(map[string]interface{})
+	if !ok {
+		return
+	}
+	deleteValueAtPath(child, segments[1:])
+	if len(child) == 0 {
+		delete(values, segments[0])
+	}
+}
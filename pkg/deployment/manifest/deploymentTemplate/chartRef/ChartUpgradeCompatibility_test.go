@@ -0,0 +1,134 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package chartRef
+
+import (
+	"encoding/json"
+	"github.com/devtron-labs/devtron/pkg/deployment/manifest/deploymentTemplate/chartRef/bean"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestGetChartUpgradeReport(t *testing.T) {
+	oldDefaults := map[string]interface{}{
+		"replicaCount": 1,
+		"image":        map[string]interface{}{"pullPolicy": "IfNotPresent"},
+		"ingress":      map[string]interface{}{"enabled": false, "className": ""},
+		"podLabels":    map[string]interface{}{},
+		"legacyFlag":   true,
+	}
+	newDefaults := map[string]interface{}{
+		"replicaCount": 1,
+		"image":        map[string]interface{}{"pull_policy": "IfNotPresent"},
+		"ingress":      map[string]interface{}{"enabled": false, "ingressClassName": ""},
+		"podLabels":    map[string]interface{}{"team": ""},
+	}
+	values := map[string]interface{}{
+		"replicaCount": 2,
+		"image":        map[string]interface{}{"pullPolicy": "Always"},
+		"legacyFlag":   false,
+	}
+	report, err := GetChartUpgradeReport(oldDefaults, newDefaults, values)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expectedChanges := []bean.ValuesKeyChange{
+		{Path: "image.pullPolicy", Type: bean.ValuesKeyRenamed, NewPath: "image.pull_policy", InUse: true},
+		{Path: "ingress.className", Type: bean.ValuesKeyRemoved},
+		{Path: "legacyFlag", Type: bean.ValuesKeyRemoved, InUse: true},
+	}
+	if len(report.Changes) != len(expectedChanges) {
+		t.Fatalf("expected %d changes, got %d", len(expectedChanges), len(report.Changes))
+	}
+	for i, change := range report.Changes {
+		if *change != expectedChanges[i] {
+			t.Errorf("expected %+v, got %+v", expectedChanges[i], *change)
+		}
+	}
+	if !reflect.DeepEqual(report.AddedKeys, []string{"ingress.ingressClassName"}) {
+		t.Errorf("unexpected added keys %v", report.AddedKeys)
+	}
+	var suggestedValues map[string]interface{}
+	if err = json.Unmarshal(report.SuggestedValues, &suggestedValues); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expectedValues := map[string]interface{}{
+		"replicaCount": float64(2),
+		"image":        map[string]interface{}{"pull_policy": "Always"},
+	}
+	if !reflect.DeepEqual(suggestedValues, expectedValues) {
+		t.Errorf("expected suggested values %v, got %v", expectedValues, suggestedValues)
+	}
+}
+
+func TestFindRenamedKey(t *testing.T) {
+	leaf := func(path string, value interface{}) *valuesLeaf {
+		return &valuesLeaf{segments: strings.Split(path, "."), value: value}
+	}
+	tests := []struct {
+		name    string
+		removed *valuesLeaf
+		added   []*valuesLeaf
+		want    string
+	}{
+		{
+			name:    "same key under unrelated parent",
+			removed: leaf("service.enabled", true),
+			added:   []*valuesLeaf{leaf("serviceMonitor.enabled", true)},
+		},
+		{
+			name:    "key spelled differently under same parent",
+			removed: leaf("image.pullPolicy", "IfNotPresent"),
+			added:   []*valuesLeaf{leaf("serviceMonitor.pullPolicy", "IfNotPresent"), leaf("image.pull_policy", "IfNotPresent")},
+			want:    "image.pull_policy",
+		},
+		{
+			name:    "key moved under a new parent",
+			removed: leaf("resources.limits.cpu", "1"),
+			added:   []*valuesLeaf{leaf("deployment.resources.limits.cpu", "1"), leaf("sidecar.limits.cpu", "1")},
+			want:    "deployment.resources.limits.cpu",
+		},
+		{
+			name:    "ambiguous moved key",
+			removed: leaf("limits.cpu", "1"),
+			added:   []*valuesLeaf{leaf("app.limits.cpu", "1"), leaf("sidecar.limits.cpu", "1")},
+		},
+		{
+			name:    "single key of same parent with same value",
+			removed: leaf("ingress.className", "nginx"),
+			added:   []*valuesLeaf{leaf("ingress.ingressClassName", "nginx")},
+			want:    "ingress.ingressClassName",
+		},
+		{
+			name:    "ambiguous keys of same parent with same value",
+			removed: leaf("ingress.className", "nginx"),
+			added:   []*valuesLeaf{leaf("ingress.ingressClassName", "nginx"), leaf("ingress.defaultClass", "nginx")},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ""
+			if renamed := findRenamedKey(tt.removed, tt.added, map[string]bool{}); renamed != nil {
+				got = strings.Join(renamed.segments, ".")
+			}
+			if got != tt.want {
+				t.Errorf("findRenamedKey() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
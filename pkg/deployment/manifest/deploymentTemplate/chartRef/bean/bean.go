@@ -18,6 +18,7 @@ package bean
 
 import (
 	"encoding/json"
+	"fmt"
 
 	chartRepoRepository "github.com/devtron-labs/devtron/pkg/chartRepo/repository"
 	"github.com/devtron-labs/devtron/pkg/sql"
@@ -40,6 +41,7 @@ type ChartDataInfo struct {
 	ChartName       string `json:"chartName"`
 	ChartVersion    string `json:"chartVersion"`
 	TemporaryFolder string `json:"temporaryFolder"`
+	ChartWorkingDir string `json:"-"` // directory of the extracted chart inside TemporaryFolder
 	Description     string `json:"description"`
 	Message         string `json:"message"`
 }
@@ -147,4 +149,58 @@ const (
 	CHART_YAML_FILE        = "Chart.yaml"
 	REQUIREMENTS_YAML_FILE = "requirements.yaml"
 	VALUES_YAML_FILE       = "values.yaml"
+	APP_VALUES_YAML_FILE   = "app-values.yaml"
+	ENV_VALUES_YAML_FILE   = "env-values.yaml"
+	SCHEMA_JSON_FILE       = "schema.json"
+	README_MD_FILE         = "README.md"
+	TEMPLATES_DIR          = "templates"
 )
+
+type ChartLintReport struct {
+	Errors   []string `json:"errors"`
+	Warnings []string `json:"warnings"`
+}
+
+func NewChartLintReport() *ChartLintReport {
+	return &ChartLintReport{
+		Errors:   make([]string, 0),
+		Warnings: make([]string, 0),
+	}
+}
+
+func (r *ChartLintReport) AddError(format string, args ...interface{}) {
+	r.Errors = append(r.Errors, fmt.Sprintf(format, args...))
+}
+
+func (r *ChartLintReport) AddWarning(format string, args ...interface{}) {
+	r.Warnings = append(r.Warnings, fmt.Sprintf(format, args...))
+}
+
+func (r *ChartLintReport) HasErrors() bool {
+	return len(r.Errors) > 0
+}
+
+type ValuesKeyChangeType string
+
+const (
+	ValuesKeyRemoved ValuesKeyChangeType = "REMOVED"
+	ValuesKeyRenamed ValuesKeyChangeType = "RENAMED"
+)
+
+// ValuesKeyChange is a values key of the current chart version which is not present in the target chart version
+type ValuesKeyChange struct {
+	Path    string              `json:"path"`
+	Type    ValuesKeyChangeType `json:"type"`
+	NewPath string              `json:"newPath,omitempty"`
+	// InUse is true when the key is set in the values being migrated
+	InUse bool `json:"inUse"`
+}
+
+type ChartUpgradeReport struct {
+	OldChartRefId int                `json:"oldChartRefId"`
+	NewChartRefId int                `json:"newChartRefId"`
+	Changes       []*ValuesKeyChange `json:"changes"`
+	AddedKeys     []string           `json:"addedKeys"`
+	// SuggestedValues are the values with renamed keys moved to their new path and removed keys dropped
+	SuggestedValues json.RawMessage `json:"suggestedValues,omitempty"`
+}
@@ -0,0 +1,234 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package lint
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	k8sUtil "github.com/devtron-labs/common-lib/utils/k8s"
+	"github.com/devtron-labs/devtron/api/helm-app/gRPC"
+	helmAppRead "github.com/devtron-labs/devtron/api/helm-app/service/read"
+	"github.com/devtron-labs/devtron/internal/util"
+	clusterBean "github.com/devtron-labs/devtron/pkg/cluster/bean"
+	"github.com/devtron-labs/devtron/pkg/deployment/manifest/deploymentTemplate/chartRef/bean"
+	"github.com/xeipuuv/gojsonschema"
+	"go.uber.org/zap"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/chartutil"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	lintReleaseName      = "chart-lint"
+	lintReleaseNamespace = "default"
+)
+
+var renderedTemplateExtensions = []string{".yaml", ".yml", ".tpl", ".txt"}
+
+type ChartLintService interface {
+	// LintCustomChart runs helm lint style checks, schema checks and a test render with the default values
+	// on an extracted custom chart. Errors in the report should block the upload, warnings are informational.
+	LintCustomChart(ctx context.Context, chartDir string) *bean.ChartLintReport
+}
+
+type ChartLintServiceImpl struct {
+	logger               *zap.SugaredLogger
+	chartTemplateService util.ChartTemplateService
+	helmAppClient        gRPC.HelmAppClient
+	helmAppReadService   helmAppRead.HelmAppReadService
+	K8sUtil              *k8sUtil.K8sServiceImpl
+}
+
+func NewChartLintServiceImpl(logger *zap.SugaredLogger,
+	chartTemplateService util.ChartTemplateService,
+	helmAppClient gRPC.HelmAppClient,
+	helmAppReadService helmAppRead.HelmAppReadService,
+	K8sUtil *k8sUtil.K8sServiceImpl) *ChartLintServiceImpl {
+	return &ChartLintServiceImpl{
+		logger:               logger,
+		chartTemplateService: chartTemplateService,
+		helmAppClient:        helmAppClient,
+		helmAppReadService:   helmAppReadService,
+		K8sUtil:              K8sUtil,
+	}
+}
+
+func (impl *ChartLintServiceImpl) LintCustomChart(ctx context.Context, chartDir string) *bean.ChartLintReport {
+	report := bean.NewChartLintReport()
+	helmChart, err := loader.LoadDir(chartDir)
+	if err != nil {
+		impl.logger.Errorw("error in loading custom chart for lint", "chartDir", chartDir, "err", err)
+		report.AddError("chart could not be loaded: %s", err.Error())
+		return report
+	}
+	impl.lintTemplates(helmChart, report)
+	if err = chartutil.ValidateAgainstSchema(helmChart, helmChart.Values); err != nil {
+		report.AddError("%s does not match values.schema.json: %s", bean.VALUES_YAML_FILE, err.Error())
+	}
+	defaultValues := impl.getDefaultValues(chartDir, report)
+	impl.lintSchema(chartDir, defaultValues, report)
+	if _, err = os.Stat(filepath.Join(chartDir, bean.README_MD_FILE)); os.IsNotExist(err) {
+		report.AddWarning("%s is not present, no readme will be shown in the deployment template editor", bean.README_MD_FILE)
+	}
+	if defaultValues != nil {
+		impl.testRender(ctx, chartDir, helmChart, defaultValues, report)
+	}
+	return report
+}
+
+func (impl *ChartLintServiceImpl) lintTemplates(helmChart *chart.Chart, report *bean.ChartLintReport) {
+	if len(helmChart.Templates) == 0 {
+		report.AddError("chart has no templates in the %s directory", bean.TEMPLATES_DIR)
+		return
+	}
+	for _, template := range helmChart.Templates {
+		ext := filepath.Ext(template.Name)
+		supported := false
+		for _, renderedExt := range renderedTemplateExtensions {
+			if ext == renderedExt {
+				supported = true
+				break
+			}
+		}
+		if !supported {
+			report.AddWarning("template %s should have one of %s extensions", template.Name, strings.Join(renderedTemplateExtensions, ", "))
+		}
+	}
+}
+
+// getDefaultValues returns app-values.yaml overridden by env-values.yaml, which is the default template of an app using the chart.
+// Returns nil if any of them is not valid yaml.
+func (impl *ChartLintServiceImpl) getDefaultValues(chartDir string, report *bean.ChartLintReport) map[string]interface{} {
+	appValues, err := chartutil.ReadValuesFile(filepath.Join(chartDir, bean.APP_VALUES_YAML_FILE))
+	if os.IsNotExist(err) {
+		report.AddWarning("%s is not present, apps using this chart will start with an empty deployment template", bean.APP_VALUES_YAML_FILE)
+		appValues = chartutil.Values{}
+	} else if err != nil {
+		report.AddError("%s is not valid yaml: %s", bean.APP_VALUES_YAML_FILE, err.Error())
+		return nil
+	}
+	envValues, err := chartutil.ReadValuesFile(filepath.Join(chartDir, bean.ENV_VALUES_YAML_FILE))
+	if os.IsNotExist(err) {
+		envValues = chartutil.Values{}
+	} else if err != nil {
+		report.AddError("%s is not valid yaml: %s", bean.ENV_VALUES_YAML_FILE, err.Error())
+		return nil
+	}
+	return chartutil.CoalesceTables(envValues.AsMap(), appValues.AsMap())
+}
+
+func (impl *ChartLintServiceImpl) lintSchema(chartDir string, defaultValues map[string]interface{}, report *bean.ChartLintReport) {
+	schemaBytes, err := os.ReadFile(filepath.Join(chartDir, bean.SCHEMA_JSON_FILE))
+	if os.IsNotExist(err) {
+		report.AddWarning("%s is not present, deployment templates of this chart will not be validated", bean.SCHEMA_JSON_FILE)
+		return
+	} else if err != nil {
+		report.AddError("%s could not be read: %s", bean.SCHEMA_JSON_FILE, err.Error())
+		return
+	}
+	var schema map[string]interface{}
+	if err = json.Unmarshal(schemaBytes, &schema); err != nil {
+		report.AddError("%s is not valid json: %s", bean.SCHEMA_JSON_FILE, err.Error())
+		return
+	}
+	compiledSchema, err := gojsonschema.NewSchema(gojsonschema.NewGoLoader(schema))
+	if err != nil {
+		report.AddError("%s is not a valid json schema: %s", bean.SCHEMA_JSON_FILE, err.Error())
+		return
+	}
+	if defaultValues == nil {
+		return
+	}
+	result, err := compiledSchema.Validate(gojsonschema.NewGoLoader(defaultValues))
+	if err != nil {
+		report.AddError("default values could not be validated against %s: %s", bean.SCHEMA_JSON_FILE, err.Error())
+		return
+	}
+	for _, resultErr := range result.Errors() {
+		report.AddError("default values do not match %s: %s", bean.SCHEMA_JSON_FILE, resultErr.String())
+	}
+}
+
+// testRender renders the chart with the default values through kubelink, the same way deployment manifests are generated.
+// If kubelink is not reachable the render is skipped with a warning.
+func (impl *ChartLintServiceImpl) testRender(ctx context.Context, chartDir string, helmChart *chart.Chart, defaultValues map[string]interface{}, report *bean.ChartLintReport) {
+	chartBytes, err := impl.chartTemplateService.LoadChartInBytes(chartDir, false)
+	if err != nil {
+		report.AddError("chart could not be packaged: %s", err.Error())
+		return
+	}
+	valuesYaml, err := yaml.Marshal(defaultValues)
+	if err != nil {
+		report.AddError("default values could not be marshalled: %s", err.Error())
+		return
+	}
+	k8sServerVersion, err := impl.K8sUtil.GetKubeVersion()
+	if err != nil {
+		impl.logger.Errorw("error in getting k8s server version for chart lint", "err", err)
+		report.AddWarning("test render skipped, kubernetes version could not be fetched: %s", err.Error())
+		return
+	}
+	clusterConfig, err := impl.helmAppReadService.GetClusterConf(clusterBean.DefaultClusterId)
+	if err != nil {
+		impl.logger.Errorw("error in fetching default cluster config for chart lint", "err", err)
+		report.AddWarning("test render skipped, cluster config could not be fetched: %s", err.Error())
+		return
+	}
+	templateChartResponse, err := impl.helmAppClient.TemplateChart(ctx, &gRPC.InstallReleaseRequest{
+		ChartName:    helmChart.Name(),
+		ChartVersion: helmChart.Metadata.Version,
+		ValuesYaml:   string(valuesYaml),
+		K8SVersion:   k8sServerVersion.String(),
+		ReleaseIdentifier: &gRPC.ReleaseIdentifier{
+			ReleaseName:      lintReleaseName,
+			ReleaseNamespace: lintReleaseNamespace,
+			ClusterConfig:    clusterConfig,
+		},
+		ChartContent: &gRPC.ChartContent{Content: chartBytes},
+	})
+	if err != nil {
+		clientErrCode, errMsg := util.GetClientDetailedError(err)
+		if clientErrCode.IsUnavailableCode() || clientErrCode.IsDeadlineExceededCode() {
+			impl.logger.Errorw("error in connecting kubelink for chart lint", "err", err)
+			report.AddWarning("test render skipped, kubelink is not reachable: %s", errMsg)
+			return
+		}
+		report.AddError("chart could not be rendered with default values: %s", errMsg)
+		return
+	}
+	generatedManifest := templateChartResponse.GetGeneratedManifest()
+	if len(strings.TrimSpace(generatedManifest)) == 0 {
+		report.AddWarning("chart renders no manifests with default values")
+		return
+	}
+	objects, err := kube.SplitYAML([]byte(generatedManifest))
+	if err != nil {
+		report.AddError("manifest rendered with default values is not valid yaml: %s", err.Error())
+		return
+	}
+	for _, object := range objects {
+		if len(object.GetKind()) == 0 || len(object.GetAPIVersion()) == 0 || len(object.GetName()) == 0 {
+			report.AddError("rendered manifest has an object without apiVersion, kind or metadata.name: %s", object.GetKind()+"/"+object.GetName())
+		}
+	}
+}
@@ -60,7 +60,7 @@ func (impl *DeploymentTemplateHistoryReadServiceImpl) GetHistoryForDeployedTempl
 		HistoryReferenceId:   history.Id,
 		HistoryReferenceType: repository.HistoryReferenceTypeDeploymentTemplate,
 	}
-	variableSnapshotMap, resolvedTemplate, err := impl.scopedVariableManager.GetVariableSnapshotAndResolveTemplate(history.Template, parsers.JsonVariableTemplate, reference, isSuperAdmin, false)
+	variableSnapshotMap, resolvedTemplate, err := impl.scopedVariableManager.GetVariableSnapshotAndResolveTemplate(history.Template, parsers.JsonVariableTemplate, reference, isSuperAdmin, false, true)
 	if err != nil {
 		impl.logger.Errorw("error while resolving template from history", "err", err, "id", id, "pipelineID", pipelineId)
 	}
@@ -99,7 +99,7 @@ func (impl *DeploymentTemplateHistoryReadServiceImpl) GetDeployedHistoryByPipeli
 		HistoryReferenceId:   history.Id,
 		HistoryReferenceType: repository.HistoryReferenceTypeDeploymentTemplate,
 	}
-	variableSnapshotMap, resolvedTemplate, err := impl.scopedVariableManager.GetVariableSnapshotAndResolveTemplate(history.Template, parsers.JsonVariableTemplate, reference, isSuperAdmin, false)
+	variableSnapshotMap, resolvedTemplate, err := impl.scopedVariableManager.GetVariableSnapshotAndResolveTemplate(history.Template, parsers.JsonVariableTemplate, reference, isSuperAdmin, false, true)
 	if err != nil {
 		impl.logger.Errorw("error while resolving template from history", "err", err, "wfrId", wfrId, "pipelineID", pipelineId)
 	}
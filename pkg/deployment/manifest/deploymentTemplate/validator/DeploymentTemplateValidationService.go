@@ -77,7 +77,7 @@ func (impl *DeploymentTemplateValidationServiceImpl) DeploymentTemplateValidate(
 	}
 
 	templateBytes := template.(json.RawMessage)
-	templatejsonstring, _, err := impl.scopedVariableManager.ExtractVariablesAndResolveTemplate(scope, string(templateBytes), parsers.JsonVariableTemplate, true, false)
+	templatejsonstring, _, err := impl.scopedVariableManager.ExtractVariablesAndResolveTemplate(scope, string(templateBytes), parsers.JsonVariableTemplate, true, false, false)
 	if err != nil {
 		return false, err
 	}
@@ -173,7 +173,13 @@ func (impl *DeploymentTemplateValidationServiceImpl) ValidateChangeChartRefReque
 			return envConfigProperties, false, util.NewApiError(http.StatusUnprocessableEntity, errMsg, errMsg)
 		}
 	}
-	var err error
+	// the upgrade report is only informative, the chart is switched without it if it can not be generated
+	chartUpgradeReport, err := impl.chartRefService.GetChartUpgradeReport(envConfigProperties.ChartRefId, request.TargetChartRefId, envConfigProperties.EnvOverrideValues)
+	if err != nil {
+		impl.logger.Errorw("error in getting chart upgrade report, ValidateChangeChartRefRequest", "err", err, "payload", request)
+	} else {
+		envConfigProperties.ChartUpgradeReport = chartUpgradeReport
+	}
 	envConfigProperties.EnvOverrideValues, err = impl.chartRefService.PerformChartSpecificPatchForSwitch(envConfigProperties.EnvOverrideValues, chartChangeType)
 	if err != nil {
 		impl.logger.Errorw("error in chart specific patch operations, ValidateChangeChartRefRequest", "err", err, "payload", request)
@@ -18,6 +18,7 @@ package deploymentTemplate
 
 import (
 	"github.com/devtron-labs/devtron/pkg/deployment/manifest/deploymentTemplate/chartRef"
+	"github.com/devtron-labs/devtron/pkg/deployment/manifest/deploymentTemplate/chartRef/lint"
 	chartRefRead "github.com/devtron-labs/devtron/pkg/deployment/manifest/deploymentTemplate/chartRef/read"
 	"github.com/devtron-labs/devtron/pkg/deployment/manifest/deploymentTemplate/read"
 	"github.com/devtron-labs/devtron/pkg/deployment/manifest/deploymentTemplate/validator"
@@ -40,6 +41,8 @@ var DeploymentTemplateWireSet = wire.NewSet(
 
 	chartRef.NewChartRefServiceImpl,
 	wire.Bind(new(chartRef.ChartRefService), new(*chartRef.ChartRefServiceImpl)),
+	lint.NewChartLintServiceImpl,
+	wire.Bind(new(lint.ChartLintService), new(*lint.ChartLintServiceImpl)),
 	read.NewDeploymentTemplateHistoryReadServiceImpl,
 	wire.Bind(new(read.DeploymentTemplateHistoryReadService), new(*read.DeploymentTemplateHistoryReadServiceImpl)),
 )
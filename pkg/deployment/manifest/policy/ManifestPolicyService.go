@@ -0,0 +1,319 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package policy
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/devtron-labs/devtron/cel"
+	"github.com/devtron-labs/devtron/internal/util"
+	"github.com/devtron-labs/devtron/pkg/cluster/environment/repository"
+	"github.com/devtron-labs/devtron/pkg/deployment/manifest/policy/bean"
+	policyRepository "github.com/devtron-labs/devtron/pkg/deployment/manifest/policy/repository"
+	"github.com/go-pg/pg"
+	"go.uber.org/zap"
+)
+
+type ManifestPolicyService interface {
+	CreatePolicy(request *bean.ManifestPolicyDto) (*bean.ManifestPolicyDto, error)
+	UpdatePolicy(request *bean.ManifestPolicyDto) (*bean.ManifestPolicyDto, error)
+	DeletePolicy(id int, userId int32) error
+	GetPolicy(id int) (*bean.ManifestPolicyDto, error)
+	GetPolicies() ([]*bean.ManifestPolicyDto, error)
+	// HasApplicablePolicies lets callers skip rendering the manifest when no policy is scoped to the target
+	HasApplicablePolicies(envId, clusterId int) (bool, error)
+	// EvaluateManifest evaluates every policy applicable to the scope against each object of the rendered manifest
+	EvaluateManifest(manifest string, scope *bean.PolicyScope) (*bean.PolicyEvaluationResult, error)
+	EvaluateManifestForRequest(request *bean.ManifestPolicyEvaluateRequest) (*bean.PolicyEvaluationResult, error)
+}
+
+type ManifestPolicyServiceImpl struct {
+	logger                   *zap.SugaredLogger
+	manifestPolicyRepository policyRepository.ManifestPolicyRepository
+	environmentRepository    repository.EnvironmentRepository
+	engines                  map[bean.PolicyEngineType]PolicyEngine
+}
+
+func NewManifestPolicyServiceImpl(logger *zap.SugaredLogger,
+	manifestPolicyRepository policyRepository.ManifestPolicyRepository,
+	environmentRepository repository.EnvironmentRepository,
+	celEvaluatorService cel.EvaluatorService) *ManifestPolicyServiceImpl {
+	return &ManifestPolicyServiceImpl{
+		logger:                   logger,
+		manifestPolicyRepository: manifestPolicyRepository,
+		environmentRepository:    environmentRepository,
+		// rego policies need an OPA runtime which is not bundled, they are rejected on save
+		engines: map[bean.PolicyEngineType]PolicyEngine{
+			bean.PolicyEngineCEL: newCelPolicyEngine(celEvaluatorService),
+		},
+	}
+}
+
+func (impl *ManifestPolicyServiceImpl) CreatePolicy(request *bean.ManifestPolicyDto) (*bean.ManifestPolicyDto, error) {
+	err := impl.validatePolicy(request)
+	if err != nil {
+		return nil, err
+	}
+	existing, err := impl.manifestPolicyRepository.FindActiveByName(request.Name)
+	if err != nil && !errors.Is(err, pg.ErrNoRows) {
+		impl.logger.Errorw("error in fetching manifest policy by name", "name", request.Name, "err", err)
+		return nil, err
+	} else if existing != nil && existing.Id > 0 {
+		errMsg := fmt.Sprintf("manifest policy with name %q already exists", request.Name)
+		return nil, util.NewApiError(http.StatusConflict, errMsg, errMsg)
+	}
+	policy := &policyRepository.ManifestPolicy{Active: true}
+	adaptPolicyDto(request, policy)
+	policy.CreateAuditLog(request.UserId)
+	err = impl.manifestPolicyRepository.Save(policy)
+	if err != nil {
+		impl.logger.Errorw("error in saving manifest policy", "policy", policy, "err", err)
+		return nil, err
+	}
+	return adaptPolicy(policy), nil
+}
+
+func (impl *ManifestPolicyServiceImpl) UpdatePolicy(request *bean.ManifestPolicyDto) (*bean.ManifestPolicyDto, error) {
+	err := impl.validatePolicy(request)
+	if err != nil {
+		return nil, err
+	}
+	policy, err := impl.getPolicyById(request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if policy.Name != request.Name {
+		existing, err := impl.manifestPolicyRepository.FindActiveByName(request.Name)
+		if err != nil && !errors.Is(err, pg.ErrNoRows) {
+			impl.logger.Errorw("error in fetching manifest policy by name", "name", request.Name, "err", err)
+			return nil, err
+		} else if existing != nil && existing.Id > 0 {
+			errMsg := fmt.Sprintf("manifest policy with name %q already exists", request.Name)
+			return nil, util.NewApiError(http.StatusConflict, errMsg, errMsg)
+		}
+	}
+	adaptPolicyDto(request, policy)
+	policy.UpdateAuditLog(request.UserId)
+	err = impl.manifestPolicyRepository.Update(policy)
+	if err != nil {
+		impl.logger.Errorw("error in updating manifest policy", "policy", policy, "err", err)
+		return nil, err
+	}
+	return adaptPolicy(policy), nil
+}
+
+func (impl *ManifestPolicyServiceImpl) DeletePolicy(id int, userId int32) error {
+	policy, err := impl.getPolicyById(id)
+	if err != nil {
+		return err
+	}
+	policy.Active = false
+	policy.UpdateAuditLog(userId)
+	err = impl.manifestPolicyRepository.Update(policy)
+	if err != nil {
+		impl.logger.Errorw("error in deleting manifest policy", "id", id, "err", err)
+		return err
+	}
+	return nil
+}
+
+func (impl *ManifestPolicyServiceImpl) GetPolicy(id int) (*bean.ManifestPolicyDto, error) {
+	policy, err := impl.getPolicyById(id)
+	if err != nil {
+		return nil, err
+	}
+	return adaptPolicy(policy), nil
+}
+
+func (impl *ManifestPolicyServiceImpl) GetPolicies() ([]*bean.ManifestPolicyDto, error) {
+	policies, err := impl.manifestPolicyRepository.FindAllActive()
+	if err != nil {
+		impl.logger.Errorw("error in fetching manifest policies", "err", err)
+		return nil, err
+	}
+	result := make([]*bean.ManifestPolicyDto, 0, len(policies))
+	for _, policy := range policies {
+		result = append(result, adaptPolicy(policy))
+	}
+	return result, nil
+}
+
+func (impl *ManifestPolicyServiceImpl) HasApplicablePolicies(envId, clusterId int) (bool, error) {
+	policies, err := impl.getApplicablePolicies(envId, clusterId)
+	if err != nil {
+		return false, err
+	}
+	return len(policies) > 0, nil
+}
+
+func (impl *ManifestPolicyServiceImpl) EvaluateManifest(manifest string, scope *bean.PolicyScope) (*bean.PolicyEvaluationResult, error) {
+	policies, err := impl.getApplicablePolicies(scope.EnvId, scope.ClusterId)
+	if err != nil {
+		return nil, err
+	}
+	return impl.evaluate(policies, manifest, scope)
+}
+
+func (impl *ManifestPolicyServiceImpl) EvaluateManifestForRequest(request *bean.ManifestPolicyEvaluateRequest) (*bean.PolicyEvaluationResult, error) {
+	env, err := impl.environmentRepository.FindById(request.EnvId)
+	if err != nil {
+		impl.logger.Errorw("error in fetching environment", "envId", request.EnvId, "err", err)
+		if errors.Is(err, pg.ErrNoRows) {
+			return nil, util.NewApiError(http.StatusNotFound, "environment not found", err.Error())
+		}
+		return nil, err
+	}
+	scope := &bean.PolicyScope{
+		AppName:   request.AppName,
+		EnvId:     env.Id,
+		EnvName:   env.Name,
+		ClusterId: env.ClusterId,
+		Namespace: env.Namespace,
+	}
+	if env.Cluster != nil {
+		scope.ClusterName = env.Cluster.ClusterName
+	}
+	if request.PolicyId == 0 {
+		return impl.EvaluateManifest(request.Manifest, scope)
+	}
+	policy, err := impl.getPolicyById(request.PolicyId)
+	if err != nil {
+		return nil, err
+	}
+	return impl.evaluate([]*policyRepository.ManifestPolicy{policy}, request.Manifest, scope)
+}
+
+func (impl *ManifestPolicyServiceImpl) evaluate(policies []*policyRepository.ManifestPolicy, manifest string, scope *bean.PolicyScope) (*bean.PolicyEvaluationResult, error) {
+	result := &bean.PolicyEvaluationResult{Violations: make([]*bean.PolicyViolation, 0)}
+	if len(policies) == 0 {
+		return result, nil
+	}
+	objects, err := kube.SplitYAML([]byte(manifest))
+	if err != nil {
+		impl.logger.Errorw("error in splitting rendered manifest", "scope", scope, "err", err)
+		return nil, util.NewApiError(http.StatusBadRequest, "invalid manifest", err.Error())
+	}
+	for _, policy := range policies {
+		engine, ok := impl.engines[policy.Engine]
+		if !ok {
+			// policies are validated on save, reaching here means the engine was removed after the policy was created
+			impl.logger.Warnw("skipping manifest policy with unsupported engine", "policyId", policy.Id, "engine", policy.Engine)
+			continue
+		}
+		for _, object := range objects {
+			if !policy.AppliesToKind(object.GetKind()) {
+				continue
+			}
+			passed, err := engine.Evaluate(policy.Expression, object.Object, scope)
+			if passed && err == nil {
+				continue
+			}
+			message := policy.Message
+			if err != nil {
+				// a rule which cannot be evaluated is reported as a violation so that enforce mode fails closed
+				message = fmt.Sprintf("policy could not be evaluated: %s", err.Error())
+			} else if len(message) == 0 {
+				message = fmt.Sprintf("expression %q evaluated to false", policy.Expression)
+			}
+			result.Violations = append(result.Violations, &bean.PolicyViolation{
+				PolicyId:   policy.Id,
+				PolicyName: policy.Name,
+				Mode:       policy.Mode,
+				Kind:       object.GetKind(),
+				Name:       object.GetName(),
+				Namespace:  object.GetNamespace(),
+				Message:    message,
+			})
+		}
+	}
+	return result, nil
+}
+
+func (impl *ManifestPolicyServiceImpl) getApplicablePolicies(envId, clusterId int) ([]*policyRepository.ManifestPolicy, error) {
+	policies, err := impl.manifestPolicyRepository.FindAllActive()
+	if err != nil {
+		impl.logger.Errorw("error in fetching manifest policies", "envId", envId, "clusterId", clusterId, "err", err)
+		return nil, err
+	}
+	applicable := make([]*policyRepository.ManifestPolicy, 0, len(policies))
+	for _, policy := range policies {
+		if policy.IsApplicable(envId, clusterId) {
+			applicable = append(applicable, policy)
+		}
+	}
+	return applicable, nil
+}
+
+func (impl *ManifestPolicyServiceImpl) getPolicyById(id int) (*policyRepository.ManifestPolicy, error) {
+	policy, err := impl.manifestPolicyRepository.FindById(id)
+	if err != nil {
+		impl.logger.Errorw("error in fetching manifest policy", "id", id, "err", err)
+		if errors.Is(err, pg.ErrNoRows) {
+			return nil, util.NewApiError(http.StatusNotFound, "manifest policy not found", err.Error())
+		}
+		return nil, err
+	}
+	return policy, nil
+}
+
+func (impl *ManifestPolicyServiceImpl) validatePolicy(request *bean.ManifestPolicyDto) error {
+	if !request.Mode.IsValid() {
+		errMsg := fmt.Sprintf("invalid policy mode %q, supported modes are %s and %s", request.Mode, bean.PolicyModeAudit, bean.PolicyModeEnforce)
+		return util.NewApiError(http.StatusBadRequest, errMsg, errMsg)
+	}
+	engine, ok := impl.engines[request.Engine]
+	if !ok {
+		errMsg := fmt.Sprintf("policy engine %q is not supported", request.Engine)
+		return util.NewApiError(http.StatusBadRequest, errMsg, errMsg)
+	}
+	err := engine.Validate(request.Expression)
+	if err != nil {
+		impl.logger.Errorw("invalid manifest policy expression", "expression", request.Expression, "err", err)
+		return util.NewApiError(http.StatusBadRequest, fmt.Sprintf("invalid expression: %s", err.Error()), err.Error())
+	}
+	return nil
+}
+
+func adaptPolicyDto(request *bean.ManifestPolicyDto, policy *policyRepository.ManifestPolicy) {
+	policy.Name = request.Name
+	policy.Description = request.Description
+	policy.Engine = request.Engine
+	policy.Expression = request.Expression
+	policy.Message = request.Message
+	policy.Mode = request.Mode
+	policy.Kinds = request.Kinds
+	policy.EnvIds = request.EnvIds
+	policy.ClusterIds = request.ClusterIds
+}
+
+func adaptPolicy(policy *policyRepository.ManifestPolicy) *bean.ManifestPolicyDto {
+	return &bean.ManifestPolicyDto{
+		Id:          policy.Id,
+		Name:        policy.Name,
+		Description: policy.Description,
+		Engine:      policy.Engine,
+		Expression:  policy.Expression,
+		Message:     policy.Message,
+		Mode:        policy.Mode,
+		Kinds:       policy.Kinds,
+		EnvIds:      policy.EnvIds,
+		ClusterIds:  policy.ClusterIds,
+	}
+}
@@ -0,0 +1,86 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package policy
+
+import (
+	"testing"
+
+	"github.com/devtron-labs/devtron/cel"
+	"github.com/devtron-labs/devtron/pkg/deployment/manifest/policy/bean"
+	"github.com/devtron-labs/devtron/pkg/deployment/manifest/policy/repository"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+const testManifest = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+  labels:
+    team: payments
+spec:
+  template:
+    spec:
+      containers:
+        - name: web
+          securityContext:
+            privileged: true
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: web
+`
+
+func TestManifestPolicyEvaluate(t *testing.T) {
+	logger := zap.NewNop().Sugar()
+	impl := NewManifestPolicyServiceImpl(logger, nil, nil, cel.NewCELServiceImpl(logger))
+	policies := []*repository.ManifestPolicy{
+		{
+			Id:         1,
+			Name:       "no-privileged",
+			Engine:     bean.PolicyEngineCEL,
+			Expression: "object.spec.template.spec.containers.all(c, !has(c.securityContext) || !has(c.securityContext.privileged) || !c.securityContext.privileged)",
+			Mode:       bean.PolicyModeEnforce,
+			Kinds:      []string{"Deployment"},
+		},
+		{
+			Id:         2,
+			Name:       "team-label",
+			Engine:     bean.PolicyEngineCEL,
+			Expression: "has(object.metadata.labels) && 'team' in object.metadata.labels",
+			Message:    "team label is required",
+			Mode:       bean.PolicyModeAudit,
+		},
+	}
+	result, err := impl.evaluate(policies, testManifest, &bean.PolicyScope{EnvName: "prod"})
+	assert.NoError(t, err)
+	assert.Len(t, result.Violations, 2)
+	assert.Equal(t, "no-privileged", result.Violations[0].PolicyName)
+	assert.Equal(t, "Deployment", result.Violations[0].Kind)
+	assert.Equal(t, "team-label", result.Violations[1].PolicyName)
+	assert.Equal(t, "Service", result.Violations[1].Kind)
+	assert.Equal(t, "team label is required", result.Violations[1].Message)
+	assert.Len(t, result.GetEnforcedViolations(), 1)
+
+	assert.NoError(t, impl.validatePolicy(adaptPolicy(policies[1])))
+	invalid := &bean.ManifestPolicyDto{Engine: bean.PolicyEngineCEL, Expression: "size(object)", Mode: bean.PolicyModeAudit}
+	assert.Error(t, impl.validatePolicy(invalid))
+	rego := &bean.ManifestPolicyDto{Engine: bean.PolicyEngineRego, Expression: "deny[msg] { true }", Mode: bean.PolicyModeAudit}
+	assert.Error(t, impl.validatePolicy(rego))
+}
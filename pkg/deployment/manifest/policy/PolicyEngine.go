@@ -0,0 +1,78 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package policy
+
+import (
+	"fmt"
+
+	"github.com/devtron-labs/devtron/cel"
+	"github.com/devtron-labs/devtron/pkg/deployment/manifest/policy/bean"
+	celgo "github.com/google/cel-go/cel"
+)
+
+// PolicyEngine evaluates a rule against a single rendered kubernetes object,
+// engines are looked up by bean.PolicyEngineType so new rule languages can be plugged in
+type PolicyEngine interface {
+	// Validate compiles the rule without evaluating it
+	Validate(expression string) error
+	// Evaluate returns true when the object satisfies the rule
+	Evaluate(expression string, object map[string]interface{}, scope *bean.PolicyScope) (bool, error)
+}
+
+const (
+	manifestObjectParam cel.ParamName = "object"
+	namespaceParam      cel.ParamName = "namespace"
+)
+
+type celPolicyEngine struct {
+	celEvaluatorService cel.EvaluatorService
+}
+
+func newCelPolicyEngine(celEvaluatorService cel.EvaluatorService) *celPolicyEngine {
+	return &celPolicyEngine{celEvaluatorService: celEvaluatorService}
+}
+
+func (engine *celPolicyEngine) Validate(expression string) error {
+	ast, _, err := engine.celEvaluatorService.Validate(engine.buildRequest(expression, nil, &bean.PolicyScope{}))
+	if err != nil {
+		return err
+	}
+	if outputType := ast.OutputType(); !outputType.IsExactType(celgo.BoolType) && !outputType.IsExactType(celgo.DynType) {
+		return fmt.Errorf("expression must evaluate to a boolean, found %s", outputType.String())
+	}
+	return nil
+}
+
+func (engine *celPolicyEngine) Evaluate(expression string, object map[string]interface{}, scope *bean.PolicyScope) (bool, error) {
+	return engine.celEvaluatorService.EvaluateCELRequest(engine.buildRequest(expression, object, scope))
+}
+
+// buildRequest exposes the rendered object as `object` along with the deployment target names
+func (engine *celPolicyEngine) buildRequest(expression string, object map[string]interface{}, scope *bean.PolicyScope) cel.Request {
+	return cel.Request{
+		Expression: expression,
+		ExpressionMetadata: cel.ExpressionMetadata{
+			Params: []cel.ExpressionParam{
+				{ParamName: manifestObjectParam, Value: object, Type: cel.ParamTypeMapStringToAny},
+				{ParamName: cel.AppName, Value: scope.AppName, Type: cel.ParamTypeString},
+				{ParamName: cel.EnvName, Value: scope.EnvName, Type: cel.ParamTypeString},
+				{ParamName: cel.ClusterName, Value: scope.ClusterName, Type: cel.ParamTypeString},
+				{ParamName: namespaceParam, Value: scope.Namespace, Type: cel.ParamTypeString},
+			},
+		},
+	}
+}
@@ -0,0 +1,121 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package bean
+
+import (
+	"fmt"
+	"strings"
+)
+
+type PolicyEngineType string
+
+const (
+	PolicyEngineCEL  PolicyEngineType = "CEL"
+	PolicyEngineRego PolicyEngineType = "REGO"
+)
+
+type PolicyMode string
+
+const (
+	// PolicyModeAudit records violations on the deployment timeline without blocking the deployment
+	PolicyModeAudit PolicyMode = "AUDIT"
+	// PolicyModeEnforce fails the deployment when a violation is found
+	PolicyModeEnforce PolicyMode = "ENFORCE"
+)
+
+func (mode PolicyMode) IsValid() bool {
+	return mode == PolicyModeAudit || mode == PolicyModeEnforce
+}
+
+type ManifestPolicyDto struct {
+	Id          int              `json:"id"`
+	Name        string           `json:"name" validate:"required,max=250"`
+	Description string           `json:"description"`
+	Engine      PolicyEngineType `json:"engine" validate:"required"`
+	// Expression must evaluate to true for every rendered object the policy applies to
+	Expression string     `json:"expression" validate:"required"`
+	Message    string     `json:"message"`
+	Mode       PolicyMode `json:"mode" validate:"required"`
+	// Kinds restricts the policy to the given kubernetes kinds, empty applies to all kinds
+	Kinds []string `json:"kinds"`
+	// EnvIds and ClusterIds scope the policy, a policy without either applies to every deployment
+	EnvIds     []int `json:"envIds"`
+	ClusterIds []int `json:"clusterIds"`
+	UserId     int32 `json:"-"`
+}
+
+// PolicyScope describes the deployment target a rendered manifest is evaluated for
+type PolicyScope struct {
+	AppName     string
+	EnvId       int
+	EnvName     string
+	ClusterId   int
+	ClusterName string
+	Namespace   string
+}
+
+type ManifestPolicyEvaluateRequest struct {
+	Manifest string `json:"manifest" validate:"required"`
+	AppName  string `json:"appName"`
+	EnvId    int    `json:"envId" validate:"required,number,gt=0"`
+	// PolicyId evaluates a single policy, irrespective of its scope, when set
+	PolicyId int `json:"policyId"`
+}
+
+type PolicyViolation struct {
+	PolicyId   int        `json:"policyId"`
+	PolicyName string     `json:"policyName"`
+	Mode       PolicyMode `json:"mode"`
+	Kind       string     `json:"kind"`
+	Name       string     `json:"name"`
+	Namespace  string     `json:"namespace,omitempty"`
+	Message    string     `json:"message"`
+}
+
+func (v *PolicyViolation) String() string {
+	return fmt.Sprintf("[%s] %s %s/%s: %s", v.PolicyName, v.Mode, v.Kind, v.Name, v.Message)
+}
+
+type PolicyEvaluationResult struct {
+	Violations []*PolicyViolation `json:"violations"`
+}
+
+func (r *PolicyEvaluationResult) HasViolations() bool {
+	return r != nil && len(r.Violations) > 0
+}
+
+func (r *PolicyEvaluationResult) GetEnforcedViolations() []*PolicyViolation {
+	if r == nil {
+		return nil
+	}
+	var enforced []*PolicyViolation
+	for _, violation := range r.Violations {
+		if violation.Mode == PolicyModeEnforce {
+			enforced = append(enforced, violation)
+		}
+	}
+	return enforced
+}
+
+// FormatViolations renders one violation per line, used for timeline details and error messages
+func FormatViolations(violations []*PolicyViolation) string {
+	lines := make([]string, 0, len(violations))
+	for _, violation := range violations {
+		lines = append(lines, violation.String())
+	}
+	return strings.Join(lines, "\n")
+}
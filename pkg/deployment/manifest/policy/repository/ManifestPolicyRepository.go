@@ -0,0 +1,107 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package repository
+
+import (
+	"slices"
+
+	"github.com/devtron-labs/devtron/pkg/deployment/manifest/policy/bean"
+	"github.com/devtron-labs/devtron/pkg/sql"
+	"github.com/go-pg/pg"
+	"go.uber.org/zap"
+)
+
+type ManifestPolicy struct {
+	tableName   struct{}              `sql:"manifest_policy" pg:",discard_unknown_columns"`
+	Id          int                   `sql:"id,pk"`
+	Name        string                `sql:"name,notnull"`
+	Description string                `sql:"description"`
+	Engine      bean.PolicyEngineType `sql:"engine,notnull"`
+	Expression  string                `sql:"expression,notnull"`
+	Message     string                `sql:"message"`
+	Mode        bean.PolicyMode       `sql:"mode,notnull"`
+	Kinds       []string              `sql:"kinds" pg:",array"`
+	EnvIds      []int                 `sql:"env_ids" pg:",array"`
+	ClusterIds  []int                 `sql:"cluster_ids" pg:",array"`
+	Active      bool                  `sql:"active,notnull"`
+	sql.AuditLog
+}
+
+// IsApplicable checks the policy scope, a policy without env and cluster scope applies everywhere,
+// otherwise it applies to the listed environments and to every environment of the listed clusters
+func (p *ManifestPolicy) IsApplicable(envId, clusterId int) bool {
+	if len(p.EnvIds) == 0 && len(p.ClusterIds) == 0 {
+		return true
+	}
+	return slices.Contains(p.EnvIds, envId) || slices.Contains(p.ClusterIds, clusterId)
+}
+
+func (p *ManifestPolicy) AppliesToKind(kind string) bool {
+	return len(p.Kinds) == 0 || slices.Contains(p.Kinds, kind)
+}
+
+type ManifestPolicyRepository interface {
+	Save(policy *ManifestPolicy) error
+	Update(policy *ManifestPolicy) error
+	FindById(id int) (*ManifestPolicy, error)
+	FindActiveByName(name string) (*ManifestPolicy, error)
+	FindAllActive() ([]*ManifestPolicy, error)
+}
+
+type ManifestPolicyRepositoryImpl struct {
+	dbConnection *pg.DB
+	logger       *zap.SugaredLogger
+}
+
+func NewManifestPolicyRepositoryImpl(dbConnection *pg.DB, logger *zap.SugaredLogger) *ManifestPolicyRepositoryImpl {
+	return &ManifestPolicyRepositoryImpl{dbConnection: dbConnection, logger: logger}
+}
+
+func (impl *ManifestPolicyRepositoryImpl) Save(policy *ManifestPolicy) error {
+	return impl.dbConnection.Insert(policy)
+}
+
+func (impl *ManifestPolicyRepositoryImpl) Update(policy *ManifestPolicy) error {
+	return impl.dbConnection.Update(policy)
+}
+
+func (impl *ManifestPolicyRepositoryImpl) FindById(id int) (*ManifestPolicy, error) {
+	policy := &ManifestPolicy{}
+	err := impl.dbConnection.Model(policy).
+		Where("id = ?", id).
+		Where("active = ?", true).
+		Select()
+	return policy, err
+}
+
+func (impl *ManifestPolicyRepositoryImpl) FindActiveByName(name string) (*ManifestPolicy, error) {
+	policy := &ManifestPolicy{}
+	err := impl.dbConnection.Model(policy).
+		Where("name = ?", name).
+		Where("active = ?", true).
+		Select()
+	return policy, err
+}
+
+func (impl *ManifestPolicyRepositoryImpl) FindAllActive() ([]*ManifestPolicy, error) {
+	var policies []*ManifestPolicy
+	err := impl.dbConnection.Model(&policies).
+		Where("active = ?", true).
+		Order("id ASC").
+		Select()
+	return policies, err
+}
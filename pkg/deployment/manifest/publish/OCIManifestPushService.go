@@ -0,0 +1,362 @@
+/*
+ * Copyright (c) 2024. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package publish
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	registryUtil "github.com/devtron-labs/common-lib/utils/registry"
+	"github.com/devtron-labs/devtron/client/argocdServer"
+	"github.com/devtron-labs/devtron/internal/sql/repository/chartConfig"
+	dockerRegistryRepository "github.com/devtron-labs/devtron/internal/sql/repository/dockerRegistry"
+	"github.com/devtron-labs/devtron/internal/sql/repository/pipelineConfig"
+	"github.com/devtron-labs/devtron/internal/sql/repository/pipelineConfig/bean/timelineStatus"
+	"github.com/devtron-labs/devtron/internal/util"
+	"github.com/devtron-labs/devtron/pkg/app/bean"
+	"github.com/devtron-labs/devtron/pkg/app/status"
+	"github.com/devtron-labs/devtron/pkg/sql"
+	"github.com/opencontainers/image-spec/specs-go"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"go.opentelemetry.io/otel"
+	"go.uber.org/zap"
+	"helm.sh/helm/v3/pkg/chartutil"
+	"net/http"
+	"oras.land/oras-go/v2/content"
+	"oras.land/oras-go/v2/errdef"
+	"oras.land/oras-go/v2/registry/remote"
+	"oras.land/oras-go/v2/registry/remote/auth"
+	"oras.land/oras-go/v2/registry/remote/retry"
+	"os"
+	"path"
+	"path/filepath"
+	sigsYaml "sigs.k8s.io/yaml"
+	"time"
+)
+
+const (
+	HelmChartConfigMediaType       = "application/vnd.cncf.helm.config.v1+json"
+	HelmChartContentLayerMediaType = "application/vnd.cncf.helm.chart.content.v1.tar+gzip"
+
+	registryConnectionInsecure       = "insecure"
+	registryConnectionSecureWithCert = "secure-with-cert"
+)
+
+type OCIPushService interface {
+	ManifestPushService
+}
+
+// OCIManifestPushServiceImpl packages the rendered chart along with the merged values and pushes it
+// as a helm OCI artifact to the container registry configured in manifest_push_config.
+// ArgoCd consumes the pushed chart as an OCI helm source, so no git repository is involved.
+type OCIManifestPushServiceImpl struct {
+	logger                        *zap.SugaredLogger
+	pipelineStatusTimelineService status.PipelineStatusTimelineService
+	pipelineOverrideRepository    chartConfig.PipelineOverrideRepository
+	dockerArtifactStoreRepository dockerRegistryRepository.DockerArtifactStoreRepository
+	argoClientWrapperService      argocdServer.ArgoClientWrapperService
+	acdConfig                     *argocdServer.ACDConfig
+	chartTemplateService          util.ChartTemplateService
+	*sql.TransactionUtilImpl
+}
+
+func NewOCIManifestPushServiceImpl(logger *zap.SugaredLogger,
+	pipelineStatusTimelineService status.PipelineStatusTimelineService,
+	pipelineOverrideRepository chartConfig.PipelineOverrideRepository,
+	dockerArtifactStoreRepository dockerRegistryRepository.DockerArtifactStoreRepository,
+	argoClientWrapperService argocdServer.ArgoClientWrapperService,
+	acdConfig *argocdServer.ACDConfig,
+	chartTemplateService util.ChartTemplateService,
+	transactionUtilImpl *sql.TransactionUtilImpl) *OCIManifestPushServiceImpl {
+	return &OCIManifestPushServiceImpl{
+		logger:                        logger,
+		pipelineStatusTimelineService: pipelineStatusTimelineService,
+		pipelineOverrideRepository:    pipelineOverrideRepository,
+		dockerArtifactStoreRepository: dockerArtifactStoreRepository,
+		argoClientWrapperService:      argoClientWrapperService,
+		acdConfig:                     acdConfig,
+		chartTemplateService:          chartTemplateService,
+		TransactionUtilImpl:           transactionUtilImpl,
+	}
+}
+
+func (impl *OCIManifestPushServiceImpl) PushChart(ctx context.Context, manifestPushTemplate *bean.ManifestPushTemplate) bean.ManifestPushResponse {
+	newCtx, span := otel.Tracer("orchestrator").Start(ctx, "OCIManifestPushServiceImpl.PushChart")
+	defer span.End()
+	manifestPushResponse := bean.ManifestPushResponse{}
+	// 1. Fetch the container registry configured for the pipeline
+	dockerArtifactStore, ociRegistryConfig, err := impl.getChartRegistry(manifestPushTemplate.HelmRepositoryConfig)
+	if err != nil {
+		impl.logger.Errorw("error in fetching container registry for oci chart push", "helmRepositoryConfig", manifestPushTemplate.HelmRepositoryConfig, "err", err)
+		manifestPushResponse.Error = err
+		impl.SaveTimelineForError(manifestPushTemplate, err)
+		return manifestPushResponse
+	}
+	// 2. Package chart with the merged values
+	chartBytes, chartMetadataBytes, err := impl.packageChartWithValues(manifestPushTemplate)
+	if err != nil {
+		impl.logger.Errorw("error in packaging chart for oci push", "builtChartPath", manifestPushTemplate.BuiltChartPath, "err", err)
+		manifestPushResponse.Error = err
+		impl.SaveTimelineForError(manifestPushTemplate, err)
+		return manifestPushResponse
+	}
+	// 3. Push chart to the OCI registry
+	digest, err := impl.pushChartToOCIRegistry(newCtx, manifestPushTemplate, dockerArtifactStore, chartBytes, chartMetadataBytes)
+	if err != nil {
+		impl.logger.Errorw("error in pushing chart to oci registry", "repository", manifestPushTemplate.ChartLocation, "version", manifestPushTemplate.ChartVersion, "err", err)
+		manifestPushResponse.Error = err
+		impl.SaveTimelineForError(manifestPushTemplate, err)
+		return manifestPushResponse
+	}
+	// 4. Register the chart repository in ArgoCd, so that the application can pull the chart
+	err = impl.argoClientWrapperService.AddOrUpdateOCIRegistry(dockerArtifactStore.Username, dockerArtifactStore.Password.String(),
+		ociRegistryConfig.Id, dockerArtifactStore.RegistryURL, path.Join(manifestPushTemplate.HelmRepositoryConfig.RepositoryName, manifestPushTemplate.ChartName), ociRegistryConfig.IsPublic)
+	if err != nil {
+		impl.logger.Errorw("error in registering oci chart repository in argocd", "dockerArtifactStoreId", dockerArtifactStore.Id, "err", err)
+		manifestPushResponse.Error = err
+		impl.SaveTimelineForError(manifestPushTemplate, err)
+		return manifestPushResponse
+	}
+	manifestPushResponse.CommitHash = digest
+	manifestPushResponse.CommitTime = time.Now()
+	// 5. Update chart details in PipelineConfigOverride and Deployment Status Timelines
+	tx, err := impl.TransactionUtilImpl.StartTx()
+	defer impl.TransactionUtilImpl.RollbackTx(tx)
+	if err != nil {
+		impl.logger.Errorw("error in transaction begin in saving oci push timeline", "err", err)
+		manifestPushResponse.Error = err
+		impl.SaveTimelineForError(manifestPushTemplate, err)
+		return manifestPushResponse
+	}
+	err = impl.pipelineOverrideRepository.UpdateCommitDetails(newCtx, tx, manifestPushTemplate.PipelineOverrideId, manifestPushResponse.CommitHash, manifestPushResponse.CommitTime, manifestPushTemplate.UserId)
+	if err != nil {
+		impl.logger.Errorw("error in updating chart digest to PipelineConfigOverride", "pipelineOverrideId", manifestPushTemplate.PipelineOverrideId, "err", err)
+		manifestPushResponse.Error = err
+		impl.SaveTimelineForError(manifestPushTemplate, err)
+		return manifestPushResponse
+	}
+	chartPushTimeline := impl.pipelineStatusTimelineService.NewDevtronAppPipelineStatusTimelineDbObject(manifestPushTemplate.WorkflowRunnerId, timelineStatus.TIMELINE_STATUS_GIT_COMMIT, timelineStatus.TIMELINE_DESCRIPTION_OCI_CHART_PUSH, manifestPushTemplate.UserId)
+	timelines := []*pipelineConfig.PipelineStatusTimeline{chartPushTimeline}
+	if impl.acdConfig.IsManualSyncEnabled() && manifestPushTemplate.ArgoSyncNeeded {
+		argoCDSyncInitiatedTimeline := impl.pipelineStatusTimelineService.NewDevtronAppPipelineStatusTimelineDbObject(manifestPushTemplate.WorkflowRunnerId, timelineStatus.TIMELINE_STATUS_ARGOCD_SYNC_INITIATED, timelineStatus.TIMELINE_DESCRIPTION_ARGOCD_SYNC_INITIATED, manifestPushTemplate.UserId)
+		timelines = append(timelines, argoCDSyncInitiatedTimeline)
+	}
+	timelineErr := impl.pipelineStatusTimelineService.SaveMultipleTimelinesIfNotAlreadyPresent(timelines, tx)
+	if timelineErr != nil {
+		impl.logger.Errorw("error in saving oci chart push success timeline", "err", timelineErr)
+	}
+	err = impl.TransactionUtilImpl.CommitTx(tx)
+	if err != nil {
+		impl.logger.Errorw("error in committing transaction to save oci push timeline", "err", err)
+		manifestPushResponse.Error = err
+		return manifestPushResponse
+	}
+	return manifestPushResponse
+}
+
+func (impl *OCIManifestPushServiceImpl) getChartRegistry(helmRepositoryConfig *bean.HelmRepositoryConfig) (*dockerRegistryRepository.DockerArtifactStore, *dockerRegistryRepository.OCIRegistryConfig, error) {
+	if helmRepositoryConfig == nil || len(helmRepositoryConfig.ContainerRegistryName) == 0 {
+		return nil, nil, fmt.Errorf("container registry is not configured for oci manifest push")
+	}
+	dockerArtifactStore, err := impl.dockerArtifactStoreRepository.FindOne(helmRepositoryConfig.ContainerRegistryName)
+	if err != nil {
+		impl.logger.Errorw("error in fetching container registry", "containerRegistryName", helmRepositoryConfig.ContainerRegistryName, "err", err)
+		return nil, nil, fmt.Errorf("container registry '%s' not found", helmRepositoryConfig.ContainerRegistryName)
+	}
+	ociRegistryConfig := GetChartPushOCIRegistryConfig(dockerArtifactStore)
+	if ociRegistryConfig == nil {
+		return nil, nil, fmt.Errorf("container registry '%s' is not configured to push helm charts", helmRepositoryConfig.ContainerRegistryName)
+	}
+	return dockerArtifactStore, ociRegistryConfig, nil
+}
+
+// GetChartPushOCIRegistryConfig returns the chart repository config of the registry if chart push is allowed, else nil
+func GetChartPushOCIRegistryConfig(dockerArtifactStore *dockerRegistryRepository.DockerArtifactStore) *dockerRegistryRepository.OCIRegistryConfig {
+	if !dockerArtifactStore.IsOCICompliantRegistry {
+		return nil
+	}
+	for _, ociRegistryConfig := range dockerArtifactStore.OCIRegistryConfig {
+		if ociRegistryConfig.RepositoryType == dockerRegistryRepository.OCI_REGISRTY_REPO_TYPE_CHART &&
+			(ociRegistryConfig.RepositoryAction == dockerRegistryRepository.STORAGE_ACTION_TYPE_PUSH ||
+				ociRegistryConfig.RepositoryAction == dockerRegistryRepository.STORAGE_ACTION_TYPE_PULL_AND_PUSH) {
+			return ociRegistryConfig
+		}
+	}
+	return nil
+}
+
+// packageChartWithValues returns the packaged chart (.tgz) with the merged values as values.yaml
+// and the chart metadata, used as the config blob of the OCI manifest.
+func (impl *OCIManifestPushServiceImpl) packageChartWithValues(manifestPushTemplate *bean.ManifestPushTemplate) ([]byte, []byte, error) {
+	helmChart, err := impl.chartTemplateService.LoadChartFromDir(manifestPushTemplate.BuiltChartPath)
+	if err != nil {
+		return nil, nil, err
+	}
+	helmChart.Metadata.Name = manifestPushTemplate.ChartName
+	helmChart.Metadata.Version = manifestPushTemplate.ChartVersion
+	valuesYaml, err := sigsYaml.JSONToYAML([]byte(manifestPushTemplate.MergedValues))
+	if err != nil {
+		impl.logger.Errorw("error in converting merged values to yaml", "err", err)
+		return nil, nil, err
+	}
+	helmChart.Values, err = chartutil.ReadValues(valuesYaml)
+	if err != nil {
+		impl.logger.Errorw("error in reading merged values", "err", err)
+		return nil, nil, err
+	}
+	valuesFileFound := false
+	for _, file := range helmChart.Raw {
+		if file.Name == chartutil.ValuesfileName {
+			file.Data = valuesYaml
+			valuesFileFound = true
+		}
+	}
+	if !valuesFileFound {
+		return nil, nil, errors.New("values.yaml not found in the built chart")
+	}
+	outputDir := filepath.Join(util.CHART_WORKING_DIR_PATH, impl.chartTemplateService.GetDir())
+	err = os.MkdirAll(outputDir, os.ModePerm)
+	if err != nil {
+		impl.logger.Errorw("error in creating dir for packaging chart", "dir", outputDir, "err", err)
+		return nil, nil, err
+	}
+	defer impl.chartTemplateService.CleanDir(outputDir)
+	chartBytes, err := impl.chartTemplateService.CreateZipFileForChart(helmChart, outputDir)
+	if err != nil {
+		return nil, nil, err
+	}
+	chartMetadataBytes, err := json.Marshal(helmChart.Metadata)
+	if err != nil {
+		return nil, nil, err
+	}
+	return chartBytes, chartMetadataBytes, nil
+}
+
+func (impl *OCIManifestPushServiceImpl) pushChartToOCIRegistry(ctx context.Context, manifestPushTemplate *bean.ManifestPushTemplate,
+	dockerArtifactStore *dockerRegistryRepository.DockerArtifactStore, chartBytes, chartMetadataBytes []byte) (string, error) {
+	newCtx, span := otel.Tracer("orchestrator").Start(ctx, "OCIManifestPushServiceImpl.pushChartToOCIRegistry")
+	defer span.End()
+	repo, err := impl.getRemoteRepository(manifestPushTemplate, dockerArtifactStore)
+	if err != nil {
+		return "", err
+	}
+	configDesc := content.NewDescriptorFromBytes(HelmChartConfigMediaType, chartMetadataBytes)
+	err = repo.Push(newCtx, configDesc, bytes.NewReader(chartMetadataBytes))
+	if err != nil && !errors.Is(err, errdef.ErrAlreadyExists) {
+		impl.logger.Errorw("error in pushing chart config blob", "repository", repo.Reference.String(), "err", err)
+		return "", err
+	}
+	layerDesc := content.NewDescriptorFromBytes(HelmChartContentLayerMediaType, chartBytes)
+	err = repo.Push(newCtx, layerDesc, bytes.NewReader(chartBytes))
+	if err != nil && !errors.Is(err, errdef.ErrAlreadyExists) {
+		impl.logger.Errorw("error in pushing chart content blob", "repository", repo.Reference.String(), "err", err)
+		return "", err
+	}
+	manifest := ocispec.Manifest{
+		Versioned: specs.Versioned{SchemaVersion: 2},
+		MediaType: ocispec.MediaTypeImageManifest,
+		Config:    configDesc,
+		Layers:    []ocispec.Descriptor{layerDesc},
+		Annotations: map[string]string{
+			ocispec.AnnotationCreated: time.Now().UTC().Format(time.RFC3339),
+		},
+	}
+	manifestBytes, err := json.Marshal(manifest)
+	if err != nil {
+		return "", err
+	}
+	manifestDesc := content.NewDescriptorFromBytes(ocispec.MediaTypeImageManifest, manifestBytes)
+	err = repo.PushReference(newCtx, manifestDesc, bytes.NewReader(manifestBytes), manifestPushTemplate.ChartVersion)
+	if err != nil {
+		impl.logger.Errorw("error in pushing chart manifest", "repository", repo.Reference.String(), "version", manifestPushTemplate.ChartVersion, "err", err)
+		return "", err
+	}
+	return manifestDesc.Digest.String(), nil
+}
+
+func (impl *OCIManifestPushServiceImpl) getRemoteRepository(manifestPushTemplate *bean.ManifestPushTemplate, dockerArtifactStore *dockerRegistryRepository.DockerArtifactStore) (*remote.Repository, error) {
+	repo, err := remote.NewRepository(fmt.Sprintf("%s/%s", manifestPushTemplate.RepoUrl, manifestPushTemplate.ChartLocation))
+	if err != nil {
+		impl.logger.Errorw("invalid oci repository reference", "registry", manifestPushTemplate.RepoUrl, "repository", manifestPushTemplate.ChartLocation, "err", err)
+		return nil, err
+	}
+	username, password, err := registryUtil.ExtractCredentialsForRegistry(&registryUtil.RegistryCredential{
+		RegistryType:       registryUtil.Registry(dockerArtifactStore.RegistryType),
+		RegistryURL:        dockerArtifactStore.RegistryURL,
+		Username:           dockerArtifactStore.Username,
+		Password:           dockerArtifactStore.Password.String(),
+		AWSAccessKeyId:     dockerArtifactStore.AWSAccessKeyId,
+		AWSSecretAccessKey: dockerArtifactStore.AWSSecretAccessKey.String(),
+		AWSRegion:          dockerArtifactStore.AWSRegion,
+	})
+	if err != nil {
+		impl.logger.Errorw("error in extracting registry credentials", "dockerArtifactStoreId", dockerArtifactStore.Id, "err", err)
+		return nil, err
+	}
+	httpClient, err := getRegistryHttpClient(dockerArtifactStore)
+	if err != nil {
+		impl.logger.Errorw("error in creating registry http client", "dockerArtifactStoreId", dockerArtifactStore.Id, "err", err)
+		return nil, err
+	}
+	repo.Client = &auth.Client{
+		Client: httpClient,
+		Cache:  auth.NewCache(),
+		Credential: auth.StaticCredential(repo.Reference.Registry, auth.Credential{
+			Username: username,
+			Password: password,
+		}),
+	}
+	return repo, nil
+}
+
+func getRegistryHttpClient(dockerArtifactStore *dockerRegistryRepository.DockerArtifactStore) (*http.Client, error) {
+	switch dockerArtifactStore.Connection {
+	case registryConnectionInsecure:
+		transport := retry.NewTransport(&http.Transport{
+			Proxy:           http.ProxyFromEnvironment,
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true}, // #nosec G402 -- registry is marked insecure by the user
+		})
+		return &http.Client{Transport: transport}, nil
+	case registryConnectionSecureWithCert:
+		certPool, err := x509.SystemCertPool()
+		if err != nil {
+			certPool = x509.NewCertPool()
+		}
+		if !certPool.AppendCertsFromPEM([]byte(dockerArtifactStore.Cert)) {
+			return nil, errors.New("invalid certificate configured for container registry")
+		}
+		transport := retry.NewTransport(&http.Transport{
+			Proxy:           http.ProxyFromEnvironment,
+			TLSClientConfig: &tls.Config{RootCAs: certPool},
+		})
+		return &http.Client{Transport: transport}, nil
+	default:
+		return retry.DefaultClient, nil
+	}
+}
+
+func (impl *OCIManifestPushServiceImpl) SaveTimelineForError(manifestPushTemplate *bean.ManifestPushTemplate, pushErr error) {
+	timeline := impl.pipelineStatusTimelineService.NewDevtronAppPipelineStatusTimelineDbObject(manifestPushTemplate.WorkflowRunnerId, timelineStatus.TIMELINE_STATUS_GIT_COMMIT_FAILED, fmt.Sprintf("Helm chart push to OCI registry failed - %v", pushErr), manifestPushTemplate.UserId)
+	timelineErr := impl.pipelineStatusTimelineService.SaveTimeline(timeline, nil)
+	if timelineErr != nil {
+		impl.logger.Errorw("error in creating timeline status for oci chart push", "err", timelineErr, "timeline", timeline)
+	}
+}
@@ -18,8 +18,10 @@ package manifest
 
 import (
 	"github.com/devtron-labs/devtron/pkg/deployment/manifest/configMapAndSecret"
+	"github.com/devtron-labs/devtron/pkg/deployment/manifest/configMapAndSecret/rotation"
 	"github.com/devtron-labs/devtron/pkg/deployment/manifest/deployedAppMetrics"
 	"github.com/devtron-labs/devtron/pkg/deployment/manifest/deploymentTemplate"
+	"github.com/devtron-labs/devtron/pkg/deployment/manifest/policy"
 	"github.com/google/wire"
 )
 
@@ -27,6 +29,8 @@ var DeploymentManifestWireSet = wire.NewSet(
 	deployedAppMetrics.AppMetricsWireSet,
 	deploymentTemplate.DeploymentTemplateWireSet,
 	configMapAndSecret.ConfigMapAndSecretWireSet,
+	rotation.CmCsRotationWireSet,
+	policy.ManifestPolicyWireSet,
 
 	NewManifestCreationServiceImpl,
 	wire.Bind(new(ManifestCreationService), new(*ManifestCreationServiceImpl)),
@@ -57,12 +57,14 @@ import (
 	"github.com/devtron-labs/devtron/pkg/deployment/gitOps/config"
 	"github.com/devtron-labs/devtron/pkg/deployment/gitOps/git"
 	"github.com/devtron-labs/devtron/pkg/deployment/manifest"
+	manifestPolicy "github.com/devtron-labs/devtron/pkg/deployment/manifest/policy"
 	"github.com/devtron-labs/devtron/pkg/deployment/manifest/publish"
 	"github.com/devtron-labs/devtron/pkg/deployment/trigger/devtronApps/bean"
 	"github.com/devtron-labs/devtron/pkg/deployment/trigger/devtronApps/userDeploymentRequest/service"
 	"github.com/devtron-labs/devtron/pkg/eventProcessor/out"
 	"github.com/devtron-labs/devtron/pkg/executor"
 	"github.com/devtron-labs/devtron/pkg/imageDigestPolicy"
+	infraConfigService "github.com/devtron-labs/devtron/pkg/infraConfig/service"
 	"github.com/devtron-labs/devtron/pkg/pipeline"
 	"github.com/devtron-labs/devtron/pkg/pipeline/history"
 	"github.com/devtron-labs/devtron/pkg/pipeline/repository"
@@ -70,6 +72,7 @@ import (
 	"github.com/devtron-labs/devtron/pkg/plugin"
 	security2 "github.com/devtron-labs/devtron/pkg/policyGovernance/security/imageScanning"
 	read2 "github.com/devtron-labs/devtron/pkg/policyGovernance/security/imageScanning/read"
+	"github.com/devtron-labs/devtron/pkg/policyGovernance/security/imageSigning"
 	"github.com/devtron-labs/devtron/pkg/sql"
 	"github.com/devtron-labs/devtron/pkg/variables"
 	"github.com/devtron-labs/devtron/pkg/workflow/cd"
@@ -105,6 +108,8 @@ type HandlerService interface {
 
 	TriggerRelease(ctx context.Context, overrideRequest *bean3.ValuesOverrideRequest, envDeploymentConfig *bean9.DeploymentConfig, triggeredAt time.Time, triggeredBy int32) (releaseNo int, manifestPushTemplate *bean4.ManifestPushTemplate, err error)
 
+	GetManifestDiffPreview(ctx context.Context, overrideRequest *bean3.ValuesOverrideRequest) (*bean.ManifestDiffPreview, error)
+
 	CancelStage(workflowRunnerId int, forceAbort bool, userId int32) (int, error)
 	DownloadCdWorkflowArtifacts(buildId int) (*os.File, error)
 	GetRunningWorkflowLogs(environmentId int, pipelineId int, workflowId int, followLogs bool) (*bufio.Reader, func() error, error)
@@ -114,6 +119,7 @@ type HandlerServiceImpl struct {
 	logger                              *zap.SugaredLogger
 	cdWorkflowCommonService             cd.CdWorkflowCommonService
 	gitOpsManifestPushService           publish.GitOpsPushService
+	ociManifestPushService              publish.OCIPushService
 	gitOpsConfigReadService             config.GitOpsConfigReadService
 	argoK8sClient                       argocdServer.ArgoK8sClient
 	ACDConfig                           *argocdServer.ACDConfig
@@ -176,11 +182,15 @@ type HandlerServiceImpl struct {
 	workflowTriggerAuditService         service2.WorkflowTriggerAuditService
 	fluxCdDeploymentService             fluxcd.DeploymentService
 	workflowStatusLatestService         workflowStatusLatest.WorkflowStatusLatestService
+	imageSigningService                 imageSigning.ImageSigningService
+	manifestPolicyService               manifestPolicy.ManifestPolicyService
+	infraConfigService                  infraConfigService.InfraConfigService
 }
 
 func NewHandlerServiceImpl(logger *zap.SugaredLogger,
 	cdWorkflowCommonService cd.CdWorkflowCommonService,
 	gitOpsManifestPushService publish.GitOpsPushService,
+	ociManifestPushService publish.OCIPushService,
 	gitOpsConfigReadService config.GitOpsConfigReadService,
 	argoK8sClient argocdServer.ArgoK8sClient,
 	ACDConfig *argocdServer.ACDConfig,
@@ -241,11 +251,15 @@ func NewHandlerServiceImpl(logger *zap.SugaredLogger,
 	asyncRunnable *async.Runnable,
 	workflowTriggerAuditService service2.WorkflowTriggerAuditService,
 	fluxCdDeploymentService fluxcd.DeploymentService,
-	workflowStatusLatestService workflowStatusLatest.WorkflowStatusLatestService) (*HandlerServiceImpl, error) {
+	workflowStatusLatestService workflowStatusLatest.WorkflowStatusLatestService,
+	imageSigningService imageSigning.ImageSigningService,
+	manifestPolicyService manifestPolicy.ManifestPolicyService,
+	infraConfigService infraConfigService.InfraConfigService) (*HandlerServiceImpl, error) {
 	impl := &HandlerServiceImpl{
 		logger:                              logger,
 		cdWorkflowCommonService:             cdWorkflowCommonService,
 		gitOpsManifestPushService:           gitOpsManifestPushService,
+		ociManifestPushService:              ociManifestPushService,
 		gitOpsConfigReadService:             gitOpsConfigReadService,
 		argoK8sClient:                       argoK8sClient,
 		ACDConfig:                           ACDConfig,
@@ -312,6 +326,9 @@ func NewHandlerServiceImpl(logger *zap.SugaredLogger,
 		workflowTriggerAuditService: workflowTriggerAuditService,
 		fluxCdDeploymentService:     fluxCdDeploymentService,
 		workflowStatusLatestService: workflowStatusLatestService,
+		imageSigningService:         imageSigningService,
+		manifestPolicyService:       manifestPolicyService,
+		infraConfigService:          infraConfigService,
 	}
 	config, err := types.GetCdConfig()
 	if err != nil {
@@ -21,6 +21,7 @@ import (
 	"github.com/devtron-labs/devtron/api/bean"
 	"github.com/devtron-labs/devtron/internal/sql/repository"
 	"github.com/devtron-labs/devtron/internal/sql/repository/pipelineConfig"
+	"github.com/devtron-labs/devtron/internal/sql/repository/pipelineConfig/bean/workflow/cdWorkflow"
 	bean2 "github.com/devtron-labs/devtron/pkg/deployment/common/bean"
 	"time"
 )
@@ -48,6 +49,7 @@ type CdTriggerRequest struct {
 	TriggeredBy            int32
 	RefCdWorkflowRunnerId  int
 	RunStageInEnvNamespace string
+	WorkflowExecutorType   cdWorkflow.WorkflowExecutorType
 	WorkflowType           bean.WorkflowType
 	CdWorkflowRunnerId     int
 	TriggerContext
@@ -90,7 +92,9 @@ type TriggerRequirementRequestDto struct {
 
 type VulnerabilityCheckRequest struct {
 	ImageDigest string
-	CdPipeline  *pipelineConfig.Pipeline
+	// Image is used to match image scoped cve exceptions
+	Image      string
+	CdPipeline *pipelineConfig.Pipeline
 }
 
 const (
@@ -110,6 +114,7 @@ const (
 type ValidateDeploymentTriggerObj struct {
 	Runner               *pipelineConfig.CdWorkflowRunner
 	CdPipeline           *pipelineConfig.Pipeline
+	Artifact             *repository.CiArtifact
 	ImageDigest          string
 	DeploymentConfig     *bean2.DeploymentConfig
 	TriggeredBy          int32
@@ -119,3 +124,52 @@ type ValidateDeploymentTriggerObj struct {
 func (r *ValidateDeploymentTriggerObj) IsDeploymentTypeRollback() bool {
 	return r.IsRollbackDeployment
 }
+
+type ResourceDiffStatus string
+
+const (
+	ResourceDiffStatusAdded        ResourceDiffStatus = "Added"
+	ResourceDiffStatusChanged      ResourceDiffStatus = "Changed"
+	ResourceDiffStatusRemoved      ResourceDiffStatus = "Removed"
+	ResourceDiffStatusUnchanged    ResourceDiffStatus = "Unchanged"
+	ResourceDiffStatusDryRunFailed ResourceDiffStatus = "DryRunFailed"
+)
+
+type FieldDiffOperation string
+
+const (
+	FieldDiffOperationAdded   FieldDiffOperation = "added"
+	FieldDiffOperationChanged FieldDiffOperation = "changed"
+	FieldDiffOperationRemoved FieldDiffOperation = "removed"
+)
+
+const MaskedSecretValue = "********"
+
+type FieldDiff struct {
+	Path      string             `json:"path"`
+	Operation FieldDiffOperation `json:"operation"`
+	OldValue  interface{}        `json:"oldValue,omitempty"`
+	NewValue  interface{}        `json:"newValue,omitempty"`
+}
+
+type ResourceDiff struct {
+	Group     string             `json:"group"`
+	Version   string             `json:"version"`
+	Kind      string             `json:"kind"`
+	Namespace string             `json:"namespace"`
+	Name      string             `json:"name"`
+	Status    ResourceDiffStatus `json:"status"`
+	Fields    []*FieldDiff       `json:"fields,omitempty"`
+	Error     string             `json:"error,omitempty"`
+}
+
+// ManifestDiffPreview is the diff between the manifests a deploy trigger would apply and the live objects in the cluster
+type ManifestDiffPreview struct {
+	PipelineId     int             `json:"pipelineId"`
+	CiArtifactId   int             `json:"ciArtifactId"`
+	AppName        string          `json:"appName"`
+	EnvName        string          `json:"envName"`
+	Namespace      string          `json:"namespace"`
+	ReleaseVersion int             `json:"releaseVersion"`
+	Resources      []*ResourceDiff `json:"resources"`
+}
@@ -46,10 +46,13 @@ import (
 	statusBean "github.com/devtron-labs/devtron/pkg/app/status/bean"
 	userBean "github.com/devtron-labs/devtron/pkg/auth/user/bean"
 	bean2 "github.com/devtron-labs/devtron/pkg/bean"
+	repository5 "github.com/devtron-labs/devtron/pkg/cluster/repository"
 	"github.com/devtron-labs/devtron/pkg/deployment/common"
 	bean9 "github.com/devtron-labs/devtron/pkg/deployment/common/bean"
 	bean10 "github.com/devtron-labs/devtron/pkg/deployment/manifest/deploymentTemplate/bean"
 	bean5 "github.com/devtron-labs/devtron/pkg/deployment/manifest/deploymentTemplate/chartRef/bean"
+	manifestPolicyBean "github.com/devtron-labs/devtron/pkg/deployment/manifest/policy/bean"
+	"github.com/devtron-labs/devtron/pkg/deployment/manifest/publish"
 	"github.com/devtron-labs/devtron/pkg/deployment/trigger/devtronApps/adapter"
 	"github.com/devtron-labs/devtron/pkg/deployment/trigger/devtronApps/bean"
 	"github.com/devtron-labs/devtron/pkg/deployment/trigger/devtronApps/helper"
@@ -136,7 +139,7 @@ func (impl *HandlerServiceImpl) validateDeploymentTriggerRequest(ctx context.Con
 	// if request is for rollback then bypass vulnerability validation
 	if !validateDeploymentTriggerObj.IsDeploymentTypeRollback() {
 		// checking vulnerability for deploying image
-		vulnerabilityCheckRequest := adapter.GetVulnerabilityCheckRequest(validateDeploymentTriggerObj.CdPipeline, validateDeploymentTriggerObj.ImageDigest)
+		vulnerabilityCheckRequest := adapter.GetVulnerabilityCheckRequest(validateDeploymentTriggerObj.CdPipeline, validateDeploymentTriggerObj.ImageDigest, validateDeploymentTriggerObj.Artifact.Image)
 		isVulnerable, err = impl.imageScanService.GetArtifactVulnerabilityStatus(newCtx, vulnerabilityCheckRequest)
 		if err != nil {
 			impl.logger.Errorw("error in getting Artifact vulnerability status, ManualCdTrigger", "err", err)
@@ -151,6 +154,17 @@ func (impl *HandlerServiceImpl) validateDeploymentTriggerRequest(ctx context.Con
 		}
 		return fmt.Errorf("found vulnerability for image digest %s", validateDeploymentTriggerObj.ImageDigest)
 	}
+	// rollbacks redeploy an already verified image, same as the vulnerability validation
+	if !validateDeploymentTriggerObj.IsDeploymentTypeRollback() {
+		err = impl.imageSigningService.VerifyArtifactForDeployment(newCtx, validateDeploymentTriggerObj.Artifact, validateDeploymentTriggerObj.CdPipeline.EnvironmentId, validateDeploymentTriggerObj.TriggeredBy)
+		if err != nil {
+			impl.logger.Errorw("image signature verification failed, TriggerDeployment", "wfrId", validateDeploymentTriggerObj.Runner.Id, "err", err)
+			if markErr := impl.cdWorkflowCommonService.MarkCurrentDeploymentFailed(validateDeploymentTriggerObj.Runner, err, validateDeploymentTriggerObj.TriggeredBy); markErr != nil {
+				impl.logger.Errorw("error while updating current runner status to failed, TriggerDeployment", "wfrId", validateDeploymentTriggerObj.Runner.Id, "err", markErr)
+			}
+			return err
+		}
+	}
 	return nil
 }
 
@@ -330,7 +344,7 @@ func (impl *HandlerServiceImpl) ManualCdTrigger(triggerContext bean.TriggerConte
 			impl.logger.Errorw("error in creating timeline status for deployment initiation, ManualCdTrigger", "err", err, "timeline", timeline)
 		}
 		if isNotHibernateRequest(overrideRequest.DeploymentType) {
-			validateReqObj := adapter.NewValidateDeploymentTriggerObj(runner, cdPipeline, artifact.ImageDigest, envDeploymentConfig, overrideRequest.UserId, overrideRequest.IsRollbackDeployment)
+			validateReqObj := adapter.NewValidateDeploymentTriggerObj(runner, cdPipeline, artifact, envDeploymentConfig, overrideRequest.UserId, overrideRequest.IsRollbackDeployment)
 			validationErr := impl.validateDeploymentTriggerRequest(ctx, validateReqObj)
 			if validationErr != nil {
 				impl.logger.Errorw("validation error deployment request", "cdWfr", runner.Id, "err", validationErr)
@@ -347,11 +361,14 @@ func (impl *HandlerServiceImpl) ManualCdTrigger(triggerContext bean.TriggerConte
 				impl.logger.Errorw("error while updating current runner status to failed", "cdWfr", runner.Id, "err", err)
 			}
 			pipelineOverride, err := impl.pipelineOverrideRepository.FindLatestByCdWorkflowId(runner.CdWorkflowId)
-			if err != nil {
+			if err != nil && !util.IsErrNoRows(err) {
 				impl.logger.Errorw("error in getting latest pipeline override by cdWorkflowId", "err", err, "cdWorkflowId", runner.CdWorkflowId)
 				return 0, "", nil, err
 			}
-			impl.deploymentEventHandler.WriteCDNotificationEventAsync(pipelineOverride.Pipeline.AppId, pipelineOverride.Pipeline.EnvironmentId, pipelineOverride, util2.Fail)
+			// pipeline override is not saved when the release fails before it, e.g. on enforced manifest policy violations
+			if pipelineOverride.Id > 0 {
+				impl.deploymentEventHandler.WriteCDNotificationEventAsync(pipelineOverride.Pipeline.AppId, pipelineOverride.Pipeline.EnvironmentId, pipelineOverride, util2.Fail)
+			}
 			return 0, "", nil, releaseErr
 		}
 
@@ -500,7 +517,7 @@ func (impl *HandlerServiceImpl) TriggerAutomaticDeployment(request bean.CdTrigge
 		impl.logger.Errorw("error in fetching environment deployment config by appId and envId", "appId", pipeline.AppId, "envId", pipeline.EnvironmentId, "err", err)
 		return err
 	}
-	validationErr := impl.validateDeploymentTriggerRequest(ctx, adapter.NewValidateDeploymentTriggerObj(runner, pipeline, artifact.ImageDigest, envDeploymentConfig, triggeredBy, false))
+	validationErr := impl.validateDeploymentTriggerRequest(ctx, adapter.NewValidateDeploymentTriggerObj(runner, pipeline, artifact, envDeploymentConfig, triggeredBy, false))
 	if validationErr != nil {
 		impl.logger.Errorw("validation error deployment request", "cdWfr", runner.Id, "err", validationErr)
 		return validationErr
@@ -645,6 +662,13 @@ func (impl *HandlerServiceImpl) handleCDTriggerRelease(ctx context.Context, over
 		}
 		envDeploymentConfig = deploymentConfig
 	}
+	// manifest policies are evaluated before the pipeline override is saved and in the synchronous part of the trigger,
+	// so that violations are returned in the trigger response for async installations too
+	overrideRequest.ManifestPolicyViolations, err = impl.evaluateManifestPolicies(newCtx, overrideRequest, envDeploymentConfig)
+	if err != nil {
+		impl.logger.Errorw("error in evaluating manifest policies", "cdWfrId", overrideRequest.WfrId, "err", err)
+		return releaseNo, manifestPushTemplate, err
+	}
 	if isAsyncMode {
 		return impl.triggerAsyncRelease(newCtx, overrideRequest, envDeploymentConfig, userDeploymentRequestId, triggeredAt, deployedBy)
 	}
@@ -720,6 +744,96 @@ func (impl *HandlerServiceImpl) TriggerRelease(ctx context.Context, overrideRequ
 	return releaseNo, valuesOverrideResponse.ManifestPushTemplate, nil
 }
 
+// evaluateManifestPolicies renders the manifest of the trigger in dry run mode and evaluates the manifest policies scoped to
+// the deployment target, violations are recorded on the timeline and returned, enforced violations fail the deployment
+func (impl *HandlerServiceImpl) evaluateManifestPolicies(ctx context.Context, overrideRequest *bean3.ValuesOverrideRequest,
+	envDeploymentConfig *bean9.DeploymentConfig) ([]*manifestPolicyBean.PolicyViolation, error) {
+	newCtx, span := otel.Tracer("orchestrator").Start(ctx, "HandlerServiceImpl.evaluateManifestPolicies")
+	defer span.End()
+	clusterId := overrideRequest.ClusterId
+	hasPolicies, err := impl.manifestPolicyService.HasApplicablePolicies(overrideRequest.EnvId, clusterId)
+	if err != nil {
+		impl.logger.Errorw("error in checking applicable manifest policies", "envId", overrideRequest.EnvId, "clusterId", clusterId, "err", err)
+		return nil, err
+	} else if !hasPolicies {
+		return nil, nil
+	}
+	cluster, err := impl.clusterRepository.FindById(clusterId)
+	if err != nil {
+		impl.logger.Errorw("error in getting cluster by id", "clusterId", clusterId, "err", err)
+		return nil, err
+	}
+	dryRunRequest := *overrideRequest
+	dryRunRequest.PipelineOverrideId = 0
+	dryRunRequest.IsDryRun = true
+	_, generatedManifest, err := impl.renderDryRunManifest(newCtx, &dryRunRequest, envDeploymentConfig)
+	if err != nil {
+		impl.logger.Errorw("error in rendering manifest for policy evaluation", "cdWfrId", overrideRequest.WfrId, "err", err)
+		return nil, err
+	}
+	scope := &manifestPolicyBean.PolicyScope{
+		AppName:     overrideRequest.AppName,
+		EnvId:       overrideRequest.EnvId,
+		EnvName:     overrideRequest.EnvName,
+		ClusterId:   clusterId,
+		ClusterName: cluster.ClusterName,
+		Namespace:   overrideRequest.Namespace,
+	}
+	result, err := impl.manifestPolicyService.EvaluateManifest(generatedManifest, scope)
+	if err != nil {
+		impl.logger.Errorw("error in evaluating manifest policies", "scope", scope, "err", err)
+		return nil, err
+	} else if !result.HasViolations() {
+		return nil, nil
+	}
+	enforcedViolations := result.GetEnforcedViolations()
+	timelineDescription := timelineStatus.TIMELINE_DESCRIPTION_MANIFEST_POLICY_AUDIT
+	if len(enforcedViolations) > 0 {
+		timelineDescription = timelineStatus.TIMELINE_DESCRIPTION_MANIFEST_POLICY_ENFORCED
+	}
+	timeline := impl.pipelineStatusTimelineService.NewDevtronAppPipelineStatusTimelineDbObject(overrideRequest.WfrId, timelineStatus.TIMELINE_STATUS_MANIFEST_POLICY_VIOLATED,
+		fmt.Sprintf("%s\n%s", timelineDescription, manifestPolicyBean.FormatViolations(result.Violations)), overrideRequest.UserId)
+	_, dbErr := impl.pipelineStatusTimelineService.SaveTimelineIfNotAlreadyPresent(timeline, nil)
+	if dbErr != nil {
+		impl.logger.Errorw("error in creating timeline status for manifest policy violations", "err", dbErr, "timeline", timeline)
+	}
+	if len(enforcedViolations) > 0 {
+		errMsg := fmt.Sprintf("manifest policy violated: %s", manifestPolicyBean.FormatViolations(enforcedViolations))
+		return result.Violations, util.NewApiError(http.StatusUnprocessableEntity, errMsg, errMsg)
+	}
+	return result.Violations, nil
+}
+
+// renderTriggerManifest renders the built chart with the merged values of the trigger, as it would be applied in the cluster
+func (impl *HandlerServiceImpl) renderTriggerManifest(ctx context.Context, valuesOverrideResponse *app.ValuesOverrideResponse,
+	builtChartPath string, cluster *repository5.Cluster) (string, error) {
+	envOverride := valuesOverrideResponse.EnvOverride
+	chartBytes, err := impl.chartTemplateService.LoadChartInBytes(builtChartPath, false)
+	if err != nil {
+		impl.logger.Errorw("error in loading built chart", "builtChartPath", builtChartPath, "err", err)
+		return "", err
+	}
+	sanitizedK8sVersion, err := impl.getSanitizedK8sVersion(envOverride.Chart.ReferenceTemplate)
+	if err != nil {
+		return "", err
+	}
+	templateChartResponse, err := impl.helmAppClient.TemplateChart(ctx, &gRPC.InstallReleaseRequest{
+		ReleaseIdentifier: &gRPC.ReleaseIdentifier{
+			ReleaseName:      valuesOverrideResponse.Pipeline.DeploymentAppName,
+			ReleaseNamespace: envOverride.Namespace,
+			ClusterConfig:    impl.getClusterGRPCConfig(*cluster),
+		},
+		ValuesYaml:   valuesOverrideResponse.MergedValues,
+		K8SVersion:   sanitizedK8sVersion,
+		ChartContent: &gRPC.ChartContent{Content: chartBytes},
+	})
+	if err != nil {
+		impl.logger.Errorw("error in template chart", "appName", valuesOverrideResponse.Pipeline.DeploymentAppName, "err", err)
+		return "", err
+	}
+	return templateChartResponse.GetGeneratedManifest(), nil
+}
+
 func (impl *HandlerServiceImpl) performGitOps(ctx context.Context,
 	overrideRequest *bean3.ValuesOverrideRequest, valuesOverrideResponse *app.ValuesOverrideResponse,
 	builtChartPath string, triggerEvent bean.TriggerEvent) error {
@@ -736,7 +850,12 @@ func (impl *HandlerServiceImpl) performGitOps(ctx context.Context,
 		impl.logger.Errorw("error in building manifest push template", "err", err)
 		return err
 	}
-	manifestPushService := impl.getManifestPushService(triggerEvent.ManifestStorageType)
+	var manifestPushService publish.ManifestPushService
+	if bean2.IsOCIStorage(triggerEvent.ManifestStorageType) {
+		manifestPushService = impl.ociManifestPushService
+	} else {
+		manifestPushService = impl.getManifestPushService(triggerEvent.ManifestStorageType)
+	}
 	manifestPushResponse := manifestPushService.PushChart(newCtx, manifestPushTemplate)
 	if manifestPushResponse.Error != nil {
 		impl.logger.Errorw("error in pushing manifest to git/helm", "err", manifestPushResponse.Error, "git_repo_url", manifestPushTemplate.RepoUrl)
@@ -752,6 +871,16 @@ func (impl *HandlerServiceImpl) performGitOps(ctx context.Context,
 
 func (impl *HandlerServiceImpl) buildTriggerEventForOverrideRequest(overrideRequest *bean3.ValuesOverrideRequest, triggeredAt time.Time) (triggerEvent bean.TriggerEvent, skipRequest bool, err error) {
 	triggerEvent = helper.NewTriggerEvent(overrideRequest.DeploymentAppType, triggeredAt, overrideRequest.UserId)
+	if util.IsAcdApp(overrideRequest.DeploymentAppType) {
+		manifestPushConfig, err := impl.manifestPushConfigRepository.GetManifestPushConfigByAppIdAndEnvId(overrideRequest.AppId, overrideRequest.EnvId)
+		if err != nil && !util.IsErrNoRows(err) {
+			impl.logger.Errorw("error in fetching manifest push config", "appId", overrideRequest.AppId, "envId", overrideRequest.EnvId, "err", err)
+			return triggerEvent, skipRequest, err
+		}
+		if manifestPushConfig != nil && manifestPushConfig.Id != 0 && bean2.IsOCIStorage(manifestPushConfig.StorageType) {
+			triggerEvent.ManifestStorageType = bean2.ManifestStorageOCI
+		}
+	}
 	request := statusBean.NewTimelineGetRequest().
 		WithCdWfrId(overrideRequest.WfrId).
 		ExcludingStatuses(timelineStatus.TIMELINE_STATUS_UNABLE_TO_FETCH_STATUS,
@@ -846,15 +975,16 @@ func (impl *HandlerServiceImpl) buildManifestPushTemplate(overrideRequest *bean3
 		return manifestPushTemplate, err
 	}
 
-	if manifestPushConfig != nil && manifestPushConfig.Id != 0 {
-		if manifestPushConfig.StorageType == bean2.ManifestStorageGit {
-			// need to implement for git repo push
-			// currently manifest push config doesn't have git push config. GitOps config is derived from charts, chart_env_config_override and chart_ref table
-		} else {
-			err2 := impl.buildManifestPushTemplateForNonGitStorageType(overrideRequest, valuesOverrideResponse, builtChartPath, err, manifestPushConfig, manifestPushTemplate)
-			if err2 != nil {
-				return manifestPushTemplate, err2
-			}
+	// manifest push config doesn't have git push config. GitOps config is derived from charts, chart_env_config_override and chart_ref table
+	if manifestPushConfig != nil && manifestPushConfig.Id != 0 && bean2.IsOCIStorage(manifestPushConfig.StorageType) {
+		err = impl.buildManifestPushTemplateForOCIStorageType(valuesOverrideResponse, manifestPushConfig, manifestPushTemplate)
+		if err != nil {
+			return manifestPushTemplate, err
+		}
+	} else if manifestPushConfig != nil && manifestPushConfig.Id != 0 && !bean2.IsGitStorage(manifestPushConfig.StorageType) {
+		err2 := impl.buildManifestPushTemplateForNonGitStorageType(overrideRequest, valuesOverrideResponse, builtChartPath, err, manifestPushConfig, manifestPushTemplate)
+		if err2 != nil {
+			return manifestPushTemplate, err2
 		}
 	} else {
 
@@ -879,7 +1009,11 @@ func (impl *HandlerServiceImpl) deployApp(ctx context.Context, overrideRequest *
 	var err error
 
 	if util.IsAcdApp(overrideRequest.DeploymentAppType) && triggerEvent.DeployArgoCdApp {
-		err = impl.deployArgoCdApp(newCtx, overrideRequest, valuesOverrideResponse)
+		if bean2.IsOCIStorage(triggerEvent.ManifestStorageType) {
+			err = impl.deployArgoCdAppFromOCIRegistry(newCtx, overrideRequest, valuesOverrideResponse)
+		} else {
+			err = impl.deployArgoCdApp(newCtx, overrideRequest, valuesOverrideResponse)
+		}
 		if err != nil {
 			impl.logger.Errorw("error in deploying app on ArgoCd", "err", err)
 			return err
@@ -1072,25 +1206,10 @@ func (impl *HandlerServiceImpl) deployArgoCdApp(ctx context.Context, overrideReq
 		return err
 	}
 	if valuesOverrideResponse.DeploymentConfig.IsArgoAppSyncAndRefreshSupported() {
-		syncTime := time.Now()
 		targetRevision := valuesOverrideResponse.DeploymentConfig.GetTargetRevision()
-		err = impl.argoClientWrapperService.SyncArgoCDApplicationIfNeededAndRefresh(newCtx, valuesOverrideResponse.Pipeline.DeploymentAppName, targetRevision)
+		err = impl.syncArgoCdApp(newCtx, overrideRequest, valuesOverrideResponse.Pipeline.DeploymentAppName, targetRevision)
 		if err != nil {
-			impl.logger.Errorw("error in getting argo application with normal refresh", "argoAppName", valuesOverrideResponse.Pipeline.DeploymentAppName)
-			return fmt.Errorf("%s. err: %s", bean.ARGOCD_SYNC_ERROR, util.GetClientErrorDetailedMessage(err))
-		}
-		if impl.ACDConfig.IsManualSyncEnabled() {
-			timeline := &pipelineConfig.PipelineStatusTimeline{
-				CdWorkflowRunnerId: overrideRequest.WfrId,
-				StatusTime:         syncTime,
-				Status:             timelineStatus.TIMELINE_STATUS_ARGOCD_SYNC_COMPLETED,
-				StatusDetail:       timelineStatus.TIMELINE_DESCRIPTION_ARGOCD_SYNC_COMPLETED,
-			}
-			timeline.CreateAuditLog(overrideRequest.UserId)
-			_, err = impl.pipelineStatusTimelineService.SaveTimelineIfNotAlreadyPresent(timeline, nil)
-			if err != nil {
-				impl.logger.Errorw("error in saving pipeline status timeline", "err", err)
-			}
+			return err
 		}
 	}
 	if updateAppInArgoCd {
@@ -1101,6 +1220,29 @@ func (impl *HandlerServiceImpl) deployArgoCdApp(ctx context.Context, overrideReq
 	return nil
 }
 
+func (impl *HandlerServiceImpl) syncArgoCdApp(ctx context.Context, overrideRequest *bean3.ValuesOverrideRequest, argoAppName, targetRevision string) error {
+	syncTime := time.Now()
+	err := impl.argoClientWrapperService.SyncArgoCDApplicationIfNeededAndRefresh(ctx, argoAppName, targetRevision)
+	if err != nil {
+		impl.logger.Errorw("error in getting argo application with normal refresh", "argoAppName", argoAppName)
+		return fmt.Errorf("%s. err: %s", bean.ARGOCD_SYNC_ERROR, util.GetClientErrorDetailedMessage(err))
+	}
+	if impl.ACDConfig.IsManualSyncEnabled() {
+		timeline := &pipelineConfig.PipelineStatusTimeline{
+			CdWorkflowRunnerId: overrideRequest.WfrId,
+			StatusTime:         syncTime,
+			Status:             timelineStatus.TIMELINE_STATUS_ARGOCD_SYNC_COMPLETED,
+			StatusDetail:       timelineStatus.TIMELINE_DESCRIPTION_ARGOCD_SYNC_COMPLETED,
+		}
+		timeline.CreateAuditLog(overrideRequest.UserId)
+		_, err = impl.pipelineStatusTimelineService.SaveTimelineIfNotAlreadyPresent(timeline, nil)
+		if err != nil {
+			impl.logger.Errorw("error in saving pipeline status timeline", "err", err)
+		}
+	}
+	return nil
+}
+
 // update repoUrl, revision and argo app sync mode (auto/manual) if needed
 func (impl *HandlerServiceImpl) updateArgoPipeline(ctx context.Context, pipeline *pipelineConfig.Pipeline, envOverride *bean10.EnvConfigOverride, deploymentConfig *bean9.DeploymentConfig) (bool, error) {
 	if !deploymentConfig.IsArgoAppPatchSupported() {
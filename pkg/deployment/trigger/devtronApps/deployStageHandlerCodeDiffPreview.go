@@ -0,0 +1,267 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package devtronApps
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	bean3 "github.com/devtron-labs/devtron/api/bean"
+	"github.com/devtron-labs/devtron/internal/sql/models"
+	"github.com/devtron-labs/devtron/internal/util"
+	"github.com/devtron-labs/devtron/pkg/app"
+	bean9 "github.com/devtron-labs/devtron/pkg/deployment/common/bean"
+	"github.com/devtron-labs/devtron/pkg/deployment/trigger/devtronApps/adapter"
+	"github.com/devtron-labs/devtron/pkg/deployment/trigger/devtronApps/bean"
+	"github.com/devtron-labs/devtron/pkg/deployment/trigger/devtronApps/helper"
+	"go.opentelemetry.io/otel"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+)
+
+const diffPreviewFieldManager = "devtron-diff-preview"
+
+// GetManifestDiffPreview renders the manifests which TriggerRelease would apply for the selected artifact and diffs them
+// against the live objects in the cluster. The expected object is the server side dry run apply of the rendered object
+// (defaulted and mutated by admission) without the fields which the last release rendered and the new one does not,
+// the same way the three way merge of the release removes them. Resources of the last release which are not rendered
+// anymore are reported as removed. Nothing is persisted or applied.
+func (impl *HandlerServiceImpl) GetManifestDiffPreview(ctx context.Context, overrideRequest *bean3.ValuesOverrideRequest) (*bean.ManifestDiffPreview, error) {
+	newCtx, span := otel.Tracer("orchestrator").Start(ctx, "HandlerServiceImpl.GetManifestDiffPreview")
+	defer span.End()
+	cdPipeline, err := impl.pipelineRepository.FindById(overrideRequest.PipelineId)
+	if err != nil {
+		impl.logger.Errorw("error in getting cd pipeline by id", "pipelineId", overrideRequest.PipelineId, "err", err)
+		return nil, err
+	}
+	if cdPipeline.Environment.IsVirtualEnvironment {
+		errMsg := "manifest diff preview is not supported for virtual environments"
+		return nil, util.NewApiError(http.StatusBadRequest, errMsg, errMsg)
+	}
+	envDeploymentConfig, err := impl.deploymentConfigService.GetAndMigrateConfigIfAbsentForDevtronApps(nil, cdPipeline.AppId, cdPipeline.EnvironmentId)
+	if err != nil {
+		impl.logger.Errorw("error in fetching environment deployment config by appId and envId", "appId", cdPipeline.AppId, "envId", cdPipeline.EnvironmentId, "err", err)
+		return nil, err
+	}
+	adapter.SetPipelineFieldsInOverrideRequest(overrideRequest, cdPipeline, envDeploymentConfig)
+	overrideRequest.CdWorkflowType = bean3.CD_WORKFLOW_TYPE_DEPLOY
+	if overrideRequest.DeploymentType == models.DEPLOYMENTTYPE_UNKNOWN {
+		overrideRequest.DeploymentType = models.DEPLOYMENTTYPE_DEPLOY
+	}
+	overrideRequest.PipelineOverrideId = 0
+	overrideRequest.IsDryRun = true
+	valuesOverrideResponse, desiredManifest, err := impl.renderDryRunManifest(newCtx, overrideRequest, envDeploymentConfig)
+	if err != nil {
+		impl.logger.Errorw("error in rendering manifest for diff preview", "pipelineId", overrideRequest.PipelineId, "ciArtifactId", overrideRequest.CiArtifactId, "err", err)
+		return nil, err
+	}
+	desiredObjects, err := kube.SplitYAML([]byte(desiredManifest))
+	if err != nil {
+		impl.logger.Errorw("error in splitting rendered manifest", "pipelineId", overrideRequest.PipelineId, "err", err)
+		return nil, err
+	}
+	clusterConfig, err := impl.clusterService.GetClusterConfigByClusterId(overrideRequest.ClusterId)
+	if err != nil {
+		impl.logger.Errorw("error in getting cluster config", "clusterId", overrideRequest.ClusterId, "err", err)
+		return nil, err
+	}
+	restConfig, err := impl.K8sUtil.GetRestConfigByCluster(clusterConfig)
+	if err != nil {
+		impl.logger.Errorw("error in getting rest config", "clusterId", overrideRequest.ClusterId, "err", err)
+		return nil, err
+	}
+	preview := &bean.ManifestDiffPreview{
+		PipelineId:     overrideRequest.PipelineId,
+		CiArtifactId:   overrideRequest.CiArtifactId,
+		AppName:        overrideRequest.AppName,
+		EnvName:        overrideRequest.EnvName,
+		Namespace:      overrideRequest.Namespace,
+		ReleaseVersion: valuesOverrideResponse.PipelineOverride.PipelineReleaseCounter,
+		Resources:      make([]*bean.ResourceDiff, 0, len(desiredObjects)),
+	}
+	previousObjects := impl.getLastReleaseObjects(newCtx, overrideRequest, envDeploymentConfig)
+	previousObjectsByKey := make(map[kube.ResourceKey]*unstructured.Unstructured, len(previousObjects))
+	for _, previousObject := range previousObjects {
+		previousObjectsByKey[kube.GetResourceKey(previousObject)] = previousObject
+	}
+	desiredResourceKeys := make(map[kube.ResourceKey]bool, len(desiredObjects))
+	for _, desiredObject := range desiredObjects {
+		resourceKey := kube.GetResourceKey(desiredObject)
+		desiredResourceKeys[resourceKey] = true
+		preview.Resources = append(preview.Resources, impl.getResourceDiff(newCtx, restConfig, desiredObject, previousObjectsByKey[resourceKey], overrideRequest.Namespace))
+	}
+	for _, previousObject := range previousObjects {
+		if desiredResourceKeys[kube.GetResourceKey(previousObject)] {
+			continue
+		}
+		if resourceDiff := impl.getRemovedResourceDiff(newCtx, restConfig, previousObject, overrideRequest.Namespace); resourceDiff != nil {
+			preview.Resources = append(preview.Resources, resourceDiff)
+		}
+	}
+	return preview, nil
+}
+
+// renderDryRunManifest renders the manifest of the trigger request without persisting anything, the request must have IsDryRun set
+func (impl *HandlerServiceImpl) renderDryRunManifest(ctx context.Context, overrideRequest *bean3.ValuesOverrideRequest,
+	envDeploymentConfig *bean9.DeploymentConfig) (*app.ValuesOverrideResponse, string, error) {
+	valuesOverrideResponse, builtChartPath, err := impl.manifestCreationService.BuildManifestForTrigger(ctx, overrideRequest, envDeploymentConfig, time.Now())
+	if err != nil {
+		return nil, "", err
+	}
+	defer os.RemoveAll(builtChartPath)
+	cluster, err := impl.clusterRepository.FindById(overrideRequest.ClusterId)
+	if err != nil {
+		impl.logger.Errorw("error in getting cluster by id", "clusterId", overrideRequest.ClusterId, "err", err)
+		return nil, "", err
+	}
+	manifest, err := impl.renderTriggerManifest(ctx, valuesOverrideResponse, builtChartPath, cluster)
+	if err != nil {
+		return nil, "", err
+	}
+	return valuesOverrideResponse, manifest, nil
+}
+
+// getLastReleaseObjects renders the manifest of the last release of the pipeline from its saved merged values.
+// Failures are not blocking, the preview is then returned without removed resources.
+func (impl *HandlerServiceImpl) getLastReleaseObjects(ctx context.Context, overrideRequest *bean3.ValuesOverrideRequest,
+	envDeploymentConfig *bean9.DeploymentConfig) []*unstructured.Unstructured {
+	lastRelease, err := impl.pipelineOverrideRepository.GetLatestRelease(overrideRequest.AppId, overrideRequest.EnvId)
+	if err != nil {
+		if !util.IsErrNoRows(err) {
+			impl.logger.Errorw("error in getting last release for diff preview", "appId", overrideRequest.AppId, "envId", overrideRequest.EnvId, "err", err)
+		}
+		return nil
+	}
+	lastReleaseRequest := *overrideRequest
+	lastReleaseRequest.PipelineOverrideId = lastRelease.Id
+	lastReleaseRequest.CiArtifactId = lastRelease.CiArtifactId
+	_, lastReleaseManifest, err := impl.renderDryRunManifest(ctx, &lastReleaseRequest, envDeploymentConfig)
+	if err != nil {
+		impl.logger.Errorw("error in rendering last release manifest for diff preview", "pipelineOverrideId", lastRelease.Id, "err", err)
+		return nil
+	}
+	lastReleaseObjects, err := kube.SplitYAML([]byte(lastReleaseManifest))
+	if err != nil {
+		impl.logger.Errorw("error in splitting last release manifest", "pipelineOverrideId", lastRelease.Id, "err", err)
+		return nil
+	}
+	return lastReleaseObjects
+}
+
+func (impl *HandlerServiceImpl) getResourceClient(restConfig *rest.Config, object *unstructured.Unstructured, releaseNamespace string) (dynamic.ResourceInterface, error) {
+	resourceIf, namespaced, err := impl.K8sUtil.GetResourceIf(restConfig, object.GroupVersionKind())
+	if err != nil {
+		return nil, err
+	}
+	if !namespaced {
+		object.SetNamespace("")
+		return resourceIf, nil
+	}
+	if len(object.GetNamespace()) == 0 {
+		object.SetNamespace(releaseNamespace)
+	}
+	return resourceIf.Namespace(object.GetNamespace()), nil
+}
+
+// getResourceDiff diffs the live object against the expected object after the release. The dry run is applied by a
+// field manager which owns none of the live fields, so fields dropped from the chart are still part of its result;
+// they are pruned using the object rendered by the last release.
+func (impl *HandlerServiceImpl) getResourceDiff(ctx context.Context, restConfig *rest.Config, desiredObject, previousObject *unstructured.Unstructured,
+	releaseNamespace string) *bean.ResourceDiff {
+	resourceDiff := newResourceDiff(desiredObject)
+	resourceClient, err := impl.getResourceClient(restConfig, desiredObject, releaseNamespace)
+	if err != nil {
+		return setResourceDiffError(resourceDiff, err)
+	}
+	resourceDiff.Namespace = desiredObject.GetNamespace()
+	var liveObject map[string]interface{}
+	live, err := resourceClient.Get(ctx, desiredObject.GetName(), metav1.GetOptions{})
+	if err == nil {
+		liveObject = live.Object
+	} else if !k8sErrors.IsNotFound(err) {
+		return setResourceDiffError(resourceDiff, err)
+	}
+	data, err := json.Marshal(desiredObject.Object)
+	if err != nil {
+		return setResourceDiffError(resourceDiff, err)
+	}
+	force := true
+	dryRunObject, err := resourceClient.Patch(ctx, desiredObject.GetName(), types.ApplyPatchType, data,
+		metav1.PatchOptions{DryRun: []string{metav1.DryRunAll}, FieldManager: diffPreviewFieldManager, Force: &force})
+	if err != nil {
+		impl.logger.Errorw("error in server side dry run apply", "kind", resourceDiff.Kind, "name", resourceDiff.Name, "namespace", resourceDiff.Namespace, "err", err)
+		return setResourceDiffError(resourceDiff, err)
+	}
+	expectedObject := dryRunObject.Object
+	if previousObject != nil {
+		helper.RemoveFieldsDroppedFromRelease(expectedObject, previousObject.Object, desiredObject.Object)
+	}
+	resourceDiff.Fields = helper.GetFieldDiffs(resourceDiff.Kind, helper.SanitizeObjectForDiff(liveObject), helper.SanitizeObjectForDiff(expectedObject))
+	switch {
+	case liveObject == nil:
+		resourceDiff.Status = bean.ResourceDiffStatusAdded
+	case len(resourceDiff.Fields) == 0:
+		resourceDiff.Status = bean.ResourceDiffStatusUnchanged
+	default:
+		resourceDiff.Status = bean.ResourceDiffStatusChanged
+	}
+	return resourceDiff
+}
+
+// getRemovedResourceDiff returns nil if the object of the last release is not present in the cluster anymore
+func (impl *HandlerServiceImpl) getRemovedResourceDiff(ctx context.Context, restConfig *rest.Config, previousObject *unstructured.Unstructured, releaseNamespace string) *bean.ResourceDiff {
+	resourceDiff := newResourceDiff(previousObject)
+	resourceClient, err := impl.getResourceClient(restConfig, previousObject, releaseNamespace)
+	if err != nil {
+		return setResourceDiffError(resourceDiff, err)
+	}
+	resourceDiff.Namespace = previousObject.GetNamespace()
+	live, err := resourceClient.Get(ctx, previousObject.GetName(), metav1.GetOptions{})
+	if k8sErrors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return setResourceDiffError(resourceDiff, err)
+	}
+	resourceDiff.Status = bean.ResourceDiffStatusRemoved
+	resourceDiff.Fields = helper.GetFieldDiffs(resourceDiff.Kind, helper.SanitizeObjectForDiff(live.Object), nil)
+	return resourceDiff
+}
+
+func newResourceDiff(object *unstructured.Unstructured) *bean.ResourceDiff {
+	gvk := object.GroupVersionKind()
+	return &bean.ResourceDiff{
+		Group:     gvk.Group,
+		Version:   gvk.Version,
+		Kind:      gvk.Kind,
+		Namespace: object.GetNamespace(),
+		Name:      object.GetName(),
+	}
+}
+
+func setResourceDiffError(resourceDiff *bean.ResourceDiff, err error) *bean.ResourceDiff {
+	resourceDiff.Status = bean.ResourceDiffStatusDryRunFailed
+	resourceDiff.Error = err.Error()
+	return resourceDiff
+}
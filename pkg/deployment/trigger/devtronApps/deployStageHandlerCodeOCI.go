@@ -0,0 +1,162 @@
+/*
+ * Copyright (c) 2024. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package devtronApps
+
+import (
+	"context"
+	"encoding/json"
+	bean3 "github.com/devtron-labs/devtron/api/bean"
+	"github.com/devtron-labs/devtron/client/argocdServer"
+	bean7 "github.com/devtron-labs/devtron/client/argocdServer/bean"
+	"github.com/devtron-labs/devtron/client/argocdServer/repoCredsK8sClient"
+	"github.com/devtron-labs/devtron/pkg/app"
+	bean4 "github.com/devtron-labs/devtron/pkg/app/bean"
+	bean2 "github.com/devtron-labs/devtron/pkg/bean"
+	"github.com/devtron-labs/devtron/pkg/pipeline/repository"
+	"go.opentelemetry.io/otel"
+	"path"
+)
+
+// buildManifestPushTemplateForOCIStorageType sets the container registry repository and the chart version
+// the rendered chart is pushed to, ArgoCd pulls the chart from the same OCI repository
+func (impl *HandlerServiceImpl) buildManifestPushTemplateForOCIStorageType(valuesOverrideResponse *app.ValuesOverrideResponse,
+	manifestPushConfig *repository.ManifestPushConfig, manifestPushTemplate *bean4.ManifestPushTemplate) error {
+	helmRepositoryConfig := &bean4.HelmRepositoryConfig{}
+	err := json.Unmarshal([]byte(manifestPushConfig.CredentialsConfig), helmRepositoryConfig)
+	if err != nil {
+		impl.logger.Errorw("error in unmarshalling helm repository config", "manifestPushConfigId", manifestPushConfig.Id, "err", err)
+		return err
+	}
+	dockerArtifactStore, err := impl.dockerArtifactStoreRepository.FindOne(helmRepositoryConfig.ContainerRegistryName)
+	if err != nil {
+		impl.logger.Errorw("error in fetching container registry", "containerRegistryName", helmRepositoryConfig.ContainerRegistryName, "err", err)
+		return err
+	}
+	registryHost, chartRepository, err := repoCredsK8sClient.GetHostAndFullRepoPath(dockerArtifactStore.RegistryURL, path.Join(helmRepositoryConfig.RepositoryName, manifestPushConfig.ChartName))
+	if err != nil {
+		impl.logger.Errorw("error in parsing container registry url", "registryUrl", dockerArtifactStore.RegistryURL, "err", err)
+		return err
+	}
+	manifestPushTemplate.StorageType = bean2.ManifestStorageOCI
+	manifestPushTemplate.HelmRepositoryConfig = helmRepositoryConfig
+	manifestPushTemplate.ChartReferenceTemplate = valuesOverrideResponse.EnvOverride.Chart.ReferenceTemplate
+	manifestPushTemplate.ChartName = manifestPushConfig.ChartName
+	manifestPushTemplate.ChartVersion = bean4.GetOCIChartVersion(manifestPushConfig.ChartBaseVersion, valuesOverrideResponse.PipelineOverride.Id)
+	manifestPushTemplate.RepoUrl = registryHost
+	manifestPushTemplate.ChartLocation = chartRepository
+	manifestPushTemplate.TargetRevision = manifestPushTemplate.ChartVersion
+	manifestPushTemplate.ReleaseMode = valuesOverrideResponse.DeploymentConfig.ReleaseMode
+	manifestPushTemplate.ArgoSyncNeeded = valuesOverrideResponse.DeploymentConfig.IsArgoAppSyncAndRefreshSupported()
+	return nil
+}
+
+// deployArgoCdAppFromOCIRegistry creates or patches the ArgoCd application with the helm OCI source,
+// the chart has been pushed to the container registry by publish.OCIManifestPushServiceImpl
+func (impl *HandlerServiceImpl) deployArgoCdAppFromOCIRegistry(ctx context.Context, overrideRequest *bean3.ValuesOverrideRequest,
+	valuesOverrideResponse *app.ValuesOverrideResponse) error {
+	newCtx, span := otel.Tracer("orchestrator").Start(ctx, "HandlerServiceImpl.deployArgoCdAppFromOCIRegistry")
+	defer span.End()
+	manifestPushTemplate := valuesOverrideResponse.ManifestPushTemplate
+	if manifestPushTemplate == nil || !manifestPushTemplate.IsOCIStorage() {
+		// chart push has been performed in an earlier attempt of this deployment
+		var err error
+		manifestPushTemplate, err = impl.buildManifestPushTemplate(overrideRequest, valuesOverrideResponse, "")
+		if err != nil {
+			impl.logger.Errorw("error in building manifest push template", "cdWfrId", overrideRequest.WfrId, "err", err)
+			return err
+		}
+	}
+	pipeline := valuesOverrideResponse.Pipeline
+	deploymentConfig := valuesOverrideResponse.DeploymentConfig
+	if deploymentConfig.IsArgoAppCreationRequired(pipeline.DeploymentAppCreated) {
+		err := impl.createOCIArgoApplication(newCtx, valuesOverrideResponse, manifestPushTemplate, overrideRequest.UserId)
+		if err != nil {
+			impl.logger.Errorw("acd application create error on cd trigger", "err", err, "req", overrideRequest)
+			return err
+		}
+	} else if deploymentConfig.IsArgoAppPatchSupported() {
+		err := impl.patchOCIArgoApplication(newCtx, pipeline.DeploymentAppName, manifestPushTemplate)
+		if err != nil {
+			impl.logger.Errorw("error in updating argocd app ", "argoAppName", pipeline.DeploymentAppName, "err", err)
+			return err
+		}
+	}
+	if deploymentConfig.IsArgoAppSyncAndRefreshSupported() {
+		return impl.syncArgoCdApp(newCtx, overrideRequest, pipeline.DeploymentAppName, manifestPushTemplate.TargetRevision)
+	}
+	return nil
+}
+
+func (impl *HandlerServiceImpl) createOCIArgoApplication(ctx context.Context, valuesOverrideResponse *app.ValuesOverrideResponse,
+	manifestPushTemplate *bean4.ManifestPushTemplate, userId int32) error {
+	envModel, err := impl.envRepository.FindById(valuesOverrideResponse.EnvOverride.TargetEnvironment)
+	if err != nil {
+		return err
+	}
+	appNamespace := valuesOverrideResponse.EnvOverride.Namespace
+	if appNamespace == "" {
+		appNamespace = "default"
+	}
+	appRequest := &argocdServer.AppTemplate{
+		ApplicationName: valuesOverrideResponse.Pipeline.DeploymentAppName,
+		Namespace:       argocdServer.DevtronInstalationNs,
+		TargetNamespace: appNamespace,
+		TargetServer:    envModel.Cluster.ServerUrl,
+		Project:         "default",
+		RepoUrl:         manifestPushTemplate.RepoUrl,
+		Chart:           manifestPushTemplate.ChartLocation,
+		TargetRevision:  manifestPushTemplate.TargetRevision,
+		AutoSyncEnabled: impl.ACDConfig.ArgoCDAutoSyncEnabled,
+	}
+	_, err = impl.argoK8sClient.CreateAcdApp(ctx, appRequest, argocdServer.ARGOCD_OCI_APPLICATION_TEMPLATE)
+	if err != nil {
+		return err
+	}
+	// update cd pipeline to mark deployment app created
+	_, err = impl.updatePipeline(valuesOverrideResponse.Pipeline, userId)
+	if err != nil {
+		impl.logger.Errorw("error in update cd pipeline for deployment app created or not", "err", err)
+		return err
+	}
+	return nil
+}
+
+func (impl *HandlerServiceImpl) patchOCIArgoApplication(ctx context.Context, argoAppName string, manifestPushTemplate *bean4.ManifestPushTemplate) error {
+	argoApplication, err := impl.argoClientWrapperService.GetArgoAppByName(ctx, argoAppName)
+	if err != nil {
+		impl.logger.Errorw("unable to get ArgoCd app", "app", argoAppName, "err", err)
+		return err
+	}
+	source := argoApplication.Spec.Source
+	if source != nil && (source.RepoURL != manifestPushTemplate.RepoUrl ||
+		source.Chart != manifestPushTemplate.ChartLocation ||
+		source.TargetRevision != manifestPushTemplate.TargetRevision) {
+		patchRequestDto := &bean7.ArgoCdAppPatchReqDto{
+			ArgoAppName:    argoAppName,
+			GitRepoUrl:     manifestPushTemplate.RepoUrl,
+			Chart:          manifestPushTemplate.ChartLocation,
+			TargetRevision: manifestPushTemplate.TargetRevision,
+			PatchType:      bean7.PatchTypeMerge,
+		}
+		err = impl.argoClientWrapperService.PatchArgoCdApp(ctx, patchRequestDto)
+		if err != nil {
+			impl.logger.Errorw("error in patching argo application", "err", err, "req", patchRequestDto)
+			return err
+		}
+	}
+	return impl.argoClientWrapperService.UpdateArgoCDSyncModeIfNeeded(ctx, argoApplication)
+}
@@ -0,0 +1,82 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package devtronApps
+
+import (
+	"testing"
+
+	"github.com/devtron-labs/devtron/internal/sql/repository/chartConfig"
+	repository4 "github.com/devtron-labs/devtron/internal/sql/repository/dockerRegistry"
+	dockerRegistryMocks "github.com/devtron-labs/devtron/internal/sql/repository/dockerRegistry/mocks"
+	"github.com/devtron-labs/devtron/internal/util"
+	"github.com/devtron-labs/devtron/pkg/app"
+	bean4 "github.com/devtron-labs/devtron/pkg/app/bean"
+	bean2 "github.com/devtron-labs/devtron/pkg/bean"
+	chartRepoRepository "github.com/devtron-labs/devtron/pkg/chartRepo/repository"
+	bean9 "github.com/devtron-labs/devtron/pkg/deployment/common/bean"
+	bean10 "github.com/devtron-labs/devtron/pkg/deployment/manifest/deploymentTemplate/bean"
+	"github.com/devtron-labs/devtron/pkg/pipeline/repository"
+	"github.com/go-pg/pg"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func TestBuildManifestPushTemplateForOCIStorageType(t *testing.T) {
+	dockerArtifactStoreRepository := dockerRegistryMocks.NewDockerArtifactStoreRepository(t)
+	dockerArtifactStoreRepository.On("FindOne", "ecr").Return(&repository4.DockerArtifactStore{Id: "ecr", RegistryURL: "https://123456789.dkr.ecr.us-east-1.amazonaws.com/devtron"}, nil)
+	dockerArtifactStoreRepository.On("FindOne", "deleted").Return(nil, pg.ErrNoRows)
+	impl := &HandlerServiceImpl{
+		logger:                        zap.NewNop().Sugar(),
+		dockerArtifactStoreRepository: dockerArtifactStoreRepository,
+	}
+	valuesOverrideResponse := &app.ValuesOverrideResponse{
+		EnvOverride:      &bean10.EnvConfigOverride{Chart: &chartRepoRepository.Chart{ReferenceTemplate: "reference-chart_4-20-0"}},
+		PipelineOverride: &chartConfig.PipelineOverride{Id: 42},
+		DeploymentConfig: &bean9.DeploymentConfig{DeploymentAppType: util.PIPELINE_DEPLOYMENT_TYPE_ACD, ReleaseMode: util.PIPELINE_RELEASE_MODE_CREATE},
+	}
+	t.Run("chart is pushed to the repository of the container registry", func(t *testing.T) {
+		manifestPushConfig := &repository.ManifestPushConfig{
+			Id:                1,
+			StorageType:       bean2.ManifestStorageOCI,
+			CredentialsConfig: `{"repositoryName":"charts","containerRegistryName":"ecr"}`,
+			ChartName:         "payments",
+			ChartBaseVersion:  "1.2.0",
+		}
+		manifestPushTemplate := &bean4.ManifestPushTemplate{}
+		err := impl.buildManifestPushTemplateForOCIStorageType(valuesOverrideResponse, manifestPushConfig, manifestPushTemplate)
+		assert.NoError(t, err)
+		assert.True(t, manifestPushTemplate.IsOCIStorage())
+		assert.Equal(t, "123456789.dkr.ecr.us-east-1.amazonaws.com", manifestPushTemplate.RepoUrl)
+		assert.Equal(t, "devtron/charts/payments", manifestPushTemplate.ChartLocation)
+		assert.Equal(t, "payments", manifestPushTemplate.ChartName)
+		assert.Equal(t, "1.2.0-42", manifestPushTemplate.ChartVersion)
+		assert.Equal(t, manifestPushTemplate.ChartVersion, manifestPushTemplate.TargetRevision)
+		assert.Equal(t, "reference-chart_4-20-0", manifestPushTemplate.ChartReferenceTemplate)
+		assert.Equal(t, &bean4.HelmRepositoryConfig{RepositoryName: "charts", ContainerRegistryName: "ecr"}, manifestPushTemplate.HelmRepositoryConfig)
+		assert.True(t, manifestPushTemplate.ArgoSyncNeeded)
+	})
+	t.Run("missing container registry fails the push", func(t *testing.T) {
+		manifestPushConfig := &repository.ManifestPushConfig{
+			Id:                2,
+			StorageType:       bean2.ManifestStorageOCI,
+			CredentialsConfig: `{"repositoryName":"charts","containerRegistryName":"deleted"}`,
+			ChartName:         "payments",
+		}
+		err := impl.buildManifestPushTemplateForOCIStorageType(valuesOverrideResponse, manifestPushConfig, &bean4.ManifestPushTemplate{})
+		assert.ErrorIs(t, err, pg.ErrNoRows)
+	})
+}
@@ -0,0 +1,204 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package helper
+
+import (
+	"fmt"
+	"github.com/devtron-labs/devtron/pkg/deployment/trigger/devtronApps/bean"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+const lastAppliedConfigAnnotation = "kubectl.kubernetes.io/last-applied-configuration"
+
+// server populated metadata fields which are not part of the desired state
+var volatileMetadataFields = []string{"managedFields", "resourceVersion", "generation", "uid", "creationTimestamp", "selfLink"}
+
+// SanitizeObjectForDiff removes status and server populated metadata from the object so only the desired state is compared
+func SanitizeObjectForDiff(object map[string]interface{}) map[string]interface{} {
+	if object == nil {
+		return nil
+	}
+	delete(object, "status")
+	metadata, ok := object["metadata"].(map[string]interface{})
+	if !ok {
+		return object
+	}
+	for _, field := range volatileMetadataFields {
+		delete(metadata, field)
+	}
+	if annotations, ok := metadata["annotations"].(map[string]interface{}); ok {
+		delete(annotations, lastAppliedConfigAnnotation)
+		if len(annotations) == 0 {
+			delete(metadata, "annotations")
+		}
+	}
+	return object
+}
+
+// RemoveFieldsDroppedFromRelease removes the fields from the expected object which were rendered in the previous
+// release but are not rendered in the desired object anymore. Items of lists are matched by their name.
+func RemoveFieldsDroppedFromRelease(expected, previous, desired map[string]interface{}) {
+	for key, previousValue := range previous {
+		desiredValue, ok := desired[key]
+		if !ok {
+			delete(expected, key)
+			continue
+		}
+		switch previousChild := previousValue.(type) {
+		case map[string]interface{}:
+			expectedChild, isExpectedMap := expected[key].(map[string]interface{})
+			desiredChild, isDesiredMap := desiredValue.(map[string]interface{})
+			if isExpectedMap && isDesiredMap {
+				RemoveFieldsDroppedFromRelease(expectedChild, previousChild, desiredChild)
+			}
+		case []interface{}:
+			expectedList, isExpectedList := expected[key].([]interface{})
+			desiredList, isDesiredList := desiredValue.([]interface{})
+			if isExpectedList && isDesiredList {
+				expected[key] = removeItemsDroppedFromRelease(expectedList, previousChild, desiredList)
+			}
+		}
+	}
+}
+
+// removeItemsDroppedFromRelease handles lists of named items (containers, env, ports, volumes), other lists are
+// replaced as a whole on apply and are returned as is
+func removeItemsDroppedFromRelease(expected, previous, desired []interface{}) []interface{} {
+	desiredItems := make(map[string]map[string]interface{}, len(desired))
+	for _, item := range desired {
+		name, itemMap := getListItemName(item)
+		if len(name) == 0 {
+			return expected
+		}
+		desiredItems[name] = itemMap
+	}
+	previousItems := make(map[string]map[string]interface{}, len(previous))
+	for _, item := range previous {
+		name, itemMap := getListItemName(item)
+		if len(name) == 0 {
+			return expected
+		}
+		previousItems[name] = itemMap
+	}
+	result := make([]interface{}, 0, len(expected))
+	for _, item := range expected {
+		name, itemMap := getListItemName(item)
+		previousItem, isPrevious := previousItems[name]
+		desiredItem, isDesired := desiredItems[name]
+		if len(name) == 0 || !isPrevious {
+			result = append(result, item)
+			continue
+		}
+		if !isDesired {
+			continue
+		}
+		RemoveFieldsDroppedFromRelease(itemMap, previousItem, desiredItem)
+		result = append(result, itemMap)
+	}
+	return result
+}
+
+func getListItemName(item interface{}) (string, map[string]interface{}) {
+	itemMap, ok := item.(map[string]interface{})
+	if !ok {
+		return "", nil
+	}
+	name, _ := itemMap["name"].(string)
+	return name, itemMap
+}
+
+// GetFieldDiffs returns the leaf level field differences between the live and the desired object, sorted by path.
+// Values of secret data are masked.
+func GetFieldDiffs(kind string, live, desired map[string]interface{}) []*bean.FieldDiff {
+	fieldDiffs := make([]*bean.FieldDiff, 0)
+	diffValues("", live, desired, &fieldDiffs)
+	if kind == "Secret" {
+		for _, fieldDiff := range fieldDiffs {
+			if isSecretDataPath(fieldDiff.Path) {
+				fieldDiff.OldValue = maskValue(fieldDiff.OldValue)
+				fieldDiff.NewValue = maskValue(fieldDiff.NewValue)
+			}
+		}
+	}
+	sort.Slice(fieldDiffs, func(i, j int) bool {
+		return fieldDiffs[i].Path < fieldDiffs[j].Path
+	})
+	return fieldDiffs
+}
+
+func diffValues(path string, oldValue, newValue interface{}, fieldDiffs *[]*bean.FieldDiff) {
+	oldMap, isOldMap := oldValue.(map[string]interface{})
+	newMap, isNewMap := newValue.(map[string]interface{})
+	if (isOldMap || oldValue == nil) && (isNewMap || newValue == nil) && (isOldMap || isNewMap) {
+		for key, newChild := range newMap {
+			diffValues(joinFieldPath(path, key), oldMap[key], newChild, fieldDiffs)
+		}
+		for key, oldChild := range oldMap {
+			if _, ok := newMap[key]; !ok {
+				diffValues(joinFieldPath(path, key), oldChild, nil, fieldDiffs)
+			}
+		}
+		return
+	}
+	oldList, isOldList := oldValue.([]interface{})
+	newList, isNewList := newValue.([]interface{})
+	if isOldList && isNewList && len(oldList) == len(newList) {
+		for i := range newList {
+			diffValues(fmt.Sprintf("%s[%d]", path, i), oldList[i], newList[i], fieldDiffs)
+		}
+		return
+	}
+	switch {
+	case oldValue == nil && newValue == nil:
+		return
+	case oldValue == nil:
+		*fieldDiffs = append(*fieldDiffs, &bean.FieldDiff{Path: path, Operation: bean.FieldDiffOperationAdded, NewValue: newValue})
+	case newValue == nil:
+		*fieldDiffs = append(*fieldDiffs, &bean.FieldDiff{Path: path, Operation: bean.FieldDiffOperationRemoved, OldValue: oldValue})
+	case !reflect.DeepEqual(oldValue, newValue):
+		*fieldDiffs = append(*fieldDiffs, &bean.FieldDiff{Path: path, Operation: bean.FieldDiffOperationChanged, OldValue: oldValue, NewValue: newValue})
+	}
+}
+
+func joinFieldPath(path, key string) string {
+	if strings.Contains(key, ".") {
+		key = fmt.Sprintf("[%q]", key)
+		return path + key
+	}
+	if len(path) == 0 {
+		return key
+	}
+	return path + "." + key
+}
+
+func isSecretDataPath(path string) bool {
+	for _, field := range []string{"data", "stringData"} {
+		if path == field || strings.HasPrefix(path, field+".") || strings.HasPrefix(path, field+"[") {
+			return true
+		}
+	}
+	return false
+}
+
+func maskValue(value interface{}) interface{} {
+	if value == nil {
+		return nil
+	}
+	return bean.MaskedSecretValue
+}
@@ -0,0 +1,116 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package helper
+
+import (
+	"github.com/devtron-labs/devtron/pkg/deployment/trigger/devtronApps/bean"
+	"testing"
+)
+
+func TestGetFieldDiffs(t *testing.T) {
+	live := SanitizeObjectForDiff(map[string]interface{}{
+		"kind": "Secret",
+		"metadata": map[string]interface{}{
+			"name":            "app-secret",
+			"resourceVersion": "12",
+			"labels":          map[string]interface{}{"app": "demo", "team": "core"},
+		},
+		"data":   map[string]interface{}{"password": "b2xk", "user": "YWRtaW4="},
+		"status": map[string]interface{}{"phase": "Active"},
+	})
+	desired := map[string]interface{}{
+		"kind": "Secret",
+		"metadata": map[string]interface{}{
+			"name":   "app-secret",
+			"labels": map[string]interface{}{"app": "demo", "app.kubernetes.io/version": "v2"},
+		},
+		"data": map[string]interface{}{"password": "bmV3", "user": "YWRtaW4="},
+	}
+	fieldDiffs := GetFieldDiffs("Secret", live, desired)
+	expected := []bean.FieldDiff{
+		{Path: "data.password", Operation: bean.FieldDiffOperationChanged, OldValue: bean.MaskedSecretValue, NewValue: bean.MaskedSecretValue},
+		{Path: "metadata.labels.team", Operation: bean.FieldDiffOperationRemoved, OldValue: "core"},
+		{Path: `metadata.labels["app.kubernetes.io/version"]`, Operation: bean.FieldDiffOperationAdded, NewValue: "v2"},
+	}
+	if len(fieldDiffs) != len(expected) {
+		t.Fatalf("expected %d field diffs, got %d", len(expected), len(fieldDiffs))
+	}
+	for i, fieldDiff := range fieldDiffs {
+		if *fieldDiff != expected[i] {
+			t.Errorf("expected %+v, got %+v", expected[i], *fieldDiff)
+		}
+	}
+	if len(GetFieldDiffs("Secret", desired, desired)) != 0 {
+		t.Errorf("expected no diff for identical objects")
+	}
+}
+
+func TestRemoveFieldsDroppedFromRelease(t *testing.T) {
+	previous := map[string]interface{}{
+		"metadata": map[string]interface{}{"labels": map[string]interface{}{"app": "demo", "tier": "web"}},
+		"spec": map[string]interface{}{
+			"replicas": int64(2),
+			"containers": []interface{}{
+				map[string]interface{}{"name": "app", "env": []interface{}{
+					map[string]interface{}{"name": "A", "value": "1"},
+					map[string]interface{}{"name": "B", "value": "2"},
+				}},
+				map[string]interface{}{"name": "sidecar"},
+			},
+		},
+	}
+	desired := map[string]interface{}{
+		"metadata": map[string]interface{}{"labels": map[string]interface{}{"app": "demo"}},
+		"spec": map[string]interface{}{
+			"containers": []interface{}{
+				map[string]interface{}{"name": "app", "env": []interface{}{
+					map[string]interface{}{"name": "A", "value": "1"},
+				}},
+			},
+		},
+	}
+	// dry run result of a field manager owning no fields keeps the fields dropped from the chart
+	expected := map[string]interface{}{
+		"metadata": map[string]interface{}{"labels": map[string]interface{}{"app": "demo", "tier": "web", "injected": "true"}},
+		"spec": map[string]interface{}{
+			"replicas": int64(2),
+			"containers": []interface{}{
+				map[string]interface{}{"name": "app", "imagePullPolicy": "Always", "env": []interface{}{
+					map[string]interface{}{"name": "A", "value": "1"},
+					map[string]interface{}{"name": "B", "value": "2"},
+				}},
+				map[string]interface{}{"name": "sidecar"},
+				map[string]interface{}{"name": "istio-proxy"},
+			},
+		},
+	}
+	RemoveFieldsDroppedFromRelease(expected, previous, desired)
+	fieldDiffs := GetFieldDiffs("Deployment", expected, map[string]interface{}{
+		"metadata": map[string]interface{}{"labels": map[string]interface{}{"app": "demo", "injected": "true"}},
+		"spec": map[string]interface{}{
+			"containers": []interface{}{
+				map[string]interface{}{"name": "app", "imagePullPolicy": "Always", "env": []interface{}{
+					map[string]interface{}{"name": "A", "value": "1"},
+				}},
+				map[string]interface{}{"name": "istio-proxy"},
+			},
+		},
+	})
+	for _, fieldDiff := range fieldDiffs {
+		t.Errorf("unexpected field diff %+v", *fieldDiff)
+	}
+}
@@ -43,6 +43,7 @@ func (impl *HandlerServiceImpl) TriggerPostStage(request bean.CdTriggerRequest)
 		return nil, nil
 	}
 	request.RunStageInEnvNamespace = namespace
+	request.WorkflowExecutorType = impl.getWorkflowExecutorTypeForStage(request, env)
 
 	cdWf, runner, err := impl.createStartingWfAndRunner(request, triggeredAt)
 	if err != nil {
@@ -36,6 +36,7 @@ import (
 	bean4 "github.com/devtron-labs/devtron/pkg/bean"
 	"github.com/devtron-labs/devtron/pkg/bean/common"
 	buildCommonBean "github.com/devtron-labs/devtron/pkg/build/pipeline/bean/common"
+	clusterBean "github.com/devtron-labs/devtron/pkg/cluster/bean"
 	repository4 "github.com/devtron-labs/devtron/pkg/cluster/environment/repository"
 	bean5 "github.com/devtron-labs/devtron/pkg/deployment/common/bean"
 	adapter2 "github.com/devtron-labs/devtron/pkg/deployment/trigger/devtronApps/adapter"
@@ -103,6 +104,7 @@ func (impl *HandlerServiceImpl) TriggerPreStage(request bean.CdTriggerRequest) (
 		return nil, nil
 	}
 	request.RunStageInEnvNamespace = namespace
+	request.WorkflowExecutorType = impl.getWorkflowExecutorTypeForStage(request, env)
 
 	filterEvaluationAudit, err := impl.checkFeasibilityForPreStage(pipeline, &request, env, artifact, triggeredBy)
 	if err != nil {
@@ -298,7 +300,7 @@ func (impl *HandlerServiceImpl) createStartingWfAndRunner(request bean.CdTrigger
 	runner := &pipelineConfig.CdWorkflowRunner{
 		Name:                  pipeline.Name,
 		WorkflowType:          request.WorkflowType,
-		ExecutorType:          impl.config.GetWorkflowExecutorType(),
+		ExecutorType:          request.WorkflowExecutorType,
 		Status:                cdWorkflow.WorkflowStarting, // starting PreStage
 		PodStatus:             string(v1alpha1.NodePending),
 		TriggeredBy:           triggeredBy,
@@ -324,17 +326,11 @@ func (impl *HandlerServiceImpl) createStartingWfAndRunner(request bean.CdTrigger
 }
 
 func (impl *HandlerServiceImpl) getEnvAndNsIfRunStageInEnv(ctx context.Context, request bean.CdTriggerRequest) (*repository4.Environment, string, error) {
-	workflowStage := request.WorkflowType
 	pipeline := request.Pipeline
 	var env *repository4.Environment
 	var err error
 	namespace := impl.config.GetDefaultNamespace()
-	runStageInEnv := false
-	if workflowStage == apiBean.CD_WORKFLOW_TYPE_PRE {
-		runStageInEnv = pipeline.RunPreStageInEnv
-	} else if workflowStage == apiBean.CD_WORKFLOW_TYPE_POST {
-		runStageInEnv = pipeline.RunPostStageInEnv
-	}
+	runStageInEnv := isRunStageInEnv(request)
 	_, span := otel.Tracer("orchestrator").Start(ctx, "envRepository.FindById")
 	env, err = impl.envRepository.FindById(pipeline.EnvironmentId)
 	span.End()
@@ -348,10 +344,50 @@ func (impl *HandlerServiceImpl) getEnvAndNsIfRunStageInEnv(ctx context.Context,
 	return env, namespace, nil
 }
 
+func isRunStageInEnv(request bean.CdTriggerRequest) bool {
+	if request.WorkflowType == apiBean.CD_WORKFLOW_TYPE_PRE {
+		return request.Pipeline.RunPreStageInEnv
+	} else if request.WorkflowType == apiBean.CD_WORKFLOW_TYPE_POST {
+		return request.Pipeline.RunPostStageInEnv
+	}
+	return false
+}
+
+// getWorkflowExecutorTypeForStage returns the executor configured on the cluster the stage runs in, i.e. the env cluster when the stage
+// runs in env or the default cluster, else the executor of the infra profile applied to the pipeline scope, else the global executor
+func (impl *HandlerServiceImpl) getWorkflowExecutorTypeForStage(request bean.CdTriggerRequest, env *repository4.Environment) cdWorkflow.WorkflowExecutorType {
+	if isRunStageInEnv(request) {
+		if env != nil && env.Cluster != nil && len(env.Cluster.WorkflowExecutorType) > 0 {
+			return cdWorkflow.WorkflowExecutorType(env.Cluster.WorkflowExecutorType)
+		}
+	} else {
+		defaultCluster, err := impl.clusterService.FindByIdWithoutConfig(clusterBean.DefaultClusterId)
+		if err != nil {
+			impl.logger.Errorw("error in fetching default cluster, skipping cluster workflow executor", "clusterId", clusterBean.DefaultClusterId, "err", err)
+		} else if len(defaultCluster.WorkflowExecutorType) > 0 {
+			return cdWorkflow.WorkflowExecutorType(defaultCluster.WorkflowExecutorType)
+		}
+	}
+	scope := resourceQualifiers.Scope{
+		AppId: request.Pipeline.AppId,
+	}
+	if env != nil {
+		scope.EnvId = env.Id
+		scope.ClusterId = env.ClusterId
+	}
+	profileExecutorType, err := impl.infraConfigService.GetWorkflowExecutorTypeByScope(scope)
+	if err != nil {
+		impl.logger.Errorw("error in fetching infra profile workflow executor, using global executor", "scope", scope, "err", err)
+	} else if len(profileExecutorType) > 0 {
+		return cdWorkflow.WorkflowExecutorType(profileExecutorType)
+	}
+	return impl.config.GetWorkflowExecutorType()
+}
+
 func (impl *HandlerServiceImpl) checkVulnerabilityStatusAndFailWfIfNeeded(ctx context.Context, artifact *repository.CiArtifact,
 	cdPipeline *pipelineConfig.Pipeline, runner *pipelineConfig.CdWorkflowRunner, triggeredBy int32) error {
 	//checking vulnerability for the selected image
-	vulnerabilityCheckRequest := adapter2.GetVulnerabilityCheckRequest(cdPipeline, artifact.ImageDigest)
+	vulnerabilityCheckRequest := adapter2.GetVulnerabilityCheckRequest(cdPipeline, artifact.ImageDigest, artifact.Image)
 	isVulnerable, err := impl.imageScanService.GetArtifactVulnerabilityStatus(ctx, vulnerabilityCheckRequest)
 	if err != nil {
 		impl.logger.Errorw("error in getting Artifact vulnerability status, TriggerPreStage", "err", err)
@@ -0,0 +1,87 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package devtronApps
+
+import (
+	"errors"
+	"testing"
+
+	apiBean "github.com/devtron-labs/devtron/api/bean"
+	"github.com/devtron-labs/devtron/internal/sql/repository/pipelineConfig"
+	"github.com/devtron-labs/devtron/internal/sql/repository/pipelineConfig/bean/workflow/cdWorkflow"
+	clusterBean "github.com/devtron-labs/devtron/pkg/cluster/bean"
+	repository4 "github.com/devtron-labs/devtron/pkg/cluster/environment/repository"
+	clusterMocks "github.com/devtron-labs/devtron/pkg/cluster/mocks"
+	clusterRepository "github.com/devtron-labs/devtron/pkg/cluster/repository"
+	"github.com/devtron-labs/devtron/pkg/deployment/trigger/devtronApps/bean"
+	infraConfigMocks "github.com/devtron-labs/devtron/pkg/infraConfig/service/mocks"
+	"github.com/devtron-labs/devtron/pkg/pipeline/types"
+	"github.com/devtron-labs/devtron/pkg/resourceQualifiers"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func TestGetWorkflowExecutorTypeForStage(t *testing.T) {
+	newHandlerService := func(clusterService *clusterMocks.ClusterService, infraService *infraConfigMocks.InfraConfigService) *HandlerServiceImpl {
+		return &HandlerServiceImpl{
+			logger:             zap.NewNop().Sugar(),
+			clusterService:     clusterService,
+			infraConfigService: infraService,
+			config:             &types.CdConfig{CiCdConfig: &types.CiCdConfig{Type: types.CdConfigType, CdWorkflowExecutorType: cdWorkflow.WORKFLOW_EXECUTOR_TYPE_AWF}},
+		}
+	}
+	preStageRequest := func(runInEnv bool) bean.CdTriggerRequest {
+		return bean.CdTriggerRequest{
+			Pipeline:     &pipelineConfig.Pipeline{AppId: 1, EnvironmentId: 2, RunPreStageInEnv: runInEnv},
+			WorkflowType: apiBean.CD_WORKFLOW_TYPE_PRE,
+		}
+	}
+	env := func(executorType string) *repository4.Environment {
+		return &repository4.Environment{Id: 2, ClusterId: 3, Cluster: &clusterRepository.Cluster{WorkflowExecutorType: executorType}}
+	}
+	defaultCluster := func(executorType string) *clusterBean.ClusterBean {
+		return &clusterBean.ClusterBean{Id: clusterBean.DefaultClusterId, WorkflowExecutorType: executorType}
+	}
+	scope := resourceQualifiers.Scope{AppId: 1, EnvId: 2, ClusterId: 3}
+	t.Run("env cluster executor takes precedence when the stage runs in env", func(t *testing.T) {
+		impl := newHandlerService(clusterMocks.NewClusterService(t), infraConfigMocks.NewInfraConfigService(t))
+		assert.Equal(t, cdWorkflow.WorkflowExecutorType("TEKTON"), impl.getWorkflowExecutorTypeForStage(preStageRequest(true), env("TEKTON")))
+	})
+	t.Run("stage in default cluster uses the default cluster executor", func(t *testing.T) {
+		clusterService := clusterMocks.NewClusterService(t)
+		clusterService.On("FindByIdWithoutConfig", clusterBean.DefaultClusterId).Return(defaultCluster("TEKTON"), nil)
+		impl := newHandlerService(clusterService, infraConfigMocks.NewInfraConfigService(t))
+		assert.Equal(t, cdWorkflow.WorkflowExecutorType("TEKTON"), impl.getWorkflowExecutorTypeForStage(preStageRequest(false), env("SYSTEM")))
+	})
+	t.Run("infra profile executor is used when the cluster has none", func(t *testing.T) {
+		clusterService := clusterMocks.NewClusterService(t)
+		clusterService.On("FindByIdWithoutConfig", clusterBean.DefaultClusterId).Return(defaultCluster(""), nil).Once()
+		infraService := infraConfigMocks.NewInfraConfigService(t)
+		infraService.On("GetWorkflowExecutorTypeByScope", scope).Return("TEKTON", nil).Twice()
+		impl := newHandlerService(clusterService, infraService)
+		assert.Equal(t, cdWorkflow.WorkflowExecutorType("TEKTON"), impl.getWorkflowExecutorTypeForStage(preStageRequest(false), env("")))
+		assert.Equal(t, cdWorkflow.WorkflowExecutorType("TEKTON"), impl.getWorkflowExecutorTypeForStage(preStageRequest(true), env("")))
+	})
+	t.Run("global executor is the fallback", func(t *testing.T) {
+		clusterService := clusterMocks.NewClusterService(t)
+		clusterService.On("FindByIdWithoutConfig", clusterBean.DefaultClusterId).Return(nil, errors.New("cluster not found"))
+		infraService := infraConfigMocks.NewInfraConfigService(t)
+		infraService.On("GetWorkflowExecutorTypeByScope", scope).Return("", nil)
+		impl := newHandlerService(clusterService, infraService)
+		assert.Equal(t, cdWorkflow.WorkflowExecutorType(cdWorkflow.WORKFLOW_EXECUTOR_TYPE_AWF), impl.getWorkflowExecutorTypeForStage(preStageRequest(false), env("")))
+	})
+}
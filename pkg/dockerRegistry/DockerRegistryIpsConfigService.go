@@ -39,6 +39,8 @@ import (
 type DockerRegistryIpsConfigService interface {
 	IsImagePullSecretAccessProvided(dockerRegistryId string, clusterId int, isVirtualEnv bool) (bool, error)
 	HandleImagePullSecretOnApplicationDeployment(ctx context.Context, environment *repository2.Environment, artifact *repository3.CiArtifact, ciPipelineId int, valuesFileContent []byte) ([]byte, error)
+	// SetImagePullSecretNameInValues only sets the image pull secret name in values, the secret is not created or updated in the cluster
+	SetImagePullSecretNameInValues(ctx context.Context, environment *repository2.Environment, artifact *repository3.CiArtifact, ciPipelineId int, valuesFileContent []byte) ([]byte, error)
 }
 
 type DockerRegistryIpsConfigServiceImpl struct {
@@ -83,6 +85,16 @@ func (impl DockerRegistryIpsConfigServiceImpl) IsImagePullSecretAccessProvided(d
 func (impl DockerRegistryIpsConfigServiceImpl) HandleImagePullSecretOnApplicationDeployment(ctx context.Context, environment *repository2.Environment, artifact *repository3.CiArtifact, ciPipelineId int, valuesFileContent []byte) ([]byte, error) {
 	_, span := otel.Tracer("orchestrator").Start(ctx, "DockerRegistryIpsConfigServiceImpl.HandleImagePullSecretOnApplicationDeployment")
 	defer span.End()
+	return impl.handleImagePullSecret(environment, artifact, ciPipelineId, valuesFileContent, true)
+}
+
+func (impl DockerRegistryIpsConfigServiceImpl) SetImagePullSecretNameInValues(ctx context.Context, environment *repository2.Environment, artifact *repository3.CiArtifact, ciPipelineId int, valuesFileContent []byte) ([]byte, error) {
+	_, span := otel.Tracer("orchestrator").Start(ctx, "DockerRegistryIpsConfigServiceImpl.SetImagePullSecretNameInValues")
+	defer span.End()
+	return impl.handleImagePullSecret(environment, artifact, ciPipelineId, valuesFileContent, false)
+}
+
+func (impl DockerRegistryIpsConfigServiceImpl) handleImagePullSecret(environment *repository2.Environment, artifact *repository3.CiArtifact, ciPipelineId int, valuesFileContent []byte, createOrUpdateSecret bool) ([]byte, error) {
 	clusterId := environment.ClusterId
 	impl.logger.Infow("handling ips if access given", "ciPipelineId", ciPipelineId, "clusterId", clusterId)
 
@@ -126,7 +138,7 @@ func (impl DockerRegistryIpsConfigServiceImpl) HandleImagePullSecretOnApplicatio
 	ipsName := BuildIpsName(*dockerRegistryId, ipsCredentialType, ipsConfig.CredentialValue)
 
 	// Create or update secret of credential type is not of NAME type
-	if ipsCredentialType != IPS_CREDENTIAL_TYPE_NAME {
+	if createOrUpdateSecret && ipsCredentialType != IPS_CREDENTIAL_TYPE_NAME {
 		err = impl.createOrUpdateDockerRegistryImagePullSecret(clusterId, environment.Namespace, ipsName, dockerRegistryBean)
 		if err != nil {
 			return nil, err
@@ -23,7 +23,10 @@ import (
 	"github.com/devtron-labs/common-lib/utils/registry"
 	"github.com/devtron-labs/devtron/api/bean"
 	"github.com/devtron-labs/devtron/internal/sql/repository/pipelineConfig"
+	sbomBean "github.com/devtron-labs/devtron/pkg/build/artifacts/sbom/bean"
+	cacheBean "github.com/devtron-labs/devtron/pkg/build/cache/bean"
 	bean3 "github.com/devtron-labs/devtron/pkg/pipeline/bean"
+	imageSigningBean "github.com/devtron-labs/devtron/pkg/policyGovernance/security/imageSigning/bean"
 	"github.com/devtron-labs/devtron/util"
 	"time"
 )
@@ -88,6 +91,12 @@ type CiCompleteEvent struct {
 	TargetPlatforms               []string                 `json:"targetPlatforms"`
 	pluginImageDetails            *registry.ImageDetailsFromCR
 	PluginArtifacts               *PluginArtifacts `json:"pluginArtifacts"`
+	// BuildCacheMetrics is sent by ci runner for builds with a managed buildx cache
+	BuildCacheMetrics *cacheBean.BuildCacheMetrics `json:"buildCacheMetrics,omitempty"`
+	// Sbom is sent by ci runner when the build produced one, e.g. the SBOM layers of a buildpack build
+	Sbom *sbomBean.Sbom `json:"sbom,omitempty"`
+	// ImageSignatures lists the keys ci runner signed the pushed image with
+	ImageSignatures []*imageSigningBean.ImageSignature `json:"imageSignatures,omitempty"`
 }
 
 func (c *CiCompleteEvent) GetPluginImageDetails() *registry.ImageDetailsFromCR {
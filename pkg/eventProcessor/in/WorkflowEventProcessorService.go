@@ -42,6 +42,9 @@ import (
 	util3 "github.com/devtron-labs/devtron/internal/util"
 	"github.com/devtron-labs/devtron/pkg/app"
 	userBean "github.com/devtron-labs/devtron/pkg/auth/user/bean"
+	"github.com/devtron-labs/devtron/pkg/build/artifacts/provenance"
+	"github.com/devtron-labs/devtron/pkg/build/artifacts/sbom"
+	"github.com/devtron-labs/devtron/pkg/build/cache"
 	"github.com/devtron-labs/devtron/pkg/build/trigger"
 	"github.com/devtron-labs/devtron/pkg/deployment/common"
 	"github.com/devtron-labs/devtron/pkg/deployment/deployedApp"
@@ -54,6 +57,7 @@ import (
 	eventProcessorBean "github.com/devtron-labs/devtron/pkg/eventProcessor/out/bean"
 	"github.com/devtron-labs/devtron/pkg/pipeline"
 	"github.com/devtron-labs/devtron/pkg/pipeline/executors"
+	"github.com/devtron-labs/devtron/pkg/policyGovernance/security/imageSigning"
 	"github.com/devtron-labs/devtron/pkg/ucid"
 	"github.com/devtron-labs/devtron/pkg/workflow/cd"
 	"github.com/devtron-labs/devtron/pkg/workflow/cd/adapter"
@@ -101,6 +105,11 @@ type WorkflowEventProcessorImpl struct {
 	//ent only
 	ciHandlerService trigger.HandlerService
 
+	buildCacheService   cache.BuildCacheService
+	sbomService         sbom.SbomService
+	imageSigningService imageSigning.ImageSigningService
+	provenanceService   provenance.ProvenanceService
+
 	// repositories import to be removed
 	pipelineRepository      pipelineConfig.PipelineRepository
 	ciArtifactRepository    repository.CiArtifactRepository
@@ -131,7 +140,11 @@ func NewWorkflowEventProcessorImpl(logger *zap.SugaredLogger,
 	cdWorkflowRepository pipelineConfig.CdWorkflowRepository,
 	deploymentConfigService common.DeploymentConfigService,
 	ciHandlerService trigger.HandlerService,
-	asyncRunnable *async.Runnable) (*WorkflowEventProcessorImpl, error) {
+	asyncRunnable *async.Runnable,
+	buildCacheService cache.BuildCacheService,
+	sbomService sbom.SbomService,
+	imageSigningService imageSigning.ImageSigningService,
+	provenanceService provenance.ProvenanceService) (*WorkflowEventProcessorImpl, error) {
 	impl := &WorkflowEventProcessorImpl{
 		logger:                          logger,
 		pubSubClient:                    pubSubClient,
@@ -161,6 +174,10 @@ func NewWorkflowEventProcessorImpl(logger *zap.SugaredLogger,
 		deploymentConfigService:         deploymentConfigService,
 		ciHandlerService:                ciHandlerService,
 		asyncRunnable:                   asyncRunnable,
+		buildCacheService:               buildCacheService,
+		sbomService:                     sbomService,
+		imageSigningService:             imageSigningService,
+		provenanceService:               provenanceService,
 	}
 	appServiceConfig, err := app.GetAppServiceConfig()
 	if err != nil {
@@ -588,6 +605,11 @@ func (impl *WorkflowEventProcessorImpl) SubscribeCICompleteEvent() error {
 			return
 		}
 		impl.logger.Debugw("ci complete event for ci", "ciPipelineId", ciCompleteEvent.PipelineId)
+		if ciCompleteEvent.WorkflowId != nil && ciCompleteEvent.BuildCacheMetrics != nil {
+			// saved before the failure reason is handled so that builds failing in a step report their cache usage too,
+			// the error is logged by the service and does not block processing the event
+			_ = impl.buildCacheService.SaveBuildCacheMetrics(*ciCompleteEvent.WorkflowId, ciCompleteEvent.BuildCacheMetrics)
+		}
 		req, err := impl.BuildCiArtifactRequest(ciCompleteEvent)
 		if err != nil {
 			return
@@ -636,6 +658,18 @@ func (impl *WorkflowEventProcessorImpl) SubscribeCICompleteEvent() error {
 			if err != nil {
 				return
 			}
+			if ciCompleteEvent.Sbom != nil && resp > 0 {
+				_ = impl.sbomService.SaveArtifactSbom(resp, ciCompleteEvent.Sbom, ciCompleteEvent.TriggeredBy)
+			}
+			if len(ciCompleteEvent.ImageSignatures) > 0 && resp > 0 {
+				_ = impl.imageSigningService.SaveArtifactSignatures(resp, ciCompleteEvent.ImageSignatures, ciCompleteEvent.TriggeredBy)
+			}
+			if ciCompleteEvent.WorkflowId != nil && resp > 0 {
+				err = impl.provenanceService.GenerateArtifactProvenance(resp, *ciCompleteEvent.WorkflowId, ciCompleteEvent.TriggeredBy)
+				if err != nil {
+					impl.logger.Errorw("error in generating artifact provenance", "ciArtifactId", resp, "ciWorkflowId", *ciCompleteEvent.WorkflowId, "err", err)
+				}
+			}
 			impl.logger.Debug(resp)
 		}
 	}
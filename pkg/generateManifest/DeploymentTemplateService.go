@@ -43,6 +43,7 @@ import (
 	"github.com/devtron-labs/devtron/pkg/pipeline"
 	"github.com/devtron-labs/devtron/pkg/resourceQualifiers"
 	"github.com/devtron-labs/devtron/pkg/variables"
+	"github.com/devtron-labs/devtron/pkg/variables/models"
 	"github.com/devtron-labs/devtron/pkg/variables/parsers"
 	"github.com/devtron-labs/devtron/pkg/variables/utils"
 	util2 "github.com/devtron-labs/devtron/util"
@@ -218,10 +219,11 @@ func (impl DeploymentTemplateServiceImpl) GetDeploymentTemplate(ctx context.Cont
 	var values, resolvedValue string
 	var err error
 	var variableSnapshot map[string]string
+	var variableResolutionTrace map[string]*models.VariableResolutionTrace
 
 	if request.Values != "" {
 		values = request.Values
-		resolvedValue, variableSnapshot, err = impl.resolveTemplateVariables(ctx, request.Values, request)
+		resolvedValue, variableSnapshot, variableResolutionTrace, err = impl.resolveTemplateVariables(ctx, request.Values, request)
 		if err != nil {
 			return result, err
 		}
@@ -247,6 +249,7 @@ func (impl DeploymentTemplateServiceImpl) GetDeploymentTemplate(ctx context.Cont
 		result.Data = values
 		result.ResolvedData = resolvedValue
 		result.VariableSnapshot = variableSnapshot
+		result.VariableResolutionTrace = variableResolutionTrace
 		if response != nil {
 			result = ConvertPointerDeploymentTemplateResponseToNonPointer(response)
 		}
@@ -254,6 +257,7 @@ func (impl DeploymentTemplateServiceImpl) GetDeploymentTemplate(ctx context.Cont
 	}
 	if variableSnapshot != nil {
 		result.VariableSnapshot = variableSnapshot
+		result.VariableResolutionTrace = variableResolutionTrace
 	}
 	request = impl.setRequestMetadata(&request)
 	manifest, err := impl.GenerateManifest(ctx, &request, resolvedValue)
@@ -271,10 +275,11 @@ func (impl DeploymentTemplateServiceImpl) GetDeploymentTemplateWithResolvedData(
 	var values, resolvedValue string
 	var err error
 	var variableSnapshot map[string]string
+	var variableResolutionTrace map[string]*models.VariableResolutionTrace
 
 	if request.Values != "" {
 		values = request.Values
-		resolvedValue, variableSnapshot, err = impl.resolveTemplateVariables(ctx, request.Values, request)
+		resolvedValue, variableSnapshot, variableResolutionTrace, err = impl.resolveTemplateVariables(ctx, request.Values, request)
 		if err != nil {
 			return result, err
 		}
@@ -283,6 +288,7 @@ func (impl DeploymentTemplateServiceImpl) GetDeploymentTemplateWithResolvedData(
 		result.Data = values
 		result.ResolvedData = resolvedValue
 		result.VariableSnapshot = variableSnapshot
+		result.VariableResolutionTrace = variableResolutionTrace
 		return result, nil
 	}
 
@@ -357,17 +363,18 @@ func (impl DeploymentTemplateServiceImpl) fetchResolvedTemplateForPublishedEnvs(
 		impl.Logger.Errorw("error in getting chart ref by chartRefId ", "chartRefId", request.ChartRefId, "err", err)
 		return nil, err
 	}
-	resolvedTemplate, variableSnapshot, err := impl.resolveTemplateVariables(ctx, values, request)
+	resolvedTemplate, variableSnapshot, variableResolutionTrace, err := impl.resolveTemplateVariables(ctx, values, request)
 	if err != nil {
 		impl.Logger.Errorw("error in resolving template variables for env override  ", "deploymentTemplateRequest", request, "err", err)
 		return nil, err
 	}
 	return &DeploymentTemplateResponse{
-		Data:                values,
-		ResolvedData:        resolvedTemplate,
-		VariableSnapshot:    variableSnapshot,
-		TemplateVersion:     version,
-		IsAppMetricsEnabled: *override.AppMetrics,
+		Data:                    values,
+		ResolvedData:            resolvedTemplate,
+		VariableSnapshot:        variableSnapshot,
+		VariableResolutionTrace: variableResolutionTrace,
+		TemplateVersion:         version,
+		IsAppMetricsEnabled:     *override.AppMetrics,
 	}, nil
 }
 
@@ -388,22 +395,22 @@ func (impl DeploymentTemplateServiceImpl) fetchTemplateForDeployedEnv(ctx contex
 	}, nil
 }
 
-func (impl DeploymentTemplateServiceImpl) resolveTemplateVariables(ctx context.Context, values string, request DeploymentTemplateRequest) (string, map[string]string, error) {
+func (impl DeploymentTemplateServiceImpl) resolveTemplateVariables(ctx context.Context, values string, request DeploymentTemplateRequest) (string, map[string]string, map[string]*models.VariableResolutionTrace, error) {
 
 	isSuperAdmin, err := util2.GetIsSuperAdminFromContext(ctx)
 	if err != nil {
-		return values, nil, err
+		return values, nil, nil, err
 	}
 	scope, err := impl.extractScopeData(request)
 	if err != nil {
-		return values, nil, err
+		return values, nil, nil, err
 	}
 	maskUnknownVariableForHelmGenerate := request.RequestDataMode == Manifest
-	resolvedTemplate, variableSnapshot, err := impl.scopedVariableManager.ExtractVariablesAndResolveTemplate(scope, values, parsers.StringVariableTemplate, isSuperAdmin, maskUnknownVariableForHelmGenerate)
+	resolvedTemplate, variableSnapshot, variableResolutionTrace, err := impl.scopedVariableManager.ExtractVariablesAndResolveTemplateWithTrace(scope, values, parsers.StringVariableTemplate, isSuperAdmin, maskUnknownVariableForHelmGenerate, true)
 	if err != nil {
-		return values, variableSnapshot, err
+		return values, variableSnapshot, variableResolutionTrace, err
 	}
-	return resolvedTemplate, variableSnapshot, nil
+	return resolvedTemplate, variableSnapshot, variableResolutionTrace, nil
 }
 
 func (impl DeploymentTemplateServiceImpl) extractScopeData(request DeploymentTemplateRequest) (resourceQualifiers.Scope, error) {
@@ -656,7 +663,7 @@ func (impl DeploymentTemplateServiceImpl) ResolveTemplateVariables(ctx context.C
 		return values, nil, err
 	}
 	maskUnknownVariableForHelmGenerate := request.RequestDataMode == Manifest
-	resolvedTemplate, variableSnapshot, err := impl.scopedVariableManager.ExtractVariablesAndResolveTemplate(scope, values, parsers.JsonVariableTemplate, isSuperAdmin, maskUnknownVariableForHelmGenerate)
+	resolvedTemplate, variableSnapshot, err := impl.scopedVariableManager.ExtractVariablesAndResolveTemplate(scope, values, parsers.JsonVariableTemplate, isSuperAdmin, maskUnknownVariableForHelmGenerate, true)
 	if err != nil {
 		return values, variableSnapshot, err
 	}
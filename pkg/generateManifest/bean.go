@@ -19,6 +19,7 @@ package generateManifest
 import (
 	"github.com/devtron-labs/devtron/api/helm-app/gRPC"
 	"github.com/devtron-labs/devtron/internal/sql/repository"
+	"github.com/devtron-labs/devtron/pkg/variables/models"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
@@ -70,11 +71,13 @@ var ReleaseIdentifier = &gRPC.ReleaseIdentifier{
 }
 
 type DeploymentTemplateResponse struct {
-	Data                string            `json:"data"`
-	ResolvedData        string            `json:"resolvedData"`
-	VariableSnapshot    map[string]string `json:"variableSnapshot"`
-	TemplateVersion     string            `json:"-"`
-	IsAppMetricsEnabled bool              `json:"-"`
+	Data             string            `json:"data"`
+	ResolvedData     string            `json:"resolvedData"`
+	VariableSnapshot map[string]string `json:"variableSnapshot"`
+	// VariableResolutionTrace maps each resolved scoped variable to the scope which supplied its value
+	VariableResolutionTrace map[string]*models.VariableResolutionTrace `json:"variableResolutionTrace,omitempty"`
+	TemplateVersion         string                                     `json:"-"`
+	IsAppMetricsEnabled     bool                                       `json:"-"`
 }
 
 type RestartPodResponse struct {
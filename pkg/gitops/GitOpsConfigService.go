@@ -37,6 +37,7 @@ import (
 	"github.com/devtron-labs/devtron/pkg/cluster/read"
 	"github.com/devtron-labs/devtron/pkg/deployment/gitOps/config"
 	"github.com/devtron-labs/devtron/pkg/deployment/gitOps/git"
+	"github.com/devtron-labs/devtron/pkg/deployment/gitOps/git/commandManager"
 	"github.com/devtron-labs/devtron/pkg/deployment/gitOps/validation"
 	gitOpsBean "github.com/devtron-labs/devtron/pkg/gitops/bean"
 	moduleBean "github.com/devtron-labs/devtron/pkg/module/bean"
@@ -177,7 +178,9 @@ func (impl *GitOpsConfigServiceImpl) ValidateAndUpdateGitOpsConfig(config *apiBe
 		(config.TLSConfig == nil ||
 			(config.TLSConfig != nil && (len(config.TLSConfig.CaData) == 0 || len(config.TLSConfig.TLSCertData) == 0 || len(config.TLSConfig.TLSKeyData) == 0)))
 
-	if isTokenEmpty || isTlsDetailsEmpty {
+	isSigningKeyEmpty := isCommitSigningKeyEmpty(config)
+
+	if isTokenEmpty || isTlsDetailsEmpty || isSigningKeyEmpty {
 		model, err := impl.gitOpsRepository.GetGitOpsConfigById(config.Id)
 		if err != nil {
 			impl.logger.Errorw("No matching entry found for update.", "id", config.Id)
@@ -190,6 +193,9 @@ func (impl *GitOpsConfigServiceImpl) ValidateAndUpdateGitOpsConfig(config *apiBe
 		if isTokenEmpty {
 			config.Token = model.Token.String()
 		}
+		if isSigningKeyEmpty {
+			fillExistingCommitSigningSecrets(config, model)
+		}
 		if isTlsDetailsEmpty {
 			caData := model.CaCert
 			tlsCert := model.TlsCert
@@ -462,6 +468,10 @@ func (impl *GitOpsConfigServiceImpl) createGitOpsConfig(ctx context.Context, req
 			TLSKeyData:  model.TlsKey,
 		}
 	}
+	err = impl.setCommitSigningConfig(model, request.CommitSigningConfig)
+	if err != nil {
+		return nil, err
+	}
 
 	model, err = impl.gitOpsRepository.CreateGitOpsConfig(model, tx)
 	if err != nil {
@@ -737,6 +747,10 @@ func (impl *GitOpsConfigServiceImpl) updateGitOpsConfig(request *apiBean.GitOpsC
 			TLSKeyData:  model.TlsKey,
 		}
 	}
+	err = impl.setCommitSigningConfig(model, request.CommitSigningConfig)
+	if err != nil {
+		return err
+	}
 
 	err = impl.gitOpsRepository.UpdateGitOpsConfig(model, tx)
 	if err != nil {
@@ -800,6 +814,7 @@ func (impl *GitOpsConfigServiceImpl) GetGitOpsConfigById(id int) (*apiBean.GitOp
 		IsCADataPresent:      len(model.CaCert) > 0,
 		IsTLSCertDataPresent: len(model.TlsCert) > 0,
 		IsTLSKeyDataPresent:  len(model.TlsKey) > 0,
+		CommitSigningConfig:  getCommitSigningConfigDto(model),
 	}
 	return config, err
 }
@@ -835,6 +850,7 @@ func (impl *GitOpsConfigServiceImpl) GetAllGitOpsConfig() ([]*apiBean.GitOpsConf
 			IsCADataPresent:      len(model.CaCert) > 0,
 			IsTLSCertDataPresent: len(model.TlsCert) > 0,
 			IsTLSKeyDataPresent:  len(model.TlsKey) > 0,
+			CommitSigningConfig:  getCommitSigningConfigDto(model),
 		}
 		configs = append(configs, config)
 	}
@@ -870,6 +886,7 @@ func (impl *GitOpsConfigServiceImpl) GetGitOpsConfigByProvider(provider string)
 		IsCADataPresent:      len(model.CaCert) > 0,
 		IsTLSCertDataPresent: len(model.TlsCert) > 0,
 		IsTLSKeyDataPresent:  len(model.TlsKey) > 0,
+		CommitSigningConfig:  getCommitSigningConfigDto(model),
 	}
 
 	return config, err
@@ -880,7 +897,9 @@ func (impl *GitOpsConfigServiceImpl) GitOpsValidateDryRun(isArgoModuleInstalled
 	isTokenEmpty := config.Token == ""
 	isTlsDetailsEmpty := config.EnableTLSVerification && (len(config.TLSConfig.CaData) == 0 && len(config.TLSConfig.TLSCertData) == 0 && len(config.TLSConfig.TLSKeyData) == 0)
 
-	if isTokenEmpty || isTlsDetailsEmpty {
+	isSigningKeyEmpty := isCommitSigningKeyEmpty(config)
+
+	if isTokenEmpty || isTlsDetailsEmpty || isSigningKeyEmpty {
 		model, err := impl.gitOpsRepository.GetGitOpsConfigById(config.Id)
 		if err != nil {
 			impl.logger.Errorw("No matching entry found for update.", "id", config.Id)
@@ -893,6 +912,9 @@ func (impl *GitOpsConfigServiceImpl) GitOpsValidateDryRun(isArgoModuleInstalled
 		if isTokenEmpty {
 			config.Token = model.Token.String()
 		}
+		if isSigningKeyEmpty {
+			fillExistingCommitSigningSecrets(config, model)
+		}
 		if isTlsDetailsEmpty {
 			caData := model.CaCert
 			tlsCert := model.TlsCert
@@ -969,3 +991,55 @@ func (impl *GitOpsConfigServiceImpl) createRepoElement(secretName string, reques
 	repoData.UsernameSecret = usernameSecret
 	return repoData
 }
+
+// setCommitSigningConfig validates the signing key and sets it on the model,
+// the existing key is retained if it is not sent in the request.
+func (impl *GitOpsConfigServiceImpl) setCommitSigningConfig(model *repository.GitOpsConfig, signingConfig *apiBean.CommitSigningConfig) error {
+	if signingConfig == nil || !signingConfig.Enabled {
+		model.CommitSigningEnabled = false
+		model.CommitSigningFormat = ""
+		model.CommitSigningKey = ""
+		model.CommitSigningPassphrase = ""
+		return nil
+	}
+	if len(signingConfig.SigningKey) > 0 {
+		model.CommitSigningKey = securestore.ToEncryptedString(signingConfig.SigningKey)
+		model.CommitSigningPassphrase = securestore.ToEncryptedString(signingConfig.Passphrase)
+	}
+	model.CommitSigningEnabled = true
+	model.CommitSigningFormat = signingConfig.Format
+	_, err := commandManager.NewCommitSigner(&commandManager.CommitSigningConfig{
+		Format:     commandManager.SigningFormat(model.CommitSigningFormat),
+		SigningKey: model.CommitSigningKey.String(),
+		Passphrase: model.CommitSigningPassphrase.String(),
+	})
+	if err != nil {
+		impl.logger.Errorw("invalid commit signing key", "format", model.CommitSigningFormat, "err", err)
+		return &util.ApiError{
+			HttpStatusCode:  http.StatusPreconditionFailed,
+			InternalMessage: err.Error(),
+			UserMessage:     fmt.Sprintf("invalid commit signing key: %s", err.Error()),
+		}
+	}
+	return nil
+}
+
+func isCommitSigningKeyEmpty(config *apiBean.GitOpsConfigDto) bool {
+	return config.CommitSigningConfig != nil && config.CommitSigningConfig.Enabled &&
+		len(config.CommitSigningConfig.SigningKey) == 0 && config.CommitSigningConfig.IsSigningKeyPresent
+}
+
+// fillExistingCommitSigningSecrets sets the saved signing key in the request,
+// as the key material is not sent back to the FE.
+func fillExistingCommitSigningSecrets(config *apiBean.GitOpsConfigDto, model *repository.GitOpsConfig) {
+	config.CommitSigningConfig.SigningKey = model.CommitSigningKey.String()
+	config.CommitSigningConfig.Passphrase = model.CommitSigningPassphrase.String()
+}
+
+func getCommitSigningConfigDto(model *repository.GitOpsConfig) *apiBean.CommitSigningConfig {
+	return &apiBean.CommitSigningConfig{ // sending empty key material as it is hidden in FE
+		Enabled:             model.CommitSigningEnabled,
+		Format:              model.CommitSigningFormat,
+		IsSigningKeyPresent: len(model.CommitSigningKey) > 0,
+	}
+}
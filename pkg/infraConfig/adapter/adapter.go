@@ -78,6 +78,7 @@ func GetV0ProfileBean(profileBean *v1.ProfileBeanDto) *v0.ProfileBeanV0 {
 		Configurations: ConvertToV0ConfigBeans(ciRunnerConfig),
 	}
 	profileV0Bean.BuildxDriverType = profileBean.GetBuildxDriverType()
+	profileV0Bean.WorkflowExecutorType = profileBean.GetWorkflowExecutorType()
 	return profileV0Bean
 }
 
@@ -107,6 +108,7 @@ func ConvertToV1ProfileBean(profileBean *v0.ProfileBeanV0) *v1.ProfileBeanDto {
 		Configurations: map[string][]*v1.ConfigurationBean{v1.RUNNER_PLATFORM: getV1ConfigBeans(profileBean.Configurations)},
 	}
 	newProfileBean.BuildxDriverType = profileBean.GetBuildxDriverType()
+	newProfileBean.WorkflowExecutorType = profileBean.GetWorkflowExecutorType()
 	return newProfileBean
 }
 
@@ -192,17 +194,20 @@ func ConvertToProfileBean(infraProfile *repository.InfraProfileEntity) *v1.Profi
 		},
 	}
 	newProfileBean.BuildxDriverType = infraProfile.BuildxDriverType
+	newProfileBean.WorkflowExecutorType = infraProfile.WorkflowExecutorType
 	return newProfileBean
 }
 
 // ConvertToInfraProfileEntity converts *bean.ProfileBeanDto to *repository.InfraProfileEntity
 func ConvertToInfraProfileEntity(profileBean *v1.ProfileBeanDto) *repository.InfraProfileEntity {
-	return &repository.InfraProfileEntity{
+	infraProfile := &repository.InfraProfileEntity{
 		Id:               profileBean.Id,
 		Name:             profileBean.GetName(),
 		Description:      profileBean.GetDescription(),
 		BuildxDriverType: profileBean.GetBuildxDriverType(),
 	}
+	infraProfile.WorkflowExecutorType = profileBean.GetWorkflowExecutorType()
+	return infraProfile
 }
 
 // NewInfraProfileConfigEntity creates a new instance of repository.InfraProfileConfigurationEntity
@@ -53,6 +53,13 @@ func (profileBean *ProfileBeanV0) GetName() string {
 	return profileBean.ProfileBeanAbstract.GetName()
 }
 
+func (profileBean *ProfileBeanV0) GetWorkflowExecutorType() string {
+	if profileBean == nil {
+		return ""
+	}
+	return profileBean.ProfileBeanAbstract.GetWorkflowExecutorType()
+}
+
 // Deprecated: ProfileResponseV0 is deprecated in favor of v1.ProfileResponse
 type ProfileResponseV0 struct {
 	Profile ProfileBeanV0 `json:"profile"`
@@ -38,6 +38,13 @@ func (profileBean *ProfileBeanDto) GetName() string {
 	return profileBean.ProfileBeanAbstract.GetName()
 }
 
+func (profileBean *ProfileBeanDto) GetWorkflowExecutorType() string {
+	if profileBean == nil {
+		return ""
+	}
+	return profileBean.ProfileBeanAbstract.GetWorkflowExecutorType()
+}
+
 func (profileBean *ProfileBeanDto) DeepCopy() *ProfileBeanDto {
 	if profileBean == nil {
 		return nil
@@ -77,6 +84,9 @@ type ProfileBeanAbstract struct {
 	Active      bool        `json:"active"`
 	Type        ProfileType `json:"type"`
 	AppCount    int         `json:"appCount"`
+	// WorkflowExecutorType overrides the global ci workflow executor for the apps using the profile,
+	// the executor configured on the cluster the build runs in takes precedence
+	WorkflowExecutorType string `json:"workflowExecutorType,omitempty" validate:"omitempty,oneof=AWF SYSTEM TEKTON"`
 	ProfileBeanAbstractEnt
 }
 
@@ -87,6 +97,13 @@ func (p *ProfileBeanAbstract) GetDescription() string {
 	return strings.TrimSpace(p.Description)
 }
 
+func (p *ProfileBeanAbstract) GetWorkflowExecutorType() string {
+	if p == nil {
+		return ""
+	}
+	return p.WorkflowExecutorType
+}
+
 func (p *ProfileBeanAbstract) GetName() string {
 	if p == nil {
 		return ""
@@ -38,7 +38,9 @@ type InfraProfileEntity struct {
 	Name             string          `sql:"name"`
 	Description      string          `sql:"description"`
 	BuildxDriverType v1.BuildxDriver `sql:"buildx_driver_type,notnull"`
-	Active           bool            `sql:"active"`
+	// WorkflowExecutorType overrides the global workflow executor for builds of the apps using the profile
+	WorkflowExecutorType string `sql:"workflow_executor_type"`
+	Active               bool   `sql:"active"`
 	sql.AuditLog
 }
 
@@ -208,6 +210,7 @@ func (impl *InfraConfigRepositoryImpl) UpdateProfile(tx *pg.Tx, profileName stri
 		Set("name = ?", profile.Name).
 		Set("description = ?", profile.Description).
 		Set("buildx_driver_type = ?", profile.BuildxDriverType).
+		Set("workflow_executor_type = ?", profile.WorkflowExecutorType).
 		Set("updated_by = ?", profile.UpdatedBy).
 		Set("updated_on = ?", profile.UpdatedOn).
 		Where("name = ?", profileName).
@@ -55,6 +55,9 @@ type InfraConfigService interface {
 	// GetConfigurationsByScopeAndTargetPlatforms fetches the infra configurations for the given scope and targetPlatforms.
 	GetConfigurationsByScopeAndTargetPlatforms(scope resourceQualifiers.Scope, targetPlatformsList []string) (map[string]*v1.InfraConfig, error)
 	HandleInfraConfigTriggerAudit(workflowId int, triggeredBy int32, infraConfigs map[string]*v1.InfraConfig) error
+	// GetWorkflowExecutorTypeByScope returns the workflow executor of the profile applied to the scope,
+	// falling back to the executor of the global profile. It is empty if neither of them selects an executor.
+	GetWorkflowExecutorTypeByScope(scope resourceQualifiers.Scope) (string, error)
 	InfraConfigServiceEnt
 }
 
@@ -296,6 +299,18 @@ func (impl *InfraConfigServiceImpl) GetConfigurationsByScopeAndTargetPlatforms(s
 	return platformToInfraConfigMap, err
 }
 
+func (impl *InfraConfigServiceImpl) GetWorkflowExecutorTypeByScope(scope resourceQualifiers.Scope) (string, error) {
+	appliedProfile, defaultProfile, err := impl.getAppliedProfileForTriggerScope(infraGetters.GetInfraConfigScope(scope))
+	if err != nil {
+		impl.logger.Errorw("error in fetching applied profile", "scope", scope, "error", err)
+		return "", err
+	}
+	if executorType := appliedProfile.GetWorkflowExecutorType(); len(executorType) > 0 {
+		return executorType, nil
+	}
+	return defaultProfile.GetWorkflowExecutorType(), nil
+}
+
 func (impl *InfraConfigServiceImpl) HandleInfraConfigTriggerAudit(workflowId int, triggeredBy int32, infraConfigs map[string]*v1.InfraConfig) error {
 	return impl.infraConfigClient.HandleInfraConfigTriggerAudit(workflowId, triggeredBy, infraConfigs)
 }
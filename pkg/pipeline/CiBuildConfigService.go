@@ -19,6 +19,7 @@ package pipeline
 import (
 	"errors"
 	"github.com/devtron-labs/devtron/internal/sql/repository/pipelineConfig"
+	"github.com/devtron-labs/devtron/pkg/build/buildpack"
 	"github.com/devtron-labs/devtron/pkg/build/pipeline/bean"
 	"github.com/devtron-labs/devtron/pkg/pipeline/adapter"
 	"go.uber.org/zap"
@@ -33,18 +34,25 @@ type CiBuildConfigService interface {
 }
 
 type CiBuildConfigServiceImpl struct {
-	Logger                  *zap.SugaredLogger
-	CiBuildConfigRepository pipelineConfig.CiBuildConfigRepository
+	Logger                    *zap.SugaredLogger
+	CiBuildConfigRepository   pipelineConfig.CiBuildConfigRepository
+	buildpackCatalogueService buildpack.BuildpackCatalogueService
 }
 
-func NewCiBuildConfigServiceImpl(logger *zap.SugaredLogger, ciBuildConfigRepository pipelineConfig.CiBuildConfigRepository) *CiBuildConfigServiceImpl {
+func NewCiBuildConfigServiceImpl(logger *zap.SugaredLogger, ciBuildConfigRepository pipelineConfig.CiBuildConfigRepository,
+	buildpackCatalogueService buildpack.BuildpackCatalogueService) *CiBuildConfigServiceImpl {
 	return &CiBuildConfigServiceImpl{
-		Logger:                  logger,
-		CiBuildConfigRepository: ciBuildConfigRepository,
+		Logger:                    logger,
+		CiBuildConfigRepository:   ciBuildConfigRepository,
+		buildpackCatalogueService: buildpackCatalogueService,
 	}
 }
 
 func (impl *CiBuildConfigServiceImpl) Save(templateId int, overrideTemplateId int, ciBuildConfigBean *bean.CiBuildConfigBean, userId int32) error {
+	err := impl.applyBuildpackCatalogue(ciBuildConfigBean)
+	if err != nil {
+		return err
+	}
 	ciBuildConfigEntity, err := adapter.ConvertBuildConfigBeanToDbEntity(templateId, overrideTemplateId, ciBuildConfigBean, userId)
 	if err != nil {
 		impl.Logger.Errorw("error occurred while converting build config to db entity", "templateId", templateId,
@@ -67,6 +75,10 @@ func (impl *CiBuildConfigServiceImpl) UpdateOrSave(templateId int, overrideTempl
 		impl.Logger.Warnw("not updating build config as object is empty", "ciBuildConfig", ciBuildConfig)
 		return nil, nil
 	}
+	err := impl.applyBuildpackCatalogue(ciBuildConfig)
+	if err != nil {
+		return nil, err
+	}
 	ciBuildConfigEntity, err := adapter.ConvertBuildConfigBeanToDbEntity(templateId, overrideTemplateId, ciBuildConfig, userId)
 	if err != nil {
 		impl.Logger.Errorw("error occurred while converting build config to db entity", "templateId", templateId,
@@ -88,6 +100,18 @@ func (impl *CiBuildConfigServiceImpl) UpdateOrSave(templateId int, overrideTempl
 	return ciBuildConfig, nil
 }
 
+func (impl *CiBuildConfigServiceImpl) applyBuildpackCatalogue(ciBuildConfig *bean.CiBuildConfigBean) error {
+	if ciBuildConfig.CiBuildType != bean.BUILDPACK_BUILD_TYPE {
+		return nil
+	}
+	err := impl.buildpackCatalogueService.ApplyCatalogue(ciBuildConfig.BuildPackConfig)
+	if err != nil {
+		impl.Logger.Errorw("error in applying buildpack catalogue on build config", "buildPackConfig", ciBuildConfig.BuildPackConfig, "err", err)
+		return err
+	}
+	return nil
+}
+
 func (impl *CiBuildConfigServiceImpl) Delete(ciBuildConfigId int) error {
 	return impl.CiBuildConfigRepository.Delete(ciBuildConfigId)
 }
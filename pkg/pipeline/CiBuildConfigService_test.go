@@ -34,7 +34,7 @@ func TestCiBuildConfigService(t *testing.T) {
 		assert.True(t, err == nil, err)
 		db, err := sql.NewDbConnection(config, sugaredLogger)
 		ciBuildConfigRepositoryImpl := pipelineConfig.NewCiBuildConfigRepositoryImpl(db, sugaredLogger)
-		ciBuildConfigServiceImpl := NewCiBuildConfigServiceImpl(sugaredLogger, ciBuildConfigRepositoryImpl)
+		ciBuildConfigServiceImpl := NewCiBuildConfigServiceImpl(sugaredLogger, ciBuildConfigRepositoryImpl, nil)
 		countByBuildType := ciBuildConfigServiceImpl.GetCountByBuildType()
 		fmt.Println(countByBuildType)
 	})
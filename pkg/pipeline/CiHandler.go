@@ -30,7 +30,9 @@ import (
 	"github.com/devtron-labs/common-lib/utils/workFlow"
 	cdWorkflowBean "github.com/devtron-labs/devtron/internal/sql/repository/pipelineConfig/bean/workflow/cdWorkflow"
 	"github.com/devtron-labs/devtron/pkg/build/artifacts/imageTagging"
+	cacheBean "github.com/devtron-labs/devtron/pkg/build/cache/bean"
 	buildBean "github.com/devtron-labs/devtron/pkg/build/pipeline/bean"
+	buildQueueRepository "github.com/devtron-labs/devtron/pkg/build/queue/repository"
 	repository2 "github.com/devtron-labs/devtron/pkg/cluster/environment/repository"
 	eventProcessorBean "github.com/devtron-labs/devtron/pkg/eventProcessor/bean"
 	"github.com/devtron-labs/devtron/pkg/pipeline/adapter"
@@ -102,6 +104,7 @@ type CiHandlerImpl struct {
 	k8sCommonService             k8sPkg.K8sCommonService
 	workFlowStageStatusService   workflowStatus.WorkFlowStageStatusService
 	workflowStatusLatestService  workflowStatusLatest.WorkflowStatusLatestService
+	ciBuildQueueRepository       buildQueueRepository.CiBuildQueueRepository
 }
 
 func NewCiHandlerImpl(Logger *zap.SugaredLogger, ciService CiService, ciPipelineMaterialRepository pipelineConfig.CiPipelineMaterialRepository, gitSensorClient gitSensor.Client, ciWorkflowRepository pipelineConfig.CiWorkflowRepository,
@@ -110,6 +113,7 @@ func NewCiHandlerImpl(Logger *zap.SugaredLogger, ciService CiService, ciPipeline
 	imageTaggingService imageTagging.ImageTaggingService, k8sCommonService k8sPkg.K8sCommonService, appWorkflowRepository appWorkflow.AppWorkflowRepository, customTagService CustomTagService,
 	workFlowStageStatusService workflowStatus.WorkFlowStageStatusService,
 	workflowStatusLatestService workflowStatusLatest.WorkflowStatusLatestService,
+	ciBuildQueueRepository buildQueueRepository.CiBuildQueueRepository,
 ) *CiHandlerImpl {
 	cih := &CiHandlerImpl{
 		Logger:                       Logger,
@@ -133,6 +137,7 @@ func NewCiHandlerImpl(Logger *zap.SugaredLogger, ciService CiService, ciPipeline
 		k8sCommonService:             k8sCommonService,
 		workFlowStageStatusService:   workFlowStageStatusService,
 		workflowStatusLatestService:  workflowStatusLatestService,
+		ciBuildQueueRepository:       ciBuildQueueRepository,
 	}
 	config, err := types.GetCiConfig()
 	if err != nil {
@@ -408,6 +413,7 @@ func (impl *CiHandlerImpl) GetBuildHistory(pipelineId int, appId int, offset int
 				Message:              pipelineConfigBean.ImageTagUnavailableMessage,
 			}
 		}
+		wfResponse.QueuePosition = impl.getQueuePosition(w.Id, w.Status)
 		if imageTagsDataMap[w.CiArtifactId] != nil {
 			wfResponse.ImageReleaseTags = imageTagsDataMap[w.CiArtifactId] // if artifact is not yet created,empty list will be sent
 		}
@@ -508,10 +514,37 @@ func (impl *CiHandlerImpl) FetchWorkflowDetails(appId int, pipelineId int, build
 		PodName:                workflow.PodName,
 		TargetPlatforms:        utils.ConvertTargetPlatformStringToObject(ciArtifact.TargetPlatforms),
 		WorkflowExecutionStage: impl.workFlowStageStatusService.ConvertDBWorkflowStageToMap(wfStagesDetail, workflow.Id, workflow.Status, workflow.PodStatus, workflow.Message, bean2.CI_WORKFLOW_TYPE.String(), workflow.StartedOn, workflow.FinishedOn),
+		QueuePosition:          impl.getQueuePosition(workflow.Id, workflow.Status),
+		BuildCacheMetrics:      getBuildCacheMetrics(workflow),
 	}
 	return workflowResponse, nil
 }
 
+func getBuildCacheMetrics(workflow *pipelineConfig.CiWorkflow) *cacheBean.BuildCacheMetrics {
+	if len(workflow.BuildCacheBackend) == 0 {
+		return nil
+	}
+	return &cacheBean.BuildCacheMetrics{
+		Backend:     cacheBean.CacheBackend(workflow.BuildCacheBackend),
+		CachedSteps: workflow.BuildCacheCachedSteps,
+		TotalSteps:  workflow.BuildCacheTotalSteps,
+		CacheUsed:   workflow.BuildCacheCachedSteps > 0,
+	}
+}
+
+// getQueuePosition returns the position of a build waiting in the build queue, 0 otherwise
+func (impl *CiHandlerImpl) getQueuePosition(workflowId int, status string) int {
+	if status != cdWorkflowBean.WorkflowInQueue {
+		return 0
+	}
+	position, err := impl.ciBuildQueueRepository.FindQueuePosition(workflowId)
+	if err != nil {
+		impl.Logger.Errorw("error in fetching build queue position", "ciWorkflowId", workflowId, "err", err)
+		return 0
+	}
+	return position
+}
+
 func (impl *CiHandlerImpl) FetchArtifactsForCiJob(buildId int) (*types.ArtifactsForCiJob, error) {
 	artifacts, err := impl.ciArtifactRepository.GetArtifactsByParentCiWorkflowId(buildId)
 	if err != nil {
@@ -589,7 +622,7 @@ func (impl *CiHandlerImpl) UpdateWorkflow(workflowStatus eventProcessorBean.CiCd
 			savedWorkflow.Status = status
 		}
 		savedWorkflow.PodStatus = podStatus
-		if savedWorkflow.ExecutorType == cdWorkflowBean.WORKFLOW_EXECUTOR_TYPE_SYSTEM && savedWorkflow.Status == cdWorkflowBean.WorkflowCancel {
+		if savedWorkflow.ExecutorType.IsArgoWorkflowLess() && savedWorkflow.Status == cdWorkflowBean.WorkflowCancel {
 			savedWorkflow.PodStatus = "Failed"
 			savedWorkflow.Message = constants.TERMINATE_MESSAGE
 		}
@@ -181,6 +181,7 @@ func (impl ConfigMapServiceImpl) CMGlobalAddUpdate(configMapRequest *bean.Config
 				found = true
 				item.SubPath = configData.SubPath
 				item.FilePermission = configData.FilePermission
+				item.RotationPolicy = configData.RotationPolicy
 			}
 			configs = append(configs, item)
 		}
@@ -323,6 +324,7 @@ func (impl ConfigMapServiceImpl) CMEnvironmentAddUpdate(configMapRequest *bean.C
 				item.ExternalSecretType = configData.ExternalSecretType
 				item.SubPath = configData.SubPath
 				item.FilePermission = configData.FilePermission
+				item.RotationPolicy = configData.RotationPolicy
 				found = true
 				item.MergeStrategy = configData.MergeStrategy
 				if len(appLevelConfigMap.ConfigData) > 0 {
@@ -595,6 +597,7 @@ func (impl ConfigMapServiceImpl) CSGlobalAddUpdate(configMapRequest *bean.Config
 				item.SubPath = configData.SubPath
 				item.ESOSubPath = configData.ESOSubPath
 				item.FilePermission = configData.FilePermission
+				item.RotationPolicy = configData.RotationPolicy
 			}
 			configs = append(configs, item)
 		}
@@ -759,6 +762,7 @@ func (impl ConfigMapServiceImpl) CSEnvironmentAddUpdate(configMapRequest *bean.C
 				item.SubPath = configData.SubPath
 				item.ESOSubPath = configData.ESOSubPath
 				item.FilePermission = configData.FilePermission
+				item.RotationPolicy = configData.RotationPolicy
 				found = true
 				item.MergeStrategy = configData.MergeStrategy
 				if len(appLevelSecret.ConfigData) > 0 {
@@ -39,10 +39,12 @@ import (
 	"github.com/devtron-labs/devtron/internal/sql/repository/appStatus"
 	"github.com/devtron-labs/devtron/internal/sql/repository/appWorkflow"
 	"github.com/devtron-labs/devtron/internal/sql/repository/chartConfig"
+	dockerRegistryRepository "github.com/devtron-labs/devtron/internal/sql/repository/dockerRegistry"
 	"github.com/devtron-labs/devtron/internal/sql/repository/pipelineConfig"
 	"github.com/devtron-labs/devtron/internal/sql/repository/pipelineConfig/bean/workflow/cdWorkflow"
 	"github.com/devtron-labs/devtron/internal/util"
 	"github.com/devtron-labs/devtron/pkg/app"
+	appBean "github.com/devtron-labs/devtron/pkg/app/bean"
 	installedAppReader "github.com/devtron-labs/devtron/pkg/appStore/installedApp/read"
 	"github.com/devtron-labs/devtron/pkg/bean"
 	"github.com/devtron-labs/devtron/pkg/chart"
@@ -69,6 +71,7 @@ import (
 	chartRefBean "github.com/devtron-labs/devtron/pkg/deployment/manifest/deploymentTemplate/chartRef/bean"
 	chartRefRead "github.com/devtron-labs/devtron/pkg/deployment/manifest/deploymentTemplate/chartRef/read"
 	"github.com/devtron-labs/devtron/pkg/deployment/manifest/deploymentTemplate/read"
+	"github.com/devtron-labs/devtron/pkg/deployment/manifest/publish"
 	config2 "github.com/devtron-labs/devtron/pkg/deployment/providerConfig"
 	clientErrors "github.com/devtron-labs/devtron/pkg/errors"
 	"github.com/devtron-labs/devtron/pkg/eventProcessor/out"
@@ -208,6 +211,8 @@ type CdPipelineConfigServiceImpl struct {
 	helmAppReadService                read4.HelmAppReadService
 	K8sUtil                           *k8s.K8sServiceImpl
 	fluxCDDeploymentService           fluxcd.DeploymentService
+	manifestPushConfigRepository      repository5.ManifestPushConfigRepository
+	dockerArtifactStoreRepository     dockerRegistryRepository.DockerArtifactStoreRepository
 }
 
 func NewCdPipelineConfigServiceImpl(logger *zap.SugaredLogger, pipelineRepository pipelineConfig.PipelineRepository,
@@ -243,7 +248,9 @@ func NewCdPipelineConfigServiceImpl(logger *zap.SugaredLogger, pipelineRepositor
 	chartReadService read3.ChartReadService,
 	helmAppReadService read4.HelmAppReadService,
 	K8sUtil *k8s.K8sServiceImpl,
-	fluxCDDeploymentService fluxcd.DeploymentService) *CdPipelineConfigServiceImpl {
+	fluxCDDeploymentService fluxcd.DeploymentService,
+	manifestPushConfigRepository repository5.ManifestPushConfigRepository,
+	dockerArtifactStoreRepository dockerRegistryRepository.DockerArtifactStoreRepository) *CdPipelineConfigServiceImpl {
 	return &CdPipelineConfigServiceImpl{
 		logger:                            logger,
 		pipelineRepository:                pipelineRepository,
@@ -291,6 +298,8 @@ func NewCdPipelineConfigServiceImpl(logger *zap.SugaredLogger, pipelineRepositor
 		helmAppReadService:                helmAppReadService,
 		K8sUtil:                           K8sUtil,
 		fluxCDDeploymentService:           fluxCDDeploymentService,
+		manifestPushConfigRepository:      manifestPushConfigRepository,
+		dockerArtifactStoreRepository:     dockerArtifactStoreRepository,
 	}
 }
 
@@ -449,6 +458,24 @@ func (impl *CdPipelineConfigServiceImpl) GetCdPipelineById(pipelineId int) (cdPi
 		AppId:                         dbPipeline.AppId,
 		IsDigestEnforcedForPipeline:   digestPolicyConfigurations.DigestConfiguredForPipeline,
 	}
+	manifestPushConfig, err := impl.manifestPushConfigRepository.GetManifestPushConfigByAppIdAndEnvId(dbPipeline.AppId, dbPipeline.EnvironmentId)
+	if err != nil {
+		impl.logger.Errorw("error in fetching manifest push config", "appId", dbPipeline.AppId, "envId", dbPipeline.EnvironmentId, "err", err)
+		return nil, err
+	}
+	if manifestPushConfig.Id > 0 && bean.IsOCIStorage(manifestPushConfig.StorageType) {
+		helmRepositoryConfig := &appBean.HelmRepositoryConfig{}
+		err = json.Unmarshal([]byte(manifestPushConfig.CredentialsConfig), helmRepositoryConfig)
+		if err != nil {
+			impl.logger.Errorw("error in unmarshalling helm repository config", "manifestPushConfigId", manifestPushConfig.Id, "err", err)
+			return nil, err
+		}
+		cdPipeline.ManifestStorageType = manifestPushConfig.StorageType
+		cdPipeline.ChartName = manifestPushConfig.ChartName
+		cdPipeline.ChartBaseVersion = manifestPushConfig.ChartBaseVersion
+		cdPipeline.RepoUrl = helmRepositoryConfig.RepositoryName
+		cdPipeline.ContainerRegistryName = helmRepositoryConfig.ContainerRegistryName
+	}
 	var preDeployStage *pipelineConfigBean.PipelineStageDto
 	var postDeployStage *pipelineConfigBean.PipelineStageDto
 	preDeployStage, postDeployStage, err = impl.pipelineStageService.GetCdPipelineStageDataDeepCopy(dbPipeline)
@@ -481,12 +508,24 @@ func (impl *CdPipelineConfigServiceImpl) CreateCdPipelines(pipelineCreateRequest
 		// validate and override deployment app type
 		// NOTE: using gitOpsConfigurationStatus.IsGitOpsConfigured instead of gitOpsConfigurationStatus.IsGitOpsConfiguredAndArgoCdInstalled()
 		// as we need to allow the user to create pipeline with linked acd app, even if argo cd is not installed
-		overrideDeploymentType, err := impl.deploymentTypeOverrideService.ValidateAndOverrideDeploymentAppType(pipeline.DeploymentAppType, gitOpsConfigurationStatus.IsGitOpsConfiguredAndArgoCdInstalled(), pipeline.EnvironmentId)
+		isArgoCdDeploymentAllowed := gitOpsConfigurationStatus.IsGitOpsConfiguredAndArgoCdInstalled()
+		if pipeline.IsOCIManifestStorageType() {
+			// manifests are pushed to the container registry, gitOps configuration is not required
+			isArgoCdDeploymentAllowed = gitOpsConfigurationStatus.IsArgoCdInstalled
+		}
+		overrideDeploymentType, err := impl.deploymentTypeOverrideService.ValidateAndOverrideDeploymentAppType(pipeline.DeploymentAppType, isArgoCdDeploymentAllowed, pipeline.EnvironmentId)
 		if err != nil {
 			impl.logger.Errorw("validation error in creating pipeline", "name", pipeline.Name, "err", err)
 			return nil, err
 		}
 		pipeline.DeploymentAppType = overrideDeploymentType
+		if pipeline.IsOCIManifestStorageType() {
+			err = impl.validateOCIManifestStorageConfig(pipeline)
+			if err != nil {
+				impl.logger.Errorw("validation error in oci manifest storage config", "name", pipeline.Name, "err", err)
+				return nil, err
+			}
+		}
 	}
 
 	if impl.deploymentConfig.ShouldCheckNamespaceOnClone {
@@ -1559,6 +1598,12 @@ func (impl *CdPipelineConfigServiceImpl) DeleteCdPipeline(pipeline *pipelineConf
 		impl.logger.Errorw("err in deleting app_status from db", "appId", pipeline.AppId, "envId", pipeline.EnvironmentId, "err", err)
 		return deleteResponse, err
 	}
+	// a pipeline recreated for the same app and env must not pick up the manifest storage of the deleted one
+	err = impl.manifestPushConfigRepository.MarkDeletedByAppIdAndEnvId(pipeline.AppId, pipeline.EnvironmentId, userId, tx)
+	if err != nil {
+		impl.logger.Errorw("err in deleting manifest push config", "appId", pipeline.AppId, "envId", pipeline.EnvironmentId, "err", err)
+		return deleteResponse, err
+	}
 	//delete app workflow mapping
 	appWorkflowMapping, err := impl.appWorkflowRepository.FindWFCDMappingByCDPipelineId(pipeline.Id)
 	if err != nil {
@@ -2380,6 +2425,9 @@ func (impl *CdPipelineConfigServiceImpl) IsGitOpsRequiredForCD(pipelineCreateReq
 	// if deploymentAppType is not coming in request than hasAtLeastOneGitOps will be false
 	haveAtLeastOneGitOps := false
 	for _, pipeline := range pipelineCreateRequest.Pipelines {
+		if pipeline.IsOCIManifestStorageType() {
+			continue
+		}
 		if pipeline.EnvironmentId > 0 &&
 			(pipeline.DeploymentAppType == util.PIPELINE_DEPLOYMENT_TYPE_ACD &&
 				!pipeline.IsExternalArgoAppLinkRequest()) || (pipeline.DeploymentAppType == util.PIPELINE_DEPLOYMENT_TYPE_FLUX &&
@@ -2775,6 +2823,12 @@ func (impl *CdPipelineConfigServiceImpl) createCdPipeline(ctx context.Context, a
 
 		}
 
+		err = impl.saveManifestPushConfig(app.Id, pipeline, userId, tx)
+		if err != nil {
+			impl.logger.Errorw("error in saving manifest push config", "pipelineId", pipelineId, "err", err)
+			return 0, err
+		}
+
 		// save custom tag data
 		err = impl.CDPipelineCustomTagDBOperations(pipeline)
 		if err != nil {
@@ -2991,6 +3045,11 @@ func (impl *CdPipelineConfigServiceImpl) updateCdPipeline(ctx context.Context, p
 		impl.logger.Errorw("error in updating pipeline")
 		return err
 	}
+	err = impl.updateManifestPushConfig(dbPipelineObj, pipeline, userID, tx)
+	if err != nil {
+		impl.logger.Errorw("error in updating manifest push config", "pipelineId", pipeline.Id, "err", err)
+		return err
+	}
 
 	// strategies for pipeline ids, there is only one is default
 	existingStrategies, err := impl.pipelineConfigRepository.GetAllStrategyByPipelineId(pipeline.Id)
@@ -3256,3 +3315,107 @@ func (impl *CdPipelineConfigServiceImpl) checkIfNsExistsForEnvIds(envIds []*int)
 	}
 	return nil
 }
+
+// validateOCIManifestStorageConfig validates the container registry config of a pipeline
+// which pushes its rendered helm chart to an OCI registry instead of a gitOps repository
+func (impl *CdPipelineConfigServiceImpl) validateOCIManifestStorageConfig(pipeline *bean.CDPipelineConfigObject) error {
+	if pipeline.DeploymentAppType != util.PIPELINE_DEPLOYMENT_TYPE_ACD {
+		return util.NewApiError(http.StatusBadRequest, "oci manifest storage is supported for argo cd deployments only", "oci manifest storage is supported for argo cd deployments only")
+	}
+	if len(pipeline.ContainerRegistryName) == 0 || len(pipeline.RepoUrl) == 0 || len(pipeline.ChartName) == 0 {
+		return util.NewApiError(http.StatusBadRequest, "container registry, repository and chart name are required for oci manifest storage", "container registry, repository and chart name are required for oci manifest storage")
+	}
+	dockerArtifactStore, err := impl.dockerArtifactStoreRepository.FindOne(pipeline.ContainerRegistryName)
+	if err != nil && !errors2.IsNotFound(err) {
+		impl.logger.Errorw("error in fetching container registry", "registry", pipeline.ContainerRegistryName, "err", err)
+		return err
+	} else if errors2.IsNotFound(err) {
+		return util.NewApiError(http.StatusBadRequest, fmt.Sprintf("container registry %q not found", pipeline.ContainerRegistryName), "container registry not found")
+	}
+	if publish.GetChartPushOCIRegistryConfig(dockerArtifactStore) == nil {
+		errMsg := fmt.Sprintf("container registry %q is not configured to push helm charts", pipeline.ContainerRegistryName)
+		return util.NewApiError(http.StatusBadRequest, errMsg, errMsg)
+	}
+	return nil
+}
+
+func (impl *CdPipelineConfigServiceImpl) saveManifestPushConfig(appId int, pipeline *bean.CDPipelineConfigObject, userId int32, tx *pg.Tx) error {
+	if !pipeline.IsOCIManifestStorageType() {
+		return nil
+	}
+	credentialsConfig, err := getHelmRepositoryCredentialsConfig(pipeline)
+	if err != nil {
+		impl.logger.Errorw("error in marshalling helm repository config", "pipelineId", pipeline.Id, "err", err)
+		return err
+	}
+	manifestPushConfig := &repository5.ManifestPushConfig{
+		AppId:             appId,
+		EnvId:             pipeline.EnvironmentId,
+		CredentialsConfig: credentialsConfig,
+		ChartName:         pipeline.ChartName,
+		ChartBaseVersion:  pipeline.ChartBaseVersion,
+		StorageType:       bean.ManifestStorageOCI,
+		AuditLog:          sql.NewDefaultAuditLog(userId),
+	}
+	_, err = impl.manifestPushConfigRepository.SaveConfig(manifestPushConfig, tx)
+	return err
+}
+
+// updateManifestPushConfig updates the oci manifest storage config of an existing pipeline,
+// switching between gitOps and oci storage is not allowed once the argo cd application is created
+func (impl *CdPipelineConfigServiceImpl) updateManifestPushConfig(dbPipeline *pipelineConfig.Pipeline, pipeline *bean.CDPipelineConfigObject, userId int32, tx *pg.Tx) error {
+	manifestPushConfig, err := impl.manifestPushConfigRepository.GetManifestPushConfigByAppIdAndEnvId(dbPipeline.AppId, dbPipeline.EnvironmentId)
+	if err != nil {
+		impl.logger.Errorw("error in fetching manifest push config", "appId", dbPipeline.AppId, "envId", dbPipeline.EnvironmentId, "err", err)
+		return err
+	}
+	isOCIStorageConfigured := manifestPushConfig.Id > 0 && bean.IsOCIStorage(manifestPushConfig.StorageType)
+	if isOCIStorageConfigured != pipeline.IsOCIManifestStorageType() && dbPipeline.DeploymentAppCreated {
+		errMsg := "manifest storage type cannot be changed once the deployment app is created"
+		return util.NewApiError(http.StatusBadRequest, errMsg, errMsg)
+	}
+	if !pipeline.IsOCIManifestStorageType() {
+		if manifestPushConfig.Id > 0 {
+			manifestPushConfig.Deleted = true
+			manifestPushConfig.UpdateAuditLog(userId)
+			return impl.manifestPushConfigRepository.UpdateConfig(manifestPushConfig, tx)
+		}
+		return nil
+	}
+	envDeploymentConfig, err := impl.deploymentConfigService.GetConfigForDevtronApps(nil, dbPipeline.AppId, dbPipeline.EnvironmentId)
+	if err != nil {
+		impl.logger.Errorw("error in fetching environment deployment config", "appId", dbPipeline.AppId, "envId", dbPipeline.EnvironmentId, "err", err)
+		return err
+	}
+	pipeline.DeploymentAppType = envDeploymentConfig.DeploymentAppType
+	err = impl.validateOCIManifestStorageConfig(pipeline)
+	if err != nil {
+		return err
+	}
+	if manifestPushConfig.Id == 0 {
+		pipeline.EnvironmentId = dbPipeline.EnvironmentId
+		return impl.saveManifestPushConfig(dbPipeline.AppId, pipeline, userId, tx)
+	}
+	credentialsConfig, err := getHelmRepositoryCredentialsConfig(pipeline)
+	if err != nil {
+		impl.logger.Errorw("error in marshalling helm repository config", "pipelineId", pipeline.Id, "err", err)
+		return err
+	}
+	manifestPushConfig.CredentialsConfig = credentialsConfig
+	manifestPushConfig.ChartName = pipeline.ChartName
+	manifestPushConfig.ChartBaseVersion = pipeline.ChartBaseVersion
+	manifestPushConfig.StorageType = bean.ManifestStorageOCI
+	manifestPushConfig.UpdateAuditLog(userId)
+	return impl.manifestPushConfigRepository.UpdateConfig(manifestPushConfig, tx)
+}
+
+func getHelmRepositoryCredentialsConfig(pipeline *bean.CDPipelineConfigObject) (string, error) {
+	credentialsConfig, err := json.Marshal(&appBean.HelmRepositoryConfig{
+		RepositoryName:        pipeline.RepoUrl,
+		ContainerRegistryName: pipeline.ContainerRegistryName,
+	})
+	if err != nil {
+		return "", err
+	}
+	return string(credentialsConfig), nil
+}
@@ -769,6 +769,7 @@ func (impl *PropertiesConfigServiceImpl) ChangeChartRefForEnvConfigOverride(ctx
 			impl.logger.Errorw("service err, ChangeChartRef", "err", err, "payload", request)
 			return nil, err
 		}
+		createResp.ChartUpgradeReport = request.EnvConfigProperties.ChartUpgradeReport
 		return createResp, nil
 	}
 	envConfigProperties := request.EnvConfigProperties
@@ -778,6 +779,7 @@ func (impl *PropertiesConfigServiceImpl) ChangeChartRefForEnvConfigOverride(ctx
 		impl.logger.Errorw("service err, EnvConfigOverrideUpdate", "err", err, "payload", envConfigProperties)
 		return nil, fmt.Errorf("could not update env override, error: %v", err)
 	}
+	createResp.ChartUpgradeReport = envConfigProperties.ChartUpgradeReport
 	return createResp, nil
 }
 
@@ -258,6 +258,7 @@ func ConvertConfigDataToPipelineConfigData(r *bean.ConfigData) *pipelineConfigBe
 			ESOSubPath:            r.ESOSubPath,
 			FilePermission:        r.FilePermission,
 			Overridden:            r.Overridden,
+			RotationPolicy:        ConvertRotationPolicyToPipelineRotationPolicy(r.RotationPolicy),
 		}
 	}
 	return &pipelineConfigBean.ConfigData{}
@@ -272,6 +273,16 @@ func ConvertESOSecretDataToPipelineESOSecretData(r bean.ESOSecretData) pipelineC
 	}
 }
 
+func ConvertRotationPolicyToPipelineRotationPolicy(r *bean.RotationPolicy) *pipelineConfigBean.RotationPolicy {
+	if r == nil {
+		return nil
+	}
+	return &pipelineConfigBean.RotationPolicy{
+		Enabled:     r.Enabled,
+		AutoRestart: r.AutoRestart,
+	}
+}
+
 func ConvertExternalSecretToPipelineExternalSecret(r []bean.ExternalSecret) []pipelineConfigBean.ExternalSecret {
 	extSec := make([]pipelineConfigBean.ExternalSecret, 0, len(r))
 	for _, item := range r {
@@ -322,12 +333,23 @@ func ConvertPipelineConfigDataToConfigData(r *pipelineConfigBean.ConfigData) *be
 			ESOSubPath:            r.ESOSubPath,
 			FilePermission:        r.FilePermission,
 			Overridden:            r.Overridden,
+			RotationPolicy:        ConvertPipelineRotationPolicyToRotationPolicy(r.RotationPolicy),
 		}
 	}
 	return &bean.ConfigData{}
 
 }
 
+func ConvertPipelineRotationPolicyToRotationPolicy(r *pipelineConfigBean.RotationPolicy) *bean.RotationPolicy {
+	if r == nil {
+		return nil
+	}
+	return &bean.RotationPolicy{
+		Enabled:     r.Enabled,
+		AutoRestart: r.AutoRestart,
+	}
+}
+
 func ConvertPipelineESOSecretDataToESOSecretData(r pipelineConfigBean.ESOSecretData) bean.ESOSecretData {
 	return bean.ESOSecretData{
 		SecretStore:     r.SecretStore,
@@ -70,12 +70,29 @@ type ConfigData struct {
 	ESOSubPath            []string             `json:"esoSubPath"`
 	FilePermission        string               `json:"filePermission"`
 	Overridden            bool                 `json:"overridden"`
+	RotationPolicy        *RotationPolicy      `json:"rotationPolicy,omitempty"`
 }
 
 func (c *ConfigData) IsESOExternalSecretType() bool {
 	return strings.HasPrefix(c.ExternalSecretType, "ESO")
 }
 
+func (c *ConfigData) IsRotationPolicyEnabled() bool {
+	return c.RotationPolicy != nil && c.RotationPolicy.Enabled
+}
+
+// RotationPolicy restarts the workloads consuming a configmap/secret on change of its in-cluster value,
+// devtron managed data changes reach the cluster on deployment and external ones (including ESO) when updated outside devtron
+type RotationPolicy struct {
+	Enabled bool `json:"enabled"`
+	// AutoRestart rolls the workloads consuming the configmap/secret when its in-cluster value changes,
+	// otherwise the change is only recorded in configmap/secret history
+	AutoRestart bool `json:"autoRestart"`
+}
+
+// RotationPolicyEnabledPattern matches the stored config json of configmaps/secrets having an enabled rotation policy
+const RotationPolicyEnabledPattern = `%"rotationPolicy":{"enabled":true%`
+
 type ExternalSecret struct {
 	Key      string `json:"key"`
 	Name     string `json:"name"`
@@ -21,6 +21,7 @@ import (
 	"github.com/devtron-labs/devtron/internal/sql/models"
 	chartRepoRepository "github.com/devtron-labs/devtron/pkg/chartRepo/repository"
 	"github.com/devtron-labs/devtron/pkg/deployment/common/bean"
+	chartRefBean "github.com/devtron-labs/devtron/pkg/deployment/manifest/deploymentTemplate/chartRef/bean"
 )
 
 type EnvironmentProperties struct {
@@ -44,6 +45,8 @@ type EnvironmentProperties struct {
 	MergeStrategy     models.MergeStrategy        `json:"mergeStrategy"`
 	MigratedFrom      *bean.ExternalReleaseType   `json:"migratedFrom,omitempty"`
 	AppId             int                         `json:"appId"`
+	// ChartUpgradeReport is set on chart ref change, it lists the values keys removed or renamed in the target chart
+	ChartUpgradeReport *chartRefBean.ChartUpgradeReport `json:"chartUpgradeReport,omitempty"`
 }
 
 type EnvironmentOverrideCreateInternalDTO struct {
@@ -0,0 +1,347 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package executors
+
+import (
+	"context"
+	"fmt"
+	"github.com/devtron-labs/common-lib/utils/k8s"
+	"github.com/devtron-labs/devtron/pkg/pipeline/bean"
+	"github.com/devtron-labs/devtron/pkg/pipeline/executors/adapter"
+	types2 "github.com/devtron-labs/devtron/pkg/pipeline/types"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	v12 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+	"k8s.io/utils/pointer"
+)
+
+const (
+	TektonTaskRunKind        = "TaskRun"
+	TektonApiVersion         = "tekton.dev/v1"
+	TektonSucceededCondition = "Succeeded"
+	TektonTaskRunCancelled   = "TaskRunCancelled"
+)
+
+var TektonTaskRunGvr = schema.GroupVersionResource{Group: "tekton.dev", Version: "v1", Resource: "taskruns"}
+
+// tektonStepFields are the container fields supported on a tekton step, everything else on the pod spec container is dropped
+var tektonStepFields = []string{"name", "image", "command", "args", "workingDir", "env", "envFrom", "volumeMounts", "volumeDevices", "imagePullPolicy", "securityContext"}
+
+type TektonWorkflowExecutor interface {
+	WorkflowExecutor
+}
+
+// TektonWorkflowExecutorImpl runs ci/cd stages as tekton TaskRuns, for clusters where argo workflow CRDs can not be installed.
+// TaskRun labels are propagated by tekton to the step pod, so the pod is tracked the same way as for the system executor.
+type TektonWorkflowExecutorImpl struct {
+	logger  *zap.SugaredLogger
+	k8sUtil *k8s.K8sServiceImpl
+}
+
+func NewTektonWorkflowExecutorImpl(logger *zap.SugaredLogger, k8sUtil *k8s.K8sServiceImpl) *TektonWorkflowExecutorImpl {
+	return &TektonWorkflowExecutorImpl{logger: logger, k8sUtil: k8sUtil}
+}
+
+func (impl *TektonWorkflowExecutorImpl) ExecuteWorkflow(workflowTemplate bean.WorkflowTemplate) (*unstructured.UnstructuredList, error) {
+	templatesList := &unstructured.UnstructuredList{}
+	taskRunTemplate, err := impl.getTaskRunTemplate(workflowTemplate)
+	if err != nil {
+		impl.logger.Errorw("error occurred while creating task run template", "WorkflowRunnerId", workflowTemplate.WorkflowRunnerId, "err", err)
+		return nil, err
+	}
+	taskRunClient, err := impl.getTaskRunClient(workflowTemplate.ClusterConfig, workflowTemplate.Namespace)
+	if err != nil {
+		impl.logger.Errorw("error occurred while creating k8s client", "WorkflowRunnerId", workflowTemplate.WorkflowRunnerId, "err", err)
+		return nil, err
+	}
+	createdTaskRun, err := taskRunClient.Create(context.Background(), taskRunTemplate, v12.CreateOptions{})
+	if err != nil {
+		impl.logger.Errorw("error occurred while creating tekton task run", "WorkflowRunnerId", workflowTemplate.WorkflowRunnerId, "err", err)
+		return nil, err
+	}
+	// task run has no suspended state, cm and secrets are created right after it and
+	// the kubelet keeps retrying the step pod until they are available
+	err = impl.createCmAndSecrets(workflowTemplate, createdTaskRun, templatesList)
+	if err != nil {
+		impl.logger.Errorw("error occurred while creating cm and secret", "WorkflowRunnerId", workflowTemplate.WorkflowRunnerId, "err", err)
+		return nil, err
+	}
+	templatesList.Items = append(templatesList.Items, *createdTaskRun)
+	return templatesList, nil
+}
+
+func (impl *TektonWorkflowExecutorImpl) TerminateWorkflow(workflowName string, namespace string, clusterConfig *rest.Config) error {
+	taskRunClient, err := impl.getTaskRunClient(clusterConfig, namespace)
+	if err != nil {
+		impl.logger.Errorw("error occurred while creating k8s client", "workflowName", workflowName, "namespace", namespace, "err", err)
+		return err
+	}
+	cancelPatch := fmt.Sprintf(`{"spec":{"status":"%s"}}`, TektonTaskRunCancelled)
+	_, err = taskRunClient.Patch(context.Background(), workflowName, types.MergePatchType, []byte(cancelPatch), v12.PatchOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			err = fmt.Errorf("cannot find workflow %s", workflowName)
+		}
+		impl.logger.Errorw("error occurred while cancelling workflow", "workflowName", workflowName, "namespace", namespace, "err", err)
+	}
+	return err
+}
+
+func (impl *TektonWorkflowExecutorImpl) TerminateDanglingWorkflow(workflowGenerateName string, namespace string, clusterConfig *rest.Config) error {
+	taskRunClient, err := impl.getTaskRunClient(clusterConfig, namespace)
+	if err != nil {
+		impl.logger.Errorw("error occurred while creating k8s client", "workflowGenerateName", workflowGenerateName, "namespace", namespace, "err", err)
+		return err
+	}
+	taskRunSelectorLabel := fmt.Sprintf("%s=%s", bean.WorkflowGenerateNamePrefix, workflowGenerateName)
+	taskRunList, err := taskRunClient.List(context.Background(), v12.ListOptions{LabelSelector: taskRunSelectorLabel})
+	if err != nil {
+		impl.logger.Errorw("error occurred while fetching task runs for terminating dangling workflows", "namespace", namespace, "err", err)
+		return err
+	}
+	for _, taskRun := range taskRunList.Items {
+		err = taskRunClient.Delete(context.Background(), taskRun.GetName(), v12.DeleteOptions{})
+		if err != nil && !errors.IsNotFound(err) {
+			impl.logger.Errorw("error occurred while deleting workflow", "workflowName", taskRun.GetName(), "namespace", namespace, "err", err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (impl *TektonWorkflowExecutorImpl) GetWorkflow(workflowName string, namespace string, clusterConfig *rest.Config) (*unstructured.UnstructuredList, error) {
+	taskRun, err := impl.getTaskRun(workflowName, namespace, clusterConfig)
+	if err != nil {
+		return nil, err
+	}
+	templatesList := &unstructured.UnstructuredList{}
+	templatesList.Items = append(templatesList.Items, *taskRun)
+	return templatesList, nil
+}
+
+func (impl *TektonWorkflowExecutorImpl) GetWorkflowStatus(workflowName string, namespace string, clusterConfig *rest.Config) (*types2.WorkflowStatus, error) {
+	taskRun, err := impl.getTaskRun(workflowName, namespace, clusterConfig)
+	if err != nil {
+		return nil, err
+	}
+	status, message := getTaskRunStatus(taskRun)
+	return &types2.WorkflowStatus{Status: status, Message: message}, nil
+}
+
+func (impl *TektonWorkflowExecutorImpl) getTaskRun(workflowName string, namespace string, clusterConfig *rest.Config) (*unstructured.Unstructured, error) {
+	taskRunClient, err := impl.getTaskRunClient(clusterConfig, namespace)
+	if err != nil {
+		impl.logger.Errorw("error occurred while creating k8s client", "workflowName", workflowName, "namespace", namespace, "err", err)
+		return nil, err
+	}
+	taskRun, err := taskRunClient.Get(context.Background(), workflowName, v12.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			err = fmt.Errorf("cannot find workflow %s", workflowName)
+		}
+		return nil, err
+	}
+	return taskRun, nil
+}
+
+func (impl *TektonWorkflowExecutorImpl) getTaskRunClient(clusterConfig *rest.Config, namespace string) (dynamic.ResourceInterface, error) {
+	httpClient, _, err := impl.k8sUtil.GetK8sConfigAndClientsByRestConfig(clusterConfig)
+	if err != nil {
+		return nil, err
+	}
+	dynamicClient, err := impl.k8sUtil.GetK8sDynamicClient(clusterConfig, httpClient)
+	if err != nil {
+		return nil, err
+	}
+	return dynamicClient.Resource(TektonTaskRunGvr).Namespace(namespace), nil
+}
+
+// getTaskRunStatus maps the Succeeded condition of the task run to the workflow phases used by the other executors
+func getTaskRunStatus(taskRun *unstructured.Unstructured) (string, string) {
+	conditions, _, _ := unstructured.NestedSlice(taskRun.Object, "status", "conditions")
+	for _, condition := range conditions {
+		conditionMap, ok := condition.(map[string]interface{})
+		if !ok || conditionMap["type"] != TektonSucceededCondition {
+			continue
+		}
+		message, _ := conditionMap["message"].(string)
+		switch conditionMap["status"] {
+		case string(corev1.ConditionTrue):
+			return "Succeeded", message
+		case string(corev1.ConditionFalse):
+			return "Failed", message
+		default:
+			return "Running", message
+		}
+	}
+	return "Pending", ""
+}
+
+func (impl *TektonWorkflowExecutorImpl) getTaskRunTemplate(workflowTemplate bean.WorkflowTemplate) (*unstructured.Unstructured, error) {
+	workflowLabels := getWorkflowLabelsForSystemExecutor(workflowTemplate)
+	podSpec := workflowTemplate.PodSpec
+	// tekton has no init containers, steps run in order so init containers run as the leading steps
+	containers := make([]corev1.Container, 0, len(podSpec.InitContainers)+len(podSpec.Containers))
+	containers = append(containers, podSpec.InitContainers...)
+	containers = append(containers, podSpec.Containers...)
+	steps := make([]interface{}, 0, len(containers))
+	for _, container := range containers {
+		step, err := getTektonStep(container)
+		if err != nil {
+			return nil, err
+		}
+		steps = append(steps, step)
+	}
+	volumes, err := toUnstructuredSlice(podSpec.Volumes)
+	if err != nil {
+		return nil, err
+	}
+	podTemplate, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&corev1.PodSpec{
+		NodeSelector:     podSpec.NodeSelector,
+		Tolerations:      podSpec.Tolerations,
+		Affinity:         podSpec.Affinity,
+		SecurityContext:  podSpec.SecurityContext,
+		ImagePullSecrets: podSpec.ImagePullSecrets,
+		HostAliases:      podSpec.HostAliases,
+		DNSConfig:        podSpec.DNSConfig,
+		DNSPolicy:        podSpec.DNSPolicy,
+	})
+	if err != nil {
+		return nil, err
+	}
+	spec := map[string]interface{}{
+		"taskSpec": map[string]interface{}{
+			"steps":   steps,
+			"volumes": volumes,
+		},
+		"podTemplate": podTemplate,
+	}
+	if len(podSpec.ServiceAccountName) > 0 {
+		spec["serviceAccountName"] = podSpec.ServiceAccountName
+	}
+	if workflowTemplate.ActiveDeadlineSeconds != nil {
+		spec["timeout"] = fmt.Sprintf("%ds", *workflowTemplate.ActiveDeadlineSeconds)
+	}
+	labels := make(map[string]interface{}, len(workflowLabels))
+	for key, value := range workflowLabels {
+		labels[key] = value
+	}
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": TektonApiVersion,
+		"kind":       TektonTaskRunKind,
+		"metadata": map[string]interface{}{
+			"generateName": fmt.Sprintf(WORKFLOW_GENERATE_NAME_REGEX, workflowTemplate.WorkflowNamePrefix),
+			"labels":       labels,
+		},
+		"spec": spec,
+	}}, nil
+}
+
+func getTektonStep(container corev1.Container) (map[string]interface{}, error) {
+	containerMap, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&container)
+	if err != nil {
+		return nil, err
+	}
+	step := make(map[string]interface{}, len(tektonStepFields)+1)
+	for _, field := range tektonStepFields {
+		if value, ok := containerMap[field]; ok {
+			step[field] = value
+		}
+	}
+	// tekton v1 steps declare resources as computeResources
+	if resources, ok := containerMap["resources"]; ok {
+		step["computeResources"] = resources
+	}
+	return step, nil
+}
+
+func toUnstructuredSlice(volumes []corev1.Volume) ([]interface{}, error) {
+	unstructuredVolumes := make([]interface{}, 0, len(volumes))
+	for i := range volumes {
+		volume, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&volumes[i])
+		if err != nil {
+			return nil, err
+		}
+		unstructuredVolumes = append(unstructuredVolumes, volume)
+	}
+	return unstructuredVolumes, nil
+}
+
+func (impl *TektonWorkflowExecutorImpl) createTaskRunOwnerRefVal(createdTaskRun *unstructured.Unstructured) v12.OwnerReference {
+	return v12.OwnerReference{UID: createdTaskRun.GetUID(), Name: createdTaskRun.GetName(), Kind: TektonTaskRunKind, APIVersion: TektonApiVersion, BlockOwnerDeletion: pointer.BoolPtr(true), Controller: pointer.BoolPtr(true)}
+}
+
+func (impl *TektonWorkflowExecutorImpl) createCmAndSecrets(template bean.WorkflowTemplate, createdTaskRun *unstructured.Unstructured, templateList *unstructured.UnstructuredList) error {
+	client, err := impl.k8sUtil.GetCoreV1ClientByRestConfig(template.ClusterConfig)
+	if err != nil {
+		impl.logger.Errorw("error occurred while creating k8s client", "WorkflowRunnerId", template.WorkflowRunnerId, "err", err)
+		return err
+	}
+	ownerRef := impl.createTaskRunOwnerRefVal(createdTaskRun)
+	for _, configMapData := range template.ConfigMaps {
+		if configMapData.External {
+			continue
+		}
+		configMapSecretDto, err := adapter.GetConfigMapSecretDto(configMapData, ownerRef, false)
+		if err != nil {
+			impl.logger.Errorw("error occurred while creating config map dto", "err", err)
+			return err
+		}
+		configMap := adapter.GetConfigMapBody(configMapSecretDto)
+		addToUnstructuredList(configMap, templateList)
+		_, err = impl.k8sUtil.CreateConfigMap(createdTaskRun.GetNamespace(), &configMap, client)
+		if err != nil {
+			impl.logger.Errorw("error occurred while creating cm, but ignoring", "err", err)
+		}
+	}
+	for _, secretData := range template.Secrets {
+		if secretData.External {
+			continue
+		}
+		configMapSecretDto, err := adapter.GetConfigMapSecretDto(secretData, ownerRef, true)
+		if err != nil {
+			impl.logger.Errorw("error occurred while creating secret dto", "err", err)
+			return err
+		}
+		secret, err := adapter.GetSecretBody(configMapSecretDto)
+		if err != nil {
+			impl.logger.Errorw("error occurred while creating secret body", "err", err)
+			return err
+		}
+		addToUnstructuredList(secret, templateList)
+		_, err = impl.k8sUtil.CreateSecretData(createdTaskRun.GetNamespace(), &secret, client)
+		if err != nil {
+			impl.logger.Errorw("error occurred while creating secret, but ignoring", "err", err)
+		}
+	}
+	return nil
+}
+
+func addToUnstructuredList(template interface{}, templateList *unstructured.UnstructuredList) {
+	unstructuredObjMap, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&template)
+	if err != nil {
+		return
+	}
+	templateList.Items = append(templateList.Items, unstructured.Unstructured{Object: unstructuredObjMap})
+}
@@ -0,0 +1,108 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package executors
+
+import (
+	"testing"
+
+	"github.com/devtron-labs/devtron/pkg/pipeline/bean"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestGetTaskRunTemplate(t *testing.T) {
+	activeDeadlineSeconds := int64(3600)
+	workflowTemplate := bean.WorkflowTemplate{
+		WorkflowNamePrefix: "1-ci",
+		WorkflowType:       bean.CI_WORKFLOW_NAME,
+		PodSpec: corev1.PodSpec{
+			ServiceAccountName:    "ci-runner",
+			ActiveDeadlineSeconds: &activeDeadlineSeconds,
+			NodeSelector:          map[string]string{"pool": "ci"},
+			InitContainers: []corev1.Container{
+				{Name: "init-certs", Image: "alpine:3", Command: []string{"sh", "-c", "cp /certs/* /shared"}},
+			},
+			Containers: []corev1.Container{{
+				Name:  "ci",
+				Image: "quay.io/devtron/ci-runner:latest",
+				Args:  []string{"--in-app-logging"},
+				Ports: []corev1.ContainerPort{{ContainerPort: 9102}},
+				Resources: corev1.ResourceRequirements{
+					Limits: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("500m")},
+				},
+				VolumeMounts: []corev1.VolumeMount{{Name: "shared", MountPath: "/shared"}},
+			}},
+			Volumes: []corev1.Volume{{Name: "shared", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}}},
+		},
+	}
+	impl := NewTektonWorkflowExecutorImpl(zap.NewNop().Sugar(), nil)
+	taskRun, err := impl.getTaskRunTemplate(workflowTemplate)
+	assert.NoError(t, err)
+
+	assert.Equal(t, TektonApiVersion, taskRun.GetAPIVersion())
+	assert.Equal(t, TektonTaskRunKind, taskRun.GetKind())
+	assert.Equal(t, "1-ci-", taskRun.GetGenerateName())
+	assert.Equal(t, "1-ci", taskRun.GetLabels()[bean.WorkflowGenerateNamePrefix])
+
+	serviceAccountName, _, _ := unstructured.NestedString(taskRun.Object, "spec", "serviceAccountName")
+	assert.Equal(t, "ci-runner", serviceAccountName)
+	timeout, _, _ := unstructured.NestedString(taskRun.Object, "spec", "timeout")
+	assert.Equal(t, "3600s", timeout)
+	nodeSelector, _, _ := unstructured.NestedStringMap(taskRun.Object, "spec", "podTemplate", "nodeSelector")
+	assert.Equal(t, map[string]string{"pool": "ci"}, nodeSelector)
+
+	steps, _, _ := unstructured.NestedSlice(taskRun.Object, "spec", "taskSpec", "steps")
+	assert.Len(t, steps, 2)
+	initStep := steps[0].(map[string]interface{})
+	assert.Equal(t, "init-certs", initStep["name"])
+	assert.Equal(t, []interface{}{"sh", "-c", "cp /certs/* /shared"}, initStep["command"])
+	mainStep := steps[1].(map[string]interface{})
+	assert.Equal(t, "ci", mainStep["name"])
+	assert.Equal(t, []interface{}{"--in-app-logging"}, mainStep["args"])
+	assert.NotContains(t, mainStep, "ports")
+	assert.NotContains(t, mainStep, "resources")
+	cpuLimit, _, _ := unstructured.NestedString(mainStep, "computeResources", "limits", "cpu")
+	assert.Equal(t, "500m", cpuLimit)
+
+	volumes, _, _ := unstructured.NestedSlice(taskRun.Object, "spec", "taskSpec", "volumes")
+	assert.Len(t, volumes, 1)
+	assert.Equal(t, "shared", volumes[0].(map[string]interface{})["name"])
+}
+
+func TestGetTaskRunStatus(t *testing.T) {
+	taskRunWithCondition := func(status string, message string) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"status": map[string]interface{}{
+				"conditions": []interface{}{
+					map[string]interface{}{"type": TektonSucceededCondition, "status": status, "message": message},
+				},
+			},
+		}}
+	}
+	status, message := getTaskRunStatus(taskRunWithCondition("True", "All Steps have completed executing"))
+	assert.Equal(t, "Succeeded", status)
+	assert.Equal(t, "All Steps have completed executing", message)
+	status, _ = getTaskRunStatus(taskRunWithCondition("False", "step ci failed"))
+	assert.Equal(t, "Failed", status)
+	status, _ = getTaskRunStatus(taskRunWithCondition("Unknown", "Pending"))
+	assert.Equal(t, "Running", status)
+	status, _ = getTaskRunStatus(&unstructured.Unstructured{Object: map[string]interface{}{}})
+	assert.Equal(t, "Pending", status)
+}
@@ -38,6 +38,7 @@ type ConfigMapHistoryRepository interface {
 	GetHistoryByPipelineIdAndWfrId(pipelineId, wfrId int, configType ConfigType) (*ConfigmapAndSecretHistory, error)
 	GetDeployedHistoryForPipelineIdOnTime(pipelineId int, deployedOn time.Time, configType ConfigType) (*ConfigmapAndSecretHistory, error)
 	GetDeployedHistoryList(pipelineId, baseConfigId int, configType ConfigType, componentName string) ([]*ConfigmapAndSecretHistory, error)
+	GetRotationHistoryList(pipelineId int, configType ConfigType, resourceName string) ([]*ConfigmapAndSecretHistory, error)
 }
 
 type ConfigMapHistoryRepositoryImpl struct {
@@ -64,6 +65,10 @@ type ConfigmapAndSecretHistory struct {
 	Deployed   bool       `sql:"deployed"`
 	DeployedOn time.Time  `sql:"deployed_on"`
 	DeployedBy int32      `sql:"deployed_by"`
+	// below fields are only set for entries recorded on configmap/secret rotation, these are never deployed
+	RotationTrigger     string `sql:"rotation_trigger"`
+	RotatedResourceName string `sql:"rotated_resource_name"`
+	RestartedWorkloads  string `sql:"restarted_workloads"`
 	sql.AuditLog
 	//getting below data from cd_workflow_runner join
 	DeploymentStatus  string `sql:"-"`
@@ -133,6 +138,22 @@ func (impl ConfigMapHistoryRepositoryImpl) GetDeployedHistoryList(pipelineId, ba
 	return histories, nil
 }
 
+func (impl ConfigMapHistoryRepositoryImpl) GetRotationHistoryList(pipelineId int, configType ConfigType, resourceName string) ([]*ConfigmapAndSecretHistory, error) {
+	var histories []*ConfigmapAndSecretHistory
+	err := impl.dbConnection.Model(&histories).
+		Where("pipeline_id = ?", pipelineId).
+		Where("data_type = ?", configType).
+		Where("rotated_resource_name = ?", resourceName).
+		Where("deployed = ?", false).
+		Order("id DESC").
+		Select()
+	if err != nil {
+		impl.logger.Errorw("error in getting configmap/secret rotation history list", "pipelineId", pipelineId, "resourceName", resourceName, "err", err)
+		return histories, err
+	}
+	return histories, nil
+}
+
 func (impl ConfigMapHistoryRepositoryImpl) GetDeployedHistoryForPipelineIdOnTime(pipelineId int, deployedOn time.Time, configType ConfigType) (*ConfigmapAndSecretHistory, error) {
 	var history ConfigmapAndSecretHistory
 	err := impl.dbConnection.Model(&history).
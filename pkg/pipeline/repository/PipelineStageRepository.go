@@ -227,6 +227,7 @@ type PipelineStageRepository interface {
 	CreatePipelineStage(pipelineStage *PipelineStage, tx *pg.Tx) (*PipelineStage, error)
 	UpdatePipelineStage(pipelineStage *PipelineStage) (*PipelineStage, error)
 	MarkPipelineStageDeletedById(stageId int, updatedBy int32, tx *pg.Tx) error
+	GetPipelineStageById(stageId int) (*PipelineStage, error)
 
 	GetAllCiStagesByCiPipelineId(ciPipelineId int) ([]*PipelineStage, error)
 	GetAllCdStagesByCdPipelineId(cdPipelineId int) ([]*PipelineStage, error)
@@ -391,6 +392,18 @@ func (impl *PipelineStageRepositoryImpl) UpdatePipelineStage(pipelineStage *Pipe
 	return pipelineStage, nil
 }
 
+func (impl *PipelineStageRepositoryImpl) GetPipelineStageById(stageId int) (*PipelineStage, error) {
+	pipelineStage := &PipelineStage{}
+	err := impl.dbConnection.Model(pipelineStage).
+		Where("id = ?", stageId).
+		Where("deleted = ?", false).Select()
+	if err != nil {
+		impl.logger.Errorw("err in getting pipeline stage by id", "err", err, "stageId", stageId)
+		return nil, err
+	}
+	return pipelineStage, nil
+}
+
 func (impl *PipelineStageRepositoryImpl) MarkPipelineStageDeletedById(stageId int, updatedBy int32, tx *pg.Tx) error {
 	var stage PipelineStage
 	_, err := tx.Model(&stage).Set("deleted = ?", true).Set("updated_on = ?", time.Now()).
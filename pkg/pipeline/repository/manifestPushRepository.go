@@ -20,6 +20,7 @@ import (
 	"github.com/devtron-labs/devtron/pkg/sql"
 	"github.com/go-pg/pg"
 	"go.uber.org/zap"
+	"time"
 )
 
 type ManifestPushConfig struct {
@@ -36,8 +37,10 @@ type ManifestPushConfig struct {
 }
 
 type ManifestPushConfigRepository interface {
-	SaveConfig(manifestPushConfig *ManifestPushConfig) (*ManifestPushConfig, error)
+	SaveConfig(manifestPushConfig *ManifestPushConfig, tx *pg.Tx) (*ManifestPushConfig, error)
+	UpdateConfig(manifestPushConfig *ManifestPushConfig, tx *pg.Tx) error
 	GetManifestPushConfigByAppIdAndEnvId(appId, envId int) (*ManifestPushConfig, error)
+	MarkDeletedByAppIdAndEnvId(appId, envId int, userId int32, tx *pg.Tx) error
 }
 
 type ManifestPushConfigRepositoryImpl struct {
@@ -54,22 +57,39 @@ func NewManifestPushConfigRepository(logger *zap.SugaredLogger,
 	}
 }
 
-func (impl ManifestPushConfigRepositoryImpl) SaveConfig(manifestPushConfig *ManifestPushConfig) (*ManifestPushConfig, error) {
-	err := impl.dbConnection.Insert(manifestPushConfig)
+func (impl ManifestPushConfigRepositoryImpl) SaveConfig(manifestPushConfig *ManifestPushConfig, tx *pg.Tx) (*ManifestPushConfig, error) {
+	err := tx.Insert(manifestPushConfig)
 	if err != nil {
 		return manifestPushConfig, err
 	}
 	return manifestPushConfig, err
 }
 
+func (impl ManifestPushConfigRepositoryImpl) UpdateConfig(manifestPushConfig *ManifestPushConfig, tx *pg.Tx) error {
+	return tx.Update(manifestPushConfig)
+}
+
 func (impl ManifestPushConfigRepositoryImpl) GetManifestPushConfigByAppIdAndEnvId(appId, envId int) (*ManifestPushConfig, error) {
-	var manifestPushConfig *ManifestPushConfig
+	manifestPushConfig := &ManifestPushConfig{}
 	err := impl.dbConnection.Model(manifestPushConfig).
 		Where("app_id = ? ", appId).
 		Where("env_id = ? ", envId).
+		Where("deleted = ? ", false).
 		Select()
 	if err != nil && err != pg.ErrNoRows {
 		return manifestPushConfig, err
 	}
 	return manifestPushConfig, nil
 }
+
+func (impl ManifestPushConfigRepositoryImpl) MarkDeletedByAppIdAndEnvId(appId, envId int, userId int32, tx *pg.Tx) error {
+	_, err := tx.Model(&ManifestPushConfig{}).
+		Set("deleted = ?", true).
+		Set("updated_on = ?", time.Now()).
+		Set("updated_by = ?", userId).
+		Where("app_id = ? ", appId).
+		Where("env_id = ? ", envId).
+		Where("deleted = ? ", false).
+		Update()
+	return err
+}
@@ -33,6 +33,9 @@ import (
 	"github.com/devtron-labs/devtron/internal/sql/repository/pipelineConfig"
 	"github.com/devtron-labs/devtron/internal/sql/repository/pipelineConfig/bean/workflow/cdWorkflow"
 	bean2 "github.com/devtron-labs/devtron/pkg/bean"
+	sbomBean "github.com/devtron-labs/devtron/pkg/build/artifacts/sbom/bean"
+	imageSigningBean "github.com/devtron-labs/devtron/pkg/policyGovernance/security/imageSigning/bean"
+	cacheBean "github.com/devtron-labs/devtron/pkg/build/cache/bean"
 	bean5 "github.com/devtron-labs/devtron/pkg/build/pipeline/bean"
 	buildBean "github.com/devtron-labs/devtron/pkg/build/pipeline/bean"
 	repository4 "github.com/devtron-labs/devtron/pkg/cluster/environment/repository"
@@ -159,6 +162,10 @@ type WorkflowRequest struct {
 	AsyncBuildxCacheExport      bool   `json:"asyncBuildxCacheExport"`
 	BuildxInterruptionMaxRetry        int    `json:"buildxInterruptionMaxRetry"`
 	BuildxBuilderPodWaitDurationSecs  int    `json:"buildxBuilderPodWaitDurationSecs"`
+	BuildxCacheConfig                 *cacheBean.BuildxCacheConfig `json:"buildxCacheConfig,omitempty"`
+	ExtractBuildpackSbom              bool   `json:"extractBuildpackSbom,omitempty"`
+	SbomGenerationConfig              *sbomBean.SbomGenerationConfig `json:"sbomGenerationConfig,omitempty"`
+	ImageSigningConfig                *imageSigningBean.CiSigningConfig `json:"imageSigningConfig,omitempty"`
 	UseDockerApiToGetDigest           bool   `json:"useDockerApiToGetDigest"`
 	HostUrl                     string `json:"hostUrl"`
 	WorkflowRequestEnt
@@ -394,7 +401,7 @@ func (workflowRequest *WorkflowRequest) getBlobStorageLogsPrefix() string {
 
 func (workflowRequest *WorkflowRequest) updateBlobStorageLogsKey(config *CiCdConfig) {
 	workflowRequest.BlobStorageLogsKey = fmt.Sprintf("%s/%s", workflowRequest.getDefaultBuildLogsKeyPrefix(config), workflowRequest.getBlobStorageLogsPrefix())
-	workflowRequest.InAppLoggingEnabled = config.InAppLoggingEnabled || (workflowRequest.WorkflowExecutor == cdWorkflow.WORKFLOW_EXECUTOR_TYPE_SYSTEM)
+	workflowRequest.InAppLoggingEnabled = config.InAppLoggingEnabled || workflowRequest.WorkflowExecutor.IsArgoWorkflowLess()
 }
 
 func (workflowRequest *WorkflowRequest) getWorkflowJson() ([]byte, error) {
@@ -763,6 +770,8 @@ type WorkflowResponse struct {
 	ReferenceWorkflowId    int                                    `json:"referenceWorkflowId"`
 	TargetPlatforms        []*commonBean.TargetPlatform           `json:"targetPlatforms"`
 	WorkflowExecutionStage map[string][]*bean6.WorkflowStageDto   `json:"workflowExecutionStages"`
+	QueuePosition          int                                    `json:"queuePosition,omitempty"`
+	BuildCacheMetrics      *cacheBean.BuildCacheMetrics           `json:"buildCacheMetrics,omitempty"`
 }
 
 type ConfigMapSecretDto struct {
@@ -0,0 +1,387 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package imageScanning
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/caarlos0/env"
+	client "github.com/devtron-labs/devtron/client/events"
+	repository1 "github.com/devtron-labs/devtron/internal/sql/repository/app"
+	"github.com/devtron-labs/devtron/internal/util"
+	"github.com/devtron-labs/devtron/pkg/auth/user"
+	"github.com/devtron-labs/devtron/pkg/cluster/environment"
+	bean3 "github.com/devtron-labs/devtron/pkg/policyGovernance/security/imageScanning/bean"
+	repository3 "github.com/devtron-labs/devtron/pkg/policyGovernance/security/imageScanning/repository"
+	securityBean "github.com/devtron-labs/devtron/pkg/policyGovernance/security/imageScanning/repository/bean"
+	"github.com/devtron-labs/devtron/pkg/sql"
+	cron2 "github.com/devtron-labs/devtron/util/cron"
+	util2 "github.com/devtron-labs/devtron/util/event"
+	"github.com/go-pg/pg"
+	"github.com/robfig/cron/v3"
+	"go.uber.org/zap"
+)
+
+type CveExceptionService interface {
+	RequestException(request *bean3.CveExceptionRequest, userId int32) (*bean3.CveExceptionDto, error)
+	ReviewException(request *bean3.CveExceptionReviewRequest, userId int32) (*bean3.CveExceptionDto, error)
+	RevokeException(id int, userId int32) (*bean3.CveExceptionDto, error)
+	GetException(id int) (*bean3.CveExceptionDto, error)
+	GetExceptions(request *bean3.CveExceptionListRequest) ([]*bean3.CveExceptionDto, error)
+	// ProcessExceptionExpiry notifies owners of exceptions about to expire and marks lapsed ones EXPIRED
+	ProcessExceptionExpiry()
+}
+
+type CveExceptionServiceImpl struct {
+	logger                 *zap.SugaredLogger
+	cveExceptionRepository repository3.CveExceptionRepository
+	cveStoreRepository     repository3.CveStoreRepository
+	appRepository          repository1.AppRepository
+	envService             environment.EnvironmentService
+	userService            user.UserService
+	eventClient            client.EventClient
+	eventFactory           client.EventFactory
+	cronLease              sql.CronLease
+	config                 *bean3.CveExceptionConfig
+	cron                   *cron.Cron
+}
+
+func NewCveExceptionServiceImpl(logger *zap.SugaredLogger,
+	cveExceptionRepository repository3.CveExceptionRepository,
+	cveStoreRepository repository3.CveStoreRepository,
+	appRepository repository1.AppRepository,
+	envService environment.EnvironmentService,
+	userService user.UserService,
+	eventClient client.EventClient,
+	eventFactory client.EventFactory,
+	cronLease sql.CronLease,
+	cronLogger *cron2.CronLoggerImpl) (*CveExceptionServiceImpl, error) {
+	config := &bean3.CveExceptionConfig{}
+	err := env.Parse(config)
+	if err != nil {
+		logger.Errorw("error in parsing cve exception config", "err", err)
+		return nil, err
+	}
+	impl := &CveExceptionServiceImpl{
+		logger:                 logger,
+		cveExceptionRepository: cveExceptionRepository,
+		cveStoreRepository:     cveStoreRepository,
+		appRepository:          appRepository,
+		envService:             envService,
+		userService:            userService,
+		eventClient:            eventClient,
+		eventFactory:           eventFactory,
+		cronLease:              cronLease,
+		config:                 config,
+	}
+	if len(config.ExpiryCheckCron) > 0 {
+		impl.cron = cron.New(cron.WithChain(cron.SkipIfStillRunning(cronLogger), cron.Recover(cronLogger)))
+		_, err = impl.cron.AddFunc(config.ExpiryCheckCron, impl.ProcessExceptionExpiry)
+		if err != nil {
+			logger.Errorw("error in adding cve exception expiry cron", "cronExpression", config.ExpiryCheckCron, "err", err)
+			return nil, err
+		}
+		impl.cron.Start()
+	}
+	return impl, nil
+}
+
+func (impl *CveExceptionServiceImpl) RequestException(request *bean3.CveExceptionRequest, userId int32) (*bean3.CveExceptionDto, error) {
+	request.Image = strings.TrimSpace(request.Image)
+	if request.AppId == 0 && request.EnvId == 0 && len(request.Image) == 0 {
+		return nil, util.NewApiError(http.StatusBadRequest, "cve exception must be scoped to an app, environment or image", "cve exception without scope")
+	}
+	now := time.Now()
+	if err := impl.validateExpiry(request.ExpiresOn, now); err != nil {
+		return nil, err
+	}
+	cveStore, err := impl.cveStoreRepository.FindByName(request.CveName)
+	if err != nil && err != pg.ErrNoRows {
+		impl.logger.Errorw("error in fetching cve", "cveName", request.CveName, "err", err)
+		return nil, err
+	}
+	if cveStore == nil || len(cveStore.Name) == 0 {
+		return nil, util.NewApiError(http.StatusNotFound, fmt.Sprintf("cve %s not found in scan results", request.CveName), "cve not found")
+	}
+	exception := &repository3.CveException{
+		CveName:       cveStore.Name,
+		AppId:         request.AppId,
+		EnvId:         request.EnvId,
+		Image:         request.Image,
+		Justification: request.Justification,
+		Status:        securityBean.CveExceptionPending,
+		RequestedBy:   userId,
+		ExpiresOn:     request.ExpiresOn,
+		AuditLog:      sql.NewDefaultAuditLog(userId),
+	}
+	err = impl.cveExceptionRepository.Save(exception)
+	if err != nil {
+		impl.logger.Errorw("error in saving cve exception", "request", request, "err", err)
+		return nil, err
+	}
+	return impl.toDto(exception, newCveExceptionNameCache()), nil
+}
+
+func (impl *CveExceptionServiceImpl) ReviewException(request *bean3.CveExceptionReviewRequest, userId int32) (*bean3.CveExceptionDto, error) {
+	exception, err := impl.getExceptionById(request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if exception.Status != securityBean.CveExceptionPending {
+		return nil, util.NewApiError(http.StatusConflict, fmt.Sprintf("cve exception is already %s", exception.Status), "cve exception is not pending")
+	}
+	if request.Status == securityBean.CveExceptionApproved {
+		if exception.RequestedBy == userId && !impl.config.AllowSelfApproval {
+			return nil, util.NewApiError(http.StatusForbidden, "cve exception cannot be approved by its requester", "self approval of cve exception")
+		}
+		now := time.Now()
+		if request.ExpiresOn != nil {
+			if request.ExpiresOn.After(exception.ExpiresOn) {
+				return nil, util.NewApiError(http.StatusBadRequest, "expiry can only be shortened while approving", "approved expiry after requested expiry")
+			}
+			exception.ExpiresOn = *request.ExpiresOn
+		}
+		if err = impl.validateExpiry(exception.ExpiresOn, now); err != nil {
+			return nil, err
+		}
+	}
+	exception.Status = request.Status
+	exception.ReviewedBy = userId
+	exception.ReviewedOn = time.Now()
+	exception.ReviewComment = request.Comment
+	exception.UpdateAuditLog(userId)
+	err = impl.cveExceptionRepository.Update(exception)
+	if err != nil {
+		impl.logger.Errorw("error in updating cve exception review", "id", exception.Id, "err", err)
+		return nil, err
+	}
+	return impl.toDto(exception, newCveExceptionNameCache()), nil
+}
+
+func (impl *CveExceptionServiceImpl) RevokeException(id int, userId int32) (*bean3.CveExceptionDto, error) {
+	exception, err := impl.getExceptionById(id)
+	if err != nil {
+		return nil, err
+	}
+	if exception.Status != securityBean.CveExceptionPending && exception.Status != securityBean.CveExceptionApproved {
+		return nil, util.NewApiError(http.StatusConflict, fmt.Sprintf("cve exception is already %s", exception.Status), "cve exception is not revocable")
+	}
+	exception.Status = securityBean.CveExceptionRevoked
+	exception.UpdateAuditLog(userId)
+	err = impl.cveExceptionRepository.Update(exception)
+	if err != nil {
+		impl.logger.Errorw("error in revoking cve exception", "id", id, "err", err)
+		return nil, err
+	}
+	return impl.toDto(exception, newCveExceptionNameCache()), nil
+}
+
+func (impl *CveExceptionServiceImpl) GetException(id int) (*bean3.CveExceptionDto, error) {
+	exception, err := impl.getExceptionById(id)
+	if err != nil {
+		return nil, err
+	}
+	return impl.toDto(exception, newCveExceptionNameCache()), nil
+}
+
+func (impl *CveExceptionServiceImpl) GetExceptions(request *bean3.CveExceptionListRequest) ([]*bean3.CveExceptionDto, error) {
+	if len(request.Status) > 0 && !request.Status.IsValid() {
+		return nil, util.NewApiError(http.StatusBadRequest, fmt.Sprintf("invalid status %s", request.Status), "invalid cve exception status")
+	}
+	exceptions, err := impl.cveExceptionRepository.FindByFilter(&repository3.CveExceptionFilter{
+		CveName: request.CveName,
+		AppId:   request.AppId,
+		EnvId:   request.EnvId,
+		Status:  request.Status,
+	})
+	if err != nil {
+		impl.logger.Errorw("error in fetching cve exceptions", "request", request, "err", err)
+		return nil, err
+	}
+	names := newCveExceptionNameCache()
+	result := make([]*bean3.CveExceptionDto, 0, len(exceptions))
+	for _, exception := range exceptions {
+		result = append(result, impl.toDto(exception, names))
+	}
+	return result, nil
+}
+
+func (impl *CveExceptionServiceImpl) ProcessExceptionExpiry() {
+	// a notification is sent once per exception, so only one replica processes the expiry at a time
+	acquired, err := impl.cronLease.TryAcquire(bean3.CveExceptionExpiryLeaseKey, bean3.CveExceptionExpiryLeaseTtl)
+	if err != nil {
+		impl.logger.Errorw("error in taking lease for cve exception expiry", "err", err)
+		return
+	}
+	if !acquired {
+		impl.logger.Debugw("cve exception expiry is running on another replica, skipping")
+		return
+	}
+	defer func() {
+		err := impl.cronLease.Release(bean3.CveExceptionExpiryLeaseKey)
+		if err != nil {
+			impl.logger.Errorw("error in releasing lease of cve exception expiry", "err", err)
+		}
+	}()
+	now := time.Now()
+	expired, err := impl.cveExceptionRepository.FindApprovedExpired(now)
+	if err != nil {
+		impl.logger.Errorw("error in fetching expired cve exceptions", "err", err)
+		return
+	}
+	for _, exception := range expired {
+		// enforcement already ignores lapsed exceptions, the status change keeps listings and audits accurate
+		exception.Status = securityBean.CveExceptionExpired
+		exception.UpdateAuditLog(1)
+		err = impl.cveExceptionRepository.Update(exception)
+		if err != nil {
+			impl.logger.Errorw("error in marking cve exception expired", "id", exception.Id, "err", err)
+			continue
+		}
+		impl.sendExpiryNotification(exception, true)
+	}
+	if impl.config.ExpiryNotificationDays <= 0 {
+		return
+	}
+	expiring, err := impl.cveExceptionRepository.FindApprovedExpiringBefore(now.AddDate(0, 0, impl.config.ExpiryNotificationDays))
+	if err != nil {
+		impl.logger.Errorw("error in fetching expiring cve exceptions", "err", err)
+		return
+	}
+	for _, exception := range expiring {
+		impl.sendExpiryNotification(exception, false)
+		exception.ExpiryNotified = true
+		exception.UpdateAuditLog(1)
+		err = impl.cveExceptionRepository.Update(exception)
+		if err != nil {
+			impl.logger.Errorw("error in marking cve exception expiry notified", "id", exception.Id, "err", err)
+		}
+	}
+}
+
+func (impl *CveExceptionServiceImpl) sendExpiryNotification(exception *repository3.CveException, expired bool) {
+	event, err := impl.eventFactory.Build(util2.CveExceptionExpiry, nil, exception.AppId, &exception.EnvId, util2.CD)
+	if err != nil {
+		impl.logger.Errorw("error in building cve exception expiry event", "id", exception.Id, "err", err)
+		return
+	}
+	dto := impl.toDto(exception, newCveExceptionNameCache())
+	event.Payload = &client.Payload{
+		AppName: dto.AppName,
+		EnvName: dto.EnvName,
+		CveException: &client.CveExceptionPayload{
+			ExceptionId:   exception.Id,
+			CveName:       exception.CveName,
+			Image:         exception.Image,
+			Justification: exception.Justification,
+			ExpiresOn:     exception.ExpiresOn,
+			Expired:       expired,
+			RequestedBy:   dto.RequestedBy,
+			ApprovedBy:    dto.ReviewedBy,
+		},
+	}
+	_, err = impl.eventClient.WriteNotificationEvent(event)
+	if err != nil {
+		impl.logger.Errorw("error in sending cve exception expiry notification", "id", exception.Id, "err", err)
+	}
+}
+
+func (impl *CveExceptionServiceImpl) validateExpiry(expiresOn time.Time, now time.Time) error {
+	if !expiresOn.After(now) {
+		return util.NewApiError(http.StatusBadRequest, "expiry of cve exception must be in the future", "expiry in past")
+	}
+	if impl.config.MaxValidityDays > 0 && expiresOn.After(now.AddDate(0, 0, impl.config.MaxValidityDays)) {
+		return util.NewApiError(http.StatusBadRequest, fmt.Sprintf("cve exception cannot be valid for more than %d days", impl.config.MaxValidityDays), "expiry beyond max validity")
+	}
+	return nil
+}
+
+func (impl *CveExceptionServiceImpl) getExceptionById(id int) (*repository3.CveException, error) {
+	exception, err := impl.cveExceptionRepository.FindById(id)
+	if err == pg.ErrNoRows {
+		return nil, util.NewApiError(http.StatusNotFound, "cve exception not found", "cve exception not found")
+	} else if err != nil {
+		impl.logger.Errorw("error in fetching cve exception", "id", id, "err", err)
+		return nil, err
+	}
+	return exception, nil
+}
+
+// cveExceptionNameCache avoids repeated lookups of the same app, env and user while building a listing
+type cveExceptionNameCache struct {
+	apps   map[int]string
+	envs   map[int]string
+	emails map[int32]string
+}
+
+func newCveExceptionNameCache() *cveExceptionNameCache {
+	return &cveExceptionNameCache{apps: make(map[int]string), envs: make(map[int]string), emails: make(map[int32]string)}
+}
+
+func (impl *CveExceptionServiceImpl) toDto(exception *repository3.CveException, names *cveExceptionNameCache) *bean3.CveExceptionDto {
+	dto := &bean3.CveExceptionDto{
+		Id:             exception.Id,
+		CveName:        exception.CveName,
+		AppId:          exception.AppId,
+		EnvId:          exception.EnvId,
+		Image:          exception.Image,
+		Justification:  exception.Justification,
+		Status:         exception.Status,
+		RequestedOn:    exception.CreatedOn,
+		ReviewComment:  exception.ReviewComment,
+		ExpiresOn:      exception.ExpiresOn,
+		ExpiryNotified: exception.ExpiryNotified,
+	}
+	if exception.AppId > 0 {
+		if _, ok := names.apps[exception.AppId]; !ok {
+			if app, err := impl.appRepository.FindById(exception.AppId); err == nil {
+				names.apps[exception.AppId] = app.AppName
+			}
+		}
+		dto.AppName = names.apps[exception.AppId]
+	}
+	if exception.EnvId > 0 {
+		if _, ok := names.envs[exception.EnvId]; !ok {
+			if envBean, err := impl.envService.FindById(exception.EnvId); err == nil {
+				names.envs[exception.EnvId] = envBean.Environment
+			}
+		}
+		dto.EnvName = names.envs[exception.EnvId]
+	}
+	dto.RequestedBy = impl.getEmail(exception.RequestedBy, names)
+	if exception.ReviewedBy > 0 {
+		dto.ReviewedBy = impl.getEmail(exception.ReviewedBy, names)
+		reviewedOn := exception.ReviewedOn
+		dto.ReviewedOn = &reviewedOn
+	}
+	return dto
+}
+
+func (impl *CveExceptionServiceImpl) getEmail(userId int32, names *cveExceptionNameCache) string {
+	if email, ok := names.emails[userId]; ok {
+		return email
+	}
+	email, err := impl.userService.GetEmailById(userId)
+	if err != nil {
+		impl.logger.Errorw("error in fetching user email", "userId", userId, "err", err)
+	}
+	names.emails[userId] = email
+	return email
+}
@@ -0,0 +1,132 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package imageScanning
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	client "github.com/devtron-labs/devtron/client/events"
+	eventMocks "github.com/devtron-labs/devtron/client/events/mocks"
+	"github.com/devtron-labs/devtron/internal/util"
+	mock_user "github.com/devtron-labs/devtron/pkg/auth/user/mocks"
+	bean3 "github.com/devtron-labs/devtron/pkg/policyGovernance/security/imageScanning/bean"
+	repository3 "github.com/devtron-labs/devtron/pkg/policyGovernance/security/imageScanning/repository"
+	securityBean "github.com/devtron-labs/devtron/pkg/policyGovernance/security/imageScanning/repository/bean"
+	repositoryMocks "github.com/devtron-labs/devtron/pkg/policyGovernance/security/imageScanning/repository/mocks"
+	sqlMocks "github.com/devtron-labs/devtron/pkg/sql/mocks"
+	util2 "github.com/devtron-labs/devtron/util/event"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func newTestCveExceptionService(t *testing.T, cronLease *sqlMocks.CronLease) (*CveExceptionServiceImpl, *repositoryMocks.CveExceptionRepository, *eventMocks.EventClient) {
+	cveExceptionRepository := repositoryMocks.NewCveExceptionRepository(t)
+	userService := mock_user.NewUserService(t)
+	userService.On("GetEmailById", mock.Anything).Return("user@example.com", nil).Maybe()
+	eventFactory := eventMocks.NewEventFactory(t)
+	eventFactory.On("Build", util2.CveExceptionExpiry, mock.Anything, mock.Anything, mock.Anything, util2.CD).Return(client.Event{EventTypeId: int(util2.CveExceptionExpiry)}, nil).Maybe()
+	eventClient := eventMocks.NewEventClient(t)
+	impl := &CveExceptionServiceImpl{
+		logger:                 zap.NewNop().Sugar(),
+		cveExceptionRepository: cveExceptionRepository,
+		userService:            userService,
+		eventClient:            eventClient,
+		eventFactory:           eventFactory,
+		cronLease:              cronLease,
+		config:                 &bean3.CveExceptionConfig{MaxValidityDays: 90, ExpiryNotificationDays: 7},
+	}
+	return impl, cveExceptionRepository, eventClient
+}
+
+func TestProcessExceptionExpiry(t *testing.T) {
+	t.Run("expiry is skipped when another replica holds the lease", func(t *testing.T) {
+		cronLease := sqlMocks.NewCronLease(t)
+		cronLease.On("TryAcquire", bean3.CveExceptionExpiryLeaseKey, bean3.CveExceptionExpiryLeaseTtl).Return(false, nil).Once()
+		impl, cveExceptionRepository, eventClient := newTestCveExceptionService(t, cronLease)
+		impl.ProcessExceptionExpiry()
+		cveExceptionRepository.AssertNotCalled(t, "FindApprovedExpired", mock.Anything)
+		eventClient.AssertNotCalled(t, "WriteNotificationEvent", mock.Anything)
+		cronLease.AssertNotCalled(t, "Release", mock.Anything)
+	})
+	t.Run("lapsed exceptions are expired and expiring ones are notified once", func(t *testing.T) {
+		expired := &repository3.CveException{Id: 1, Status: securityBean.CveExceptionApproved, RequestedBy: 2}
+		expiring := &repository3.CveException{Id: 2, Status: securityBean.CveExceptionApproved, RequestedBy: 2}
+		cronLease := sqlMocks.NewCronLease(t)
+		cronLease.On("TryAcquire", bean3.CveExceptionExpiryLeaseKey, bean3.CveExceptionExpiryLeaseTtl).Return(true, nil).Once()
+		cronLease.On("Release", bean3.CveExceptionExpiryLeaseKey).Return(nil).Once()
+		impl, cveExceptionRepository, eventClient := newTestCveExceptionService(t, cronLease)
+		cveExceptionRepository.On("FindApprovedExpired", mock.AnythingOfType("time.Time")).Return([]*repository3.CveException{expired}, nil).Once()
+		cveExceptionRepository.On("FindApprovedExpiringBefore", mock.AnythingOfType("time.Time")).Return([]*repository3.CveException{expiring}, nil).Once()
+		cveExceptionRepository.On("Update", expired).Return(nil).Once()
+		cveExceptionRepository.On("Update", expiring).Return(nil).Once()
+		eventClient.On("WriteNotificationEvent", mock.AnythingOfType("client.Event")).Return(true, nil).Twice()
+		impl.ProcessExceptionExpiry()
+		assert.Equal(t, securityBean.CveExceptionExpired, expired.Status)
+		assert.Equal(t, securityBean.CveExceptionApproved, expiring.Status)
+		assert.True(t, expiring.ExpiryNotified)
+	})
+}
+
+func TestReviewException(t *testing.T) {
+	newPending := func() *repository3.CveException {
+		return &repository3.CveException{Id: 1, Status: securityBean.CveExceptionPending, RequestedBy: 2, ExpiresOn: time.Now().Add(10 * 24 * time.Hour)}
+	}
+	apiErrStatus := func(err error) int {
+		if apiErr, ok := err.(*util.ApiError); ok {
+			return apiErr.HttpStatusCode
+		}
+		return 0
+	}
+	t.Run("requester cannot approve own exception", func(t *testing.T) {
+		impl, cveExceptionRepository, _ := newTestCveExceptionService(t, sqlMocks.NewCronLease(t))
+		cveExceptionRepository.On("FindById", 1).Return(newPending(), nil)
+		_, err := impl.ReviewException(&bean3.CveExceptionReviewRequest{Id: 1, Status: securityBean.CveExceptionApproved}, 2)
+		assert.Equal(t, http.StatusForbidden, apiErrStatus(err))
+	})
+	t.Run("approver can only shorten the expiry", func(t *testing.T) {
+		impl, cveExceptionRepository, _ := newTestCveExceptionService(t, sqlMocks.NewCronLease(t))
+		cveExceptionRepository.On("FindById", 1).Return(newPending(), nil)
+		cveExceptionRepository.On("Update", mock.AnythingOfType("*repository.CveException")).Return(nil).Once()
+		later := time.Now().Add(20 * 24 * time.Hour)
+		_, err := impl.ReviewException(&bean3.CveExceptionReviewRequest{Id: 1, Status: securityBean.CveExceptionApproved, ExpiresOn: &later}, 3)
+		assert.Equal(t, http.StatusBadRequest, apiErrStatus(err))
+		sooner := time.Now().Add(5 * 24 * time.Hour)
+		dto, err := impl.ReviewException(&bean3.CveExceptionReviewRequest{Id: 1, Status: securityBean.CveExceptionApproved, ExpiresOn: &sooner}, 3)
+		assert.NoError(t, err)
+		assert.Equal(t, securityBean.CveExceptionApproved, dto.Status)
+		assert.Equal(t, sooner, dto.ExpiresOn)
+	})
+	t.Run("reviewed exception cannot be reviewed again", func(t *testing.T) {
+		exception := newPending()
+		exception.Status = securityBean.CveExceptionRejected
+		impl, cveExceptionRepository, _ := newTestCveExceptionService(t, sqlMocks.NewCronLease(t))
+		cveExceptionRepository.On("FindById", 1).Return(exception, nil)
+		_, err := impl.ReviewException(&bean3.CveExceptionReviewRequest{Id: 1, Status: securityBean.CveExceptionApproved}, 3)
+		assert.Equal(t, http.StatusConflict, apiErrStatus(err))
+	})
+}
+
+func TestValidateExpiry(t *testing.T) {
+	impl := &CveExceptionServiceImpl{config: &bean3.CveExceptionConfig{MaxValidityDays: 30}}
+	now := time.Now()
+	assert.Error(t, impl.validateExpiry(now.Add(-time.Hour), now))
+	assert.Error(t, impl.validateExpiry(now.AddDate(0, 0, 31), now))
+	assert.NoError(t, impl.validateExpiry(now.AddDate(0, 0, 29), now))
+}
@@ -51,6 +51,10 @@ type PolicyService interface {
 	DeletePolicy(id int, userId int32) (*bean.IdVulnerabilityPolicyResult, error)
 	GetPolicies(policyLevel securityBean.PolicyLevel, clusterId, environmentId, appId int) (*bean.GetVulnerabilityPolicyResult, error)
 	GetBlockedCVEList(cves []*repository3.CveStore, clusterId, envId, appId int, isAppstore bool) ([]*repository3.CveStore, error)
+	// GetBlockedCVEListForImage is GetBlockedCVEList which also honours cve exceptions scoped to the image
+	GetBlockedCVEListForImage(cves []*repository3.CveStore, clusterId, envId, appId int, isAppstore bool, image string) ([]*repository3.CveStore, error)
+	GetActiveCveExceptions(appId, envId int) ([]*repository3.CveException, error)
+	SendEventToClairUtility(event *bean2.ImageScanEvent) error
 	VerifyImage(verifyImageRequest *VerifyImageRequest) (map[string][]*VerifyImageResponse, error)
 	GetCvePolicy(id int, userId int32) (*repository3.CvePolicy, error)
 	GetApplicablePolicy(clusterId, envId, appId int, isAppstore bool) (map[string]*repository3.CvePolicy, map[securityBean.Severity]*repository3.CvePolicy, error)
@@ -75,6 +79,7 @@ type PolicyServiceImpl struct {
 	ciTemplateRepository          pipelineConfig.CiTemplateRepository
 	ClusterReadService            read2.ClusterReadService
 	transactionManager            sql.TransactionWrapper
+	cveExceptionRepository        repository3.CveExceptionRepository
 }
 
 func NewPolicyServiceImpl(environmentService environment.EnvironmentService,
@@ -92,7 +97,8 @@ func NewPolicyServiceImpl(environmentService environment.EnvironmentService,
 	cveStoreRepository repository3.CveStoreRepository,
 	ciTemplateRepository pipelineConfig.CiTemplateRepository,
 	ClusterReadService read2.ClusterReadService,
-	transactionManager sql.TransactionWrapper) *PolicyServiceImpl {
+	transactionManager sql.TransactionWrapper,
+	cveExceptionRepository repository3.CveExceptionRepository) *PolicyServiceImpl {
 	return &PolicyServiceImpl{
 		environmentService:            environmentService,
 		logger:                        logger,
@@ -112,6 +118,7 @@ func NewPolicyServiceImpl(environmentService environment.EnvironmentService,
 		ciTemplateRepository:          ciTemplateRepository,
 		ClusterReadService:            ClusterReadService,
 		transactionManager:            transactionManager,
+		cveExceptionRepository:        cveExceptionRepository,
 	}
 }
 
@@ -199,6 +206,10 @@ func (impl *PolicyServiceImpl) VerifyImage(verifyImageRequest *VerifyImageReques
 	if err != nil {
 		impl.logger.Errorw("error in generating applicable policy", "err", err)
 	}
+	cveExceptions, err := impl.GetActiveCveExceptions(appId, envId)
+	if err != nil {
+		impl.logger.Errorw("error in fetching active cve exceptions", "appId", appId, "envId", envId, "err", err)
+	}
 
 	var objectType string
 	var typeId int
@@ -251,6 +262,7 @@ func (impl *PolicyServiceImpl) VerifyImage(verifyImageRequest *VerifyImageReques
 				scanResultsIdMap[scanResult.ImageScanExecutionHistoryId] = scanResult.ImageScanExecutionHistoryId
 			}
 		}
+		cveStores = repository3.RemoveExceptedCves(cveStores, cveExceptions, appId, envId, image)
 		blockedCves := repository3.EnforceCvePolicy(cveStores, cvePolicy, severityPolicy)
 		impl.logger.Debugw("blocked cve for image", "image", image, "blocked", blockedCves)
 		for _, cve := range blockedCves {
@@ -721,15 +733,35 @@ func (impl *PolicyServiceImpl) getPolicies(policyLevel securityBean.PolicyLevel,
 }
 
 func (impl *PolicyServiceImpl) GetBlockedCVEList(cves []*repository3.CveStore, clusterId, envId, appId int, isAppstore bool) ([]*repository3.CveStore, error) {
+	return impl.GetBlockedCVEListForImage(cves, clusterId, envId, appId, isAppstore, "")
+}
+
+func (impl *PolicyServiceImpl) GetBlockedCVEListForImage(cves []*repository3.CveStore, clusterId, envId, appId int, isAppstore bool, image string) ([]*repository3.CveStore, error) {
 
 	cvePolicy, severityPolicy, err := impl.GetApplicablePolicy(clusterId, envId, appId, isAppstore)
 	if err != nil {
 		return nil, err
 	}
+	cveExceptions, err := impl.GetActiveCveExceptions(appId, envId)
+	if err != nil {
+		return nil, err
+	}
+	cves = repository3.RemoveExceptedCves(cves, cveExceptions, appId, envId, image)
 	blockedCve := repository3.EnforceCvePolicy(cves, cvePolicy, severityPolicy)
 	return blockedCve, nil
 }
 
+// GetActiveCveExceptions returns approved and unexpired exceptions which may apply to the app/env, expiry is
+// checked here rather than relying on the expiry job so that a lapsed exception blocks again immediately
+func (impl *PolicyServiceImpl) GetActiveCveExceptions(appId, envId int) ([]*repository3.CveException, error) {
+	cveExceptions, err := impl.cveExceptionRepository.FindActiveByScope(appId, envId, time.Now())
+	if err != nil {
+		impl.logger.Errorw("error in fetching active cve exceptions", "appId", appId, "envId", envId, "err", err)
+		return nil, err
+	}
+	return cveExceptions, nil
+}
+
 func (impl *PolicyServiceImpl) HasBlockedCVE(cves []*repository3.CveStore, cvePolicy map[string]*repository3.CvePolicy, severityPolicy map[securityBean.Severity]*repository3.CvePolicy) bool {
 	for _, cve := range cves {
 		if policy, ok := cvePolicy[cve.Name]; ok {
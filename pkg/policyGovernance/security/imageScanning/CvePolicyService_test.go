@@ -42,17 +42,17 @@ func TestPolicyServiceImpl_HasBlockedCVE(t *testing.T) {
 						Name: "abc",
 					},
 					{
-						Severity: security.Low,
+						Severity: securityBean.Low,
 					},
 				},
 				cvePolicy: map[string]*repository2.CvePolicy{
 					"abc": {
-						Action: security.Allow,
+						Action: securityBean.Allow,
 					},
 				},
-				severityPolicy: map[security.Severity]*repository2.CvePolicy{
-					security.Low: {
-						Action: security.Allow,
+				severityPolicy: map[securityBean.Severity]*repository2.CvePolicy{
+					securityBean.Low: {
+						Action: securityBean.Allow,
 					},
 				},
 			},
@@ -68,10 +68,10 @@ func TestPolicyServiceImpl_HasBlockedCVE(t *testing.T) {
 				},
 				cvePolicy: map[string]*repository2.CvePolicy{
 					"abc": {
-						Action: security.Block,
+						Action: securityBean.Block,
 					},
 				},
-				severityPolicy: map[security.Severity]*repository2.CvePolicy{},
+				severityPolicy: map[securityBean.Severity]*repository2.CvePolicy{},
 			},
 			want: true,
 		},
@@ -80,13 +80,13 @@ func TestPolicyServiceImpl_HasBlockedCVE(t *testing.T) {
 			args: args{
 				cves: []*repository2.CveStore{
 					{
-						Severity: security.High,
+						Severity: securityBean.High,
 					},
 				},
 				cvePolicy: map[string]*repository2.CvePolicy{},
-				severityPolicy: map[security.Severity]*repository2.CvePolicy{
-					security.High: {
-						Action: security.Block,
+				severityPolicy: map[securityBean.Severity]*repository2.CvePolicy{
+					securityBean.High: {
+						Action: securityBean.Block,
 					},
 				},
 			},
@@ -103,10 +103,10 @@ func TestPolicyServiceImpl_HasBlockedCVE(t *testing.T) {
 				},
 				cvePolicy: map[string]*repository2.CvePolicy{
 					"abc": {
-						Action: security.Blockiffixed,
+						Action: securityBean.Blockiffixed,
 					},
 				},
-				severityPolicy: map[security.Severity]*repository2.CvePolicy{},
+				severityPolicy: map[securityBean.Severity]*repository2.CvePolicy{},
 			},
 			want: true,
 		},
@@ -120,10 +120,10 @@ func TestPolicyServiceImpl_HasBlockedCVE(t *testing.T) {
 				},
 				cvePolicy: map[string]*repository2.CvePolicy{
 					"abc": {
-						Action: security.Blockiffixed,
+						Action: securityBean.Blockiffixed,
 					},
 				},
-				severityPolicy: map[security.Severity]*repository2.CvePolicy{},
+				severityPolicy: map[securityBean.Severity]*repository2.CvePolicy{},
 			},
 			want: false,
 		},
@@ -132,14 +132,14 @@ func TestPolicyServiceImpl_HasBlockedCVE(t *testing.T) {
 			args: args{
 				cves: []*repository2.CveStore{
 					{
-						Severity:     security.High,
+						Severity:     securityBean.High,
 						FixedVersion: "1.0.0",
 					},
 				},
 				cvePolicy: map[string]*repository2.CvePolicy{},
-				severityPolicy: map[security.Severity]*repository2.CvePolicy{
-					security.High: {
-						Action: security.Blockiffixed,
+				severityPolicy: map[securityBean.Severity]*repository2.CvePolicy{
+					securityBean.High: {
+						Action: securityBean.Blockiffixed,
 					},
 				},
 			},
@@ -150,13 +150,13 @@ func TestPolicyServiceImpl_HasBlockedCVE(t *testing.T) {
 			args: args{
 				cves: []*repository2.CveStore{
 					{
-						Severity: security.High,
+						Severity: securityBean.High,
 					},
 				},
 				cvePolicy: map[string]*repository2.CvePolicy{},
-				severityPolicy: map[security.Severity]*repository2.CvePolicy{
-					security.High: {
-						Action: security.Blockiffixed,
+				severityPolicy: map[securityBean.Severity]*repository2.CvePolicy{
+					securityBean.High: {
+						Action: securityBean.Blockiffixed,
 					},
 				},
 			},
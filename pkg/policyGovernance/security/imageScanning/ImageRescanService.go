@@ -0,0 +1,405 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package imageScanning
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/caarlos0/env"
+	"github.com/devtron-labs/common-lib/constants"
+	bean2 "github.com/devtron-labs/common-lib/imageScan/bean"
+	client "github.com/devtron-labs/devtron/client/events"
+	repository1 "github.com/devtron-labs/devtron/internal/sql/repository/app"
+	"github.com/devtron-labs/devtron/internal/sql/repository/pipelineConfig"
+	bean4 "github.com/devtron-labs/devtron/pkg/auth/user/bean"
+	"github.com/devtron-labs/devtron/pkg/cluster/environment"
+	bean3 "github.com/devtron-labs/devtron/pkg/policyGovernance/security/imageScanning/bean"
+	repository3 "github.com/devtron-labs/devtron/pkg/policyGovernance/security/imageScanning/repository"
+	securityBean "github.com/devtron-labs/devtron/pkg/policyGovernance/security/imageScanning/repository/bean"
+	"github.com/devtron-labs/devtron/pkg/sql"
+	cron2 "github.com/devtron-labs/devtron/util/cron"
+	util2 "github.com/devtron-labs/devtron/util/event"
+	"github.com/go-pg/pg"
+	"github.com/robfig/cron/v3"
+	"go.uber.org/zap"
+)
+
+type ImageRescanService interface {
+	// RunRescanCycle diffs the rescans completed since the last run against their previous scan and notifies
+	// about new findings, then sends the next batch of running images for rescan
+	RunRescanCycle()
+}
+
+type ImageRescanServiceImpl struct {
+	logger                        *zap.SugaredLogger
+	imageRescanRepository         repository3.ImageRescanRepository
+	imageScanDeployInfoRepository repository3.ImageScanDeployInfoRepository
+	scanHistoryRepository         repository3.ImageScanHistoryRepository
+	scanResultRepository          repository3.ImageScanResultRepository
+	ciTemplateRepository          pipelineConfig.CiTemplateRepository
+	appRepository                 repository1.AppRepository
+	envService                    environment.EnvironmentService
+	policyService                 PolicyService
+	eventClient                   client.EventClient
+	eventFactory                  client.EventFactory
+	cronLease                     sql.CronLease
+	config                        *bean3.ImageRescanConfig
+	notifySeverities              map[securityBean.Severity]bool
+	cron                          *cron.Cron
+}
+
+func NewImageRescanServiceImpl(logger *zap.SugaredLogger,
+	imageRescanRepository repository3.ImageRescanRepository,
+	imageScanDeployInfoRepository repository3.ImageScanDeployInfoRepository,
+	scanHistoryRepository repository3.ImageScanHistoryRepository,
+	scanResultRepository repository3.ImageScanResultRepository,
+	ciTemplateRepository pipelineConfig.CiTemplateRepository,
+	appRepository repository1.AppRepository,
+	envService environment.EnvironmentService,
+	policyService PolicyService,
+	eventClient client.EventClient,
+	eventFactory client.EventFactory,
+	cronLease sql.CronLease,
+	cronLogger *cron2.CronLoggerImpl) (*ImageRescanServiceImpl, error) {
+	config := &bean3.ImageRescanConfig{}
+	err := env.Parse(config)
+	if err != nil {
+		logger.Errorw("error in parsing image rescan config", "err", err)
+		return nil, err
+	}
+	notifySeverities := make(map[securityBean.Severity]bool)
+	for _, severity := range config.NotifySeverities {
+		severityEnum, err := securityBean.SeverityStringToEnumWithError(strings.ToLower(strings.TrimSpace(severity)))
+		if err != nil {
+			logger.Errorw("invalid image rescan notification severity", "severity", severity, "err", err)
+			return nil, err
+		}
+		notifySeverities[severityEnum] = true
+	}
+	impl := &ImageRescanServiceImpl{
+		logger:                        logger,
+		imageRescanRepository:         imageRescanRepository,
+		imageScanDeployInfoRepository: imageScanDeployInfoRepository,
+		scanHistoryRepository:         scanHistoryRepository,
+		scanResultRepository:          scanResultRepository,
+		ciTemplateRepository:          ciTemplateRepository,
+		appRepository:                 appRepository,
+		envService:                    envService,
+		policyService:                 policyService,
+		eventClient:                   eventClient,
+		eventFactory:                  eventFactory,
+		cronLease:                     cronLease,
+		config:                        config,
+		notifySeverities:              notifySeverities,
+	}
+	if len(config.RescanCronExpression) > 0 {
+		impl.cron = cron.New(cron.WithChain(cron.SkipIfStillRunning(cronLogger), cron.Recover(cronLogger)))
+		_, err = impl.cron.AddFunc(config.RescanCronExpression, impl.RunRescanCycle)
+		if err != nil {
+			logger.Errorw("error in adding image rescan cron", "cronExpression", config.RescanCronExpression, "err", err)
+			return nil, err
+		}
+		impl.cron.Start()
+	}
+	return impl, nil
+}
+
+// deployedImage is an image running on one or more app/env pairs
+type deployedImage struct {
+	image       string
+	imageDigest string
+	targets     []*repository3.ImageScanDeployInfo
+}
+
+func (impl *ImageRescanServiceImpl) RunRescanCycle() {
+	acquired, err := impl.cronLease.TryAcquire(bean3.ImageRescanLeaseKey, bean3.ImageRescanLeaseTtl)
+	if err != nil {
+		impl.logger.Errorw("error in taking lease for image rescan cycle", "err", err)
+		return
+	}
+	if !acquired {
+		impl.logger.Debugw("image rescan cycle is running on another replica, skipping")
+		return
+	}
+	defer func() {
+		err := impl.cronLease.Release(bean3.ImageRescanLeaseKey)
+		if err != nil {
+			impl.logger.Errorw("error in releasing lease of image rescan cycle", "err", err)
+		}
+	}()
+	deployedImages, err := impl.getDeployedImages()
+	if err != nil {
+		impl.logger.Errorw("error in fetching deployed images for rescan", "err", err)
+		return
+	}
+	pendingImages := impl.processPendingRescans(deployedImages)
+	impl.triggerRescans(deployedImages, pendingImages)
+}
+
+// getDeployedImages resolves the images of the latest deployment of every app/env which was scanned
+func (impl *ImageRescanServiceImpl) getDeployedImages() (map[string]*deployedImage, error) {
+	deployInfos, err := impl.imageScanDeployInfoRepository.FindScannedDeployInfoWithFilters(nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	var historyIds []int
+	for _, deployInfo := range deployInfos {
+		for _, historyId := range deployInfo.ImageScanExecutionHistoryId {
+			if historyId > 0 {
+				historyIds = append(historyIds, historyId)
+			}
+		}
+	}
+	deployedImages := make(map[string]*deployedImage)
+	if len(historyIds) == 0 {
+		return deployedImages, nil
+	}
+	histories, err := impl.scanHistoryRepository.FindByIds(historyIds)
+	if err != nil {
+		return nil, err
+	}
+	historyById := make(map[int]*repository3.ImageScanExecutionHistory, len(histories))
+	for _, history := range histories {
+		historyById[history.Id] = history
+	}
+	for _, deployInfo := range deployInfos {
+		for _, historyId := range deployInfo.ImageScanExecutionHistoryId {
+			history, ok := historyById[historyId]
+			if !ok || len(history.Image) == 0 {
+				continue
+			}
+			image, ok := deployedImages[history.Image]
+			if !ok {
+				image = &deployedImage{image: history.Image, imageDigest: history.ImageHash}
+				deployedImages[history.Image] = image
+			}
+			image.targets = append(image.targets, deployInfo)
+		}
+	}
+	return deployedImages, nil
+}
+
+// processPendingRescans completes the rescans whose scan has finished and returns the images still pending
+func (impl *ImageRescanServiceImpl) processPendingRescans(deployedImages map[string]*deployedImage) map[string]bool {
+	pendingImages := make(map[string]bool)
+	rescans, err := impl.imageRescanRepository.FindByStatus(securityBean.ImageRescanPending)
+	if err != nil {
+		impl.logger.Errorw("error in fetching pending image rescans", "err", err)
+		return pendingImages
+	}
+	now := time.Now()
+	timeout := time.Duration(impl.config.TimeoutMins) * time.Minute
+	for _, rescan := range rescans {
+		latest, err := impl.scanHistoryRepository.FindByImageAndDigestWithHistoryMapping(rescan.ImageDigest, rescan.Image)
+		if err != nil && err != pg.ErrNoRows {
+			impl.logger.Errorw("error in fetching latest scan of image", "image", rescan.Image, "err", err)
+			pendingImages[rescan.Image] = true
+			continue
+		}
+		scanFinished := latest != nil && latest.Id > rescan.PreviousExecutionHistoryId && !latest.ExecutionTime.Before(rescan.TriggeredOn) &&
+			(latest.ScanToolExecutionHistoryMapping == nil || latest.ScanToolExecutionHistoryMapping.State != repository3.ScanExecutionProcessStateRunning)
+		if !scanFinished {
+			if now.Sub(rescan.TriggeredOn) > timeout {
+				impl.updateRescanStatus(rescan, securityBean.ImageRescanFailed, now)
+				continue
+			}
+			pendingImages[rescan.Image] = true
+			continue
+		}
+		rescan.RescanExecutionHistoryId = latest.Id
+		if latest.ScanToolExecutionHistoryMapping != nil && latest.ScanToolExecutionHistoryMapping.State == repository3.ScanExecutionProcessStateFailed {
+			impl.updateRescanStatus(rescan, securityBean.ImageRescanFailed, now)
+			continue
+		}
+		results, err := impl.scanResultRepository.FetchByScanExecutionId(latest.Id)
+		if err != nil {
+			impl.logger.Errorw("error in fetching rescan results", "image", rescan.Image, "executionHistoryId", latest.Id, "err", err)
+			pendingImages[rescan.Image] = true
+			continue
+		}
+		newFindings := impl.getNewFindings(results, rescan.PreviousCveNames)
+		for _, finding := range newFindings {
+			rescan.NewCveNames = append(rescan.NewCveNames, finding.CveStoreName)
+		}
+		impl.updateRescanStatus(rescan, securityBean.ImageRescanCompleted, now)
+		if image, ok := deployedImages[rescan.Image]; ok && len(newFindings) > 0 {
+			impl.notifyNewFindings(image, newFindings)
+		}
+	}
+	return pendingImages
+}
+
+func (impl *ImageRescanServiceImpl) triggerRescans(deployedImages map[string]*deployedImage, pendingImages map[string]bool) {
+	images := make([]string, 0, len(deployedImages))
+	for image := range deployedImages {
+		images = append(images, image)
+	}
+	sort.Strings(images)
+	scannedAfter := time.Now().Add(-time.Duration(impl.config.MinRescanIntervalHrs) * time.Hour)
+	triggered := 0
+	for _, image := range images {
+		if triggered >= impl.config.BatchSize {
+			break
+		}
+		if pendingImages[image] {
+			continue
+		}
+		deployed := deployedImages[image]
+		latest, err := impl.scanHistoryRepository.FindByImageAndDigest(deployed.imageDigest, deployed.image)
+		if err != nil {
+			impl.logger.Errorw("error in fetching latest scan of image", "image", image, "err", err)
+			continue
+		}
+		if latest.ExecutionTime.After(scannedAfter) {
+			continue
+		}
+		err = impl.triggerRescan(deployed, latest)
+		if err != nil {
+			impl.logger.Errorw("error in triggering image rescan", "image", image, "err", err)
+			continue
+		}
+		triggered++
+	}
+	impl.logger.Infow("image rescan cycle completed", "deployedImages", len(images), "pending", len(pendingImages), "triggered", triggered)
+}
+
+func (impl *ImageRescanServiceImpl) triggerRescan(deployed *deployedImage, latest *repository3.ImageScanExecutionHistory) error {
+	previousResults, err := impl.scanResultRepository.FetchByScanExecutionId(latest.Id)
+	if err != nil {
+		return err
+	}
+	target := deployed.targets[0]
+	scanEvent := &bean2.ImageScanEvent{
+		Image:         deployed.image,
+		ImageDigest:   deployed.imageDigest,
+		AppId:         target.ScanObjectMetaId,
+		EnvId:         target.EnvId,
+		UserId:        int(bean4.SYSTEM_USER_ID),
+		SourceType:    constants.SourceTypeImage,
+		SourceSubType: constants.SourceSubTypeCi,
+		ReScan:        true,
+	}
+	ciTemplate, err := impl.ciTemplateRepository.FindByAppId(target.ScanObjectMetaId)
+	if err != nil && err != pg.ErrNoRows {
+		return err
+	}
+	if ciTemplate != nil && ciTemplate.DockerRegistry != nil {
+		scanEvent.DockerRegistryId = ciTemplate.DockerRegistry.Id
+	}
+	triggeredOn := time.Now()
+	err = impl.policyService.SendEventToClairUtility(scanEvent)
+	if err != nil {
+		return err
+	}
+	var previousCveNames []string
+	for _, result := range impl.getNewFindings(previousResults, nil) {
+		previousCveNames = append(previousCveNames, result.CveStoreName)
+	}
+	rescan := &repository3.ImageRescan{
+		Image:                      deployed.image,
+		ImageDigest:                deployed.imageDigest,
+		PreviousExecutionHistoryId: latest.Id,
+		PreviousCveNames:           previousCveNames,
+		Status:                     securityBean.ImageRescanPending,
+		TriggeredOn:                triggeredOn,
+		AuditLog:                   sql.NewDefaultAuditLog(bean4.SYSTEM_USER_ID),
+	}
+	return impl.imageRescanRepository.Save(rescan)
+}
+
+// getNewFindings returns one result per cve at a notified severity which is not in previousCveNames,
+// severities are read from the cve store so a cve upgraded to a notified severity counts as new
+func (impl *ImageRescanServiceImpl) getNewFindings(results []*repository3.ImageScanExecutionResult, previousCveNames []string) []*repository3.ImageScanExecutionResult {
+	seen := make(map[string]bool, len(previousCveNames))
+	for _, cveName := range previousCveNames {
+		seen[cveName] = true
+	}
+	var findings []*repository3.ImageScanExecutionResult
+	for _, result := range results {
+		if seen[result.CveStoreName] || !impl.notifySeverities[result.CveStore.GetSeverity()] {
+			continue
+		}
+		seen[result.CveStoreName] = true
+		findings = append(findings, result)
+	}
+	return findings
+}
+
+func (impl *ImageRescanServiceImpl) notifyNewFindings(deployed *deployedImage, findings []*repository3.ImageScanExecutionResult) {
+	for _, target := range deployed.targets {
+		if !target.IsObjectTypeApp() {
+			continue
+		}
+		appId, envId := target.ScanObjectMetaId, target.EnvId
+		cveExceptions, err := impl.policyService.GetActiveCveExceptions(appId, envId)
+		if err != nil {
+			impl.logger.Errorw("error in fetching active cve exceptions", "appId", appId, "envId", envId, "err", err)
+		}
+		var vulnerabilities []*client.RescanVulnerabilityEntry
+		for _, finding := range findings {
+			if repository3.FindMatchingCveException(cveExceptions, finding.CveStoreName, appId, envId, deployed.image) != nil {
+				continue
+			}
+			vulnerabilities = append(vulnerabilities, &client.RescanVulnerabilityEntry{
+				CveName:      finding.CveStoreName,
+				Severity:     finding.CveStore.GetSeverity().String(),
+				Package:      finding.Package,
+				Version:      finding.Version,
+				FixedVersion: finding.FixedVersion,
+			})
+		}
+		if len(vulnerabilities) == 0 {
+			continue
+		}
+		event, err := impl.eventFactory.Build(util2.ImageRescanVulnerability, nil, appId, &envId, util2.CD)
+		if err != nil {
+			impl.logger.Errorw("error in building image rescan event", "appId", appId, "envId", envId, "err", err)
+			continue
+		}
+		payload := &client.Payload{
+			DockerImageUrl: deployed.image,
+			ImageRescan: &client.ImageRescanPayload{
+				Image:              deployed.image,
+				ImageDigest:        deployed.imageDigest,
+				NewVulnerabilities: vulnerabilities,
+			},
+		}
+		if app, err := impl.appRepository.FindById(appId); err == nil {
+			payload.AppName = app.AppName
+		}
+		if env, err := impl.envService.FindById(envId); err == nil {
+			payload.EnvName = env.Environment
+		}
+		event.Payload = payload
+		_, err = impl.eventClient.WriteNotificationEvent(event)
+		if err != nil {
+			impl.logger.Errorw("error in sending image rescan notification", "appId", appId, "envId", envId, "image", deployed.image, "err", err)
+		}
+	}
+}
+
+func (impl *ImageRescanServiceImpl) updateRescanStatus(rescan *repository3.ImageRescan, status securityBean.ImageRescanStatus, now time.Time) {
+	rescan.Status = status
+	rescan.CompletedOn = now
+	rescan.UpdateAuditLog(bean4.SYSTEM_USER_ID)
+	err := impl.imageRescanRepository.Update(rescan)
+	if err != nil {
+		impl.logger.Errorw("error in updating image rescan", "id", rescan.Id, "status", status, "err", err)
+	}
+}
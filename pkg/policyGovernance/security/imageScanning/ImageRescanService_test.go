@@ -0,0 +1,133 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package imageScanning
+
+import (
+	"testing"
+	"time"
+
+	"github.com/caarlos0/env"
+	bean2 "github.com/devtron-labs/common-lib/imageScan/bean"
+	pipelineConfigMocks "github.com/devtron-labs/devtron/internal/sql/repository/pipelineConfig/mocks"
+	bean3 "github.com/devtron-labs/devtron/pkg/policyGovernance/security/imageScanning/bean"
+	repository3 "github.com/devtron-labs/devtron/pkg/policyGovernance/security/imageScanning/repository"
+	securityBean "github.com/devtron-labs/devtron/pkg/policyGovernance/security/imageScanning/repository/bean"
+	repositoryMocks "github.com/devtron-labs/devtron/pkg/policyGovernance/security/imageScanning/repository/mocks"
+	sqlMocks "github.com/devtron-labs/devtron/pkg/sql/mocks"
+	"github.com/go-pg/pg"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestImageRescanConfigIsOptIn(t *testing.T) {
+	config := &bean3.ImageRescanConfig{}
+	err := env.Parse(config)
+	assert.NoError(t, err)
+	assert.Empty(t, config.RescanCronExpression)
+}
+
+func TestRunRescanCycle(t *testing.T) {
+	t.Run("cycle is skipped when another replica holds the lease", func(t *testing.T) {
+		cronLease := sqlMocks.NewCronLease(t)
+		cronLease.On("TryAcquire", bean3.ImageRescanLeaseKey, bean3.ImageRescanLeaseTtl).Return(false, nil).Once()
+		deployInfoRepository := repositoryMocks.NewImageScanDeployInfoRepository(t)
+		impl := &ImageRescanServiceImpl{logger: zap.NewNop().Sugar(), cronLease: cronLease, imageScanDeployInfoRepository: deployInfoRepository}
+		impl.RunRescanCycle()
+		deployInfoRepository.AssertNotCalled(t, "FindScannedDeployInfoWithFilters", mock.Anything, mock.Anything)
+		cronLease.AssertNotCalled(t, "Release", mock.Anything)
+	})
+	t.Run("lease is released after the cycle", func(t *testing.T) {
+		cronLease := sqlMocks.NewCronLease(t)
+		cronLease.On("TryAcquire", bean3.ImageRescanLeaseKey, bean3.ImageRescanLeaseTtl).Return(true, nil).Once()
+		cronLease.On("Release", bean3.ImageRescanLeaseKey).Return(nil).Once()
+		deployInfoRepository := repositoryMocks.NewImageScanDeployInfoRepository(t)
+		deployInfoRepository.On("FindScannedDeployInfoWithFilters", mock.Anything, mock.Anything).Return(nil, nil).Once()
+		rescanRepository := repositoryMocks.NewImageRescanRepository(t)
+		rescanRepository.On("FindByStatus", mock.Anything).Return(nil, nil).Maybe()
+		impl := &ImageRescanServiceImpl{logger: zap.NewNop().Sugar(), cronLease: cronLease, imageScanDeployInfoRepository: deployInfoRepository,
+			imageRescanRepository: rescanRepository, config: &bean3.ImageRescanConfig{BatchSize: 1}}
+		impl.RunRescanCycle()
+	})
+}
+
+func TestTriggerRescans(t *testing.T) {
+	newDeployedImage := func(image string) *deployedImage {
+		return &deployedImage{image: image, imageDigest: "sha256:" + image, targets: []*repository3.ImageScanDeployInfo{{ScanObjectMetaId: 1, EnvId: 1}}}
+	}
+	scannedHoursAgo := func(id int, image string, hours int) *repository3.ImageScanExecutionHistory {
+		return &repository3.ImageScanExecutionHistory{Id: id, Image: image, ExecutionTime: time.Now().Add(-time.Duration(hours) * time.Hour)}
+	}
+	deployedImages := map[string]*deployedImage{
+		"api": newDeployedImage("api"), "db": newDeployedImage("db"), "web": newDeployedImage("web"), "worker": newDeployedImage("worker"),
+	}
+	scanHistoryRepository := repositoryMocks.NewImageScanHistoryRepository(t)
+	for _, history := range []*repository3.ImageScanExecutionHistory{
+		scannedHoursAgo(1, "api", 48), scannedHoursAgo(2, "db", 1), scannedHoursAgo(3, "web", 48), scannedHoursAgo(4, "worker", 48),
+	} {
+		scanHistoryRepository.On("FindByImageAndDigest", "sha256:"+history.Image, history.Image).Return(history, nil).Maybe()
+	}
+	scanResultRepository := repositoryMocks.NewImageScanResultRepository(t)
+	scanResultRepository.On("FetchByScanExecutionId", mock.Anything).Return(nil, nil).Maybe()
+	ciTemplateRepository := pipelineConfigMocks.NewCiTemplateRepository(t)
+	ciTemplateRepository.On("FindByAppId", mock.Anything).Return(nil, pg.ErrNoRows).Maybe()
+	var scannedImages []string
+	policyService := NewMockPolicyService(t)
+	policyService.On("SendEventToClairUtility", mock.AnythingOfType("*bean.ImageScanEvent")).Run(func(args mock.Arguments) {
+		scannedImages = append(scannedImages, args.Get(0).(*bean2.ImageScanEvent).Image)
+	}).Return(nil)
+	var saved []*repository3.ImageRescan
+	rescanRepository := repositoryMocks.NewImageRescanRepository(t)
+	rescanRepository.On("Save", mock.AnythingOfType("*repository.ImageRescan")).Run(func(args mock.Arguments) {
+		saved = append(saved, args.Get(0).(*repository3.ImageRescan))
+	}).Return(nil)
+	impl := &ImageRescanServiceImpl{
+		logger:                zap.NewNop().Sugar(),
+		imageRescanRepository: rescanRepository,
+		scanHistoryRepository: scanHistoryRepository,
+		scanResultRepository:  scanResultRepository,
+		ciTemplateRepository:  ciTemplateRepository,
+		policyService:         policyService,
+		config:                &bean3.ImageRescanConfig{MinRescanIntervalHrs: 24, BatchSize: 1},
+	}
+	// api is pending, db was scanned recently and the batch is full after web
+	impl.triggerRescans(deployedImages, map[string]bool{"api": true})
+	assert.Equal(t, []string{"web"}, scannedImages)
+	assert.Len(t, saved, 1)
+	assert.Equal(t, 3, saved[0].PreviousExecutionHistoryId)
+	assert.Equal(t, securityBean.ImageRescanPending, saved[0].Status)
+}
+
+func TestGetNewFindings(t *testing.T) {
+	result := func(cveName string, severity securityBean.Severity) *repository3.ImageScanExecutionResult {
+		return &repository3.ImageScanExecutionResult{CveStoreName: cveName, CveStore: repository3.CveStore{Name: cveName, Severity: severity}}
+	}
+	cveNames := func(results []*repository3.ImageScanExecutionResult) []string {
+		names := make([]string, 0, len(results))
+		for _, result := range results {
+			names = append(names, result.CveStoreName)
+		}
+		return names
+	}
+	impl := &ImageRescanServiceImpl{notifySeverities: map[securityBean.Severity]bool{securityBean.Critical: true}}
+	results := []*repository3.ImageScanExecutionResult{
+		result("CVE-1", securityBean.Critical), result("CVE-2", securityBean.Low), result("CVE-3", securityBean.Critical),
+		result("CVE-3", securityBean.Critical), result("CVE-4", securityBean.Critical),
+	}
+	assert.Equal(t, []string{"CVE-3", "CVE-4"}, cveNames(impl.getNewFindings(results, []string{"CVE-1"})))
+	assert.Empty(t, impl.getNewFindings(nil, nil))
+}
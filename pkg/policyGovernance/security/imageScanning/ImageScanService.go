@@ -601,12 +601,19 @@ func (impl ImageScanServiceImpl) FetchExecutionDetailResult(request *bean3.Image
 		imageScanResponse.EnvId = request.EnvId
 		imageScanResponse.EnvName = env.Environment
 
-		blockCveList, err := impl.policyService.GetBlockedCVEList(cveStores, env.ClusterId, env.Id, request.AppId, app.AppType == helper.ChartStoreApp)
+		blockCveList, err := impl.policyService.GetBlockedCVEListForImage(cveStores, env.ClusterId, env.Id, request.AppId, app.AppType == helper.ChartStoreApp, imageScanResponse.Image)
 		if err != nil {
 			impl.Logger.Errorw("error while fetching env", "err", err)
 			//return nil, err
 			//TODO - review @nishant
 		}
+		cveExceptions, err := impl.policyService.GetActiveCveExceptions(request.AppId, env.Id)
+		if err != nil {
+			impl.Logger.Errorw("error in fetching active cve exceptions", "appId", request.AppId, "envId", env.Id, "err", err)
+		}
+		for _, vulnerability := range imageScanResponse.Vulnerabilities {
+			vulnerability.Exception = getCveExceptionInfo(cveExceptions, vulnerability.CVEName, request.AppId, env.Id, imageScanResponse.Image)
+		}
 		if blockCveList != nil {
 			vulnerabilityPermissionMap := make(map[string]string)
 			for _, cve := range blockCveList {
@@ -822,6 +829,12 @@ func (impl *ImageScanServiceImpl) GetArtifactVulnerabilityStatus(ctx context.Con
 			}
 			request.CdPipeline.Environment = *envDetails
 		}
+		cveExceptions, err := impl.policyService.GetActiveCveExceptions(request.CdPipeline.AppId, request.CdPipeline.EnvironmentId)
+		if err != nil {
+			impl.Logger.Errorw("error in fetching active cve exceptions, GetArtifactVulnerabilityStatus", "appId", request.CdPipeline.AppId, "envId", request.CdPipeline.EnvironmentId, "err", err)
+			return false, err
+		}
+		cveStores = repository3.RemoveExceptedCves(cveStores, cveExceptions, request.CdPipeline.AppId, request.CdPipeline.EnvironmentId, request.Image)
 		blockCveList, err := impl.cvePolicyRepository.GetBlockedCVEList(cveStores, request.CdPipeline.Environment.ClusterId, request.CdPipeline.EnvironmentId, request.CdPipeline.AppId, false)
 		span.End()
 		if err != nil {
@@ -1124,6 +1137,7 @@ func (impl *ImageScanServiceImpl) FetchVulnerabilityListing(ctx context.Context,
 
 	// Slice for pagination
 	paginatedVulnerabilities := vulnerabilities[start:end]
+	impl.annotateCveExceptions(paginatedVulnerabilities)
 
 	return &bean3.VulnerabilityListingResponse{
 		Offset:          request.Offset,
@@ -1133,6 +1147,37 @@ func (impl *ImageScanServiceImpl) FetchVulnerabilityListing(ctx context.Context,
 	}, nil
 }
 
+// annotateCveExceptions marks vulnerabilities covered by an active app/env scoped cve exception, image scoped
+// exceptions are not applied as the listing aggregates over all images deployed on an app/env
+func (impl *ImageScanServiceImpl) annotateCveExceptions(vulnerabilities []*bean3.VulnerabilityDetail) {
+	exceptionsByAppEnv := make(map[string][]*repository3.CveException)
+	for _, vuln := range vulnerabilities {
+		key := fmt.Sprintf("%d|%d", vuln.AppId, vuln.EnvId)
+		cveExceptions, ok := exceptionsByAppEnv[key]
+		if !ok {
+			var err error
+			cveExceptions, err = impl.policyService.GetActiveCveExceptions(vuln.AppId, vuln.EnvId)
+			if err != nil {
+				impl.Logger.Errorw("error in fetching active cve exceptions", "appId", vuln.AppId, "envId", vuln.EnvId, "err", err)
+			}
+			exceptionsByAppEnv[key] = cveExceptions
+		}
+		vuln.Exception = getCveExceptionInfo(cveExceptions, vuln.CVEName, vuln.AppId, vuln.EnvId, "")
+	}
+}
+
+func getCveExceptionInfo(cveExceptions []*repository3.CveException, cveName string, appId, envId int, image string) *bean3.CveExceptionInfo {
+	exception := repository3.FindMatchingCveException(cveExceptions, cveName, appId, envId, image)
+	if exception == nil {
+		return nil
+	}
+	return &bean3.CveExceptionInfo{
+		Id:            exception.Id,
+		Justification: exception.Justification,
+		ExpiresOn:     exception.ExpiresOn,
+	}
+}
+
 // applyVulnerabilityFilters applies code-level filters (fix availability and vulnerability age)
 func (impl *ImageScanServiceImpl) applyVulnerabilityFilters(vulnerabilities []*bean3.VulnerabilityDetail, request *bean3.VulnerabilityListingRequest) []*bean3.VulnerabilityDetail {
 	filtered := make([]*bean3.VulnerabilityDetail, 0, len(vulnerabilities))
@@ -24,6 +24,8 @@ type Vulnerabilities struct {
 	Target     string `json:"target"`
 	Class      string `json:"class"`
 	Type       string `json:"type"`
+	// Exception is set when an active cve exception suppresses the block for this vulnerability
+	Exception *CveExceptionInfo `json:"exception,omitempty"`
 }
 
 func (vul *Vulnerabilities) ToSeverity() parser.Severity {
@@ -182,6 +184,8 @@ type VulnerabilityDetail struct {
 	Package        string    `json:"package"`        // Vulnerable package name
 	CurrentVersion string    `json:"currentVersion"` // Current vulnerable version
 	FixedVersion   string    `json:"fixedVersion"`   // Fixed version (empty if not fixable)
+	// Exception is set when an active cve exception covers this app/env
+	Exception *CveExceptionInfo `json:"exception,omitempty"`
 }
 
 type ImageScanExecutionDetail struct {
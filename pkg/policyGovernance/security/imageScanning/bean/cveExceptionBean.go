@@ -0,0 +1,87 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package bean
+
+import (
+	"time"
+
+	"github.com/devtron-labs/devtron/pkg/policyGovernance/security/imageScanning/repository/bean"
+)
+
+type CveExceptionConfig struct {
+	MaxValidityDays        int    `env:"CVE_EXCEPTION_MAX_VALIDITY_DAYS" envDefault:"90" description:"Maximum number of days a cve exception can stay valid after approval"`
+	ExpiryNotificationDays int    `env:"CVE_EXCEPTION_EXPIRY_NOTIFICATION_DAYS" envDefault:"7" description:"Number of days before expiry at which the requester and approver of a cve exception are notified"`
+	ExpiryCheckCron        string `env:"CVE_EXCEPTION_EXPIRY_CHECK_CRON" envDefault:"@every 30m" description:"Cron expression for expiring cve exceptions and sending expiry notifications, empty value disables the job"`
+	AllowSelfApproval      bool   `env:"CVE_EXCEPTION_ALLOW_SELF_APPROVAL" envDefault:"false" description:"Allows the requester of a cve exception to approve it"`
+}
+
+const (
+	// CveExceptionExpiryLeaseKey is the cron lease taken by the expiry job so that it runs on one replica at a time
+	CveExceptionExpiryLeaseKey = "cve_exception_expiry"
+	// CveExceptionExpiryLeaseTtl bounds an expiry run, another replica can take the job over once it expires
+	CveExceptionExpiryLeaseTtl = 15 * time.Minute
+)
+
+type CveExceptionRequest struct {
+	CveName       string    `json:"cveName" validate:"required"`
+	AppId         int       `json:"appId"`
+	EnvId         int       `json:"envId"`
+	Image         string    `json:"image"`
+	Justification string    `json:"justification" validate:"required,min=10"`
+	ExpiresOn     time.Time `json:"expiresOn" validate:"required"`
+}
+
+type CveExceptionReviewRequest struct {
+	Id      int                     `json:"-"`
+	Status  bean.CveExceptionStatus `json:"status" validate:"required,oneof=APPROVED REJECTED"`
+	Comment string                  `json:"comment"`
+	// ExpiresOn optionally shortens the expiry requested by the requester while approving
+	ExpiresOn *time.Time `json:"expiresOn,omitempty"`
+}
+
+type CveExceptionListRequest struct {
+	CveName string                  `json:"cveName"`
+	AppId   int                     `json:"appId"`
+	EnvId   int                     `json:"envId"`
+	Status  bean.CveExceptionStatus `json:"status"`
+}
+
+type CveExceptionDto struct {
+	Id             int                     `json:"id"`
+	CveName        string                  `json:"cveName"`
+	AppId          int                     `json:"appId,omitempty"`
+	AppName        string                  `json:"appName,omitempty"`
+	EnvId          int                     `json:"envId,omitempty"`
+	EnvName        string                  `json:"envName,omitempty"`
+	Image          string                  `json:"image,omitempty"`
+	Justification  string                  `json:"justification"`
+	Status         bean.CveExceptionStatus `json:"status"`
+	RequestedBy    string                  `json:"requestedBy"`
+	RequestedOn    time.Time               `json:"requestedOn"`
+	ReviewedBy     string                  `json:"reviewedBy,omitempty"`
+	ReviewedOn     *time.Time              `json:"reviewedOn,omitempty"`
+	ReviewComment  string                  `json:"reviewComment,omitempty"`
+	ExpiresOn      time.Time               `json:"expiresOn"`
+	ExpiryNotified bool                    `json:"expiryNotified"`
+}
+
+// CveExceptionInfo annotates a vulnerability in listings when an active exception suppresses it
+type CveExceptionInfo struct {
+	Id            int       `json:"id"`
+	Justification string    `json:"justification"`
+	ExpiresOn     time.Time `json:"expiresOn"`
+}
@@ -0,0 +1,34 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package bean
+
+import "time"
+
+type ImageRescanConfig struct {
+	RescanCronExpression string   `env:"IMAGE_RESCAN_CRON" envDefault:"" description:"Cron expression for rescanning images of running deployments and processing completed rescans, rescanning is disabled when empty" example:"@every 6h"`
+	MinRescanIntervalHrs int      `env:"IMAGE_RESCAN_MIN_INTERVAL_HOURS" envDefault:"24" description:"Images scanned within this many hours are not rescanned"`
+	BatchSize            int      `env:"IMAGE_RESCAN_BATCH_SIZE" envDefault:"50" description:"Maximum number of images sent for rescan in one run"`
+	TimeoutMins          int      `env:"IMAGE_RESCAN_TIMEOUT_MINUTES" envDefault:"360" description:"A rescan without a completed scan execution after this many minutes is marked failed"`
+	NotifySeverities     []string `env:"IMAGE_RESCAN_NOTIFY_SEVERITIES" envDefault:"critical" envSeparator:"," description:"Severities of newly found vulnerabilities in running images which raise a notification" example:"critical,high"`
+}
+
+const (
+	// ImageRescanLeaseKey is the cron lease taken by a rescan cycle so that it runs on one replica at a time
+	ImageRescanLeaseKey = "image_rescan"
+	// ImageRescanLeaseTtl bounds a rescan cycle, another replica can take the cycle over once it expires
+	ImageRescanLeaseTtl = time.Hour
+)
@@ -0,0 +1,191 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package repository
+
+import (
+	"strings"
+	"time"
+
+	securityBean "github.com/devtron-labs/devtron/pkg/policyGovernance/security/imageScanning/repository/bean"
+	"github.com/devtron-labs/devtron/pkg/sql"
+	"github.com/go-pg/pg"
+	"github.com/go-pg/pg/orm"
+	"go.uber.org/zap"
+)
+
+type CveException struct {
+	tableName      struct{}                        `sql:"cve_exception" pg:",discard_unknown_columns"`
+	Id             int                             `sql:"id,pk"`
+	CveName        string                          `sql:"cve_name,notnull"`
+	AppId          int                             `sql:"app_id"`
+	EnvId          int                             `sql:"env_id"`
+	Image          string                          `sql:"image"`
+	Justification  string                          `sql:"justification,notnull"`
+	Status         securityBean.CveExceptionStatus `sql:"status,notnull"`
+	RequestedBy    int32                           `sql:"requested_by,notnull"`
+	ReviewedBy     int32                           `sql:"reviewed_by"`
+	ReviewedOn     time.Time                       `sql:"reviewed_on"`
+	ReviewComment  string                          `sql:"review_comment"`
+	ExpiresOn      time.Time                       `sql:"expires_on,notnull"`
+	ExpiryNotified bool                            `sql:"expiry_notified,notnull"`
+	sql.AuditLog
+}
+
+// IsActive reports whether the exception currently suppresses the cve, an approved exception past its
+// expiry is inactive even before the expiry job has moved it to EXPIRED
+func (e *CveException) IsActive(now time.Time) bool {
+	return e.Status == securityBean.CveExceptionApproved && e.ExpiresOn.After(now)
+}
+
+// Matches checks the exception scope against a deployment target, zero values in the exception act as wildcards.
+// an image scope without tag or digest matches every tag and digest of that repository
+func (e *CveException) Matches(cveName string, appId, envId int, image string) bool {
+	if e.CveName != cveName {
+		return false
+	}
+	if e.AppId != 0 && e.AppId != appId {
+		return false
+	}
+	if e.EnvId != 0 && e.EnvId != envId {
+		return false
+	}
+	if len(e.Image) == 0 || e.Image == image {
+		return true
+	}
+	return strings.HasPrefix(image, e.Image+":") || strings.HasPrefix(image, e.Image+"@")
+}
+
+// RemoveExceptedCves drops the cves covered by an active exception for the given target
+func RemoveExceptedCves(cves []*CveStore, exceptions []*CveException, appId, envId int, image string) []*CveStore {
+	if len(exceptions) == 0 {
+		return cves
+	}
+	filtered := make([]*CveStore, 0, len(cves))
+	for _, cve := range cves {
+		if FindMatchingCveException(exceptions, cve.Name, appId, envId, image) == nil {
+			filtered = append(filtered, cve)
+		}
+	}
+	return filtered
+}
+
+// FindMatchingCveException returns the first exception which covers the cve for the given target
+func FindMatchingCveException(exceptions []*CveException, cveName string, appId, envId int, image string) *CveException {
+	for _, exception := range exceptions {
+		if exception.Matches(cveName, appId, envId, image) {
+			return exception
+		}
+	}
+	return nil
+}
+
+type CveExceptionFilter struct {
+	CveName string
+	AppId   int
+	EnvId   int
+	Status  securityBean.CveExceptionStatus
+}
+
+type CveExceptionRepository interface {
+	Save(exception *CveException) error
+	Update(exception *CveException) error
+	FindById(id int) (*CveException, error)
+	FindByFilter(filter *CveExceptionFilter) ([]*CveException, error)
+	// FindActiveByScope returns approved, unexpired exceptions which can apply to the app/env pair,
+	// i.e. the ones scoped to them and the ones left open on either dimension
+	FindActiveByScope(appId, envId int, now time.Time) ([]*CveException, error)
+	FindApprovedExpiringBefore(before time.Time) ([]*CveException, error)
+	FindApprovedExpired(now time.Time) ([]*CveException, error)
+}
+
+type CveExceptionRepositoryImpl struct {
+	dbConnection *pg.DB
+	logger       *zap.SugaredLogger
+}
+
+func NewCveExceptionRepositoryImpl(dbConnection *pg.DB, logger *zap.SugaredLogger) *CveExceptionRepositoryImpl {
+	return &CveExceptionRepositoryImpl{dbConnection: dbConnection, logger: logger}
+}
+
+func (impl *CveExceptionRepositoryImpl) Save(exception *CveException) error {
+	return impl.dbConnection.Insert(exception)
+}
+
+func (impl *CveExceptionRepositoryImpl) Update(exception *CveException) error {
+	return impl.dbConnection.Update(exception)
+}
+
+func (impl *CveExceptionRepositoryImpl) FindById(id int) (*CveException, error) {
+	exception := &CveException{}
+	err := impl.dbConnection.Model(exception).
+		Where("id = ?", id).
+		Select()
+	return exception, err
+}
+
+func (impl *CveExceptionRepositoryImpl) FindByFilter(filter *CveExceptionFilter) ([]*CveException, error) {
+	var exceptions []*CveException
+	query := impl.dbConnection.Model(&exceptions)
+	if len(filter.CveName) > 0 {
+		query = query.Where("cve_name = ?", filter.CveName)
+	}
+	if filter.AppId > 0 {
+		query = query.Where("app_id = ?", filter.AppId)
+	}
+	if filter.EnvId > 0 {
+		query = query.Where("env_id = ?", filter.EnvId)
+	}
+	if len(filter.Status) > 0 {
+		query = query.Where("status = ?", filter.Status)
+	}
+	err := query.Order("id DESC").Select()
+	return exceptions, err
+}
+
+func (impl *CveExceptionRepositoryImpl) FindActiveByScope(appId, envId int, now time.Time) ([]*CveException, error) {
+	var exceptions []*CveException
+	err := impl.dbConnection.Model(&exceptions).
+		Where("status = ?", securityBean.CveExceptionApproved).
+		Where("expires_on > ?", now).
+		WhereGroup(func(q *orm.Query) (*orm.Query, error) {
+			return q.WhereOr("app_id IS NULL").WhereOr("app_id = ?", appId), nil
+		}).
+		WhereGroup(func(q *orm.Query) (*orm.Query, error) {
+			return q.WhereOr("env_id IS NULL").WhereOr("env_id = ?", envId), nil
+		}).
+		Select()
+	return exceptions, err
+}
+
+func (impl *CveExceptionRepositoryImpl) FindApprovedExpiringBefore(before time.Time) ([]*CveException, error) {
+	var exceptions []*CveException
+	err := impl.dbConnection.Model(&exceptions).
+		Where("status = ?", securityBean.CveExceptionApproved).
+		Where("expiry_notified = false").
+		Where("expires_on <= ?", before).
+		Select()
+	return exceptions, err
+}
+
+func (impl *CveExceptionRepositoryImpl) FindApprovedExpired(now time.Time) ([]*CveException, error) {
+	var exceptions []*CveException
+	err := impl.dbConnection.Model(&exceptions).
+		Where("status = ?", securityBean.CveExceptionApproved).
+		Where("expires_on <= ?", now).
+		Select()
+	return exceptions, err
+}
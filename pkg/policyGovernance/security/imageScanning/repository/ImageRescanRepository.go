@@ -0,0 +1,73 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package repository
+
+import (
+	"time"
+
+	securityBean "github.com/devtron-labs/devtron/pkg/policyGovernance/security/imageScanning/repository/bean"
+	"github.com/devtron-labs/devtron/pkg/sql"
+	"github.com/go-pg/pg"
+	"go.uber.org/zap"
+)
+
+type ImageRescan struct {
+	tableName                  struct{}                       `sql:"image_rescan" pg:",discard_unknown_columns"`
+	Id                         int                            `sql:"id,pk"`
+	Image                      string                         `sql:"image,notnull"`
+	ImageDigest                string                         `sql:"image_digest"`
+	PreviousExecutionHistoryId int                            `sql:"previous_execution_history_id,notnull"`
+	RescanExecutionHistoryId   int                            `sql:"rescan_execution_history_id"`
+	PreviousCveNames           []string                       `sql:"previous_cve_names" pg:",array"`
+	NewCveNames                []string                       `sql:"new_cve_names" pg:",array"`
+	Status                     securityBean.ImageRescanStatus `sql:"status,notnull"`
+	TriggeredOn                time.Time                      `sql:"triggered_on,notnull"`
+	CompletedOn                time.Time                      `sql:"completed_on"`
+	sql.AuditLog
+}
+
+type ImageRescanRepository interface {
+	Save(model *ImageRescan) error
+	Update(model *ImageRescan) error
+	FindByStatus(status securityBean.ImageRescanStatus) ([]*ImageRescan, error)
+}
+
+type ImageRescanRepositoryImpl struct {
+	dbConnection *pg.DB
+	logger       *zap.SugaredLogger
+}
+
+func NewImageRescanRepositoryImpl(dbConnection *pg.DB, logger *zap.SugaredLogger) *ImageRescanRepositoryImpl {
+	return &ImageRescanRepositoryImpl{dbConnection: dbConnection, logger: logger}
+}
+
+func (impl *ImageRescanRepositoryImpl) Save(model *ImageRescan) error {
+	return impl.dbConnection.Insert(model)
+}
+
+func (impl *ImageRescanRepositoryImpl) Update(model *ImageRescan) error {
+	return impl.dbConnection.Update(model)
+}
+
+func (impl *ImageRescanRepositoryImpl) FindByStatus(status securityBean.ImageRescanStatus) ([]*ImageRescan, error) {
+	var models []*ImageRescan
+	err := impl.dbConnection.Model(&models).
+		Where("status = ?", status).
+		Order("id ASC").
+		Select()
+	return models, err
+}
@@ -137,3 +137,31 @@ const (
 	ScanStatusScanned    ScanStatusType = "scanned"
 	ScanStatusNotScanned ScanStatusType = "not-scanned"
 )
+
+// CveExceptionStatus is the lifecycle state of a cve exception, only Approved exceptions are enforced
+type CveExceptionStatus string
+
+const (
+	CveExceptionPending  CveExceptionStatus = "PENDING"
+	CveExceptionApproved CveExceptionStatus = "APPROVED"
+	CveExceptionRejected CveExceptionStatus = "REJECTED"
+	CveExceptionRevoked  CveExceptionStatus = "REVOKED"
+	CveExceptionExpired  CveExceptionStatus = "EXPIRED"
+)
+
+func (s CveExceptionStatus) IsValid() bool {
+	switch s {
+	case CveExceptionPending, CveExceptionApproved, CveExceptionRejected, CveExceptionRevoked, CveExceptionExpired:
+		return true
+	}
+	return false
+}
+
+type ImageRescanStatus string
+
+const (
+	ImageRescanPending   ImageRescanStatus = "PENDING"
+	ImageRescanCompleted ImageRescanStatus = "COMPLETED"
+	// ImageRescanFailed is set when the scan fails or the image scanner does not record a newer execution in time
+	ImageRescanFailed ImageRescanStatus = "FAILED"
+)
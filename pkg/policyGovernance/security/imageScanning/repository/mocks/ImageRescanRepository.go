@@ -0,0 +1,95 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	bean "github.com/devtron-labs/devtron/pkg/policyGovernance/security/imageScanning/repository/bean"
+	mock "github.com/stretchr/testify/mock"
+
+	repository "github.com/devtron-labs/devtron/pkg/policyGovernance/security/imageScanning/repository"
+)
+
+// ImageRescanRepository is an autogenerated mock type for the ImageRescanRepository type
+type ImageRescanRepository struct {
+	mock.Mock
+}
+
+// FindByStatus provides a mock function with given fields: status
+func (_m *ImageRescanRepository) FindByStatus(status bean.ImageRescanStatus) ([]*repository.ImageRescan, error) {
+	ret := _m.Called(status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByStatus")
+	}
+
+	var r0 []*repository.ImageRescan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(bean.ImageRescanStatus) ([]*repository.ImageRescan, error)); ok {
+		return rf(status)
+	}
+	if rf, ok := ret.Get(0).(func(bean.ImageRescanStatus) []*repository.ImageRescan); ok {
+		r0 = rf(status)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*repository.ImageRescan)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(bean.ImageRescanStatus) error); ok {
+		r1 = rf(status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Save provides a mock function with given fields: model
+func (_m *ImageRescanRepository) Save(model *repository.ImageRescan) error {
+	ret := _m.Called(model)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Save")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*repository.ImageRescan) error); ok {
+		r0 = rf(model)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Update provides a mock function with given fields: model
+func (_m *ImageRescanRepository) Update(model *repository.ImageRescan) error {
+	ret := _m.Called(model)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*repository.ImageRescan) error); ok {
+		r0 = rf(model)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewImageRescanRepository creates a new instance of ImageRescanRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewImageRescanRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ImageRescanRepository {
+	mock := &ImageRescanRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -14,6 +14,12 @@ var ImageScanningWireSet = wire.NewSet(
 	NewImageScanServiceImpl,
 	wire.Bind(new(ImageScanService), new(*ImageScanServiceImpl)),
 
+	NewCveExceptionServiceImpl,
+	wire.Bind(new(CveExceptionService), new(*CveExceptionServiceImpl)),
+
+	NewImageRescanServiceImpl,
+	wire.Bind(new(ImageRescanService), new(*ImageRescanServiceImpl)),
+
 	read.NewImageScanHistoryReadService,
 	wire.Bind(new(read.ImageScanHistoryReadService), new(*read.ImageScanHistoryReadServiceImpl)),
 
@@ -41,6 +47,10 @@ var ImageScanningWireSet = wire.NewSet(
 
 	repository.NewPolicyRepositoryImpl,
 	wire.Bind(new(repository.CvePolicyRepository), new(*repository.CvePolicyRepositoryImpl)),
+	repository.NewCveExceptionRepositoryImpl,
+	wire.Bind(new(repository.CveExceptionRepository), new(*repository.CveExceptionRepositoryImpl)),
+	repository.NewImageRescanRepositoryImpl,
+	wire.Bind(new(repository.ImageRescanRepository), new(*repository.ImageRescanRepositoryImpl)),
 	repository.NewScanToolExecutionHistoryMappingRepositoryImpl,
 	wire.Bind(new(repository.ScanToolExecutionHistoryMappingRepository), new(*repository.ScanToolExecutionHistoryMappingRepositoryImpl)),
 )
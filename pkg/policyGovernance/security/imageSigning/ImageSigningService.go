@@ -0,0 +1,748 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package imageSigning
+
+import (
+	"context"
+	"crypto"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"github.com/caarlos0/env"
+	"github.com/devtron-labs/common-lib/securestore"
+	repository2 "github.com/devtron-labs/devtron/internal/sql/repository"
+	dockerRegistryRepository "github.com/devtron-labs/devtron/internal/sql/repository/dockerRegistry"
+	"github.com/devtron-labs/devtron/internal/sql/repository/pipelineConfig"
+	"github.com/devtron-labs/devtron/internal/util"
+	"github.com/devtron-labs/devtron/pkg/build/pipeline/read"
+	"github.com/devtron-labs/devtron/pkg/cluster/environment/repository"
+	"github.com/devtron-labs/devtron/pkg/policyGovernance/security/imageSigning/bean"
+	"github.com/devtron-labs/devtron/pkg/policyGovernance/security/imageSigning/helper"
+	imageSigningRepository "github.com/devtron-labs/devtron/pkg/policyGovernance/security/imageSigning/repository"
+	"github.com/devtron-labs/devtron/pkg/sql"
+	"go.uber.org/zap"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// ImageSigningService manages the cosign keys images are signed with and the per environment signature policies
+// enforced before deployment
+type ImageSigningService interface {
+	GetSigningKeys() ([]*bean.SigningKeyDto, error)
+	SaveSigningKey(request *bean.SigningKeyDto) (*bean.SigningKeyDto, error)
+	DeleteSigningKey(id int, userId int32) error
+	GetPolicies() ([]*bean.SignaturePolicyDto, error)
+	GetPolicyById(id int) (*bean.SignaturePolicyDto, error)
+	SavePolicy(request *bean.SignaturePolicyDto) (*bean.SignaturePolicyDto, error)
+	DeletePolicy(id int, userId int32) error
+	// GetCiSigningConfig returns the keys ci runner signs the built image with, nil when no key signs builds
+	GetCiSigningConfig() (*bean.CiSigningConfig, error)
+	// RestoreCiSigningKeys fills the private keys and passwords of a persisted ci signing config back in,
+	// keys which no longer sign builds are dropped
+	RestoreCiSigningKeys(config *bean.CiSigningConfig) error
+	// IsSignatureRequired tells if a signature policy applies to the environment, images are then deployed by digest
+	IsSignatureRequired(envId int) (bool, error)
+	// SaveArtifactSignatures records the signatures ci runner created for the artifact
+	SaveArtifactSignatures(ciArtifactId int, signatures []*bean.ImageSignature, userId int32) error
+	// GetArtifactAppId returns the app the artifact was built for, 0 when it has none
+	GetArtifactAppId(ciArtifactId int) (int, error)
+	// VerifyArtifact verifies the registry signatures of the artifact against the policy of the environment, envId 0 skips the policy
+	VerifyArtifact(ctx context.Context, ciArtifactId int, envId int, userId int32) (*bean.SignatureVerificationResult, error)
+	// VerifyArtifactForDeployment returns an error when the artifact is not signed by every key the environment policy requires
+	VerifyArtifactForDeployment(ctx context.Context, artifact *repository2.CiArtifact, envId int, userId int32) error
+	// RecordArtifactSignatures verifies and records the registry signatures of an artifact, used for externally built images
+	RecordArtifactSignatures(ctx context.Context, artifact *repository2.CiArtifact, userId int32)
+}
+
+type ImageSigningServiceImpl struct {
+	logger                         *zap.SugaredLogger
+	config                         *bean.ImageSigningConfig
+	imageSigningKeyRepository      imageSigningRepository.ImageSigningKeyRepository
+	imageSignaturePolicyRepository imageSigningRepository.ImageSignaturePolicyRepository
+	ciArtifactSignatureRepository  imageSigningRepository.CiArtifactSignatureRepository
+	ciArtifactRepository           repository2.CiArtifactRepository
+	environmentRepository          repository.EnvironmentRepository
+	ciPipelineConfigReadService    read.CiPipelineConfigReadService
+	dockerArtifactStoreRepository  dockerRegistryRepository.DockerArtifactStoreRepository
+	ciPipelineRepository           pipelineConfig.CiPipelineRepository
+}
+
+func NewImageSigningServiceImpl(logger *zap.SugaredLogger,
+	imageSigningKeyRepository imageSigningRepository.ImageSigningKeyRepository,
+	imageSignaturePolicyRepository imageSigningRepository.ImageSignaturePolicyRepository,
+	ciArtifactSignatureRepository imageSigningRepository.CiArtifactSignatureRepository,
+	ciArtifactRepository repository2.CiArtifactRepository,
+	environmentRepository repository.EnvironmentRepository,
+	ciPipelineConfigReadService read.CiPipelineConfigReadService,
+	dockerArtifactStoreRepository dockerRegistryRepository.DockerArtifactStoreRepository,
+	ciPipelineRepository pipelineConfig.CiPipelineRepository) (*ImageSigningServiceImpl, error) {
+	config := &bean.ImageSigningConfig{}
+	err := env.Parse(config)
+	if err != nil {
+		logger.Errorw("error in parsing image signing config", "err", err)
+		return nil, err
+	}
+	return &ImageSigningServiceImpl{
+		logger:                         logger,
+		config:                         config,
+		imageSigningKeyRepository:      imageSigningKeyRepository,
+		imageSignaturePolicyRepository: imageSignaturePolicyRepository,
+		ciArtifactSignatureRepository:  ciArtifactSignatureRepository,
+		ciArtifactRepository:           ciArtifactRepository,
+		environmentRepository:          environmentRepository,
+		ciPipelineConfigReadService:    ciPipelineConfigReadService,
+		dockerArtifactStoreRepository:  dockerArtifactStoreRepository,
+		ciPipelineRepository:           ciPipelineRepository,
+	}, nil
+}
+
+func (impl *ImageSigningServiceImpl) GetSigningKeys() ([]*bean.SigningKeyDto, error) {
+	models, err := impl.imageSigningKeyRepository.FindAllActive()
+	if err != nil {
+		impl.logger.Errorw("error in fetching image signing keys", "err", err)
+		return nil, err
+	}
+	keys := make([]*bean.SigningKeyDto, 0, len(models))
+	for _, model := range models {
+		keys = append(keys, toSigningKeyDto(model))
+	}
+	return keys, nil
+}
+
+func (impl *ImageSigningServiceImpl) SaveSigningKey(request *bean.SigningKeyDto) (*bean.SigningKeyDto, error) {
+	request.Name = strings.TrimSpace(request.Name)
+	if _, err := helper.ParsePublicKey(request.PublicKey); err != nil {
+		errMsg := fmt.Sprintf("invalid public key: %s", err.Error())
+		return nil, util.NewApiError(http.StatusBadRequest, errMsg, errMsg)
+	}
+	if len(request.PrivateKey) > 0 {
+		if block, _ := pem.Decode([]byte(strings.TrimSpace(request.PrivateKey))); block == nil {
+			errMsg := "private key is not PEM encoded"
+			return nil, util.NewApiError(http.StatusBadRequest, errMsg, errMsg)
+		}
+	}
+	existing, err := impl.imageSigningKeyRepository.FindActiveByName(request.Name)
+	if err != nil && !util.IsErrNoRows(err) {
+		impl.logger.Errorw("error in fetching image signing key by name", "name", request.Name, "err", err)
+		return nil, err
+	}
+	if existing != nil && existing.Id > 0 && existing.Id != request.Id {
+		errMsg := fmt.Sprintf("signing key %s already exists", request.Name)
+		return nil, util.NewApiError(http.StatusConflict, errMsg, errMsg)
+	}
+	var model *imageSigningRepository.ImageSigningKey
+	if request.Id > 0 {
+		model, err = impl.imageSigningKeyRepository.FindActiveById(request.Id)
+		if util.IsErrNoRows(err) {
+			errMsg := fmt.Sprintf("signing key %d not found", request.Id)
+			return nil, util.NewApiError(http.StatusNotFound, errMsg, errMsg)
+		} else if err != nil {
+			impl.logger.Errorw("error in fetching image signing key", "id", request.Id, "err", err)
+			return nil, err
+		}
+	} else {
+		model = &imageSigningRepository.ImageSigningKey{
+			Active:   true,
+			AuditLog: sql.NewDefaultAuditLog(request.UserId),
+		}
+	}
+	model.Name = request.Name
+	model.Description = request.Description
+	model.PublicKey = strings.TrimSpace(request.PublicKey)
+	model.SignBuilds = request.SignBuilds
+	// the private key and password are never returned, an update without them keeps the stored ones
+	if len(request.PrivateKey) > 0 {
+		model.PrivateKey = securestore.ToEncryptedString(strings.TrimSpace(request.PrivateKey))
+		model.Password = securestore.ToEncryptedString(request.Password)
+	}
+	if model.SignBuilds && len(model.PrivateKey.String()) == 0 {
+		errMsg := "private key is required to sign builds with the key"
+		return nil, util.NewApiError(http.StatusBadRequest, errMsg, errMsg)
+	}
+	model.UpdateAuditLog(request.UserId)
+	if model.Id > 0 {
+		err = impl.imageSigningKeyRepository.Update(model)
+	} else {
+		err = impl.imageSigningKeyRepository.Save(model)
+	}
+	if err != nil {
+		impl.logger.Errorw("error in saving image signing key", "name", request.Name, "err", err)
+		return nil, err
+	}
+	return toSigningKeyDto(model), nil
+}
+
+func (impl *ImageSigningServiceImpl) DeleteSigningKey(id int, userId int32) error {
+	model, err := impl.imageSigningKeyRepository.FindActiveById(id)
+	if util.IsErrNoRows(err) {
+		errMsg := fmt.Sprintf("signing key %d not found", id)
+		return util.NewApiError(http.StatusNotFound, errMsg, errMsg)
+	} else if err != nil {
+		impl.logger.Errorw("error in fetching image signing key", "id", id, "err", err)
+		return err
+	}
+	policyCount, err := impl.imageSignaturePolicyRepository.CountBySigningKeyId(id)
+	if err != nil {
+		impl.logger.Errorw("error in fetching signature policies of key", "id", id, "err", err)
+		return err
+	}
+	if policyCount > 0 {
+		errMsg := fmt.Sprintf("signing key %s is required by %d signature policies, delete them before deleting the key", model.Name, policyCount)
+		return util.NewApiError(http.StatusConflict, errMsg, errMsg)
+	}
+	model.Active = false
+	model.UpdateAuditLog(userId)
+	err = impl.imageSigningKeyRepository.Update(model)
+	if err != nil {
+		impl.logger.Errorw("error in deleting image signing key", "id", id, "err", err)
+		return err
+	}
+	return nil
+}
+
+func (impl *ImageSigningServiceImpl) GetPolicies() ([]*bean.SignaturePolicyDto, error) {
+	models, err := impl.imageSignaturePolicyRepository.FindAll()
+	if err != nil {
+		impl.logger.Errorw("error in fetching image signature policies", "err", err)
+		return nil, err
+	}
+	keyNames, err := impl.getSigningKeyNames()
+	if err != nil {
+		return nil, err
+	}
+	policies := make([]*bean.SignaturePolicyDto, 0, len(models))
+	for _, model := range models {
+		policy := toSignaturePolicyDto(model)
+		policy.SigningKeyName = keyNames[model.SigningKeyId]
+		policies = append(policies, policy)
+	}
+	return policies, nil
+}
+
+func (impl *ImageSigningServiceImpl) GetPolicyById(id int) (*bean.SignaturePolicyDto, error) {
+	model, err := impl.imageSignaturePolicyRepository.FindById(id)
+	if util.IsErrNoRows(err) {
+		errMsg := fmt.Sprintf("signature policy %d not found", id)
+		return nil, util.NewApiError(http.StatusNotFound, errMsg, errMsg)
+	} else if err != nil {
+		impl.logger.Errorw("error in fetching image signature policy", "id", id, "err", err)
+		return nil, err
+	}
+	return toSignaturePolicyDto(model), nil
+}
+
+func (impl *ImageSigningServiceImpl) SavePolicy(request *bean.SignaturePolicyDto) (*bean.SignaturePolicyDto, error) {
+	model := &imageSigningRepository.ImageSignaturePolicy{
+		SigningKeyId: request.SigningKeyId,
+		AuditLog:     sql.NewDefaultAuditLog(request.UserId),
+	}
+	switch request.Level {
+	case bean.PolicyLevelGlobal:
+		model.Global = true
+	case bean.PolicyLevelCluster:
+		if request.ClusterId == 0 {
+			errMsg := "clusterId is required for a cluster level policy"
+			return nil, util.NewApiError(http.StatusBadRequest, errMsg, errMsg)
+		}
+		model.ClusterId = request.ClusterId
+	case bean.PolicyLevelEnvironment:
+		_, err := impl.environmentRepository.FindById(request.EnvId)
+		if util.IsErrNoRows(err) {
+			errMsg := fmt.Sprintf("environment %d not found", request.EnvId)
+			return nil, util.NewApiError(http.StatusBadRequest, errMsg, errMsg)
+		} else if err != nil {
+			impl.logger.Errorw("error in fetching environment", "envId", request.EnvId, "err", err)
+			return nil, err
+		}
+		model.EnvironmentId = request.EnvId
+	}
+	key, err := impl.imageSigningKeyRepository.FindActiveById(request.SigningKeyId)
+	if util.IsErrNoRows(err) {
+		errMsg := fmt.Sprintf("signing key %d not found", request.SigningKeyId)
+		return nil, util.NewApiError(http.StatusBadRequest, errMsg, errMsg)
+	} else if err != nil {
+		impl.logger.Errorw("error in fetching image signing key", "id", request.SigningKeyId, "err", err)
+		return nil, err
+	}
+	scopePolicies, err := impl.imageSignaturePolicyRepository.FindByScope(model.Global, model.ClusterId, model.EnvironmentId)
+	if err != nil {
+		impl.logger.Errorw("error in fetching signature policies of scope", "request", request, "err", err)
+		return nil, err
+	}
+	for _, scopePolicy := range scopePolicies {
+		if scopePolicy.SigningKeyId == request.SigningKeyId {
+			errMsg := fmt.Sprintf("signature of key %s is already required at this level", key.Name)
+			return nil, util.NewApiError(http.StatusConflict, errMsg, errMsg)
+		}
+	}
+	err = impl.imageSignaturePolicyRepository.Save(model)
+	if err != nil {
+		impl.logger.Errorw("error in saving image signature policy", "request", request, "err", err)
+		return nil, err
+	}
+	policy := toSignaturePolicyDto(model)
+	policy.SigningKeyName = key.Name
+	return policy, nil
+}
+
+func (impl *ImageSigningServiceImpl) DeletePolicy(id int, userId int32) error {
+	err := impl.imageSignaturePolicyRepository.MarkDeleted(id, userId)
+	if err != nil {
+		impl.logger.Errorw("error in deleting image signature policy", "id", id, "err", err)
+		return err
+	}
+	return nil
+}
+
+func (impl *ImageSigningServiceImpl) GetCiSigningConfig() (*bean.CiSigningConfig, error) {
+	models, err := impl.imageSigningKeyRepository.FindAllActiveSigningBuilds()
+	if err != nil {
+		impl.logger.Errorw("error in fetching image signing keys of builds", "err", err)
+		return nil, err
+	}
+	if len(models) == 0 {
+		return nil, nil
+	}
+	config := &bean.CiSigningConfig{Keys: make([]*bean.CiSigningKey, 0, len(models))}
+	for _, model := range models {
+		config.Keys = append(config.Keys, &bean.CiSigningKey{
+			Id:         model.Id,
+			Name:       model.Name,
+			PrivateKey: model.PrivateKey.String(),
+			Password:   model.Password.String(),
+		})
+	}
+	return config, nil
+}
+
+func (impl *ImageSigningServiceImpl) RestoreCiSigningKeys(config *bean.CiSigningConfig) error {
+	if config == nil || len(config.Keys) == 0 {
+		return nil
+	}
+	models, err := impl.imageSigningKeyRepository.FindAllActiveSigningBuilds()
+	if err != nil {
+		impl.logger.Errorw("error in fetching image signing keys of builds", "err", err)
+		return err
+	}
+	modelById := make(map[int]*imageSigningRepository.ImageSigningKey, len(models))
+	for _, model := range models {
+		modelById[model.Id] = model
+	}
+	keys := make([]*bean.CiSigningKey, 0, len(config.Keys))
+	for _, key := range config.Keys {
+		model, ok := modelById[key.Id]
+		if !ok {
+			impl.logger.Warnw("signing key no longer signs builds, skipping it", "signingKeyId", key.Id, "name", key.Name)
+			continue
+		}
+		key.PrivateKey = model.PrivateKey.String()
+		key.Password = model.Password.String()
+		keys = append(keys, key)
+	}
+	config.Keys = keys
+	return nil
+}
+
+func (impl *ImageSigningServiceImpl) IsSignatureRequired(envId int) (bool, error) {
+	requiredKeyIds, _, err := impl.getRequiredKeyIds(envId)
+	if err != nil {
+		return false, err
+	}
+	return len(requiredKeyIds) > 0, nil
+}
+
+func (impl *ImageSigningServiceImpl) SaveArtifactSignatures(ciArtifactId int, signatures []*bean.ImageSignature, userId int32) error {
+	for _, signature := range signatures {
+		if len(signature.ImageDigest) == 0 {
+			impl.logger.Warnw("skipping image signature without digest", "ciArtifactId", ciArtifactId, "signingKeyId", signature.SigningKeyId)
+			continue
+		}
+		model := &imageSigningRepository.CiArtifactSignature{
+			CiArtifactId: ciArtifactId,
+			SigningKeyId: signature.SigningKeyId,
+			ImageDigest:  signature.ImageDigest,
+			Source:       string(bean.SignatureSourceCi),
+			AuditLog:     sql.NewDefaultAuditLog(userId),
+		}
+		err := impl.ciArtifactSignatureRepository.Upsert(model)
+		if err != nil {
+			impl.logger.Errorw("error in saving artifact signature", "ciArtifactId", ciArtifactId, "signingKeyId", signature.SigningKeyId, "err", err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (impl *ImageSigningServiceImpl) VerifyArtifact(ctx context.Context, ciArtifactId int, envId int, userId int32) (*bean.SignatureVerificationResult, error) {
+	artifact, err := impl.ciArtifactRepository.Get(ciArtifactId)
+	if util.IsErrNoRows(err) {
+		errMsg := fmt.Sprintf("artifact %d not found", ciArtifactId)
+		return nil, util.NewApiError(http.StatusNotFound, errMsg, errMsg)
+	} else if err != nil {
+		impl.logger.Errorw("error in fetching ci artifact", "ciArtifactId", ciArtifactId, "err", err)
+		return nil, err
+	}
+	var requiredKeyIds []int
+	var policyLevel bean.PolicyLevel
+	if envId > 0 {
+		requiredKeyIds, policyLevel, err = impl.getRequiredKeyIds(envId)
+		if err != nil {
+			return nil, err
+		}
+	}
+	result, err := impl.verifyArtifact(ctx, artifact, requiredKeyIds, userId)
+	if err != nil {
+		return nil, err
+	}
+	result.EnvId = envId
+	result.PolicyLevel = policyLevel
+	result.AppId, err = impl.getArtifactAppId(artifact)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (impl *ImageSigningServiceImpl) VerifyArtifactForDeployment(ctx context.Context, artifact *repository2.CiArtifact, envId int, userId int32) error {
+	requiredKeyIds, _, err := impl.getRequiredKeyIds(envId)
+	if err != nil {
+		return err
+	}
+	if len(requiredKeyIds) == 0 {
+		return nil
+	}
+	result, err := impl.verifyArtifact(ctx, artifact, requiredKeyIds, userId)
+	if err != nil {
+		return err
+	}
+	if result.Allowed {
+		return nil
+	}
+	errMsg := fmt.Sprintf("%s for image %s", bean.SignatureVerificationFailedMessage, artifact.Image)
+	if len(result.VerificationErr) > 0 {
+		errMsg = fmt.Sprintf("%s, %s", errMsg, result.VerificationErr)
+	} else {
+		errMsg = fmt.Sprintf("%s, signature of key(s) %s not found", errMsg, strings.Join(result.MissingKeys, ", "))
+	}
+	return util.NewApiError(http.StatusPreconditionFailed, errMsg, errMsg)
+}
+
+func (impl *ImageSigningServiceImpl) RecordArtifactSignatures(ctx context.Context, artifact *repository2.CiArtifact, userId int32) {
+	result, err := impl.verifyArtifact(ctx, artifact, nil, userId)
+	if err != nil {
+		impl.logger.Errorw("error in recording artifact signatures", "ciArtifactId", artifact.Id, "err", err)
+		return
+	}
+	if len(result.VerificationErr) > 0 {
+		impl.logger.Warnw("unable to verify artifact signatures", "ciArtifactId", artifact.Id, "image", artifact.Image, "err", result.VerificationErr)
+	}
+}
+
+// getRequiredKeyIds returns the keys of the most specific policy level defined for the environment, environment over cluster over global
+func (impl *ImageSigningServiceImpl) getRequiredKeyIds(envId int) ([]int, bean.PolicyLevel, error) {
+	policies, err := impl.imageSignaturePolicyRepository.FindApplicable(envId)
+	if err != nil {
+		impl.logger.Errorw("error in fetching applicable signature policies", "envId", envId, "err", err)
+		return nil, "", err
+	}
+	levelKeyIds := make(map[bean.PolicyLevel][]int)
+	for _, policy := range policies {
+		level := getPolicyLevel(policy)
+		levelKeyIds[level] = append(levelKeyIds[level], policy.SigningKeyId)
+	}
+	for _, level := range []bean.PolicyLevel{bean.PolicyLevelEnvironment, bean.PolicyLevelCluster, bean.PolicyLevelGlobal} {
+		if keyIds := levelKeyIds[level]; len(keyIds) > 0 {
+			return keyIds, level, nil
+		}
+	}
+	return nil, "", nil
+}
+
+func (impl *ImageSigningServiceImpl) verifyArtifact(ctx context.Context, artifact *repository2.CiArtifact, requiredKeyIds []int, userId int32) (*bean.SignatureVerificationResult, error) {
+	result := &bean.SignatureVerificationResult{
+		CiArtifactId: artifact.Id,
+		Image:        artifact.Image,
+		ImageDigest:  artifact.ImageDigest,
+		Allowed:      true,
+		RequiredKeys: make([]string, 0, len(requiredKeyIds)),
+		MissingKeys:  make([]string, 0),
+	}
+	keys, err := impl.imageSigningKeyRepository.FindAllActive()
+	if err != nil {
+		impl.logger.Errorw("error in fetching image signing keys", "err", err)
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(impl.config.VerificationTimeoutSecs)*time.Second)
+	defer cancel()
+	var signatures []*helper.CosignSignature
+	var fetchErr error
+	if len(artifact.ImageDigest) == 0 {
+		fetchErr = errors.New(bean.ImageDigestNotFoundMessage)
+	} else {
+		signatures, fetchErr = impl.fetchSignatures(ctx, artifact)
+	}
+	verifiedKeyIds := make(map[int]bool)
+	if fetchErr != nil {
+		impl.logger.Errorw("error in fetching image signatures from registry", "ciArtifactId", artifact.Id, "image", artifact.Image, "err", fetchErr)
+		result.VerificationErr = fetchErr.Error()
+	} else {
+		for _, key := range keys {
+			publicKey, err := helper.ParsePublicKey(key.PublicKey)
+			if err != nil {
+				impl.logger.Warnw("skipping signing key with invalid public key", "signingKeyId", key.Id, "err", err)
+				continue
+			}
+			verifiedKeyIds[key.Id] = isSignedBy(publicKey, signatures, result.ImageDigest)
+		}
+		err = impl.recordVerification(artifact.Id, result.ImageDigest, verifiedKeyIds, userId)
+		if err != nil {
+			return nil, err
+		}
+	}
+	keyNames := make(map[int]string, len(keys))
+	for _, key := range keys {
+		keyNames[key.Id] = key.Name
+	}
+	for _, requiredKeyId := range requiredKeyIds {
+		result.RequiredKeys = append(result.RequiredKeys, keyNames[requiredKeyId])
+		if !verifiedKeyIds[requiredKeyId] {
+			result.MissingKeys = append(result.MissingKeys, keyNames[requiredKeyId])
+		}
+	}
+	if len(artifact.ImageDigest) == 0 {
+		// nothing can be pinned for deployment without a digest, registry fail open does not apply
+		result.Allowed = len(requiredKeyIds) == 0
+	} else if fetchErr != nil {
+		result.Allowed = len(requiredKeyIds) == 0 || impl.config.FailOpenOnRegistryError
+	} else {
+		result.Allowed = len(result.MissingKeys) == 0
+	}
+	result.Signatures, err = impl.getArtifactSignatures(artifact.Id, keyNames)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// fetchSignatures returns the cosign signatures of the artifact digest, the digest is never resolved from the tag
+// as the tag can be moved to another image after verification
+func (impl *ImageSigningServiceImpl) fetchSignatures(ctx context.Context, artifact *repository2.CiArtifact) ([]*helper.CosignSignature, error) {
+	registryHost, repositoryName, _, err := helper.ParseImage(artifact.Image)
+	if err != nil {
+		return nil, err
+	}
+	dockerArtifactStore, err := impl.getDockerArtifactStore(artifact, registryHost)
+	if err != nil {
+		return nil, err
+	}
+	repo, err := helper.NewRemoteRepository(registryHost, repositoryName, dockerArtifactStore)
+	if err != nil {
+		return nil, err
+	}
+	signatures, err := helper.FetchCosignSignatures(ctx, repo, artifact.ImageDigest)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch image signatures: %w", err)
+	}
+	return signatures, nil
+}
+
+// getDockerArtifactStore returns the registry credentials of the artifact, the container registry of the ci pipeline for devtron builds
+// and the registry matching the image host for external images, nil for anonymous access
+func (impl *ImageSigningServiceImpl) getDockerArtifactStore(artifact *repository2.CiArtifact, registryHost string) (*dockerRegistryRepository.DockerArtifactStore, error) {
+	var dockerRegistryId string
+	if artifact.PipelineId > 0 {
+		registryId, err := impl.ciPipelineConfigReadService.GetDockerRegistryIdForCiPipeline(artifact.PipelineId, artifact)
+		if err != nil && !util.IsErrNoRows(err) {
+			impl.logger.Errorw("error in fetching docker registry of ci pipeline", "ciPipelineId", artifact.PipelineId, "err", err)
+			return nil, err
+		}
+		if registryId != nil {
+			dockerRegistryId = *registryId
+		}
+	}
+	if len(dockerRegistryId) == 0 {
+		stores, err := impl.dockerArtifactStoreRepository.FindAllActiveForAutocomplete()
+		if err != nil {
+			impl.logger.Errorw("error in fetching container registries", "err", err)
+			return nil, err
+		}
+		for _, store := range stores {
+			if helper.NormaliseRegistryHost(store.RegistryURL) == registryHost {
+				dockerRegistryId = store.Id
+				break
+			}
+		}
+	}
+	if len(dockerRegistryId) == 0 {
+		return nil, nil
+	}
+	dockerArtifactStore, err := impl.dockerArtifactStoreRepository.FindOne(dockerRegistryId)
+	if util.IsErrNoRows(err) {
+		return nil, nil
+	} else if err != nil {
+		impl.logger.Errorw("error in fetching container registry", "dockerRegistryId", dockerRegistryId, "err", err)
+		return nil, err
+	}
+	return dockerArtifactStore, nil
+}
+
+// recordVerification saves the keys that signed the artifact and clears earlier records of keys that no longer verify
+func (impl *ImageSigningServiceImpl) recordVerification(ciArtifactId int, imageDigest string, verifiedKeyIds map[int]bool, userId int32) error {
+	existing, err := impl.ciArtifactSignatureRepository.FindByCiArtifactId(ciArtifactId)
+	if err != nil {
+		impl.logger.Errorw("error in fetching artifact signatures", "ciArtifactId", ciArtifactId, "err", err)
+		return err
+	}
+	existingSources := make(map[int]string, len(existing))
+	for _, signature := range existing {
+		existingSources[signature.SigningKeyId] = signature.Source
+	}
+	now := time.Now()
+	for keyId, verified := range verifiedKeyIds {
+		source, recorded := existingSources[keyId]
+		if !verified && !recorded {
+			continue
+		}
+		if !recorded {
+			source = string(bean.SignatureSourceRegistry)
+		}
+		model := &imageSigningRepository.CiArtifactSignature{
+			CiArtifactId: ciArtifactId,
+			SigningKeyId: keyId,
+			ImageDigest:  imageDigest,
+			Source:       source,
+			Verified:     verified,
+			VerifiedOn:   now,
+			AuditLog:     sql.NewDefaultAuditLog(userId),
+		}
+		err = impl.ciArtifactSignatureRepository.Upsert(model)
+		if err != nil {
+			impl.logger.Errorw("error in saving artifact signature verification", "ciArtifactId", ciArtifactId, "signingKeyId", keyId, "err", err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (impl *ImageSigningServiceImpl) getArtifactSignatures(ciArtifactId int, keyNames map[int]string) ([]*bean.ArtifactSignatureDto, error) {
+	models, err := impl.ciArtifactSignatureRepository.FindByCiArtifactId(ciArtifactId)
+	if err != nil {
+		impl.logger.Errorw("error in fetching artifact signatures", "ciArtifactId", ciArtifactId, "err", err)
+		return nil, err
+	}
+	signatures := make([]*bean.ArtifactSignatureDto, 0, len(models))
+	for _, model := range models {
+		signature := &bean.ArtifactSignatureDto{
+			SigningKeyId:   model.SigningKeyId,
+			SigningKeyName: keyNames[model.SigningKeyId],
+			ImageDigest:    model.ImageDigest,
+			Source:         bean.SignatureSource(model.Source),
+			Verified:       model.Verified,
+		}
+		if !model.VerifiedOn.IsZero() {
+			verifiedOn := model.VerifiedOn
+			signature.VerifiedOn = &verifiedOn
+		}
+		signatures = append(signatures, signature)
+	}
+	return signatures, nil
+}
+
+func (impl *ImageSigningServiceImpl) GetArtifactAppId(ciArtifactId int) (int, error) {
+	artifact, err := impl.ciArtifactRepository.Get(ciArtifactId)
+	if util.IsErrNoRows(err) {
+		errMsg := fmt.Sprintf("artifact %d not found", ciArtifactId)
+		return 0, util.NewApiError(http.StatusNotFound, errMsg, errMsg)
+	} else if err != nil {
+		impl.logger.Errorw("error in fetching ci artifact", "ciArtifactId", ciArtifactId, "err", err)
+		return 0, err
+	}
+	return impl.getArtifactAppId(artifact)
+}
+
+// getArtifactAppId returns the app of the ci or external ci pipeline the artifact was created by
+func (impl *ImageSigningServiceImpl) getArtifactAppId(artifact *repository2.CiArtifact) (int, error) {
+	if artifact.PipelineId > 0 {
+		ciPipeline, err := impl.ciPipelineRepository.FindOneWithMinData(artifact.PipelineId)
+		if err != nil {
+			impl.logger.Errorw("error in fetching ci pipeline of artifact", "ciArtifactId", artifact.Id, "ciPipelineId", artifact.PipelineId, "err", err)
+			return 0, err
+		}
+		return ciPipeline.AppId, nil
+	} else if artifact.ExternalCiPipelineId > 0 {
+		externalCiPipeline, err := impl.ciPipelineRepository.FindExternalCiById(artifact.ExternalCiPipelineId)
+		if err != nil {
+			impl.logger.Errorw("error in fetching external ci pipeline of artifact", "ciArtifactId", artifact.Id, "externalCiPipelineId", artifact.ExternalCiPipelineId, "err", err)
+			return 0, err
+		}
+		return externalCiPipeline.AppId, nil
+	}
+	return 0, nil
+}
+
+func (impl *ImageSigningServiceImpl) getSigningKeyNames() (map[int]string, error) {
+	keys, err := impl.imageSigningKeyRepository.FindAllActive()
+	if err != nil {
+		impl.logger.Errorw("error in fetching image signing keys", "err", err)
+		return nil, err
+	}
+	keyNames := make(map[int]string, len(keys))
+	for _, key := range keys {
+		keyNames[key.Id] = key.Name
+	}
+	return keyNames, nil
+}
+
+func isSignedBy(publicKey crypto.PublicKey, signatures []*helper.CosignSignature, imageDigest string) bool {
+	for _, signature := range signatures {
+		if helper.VerifySignature(publicKey, signature, imageDigest) == nil {
+			return true
+		}
+	}
+	return false
+}
+
+func getPolicyLevel(policy *imageSigningRepository.ImageSignaturePolicy) bean.PolicyLevel {
+	if policy.Global {
+		return bean.PolicyLevelGlobal
+	} else if policy.EnvironmentId > 0 {
+		return bean.PolicyLevelEnvironment
+	}
+	return bean.PolicyLevelCluster
+}
+
+func toSigningKeyDto(model *imageSigningRepository.ImageSigningKey) *bean.SigningKeyDto {
+	return &bean.SigningKeyDto{
+		Id:            model.Id,
+		Name:          model.Name,
+		Description:   model.Description,
+		PublicKey:     model.PublicKey,
+		SignBuilds:    model.SignBuilds,
+		HasPrivateKey: len(model.PrivateKey.String()) > 0,
+	}
+}
+
+func toSignaturePolicyDto(model *imageSigningRepository.ImageSignaturePolicy) *bean.SignaturePolicyDto {
+	return &bean.SignaturePolicyDto{
+		Id:           model.Id,
+		Level:        getPolicyLevel(model),
+		ClusterId:    model.ClusterId,
+		EnvId:        model.EnvironmentId,
+		SigningKeyId: model.SigningKeyId,
+	}
+}
@@ -0,0 +1,124 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package bean
+
+import "time"
+
+type ImageSigningConfig struct {
+	VerificationTimeoutSecs int `env:"IMAGE_SIGNATURE_VERIFICATION_TIMEOUT_SECS" envDefault:"30" description:"Timeout of fetching image signatures from the container registry during verification" deprecated:"false"`
+	// FailOpenOnRegistryError allows the deployment when the signature could not be fetched because of a registry error
+	FailOpenOnRegistryError bool `env:"IMAGE_SIGNATURE_FAIL_OPEN_ON_REGISTRY_ERROR" envDefault:"false" description:"Allow deployments when the signatures of the image could not be fetched due to a registry error" deprecated:"false"`
+}
+
+type SignatureSource string
+
+const (
+	// SignatureSourceCi is a signature created by ci runner after the image was pushed
+	SignatureSourceCi SignatureSource = "DEVTRON_CI"
+	// SignatureSourceRegistry is a signature found in the container registry during verification, e.g. of an externally built image
+	SignatureSourceRegistry SignatureSource = "REGISTRY"
+)
+
+type PolicyLevel string
+
+const (
+	PolicyLevelGlobal      PolicyLevel = "GLOBAL"
+	PolicyLevelCluster     PolicyLevel = "CLUSTER"
+	PolicyLevelEnvironment PolicyLevel = "ENVIRONMENT"
+)
+
+// cosign signature layout in the registry, the signature of image digest sha256:<hex> is pushed as tag sha256-<hex>.sig
+const (
+	CosignSignatureTagSuffix        = ".sig"
+	CosignSignatureAnnotation       = "dev.cosignproject.cosign/signature"
+	CosignSimpleSigningMediaType    = "application/vnd.dev.cosign.simplesigning.v1+json"
+	CosignDockerManifestDigestField = "docker-manifest-digest"
+)
+
+const (
+	SignatureVerificationFailedMessage = "image signature verification failed"
+	ImageDigestNotFoundMessage         = "image digest not found, signatures are only verified against the image digest"
+)
+
+type SigningKeyDto struct {
+	Id          int    `json:"id"`
+	Name        string `json:"name" validate:"required,max=250"`
+	Description string `json:"description,omitempty"`
+	// PublicKey is the PEM encoded cosign public key
+	PublicKey string `json:"publicKey" validate:"required"`
+	// PrivateKey is the cosign encrypted private key, it is never returned by the apis
+	PrivateKey string `json:"privateKey,omitempty"`
+	Password   string `json:"password,omitempty"`
+	// SignBuilds signs every image built in devtron ci with this key, requires the private key
+	SignBuilds    bool  `json:"signBuilds"`
+	HasPrivateKey bool  `json:"hasPrivateKey"`
+	UserId        int32 `json:"-"`
+}
+
+type SignaturePolicyDto struct {
+	Id           int         `json:"id"`
+	Level        PolicyLevel `json:"level" validate:"oneof=GLOBAL CLUSTER ENVIRONMENT"`
+	ClusterId    int         `json:"clusterId,omitempty"`
+	EnvId        int         `json:"envId,omitempty"`
+	SigningKeyId int         `json:"signingKeyId" validate:"required"`
+	// SigningKeyName is filled in responses
+	SigningKeyName string `json:"signingKeyName,omitempty"`
+	UserId         int32  `json:"-"`
+}
+
+// CiSigningConfig tells ci runner to sign the digest of the pushed image with cosign using each of the keys,
+// private keys and passwords are cleared before the workflow request is persisted and restored on dispatch
+type CiSigningConfig struct {
+	Keys []*CiSigningKey `json:"keys"`
+}
+
+type CiSigningKey struct {
+	Id         int    `json:"id"`
+	Name       string `json:"name"`
+	PrivateKey string `json:"privateKey"`
+	Password   string `json:"password"`
+}
+
+// ImageSignature is reported by ci runner in the ci complete event for every key the image was signed with
+type ImageSignature struct {
+	SigningKeyId int    `json:"signingKeyId"`
+	ImageDigest  string `json:"imageDigest"`
+}
+
+type ArtifactSignatureDto struct {
+	SigningKeyId   int             `json:"signingKeyId"`
+	SigningKeyName string          `json:"signingKeyName"`
+	ImageDigest    string          `json:"imageDigest"`
+	Source         SignatureSource `json:"source"`
+	Verified       bool            `json:"verified"`
+	VerifiedOn     *time.Time      `json:"verifiedOn,omitempty"`
+}
+
+type SignatureVerificationResult struct {
+	CiArtifactId int    `json:"ciArtifactId"`
+	AppId        int    `json:"appId"`
+	Image        string `json:"image"`
+	ImageDigest  string `json:"imageDigest"`
+	EnvId        int    `json:"envId,omitempty"`
+	// Allowed is false when a key required by the applicable policy has not signed the image
+	Allowed         bool                    `json:"allowed"`
+	PolicyLevel     PolicyLevel             `json:"policyLevel,omitempty"`
+	RequiredKeys    []string                `json:"requiredKeys"`
+	MissingKeys     []string                `json:"missingKeys"`
+	Signatures      []*ArtifactSignatureDto `json:"signatures"`
+	VerificationErr string                  `json:"verificationError,omitempty"`
+}
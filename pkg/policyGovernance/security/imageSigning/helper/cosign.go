@@ -0,0 +1,203 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package helper
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"github.com/devtron-labs/devtron/pkg/policyGovernance/security/imageSigning/bean"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"io"
+	"oras.land/oras-go/v2/errdef"
+	"oras.land/oras-go/v2/registry/remote"
+	"strings"
+)
+
+const defaultDockerRegistry = "docker.io"
+
+// maxSignatureManifestSize caps the signature manifest and payloads read from the registry
+const maxSignatureManifestSize = 4 * 1024 * 1024
+
+// CosignSignature is one signature layer of the cosign signature manifest of an image
+type CosignSignature struct {
+	Payload   []byte
+	Signature string
+}
+
+type simpleSigningPayload struct {
+	Critical struct {
+		Image map[string]string `json:"image"`
+		Type  string            `json:"type"`
+	} `json:"critical"`
+}
+
+// ParseImage splits an image reference into registry host, repository and the tag or digest, latest when the image has neither
+func ParseImage(image string) (registryHost, repository, reference string, err error) {
+	name, reference := image, "latest"
+	if idx := strings.Index(name, "@"); idx >= 0 {
+		name, reference = name[:idx], name[idx+1:]
+	} else if idx := strings.LastIndex(name, ":"); idx >= 0 && !strings.Contains(name[idx:], "/") {
+		name, reference = name[:idx], name[idx+1:]
+	}
+	if len(name) == 0 || len(reference) == 0 {
+		return "", "", "", fmt.Errorf("invalid image %q", image)
+	}
+	parts := strings.SplitN(name, "/", 2)
+	if len(parts) == 1 || !(strings.ContainsAny(parts[0], ".:") || parts[0] == "localhost") {
+		// images of docker hub can skip the registry host and the library namespace
+		repository = name
+		if !strings.Contains(repository, "/") {
+			repository = "library/" + repository
+		}
+		return defaultDockerRegistry, repository, reference, nil
+	}
+	return parts[0], parts[1], reference, nil
+}
+
+// NormaliseRegistryHost strips the scheme and trailing path separators of a registry url so it can be matched with an image host
+func NormaliseRegistryHost(registryUrl string) string {
+	host := strings.TrimPrefix(strings.TrimPrefix(registryUrl, "https://"), "http://")
+	host = strings.TrimSuffix(host, "/")
+	if idx := strings.Index(host, "/"); idx >= 0 {
+		host = host[:idx]
+	}
+	if host == "index.docker.io" || host == "registry-1.docker.io" {
+		return defaultDockerRegistry
+	}
+	return host
+}
+
+// SignatureTag returns the tag cosign pushes the signatures of an image digest to
+func SignatureTag(imageDigest string) (string, error) {
+	algorithm, hex, found := strings.Cut(imageDigest, ":")
+	if !found || len(algorithm) == 0 || len(hex) == 0 {
+		return "", fmt.Errorf("invalid image digest %q", imageDigest)
+	}
+	return fmt.Sprintf("%s-%s%s", algorithm, hex, bean.CosignSignatureTagSuffix), nil
+}
+
+// FetchCosignSignatures returns the signatures attached to the image digest in the repository, none when the image was never signed
+func FetchCosignSignatures(ctx context.Context, repo *remote.Repository, imageDigest string) ([]*CosignSignature, error) {
+	signatureTag, err := SignatureTag(imageDigest)
+	if err != nil {
+		return nil, err
+	}
+	manifestDesc, manifestReader, err := repo.FetchReference(ctx, signatureTag)
+	if errors.Is(err, errdef.ErrNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	defer manifestReader.Close()
+	if manifestDesc.Size > maxSignatureManifestSize {
+		return nil, fmt.Errorf("signature manifest of %s is too large", imageDigest)
+	}
+	manifestBytes, err := io.ReadAll(io.LimitReader(manifestReader, maxSignatureManifestSize))
+	if err != nil {
+		return nil, err
+	}
+	var manifest ocispec.Manifest
+	err = json.Unmarshal(manifestBytes, &manifest)
+	if err != nil {
+		return nil, err
+	}
+	signatures := make([]*CosignSignature, 0, len(manifest.Layers))
+	for _, layer := range manifest.Layers {
+		signature, ok := layer.Annotations[bean.CosignSignatureAnnotation]
+		if !ok || layer.MediaType != bean.CosignSimpleSigningMediaType || layer.Size > maxSignatureManifestSize {
+			continue
+		}
+		payload, err := fetchBlob(ctx, repo, layer)
+		if err != nil {
+			return nil, err
+		}
+		signatures = append(signatures, &CosignSignature{Payload: payload, Signature: signature})
+	}
+	return signatures, nil
+}
+
+func fetchBlob(ctx context.Context, repo *remote.Repository, desc ocispec.Descriptor) ([]byte, error) {
+	reader, err := repo.Blobs().Fetch(ctx, desc)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	return io.ReadAll(io.LimitReader(reader, maxSignatureManifestSize))
+}
+
+// ParsePublicKey parses a PEM encoded cosign public key
+func ParsePublicKey(publicKeyPem string) (crypto.PublicKey, error) {
+	block, _ := pem.Decode([]byte(strings.TrimSpace(publicKeyPem)))
+	if block == nil {
+		return nil, errors.New("public key is not PEM encoded")
+	}
+	publicKey, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	switch publicKey.(type) {
+	case *ecdsa.PublicKey, *rsa.PublicKey, ed25519.PublicKey:
+		return publicKey, nil
+	default:
+		return nil, fmt.Errorf("unsupported public key type %T", publicKey)
+	}
+}
+
+// VerifySignature checks that the simple signing payload was signed by the public key and refers to the image digest
+func VerifySignature(publicKey crypto.PublicKey, signature *CosignSignature, imageDigest string) error {
+	signatureBytes, err := base64.StdEncoding.DecodeString(signature.Signature)
+	if err != nil {
+		return fmt.Errorf("invalid signature encoding: %w", err)
+	}
+	digest := sha256.Sum256(signature.Payload)
+	switch key := publicKey.(type) {
+	case *ecdsa.PublicKey:
+		if !ecdsa.VerifyASN1(key, digest[:], signatureBytes) {
+			return errors.New("invalid signature")
+		}
+	case *rsa.PublicKey:
+		if err = rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signatureBytes); err != nil {
+			return errors.New("invalid signature")
+		}
+	case ed25519.PublicKey:
+		if !ed25519.Verify(key, signature.Payload, signatureBytes) {
+			return errors.New("invalid signature")
+		}
+	default:
+		return fmt.Errorf("unsupported public key type %T", publicKey)
+	}
+	// the payload is trusted only after the signature check, it binds the signature to one image digest
+	var payload simpleSigningPayload
+	err = json.Unmarshal(signature.Payload, &payload)
+	if err != nil {
+		return fmt.Errorf("invalid signature payload: %w", err)
+	}
+	if signedDigest := payload.Critical.Image[bean.CosignDockerManifestDigestField]; signedDigest != imageDigest {
+		return fmt.Errorf("signature is for digest %q and not %q", signedDigest, imageDigest)
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package helper
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"testing"
+)
+
+const testImageDigest = "sha256:4c3e1f2c2d1f0b7a2f8e6b0d9a1c3e5f7a9b1d3f5a7c9e1b3d5f7a9c1e3b5d7f"
+
+func TestParseImage(t *testing.T) {
+	tests := []struct {
+		image    string
+		wantHost string
+		wantRepo string
+		wantRef  string
+		wantErr  bool
+	}{
+		{image: "nginx", wantHost: "docker.io", wantRepo: "library/nginx", wantRef: "latest"},
+		{image: "devtron/app:abc12", wantHost: "docker.io", wantRepo: "devtron/app", wantRef: "abc12"},
+		{image: "123.dkr.ecr.us-east-1.amazonaws.com/team/app:v1", wantHost: "123.dkr.ecr.us-east-1.amazonaws.com", wantRepo: "team/app", wantRef: "v1"},
+		{image: "localhost:5000/app", wantHost: "localhost:5000", wantRepo: "app", wantRef: "latest"},
+		{image: "registry.local/app@" + testImageDigest, wantHost: "registry.local", wantRepo: "app", wantRef: testImageDigest},
+		{image: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.image, func(t *testing.T) {
+			host, repo, ref, err := ParseImage(tt.image)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseImage() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if host != tt.wantHost || repo != tt.wantRepo || ref != tt.wantRef {
+				t.Errorf("ParseImage() = %s, %s, %s, want %s, %s, %s", host, repo, ref, tt.wantHost, tt.wantRepo, tt.wantRef)
+			}
+		})
+	}
+}
+
+func TestVerifySignature(t *testing.T) {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	publicKey, err := ParsePublicKey(encodePublicKey(t, &privateKey.PublicKey))
+	if err != nil {
+		t.Fatal(err)
+	}
+	payload := []byte(fmt.Sprintf(`{"critical":{"identity":{"docker-reference":"registry.local/app"},"image":{"docker-manifest-digest":%q},"type":"cosign container image signature"},"optional":null}`, testImageDigest))
+	tests := []struct {
+		name        string
+		signature   *CosignSignature
+		imageDigest string
+		wantErr     bool
+	}{
+		{name: "signed by key", signature: sign(t, privateKey, payload), imageDigest: testImageDigest},
+		{name: "signed by other key", signature: sign(t, otherKey, payload), imageDigest: testImageDigest, wantErr: true},
+		{name: "signature of other digest", signature: sign(t, privateKey, payload), imageDigest: "sha256:0000", wantErr: true},
+		{name: "tampered payload", signature: &CosignSignature{Payload: append(payload, ' '), Signature: sign(t, privateKey, payload).Signature}, imageDigest: testImageDigest, wantErr: true},
+		{name: "invalid encoding", signature: &CosignSignature{Payload: payload, Signature: "not-base64"}, imageDigest: testImageDigest, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := VerifySignature(publicKey, tt.signature, tt.imageDigest)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("VerifySignature() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSignatureTag(t *testing.T) {
+	tag, err := SignatureTag(testImageDigest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "sha256-4c3e1f2c2d1f0b7a2f8e6b0d9a1c3e5f7a9b1d3f5a7c9e1b3d5f7a9c1e3b5d7f.sig"; tag != want {
+		t.Errorf("SignatureTag() = %s, want %s", tag, want)
+	}
+	if _, err = SignatureTag("4c3e1f2c"); err == nil {
+		t.Errorf("SignatureTag() expected error for digest without algorithm")
+	}
+}
+
+func encodePublicKey(t *testing.T, publicKey *ecdsa.PublicKey) string {
+	der, err := x509.MarshalPKIXPublicKey(publicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+}
+
+func sign(t *testing.T, privateKey *ecdsa.PrivateKey, payload []byte) *CosignSignature {
+	digest := sha256.Sum256(payload)
+	signature, err := ecdsa.SignASN1(rand.Reader, privateKey, digest[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &CosignSignature{Payload: payload, Signature: base64.StdEncoding.EncodeToString(signature)}
+}
@@ -0,0 +1,98 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package helper
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	registryUtil "github.com/devtron-labs/common-lib/utils/registry"
+	dockerRegistryRepository "github.com/devtron-labs/devtron/internal/sql/repository/dockerRegistry"
+	"net/http"
+	"oras.land/oras-go/v2/registry/remote"
+	"oras.land/oras-go/v2/registry/remote/auth"
+	"oras.land/oras-go/v2/registry/remote/retry"
+)
+
+const (
+	registryConnectionInsecure       = "insecure"
+	registryConnectionSecureWithCert = "secure-with-cert"
+)
+
+// NewRemoteRepository returns a client of the image repository, dockerArtifactStore is nil for anonymous access
+func NewRemoteRepository(registryHost, repository string, dockerArtifactStore *dockerRegistryRepository.DockerArtifactStore) (*remote.Repository, error) {
+	repo, err := remote.NewRepository(fmt.Sprintf("%s/%s", registryHost, repository))
+	if err != nil {
+		return nil, err
+	}
+	if dockerArtifactStore == nil {
+		repo.Client = &auth.Client{Client: retry.DefaultClient, Cache: auth.NewCache()}
+		return repo, nil
+	}
+	username, password, err := registryUtil.ExtractCredentialsForRegistry(&registryUtil.RegistryCredential{
+		RegistryType:       registryUtil.Registry(dockerArtifactStore.RegistryType),
+		RegistryURL:        dockerArtifactStore.RegistryURL,
+		Username:           dockerArtifactStore.Username,
+		Password:           dockerArtifactStore.Password.String(),
+		AWSAccessKeyId:     dockerArtifactStore.AWSAccessKeyId,
+		AWSSecretAccessKey: dockerArtifactStore.AWSSecretAccessKey.String(),
+		AWSRegion:          dockerArtifactStore.AWSRegion,
+	})
+	if err != nil {
+		return nil, err
+	}
+	httpClient, err := getRegistryHttpClient(dockerArtifactStore)
+	if err != nil {
+		return nil, err
+	}
+	repo.Client = &auth.Client{
+		Client: httpClient,
+		Cache:  auth.NewCache(),
+		Credential: auth.StaticCredential(repo.Reference.Registry, auth.Credential{
+			Username: username,
+			Password: password,
+		}),
+	}
+	return repo, nil
+}
+
+func getRegistryHttpClient(dockerArtifactStore *dockerRegistryRepository.DockerArtifactStore) (*http.Client, error) {
+	switch dockerArtifactStore.Connection {
+	case registryConnectionInsecure:
+		transport := retry.NewTransport(&http.Transport{
+			Proxy:           http.ProxyFromEnvironment,
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true}, // #nosec G402 -- registry is marked insecure by the user
+		})
+		return &http.Client{Transport: transport}, nil
+	case registryConnectionSecureWithCert:
+		certPool, err := x509.SystemCertPool()
+		if err != nil {
+			certPool = x509.NewCertPool()
+		}
+		if !certPool.AppendCertsFromPEM([]byte(dockerArtifactStore.Cert)) {
+			return nil, errors.New("invalid certificate configured for container registry")
+		}
+		transport := retry.NewTransport(&http.Transport{
+			Proxy:           http.ProxyFromEnvironment,
+			TLSClientConfig: &tls.Config{RootCAs: certPool},
+		})
+		return &http.Client{Transport: transport}, nil
+	default:
+		return retry.DefaultClient, nil
+	}
+}
@@ -0,0 +1,76 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package repository
+
+import (
+	"github.com/devtron-labs/devtron/pkg/sql"
+	"github.com/go-pg/pg"
+	"go.uber.org/zap"
+	"time"
+)
+
+type CiArtifactSignature struct {
+	TableName    struct{}  `sql:"ci_artifact_signature" pg:",discard_unknown_columns"`
+	Id           int       `sql:"id,pk"`
+	CiArtifactId int       `sql:"ci_artifact_id,notnull"`
+	SigningKeyId int       `sql:"signing_key_id,notnull"`
+	ImageDigest  string    `sql:"image_digest,notnull"`
+	Source       string    `sql:"source,notnull"`
+	Verified     bool      `sql:"verified,notnull"`
+	VerifiedOn   time.Time `sql:"verified_on"`
+	sql.AuditLog
+}
+
+type CiArtifactSignatureRepository interface {
+	// Upsert saves the signature of the artifact by the key, replacing an earlier record of the same key
+	Upsert(signature *CiArtifactSignature) error
+	FindByCiArtifactId(ciArtifactId int) ([]*CiArtifactSignature, error)
+}
+
+type CiArtifactSignatureRepositoryImpl struct {
+	dbConnection *pg.DB
+	logger       *zap.SugaredLogger
+}
+
+func NewCiArtifactSignatureRepositoryImpl(dbConnection *pg.DB, logger *zap.SugaredLogger) *CiArtifactSignatureRepositoryImpl {
+	return &CiArtifactSignatureRepositoryImpl{
+		dbConnection: dbConnection,
+		logger:       logger,
+	}
+}
+
+func (impl *CiArtifactSignatureRepositoryImpl) Upsert(signature *CiArtifactSignature) error {
+	_, err := impl.dbConnection.Model(signature).
+		OnConflict("(ci_artifact_id, signing_key_id) DO UPDATE").
+		Set("image_digest = EXCLUDED.image_digest").
+		Set("source = EXCLUDED.source").
+		Set("verified = EXCLUDED.verified").
+		Set("verified_on = EXCLUDED.verified_on").
+		Set("updated_on = EXCLUDED.updated_on").
+		Set("updated_by = EXCLUDED.updated_by").
+		Insert()
+	return err
+}
+
+func (impl *CiArtifactSignatureRepositoryImpl) FindByCiArtifactId(ciArtifactId int) ([]*CiArtifactSignature, error) {
+	var signatures []*CiArtifactSignature
+	err := impl.dbConnection.Model(&signatures).
+		Where("ci_artifact_id = ?", ciArtifactId).
+		Order("id ASC").
+		Select()
+	return signatures, err
+}
@@ -0,0 +1,131 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package repository
+
+import (
+	"github.com/devtron-labs/devtron/pkg/sql"
+	"github.com/go-pg/pg"
+	"github.com/go-pg/pg/orm"
+	"go.uber.org/zap"
+	"time"
+)
+
+type ImageSignaturePolicy struct {
+	TableName     struct{} `sql:"image_signature_policy" pg:",discard_unknown_columns"`
+	Id            int      `sql:"id,pk"`
+	Global        bool     `sql:"global,notnull"`
+	ClusterId     int      `sql:"cluster_id"`
+	EnvironmentId int      `sql:"env_id"`
+	SigningKeyId  int      `sql:"signing_key_id,notnull"`
+	Deleted       bool     `sql:"deleted,notnull"`
+	sql.AuditLog
+}
+
+type ImageSignaturePolicyRepository interface {
+	Save(policy *ImageSignaturePolicy) error
+	FindById(id int) (*ImageSignaturePolicy, error)
+	FindAll() ([]*ImageSignaturePolicy, error)
+	MarkDeleted(id int, userId int32) error
+	// FindByScope returns the policies defined exactly at the level, clusterId and envId 0 meaning global
+	FindByScope(global bool, clusterId, envId int) ([]*ImageSignaturePolicy, error)
+	// FindApplicable returns the global, cluster and environment level policies applicable to the environment
+	FindApplicable(envId int) ([]*ImageSignaturePolicy, error)
+	CountBySigningKeyId(signingKeyId int) (int, error)
+}
+
+type ImageSignaturePolicyRepositoryImpl struct {
+	dbConnection *pg.DB
+	logger       *zap.SugaredLogger
+}
+
+func NewImageSignaturePolicyRepositoryImpl(dbConnection *pg.DB, logger *zap.SugaredLogger) *ImageSignaturePolicyRepositoryImpl {
+	return &ImageSignaturePolicyRepositoryImpl{
+		dbConnection: dbConnection,
+		logger:       logger,
+	}
+}
+
+func (impl *ImageSignaturePolicyRepositoryImpl) Save(policy *ImageSignaturePolicy) error {
+	return impl.dbConnection.Insert(policy)
+}
+
+func (impl *ImageSignaturePolicyRepositoryImpl) FindById(id int) (*ImageSignaturePolicy, error) {
+	policy := &ImageSignaturePolicy{}
+	err := impl.dbConnection.Model(policy).
+		Where("id = ?", id).
+		Where("deleted = ?", false).
+		Select()
+	return policy, err
+}
+
+func (impl *ImageSignaturePolicyRepositoryImpl) FindAll() ([]*ImageSignaturePolicy, error) {
+	var policies []*ImageSignaturePolicy
+	err := impl.dbConnection.Model(&policies).
+		Where("deleted = ?", false).
+		Order("id ASC").
+		Select()
+	return policies, err
+}
+
+func (impl *ImageSignaturePolicyRepositoryImpl) MarkDeleted(id int, userId int32) error {
+	_, err := impl.dbConnection.Model(&ImageSignaturePolicy{}).
+		Set("deleted = ?", true).
+		Set("updated_on = ?", time.Now()).
+		Set("updated_by = ?", userId).
+		Where("id = ?", id).
+		Update()
+	return err
+}
+
+func (impl *ImageSignaturePolicyRepositoryImpl) FindByScope(global bool, clusterId, envId int) ([]*ImageSignaturePolicy, error) {
+	var policies []*ImageSignaturePolicy
+	query := impl.dbConnection.Model(&policies).
+		Where("deleted = ?", false)
+	if global {
+		query = query.Where("global = ?", true)
+	} else if envId > 0 {
+		query = query.Where("env_id = ?", envId)
+	} else {
+		query = query.Where("cluster_id = ?", clusterId).Where("env_id IS NULL")
+	}
+	err := query.Order("id ASC").Select()
+	return policies, err
+}
+
+func (impl *ImageSignaturePolicyRepositoryImpl) FindApplicable(envId int) ([]*ImageSignaturePolicy, error) {
+	var policies []*ImageSignaturePolicy
+	err := impl.dbConnection.Model(&policies).
+		WhereGroup(func(q *orm.Query) (*orm.Query, error) {
+			q = q.WhereOr("global = ?", true).
+				WhereOr("env_id = ?", envId).
+				WhereOrGroup(func(sq *orm.Query) (*orm.Query, error) {
+					sq = sq.Where("cluster_id = (SELECT cluster_id FROM environment WHERE id = ?)", envId).Where("env_id IS NULL")
+					return sq, nil
+				})
+			return q, nil
+		}).
+		Where("deleted = ?", false).
+		Select()
+	return policies, err
+}
+
+func (impl *ImageSignaturePolicyRepositoryImpl) CountBySigningKeyId(signingKeyId int) (int, error) {
+	return impl.dbConnection.Model(&ImageSignaturePolicy{}).
+		Where("signing_key_id = ?", signingKeyId).
+		Where("deleted = ?", false).
+		Count()
+}
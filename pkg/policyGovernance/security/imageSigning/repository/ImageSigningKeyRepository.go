@@ -0,0 +1,141 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package repository
+
+import (
+	"github.com/devtron-labs/common-lib/securestore"
+	"github.com/devtron-labs/devtron/pkg/sql"
+	globalUtil "github.com/devtron-labs/devtron/util"
+	"github.com/go-pg/pg"
+	"go.uber.org/zap"
+)
+
+type ImageSigningKey struct {
+	TableName   struct{}                    `sql:"image_signing_key" pg:",discard_unknown_columns"`
+	Id          int                         `sql:"id,pk"`
+	Name        string                      `sql:"name,notnull"`
+	Description string                      `sql:"description"`
+	PublicKey   string                      `sql:"public_key,notnull"`
+	PrivateKey  securestore.EncryptedString `sql:"private_key"`
+	Password    securestore.EncryptedString `sql:"password"`
+	SignBuilds  bool                        `sql:"sign_builds,notnull"`
+	Active      bool                        `sql:"active,notnull"`
+	sql.AuditLog
+}
+
+type ImageSigningKeyRepository interface {
+	Save(key *ImageSigningKey) error
+	Update(key *ImageSigningKey) error
+	FindActiveById(id int) (*ImageSigningKey, error)
+	FindActiveByName(name string) (*ImageSigningKey, error)
+	FindAllActive() ([]*ImageSigningKey, error)
+	FindActiveByIds(ids []int) ([]*ImageSigningKey, error)
+	FindAllActiveSigningBuilds() ([]*ImageSigningKey, error)
+}
+
+type ImageSigningKeyRepositoryImpl struct {
+	dbConnection       *pg.DB
+	logger             *zap.SugaredLogger
+	GlobalEnvVariables *globalUtil.GlobalEnvVariables
+}
+
+func NewImageSigningKeyRepositoryImpl(dbConnection *pg.DB, logger *zap.SugaredLogger, envVariables *globalUtil.EnvironmentVariables) *ImageSigningKeyRepositoryImpl {
+	return &ImageSigningKeyRepositoryImpl{
+		dbConnection:       dbConnection,
+		logger:             logger,
+		GlobalEnvVariables: envVariables.GlobalEnvVariables,
+	}
+}
+
+func (impl *ImageSigningKeyRepositoryImpl) Save(key *ImageSigningKey) error {
+	err := impl.encryptFieldsInSigningKey(key)
+	if err != nil {
+		return err
+	}
+	return impl.dbConnection.Insert(key)
+}
+
+func (impl *ImageSigningKeyRepositoryImpl) Update(key *ImageSigningKey) error {
+	err := impl.encryptFieldsInSigningKey(key)
+	if err != nil {
+		return err
+	}
+	return impl.dbConnection.Update(key)
+}
+
+func (impl *ImageSigningKeyRepositoryImpl) FindActiveById(id int) (*ImageSigningKey, error) {
+	key := &ImageSigningKey{}
+	err := impl.dbConnection.Model(key).
+		Where("id = ?", id).
+		Where("active = ?", true).
+		Select()
+	return key, err
+}
+
+func (impl *ImageSigningKeyRepositoryImpl) FindActiveByName(name string) (*ImageSigningKey, error) {
+	key := &ImageSigningKey{}
+	err := impl.dbConnection.Model(key).
+		Where("name = ?", name).
+		Where("active = ?", true).
+		Select()
+	return key, err
+}
+
+func (impl *ImageSigningKeyRepositoryImpl) FindAllActive() ([]*ImageSigningKey, error) {
+	var keys []*ImageSigningKey
+	err := impl.dbConnection.Model(&keys).
+		Where("active = ?", true).
+		Order("name ASC").
+		Select()
+	return keys, err
+}
+
+func (impl *ImageSigningKeyRepositoryImpl) FindActiveByIds(ids []int) ([]*ImageSigningKey, error) {
+	var keys []*ImageSigningKey
+	if len(ids) == 0 {
+		return keys, nil
+	}
+	err := impl.dbConnection.Model(&keys).
+		Where("id IN (?)", pg.In(ids)).
+		Where("active = ?", true).
+		Select()
+	return keys, err
+}
+
+func (impl *ImageSigningKeyRepositoryImpl) FindAllActiveSigningBuilds() ([]*ImageSigningKey, error) {
+	var keys []*ImageSigningKey
+	err := impl.dbConnection.Model(&keys).
+		Where("sign_builds = ?", true).
+		Where("active = ?", true).
+		Select()
+	return keys, err
+}
+
+func (impl *ImageSigningKeyRepositoryImpl) encryptFieldsInSigningKey(key *ImageSigningKey) error {
+	var err error
+	if impl.GlobalEnvVariables.EnablePasswordEncryption {
+		key.PrivateKey, err = securestore.EncryptString(key.PrivateKey.String())
+		if err != nil {
+			return err
+		}
+		key.Password, err = securestore.EncryptString(key.Password.String())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
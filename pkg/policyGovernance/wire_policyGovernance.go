@@ -2,11 +2,13 @@ package policyGovernance
 
 import (
 	"github.com/devtron-labs/devtron/pkg/policyGovernance/security/imageScanning"
+	"github.com/devtron-labs/devtron/pkg/policyGovernance/security/imageSigning"
 	"github.com/devtron-labs/devtron/pkg/policyGovernance/security/scanTool"
 	"github.com/google/wire"
 )
 
 var PolicyGovernanceWireSet = wire.NewSet(
 	imageScanning.ImageScanningWireSet,
+	imageSigning.ImageSigningWireSet,
 	scanTool.ScanToolWireSet,
 )
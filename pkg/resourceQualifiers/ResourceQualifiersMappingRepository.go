@@ -63,9 +63,21 @@ func (repo *QualifiersMappingRepositoryImpl) CreateQualifierMappings(qualifierMa
 }
 
 func (repo *QualifiersMappingRepositoryImpl) addScopeWhereClause(query *orm.Query, scope *Scope, searchableKeyNameIdMap map[bean.DevtronResourceSearchableKeyName]int) *orm.Query {
+	appIdKey := searchableKeyNameIdMap[bean.DEVTRON_RESOURCE_SEARCHABLE_KEY_APP_ID]
+	envIdKey := searchableKeyNameIdMap[bean.DEVTRON_RESOURCE_SEARCHABLE_KEY_ENV_ID]
+	clusterIdKey := searchableKeyNameIdMap[bean.DEVTRON_RESOURCE_SEARCHABLE_KEY_CLUSTER_ID]
+	// both parent (app) and child (env) rows of app+env mappings are fetched, partial matches are filtered out by the callers
 	return query.Where(
-		"( (identifier_key = ? AND identifier_value_int = ?)  AND qualifier_id = ?) "+
+		"(((identifier_key = ? AND identifier_value_int = ?) OR (identifier_key = ? AND identifier_value_int = ?)) AND qualifier_id = ?) "+
+			"OR ((identifier_key = ? AND identifier_value_int = ?) AND qualifier_id = ?) "+
+			"OR ((identifier_key = ? AND identifier_value_int = ?) AND qualifier_id = ?) "+
+			"OR ((identifier_key = ? AND identifier_value_int = ?) AND qualifier_id = ?) "+
+			"OR ( (identifier_key = ? AND identifier_value_int = ?)  AND qualifier_id = ?) "+
 			"OR (qualifier_id = ? ) ",
+		appIdKey, scope.AppId, envIdKey, scope.EnvId, APP_AND_ENV_QUALIFIER,
+		appIdKey, scope.AppId, APP_QUALIFIER,
+		envIdKey, scope.EnvId, ENV_QUALIFIER,
+		clusterIdKey, scope.ClusterId, CLUSTER_QUALIFIER,
 		searchableKeyNameIdMap[bean.DEVTRON_RESOURCE_SEARCHABLE_KEY_PIPELINE_ID], scope.PipelineId, PIPELINE_QUALIFIER,
 		GLOBAL_QUALIFIER)
 }
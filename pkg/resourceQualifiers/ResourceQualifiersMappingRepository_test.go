@@ -0,0 +1,53 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourceQualifiers
+
+import (
+	"testing"
+
+	"github.com/devtron-labs/devtron/pkg/devtronResource/bean"
+	"github.com/go-pg/pg/orm"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddScopeWhereClause(t *testing.T) {
+	searchableKeyNameIdMap := map[bean.DevtronResourceSearchableKeyName]int{
+		bean.DEVTRON_RESOURCE_SEARCHABLE_KEY_APP_ID:      1,
+		bean.DEVTRON_RESOURCE_SEARCHABLE_KEY_ENV_ID:      2,
+		bean.DEVTRON_RESOURCE_SEARCHABLE_KEY_CLUSTER_ID:  3,
+		bean.DEVTRON_RESOURCE_SEARCHABLE_KEY_PIPELINE_ID: 4,
+	}
+	scope := &Scope{AppId: 10, EnvId: 20, ClusterId: 30, PipelineId: 40}
+	repo := &QualifiersMappingRepositoryImpl{}
+	query, err := repo.addScopeWhereClause(orm.NewQuery(nil, &QualifierMapping{}), scope, searchableKeyNameIdMap).AppendQuery(nil)
+	assert.NoError(t, err)
+	// app+env mappings are matched on either of the parent (app) or child (env) rows
+	assert.Contains(t, string(query), "(((identifier_key = 1 AND identifier_value_int = 10) OR (identifier_key = 2 AND identifier_value_int = 20)) AND qualifier_id = 1)")
+	assert.Contains(t, string(query), "OR ((identifier_key = 1 AND identifier_value_int = 10) AND qualifier_id = 2)")
+	assert.Contains(t, string(query), "OR ((identifier_key = 2 AND identifier_value_int = 20) AND qualifier_id = 3)")
+	assert.Contains(t, string(query), "OR ((identifier_key = 3 AND identifier_value_int = 30) AND qualifier_id = 4)")
+	assert.Contains(t, string(query), "OR ( (identifier_key = 4 AND identifier_value_int = 40)  AND qualifier_id = 6)")
+	assert.Contains(t, string(query), "OR (qualifier_id = 5 )")
+}
+
+func TestCompoundQualifiers(t *testing.T) {
+	assert.Equal(t, []Qualifier{APP_AND_ENV_QUALIFIER}, CompoundQualifiers)
+	assert.Equal(t, 1, GetNumOfChildQualifiers(APP_AND_ENV_QUALIFIER))
+	for _, qualifier := range []Qualifier{APP_QUALIFIER, ENV_QUALIFIER, CLUSTER_QUALIFIER, GLOBAL_QUALIFIER, PIPELINE_QUALIFIER} {
+		assert.Equal(t, 0, GetNumOfChildQualifiers(qualifier))
+	}
+}
@@ -62,8 +62,12 @@ const (
 	PIPELINE_QUALIFIER    Qualifier = 6
 )
 
-var CompoundQualifiers []Qualifier
+var CompoundQualifiers = []Qualifier{APP_AND_ENV_QUALIFIER}
 
 func GetNumOfChildQualifiers(qualifier Qualifier) int {
+	switch qualifier {
+	case APP_AND_ENV_QUALIFIER:
+		return 1
+	}
 	return 0
 }
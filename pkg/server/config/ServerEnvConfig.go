@@ -41,6 +41,8 @@ type ServerEnvConfig struct {
 	ModuleMetaDataApiUrl                        string `env:"MODULE_METADATA_API_URL" envDefault:"https://api.devtron.ai/module?name=%s" description:"Modules list and meta info will be fetched from this server, that is central api server of devtron."`
 	ParallelismLimitForTagProcessing            int    `env:"PARALLELISM_LIMIT_FOR_TAG_PROCESSING" description:"App manual sync job parallel tag processing count."`
 	AppSyncJobShutDownWaitDuration              int    `env:"APP_SYNC_SHUTDOWN_WAIT_DURATION" envDefault:"120"`
+	ChartRepoMirrorBaseUrl                      string `env:"CHART_REPO_MIRROR_BASE_URL" envDefault:"http://devtron-service.devtroncd:80/orchestrator/chart-repo/mirror/serve" description:"Base url at which mirrored chart repositories are served, must be reachable by argocd and kubelink, requests are authenticated with the pull token of the mirrored repository"`
+	ChartRepoMirrorMaxVersionsPerChart          int    `env:"CHART_REPO_MIRROR_MAX_VERSIONS_PER_CHART" envDefault:"5" description:"Number of latest versions of each chart downloaded while mirroring a chart repository, 0 mirrors all versions"`
 	DevtronOperatorBasePath                     string `env:"DEVTRON_OPERATOR_BASE_PATH" envDefault:"" description:"Base path for devtron operator, used to find the helm charts and values files"`
 	DevtronInstallerModulesPath                 string `env:"DEVTRON_INSTALLER_MODULES_PATH" envDefault:"installer.modules" description:"Path to devtron installer modules, used to find the helm charts and values files"`
 	DevtronInstallerReleasePath                 string `env:"DEVTRON_INSTALLER_RELEASE_PATH" envDefault:"installer.release" description:"Path to devtron installer release, used to find the helm charts and values files"`
@@ -0,0 +1,67 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sql
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/go-pg/pg"
+	"github.com/google/uuid"
+)
+
+// CronLease is used by the crons to run on one replica at a time. The lease is a row in cron_lease, so no connection
+// or transaction is held while the cron runs, a lease not released by a crashed replica is free again once it expires.
+type CronLease interface {
+	// TryAcquire takes the lease of the key for ttl when it is free or expired and returns false when another replica has it
+	TryAcquire(key string, ttl time.Duration) (bool, error)
+	// Release frees the lease of the key if it is held by this replica
+	Release(key string) error
+}
+
+type CronLeaseImpl struct {
+	dbConnection *pg.DB
+	holder       string
+}
+
+func NewCronLeaseImpl(dbConnection *pg.DB) *CronLeaseImpl {
+	hostname, _ := os.Hostname()
+	return &CronLeaseImpl{
+		dbConnection: dbConnection,
+		holder:       fmt.Sprintf("%s-%s", hostname, uuid.NewString()),
+	}
+}
+
+func (impl *CronLeaseImpl) TryAcquire(key string, ttl time.Duration) (bool, error) {
+	var acquiredKey string
+	// expiry is compared with the db clock, so that clock skew across replicas does not matter
+	query := "INSERT INTO cron_lease (key, holder, expires_on) VALUES (?0, ?1, now() + ?2 * interval '1 second')" +
+		" ON CONFLICT (key) DO UPDATE SET holder = EXCLUDED.holder, expires_on = EXCLUDED.expires_on" +
+		" WHERE cron_lease.expires_on < now() OR cron_lease.holder = EXCLUDED.holder" +
+		" RETURNING key;"
+	res, err := impl.dbConnection.Query(pg.Scan(&acquiredKey), query, key, impl.holder, int(ttl.Seconds()))
+	if err != nil {
+		return false, err
+	}
+	return res.RowsReturned() == 1, nil
+}
+
+func (impl *CronLeaseImpl) Release(key string) error {
+	_, err := impl.dbConnection.Exec("DELETE FROM cron_lease WHERE key = ? AND holder = ?;", key, impl.holder)
+	return err
+}
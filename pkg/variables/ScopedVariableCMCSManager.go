@@ -24,6 +24,7 @@ import (
 	serviceBean "github.com/devtron-labs/devtron/pkg/bean"
 	"github.com/devtron-labs/devtron/pkg/pipeline/history/repository"
 	"github.com/devtron-labs/devtron/pkg/resourceQualifiers"
+	"github.com/devtron-labs/devtron/pkg/variables/externalSecret"
 	models2 "github.com/devtron-labs/devtron/pkg/variables/models"
 	"github.com/devtron-labs/devtron/pkg/variables/parsers"
 	repository1 "github.com/devtron-labs/devtron/pkg/variables/repository"
@@ -62,6 +63,7 @@ func NewScopedVariableCMCSManagerImpl(logger *zap.SugaredLogger,
 	variableEntityMappingService VariableEntityMappingService,
 	variableSnapshotHistoryService VariableSnapshotHistoryService,
 	variableTemplateParser parsers.VariableTemplateParser,
+	externalSecretResolver externalSecret.ExternalSecretResolver,
 ) (*ScopedVariableCMCSManagerImpl, error) {
 
 	scopedVariableManagerImpl := ScopedVariableManagerImpl{
@@ -70,6 +72,7 @@ func NewScopedVariableCMCSManagerImpl(logger *zap.SugaredLogger,
 		variableEntityMappingService:   variableEntityMappingService,
 		variableSnapshotHistoryService: variableSnapshotHistoryService,
 		variableTemplateParser:         variableTemplateParser,
+		externalSecretResolver:         externalSecretResolver,
 	}
 	scopedVariableCMCSManagerImpl := &ScopedVariableCMCSManagerImpl{
 		ScopedVariableManagerImpl: scopedVariableManagerImpl,
@@ -144,7 +147,7 @@ func (impl *ScopedVariableCMCSManagerImpl) ResolveSecretHistoryDto(ctx context.C
 	}
 	isSuperAdmin, err := util.GetIsSuperAdminFromContext(ctx)
 
-	variableSnapshotMap, resolvedTemplate, err := impl.GetVariableSnapshotAndResolveTemplate(data, parsers.StringVariableTemplate, reference, isSuperAdmin, false)
+	variableSnapshotMap, resolvedTemplate, err := impl.GetVariableSnapshotAndResolveTemplate(data, parsers.StringVariableTemplate, reference, isSuperAdmin, false, true)
 	if err != nil {
 		return cMCSData, nil, err
 	}
@@ -179,7 +182,7 @@ func (impl *ScopedVariableCMCSManagerImpl) ResolveCMHistoryDto(ctx context.Conte
 	if err != nil {
 		return cMCSData, nil, err
 	}
-	variableSnapshotMap, resolvedTemplate, err := impl.GetVariableSnapshotAndResolveTemplate(string(configListJson), parsers.StringVariableTemplate, reference, isSuperAdmin, true)
+	variableSnapshotMap, resolvedTemplate, err := impl.GetVariableSnapshotAndResolveTemplate(string(configListJson), parsers.StringVariableTemplate, reference, isSuperAdmin, true, true)
 	if err != nil {
 		return cMCSData, nil, err
 	}
@@ -401,7 +404,7 @@ func (impl *ScopedVariableCMCSManagerImpl) ResolvedVariableForSpecificType(confi
 		HistoryReferenceType: repository1.HistoryReferenceTypeConfigMap,
 	}
 
-	variableMapCM, resolvedTemplateCM, err := impl.GetVariableSnapshotAndResolveTemplate(string(configMapByte), parsers.StringVariableTemplate, reference, true, true)
+	variableMapCM, resolvedTemplateCM, err := impl.GetVariableSnapshotAndResolveTemplate(string(configMapByte), parsers.StringVariableTemplate, reference, true, true, false)
 	if err != nil {
 		return "", "", nil, nil, err
 	}
@@ -413,7 +416,7 @@ func (impl *ScopedVariableCMCSManagerImpl) ResolvedVariableForSpecificType(confi
 	if err != nil {
 		return "", "", nil, nil, err
 	}
-	variableMapCS, resolvedTemplateCS, err := impl.GetVariableSnapshotAndResolveTemplate(data, parsers.StringVariableTemplate, reference, true, true)
+	variableMapCS, resolvedTemplateCS, err := impl.GetVariableSnapshotAndResolveTemplate(data, parsers.StringVariableTemplate, reference, true, true, false)
 	encodedSecretData, err := bean.GetTransformedDataForSecretRootJsonData(resolvedTemplateCS, util.EncodeSecret)
 	if err != nil {
 		return "", "", nil, nil, err
@@ -18,8 +18,10 @@ package variables
 
 import (
 	"encoding/json"
+	"fmt"
 	mapset "github.com/deckarep/golang-set"
 	"github.com/devtron-labs/devtron/pkg/resourceQualifiers"
+	"github.com/devtron-labs/devtron/pkg/variables/externalSecret"
 	"github.com/devtron-labs/devtron/pkg/variables/models"
 	"github.com/devtron-labs/devtron/pkg/variables/parsers"
 	"github.com/devtron-labs/devtron/pkg/variables/repository"
@@ -37,14 +39,18 @@ type ScopedVariableManager interface {
 	RemoveMappedVariables(entityId int, entityType repository.EntityType, userId int32, tx *pg.Tx) error
 	ParseTemplateWithScopedVariables(request parsers.VariableParserRequest) (string, error)
 
+	// external values
+	ResolveExternalValues(scopedVariables []*models.ScopedVariableData) error
+
 	// variable mapping
 	ExtractAndMapVariables(template string, entityId int, entityType repository.EntityType, userId int32, tx *pg.Tx) error
 
 	// template resolvers
-	ExtractVariablesAndResolveTemplate(scope resourceQualifiers.Scope, template string, templateType parsers.VariableTemplateType, unmaskSensitiveData bool, maskUnknownVariable bool) (string, map[string]string, error)
+	ExtractVariablesAndResolveTemplate(scope resourceQualifiers.Scope, template string, templateType parsers.VariableTemplateType, unmaskSensitiveData bool, maskUnknownVariable bool, redactExternalValues bool) (string, map[string]string, error)
+	ExtractVariablesAndResolveTemplateWithTrace(scope resourceQualifiers.Scope, template string, templateType parsers.VariableTemplateType, unmaskSensitiveData bool, maskUnknownVariable bool, redactExternalValues bool) (string, map[string]string, map[string]*models.VariableResolutionTrace, error)
 	GetMappedVariablesAndResolveTemplate(template string, scope resourceQualifiers.Scope, entity repository.Entity, unmaskSensitiveData bool) (string, map[string]string, error)
 	GetMappedVariablesAndResolveTemplateBatch(template string, scope resourceQualifiers.Scope, entities []repository.Entity) (string, map[string]string, error)
-	GetVariableSnapshotAndResolveTemplate(template string, templateType parsers.VariableTemplateType, reference repository.HistoryReference, isSuperAdmin bool, ignoreUnknown bool) (map[string]string, string, error)
+	GetVariableSnapshotAndResolveTemplate(template string, templateType parsers.VariableTemplateType, reference repository.HistoryReference, isSuperAdmin bool, ignoreUnknown bool, redactExternalValues bool) (map[string]string, string, error)
 }
 
 func (impl ScopedVariableManagerImpl) SaveVariableHistoriesForTrigger(variableHistories []*repository.VariableSnapshotHistoryBean, userId int32) error {
@@ -57,6 +63,7 @@ type ScopedVariableManagerImpl struct {
 	variableEntityMappingService   VariableEntityMappingService
 	variableSnapshotHistoryService VariableSnapshotHistoryService
 	variableTemplateParser         parsers.VariableTemplateParser
+	externalSecretResolver         externalSecret.ExternalSecretResolver
 }
 
 func NewScopedVariableManagerImpl(logger *zap.SugaredLogger,
@@ -64,6 +71,7 @@ func NewScopedVariableManagerImpl(logger *zap.SugaredLogger,
 	variableEntityMappingService VariableEntityMappingService,
 	variableSnapshotHistoryService VariableSnapshotHistoryService,
 	variableTemplateParser parsers.VariableTemplateParser,
+	externalSecretResolver externalSecret.ExternalSecretResolver,
 ) (*ScopedVariableManagerImpl, error) {
 
 	scopedVariableManagerImpl := &ScopedVariableManagerImpl{
@@ -72,6 +80,7 @@ func NewScopedVariableManagerImpl(logger *zap.SugaredLogger,
 		variableEntityMappingService:   variableEntityMappingService,
 		variableSnapshotHistoryService: variableSnapshotHistoryService,
 		variableTemplateParser:         variableTemplateParser,
+		externalSecretResolver:         externalSecretResolver,
 	}
 
 	return scopedVariableManagerImpl, nil
@@ -98,9 +107,17 @@ func (impl ScopedVariableManagerImpl) GetMappedVariablesAndResolveTemplate(templ
 	if err != nil {
 		return template, variableMap, err
 	}
+	err = impl.ResolveExternalValues(scopedVariables)
+	if err != nil {
+		return template, variableMap, err
+	}
+	err = validateVariableValues(scopedVariables)
+	if err != nil {
+		return template, variableMap, err
+	}
 
 	for _, variable := range scopedVariables {
-		variableMap[variable.VariableName] = variable.VariableValue.StringValue()
+		variableMap[variable.VariableName] = variable.GetSnapshotValue()
 	}
 
 	if len(variableMap) == 0 {
@@ -117,25 +134,48 @@ func (impl ScopedVariableManagerImpl) GetMappedVariablesAndResolveTemplate(templ
 	return resolvedTemplate, variableMap, nil
 }
 
-func (impl ScopedVariableManagerImpl) ExtractVariablesAndResolveTemplate(scope resourceQualifiers.Scope, template string, templateType parsers.VariableTemplateType, isSuperAdmin bool, maskUnknownVariable bool) (string, map[string]string, error) {
+// ExtractVariablesAndResolveTemplate resolves the variables used in the template. Values fetched from external sources
+// are replaced by models.HiddenValue when redactExternalValues is set, for templates which are shown to users.
+func (impl ScopedVariableManagerImpl) ExtractVariablesAndResolveTemplate(scope resourceQualifiers.Scope, template string, templateType parsers.VariableTemplateType, isSuperAdmin bool, maskUnknownVariable bool, redactExternalValues bool) (string, map[string]string, error) {
+	resolvedTemplate, variableSnapshot, _, err := impl.ExtractVariablesAndResolveTemplateWithTrace(scope, template, templateType, isSuperAdmin, maskUnknownVariable, redactExternalValues)
+	return resolvedTemplate, variableSnapshot, err
+}
+
+// ExtractVariablesAndResolveTemplateWithTrace additionally returns, for each resolved variable, the scope which supplied its value
+func (impl ScopedVariableManagerImpl) ExtractVariablesAndResolveTemplateWithTrace(scope resourceQualifiers.Scope, template string, templateType parsers.VariableTemplateType, isSuperAdmin bool, maskUnknownVariable bool, redactExternalValues bool) (string, map[string]string, map[string]*models.VariableResolutionTrace, error) {
 
 	variableSnapshot := make(map[string]string)
+	resolutionTrace := make(map[string]*models.VariableResolutionTrace)
 	usedVariables, err := impl.variableTemplateParser.ExtractVariables(template, templateType)
 	if err != nil {
-		return template, variableSnapshot, err
+		return template, variableSnapshot, resolutionTrace, err
 	}
 
 	if len(usedVariables) == 0 {
-		return template, variableSnapshot, err
+		return template, variableSnapshot, resolutionTrace, err
 	}
 
 	scopedVariables, err := impl.scopedVariableService.GetScopedVariables(scope, usedVariables, isSuperAdmin)
 	if err != nil {
-		return template, variableSnapshot, err
+		return template, variableSnapshot, resolutionTrace, err
+	}
+	err = impl.ResolveExternalValues(scopedVariables)
+	if err != nil {
+		return template, variableSnapshot, resolutionTrace, err
+	}
+	err = validateVariableValues(scopedVariables)
+	if err != nil {
+		return template, variableSnapshot, resolutionTrace, err
 	}
 
 	for _, variable := range scopedVariables {
-		variableSnapshot[variable.VariableName] = variable.VariableValue.StringValue()
+		variableSnapshot[variable.VariableName] = variable.GetSnapshotValue()
+		if variable.ResolutionTrace != nil {
+			resolutionTrace[variable.VariableName] = variable.ResolutionTrace
+		}
+		if redactExternalValues && variable.ValueSource != nil {
+			variable.VariableValue = &models.VariableValue{Value: models.HiddenValue}
+		}
 	}
 
 	if maskUnknownVariable {
@@ -152,10 +192,10 @@ func (impl ScopedVariableManagerImpl) ExtractVariablesAndResolveTemplate(scope r
 	parserRequest := parsers.VariableParserRequest{Template: template, Variables: scopedVariables, TemplateType: templateType, IgnoreUnknownVariables: true}
 	resolvedTemplate, err := impl.ParseTemplateWithScopedVariables(parserRequest)
 	if err != nil {
-		return template, variableSnapshot, err
+		return template, variableSnapshot, resolutionTrace, err
 	}
 
-	return resolvedTemplate, variableSnapshot, nil
+	return resolvedTemplate, variableSnapshot, resolutionTrace, nil
 }
 
 func (impl ScopedVariableManagerImpl) ExtractAndMapVariables(template string, entityId int, entityType repository.EntityType, userId int32, tx *pg.Tx) error {
@@ -173,7 +213,10 @@ func (impl ScopedVariableManagerImpl) ExtractAndMapVariables(template string, en
 	return nil
 }
 
-func (impl ScopedVariableManagerImpl) GetVariableSnapshotAndResolveTemplate(template string, templateType parsers.VariableTemplateType, reference repository.HistoryReference, isSuperAdmin bool, ignoreUnknown bool) (map[string]string, string, error) {
+// GetVariableSnapshotAndResolveTemplate resolves the template with the variable values captured for the reference. Values
+// fetched from external sources are fetched again in their captured version, or replaced by models.HiddenValue when
+// redactExternalValues is set.
+func (impl ScopedVariableManagerImpl) GetVariableSnapshotAndResolveTemplate(template string, templateType parsers.VariableTemplateType, reference repository.HistoryReference, isSuperAdmin bool, ignoreUnknown bool, redactExternalValues bool) (map[string]string, string, error) {
 	variableSnapshotMap := make(map[string]string)
 	references, err := impl.variableSnapshotHistoryService.GetVariableHistoryForReferences([]repository.HistoryReference{reference})
 	if err != nil {
@@ -201,6 +244,16 @@ func (impl ScopedVariableManagerImpl) GetVariableSnapshotAndResolveTemplate(temp
 	}
 
 	scopedVariableData := parsers.GetScopedVarData(variableSnapshotMap, varNameToIsSensitive, isSuperAdmin)
+	// values fetched from external sources are captured as references in the snapshot, fetching them again
+	if redactExternalValues {
+		redactExternalValueReferences(scopedVariableData)
+	} else {
+		setValueSourcesFromSnapshotReferences(scopedVariableData)
+		err = impl.ResolveExternalValues(scopedVariableData)
+		if err != nil {
+			return variableSnapshotMap, template, err
+		}
+	}
 	request := parsers.VariableParserRequest{Template: template, TemplateType: templateType, Variables: scopedVariableData, IgnoreUnknownVariables: ignoreUnknown}
 
 	resolvedTemplate, err := impl.ParseTemplateWithScopedVariables(request)
@@ -250,10 +303,18 @@ func (impl ScopedVariableManagerImpl) GetMappedVariablesAndResolveTemplateBatch(
 	if err != nil {
 		return template, variableMap, err
 	}
+	err = impl.ResolveExternalValues(scopedVariables)
+	if err != nil {
+		return template, variableMap, err
+	}
+	err = validateVariableValues(scopedVariables)
+	if err != nil {
+		return template, variableMap, err
+	}
 
 	variableSnapshot := make(map[string]string)
 	for _, variable := range scopedVariables {
-		variableSnapshot[variable.VariableName] = variable.VariableValue.StringValue()
+		variableSnapshot[variable.VariableName] = variable.GetSnapshotValue()
 	}
 
 	request := parsers.VariableParserRequest{
@@ -277,7 +338,85 @@ func (impl ScopedVariableManagerImpl) GetEntityToVariableMapping(entity []reposi
 }
 
 func (impl ScopedVariableManagerImpl) GetScopedVariables(scope resourceQualifiers.Scope, varNames []string, unmaskSensitiveData bool) (scopedVariableDataObj []*models.ScopedVariableData, err error) {
-	return impl.scopedVariableService.GetScopedVariables(scope, varNames, unmaskSensitiveData)
+	scopedVariableDataObj, err = impl.scopedVariableService.GetScopedVariables(scope, varNames, unmaskSensitiveData)
+	if err != nil {
+		return scopedVariableDataObj, err
+	}
+	err = impl.ResolveExternalValues(scopedVariableDataObj)
+	if err != nil {
+		return scopedVariableDataObj, err
+	}
+	err = validateVariableValues(scopedVariableDataObj)
+	return scopedVariableDataObj, err
+}
+
+// ResolveExternalValues fetches the values of variables sourced from external secret backends and pins the value
+// source to the fetched version. Redacted variables are skipped so the secrets are only fetched where the actual values are used.
+func (impl ScopedVariableManagerImpl) ResolveExternalValues(scopedVariables []*models.ScopedVariableData) error {
+	for _, variable := range scopedVariables {
+		if variable.IsRedacted || variable.VariableValue == nil {
+			continue
+		}
+		valueSource := variable.ValueSource
+		if valueSource == nil {
+			continue
+		}
+		value, version, err := impl.externalSecretResolver.Resolve(valueSource)
+		if err != nil {
+			impl.logger.Errorw("error in resolving variable value from external source", "variableName", variable.VariableName, "backend", valueSource.Backend, "path", valueSource.Path, "err", err)
+			return fmt.Errorf("error in fetching value of variable %s from %s: %w", variable.VariableName, valueSource.Backend, err)
+		}
+		if len(valueSource.Version) == 0 && len(version) > 0 {
+			// the resolved version is captured in the snapshot so that rollback and redeploy get the same value
+			pinnedSource := *valueSource
+			pinnedSource.Version = version
+			valueSource = &pinnedSource
+		}
+		variable.ValueSource = valueSource
+		variable.VariableValue = &models.VariableValue{Value: value}
+	}
+	return nil
+}
+
+// setValueSourcesFromSnapshotReferences sets the value source of the variables captured as references in a snapshot.
+// Only snapshot values are parsed as references, plain values carrying the reference prefix are rejected on save
+func setValueSourcesFromSnapshotReferences(scopedVariables []*models.ScopedVariableData) {
+	for _, variable := range scopedVariables {
+		if variable.IsRedacted || variable.VariableValue == nil || variable.ValueSource != nil {
+			continue
+		}
+		variable.ValueSource = models.GetExternalValueSourceFromReference(variable.VariableValue.Value)
+	}
+}
+
+func redactExternalValueReferences(scopedVariables []*models.ScopedVariableData) {
+	for _, variable := range scopedVariables {
+		if variable.VariableValue != nil && models.GetExternalValueSourceFromReference(variable.VariableValue.Value) != nil {
+			variable.VariableValue = &models.VariableValue{Value: models.HiddenValue}
+		}
+	}
+}
+
+// validateVariableValues checks the resolved values against the constraints of their variables.
+// Redacted values and external values which are not fetched yet are skipped, they are checked where they get resolved
+func validateVariableValues(scopedVariables []*models.ScopedVariableData) error {
+	for _, variable := range scopedVariables {
+		if variable.Constraints == nil || variable.IsRedacted || variable.VariableValue == nil {
+			continue
+		}
+		if models.GetExternalValueSourceFromReference(variable.VariableValue.Value) != nil {
+			continue
+		}
+		err := variable.Constraints.ValidateValue(variable.DataType, variable.VariableValue.Value)
+		if err != nil {
+			scopeDescription := "resolved scope"
+			if variable.ResolutionTrace != nil && variable.ResolutionTrace.ResolvedFrom != nil {
+				scopeDescription = models.GetScopeDescription(variable.ResolutionTrace.ResolvedFrom.AttributeType, variable.ResolutionTrace.ResolvedFrom.AttributeParams)
+			}
+			return models.ValidationError{Err: fmt.Errorf("value of variable %s for %s is invalid: %v", variable.VariableName, scopeDescription, err)}
+		}
+	}
+	return nil
 }
 
 func (impl ScopedVariableManagerImpl) ParseTemplateWithScopedVariables(request parsers.VariableParserRequest) (string, error) {
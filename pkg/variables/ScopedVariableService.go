@@ -19,6 +19,7 @@ package variables
 import (
 	"fmt"
 	"regexp"
+	"sort"
 	"strings"
 	"sync"
 
@@ -26,8 +27,10 @@ import (
 	"github.com/caarlos0/env"
 	"github.com/devtron-labs/common-lib/async"
 	"github.com/devtron-labs/devtron/internal/sql/repository/app"
+	repository4 "github.com/devtron-labs/devtron/pkg/auth/user/repository"
 	repository3 "github.com/devtron-labs/devtron/pkg/cluster/environment/repository"
 	"github.com/devtron-labs/devtron/pkg/cluster/repository"
+	"github.com/devtron-labs/devtron/pkg/devtronResource/bean"
 	"github.com/devtron-labs/devtron/pkg/devtronResource/read"
 	"github.com/devtron-labs/devtron/pkg/resourceQualifiers"
 	"github.com/devtron-labs/devtron/pkg/sql"
@@ -43,6 +46,16 @@ import (
 
 type ScopedVariableService interface {
 	CreateVariables(payload models.Payload) error
+	// CreateVariableSetVersion stores the payload as a new version of the variable set,
+	// the variables are saved right away unless approval of variable set versions is required
+	CreateVariableSetVersion(payload models.Payload, comment string) (*models.VariableSetVersion, error)
+	GetVariableSetVersions() ([]*models.VariableSetVersion, error)
+	GetVariableSetVersion(id int) (*models.VariableSetVersion, error)
+	ReviewVariableSetVersion(request *models.VariableSetReviewRequest, userId int32) (*models.VariableSetVersion, error)
+	// RollbackVariableSetVersion saves the variables of an older version as a new active version
+	RollbackVariableSetVersion(request *models.VariableSetRollbackRequest, userId int32) (*models.VariableSetVersion, error)
+	// ValidateVariables runs the validations of CreateVariables without saving the payload
+	ValidateVariables(payload models.Payload) error
 	GetScopedVariables(scope resourceQualifiers.Scope, varNames []string, unmaskSensitiveData bool) (scopedVariableDataObj []*models.ScopedVariableData, err error)
 	GetJsonForVariables() (*models.Payload, error)
 	CheckForSensitiveVariables(variableNames []string) (map[string]bool, error)
@@ -52,22 +65,35 @@ type ScopedVariableService interface {
 }
 
 type ScopedVariableServiceImpl struct {
-	logger                   *zap.SugaredLogger
-	scopedVariableRepository repository2.ScopedVariableRepository
-	qualifierMappingService  resourceQualifiers.QualifierMappingService
-	VariableNameConfig       *VariableConfig
-	VariableCache            *cache.VariableCacheObj
-	asyncRunnable            *async.Runnable
+	logger                              *zap.SugaredLogger
+	scopedVariableRepository            repository2.ScopedVariableRepository
+	appRepository                       app.AppRepository
+	environmentRepository               repository3.EnvironmentRepository
+	clusterRepository                   repository.ClusterRepository
+	devtronResourceSearchableKeyService read.DevtronResourceSearchableKeyService
+	qualifierMappingService             resourceQualifiers.QualifierMappingService
+	variableSetVersionRepository        repository2.VariableSetVersionRepository
+	userRepository                      repository4.UserRepository
+	VariableNameConfig                  *VariableConfig
+	VariableCache                       *cache.VariableCacheObj
+	asyncRunnable                       *async.Runnable
 }
 
 func NewScopedVariableServiceImpl(logger *zap.SugaredLogger, scopedVariableRepository repository2.ScopedVariableRepository, appRepository app.AppRepository, environmentRepository repository3.EnvironmentRepository, devtronResourceSearchableKeyService read.DevtronResourceSearchableKeyService, clusterRepository repository.ClusterRepository,
-	qualifierMappingService resourceQualifiers.QualifierMappingService, asyncRunnable *async.Runnable) (*ScopedVariableServiceImpl, error) {
+	qualifierMappingService resourceQualifiers.QualifierMappingService, variableSetVersionRepository repository2.VariableSetVersionRepository, userRepository repository4.UserRepository,
+	asyncRunnable *async.Runnable) (*ScopedVariableServiceImpl, error) {
 	scopedVariableService := &ScopedVariableServiceImpl{
-		logger:                   logger,
-		scopedVariableRepository: scopedVariableRepository,
-		qualifierMappingService:  qualifierMappingService,
-		VariableCache:            &cache.VariableCacheObj{CacheLock: &sync.Mutex{}},
-		asyncRunnable:            asyncRunnable,
+		logger:                              logger,
+		scopedVariableRepository:            scopedVariableRepository,
+		appRepository:                       appRepository,
+		environmentRepository:               environmentRepository,
+		clusterRepository:                   clusterRepository,
+		devtronResourceSearchableKeyService: devtronResourceSearchableKeyService,
+		qualifierMappingService:             qualifierMappingService,
+		variableSetVersionRepository:        variableSetVersionRepository,
+		userRepository:                      userRepository,
+		VariableCache:                       &cache.VariableCacheObj{CacheLock: &sync.Mutex{}},
+		asyncRunnable:                       asyncRunnable,
 	}
 	cfg, err := GetVariableNameConfig()
 	if err != nil {
@@ -79,10 +105,12 @@ func NewScopedVariableServiceImpl(logger *zap.SugaredLogger, scopedVariableRepos
 }
 
 type VariableConfig struct {
-	VariableNameRegex    string `env:"SCOPED_VARIABLE_NAME_REGEX" envDefault:"^[a-zA-Z][a-zA-Z0-9_-]{0,62}[a-zA-Z0-9]$" description:"Regex for scoped variable name that must passed this regex."`
-	VariableCacheEnabled bool   `env:"VARIABLE_CACHE_ENABLED" envDefault:"true" description:"This is used to  control caching of all the scope variables defined in the system."`
-	SystemVariablePrefix string `env:"SYSTEM_VAR_PREFIX" envDefault:"DEVTRON_" description:"Scoped variable prefix, variable name must have this prefix."`
-	ScopedVariableFormat string `env:"SCOPED_VARIABLE_FORMAT" envDefault:"@{{%s}}" description:"Its a scope format for varialbe name."`
+	VariableNameRegex            string `env:"SCOPED_VARIABLE_NAME_REGEX" envDefault:"^[a-zA-Z][a-zA-Z0-9_-]{0,62}[a-zA-Z0-9]$" description:"Regex for scoped variable name that must passed this regex."`
+	VariableCacheEnabled         bool   `env:"VARIABLE_CACHE_ENABLED" envDefault:"true" description:"This is used to  control caching of all the scope variables defined in the system."`
+	SystemVariablePrefix         string `env:"SYSTEM_VAR_PREFIX" envDefault:"DEVTRON_" description:"Scoped variable prefix, variable name must have this prefix."`
+	ScopedVariableFormat         string `env:"SCOPED_VARIABLE_FORMAT" envDefault:"@{{%s}}" description:"Its a scope format for varialbe name."`
+	VariableSetApprovalRequired  bool   `env:"SCOPED_VARIABLE_SET_APPROVAL_REQUIRED" envDefault:"false" description:"When enabled, uploaded variables are saved only after another super admin approves the version."`
+	VariableSetAllowSelfApproval bool   `env:"SCOPED_VARIABLE_SET_ALLOW_SELF_APPROVAL" envDefault:"false" description:"Allows the uploader of a variable set version to approve it, used with SCOPED_VARIABLE_SET_APPROVAL_REQUIRED."`
 }
 
 func loadVariableCache(cfg *VariableConfig, service *ScopedVariableServiceImpl) {
@@ -150,30 +178,28 @@ func (impl *ScopedVariableServiceImpl) CheckForSensitiveVariables(variableNames
 	return varNameToIsSensitive, nil
 }
 
-func (impl *ScopedVariableServiceImpl) CreateVariables(payload models.Payload) error {
+func (impl *ScopedVariableServiceImpl) ValidateVariables(payload models.Payload) error {
 	err, _ := impl.isValidPayload(payload)
 	if err != nil {
 		impl.logger.Errorw("error in variable payload validation", "err", err)
 		return err
 	}
-
-	auditLog := resourceQualifiers.GetAuditLog(payload.UserId)
-	// Begin Transaction
-	tx, err := impl.scopedVariableRepository.StartTx()
+	_, err = impl.getIdentifierIdsForPayload(payload)
 	if err != nil {
-		impl.logger.Errorw("error in starting transaction of variable creation", "err", err)
+		impl.logger.Errorw("error in resolving attribute params of variable payload", "err", err)
 		return err
 	}
-	// Rollback Transaction in case of any error
-	defer func(scopedVariableRepository repository2.ScopedVariableRepository, tx *pg.Tx) {
-		err = scopedVariableRepository.RollbackTx(tx)
-		if err != nil {
-			impl.logger.Errorw("error in rollback transaction of variable creation", "err", err)
-			return
-		}
-	}(impl.scopedVariableRepository, tx)
+	return nil
+}
+
+func (impl *ScopedVariableServiceImpl) CreateVariables(payload models.Payload) error {
+	_, err := impl.CreateVariableSetVersion(payload, "")
+	return err
+}
 
-	err = impl.qualifierMappingService.DeleteAllQualifierMappings(resourceQualifiers.Variable, auditLog, tx)
+// saveVariables replaces all the saved variables with the variables of the payload
+func (impl *ScopedVariableServiceImpl) saveVariables(payload models.Payload, identifierNameToId map[models.IdentifierType]map[string]int, auditLog sql.AuditLog, tx *pg.Tx) error {
+	err := impl.qualifierMappingService.DeleteAllQualifierMappings(resourceQualifiers.Variable, auditLog, tx)
 	if err != nil {
 		impl.logger.Errorw("error in deleting qualifier mappings", "err", err)
 		return err
@@ -189,7 +215,7 @@ func (impl *ScopedVariableServiceImpl) CreateVariables(payload models.Payload) e
 			return err
 		}
 
-		scopeIdToVarData, err := impl.createVariableScopes(payload, varNameIdMap, auditLog.CreatedBy, tx)
+		scopeIdToVarData, err := impl.createVariableScopes(payload, varNameIdMap, identifierNameToId, auditLog.CreatedBy, tx)
 		if err != nil {
 			return err
 		}
@@ -197,23 +223,17 @@ func (impl *ScopedVariableServiceImpl) CreateVariables(payload models.Payload) e
 		if err != nil {
 			return err
 		}
-
 	}
-	err = impl.scopedVariableRepository.CommitTx(tx)
-	if err != nil {
-		impl.logger.Errorw("error in committing transaction of variable creation", "err", err)
-		return err
-	}
-	loadVariableCache(impl.VariableNameConfig, impl)
 	return nil
 }
 
-func (impl *ScopedVariableServiceImpl) storeVariableData(scopeIdToVarData map[int]string, auditLog sql.AuditLog, tx *pg.Tx) error {
+func (impl *ScopedVariableServiceImpl) storeVariableData(scopeIdToVarData map[int]*models.VariableScope, auditLog sql.AuditLog, tx *pg.Tx) error {
 	VariableDataList := make([]*repository2.VariableData, 0)
 	for scopeId, data := range scopeIdToVarData {
 		varData := &repository2.VariableData{
 			VariableScopeId: scopeId,
-			Data:            data,
+			Data:            data.Data,
+			ValueSource:     data.ValueSource,
 			AuditLog:        auditLog,
 		}
 		VariableDataList = append(VariableDataList, varData)
@@ -246,23 +266,108 @@ func (impl *ScopedVariableServiceImpl) storeVariableDefinitions(payload models.P
 	return variableNameToId, nil
 }
 
-func (impl *ScopedVariableServiceImpl) createVariableScopes(payload models.Payload, variableNameToId map[string]int, userId int32, tx *pg.Tx) (map[int]string, error) {
+// getIdentifierIdsForPayload resolves the app, env and cluster names used in attribute params to their ids
+func (impl *ScopedVariableServiceImpl) getIdentifierIdsForPayload(payload models.Payload) (map[models.IdentifierType]map[string]int, error) {
+	identifierTypeToNames := make(map[models.IdentifierType][]string)
+	for _, variable := range payload.Variables {
+		for _, value := range variable.AttributeValues {
+			for identifierType, name := range value.AttributeParams {
+				if !slices.Contains(identifierTypeToNames[identifierType], name) {
+					identifierTypeToNames[identifierType] = append(identifierTypeToNames[identifierType], name)
+				}
+			}
+		}
+	}
+	identifierNameToId := make(map[models.IdentifierType]map[string]int, len(identifierTypeToNames))
+	for identifierType, names := range identifierTypeToNames {
+		nameToId, err := impl.getIdsForIdentifierNames(identifierType, names)
+		if err != nil {
+			return nil, err
+		}
+		for _, name := range names {
+			if _, ok := nameToId[name]; !ok {
+				return nil, models.ValidationError{Err: fmt.Errorf("%s %s does not exist", identifierType, name)}
+			}
+		}
+		identifierNameToId[identifierType] = nameToId
+	}
+	return identifierNameToId, nil
+}
+
+func (impl *ScopedVariableServiceImpl) getIdsForIdentifierNames(identifierType models.IdentifierType, names []string) (map[string]int, error) {
+	nameToId := make(map[string]int, len(names))
+	switch identifierType {
+	case models.ApplicationName:
+		apps, err := impl.appRepository.FindByNames(names)
+		if err != nil && err != pg.ErrNoRows {
+			impl.logger.Errorw("error in fetching apps by names", "appNames", names, "err", err)
+			return nil, err
+		}
+		for _, application := range apps {
+			nameToId[application.AppName] = application.Id
+		}
+	case models.EnvName:
+		environments, err := impl.environmentRepository.FindByNames(names)
+		if err != nil && err != pg.ErrNoRows {
+			impl.logger.Errorw("error in fetching environments by names", "envNames", names, "err", err)
+			return nil, err
+		}
+		for _, environment := range environments {
+			nameToId[environment.Name] = environment.Id
+		}
+	case models.ClusterName:
+		clusters, err := impl.clusterRepository.FindByNames(names)
+		if err != nil && err != pg.ErrNoRows {
+			impl.logger.Errorw("error in fetching clusters by names", "clusterNames", names, "err", err)
+			return nil, err
+		}
+		for _, cluster := range clusters {
+			nameToId[cluster.ClusterName] = cluster.Id
+		}
+	}
+	return nameToId, nil
+}
+
+func getSelectionIdentifier(attributeParams map[models.IdentifierType]string, identifierNameToId map[models.IdentifierType]map[string]int) *resourceQualifiers.SelectionIdentifier {
+	appName := attributeParams[models.ApplicationName]
+	envName := attributeParams[models.EnvName]
+	clusterName := attributeParams[models.ClusterName]
+	return &resourceQualifiers.SelectionIdentifier{
+		AppId:     identifierNameToId[models.ApplicationName][appName],
+		EnvId:     identifierNameToId[models.EnvName][envName],
+		ClusterId: identifierNameToId[models.ClusterName][clusterName],
+		SelectionIdentifierName: &resourceQualifiers.SelectionIdentifierName{
+			AppName:         appName,
+			EnvironmentName: envName,
+			ClusterName:     clusterName,
+		},
+	}
+}
+
+func (impl *ScopedVariableServiceImpl) createVariableScopes(payload models.Payload, variableNameToId map[string]int, identifierNameToId map[models.IdentifierType]map[string]int, userId int32, tx *pg.Tx) (map[int]*models.VariableScope, error) {
 
 	variableScopes := make([]*models.VariableScope, 0)
 	for _, variable := range payload.Variables {
 		variableId := variableNameToId[variable.Definition.VarName]
 		for _, value := range variable.AttributeValues {
-			varValue, err := utils.StringifyValue(value.VariableValue.Value)
-			if err != nil {
-				return nil, err
+			var varValue string
+			// values fetched from external sources are never stored, only the reference is persisted
+			if value.ValueSource == nil {
+				var err error
+				varValue, err = utils.StringifyValue(value.VariableValue.Value)
+				if err != nil {
+					return nil, err
+				}
 			}
-			selector := resourceQualifiers.GlobalSelector
+			selector := helper.GetQualifierSelector(value.AttributeType)
 			varScope := &models.VariableScope{
-				Data: varValue,
+				Data:        varValue,
+				ValueSource: value.ValueSource,
 				ResourceMappingSelection: &resourceQualifiers.ResourceMappingSelection{
-					ResourceType:      resourceQualifiers.Variable,
-					ResourceId:        variableId,
-					QualifierSelector: selector,
+					ResourceType:        resourceQualifiers.Variable,
+					ResourceId:          variableId,
+					QualifierSelector:   selector,
+					SelectionIdentifier: getSelectionIdentifier(value.AttributeParams, identifierNameToId),
 				},
 			}
 			variableScopes = append(variableScopes, varScope)
@@ -280,9 +385,9 @@ func (impl *ScopedVariableServiceImpl) createVariableScopes(payload models.Paylo
 	if err != nil {
 		return nil, err
 	}
-	scopeIdToVarData := make(map[int]string)
+	scopeIdToVarData := make(map[int]*models.VariableScope)
 	for _, savedSelection := range savedSelections {
-		scopeIdToVarData[savedSelection.Id] = varScopeToSelection[savedSelection].Data //parentVar.Data
+		scopeIdToVarData[savedSelection.Id] = varScopeToSelection[savedSelection]
 	}
 	return scopeIdToVarData, nil
 }
@@ -333,6 +438,44 @@ func (impl *ScopedVariableServiceImpl) GetScopeWithPriority(variableIdToVariable
 	return variableIdToSelectedScopeId
 }
 
+// getResolutionTrace lists the matched scopes of a variable in priority order, marking the one which supplied the value
+func (impl *ScopedVariableServiceImpl) getResolutionTrace(matchedScopes []*resourceQualifiers.QualifierMapping, selectedScopeId int, parentIdToChildScopes map[int][]*resourceQualifiers.QualifierMapping,
+	searchableKeyIdNameMap map[int]bean.DevtronResourceSearchableKeyName) *models.VariableResolutionTrace {
+	sortedScopes := slices.Clone(matchedScopes)
+	sort.SliceStable(sortedScopes, func(i, j int) bool {
+		return helper.QualifierComparator(resourceQualifiers.Qualifier(sortedScopes[i].QualifierId), resourceQualifiers.Qualifier(sortedScopes[j].QualifierId))
+	})
+	trace := &models.VariableResolutionTrace{}
+	for _, scope := range sortedScopes {
+		scopeGroup := append([]*resourceQualifiers.QualifierMapping{scope}, parentIdToChildScopes[scope.Id]...)
+		scopeTrace := getScopeTrace(scopeGroup, searchableKeyIdNameMap)
+		if scope.Id == selectedScopeId {
+			trace.ResolvedFrom = scopeTrace
+		} else {
+			trace.Overridden = append(trace.Overridden, scopeTrace)
+		}
+	}
+	return trace
+}
+
+// getScopeTrace builds the attribute type and params of a scope from its parent and child qualifier mappings
+func getScopeTrace(scopeGroup []*resourceQualifiers.QualifierMapping, searchableKeyIdNameMap map[int]bean.DevtronResourceSearchableKeyName) *models.VariableScopeTrace {
+	scopeTrace := &models.VariableScopeTrace{
+		AttributeType: helper.GetAttributeType(resourceQualifiers.Qualifier(scopeGroup[0].QualifierId)),
+	}
+	for _, scope := range scopeGroup {
+		identifierType := helper.GetIdentifierTypeForSearchableKey(searchableKeyIdNameMap[scope.IdentifierKey])
+		if len(identifierType) == 0 {
+			continue
+		}
+		if scopeTrace.AttributeParams == nil {
+			scopeTrace.AttributeParams = make(map[models.IdentifierType]string)
+		}
+		scopeTrace.AttributeParams[identifierType] = scope.IdentifierValueString
+	}
+	return scopeTrace
+}
+
 func (impl *ScopedVariableServiceImpl) selectScopeForCompoundQualifier(scopes []*resourceQualifiers.QualifierMapping, qualifier resourceQualifiers.Qualifier) *resourceQualifiers.QualifierMapping {
 	numQualifiers := resourceQualifiers.GetNumOfChildQualifiers(qualifier)
 	parentIdToChildScopes := make(map[int][]*resourceQualifiers.QualifierMapping)
@@ -425,8 +568,15 @@ func (impl *ScopedVariableServiceImpl) GetScopedVariables(scope resourceQualifie
 		return nil, err
 	}
 
+	parentIdToChildScopes := make(map[int][]*resourceQualifiers.QualifierMapping)
+	for _, scope := range varScope {
+		if scope.ParentIdentifier > 0 {
+			parentIdToChildScopes[scope.ParentIdentifier] = append(parentIdToChildScopes[scope.ParentIdentifier], scope)
+		}
+	}
 	matchedScopes := impl.GetMatchedScopedVariables(varScope)
 	variableIdToSelectedScopeId := impl.GetScopeWithPriority(matchedScopes)
+	searchableKeyIdNameMap := impl.devtronResourceSearchableKeyService.GetAllSearchableKeyIdNameMap()
 
 	scopeIds := make([]int, 0)
 	foundVarIds := make([]int, 0) // the variable IDs which have data
@@ -450,10 +600,16 @@ func (impl *ScopedVariableServiceImpl) GetScopedVariables(scope resourceQualifie
 
 	for varId, scopeId := range variableIdToSelectedScopeId {
 		var value interface{}
-		value, err = utils.DestringifyValue(scopeIdToVarData[scopeId].Data)
-		if err != nil {
-			impl.logger.Errorw("error in validating value", "err", err)
-			return nil, err
+		valueSource := scopeIdToVarData[scopeId].ValueSource
+		if valueSource != nil {
+			// external values are fetched by ScopedVariableManager at trigger time, only the reference is returned here
+			value = valueSource.GetRedactedReference()
+		} else {
+			value, err = utils.DestringifyValue(scopeIdToVarData[scopeId].Data)
+			if err != nil {
+				impl.logger.Errorw("error in validating value", "err", err)
+				return nil, err
+			}
 		}
 
 		var varValue *models.VariableValue
@@ -468,7 +624,12 @@ func (impl *ScopedVariableServiceImpl) GetScopedVariables(scope resourceQualifie
 			VariableName:     variableIdToDefinition[varId].Name,
 			ShortDescription: variableIdToDefinition[varId].ShortDescription,
 			VariableValue:    varValue,
-			IsRedacted:       isRedacted}
+			IsRedacted:       isRedacted,
+			ResolutionTrace:  impl.getResolutionTrace(matchedScopes[varId], scopeId, parentIdToChildScopes, searchableKeyIdNameMap),
+			ValueSource:      valueSource,
+			Constraints:      variableIdToDefinition[varId].Constraints,
+			DataType:         variableIdToDefinition[varId].DataType,
+		}
 
 		scopedVariableDataObj = append(scopedVariableDataObj, scopedVariableData)
 	}
@@ -489,6 +650,7 @@ func (impl *ScopedVariableServiceImpl) GetScopedVariables(scope resourceQualifie
 				usedScopedVariableDataObj = append(usedScopedVariableDataObj, &models.ScopedVariableData{
 					VariableName:     definition.Name,
 					ShortDescription: definition.ShortDescription,
+					Constraints:      definition.Constraints,
 				})
 			}
 		}
@@ -581,6 +743,7 @@ func (impl *ScopedVariableServiceImpl) GetJsonForVariables() (*models.Payload, e
 	payload := &models.Payload{
 		Variables: make([]*models.Variables, 0),
 	}
+	searchableKeyIdNameMap := impl.devtronResourceSearchableKeyService.GetAllSearchableKeyIdNameMap()
 	variables := make([]*models.Variables, 0)
 
 	varIdVsScopeMappings, varScopeIds, err := impl.getVariableScopes(dataForJson)
@@ -599,40 +762,42 @@ func (impl *ScopedVariableServiceImpl) GetJsonForVariables() (*models.Payload, e
 			VarType:          data.VarType,
 			Description:      data.Description,
 			ShortDescription: data.ShortDescription,
+			Constraints:      data.Constraints,
 		}
 		attributes := make([]models.AttributeValue, 0)
 
 		scopedVariables := varIdVsScopeMappings[data.Id]
 		scopeIdToVarScopes := make(map[int][]*resourceQualifiers.QualifierMapping)
 		for _, scope := range scopedVariables {
+			parentScopeId := scope.Id
 			if scope.ParentIdentifier != 0 {
-				scopeIdToVarScopes[scope.ParentIdentifier] = append(scopeIdToVarScopes[scope.ParentIdentifier], scope)
-			} else {
-				scopeIdToVarScopes[scope.Id] = []*resourceQualifiers.QualifierMapping{scope}
+				parentScopeId = scope.ParentIdentifier
 			}
+			scopeIdToVarScopes[parentScopeId] = append(scopeIdToVarScopes[parentScopeId], scope)
 		}
 		for parentScopeId, scopes := range scopeIdToVarScopes {
 			attribute := models.AttributeValue{
-				AttributeParams: make(map[models.IdentifierType]string),
+				AttributeParams: getScopeTrace(scopes, searchableKeyIdNameMap).AttributeParams,
 			}
 			for _, scope := range scopes {
 				scopeId := scope.Id
 				if parentScopeId == scopeId {
 					variableData := scopeIdVsDataMap[scopeId]
-					var value interface{}
-					value, err = utils.DestringifyValue(variableData.Data)
-					if err != nil {
-						return nil, err
-					}
-					attribute.VariableValue = models.VariableValue{
-						Value: value,
+					if variableData.ValueSource != nil {
+						attribute.ValueSource = variableData.ValueSource
+					} else {
+						var value interface{}
+						value, err = utils.DestringifyValue(variableData.Data)
+						if err != nil {
+							return nil, err
+						}
+						attribute.VariableValue = models.VariableValue{
+							Value: value,
+						}
 					}
 					attribute.AttributeType = helper.GetAttributeType(resourceQualifiers.Qualifier(scope.QualifierId))
 				}
 			}
-			if len(attribute.AttributeParams) == 0 {
-				attribute.AttributeParams = nil
-			}
 			attributes = append(attributes, attribute)
 		}
 
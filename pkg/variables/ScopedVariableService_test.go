@@ -0,0 +1,149 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package variables
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/devtron-labs/devtron/pkg/devtronResource/bean"
+	readMocks "github.com/devtron-labs/devtron/pkg/devtronResource/read/mocks"
+	"github.com/devtron-labs/devtron/pkg/resourceQualifiers"
+	qualifierMocks "github.com/devtron-labs/devtron/pkg/resourceQualifiers/mocks"
+	"github.com/devtron-labs/devtron/pkg/variables/cache"
+	"github.com/devtron-labs/devtron/pkg/variables/models"
+	"github.com/devtron-labs/devtron/pkg/variables/repository"
+	repositoryMocks "github.com/devtron-labs/devtron/pkg/variables/repository/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+const (
+	testAppKey     = 1
+	testEnvKey     = 2
+	testClusterKey = 3
+)
+
+func newTestScope(id int, qualifier resourceQualifiers.Qualifier, identifierKey int, identifierValue string, parentId int) *resourceQualifiers.QualifierMapping {
+	return &resourceQualifiers.QualifierMapping{Id: id, ResourceId: 1, QualifierId: int(qualifier), IdentifierKey: identifierKey,
+		IdentifierValueString: identifierValue, ParentIdentifier: parentId}
+}
+
+func scopeIds(scopes []*resourceQualifiers.QualifierMapping) []int {
+	ids := make([]int, 0, len(scopes))
+	for _, scope := range scopes {
+		ids = append(ids, scope.Id)
+	}
+	return ids
+}
+
+func TestGetMatchedScopedVariables(t *testing.T) {
+	impl := &ScopedVariableServiceImpl{}
+	tests := []struct {
+		name     string
+		scopes   []*resourceQualifiers.QualifierMapping
+		expected []int
+	}{
+		{
+			name: "app and env value is matched when both the app and the env rows are fetched",
+			scopes: []*resourceQualifiers.QualifierMapping{
+				newTestScope(1, resourceQualifiers.APP_AND_ENV_QUALIFIER, testAppKey, "payments", 0),
+				newTestScope(2, resourceQualifiers.APP_AND_ENV_QUALIFIER, testEnvKey, "prod", 1),
+				newTestScope(3, resourceQualifiers.GLOBAL_QUALIFIER, 0, "", 0),
+			},
+			expected: []int{3, 1},
+		},
+		{
+			name: "app and env value of another env is dropped when only the app row is fetched",
+			scopes: []*resourceQualifiers.QualifierMapping{
+				newTestScope(1, resourceQualifiers.APP_AND_ENV_QUALIFIER, testAppKey, "payments", 0),
+				newTestScope(3, resourceQualifiers.APP_QUALIFIER, testAppKey, "payments", 0),
+			},
+			expected: []int{3},
+		},
+		{
+			name: "app and env value of another app is dropped when only the env row is fetched",
+			scopes: []*resourceQualifiers.QualifierMapping{
+				newTestScope(2, resourceQualifiers.APP_AND_ENV_QUALIFIER, testEnvKey, "prod", 1),
+				newTestScope(3, resourceQualifiers.ENV_QUALIFIER, testEnvKey, "prod", 0),
+			},
+			expected: []int{3},
+		},
+		{
+			name: "only the fully matched app and env value is kept",
+			scopes: []*resourceQualifiers.QualifierMapping{
+				newTestScope(1, resourceQualifiers.APP_AND_ENV_QUALIFIER, testAppKey, "payments", 0),
+				newTestScope(4, resourceQualifiers.APP_AND_ENV_QUALIFIER, testAppKey, "payments", 0),
+				newTestScope(5, resourceQualifiers.APP_AND_ENV_QUALIFIER, testEnvKey, "prod", 4),
+				newTestScope(7, resourceQualifiers.APP_AND_ENV_QUALIFIER, testEnvKey, "prod", 6),
+			},
+			expected: []int{4},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matchedScopes := impl.GetMatchedScopedVariables(tt.scopes)
+			assert.Equal(t, tt.expected, scopeIds(matchedScopes[1]))
+		})
+	}
+}
+
+func TestGetScopedVariablesResolutionTrace(t *testing.T) {
+	scopedVariableRepository := repositoryMocks.NewScopedVariableRepository(t)
+	scopedVariableRepository.On("GetAllVariables").Return([]*repository.VariableDefinition{{Id: 1, Name: "replicas", VarType: models.PUBLIC}}, nil)
+	scopedVariableRepository.On("GetDataForScopeIds", []int{1}).Return([]*repository.VariableData{{VariableScopeId: 1, Data: "5"}}, nil).Once()
+	qualifierMappingService := qualifierMocks.NewQualifierMappingService(t)
+	qualifierMappingService.On("GetQualifierMappings", resourceQualifiers.Variable, mock.Anything, []int{1}).Return([]*resourceQualifiers.QualifierMapping{
+		newTestScope(6, resourceQualifiers.GLOBAL_QUALIFIER, 0, "", 0),
+		newTestScope(3, resourceQualifiers.APP_QUALIFIER, testAppKey, "payments", 0),
+		newTestScope(5, resourceQualifiers.CLUSTER_QUALIFIER, testClusterKey, "default_cluster", 0),
+		newTestScope(1, resourceQualifiers.APP_AND_ENV_QUALIFIER, testAppKey, "payments", 0),
+		newTestScope(2, resourceQualifiers.APP_AND_ENV_QUALIFIER, testEnvKey, "prod", 1),
+		newTestScope(4, resourceQualifiers.ENV_QUALIFIER, testEnvKey, "prod", 0),
+		// app and env value of payments in another env, it neither supplies nor is overridden
+		newTestScope(7, resourceQualifiers.APP_AND_ENV_QUALIFIER, testAppKey, "payments", 0),
+	}, nil).Once()
+	searchableKeyService := readMocks.NewDevtronResourceSearchableKeyService(t)
+	searchableKeyService.On("GetAllSearchableKeyIdNameMap").Return(map[int]bean.DevtronResourceSearchableKeyName{
+		testAppKey:     bean.DEVTRON_RESOURCE_SEARCHABLE_KEY_APP_ID,
+		testEnvKey:     bean.DEVTRON_RESOURCE_SEARCHABLE_KEY_ENV_ID,
+		testClusterKey: bean.DEVTRON_RESOURCE_SEARCHABLE_KEY_CLUSTER_ID,
+	})
+	impl := &ScopedVariableServiceImpl{
+		logger:                              zap.NewNop().Sugar(),
+		scopedVariableRepository:            scopedVariableRepository,
+		qualifierMappingService:             qualifierMappingService,
+		devtronResourceSearchableKeyService: searchableKeyService,
+		VariableCache:                       &cache.VariableCacheObj{CacheLock: &sync.Mutex{}},
+	}
+
+	scopedVariables, err := impl.GetScopedVariables(resourceQualifiers.Scope{AppId: 10, EnvId: 20, ClusterId: 30}, []string{"replicas"}, false)
+	assert.NoError(t, err)
+	assert.Len(t, scopedVariables, 1)
+	assert.Equal(t, "5", scopedVariables[0].VariableValue.StringValue())
+	assert.Equal(t, &models.VariableResolutionTrace{
+		ResolvedFrom: &models.VariableScopeTrace{AttributeType: models.ApplicationEnv,
+			AttributeParams: map[models.IdentifierType]string{models.ApplicationName: "payments", models.EnvName: "prod"}},
+		Overridden: []*models.VariableScopeTrace{
+			{AttributeType: models.Env, AttributeParams: map[models.IdentifierType]string{models.EnvName: "prod"}},
+			{AttributeType: models.Application, AttributeParams: map[models.IdentifierType]string{models.ApplicationName: "payments"}},
+			{AttributeType: models.Cluster, AttributeParams: map[models.IdentifierType]string{models.ClusterName: "default_cluster"}},
+			{AttributeType: models.Global},
+		},
+	}, scopedVariables[0].ResolutionTrace)
+}
@@ -44,11 +44,28 @@ func (impl *ScopedVariableServiceImpl) isValidPayload(payload models.Payload) (e
 			return models.ValidationError{Err: fmt.Errorf("%s does not match the required format (Alphanumeric, 64 characters max, no hyphen/underscore at start/end)", variable.Definition.VarName)}, false
 		}
 		variableNamesList = append(variableNamesList, variable.Definition.VarName)
+		constraints := variable.Definition.Constraints
+		if err := constraints.Validate(variable.Definition.DataType); err != nil {
+			return models.ValidationError{Err: fmt.Errorf("invalid constraints for %s: %v", variable.Definition.VarName, err)}, false
+		}
+		if constraints != nil && constraints.ValueType != "" && constraints.ValueType != models.StringValueType && variable.Definition.VarType.IsTypeSensitive() {
+			return models.ValidationError{Err: fmt.Errorf("%s is sensitive, only string value type is supported for sensitive variables", variable.Definition.VarName)}, false
+		}
 		uniqueVariableMap := make(map[string]interface{})
 		for _, attributeValue := range variable.AttributeValues {
 
-			if !utils.IsStringType(attributeValue.VariableValue.Value) && variable.Definition.VarType.IsTypeSensitive() {
+			if attributeValue.ValueSource != nil {
+				if !variable.Definition.VarType.IsTypeSensitive() {
+					return models.ValidationError{Err: fmt.Errorf("%s must be sensitive to use values from external sources", variable.Definition.VarName)}, false
+				}
+				if err := attributeValue.ValueSource.Validate(); err != nil {
+					return models.ValidationError{Err: err}, false
+				}
+			} else if !utils.IsStringType(attributeValue.VariableValue.Value) && variable.Definition.VarType.IsTypeSensitive() {
 				return models.ValidationError{Err: fmt.Errorf("data type other than string cannot be sensitive")}, false
+			} else if models.IsExternalValueReference(attributeValue.VariableValue.Value) {
+				// references are only created from value sources, a plain value like this would get fetched from the external source
+				return models.ValidationError{Err: fmt.Errorf("value of %s cannot start with %s, use valueSource for values from external sources", variable.Definition.VarName, models.ExternalValueReferencePrefix)}, false
 			}
 
 			validIdentifierTypeList := helper.GetIdentifierTypeFromAttributeType(attributeValue.AttributeType)
@@ -60,6 +77,13 @@ func (impl *ScopedVariableServiceImpl) isValidPayload(payload models.Payload) (e
 					return models.ValidationError{Err: fmt.Errorf("invalid attribute selector key %s", key)}, false
 				}
 			}
+			// values from external sources are only known at trigger time, they are checked when templates are resolved
+			if attributeValue.ValueSource == nil {
+				if err := constraints.ValidateValue(variable.Definition.DataType, attributeValue.VariableValue.Value); err != nil {
+					scopeDescription := models.GetScopeDescription(attributeValue.AttributeType, attributeValue.AttributeParams)
+					return models.ValidationError{Err: fmt.Errorf("value of %s for %s is invalid: %v", variable.Definition.VarName, scopeDescription, err)}, false
+				}
+			}
 			identifierString := fmt.Sprintf("%s-%s", variable.Definition.VarName, string(attributeValue.AttributeType))
 			for _, key := range validIdentifierTypeList {
 				identifierString = fmt.Sprintf("%s-%s", identifierString, attributeValue.AttributeParams[key])
@@ -0,0 +1,59 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package variables
+
+import (
+	"testing"
+
+	"github.com/devtron-labs/devtron/pkg/variables/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsValidPayloadExternalValueReference(t *testing.T) {
+	cfg, err := GetVariableNameConfig()
+	assert.NoError(t, err)
+	impl := &ScopedVariableServiceImpl{VariableNameConfig: cfg}
+	source := &models.ExternalValueSource{Backend: models.AWSSecretsManager, Path: "prod/db"}
+
+	t.Run("plain value carrying the reference prefix is rejected", func(t *testing.T) {
+		payload := models.Payload{Variables: []*models.Variables{
+			newTestVariable("dbPassword", models.PRIVATE, newTestAttributeValue(source.GetRedactedReference(), models.Global, nil)),
+		}}
+		err, ok := impl.isValidPayload(payload)
+		assert.False(t, ok)
+		assert.Error(t, err)
+	})
+	t.Run("value source is accepted for sensitive variables", func(t *testing.T) {
+		attributeValue := newTestAttributeValue(nil, models.Global, nil)
+		attributeValue.ValueSource = source
+		payload := models.Payload{Variables: []*models.Variables{newTestVariable("dbPassword", models.PRIVATE, attributeValue)}}
+		err, ok := impl.isValidPayload(payload)
+		assert.True(t, ok)
+		assert.NoError(t, err)
+	})
+}
+
+func TestSetValueSourcesFromSnapshotReferences(t *testing.T) {
+	source := &models.ExternalValueSource{Backend: models.AWSSecretsManager, Path: "prod/db", Version: "v2"}
+	captured := &models.ScopedVariableData{VariableName: "dbPassword", VariableValue: &models.VariableValue{Value: source.GetRedactedReference()}}
+	plain := &models.ScopedVariableData{VariableName: "region", VariableValue: &models.VariableValue{Value: "us-east-1"}}
+	redacted := &models.ScopedVariableData{VariableName: "apiKey", IsRedacted: true, VariableValue: &models.VariableValue{Value: source.GetRedactedReference()}}
+	setValueSourcesFromSnapshotReferences([]*models.ScopedVariableData{captured, plain, redacted})
+	assert.Equal(t, source, captured.ValueSource)
+	assert.Nil(t, plain.ValueSource)
+	assert.Nil(t, redacted.ValueSource)
+}
@@ -0,0 +1,572 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package variables
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/devtron-labs/devtron/internal/util"
+	"github.com/devtron-labs/devtron/pkg/resourceQualifiers"
+	"github.com/devtron-labs/devtron/pkg/sql"
+	"github.com/devtron-labs/devtron/pkg/variables/models"
+	repository2 "github.com/devtron-labs/devtron/pkg/variables/repository"
+	"github.com/devtron-labs/devtron/pkg/variables/utils"
+	"github.com/go-pg/pg"
+)
+
+func (impl *ScopedVariableServiceImpl) CreateVariableSetVersion(payload models.Payload, comment string) (*models.VariableSetVersion, error) {
+	err, _ := impl.isValidPayload(payload)
+	if err != nil {
+		impl.logger.Errorw("error in variable payload validation", "err", err)
+		return nil, err
+	}
+	identifierNameToId, err := impl.getIdentifierIdsForPayload(payload)
+	if err != nil {
+		impl.logger.Errorw("error in resolving attribute params of variable payload", "err", err)
+		return nil, err
+	}
+	return impl.createVariableSetVersion(payload, identifierNameToId, impl.getNewVariableSetVersionStatus(), comment, 0)
+}
+
+// getNewVariableSetVersionStatus returns the status of uploaded and rolled back versions, they are activated only after approval when required
+func (impl *ScopedVariableServiceImpl) getNewVariableSetVersionStatus() models.VariableSetVersionStatus {
+	if impl.VariableNameConfig.VariableSetApprovalRequired {
+		return models.VariableSetVersionPending
+	}
+	return models.VariableSetVersionActive
+}
+
+func (impl *ScopedVariableServiceImpl) GetVariableSetVersions() ([]*models.VariableSetVersion, error) {
+	versions, err := impl.variableSetVersionRepository.FindAll()
+	if err != nil {
+		return nil, err
+	}
+	userIdToEmail := impl.getUserEmails(versions...)
+	result := make([]*models.VariableSetVersion, 0, len(versions))
+	for _, version := range versions {
+		result = append(result, toVariableSetVersion(version, userIdToEmail))
+	}
+	return result, nil
+}
+
+func (impl *ScopedVariableServiceImpl) GetVariableSetVersion(id int) (*models.VariableSetVersion, error) {
+	version, err := impl.getVariableSetVersionById(id)
+	if err != nil {
+		return nil, err
+	}
+	payload, err := decodeVariableSetPayload(version.Payload)
+	if err != nil {
+		impl.logger.Errorw("error in decoding payload of variable set version", "id", id, "err", err)
+		return nil, err
+	}
+	result := toVariableSetVersion(version, impl.getUserEmails(version))
+	result.Payload = payload
+	return result, nil
+}
+
+func (impl *ScopedVariableServiceImpl) ReviewVariableSetVersion(request *models.VariableSetReviewRequest, userId int32) (*models.VariableSetVersion, error) {
+	tx, err := impl.scopedVariableRepository.StartTx()
+	if err != nil {
+		impl.logger.Errorw("error in starting transaction of variable set review", "err", err)
+		return nil, err
+	}
+	defer impl.rollbackVariableSetTx(tx)
+	err = impl.variableSetVersionRepository.LockVariableSet(tx)
+	if err != nil {
+		return nil, err
+	}
+	version, err := impl.getVariableSetVersionForUpdate(request.VersionId, tx)
+	if err != nil {
+		return nil, err
+	}
+	if version.Status != models.VariableSetVersionPending {
+		return nil, util.NewApiError(http.StatusConflict, fmt.Sprintf("variable set version %d is %s", version.Version, version.Status), "variable set version is not pending")
+	}
+	var activeVersion *repository2.VariableSetVersion
+	var payload *models.Payload
+	var identifierNameToId map[models.IdentifierType]map[string]int
+	if request.Approve {
+		if version.CreatedBy == userId && !impl.VariableNameConfig.VariableSetAllowSelfApproval {
+			return nil, util.NewApiError(http.StatusForbidden, "variable set version cannot be approved by its uploader", "self approval of variable set version")
+		}
+		activeVersion, err = impl.getActiveVariableSetVersion(tx)
+		if err != nil {
+			return nil, err
+		}
+		// the diff shown for approval is against the base version, approving it over any other version would hide changes
+		if getVariableSetVersionId(activeVersion) != version.BaseVersionId {
+			return nil, util.NewApiError(http.StatusConflict, fmt.Sprintf("variables were updated after version %d was uploaded, please upload it again", version.Version), "base version of variable set version is not active")
+		}
+		payload, err = decodeVariableSetPayload(version.Payload)
+		if err != nil {
+			impl.logger.Errorw("error in decoding payload of variable set version", "id", version.Id, "err", err)
+			return nil, err
+		}
+		payload.UserId = userId
+		// apps, environments or clusters used in selectors might have been deleted after upload
+		identifierNameToId, err = impl.getIdentifierIdsForPayload(*payload)
+		if err != nil {
+			impl.logger.Errorw("error in resolving attribute params of variable set version", "id", version.Id, "err", err)
+			return nil, err
+		}
+		version.Status = models.VariableSetVersionActive
+	} else {
+		version.Status = models.VariableSetVersionRejected
+	}
+	version.ReviewedBy = userId
+	version.ReviewedOn = time.Now()
+	version.ReviewComment = request.Comment
+	version.UpdateAuditLog(userId)
+	err = impl.variableSetVersionRepository.Update(version, tx)
+	if err != nil {
+		impl.logger.Errorw("error in updating variable set version", "id", version.Id, "err", err)
+		return nil, err
+	}
+	if request.Approve {
+		err = impl.activateVariableSetVersion(activeVersion, *payload, identifierNameToId, resourceQualifiers.GetAuditLog(userId), tx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	err = impl.scopedVariableRepository.CommitTx(tx)
+	if err != nil {
+		impl.logger.Errorw("error in committing transaction of variable set review", "err", err)
+		return nil, err
+	}
+	if request.Approve {
+		loadVariableCache(impl.VariableNameConfig, impl)
+	}
+	return toVariableSetVersion(version, impl.getUserEmails(version)), nil
+}
+
+func (impl *ScopedVariableServiceImpl) RollbackVariableSetVersion(request *models.VariableSetRollbackRequest, userId int32) (*models.VariableSetVersion, error) {
+	version, err := impl.getVariableSetVersionById(request.VersionId)
+	if err != nil {
+		return nil, err
+	}
+	switch version.Status {
+	case models.VariableSetVersionActive:
+		return nil, util.NewApiError(http.StatusConflict, fmt.Sprintf("variable set version %d is already active", version.Version), "variable set version is already active")
+	case models.VariableSetVersionPending, models.VariableSetVersionRejected:
+		return nil, util.NewApiError(http.StatusConflict, fmt.Sprintf("variable set version %d was never active", version.Version), "rollback to a version which was never active")
+	}
+	payload, err := decodeVariableSetPayload(version.Payload)
+	if err != nil {
+		impl.logger.Errorw("error in decoding payload of variable set version", "id", version.Id, "err", err)
+		return nil, err
+	}
+	payload.UserId = userId
+	identifierNameToId, err := impl.getIdentifierIdsForPayload(*payload)
+	if err != nil {
+		impl.logger.Errorw("error in resolving attribute params of variable set version", "id", version.Id, "err", err)
+		return nil, err
+	}
+	comment := request.Comment
+	if comment == "" {
+		comment = fmt.Sprintf("rollback to version %d", version.Version)
+	}
+	// a rollback changes the active variables like an upload, hence it goes through the same approval
+	return impl.createVariableSetVersion(*payload, identifierNameToId, impl.getNewVariableSetVersionStatus(), comment, version.Id)
+}
+
+func (impl *ScopedVariableServiceImpl) createVariableSetVersion(payload models.Payload, identifierNameToId map[models.IdentifierType]map[string]int,
+	status models.VariableSetVersionStatus, comment string, rolledBackFromVersionId int) (*models.VariableSetVersion, error) {
+	payloadJson, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	auditLog := sql.NewDefaultAuditLog(payload.UserId)
+
+	tx, err := impl.scopedVariableRepository.StartTx()
+	if err != nil {
+		impl.logger.Errorw("error in starting transaction of variable set version creation", "err", err)
+		return nil, err
+	}
+	defer impl.rollbackVariableSetTx(tx)
+	// concurrent uploads would otherwise get the same version number and base version
+	err = impl.variableSetVersionRepository.LockVariableSet(tx)
+	if err != nil {
+		return nil, err
+	}
+	activeVersion, err := impl.getActiveVariableSetVersion(tx)
+	if err != nil {
+		return nil, err
+	}
+	var basePayload *models.Payload
+	if activeVersion != nil {
+		basePayload, err = decodeVariableSetPayload(activeVersion.Payload)
+	} else {
+		basePayload, err = impl.GetJsonForVariables()
+	}
+	if err != nil {
+		impl.logger.Errorw("error in fetching active variables", "err", err)
+		return nil, err
+	}
+	latestVersion, err := impl.variableSetVersionRepository.GetLatestVersionNumber(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	if activeVersion == nil && basePayload != nil && len(basePayload.Variables) > 0 {
+		// variables saved before versioning are captured as a version so that they can be rolled back to
+		activeVersion, err = impl.saveBaselineVariableSetVersion(basePayload, latestVersion+1, auditLog, tx)
+		if err != nil {
+			return nil, err
+		}
+		latestVersion = activeVersion.Version
+	}
+	version := &repository2.VariableSetVersion{
+		Version:                 latestVersion + 1,
+		Payload:                 payloadJson,
+		Diff:                    getVariableSetDiff(basePayload, &payload),
+		Status:                  status,
+		Comment:                 comment,
+		BaseVersionId:           getVariableSetVersionId(activeVersion),
+		RolledBackFromVersionId: rolledBackFromVersionId,
+		AuditLog:                auditLog,
+	}
+	err = impl.variableSetVersionRepository.Save(version, tx)
+	if err != nil {
+		impl.logger.Errorw("error in saving variable set version", "version", version.Version, "err", err)
+		return nil, err
+	}
+	if status == models.VariableSetVersionActive {
+		err = impl.activateVariableSetVersion(activeVersion, payload, identifierNameToId, resourceQualifiers.GetAuditLog(payload.UserId), tx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	err = impl.scopedVariableRepository.CommitTx(tx)
+	if err != nil {
+		impl.logger.Errorw("error in committing transaction of variable set version creation", "err", err)
+		return nil, err
+	}
+	if status == models.VariableSetVersionActive {
+		loadVariableCache(impl.VariableNameConfig, impl)
+	}
+	return toVariableSetVersion(version, impl.getUserEmails(version)), nil
+}
+
+func (impl *ScopedVariableServiceImpl) saveBaselineVariableSetVersion(payload *models.Payload, versionNumber int, auditLog sql.AuditLog, tx *pg.Tx) (*repository2.VariableSetVersion, error) {
+	payloadJson, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	version := &repository2.VariableSetVersion{
+		Version:  versionNumber,
+		Payload:  payloadJson,
+		Diff:     getVariableSetDiff(nil, payload),
+		Status:   models.VariableSetVersionActive,
+		Comment:  "variables saved before versioning",
+		AuditLog: auditLog,
+	}
+	err = impl.variableSetVersionRepository.Save(version, tx)
+	if err != nil {
+		impl.logger.Errorw("error in saving baseline variable set version", "err", err)
+		return nil, err
+	}
+	return version, nil
+}
+
+// activateVariableSetVersion supersedes the active version and saves the variables of the new version
+func (impl *ScopedVariableServiceImpl) activateVariableSetVersion(activeVersion *repository2.VariableSetVersion, payload models.Payload,
+	identifierNameToId map[models.IdentifierType]map[string]int, auditLog sql.AuditLog, tx *pg.Tx) error {
+	if activeVersion != nil {
+		activeVersion.Status = models.VariableSetVersionSuperseded
+		activeVersion.UpdateAuditLog(auditLog.UpdatedBy)
+		err := impl.variableSetVersionRepository.Update(activeVersion, tx)
+		if err != nil {
+			impl.logger.Errorw("error in superseding variable set version", "id", activeVersion.Id, "err", err)
+			return err
+		}
+	}
+	return impl.saveVariables(payload, identifierNameToId, auditLog, tx)
+}
+
+func (impl *ScopedVariableServiceImpl) getActiveVariableSetVersion(tx *pg.Tx) (*repository2.VariableSetVersion, error) {
+	activeVersion, err := impl.variableSetVersionRepository.FindActive(tx)
+	if err == pg.ErrNoRows {
+		return nil, nil
+	} else if err != nil {
+		impl.logger.Errorw("error in fetching active variable set version", "err", err)
+		return nil, err
+	}
+	return activeVersion, nil
+}
+
+func (impl *ScopedVariableServiceImpl) getVariableSetVersionById(id int) (*repository2.VariableSetVersion, error) {
+	version, err := impl.variableSetVersionRepository.FindById(id)
+	if err == pg.ErrNoRows {
+		return nil, util.NewApiError(http.StatusNotFound, fmt.Sprintf("variable set version %d not found", id), "variable set version not found")
+	} else if err != nil {
+		impl.logger.Errorw("error in fetching variable set version", "id", id, "err", err)
+		return nil, err
+	}
+	return version, nil
+}
+
+func (impl *ScopedVariableServiceImpl) getVariableSetVersionForUpdate(id int, tx *pg.Tx) (*repository2.VariableSetVersion, error) {
+	version, err := impl.variableSetVersionRepository.FindByIdForUpdate(id, tx)
+	if err == pg.ErrNoRows {
+		return nil, util.NewApiError(http.StatusNotFound, fmt.Sprintf("variable set version %d not found", id), "variable set version not found")
+	} else if err != nil {
+		impl.logger.Errorw("error in fetching variable set version", "id", id, "err", err)
+		return nil, err
+	}
+	return version, nil
+}
+
+func (impl *ScopedVariableServiceImpl) rollbackVariableSetTx(tx *pg.Tx) {
+	err := impl.scopedVariableRepository.RollbackTx(tx)
+	if err != nil {
+		impl.logger.Errorw("error in rollback transaction of variable set version", "err", err)
+	}
+}
+
+func (impl *ScopedVariableServiceImpl) getUserEmails(versions ...*repository2.VariableSetVersion) map[int32]string {
+	userIds := make([]int32, 0)
+	for _, version := range versions {
+		userIds = append(userIds, version.CreatedBy)
+		if version.ReviewedBy > 0 {
+			userIds = append(userIds, version.ReviewedBy)
+		}
+	}
+	userIdToEmail := make(map[int32]string)
+	if len(userIds) == 0 {
+		return userIdToEmail
+	}
+	users, err := impl.userRepository.GetByIds(userIds)
+	if err != nil {
+		// emails are informational, versions are returned without them
+		impl.logger.Errorw("error in fetching users of variable set versions", "userIds", userIds, "err", err)
+		return userIdToEmail
+	}
+	for _, user := range users {
+		userIdToEmail[user.Id] = user.EmailId
+	}
+	return userIdToEmail
+}
+
+func toVariableSetVersion(version *repository2.VariableSetVersion, userIdToEmail map[int32]string) *models.VariableSetVersion {
+	result := &models.VariableSetVersion{
+		Id:                      version.Id,
+		Version:                 version.Version,
+		Status:                  version.Status,
+		Comment:                 version.Comment,
+		BaseVersionId:           version.BaseVersionId,
+		RolledBackFromVersionId: version.RolledBackFromVersionId,
+		Diff:                    version.Diff,
+		CreatedBy:               version.CreatedBy,
+		CreatedByEmail:          userIdToEmail[version.CreatedBy],
+		CreatedOn:               version.CreatedOn,
+		ReviewedBy:              version.ReviewedBy,
+		ReviewedByEmail:         userIdToEmail[version.ReviewedBy],
+		ReviewComment:           version.ReviewComment,
+	}
+	if !version.ReviewedOn.IsZero() {
+		reviewedOn := version.ReviewedOn
+		result.ReviewedOn = &reviewedOn
+	}
+	return result
+}
+
+func getVariableSetVersionId(version *repository2.VariableSetVersion) int {
+	if version == nil {
+		return 0
+	}
+	return version.Id
+}
+
+// decodeVariableSetPayload keeps numbers as json.Number, the way payloads are received from the api
+func decodeVariableSetPayload(payloadJson []byte) (*models.Payload, error) {
+	payload := &models.Payload{}
+	decoder := json.NewDecoder(bytes.NewReader(payloadJson))
+	decoder.UseNumber()
+	err := decoder.Decode(payload)
+	if err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
+func getVariableSetDiff(basePayload, payload *models.Payload) *models.VariableSetDiff {
+	baseVariables := getCanonicalVariables(basePayload)
+	variables := getCanonicalVariables(payload)
+	diff := &models.VariableSetDiff{
+		Added:    make([]string, 0),
+		Removed:  make([]string, 0),
+		Modified: make([]string, 0),
+	}
+	for name, variable := range variables {
+		if baseVariable, ok := baseVariables[name]; !ok {
+			diff.Added = append(diff.Added, name)
+		} else if baseVariable != variable {
+			diff.Modified = append(diff.Modified, name)
+		}
+	}
+	for name := range baseVariables {
+		if _, ok := variables[name]; !ok {
+			diff.Removed = append(diff.Removed, name)
+		}
+	}
+	sort.Strings(diff.Added)
+	sort.Strings(diff.Removed)
+	sort.Strings(diff.Modified)
+	diff.ValueChanges = getVariableValueChanges(basePayload, payload)
+	return diff
+}
+
+type scopedVariableValue struct {
+	attributeType   models.AttributeType
+	attributeParams map[models.IdentifierType]string
+	value           interface{}
+}
+
+// getVariableValueChanges compares the values of every variable per scope, sorted by variable name and scope
+func getVariableValueChanges(basePayload, payload *models.Payload) []*models.VariableValueChange {
+	baseValues, baseSensitiveVariables := getScopedVariableValues(basePayload)
+	values, sensitiveVariables := getScopedVariableValues(payload)
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	for name := range baseValues {
+		if _, ok := values[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	valueChanges := make([]*models.VariableValueChange, 0)
+	for _, name := range names {
+		scopeKeys := make([]string, 0)
+		for scopeKey := range values[name] {
+			scopeKeys = append(scopeKeys, scopeKey)
+		}
+		for scopeKey := range baseValues[name] {
+			if _, ok := values[name][scopeKey]; !ok {
+				scopeKeys = append(scopeKeys, scopeKey)
+			}
+		}
+		sort.Strings(scopeKeys)
+		isSensitive := baseSensitiveVariables[name] || sensitiveVariables[name]
+		for _, scopeKey := range scopeKeys {
+			baseValue, isBaseScope := baseValues[name][scopeKey]
+			value, isScope := values[name][scopeKey]
+			valueChange := &models.VariableValueChange{VariableName: name}
+			switch {
+			case !isBaseScope:
+				valueChange.Operation = models.VariableValueAdded
+				valueChange.NewValue = value.value
+			case !isScope:
+				valueChange.Operation = models.VariableValueRemoved
+				valueChange.OldValue = baseValue.value
+				value = baseValue
+			case reflect.DeepEqual(baseValue.value, value.value):
+				continue
+			default:
+				valueChange.Operation = models.VariableValueModified
+				valueChange.OldValue = baseValue.value
+				valueChange.NewValue = value.value
+			}
+			valueChange.AttributeType = value.attributeType
+			valueChange.AttributeParams = value.attributeParams
+			if isSensitive {
+				valueChange.OldValue = maskVariableValue(valueChange.OldValue)
+				valueChange.NewValue = maskVariableValue(valueChange.NewValue)
+			}
+			valueChanges = append(valueChanges, valueChange)
+		}
+	}
+	return valueChanges
+}
+
+// getScopedVariableValues returns the normalized values of every variable by scope along with the private variables
+func getScopedVariableValues(payload *models.Payload) (map[string]map[string]*scopedVariableValue, map[string]bool) {
+	nameToValues := make(map[string]map[string]*scopedVariableValue)
+	sensitiveVariables := make(map[string]bool)
+	if payload == nil {
+		return nameToValues, sensitiveVariables
+	}
+	for _, variable := range payload.Variables {
+		name := variable.Definition.VarName
+		sensitiveVariables[name] = variable.Definition.VarType.IsTypeSensitive()
+		scopeToValue := make(map[string]*scopedVariableValue, len(variable.AttributeValues))
+		for _, attributeValue := range variable.AttributeValues {
+			value := attributeValue.VariableValue.Value
+			if attributeValue.ValueSource != nil {
+				value = attributeValue.ValueSource.GetRedactedReference()
+			} else if normalizedValue, err := utils.NormalizeValue(value); err == nil {
+				value = normalizedValue
+			}
+			scopeToValue[getScopeKey(attributeValue.AttributeType, attributeValue.AttributeParams)] = &scopedVariableValue{
+				attributeType:   attributeValue.AttributeType,
+				attributeParams: attributeValue.AttributeParams,
+				value:           value,
+			}
+		}
+		nameToValues[name] = scopeToValue
+	}
+	return nameToValues, sensitiveVariables
+}
+
+func getScopeKey(attributeType models.AttributeType, attributeParams map[models.IdentifierType]string) string {
+	params := make([]string, 0, len(attributeParams))
+	for identifierType, identifierName := range attributeParams {
+		params = append(params, fmt.Sprintf("%s=%s", identifierType, identifierName))
+	}
+	sort.Strings(params)
+	return fmt.Sprintf("%s/%s", attributeType, strings.Join(params, ","))
+}
+
+func maskVariableValue(value interface{}) interface{} {
+	if value == nil {
+		return nil
+	}
+	return models.HiddenValue
+}
+
+// getCanonicalVariables returns a comparable representation of every variable of the payload,
+// values are normalized and attribute values sorted as saved variables are read back in no particular order
+func getCanonicalVariables(payload *models.Payload) map[string]string {
+	nameToVariable := make(map[string]string)
+	if payload == nil {
+		return nameToVariable
+	}
+	for _, variable := range payload.Variables {
+		definition, _ := json.Marshal(variable.Definition)
+		attributes := make([]string, 0, len(variable.AttributeValues))
+		for _, attributeValue := range variable.AttributeValues {
+			if attributeValue.ValueSource == nil {
+				if value, err := utils.NormalizeValue(attributeValue.VariableValue.Value); err == nil {
+					attributeValue.VariableValue.Value = value
+				}
+			}
+			attribute, _ := json.Marshal(attributeValue)
+			attributes = append(attributes, string(attribute))
+		}
+		sort.Strings(attributes)
+		nameToVariable[variable.Definition.VarName] = string(definition) + strings.Join(attributes, ",")
+	}
+	return nameToVariable
+}
@@ -0,0 +1,103 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package variables
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/devtron-labs/devtron/pkg/variables/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestVariable(name string, varType models.VariableType, attributeValues ...models.AttributeValue) *models.Variables {
+	return &models.Variables{
+		Definition:      models.Definition{VarName: name, DataType: models.PRIMITIVE_TYPE, VarType: varType},
+		AttributeValues: attributeValues,
+	}
+}
+
+func newTestAttributeValue(value interface{}, attributeType models.AttributeType, attributeParams map[models.IdentifierType]string) models.AttributeValue {
+	return models.AttributeValue{VariableValue: models.VariableValue{Value: value}, AttributeType: attributeType, AttributeParams: attributeParams}
+}
+
+func TestGetCanonicalVariables(t *testing.T) {
+	prodEnv := map[models.IdentifierType]string{models.EnvName: "prod"}
+	payload := &models.Payload{Variables: []*models.Variables{
+		newTestVariable("replicas", models.PUBLIC,
+			newTestAttributeValue(json.Number("3"), models.Env, prodEnv),
+			newTestAttributeValue(json.Number("1"), models.Global, nil)),
+	}}
+	// same variable read back from the db, attribute values in another order and numbers decoded as int
+	savedPayload := &models.Payload{Variables: []*models.Variables{
+		newTestVariable("replicas", models.PUBLIC,
+			newTestAttributeValue(1, models.Global, nil),
+			newTestAttributeValue(3, models.Env, prodEnv)),
+	}}
+	canonicalVariables := getCanonicalVariables(payload)
+	assert.Len(t, canonicalVariables, 1)
+	assert.Equal(t, canonicalVariables, getCanonicalVariables(savedPayload))
+
+	changedPayload := &models.Payload{Variables: []*models.Variables{
+		newTestVariable("replicas", models.PUBLIC,
+			newTestAttributeValue(1, models.Global, nil),
+			newTestAttributeValue(4, models.Env, prodEnv)),
+	}}
+	assert.NotEqual(t, canonicalVariables, getCanonicalVariables(changedPayload))
+	assert.Empty(t, getCanonicalVariables(nil))
+}
+
+func TestGetVariableSetDiff(t *testing.T) {
+	prodEnv := map[models.IdentifierType]string{models.EnvName: "prod"}
+	basePayload := &models.Payload{Variables: []*models.Variables{
+		newTestVariable("replicas", models.PUBLIC,
+			newTestAttributeValue(1, models.Global, nil),
+			newTestAttributeValue(3, models.Env, prodEnv)),
+		newTestVariable("dbPassword", models.PRIVATE, newTestAttributeValue("old-password", models.Global, nil)),
+		newTestVariable("region", models.PUBLIC, newTestAttributeValue("us-east-1", models.Global, nil)),
+		newTestVariable("legacyFlag", models.PUBLIC, newTestAttributeValue(true, models.Global, nil)),
+	}}
+	payload := &models.Payload{Variables: []*models.Variables{
+		newTestVariable("replicas", models.PUBLIC,
+			newTestAttributeValue(json.Number("2"), models.Global, nil),
+			newTestAttributeValue(json.Number("3"), models.Env, prodEnv)),
+		newTestVariable("dbPassword", models.PRIVATE, newTestAttributeValue("new-password", models.Global, nil)),
+		newTestVariable("region", models.PUBLIC, newTestAttributeValue("us-east-1", models.Global, nil)),
+		newTestVariable("timeout", models.PUBLIC, newTestAttributeValue(json.Number("30"), models.Env, prodEnv)),
+	}}
+
+	diff := getVariableSetDiff(basePayload, payload)
+	assert.Equal(t, []string{"timeout"}, diff.Added)
+	assert.Equal(t, []string{"legacyFlag"}, diff.Removed)
+	assert.Equal(t, []string{"dbPassword", "replicas"}, diff.Modified)
+	assert.Equal(t, []*models.VariableValueChange{
+		{VariableName: "dbPassword", AttributeType: models.Global, Operation: models.VariableValueModified, OldValue: models.HiddenValue, NewValue: models.HiddenValue},
+		{VariableName: "legacyFlag", AttributeType: models.Global, Operation: models.VariableValueRemoved, OldValue: true},
+		{VariableName: "replicas", AttributeType: models.Global, Operation: models.VariableValueModified, OldValue: 1, NewValue: 2},
+		{VariableName: "timeout", AttributeType: models.Env, AttributeParams: prodEnv, Operation: models.VariableValueAdded, NewValue: 30},
+	}, diff.ValueChanges)
+
+	initialDiff := getVariableSetDiff(nil, basePayload)
+	assert.Equal(t, []string{"dbPassword", "legacyFlag", "region", "replicas"}, initialDiff.Added)
+	assert.Len(t, initialDiff.ValueChanges, 5)
+
+	noChangeDiff := getVariableSetDiff(basePayload, basePayload)
+	assert.Empty(t, noChangeDiff.Added)
+	assert.Empty(t, noChangeDiff.Removed)
+	assert.Empty(t, noChangeDiff.Modified)
+	assert.Empty(t, noChangeDiff.ValueChanges)
+}
@@ -29,6 +29,7 @@ import (
 type VariableEntityMappingService interface {
 	UpdateVariablesForEntity(variableNames []string, entity repository.Entity, userId int32, tx *pg.Tx) error
 	GetAllMappingsForEntities(entities []repository.Entity) (map[repository.Entity][]string, error)
+	GetAllEntitiesForVariable(variableName string) ([]repository.Entity, error)
 	DeleteMappingsForEntities(entities []repository.Entity, userId int32, tx *pg.Tx) error
 }
 
@@ -135,6 +136,19 @@ func (impl VariableEntityMappingServiceImpl) GetAllMappingsForEntities(entities
 	return entityIdToVariableNames, nil
 }
 
+func (impl VariableEntityMappingServiceImpl) GetAllEntitiesForVariable(variableName string) ([]repository.Entity, error) {
+	variableEntityMappings, err := impl.variableEntityMappingRepository.GetEntitiesForVariables([]string{variableName})
+	if err != nil {
+		impl.logger.Errorw("error in fetching mappings for variable", "variableName", variableName, "err", err)
+		return nil, err
+	}
+	entities := make([]repository.Entity, 0, len(variableEntityMappings))
+	for _, mapping := range variableEntityMappings {
+		entities = append(entities, mapping.Entity)
+	}
+	return entities, nil
+}
+
 func (impl VariableEntityMappingServiceImpl) DeleteMappingsForEntities(entities []repository.Entity, userId int32, tx *pg.Tx) error {
 	err := impl.variableEntityMappingRepository.DeleteAllVariablesForEntities(tx, entities, userId)
 	if err != nil {
@@ -0,0 +1,603 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package variables
+
+import (
+	"reflect"
+
+	"github.com/devtron-labs/devtron/api/bean"
+	"github.com/devtron-labs/devtron/internal/sql/repository/app"
+	"github.com/devtron-labs/devtron/internal/sql/repository/chartConfig"
+	"github.com/devtron-labs/devtron/internal/sql/repository/pipelineConfig"
+	chartRepoRepository "github.com/devtron-labs/devtron/pkg/chartRepo/repository"
+	repository3 "github.com/devtron-labs/devtron/pkg/cluster/environment/repository"
+	"github.com/devtron-labs/devtron/pkg/cluster/repository"
+	repository4 "github.com/devtron-labs/devtron/pkg/pipeline/repository"
+	"github.com/devtron-labs/devtron/pkg/resourceQualifiers"
+	"github.com/devtron-labs/devtron/pkg/variables/helper"
+	"github.com/devtron-labs/devtron/pkg/variables/models"
+	"github.com/devtron-labs/devtron/pkg/variables/parsers"
+	repository2 "github.com/devtron-labs/devtron/pkg/variables/repository"
+	"github.com/devtron-labs/devtron/pkg/variables/utils"
+	"github.com/devtron-labs/devtron/util"
+	"github.com/go-pg/pg"
+	"go.uber.org/zap"
+)
+
+type VariableUsageService interface {
+	// GetVariableUsage lists the deployment templates, config maps, secrets and pipeline scripts using the variable
+	// along with the value currently resolved for every app/env they get rendered for
+	GetVariableUsage(variableName string) (*models.VariableUsage, error)
+	// GetVariableImpact renders the deployment templates, config maps and secrets using the variable with the current
+	// and the proposed definition and marks the rendered manifests which would change if the definition is saved
+	GetVariableImpact(request *models.VariableImpactRequest) (*models.VariableUsage, error)
+}
+
+type VariableUsageServiceImpl struct {
+	logger                       *zap.SugaredLogger
+	variableEntityMappingService VariableEntityMappingService
+	scopedVariableService        ScopedVariableService
+	scopedVariableManager        ScopedVariableManager
+	variableTemplateParser       parsers.VariableTemplateParser
+	chartRepository              chartRepoRepository.ChartRepository
+	envConfigOverrideRepository  chartConfig.EnvConfigOverrideRepository
+	configMapRepository          chartConfig.ConfigMapRepository
+	pipelineStageRepository      repository4.PipelineStageRepository
+	pipelineRepository           pipelineConfig.PipelineRepository
+	ciPipelineRepository         pipelineConfig.CiPipelineRepository
+	appRepository                app.AppRepository
+	environmentRepository        repository3.EnvironmentRepository
+	clusterRepository            repository.ClusterRepository
+}
+
+func NewVariableUsageServiceImpl(logger *zap.SugaredLogger, variableEntityMappingService VariableEntityMappingService,
+	scopedVariableService ScopedVariableService, scopedVariableManager ScopedVariableManager, variableTemplateParser parsers.VariableTemplateParser, chartRepository chartRepoRepository.ChartRepository,
+	envConfigOverrideRepository chartConfig.EnvConfigOverrideRepository, configMapRepository chartConfig.ConfigMapRepository,
+	pipelineStageRepository repository4.PipelineStageRepository, pipelineRepository pipelineConfig.PipelineRepository,
+	ciPipelineRepository pipelineConfig.CiPipelineRepository, appRepository app.AppRepository,
+	environmentRepository repository3.EnvironmentRepository, clusterRepository repository.ClusterRepository) *VariableUsageServiceImpl {
+	return &VariableUsageServiceImpl{
+		logger:                       logger,
+		variableEntityMappingService: variableEntityMappingService,
+		scopedVariableService:        scopedVariableService,
+		scopedVariableManager:        scopedVariableManager,
+		variableTemplateParser:       variableTemplateParser,
+		chartRepository:              chartRepository,
+		envConfigOverrideRepository:  envConfigOverrideRepository,
+		configMapRepository:          configMapRepository,
+		pipelineStageRepository:      pipelineStageRepository,
+		pipelineRepository:           pipelineRepository,
+		ciPipelineRepository:         ciPipelineRepository,
+		appRepository:                appRepository,
+		environmentRepository:        environmentRepository,
+		clusterRepository:            clusterRepository,
+	}
+}
+
+type usageTargetKey struct {
+	appId int
+	envId int
+}
+
+// usageTemplate is the template of an entity using the variable, it is rendered for every target to find the impact of a proposed value
+type usageTemplate struct {
+	template     string
+	templateType parsers.VariableTemplateType
+	isSecret     bool
+}
+
+// usageContext caches the lookups shared by the entities of a single usage request
+type usageContext struct {
+	appIdToEnvIds            map[int][]int
+	appIdToOverriddenEnvIds  map[int]map[int]bool
+	targetKeyToResolvedValue map[usageTargetKey]*models.ScopedVariableData
+	usageToTemplate          map[*models.VariableEntityUsage]*usageTemplate
+}
+
+func (impl *VariableUsageServiceImpl) GetVariableUsage(variableName string) (*models.VariableUsage, error) {
+	return impl.getVariableUsage(variableName, nil)
+}
+
+func (impl *VariableUsageServiceImpl) GetVariableImpact(request *models.VariableImpactRequest) (*models.VariableUsage, error) {
+	manifest := models.ScopedVariableManifest{Spec: []models.VariableSpec{request.Spec}}
+	payload := utils.ManifestToPayload(manifest, request.UserId)
+	err := impl.scopedVariableService.ValidateVariables(payload)
+	if err != nil {
+		impl.logger.Errorw("error in validating proposed variable definition", "variableName", request.Spec.Name, "err", err)
+		return nil, err
+	}
+	return impl.getVariableUsage(request.Spec.Name, payload.Variables[0])
+}
+
+func (impl *VariableUsageServiceImpl) getVariableUsage(variableName string, proposedVariable *models.Variables) (*models.VariableUsage, error) {
+	entities, err := impl.variableEntityMappingService.GetAllEntitiesForVariable(variableName)
+	if err != nil {
+		impl.logger.Errorw("error in fetching entities using variable", "variableName", variableName, "err", err)
+		return nil, err
+	}
+	ctx := &usageContext{
+		appIdToEnvIds:            make(map[int][]int),
+		appIdToOverriddenEnvIds:  make(map[int]map[int]bool),
+		targetKeyToResolvedValue: make(map[usageTargetKey]*models.ScopedVariableData),
+		usageToTemplate:          make(map[*models.VariableEntityUsage]*usageTemplate),
+	}
+	usages := make([]*models.VariableEntityUsage, 0)
+	for _, entity := range entities {
+		usage, err := impl.getEntityUsage(entity, ctx)
+		if err != nil {
+			impl.logger.Errorw("error in fetching usage of variable for entity", "variableName", variableName, "entity", entity, "err", err)
+			return nil, err
+		}
+		// mapping of an entity which is deleted or no longer rendered
+		if usage == nil {
+			continue
+		}
+		usages = append(usages, usage)
+	}
+	variableUsage := &models.VariableUsage{
+		VariableName: variableName,
+		Usages:       usages,
+	}
+	err = impl.populateTargets(variableUsage, proposedVariable, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return variableUsage, nil
+}
+
+func (impl *VariableUsageServiceImpl) getEntityUsage(entity repository2.Entity, ctx *usageContext) (*models.VariableEntityUsage, error) {
+	usage := &models.VariableEntityUsage{EntityId: entity.EntityId}
+	switch entity.EntityType {
+	case repository2.EntityTypeDeploymentTemplateAppLevel:
+		chart, err := impl.chartRepository.FindById(entity.EntityId)
+		if err != nil {
+			return nil, ignoreNoRows(err)
+		}
+		if !chart.Latest {
+			return nil, nil
+		}
+		overriddenEnvIds, err := impl.getOverriddenEnvIds(chart.AppId, ctx)
+		if err != nil {
+			return nil, err
+		}
+		usage.EntityType = models.DeploymentTemplateUsage
+		usage.Targets, err = impl.getAppLevelTargets(chart.AppId, overriddenEnvIds, ctx)
+		if err != nil {
+			return nil, err
+		}
+		ctx.usageToTemplate[usage] = &usageTemplate{template: chart.GlobalOverride, templateType: parsers.JsonVariableTemplate}
+	case repository2.EntityTypeDeploymentTemplateEnvLevel:
+		envOverride, err := impl.envConfigOverrideRepository.GetByIdIncludingInactive(entity.EntityId)
+		if err != nil {
+			return nil, ignoreNoRows(err)
+		}
+		if !envOverride.Active || !envOverride.Latest || !envOverride.IsOverride || envOverride.Chart == nil {
+			return nil, nil
+		}
+		usage.EntityType = models.DeploymentTemplateUsage
+		usage.IsEnvOverride = true
+		usage.Targets = []*models.VariableUsageTarget{{AppId: envOverride.Chart.AppId, EnvId: envOverride.TargetEnvironment}}
+		ctx.usageToTemplate[usage] = &usageTemplate{template: envOverride.EnvOverrideValues, templateType: parsers.JsonVariableTemplate}
+	case repository2.EntityTypeConfigMapAppLevel, repository2.EntityTypeSecretAppLevel:
+		configMap, err := impl.configMapRepository.GetByIdAppLevel(entity.EntityId)
+		if err != nil {
+			return nil, ignoreNoRows(err)
+		}
+		usage.EntityType = getConfigUsageType(entity.EntityType)
+		usage.Targets, err = impl.getAppLevelTargets(configMap.AppId, nil, ctx)
+		if err != nil {
+			return nil, err
+		}
+		ctx.usageToTemplate[usage] = getConfigUsageTemplate(usage.EntityType, configMap)
+	case repository2.EntityTypeConfigMapEnvLevel, repository2.EntityTypeSecretEnvLevel:
+		configMap, err := impl.configMapRepository.GetByIdEnvLevel(entity.EntityId)
+		if err != nil {
+			return nil, ignoreNoRows(err)
+		}
+		if configMap.Deleted {
+			return nil, nil
+		}
+		usage.EntityType = getConfigUsageType(entity.EntityType)
+		usage.IsEnvOverride = true
+		usage.Targets = []*models.VariableUsageTarget{{AppId: configMap.AppId, EnvId: configMap.EnvironmentId}}
+		ctx.usageToTemplate[usage] = getConfigUsageTemplate(usage.EntityType, configMap)
+	case repository2.EntityTypePipelineStage:
+		stage, err := impl.pipelineStageRepository.GetPipelineStageById(entity.EntityId)
+		if err != nil {
+			return nil, ignoreNoRows(err)
+		}
+		usage.EntityType = models.PipelineScriptUsage
+		usage.StageType = string(stage.Type)
+		if stage.CdPipelineId > 0 {
+			cdPipeline, err := impl.pipelineRepository.FindById(stage.CdPipelineId)
+			if err != nil {
+				return nil, ignoreNoRows(err)
+			}
+			usage.CdPipelineId = cdPipeline.Id
+			usage.Targets = []*models.VariableUsageTarget{{AppId: cdPipeline.AppId, EnvId: cdPipeline.EnvironmentId}}
+		} else {
+			ciPipeline, err := impl.ciPipelineRepository.FindOneWithMinData(stage.CiPipelineId)
+			if err != nil {
+				return nil, ignoreNoRows(err)
+			}
+			if ciPipeline.Deleted {
+				return nil, nil
+			}
+			usage.CiPipelineId = ciPipeline.Id
+			usage.Targets = []*models.VariableUsageTarget{{AppId: ciPipeline.AppId}}
+		}
+	default:
+		return nil, nil
+	}
+	return usage, nil
+}
+
+// getAppLevelTargets returns a target for every environment the app is deployed to excluding skipEnvIds,
+// apps without any deployment are returned as a single target without environment
+func (impl *VariableUsageServiceImpl) getAppLevelTargets(appId int, skipEnvIds map[int]bool, ctx *usageContext) ([]*models.VariableUsageTarget, error) {
+	envIds, ok := ctx.appIdToEnvIds[appId]
+	if !ok {
+		pipelines, err := impl.pipelineRepository.FindActiveByAppId(appId)
+		if err != nil && err != pg.ErrNoRows {
+			impl.logger.Errorw("error in fetching cd pipelines of app", "appId", appId, "err", err)
+			return nil, err
+		}
+		envIds = make([]int, 0, len(pipelines))
+		for _, pipeline := range pipelines {
+			envIds = append(envIds, pipeline.EnvironmentId)
+		}
+		ctx.appIdToEnvIds[appId] = envIds
+	}
+	if len(envIds) == 0 {
+		return []*models.VariableUsageTarget{{AppId: appId}}, nil
+	}
+	targets := make([]*models.VariableUsageTarget, 0, len(envIds))
+	for _, envId := range envIds {
+		if skipEnvIds[envId] {
+			continue
+		}
+		targets = append(targets, &models.VariableUsageTarget{AppId: appId, EnvId: envId})
+	}
+	return targets, nil
+}
+
+// getOverriddenEnvIds returns the environments of the app which have overridden the base deployment template
+func (impl *VariableUsageServiceImpl) getOverriddenEnvIds(appId int, ctx *usageContext) (map[int]bool, error) {
+	if overriddenEnvIds, ok := ctx.appIdToOverriddenEnvIds[appId]; ok {
+		return overriddenEnvIds, nil
+	}
+	envOverrides, err := impl.envConfigOverrideRepository.GetAllOverridesForApp(appId)
+	if err != nil && err != pg.ErrNoRows {
+		impl.logger.Errorw("error in fetching env overrides of app", "appId", appId, "err", err)
+		return nil, err
+	}
+	overriddenEnvIds := make(map[int]bool)
+	for _, envOverride := range envOverrides {
+		if envOverride.Active && envOverride.Latest && envOverride.IsOverride {
+			overriddenEnvIds[envOverride.TargetEnvironment] = true
+		}
+	}
+	ctx.appIdToOverriddenEnvIds[appId] = overriddenEnvIds
+	return overriddenEnvIds, nil
+}
+
+func (impl *VariableUsageServiceImpl) populateTargets(variableUsage *models.VariableUsage, proposedVariable *models.Variables, ctx *usageContext) error {
+	appIds := make([]*int, 0)
+	envIds := make([]*int, 0)
+	for _, usage := range variableUsage.Usages {
+		for _, target := range usage.Targets {
+			appId, envId := target.AppId, target.EnvId
+			appIds = append(appIds, &appId)
+			if envId > 0 {
+				envIds = append(envIds, &envId)
+			}
+		}
+	}
+	if len(appIds) == 0 {
+		return nil
+	}
+	apps, err := impl.appRepository.FindByIds(appIds)
+	if err != nil {
+		impl.logger.Errorw("error in fetching apps using variable", "variableName", variableUsage.VariableName, "err", err)
+		return err
+	}
+	appIdToName := make(map[int]string)
+	for _, app := range apps {
+		appIdToName[app.Id] = app.AppName
+	}
+	envs, err := impl.environmentRepository.FindByIds(envIds)
+	if err != nil {
+		impl.logger.Errorw("error in fetching environments using variable", "variableName", variableUsage.VariableName, "err", err)
+		return err
+	}
+	envIdToEnv := make(map[int]*repository3.Environment)
+	clusterIds := make([]int, 0)
+	for _, env := range envs {
+		envIdToEnv[env.Id] = env
+		clusterIds = append(clusterIds, env.ClusterId)
+	}
+	clusterIdToName := make(map[int]string)
+	if len(clusterIds) > 0 {
+		clusters, err := impl.clusterRepository.FindByIds(clusterIds)
+		if err != nil {
+			impl.logger.Errorw("error in fetching clusters using variable", "variableName", variableUsage.VariableName, "err", err)
+			return err
+		}
+		for _, cluster := range clusters {
+			clusterIdToName[cluster.Id] = cluster.ClusterName
+		}
+	}
+
+	for _, usage := range variableUsage.Usages {
+		for _, target := range usage.Targets {
+			target.AppName = appIdToName[target.AppId]
+			var clusterId int
+			var clusterName string
+			if env, ok := envIdToEnv[target.EnvId]; ok {
+				target.EnvName = env.Name
+				clusterId = env.ClusterId
+				clusterName = clusterIdToName[env.ClusterId]
+			}
+			resolvedData, err := impl.getResolvedValue(variableUsage.VariableName, target, clusterId, ctx)
+			if err != nil {
+				return err
+			}
+			if resolvedData != nil {
+				target.ResolvedValue = getDisplayValue(resolvedData)
+				target.IsRedacted = resolvedData.IsRedacted
+				target.ResolutionTrace = resolvedData.ResolutionTrace
+			}
+			if proposedVariable == nil {
+				continue
+			}
+			proposedData, err := impl.getProposedValue(proposedVariable, target, clusterName)
+			if err != nil {
+				return err
+			}
+			target.ProposedValue = getDisplayValue(proposedData)
+			if template, ok := ctx.usageToTemplate[usage]; ok {
+				err = impl.setRenderedManifestChange(variableUsage.VariableName, template, target, clusterId, proposedData)
+				if err != nil {
+					return err
+				}
+			} else {
+				// pipeline scripts are rendered with runtime values at trigger time
+				target.ManifestChanged = isValueChanged(getValue(resolvedData), getValue(proposedData))
+			}
+			if target.ManifestChanged {
+				variableUsage.ChangedManifests++
+			}
+		}
+	}
+	return nil
+}
+
+func (impl *VariableUsageServiceImpl) getResolvedValue(variableName string, target *models.VariableUsageTarget, clusterId int, ctx *usageContext) (*models.ScopedVariableData, error) {
+	key := usageTargetKey{appId: target.AppId, envId: target.EnvId}
+	if data, ok := ctx.targetKeyToResolvedValue[key]; ok {
+		return data, nil
+	}
+	scope := resourceQualifiers.Scope{
+		AppId:     target.AppId,
+		EnvId:     target.EnvId,
+		ClusterId: clusterId,
+	}
+	// usage is only exposed to super admins, hence sensitive values are not masked. Values from external sources
+	// are fetched the same way as at trigger time, they are only returned as their redacted reference
+	scopedVariables, err := impl.scopedVariableManager.GetScopedVariables(scope, []string{variableName}, true)
+	if err != nil {
+		impl.logger.Errorw("error in resolving variable for scope", "variableName", variableName, "scope", scope, "err", err)
+		return nil, err
+	}
+	var resolvedData *models.ScopedVariableData
+	for _, data := range scopedVariables {
+		if data.VariableName == variableName && data.VariableValue != nil {
+			resolvedData = data
+			break
+		}
+	}
+	ctx.targetKeyToResolvedValue[key] = resolvedData
+	return resolvedData, nil
+}
+
+// setRenderedManifestChange renders the template for the target with the current values and with the proposed value
+// of the variable through the same resolution and parsing as a deployment, the manifest is changed if the renders differ.
+// The rendered manifests returned carry the redacted reference of values from external sources
+func (impl *VariableUsageServiceImpl) setRenderedManifestChange(variableName string, template *usageTemplate, target *models.VariableUsageTarget, clusterId int, proposedData *models.ScopedVariableData) error {
+	usedVariables, err := impl.variableTemplateParser.ExtractVariables(template.template, template.templateType)
+	if err != nil {
+		impl.logger.Errorw("error in extracting variables from template", "variableName", variableName, "err", err)
+		return err
+	}
+	scope := resourceQualifiers.Scope{
+		AppId:     target.AppId,
+		EnvId:     target.EnvId,
+		ClusterId: clusterId,
+	}
+	scopedVariables, err := impl.scopedVariableManager.GetScopedVariables(scope, usedVariables, true)
+	if err != nil {
+		impl.logger.Errorw("error in resolving variables for scope", "variableName", variableName, "scope", scope, "err", err)
+		return err
+	}
+	overrides := map[string]*models.ScopedVariableData{variableName: proposedData}
+	current, proposed, err := impl.renderCurrentAndProposed(template, scopedVariables, overrides, false)
+	if err != nil {
+		impl.logger.Errorw("error in rendering template with current and proposed values", "variableName", variableName, "scope", scope, "err", err)
+		return err
+	}
+	target.ManifestChanged = current != proposed
+	if !target.ManifestChanged || template.isSecret {
+		return nil
+	}
+	current, proposed, err = impl.renderCurrentAndProposed(template, scopedVariables, overrides, true)
+	if err != nil {
+		impl.logger.Errorw("error in rendering redacted template with current and proposed values", "variableName", variableName, "scope", scope, "err", err)
+		return err
+	}
+	target.RenderedManifest = &models.RenderedManifestDiff{Current: current, Proposed: proposed}
+	return nil
+}
+
+func (impl *VariableUsageServiceImpl) renderCurrentAndProposed(template *usageTemplate, scopedVariables []*models.ScopedVariableData,
+	overrides map[string]*models.ScopedVariableData, redactExternalValues bool) (string, string, error) {
+	current, err := impl.renderTemplate(template, getRenderVariables(scopedVariables, nil, redactExternalValues))
+	if err != nil {
+		return "", "", err
+	}
+	proposed, err := impl.renderTemplate(template, getRenderVariables(scopedVariables, overrides, redactExternalValues))
+	if err != nil {
+		return "", "", models.ValidationError{Err: err}
+	}
+	return current, proposed, nil
+}
+
+func (impl *VariableUsageServiceImpl) renderTemplate(template *usageTemplate, scopedVariables []*models.ScopedVariableData) (string, error) {
+	data := template.template
+	if template.isSecret {
+		decodedData, err := bean.GetTransformedDataForSecretRootJsonData(data, util.DecodeSecret)
+		if err != nil {
+			return "", err
+		}
+		data = decodedData
+	}
+	parserResponse := impl.variableTemplateParser.ParseTemplate(parsers.CreateParserRequest(data, template.templateType, scopedVariables, true))
+	return parserResponse.ResolvedTemplate, parserResponse.Error
+}
+
+// getRenderVariables returns the variables to render a template with, values from external sources are rendered as their
+// redacted reference if redactExternalValues is set. Overrides replace the resolved values, a nil override leaves the variable unresolved
+func getRenderVariables(scopedVariables []*models.ScopedVariableData, overrides map[string]*models.ScopedVariableData, redactExternalValues bool) []*models.ScopedVariableData {
+	getRenderValue := getValue
+	if redactExternalValues {
+		getRenderValue = getDisplayValue
+	}
+	renderVariables := make([]*models.ScopedVariableData, 0, len(scopedVariables)+len(overrides))
+	for _, data := range scopedVariables {
+		if _, ok := overrides[data.VariableName]; ok {
+			continue
+		}
+		if value := getRenderValue(data); value != nil {
+			renderVariables = append(renderVariables, &models.ScopedVariableData{VariableName: data.VariableName, VariableValue: value})
+		}
+	}
+	for variableName, data := range overrides {
+		if value := getRenderValue(data); value != nil {
+			renderVariables = append(renderVariables, &models.ScopedVariableData{VariableName: variableName, VariableValue: value})
+		}
+	}
+	return renderVariables
+}
+
+func getValue(data *models.ScopedVariableData) *models.VariableValue {
+	if data == nil {
+		return nil
+	}
+	return data.VariableValue
+}
+
+// getDisplayValue returns the value of the variable to be shown, values from external sources are shown as their redacted reference
+func getDisplayValue(data *models.ScopedVariableData) *models.VariableValue {
+	if data == nil || data.VariableValue == nil {
+		return nil
+	}
+	if data.ValueSource != nil {
+		return &models.VariableValue{Value: data.ValueSource.GetRedactedReference()}
+	}
+	return data.VariableValue
+}
+
+// getProposedValue picks the attribute value of the proposed definition matching the target with the highest priority,
+// the value goes through the same conversion and external value resolution as a saved value so that it can be compared with the resolved value
+func (impl *VariableUsageServiceImpl) getProposedValue(variable *models.Variables, target *models.VariableUsageTarget, clusterName string) (*models.ScopedVariableData, error) {
+	var selected *models.AttributeValue
+	for i := range variable.AttributeValues {
+		attributeValue := &variable.AttributeValues[i]
+		if !isAttributeMatchingTarget(attributeValue, target, clusterName) {
+			continue
+		}
+		if selected == nil || helper.GetPriority(helper.GetQualifierId(attributeValue.AttributeType)) < helper.GetPriority(helper.GetQualifierId(selected.AttributeType)) {
+			selected = attributeValue
+		}
+	}
+	if selected == nil {
+		return nil, nil
+	}
+	proposedData := &models.ScopedVariableData{VariableName: variable.Definition.VarName}
+	if selected.ValueSource != nil {
+		proposedData.ValueSource = selected.ValueSource
+		proposedData.VariableValue = &models.VariableValue{Value: selected.ValueSource.GetRedactedReference()}
+		err := impl.scopedVariableManager.ResolveExternalValues([]*models.ScopedVariableData{proposedData})
+		if err != nil {
+			impl.logger.Errorw("error in resolving proposed value of variable from external source", "variableName", variable.Definition.VarName, "err", err)
+			return nil, err
+		}
+		return proposedData, nil
+	}
+	value, err := utils.NormalizeValue(selected.VariableValue.Value)
+	if err != nil {
+		impl.logger.Errorw("error in resolving proposed value of variable", "variableName", variable.Definition.VarName, "err", err)
+		return nil, models.ValidationError{Err: err}
+	}
+	proposedData.VariableValue = &models.VariableValue{Value: value}
+	return proposedData, nil
+}
+
+func isAttributeMatchingTarget(attributeValue *models.AttributeValue, target *models.VariableUsageTarget, clusterName string) bool {
+	params := attributeValue.AttributeParams
+	switch attributeValue.AttributeType {
+	case models.ApplicationEnv:
+		return target.EnvName != "" && params[models.ApplicationName] == target.AppName && params[models.EnvName] == target.EnvName
+	case models.Application:
+		return params[models.ApplicationName] == target.AppName
+	case models.Env:
+		return target.EnvName != "" && params[models.EnvName] == target.EnvName
+	case models.Cluster:
+		return clusterName != "" && params[models.ClusterName] == clusterName
+	case models.Global:
+		return true
+	}
+	return false
+}
+
+func isValueChanged(current, proposed *models.VariableValue) bool {
+	if current == nil || proposed == nil {
+		return current != proposed
+	}
+	return !reflect.DeepEqual(current.Value, proposed.Value)
+}
+
+func getConfigUsageTemplate(usageType models.VariableUsageEntityType, configMap chartConfig.ConfigModel) *usageTemplate {
+	if usageType == models.SecretUsage {
+		return &usageTemplate{template: configMap.GetSecretData(), templateType: parsers.StringVariableTemplate, isSecret: true}
+	}
+	return &usageTemplate{template: configMap.GetConfigMapData(), templateType: parsers.StringVariableTemplate}
+}
+
+func getConfigUsageType(entityType repository2.EntityType) models.VariableUsageEntityType {
+	if entityType == repository2.EntityTypeSecretAppLevel || entityType == repository2.EntityTypeSecretEnvLevel {
+		return models.SecretUsage
+	}
+	return models.ConfigMapUsage
+}
+
+// ignoreNoRows drops pg.ErrNoRows so that mappings of deleted entities are skipped
+func ignoreNoRows(err error) error {
+	if err == pg.ErrNoRows {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,200 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package variables
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/devtron-labs/devtron/pkg/resourceQualifiers"
+	"github.com/devtron-labs/devtron/pkg/variables/mocks"
+	"github.com/devtron-labs/devtron/pkg/variables/models"
+	"github.com/devtron-labs/devtron/pkg/variables/parsers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+// newTestVariableUsageService resolves the same values for every scope, values from external sources are resolved as is
+func newTestVariableUsageService(t *testing.T, values map[string]*models.ScopedVariableData) *VariableUsageServiceImpl {
+	t.Setenv("SCOPED_VARIABLE_ENABLED", "true")
+	variableTemplateParser, err := parsers.NewVariableTemplateParserImpl(zap.NewNop().Sugar())
+	assert.NoError(t, err)
+	scopedVariableManager := mocks.NewScopedVariableManager(t)
+	scopedVariableManager.On("GetScopedVariables", mock.AnythingOfType("resourceQualifiers.Scope"), mock.Anything, true).Return(
+		func(scope resourceQualifiers.Scope, varNames []string, unmaskSensitiveData bool) []*models.ScopedVariableData {
+			scopedVariables := make([]*models.ScopedVariableData, 0, len(varNames))
+			for _, varName := range varNames {
+				if data, ok := values[varName]; ok {
+					scopedVariables = append(scopedVariables, data)
+				}
+			}
+			return scopedVariables
+		}, nil).Maybe()
+	return &VariableUsageServiceImpl{
+		logger:                 zap.NewNop().Sugar(),
+		scopedVariableManager:  scopedVariableManager,
+		variableTemplateParser: variableTemplateParser,
+	}
+}
+
+func newTestProposedData(variableName string, value interface{}) *models.ScopedVariableData {
+	return &models.ScopedVariableData{VariableName: variableName, VariableValue: &models.VariableValue{Value: value}}
+}
+
+func TestSetRenderedManifestChange(t *testing.T) {
+	values := map[string]*models.ScopedVariableData{
+		"replicas": {VariableName: "replicas", VariableValue: &models.VariableValue{Value: 2}},
+		"region":   {VariableName: "region", VariableValue: &models.VariableValue{Value: "us-east-1"}},
+	}
+	deploymentTemplate := &usageTemplate{template: `{"replicaCount":"@{{replicas}}","region":"@{{region}}"}`, templateType: parsers.JsonVariableTemplate}
+
+	t.Run("changed value renders a different manifest", func(t *testing.T) {
+		impl := newTestVariableUsageService(t, values)
+		target := &models.VariableUsageTarget{AppId: 1, EnvId: 1}
+		err := impl.setRenderedManifestChange("replicas", deploymentTemplate, target, 1, newTestProposedData("replicas", 3))
+		assert.NoError(t, err)
+		assert.True(t, target.ManifestChanged)
+		assert.Equal(t, &models.RenderedManifestDiff{
+			Current:  `{"region":"us-east-1","replicaCount":"2"}`,
+			Proposed: `{"region":"us-east-1","replicaCount":"3"}`,
+		}, target.RenderedManifest)
+	})
+	t.Run("same value renders the same manifest", func(t *testing.T) {
+		impl := newTestVariableUsageService(t, values)
+		target := &models.VariableUsageTarget{AppId: 1, EnvId: 1}
+		err := impl.setRenderedManifestChange("replicas", deploymentTemplate, target, 1, newTestProposedData("replicas", 2))
+		assert.NoError(t, err)
+		assert.False(t, target.ManifestChanged)
+		assert.Nil(t, target.RenderedManifest)
+	})
+	t.Run("value no longer defined for the scope leaves the variable unresolved", func(t *testing.T) {
+		impl := newTestVariableUsageService(t, values)
+		target := &models.VariableUsageTarget{AppId: 1, EnvId: 1}
+		err := impl.setRenderedManifestChange("region", deploymentTemplate, target, 1, nil)
+		assert.NoError(t, err)
+		assert.True(t, target.ManifestChanged)
+	})
+	t.Run("secrets are compared decoded and their renders are not returned", func(t *testing.T) {
+		impl := newTestVariableUsageService(t, map[string]*models.ScopedVariableData{
+			"dbPassword": {VariableName: "dbPassword", VariableValue: &models.VariableValue{Value: "old-password"}},
+		})
+		encodedValue := base64.StdEncoding.EncodeToString([]byte("@{{dbPassword}}"))
+		secret := &usageTemplate{
+			template:     fmt.Sprintf(`{"ConfigSecrets":{"enabled":true,"secrets":[{"name":"db","type":"environment","data":{"DB_PASSWORD":"%s"}}]}}`, encodedValue),
+			templateType: parsers.StringVariableTemplate,
+			isSecret:     true,
+		}
+		target := &models.VariableUsageTarget{AppId: 1, EnvId: 1}
+		err := impl.setRenderedManifestChange("dbPassword", secret, target, 1, newTestProposedData("dbPassword", "new-password"))
+		assert.NoError(t, err)
+		assert.True(t, target.ManifestChanged)
+		assert.Nil(t, target.RenderedManifest)
+	})
+	t.Run("external values are compared fetched and rendered redacted", func(t *testing.T) {
+		source := &models.ExternalValueSource{Backend: models.AWSSecretsManager, Path: "prod/region", Version: "v1"}
+		impl := newTestVariableUsageService(t, map[string]*models.ScopedVariableData{
+			"replicas": values["replicas"],
+			"region":   {VariableName: "region", VariableValue: &models.VariableValue{Value: "us-east-1"}, ValueSource: source},
+		})
+		target := &models.VariableUsageTarget{AppId: 1, EnvId: 1}
+		err := impl.setRenderedManifestChange("replicas", deploymentTemplate, target, 1, newTestProposedData("replicas", 3))
+		assert.NoError(t, err)
+		assert.True(t, target.ManifestChanged)
+		assert.NotContains(t, target.RenderedManifest.Current, "us-east-1")
+		assert.NotContains(t, target.RenderedManifest.Proposed, "us-east-1")
+	})
+}
+
+func TestGetResolvedValue(t *testing.T) {
+	scopedVariableManager := mocks.NewScopedVariableManager(t)
+	resolvedData := &models.ScopedVariableData{VariableName: "dbPassword", VariableValue: &models.VariableValue{Value: "fetched-password"},
+		ValueSource: &models.ExternalValueSource{Backend: models.AWSSecretsManager, Path: "prod/db", Version: "v1"}}
+	scopedVariableManager.On("GetScopedVariables", resourceQualifiers.Scope{AppId: 1, EnvId: 2, ClusterId: 3}, []string{"dbPassword"}, true).
+		Return([]*models.ScopedVariableData{resolvedData}, nil).Once()
+	impl := &VariableUsageServiceImpl{logger: zap.NewNop().Sugar(), scopedVariableManager: scopedVariableManager}
+	ctx := &usageContext{targetKeyToResolvedValue: make(map[usageTargetKey]*models.ScopedVariableData)}
+	target := &models.VariableUsageTarget{AppId: 1, EnvId: 2}
+	for i := 0; i < 2; i++ {
+		data, err := impl.getResolvedValue("dbPassword", target, 3, ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, resolvedData, data)
+	}
+}
+
+func TestGetProposedValue(t *testing.T) {
+	source := &models.ExternalValueSource{Backend: models.AWSSecretsManager, Path: "prod/db"}
+	target := &models.VariableUsageTarget{AppId: 1, AppName: "payments", EnvId: 2, EnvName: "prod"}
+
+	t.Run("value of the matching scope with the highest priority is proposed", func(t *testing.T) {
+		impl := newTestVariableUsageService(t, nil)
+		variable := newTestVariable("replicas", models.PUBLIC,
+			newTestAttributeValue(json.Number("1"), models.Global, nil),
+			newTestAttributeValue(json.Number("2"), models.Application, map[models.IdentifierType]string{models.ApplicationName: "payments"}),
+			newTestAttributeValue(json.Number("3"), models.Env, map[models.IdentifierType]string{models.EnvName: "prod"}),
+			newTestAttributeValue(json.Number("4"), models.Env, map[models.IdentifierType]string{models.EnvName: "staging"}),
+		)
+		proposedData, err := impl.getProposedValue(variable, target, "default_cluster")
+		assert.NoError(t, err)
+		assert.Equal(t, 3, proposedData.VariableValue.Value)
+	})
+	t.Run("value from an external source is fetched through the variable manager", func(t *testing.T) {
+		impl := newTestVariableUsageService(t, nil)
+		scopedVariableManager := impl.scopedVariableManager.(*mocks.ScopedVariableManager)
+		scopedVariableManager.On("ResolveExternalValues", mock.MatchedBy(func(scopedVariables []*models.ScopedVariableData) bool {
+			return len(scopedVariables) == 1 && scopedVariables[0].ValueSource == source
+		})).Run(func(args mock.Arguments) {
+			args.Get(0).([]*models.ScopedVariableData)[0].VariableValue = &models.VariableValue{Value: "fetched-password"}
+		}).Return(nil).Once()
+		variable := newTestVariable("dbPassword", models.PRIVATE, models.AttributeValue{AttributeType: models.Global, ValueSource: source})
+		proposedData, err := impl.getProposedValue(variable, target, "default_cluster")
+		assert.NoError(t, err)
+		assert.Equal(t, "fetched-password", proposedData.VariableValue.Value)
+		assert.Equal(t, source.GetRedactedReference(), getDisplayValue(proposedData).Value)
+	})
+	t.Run("no matching scope proposes no value", func(t *testing.T) {
+		impl := newTestVariableUsageService(t, nil)
+		variable := newTestVariable("replicas", models.PUBLIC,
+			newTestAttributeValue(json.Number("4"), models.Env, map[models.IdentifierType]string{models.EnvName: "staging"}))
+		proposedData, err := impl.getProposedValue(variable, target, "default_cluster")
+		assert.NoError(t, err)
+		assert.Nil(t, proposedData)
+	})
+}
+
+func TestGetRenderVariables(t *testing.T) {
+	source := &models.ExternalValueSource{Backend: models.AWSSecretsManager, Path: "prod/db"}
+	scopedVariables := []*models.ScopedVariableData{
+		{VariableName: "replicas", VariableValue: &models.VariableValue{Value: 2}},
+		{VariableName: "dbPassword", VariableValue: &models.VariableValue{Value: "fetched-password"}, ValueSource: source},
+	}
+	valueByName := func(renderVariables []*models.ScopedVariableData) map[string]interface{} {
+		values := make(map[string]interface{})
+		for _, data := range renderVariables {
+			values[data.VariableName] = data.VariableValue.Value
+		}
+		return values
+	}
+	assert.Equal(t, map[string]interface{}{"replicas": 2, "dbPassword": "fetched-password"}, valueByName(getRenderVariables(scopedVariables, nil, false)))
+	assert.Equal(t, map[string]interface{}{"replicas": 2, "dbPassword": source.GetRedactedReference()}, valueByName(getRenderVariables(scopedVariables, nil, true)))
+	assert.Equal(t, map[string]interface{}{"replicas": 3, "dbPassword": source.GetRedactedReference()},
+		valueByName(getRenderVariables(scopedVariables, map[string]*models.ScopedVariableData{"replicas": newTestProposedData("replicas", 3)}, true)))
+	assert.Equal(t, map[string]interface{}{"dbPassword": "fetched-password"},
+		valueByName(getRenderVariables(scopedVariables, map[string]*models.ScopedVariableData{"replicas": nil}, false)))
+}
@@ -0,0 +1,363 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package externalSecret
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsCredentials "github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	v4 "github.com/aws/aws-sdk-go/aws/signer/v4"
+	"github.com/caarlos0/env"
+	"github.com/devtron-labs/common-lib/utils/k8s"
+	"github.com/devtron-labs/devtron/pkg/cluster/read"
+	"github.com/devtron-labs/devtron/pkg/variables/models"
+	"go.uber.org/zap"
+	"golang.org/x/oauth2/google"
+)
+
+type ExternalSecretResolver interface {
+	// Resolve fetches the value of the secret referenced by source along with the version it was read from, the
+	// version of source is fetched when set. Kubernetes secrets are not versioned, their version is always empty.
+	Resolve(source *models.ExternalValueSource) (value string, version string, err error)
+}
+
+// ExternalSecretConfig holds the access details of the secret backends, credentials are never read from devtron's database.
+// AWS and GCP use the default credential chain of the pod (IRSA / workload identity), kubernetes secrets use the cluster credentials.
+type ExternalSecretConfig struct {
+	VaultAddress       string `env:"SCOPED_VARIABLE_VAULT_ADDR" envDefault:"" description:"Address of the vault server used for scoped variable values sourced from vault kv"`
+	VaultToken         string `env:"SCOPED_VARIABLE_VAULT_TOKEN" envDefault:"" description:"Vault token used for reading scoped variable values, ignored when SCOPED_VARIABLE_VAULT_TOKEN_PATH is set"`
+	VaultTokenPath     string `env:"SCOPED_VARIABLE_VAULT_TOKEN_PATH" envDefault:"" description:"Path of the file containing the vault token, e.g. the sink of a vault agent sidecar"`
+	VaultNamespace     string `env:"SCOPED_VARIABLE_VAULT_NAMESPACE" envDefault:"" description:"Vault enterprise namespace of the scoped variable secrets"`
+	AWSRegion          string `env:"SCOPED_VARIABLE_AWS_REGION" envDefault:"" description:"Default region of aws secrets manager when not given in the value source"`
+	AWSEndpoint        string `env:"SCOPED_VARIABLE_AWS_SECRETS_MANAGER_ENDPOINT" envDefault:"" description:"Endpoint of aws secrets manager, e.g. a vpc endpoint, defaults to the regional endpoint"`
+	GCPEndpoint        string `env:"SCOPED_VARIABLE_GCP_SECRET_MANAGER_ENDPOINT" envDefault:"https://secretmanager.googleapis.com" description:"Endpoint of gcp secret manager"`
+	RequestTimeoutSecs int    `env:"SCOPED_VARIABLE_EXTERNAL_SECRET_TIMEOUT_SECS" envDefault:"10" description:"Timeout in seconds for fetching a scoped variable value from an external source"`
+}
+
+type ExternalSecretResolverImpl struct {
+	logger             *zap.SugaredLogger
+	clusterReadService read.ClusterReadService
+	k8sUtil            *k8s.K8sServiceImpl
+	config             *ExternalSecretConfig
+	httpClient         *http.Client
+	// gcpClientProvider returns the http client authenticated with the default gcp credentials
+	gcpClientProvider func(ctx context.Context) (*http.Client, error)
+}
+
+func NewExternalSecretResolverImpl(logger *zap.SugaredLogger, clusterReadService read.ClusterReadService, k8sUtil *k8s.K8sServiceImpl) (*ExternalSecretResolverImpl, error) {
+	cfg := &ExternalSecretConfig{}
+	err := env.Parse(cfg)
+	if err != nil {
+		logger.Errorw("error in parsing external secret config", "err", err)
+		return nil, err
+	}
+	return &ExternalSecretResolverImpl{
+		logger:             logger,
+		clusterReadService: clusterReadService,
+		k8sUtil:            k8sUtil,
+		config:             cfg,
+		httpClient:         &http.Client{Timeout: cfg.getRequestTimeout()},
+		gcpClientProvider:  getGCPDefaultClient,
+	}, nil
+}
+
+func getGCPDefaultClient(ctx context.Context) (*http.Client, error) {
+	return google.DefaultClient(ctx, "https://www.googleapis.com/auth/cloud-platform")
+}
+
+func (cfg *ExternalSecretConfig) getRequestTimeout() time.Duration {
+	return time.Duration(cfg.RequestTimeoutSecs) * time.Second
+}
+
+func (impl *ExternalSecretResolverImpl) Resolve(source *models.ExternalValueSource) (string, string, error) {
+	switch source.Backend {
+	case models.VaultKV:
+		return impl.resolveFromVault(source)
+	case models.AWSSecretsManager:
+		return impl.resolveFromAWSSecretsManager(source)
+	case models.GCPSecretManager:
+		return impl.resolveFromGCPSecretManager(source)
+	case models.KubernetesSecret:
+		return impl.resolveFromKubernetesSecret(source)
+	}
+	return "", "", fmt.Errorf("unsupported external secret backend %s", source.Backend)
+}
+
+type vaultSecretResponse struct {
+	Data map[string]interface{} `json:"data"`
+}
+
+func (impl *ExternalSecretResolverImpl) resolveFromVault(source *models.ExternalValueSource) (string, string, error) {
+	if len(impl.config.VaultAddress) == 0 {
+		return "", "", fmt.Errorf("vault address is not configured")
+	}
+	token, err := impl.getVaultToken()
+	if err != nil {
+		return "", "", err
+	}
+	requestUrl := fmt.Sprintf("%s/v1/%s", strings.TrimSuffix(impl.config.VaultAddress, "/"), strings.TrimPrefix(source.Path, "/"))
+	if len(source.Version) > 0 {
+		requestUrl = fmt.Sprintf("%s?version=%s", requestUrl, url.QueryEscape(source.Version))
+	}
+	request, err := http.NewRequest(http.MethodGet, requestUrl, nil)
+	if err != nil {
+		return "", "", err
+	}
+	request.Header.Set("X-Vault-Token", token)
+	if len(impl.config.VaultNamespace) > 0 {
+		request.Header.Set("X-Vault-Namespace", impl.config.VaultNamespace)
+	}
+	body, err := impl.doRequest(impl.httpClient, request)
+	if err != nil {
+		impl.logger.Errorw("error in reading secret from vault", "path", source.Path, "err", err)
+		return "", "", err
+	}
+	return getValueFromVaultResponse(body, source)
+}
+
+// getValueFromVaultResponse reads the key of the secret from a kv v1 or v2 response, only kv v2 secrets are versioned
+func getValueFromVaultResponse(body []byte, source *models.ExternalValueSource) (string, string, error) {
+	response := &vaultSecretResponse{}
+	err := json.Unmarshal(body, response)
+	if err != nil {
+		return "", "", err
+	}
+	data := response.Data
+	version := ""
+	// kv v2 nests the secret under data.data alongside its metadata
+	if nestedData, ok := data["data"].(map[string]interface{}); ok {
+		if metadata, hasMetadata := data["metadata"]; hasMetadata {
+			data = nestedData
+			if metadataMap, ok := metadata.(map[string]interface{}); ok && metadataMap["version"] != nil {
+				version = fmt.Sprint(metadataMap["version"])
+			}
+		}
+	}
+	value, err := getValueForKey(data, source)
+	return value, version, err
+}
+
+func (impl *ExternalSecretResolverImpl) getVaultToken() (string, error) {
+	if len(impl.config.VaultTokenPath) == 0 {
+		if len(impl.config.VaultToken) == 0 {
+			return "", fmt.Errorf("vault token is not configured")
+		}
+		return impl.config.VaultToken, nil
+	}
+	// read on every call as the token file is rotated by the vault agent
+	token, err := os.ReadFile(impl.config.VaultTokenPath)
+	if err != nil {
+		impl.logger.Errorw("error in reading vault token file", "path", impl.config.VaultTokenPath, "err", err)
+		return "", err
+	}
+	return strings.TrimSpace(string(token)), nil
+}
+
+type awsSecretValueResponse struct {
+	SecretString string `json:"SecretString"`
+	SecretBinary []byte `json:"SecretBinary"`
+	VersionId    string `json:"VersionId"`
+}
+
+func (impl *ExternalSecretResolverImpl) resolveFromAWSSecretsManager(source *models.ExternalValueSource) (string, string, error) {
+	region := source.Region
+	if len(region) == 0 {
+		region = impl.config.AWSRegion
+	}
+	if len(region) == 0 {
+		return "", "", fmt.Errorf("region is required for %s value source", source.Backend)
+	}
+	awsSession, err := session.NewSession(&aws.Config{Region: aws.String(region)})
+	if err != nil {
+		impl.logger.Errorw("error in creating aws session", "region", region, "err", err)
+		return "", "", err
+	}
+	endpoint := impl.config.AWSEndpoint
+	if len(endpoint) == 0 {
+		endpoint = fmt.Sprintf("https://secretsmanager.%s.amazonaws.com/", region)
+	}
+	request, err := newAWSGetSecretValueRequest(endpoint, region, source, awsSession.Config.Credentials, time.Now())
+	if err != nil {
+		impl.logger.Errorw("error in creating aws secrets manager request", "secretId", source.Path, "err", err)
+		return "", "", err
+	}
+	body, err := impl.doRequest(impl.httpClient, request)
+	if err != nil {
+		impl.logger.Errorw("error in reading secret from aws secrets manager", "secretId", source.Path, "err", err)
+		return "", "", err
+	}
+	response := &awsSecretValueResponse{}
+	err = json.Unmarshal(body, response)
+	if err != nil {
+		return "", "", err
+	}
+	secret := response.SecretString
+	if len(secret) == 0 {
+		secret = string(response.SecretBinary)
+	}
+	value, err := getValueFromSecretString(secret, source)
+	return value, response.VersionId, err
+}
+
+// newAWSGetSecretValueRequest creates the GetSecretValue request of the secrets manager json api signed with signature v4
+func newAWSGetSecretValueRequest(endpoint, region string, source *models.ExternalValueSource, credentials *awsCredentials.Credentials, signTime time.Time) (*http.Request, error) {
+	payload := map[string]string{"SecretId": source.Path}
+	if len(source.Version) > 0 {
+		payload["VersionId"] = source.Version
+	}
+	payloadBytes, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	request, err := http.NewRequest(http.MethodPost, endpoint, bytes.NewReader(payloadBytes))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Content-Type", "application/x-amz-json-1.1")
+	request.Header.Set("X-Amz-Target", "secretsmanager.GetSecretValue")
+	_, err = v4.NewSigner(credentials).Sign(request, bytes.NewReader(payloadBytes), "secretsmanager", region, signTime)
+	if err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+type gcpSecretVersionResponse struct {
+	// Name is the resource name of the accessed version, projects/<project>/secrets/<name>/versions/<version>
+	Name    string `json:"name"`
+	Payload struct {
+		Data string `json:"data"`
+	} `json:"payload"`
+}
+
+func (impl *ExternalSecretResolverImpl) resolveFromGCPSecretManager(source *models.ExternalValueSource) (string, string, error) {
+	version := source.Version
+	if len(version) == 0 {
+		version = "latest"
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), impl.config.getRequestTimeout())
+	defer cancel()
+	client, err := impl.gcpClientProvider(ctx)
+	if err != nil {
+		impl.logger.Errorw("error in getting gcp client from default credentials", "err", err)
+		return "", "", err
+	}
+	requestUrl := fmt.Sprintf("%s/v1/%s/versions/%s:access", strings.TrimSuffix(impl.config.GCPEndpoint, "/"), strings.Trim(source.Path, "/"), url.PathEscape(version))
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, requestUrl, nil)
+	if err != nil {
+		return "", "", err
+	}
+	body, err := impl.doRequest(client, request)
+	if err != nil {
+		impl.logger.Errorw("error in reading secret from gcp secret manager", "secret", source.Path, "err", err)
+		return "", "", err
+	}
+	response := &gcpSecretVersionResponse{}
+	err = json.Unmarshal(body, response)
+	if err != nil {
+		return "", "", err
+	}
+	secret, err := base64.StdEncoding.DecodeString(response.Payload.Data)
+	if err != nil {
+		return "", "", err
+	}
+	value, err := getValueFromSecretString(string(secret), source)
+	// the latest alias is resolved to the version number so the same value is used again
+	return value, response.Name[strings.LastIndex(response.Name, "/")+1:], err
+}
+
+// resolveFromKubernetesSecret reads the key of the secret from the cluster. Kubernetes secrets have no history,
+// so a snapshot of a release always gets the current value of the secret.
+func (impl *ExternalSecretResolverImpl) resolveFromKubernetesSecret(source *models.ExternalValueSource) (string, string, error) {
+	clusterBean, err := impl.clusterReadService.FindOne(source.ClusterName)
+	if err != nil {
+		impl.logger.Errorw("error in finding cluster by name", "clusterName", source.ClusterName, "err", err)
+		return "", "", err
+	}
+	client, err := impl.k8sUtil.GetCoreV1Client(clusterBean.GetClusterConfig())
+	if err != nil {
+		impl.logger.Errorw("error in getting k8s client", "clusterName", source.ClusterName, "err", err)
+		return "", "", err
+	}
+	secret, err := impl.k8sUtil.GetSecret(source.Namespace, source.Path, client)
+	if err != nil {
+		impl.logger.Errorw("error in getting k8s secret", "clusterName", source.ClusterName, "namespace", source.Namespace, "name", source.Path, "err", err)
+		return "", "", err
+	}
+	value, ok := secret.Data[source.Key]
+	if !ok {
+		return "", "", fmt.Errorf("key %s not found in secret %s/%s", source.Key, source.Namespace, source.Path)
+	}
+	return string(value), "", nil
+}
+
+func (impl *ExternalSecretResolverImpl) doRequest(client *http.Client, request *http.Request) ([]byte, error) {
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		// response body is not returned as it may echo parts of the request
+		return nil, fmt.Errorf("request to %s failed with status %d", request.URL.Host, response.StatusCode)
+	}
+	return body, nil
+}
+
+// getValueFromSecretString returns the secret as is when no key is given, otherwise the secret is read as a json object
+func getValueFromSecretString(secret string, source *models.ExternalValueSource) (string, error) {
+	if len(source.Key) == 0 {
+		return secret, nil
+	}
+	data := make(map[string]interface{})
+	err := json.Unmarshal([]byte(secret), &data)
+	if err != nil {
+		return "", fmt.Errorf("secret %s is not a json object, key %s cannot be read", source.Path, source.Key)
+	}
+	return getValueForKey(data, source)
+}
+
+func getValueForKey(data map[string]interface{}, source *models.ExternalValueSource) (string, error) {
+	value, ok := data[source.Key]
+	if !ok {
+		return "", fmt.Errorf("key %s not found in secret %s", source.Key, source.Path)
+	}
+	if stringValue, ok := value.(string); ok {
+		return stringValue, nil
+	}
+	valueBytes, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return string(valueBytes), nil
+}
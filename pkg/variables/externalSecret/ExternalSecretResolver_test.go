@@ -0,0 +1,274 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package externalSecret
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	awsCredentials "github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/devtron-labs/common-lib/utils/k8s"
+	commonBean "github.com/devtron-labs/common-lib/utils/k8s/commonBean"
+	clusterBean "github.com/devtron-labs/devtron/pkg/cluster/bean"
+	"github.com/devtron-labs/devtron/pkg/cluster/read"
+	"github.com/devtron-labs/devtron/pkg/variables/models"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+const vaultKvV2Response = `{"data":{"data":{"password":"s3cr3t","port":5432},"metadata":{"version":3}}}`
+
+func TestExternalSecretResolverImpl_ResolveFromVault(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != "dev-token" || r.URL.Path != "/v1/secret/data/payments/db" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		_, _ = w.Write([]byte(vaultKvV2Response))
+	}))
+	defer server.Close()
+
+	impl := &ExternalSecretResolverImpl{
+		logger:     zap.NewNop().Sugar(),
+		config:     &ExternalSecretConfig{VaultAddress: server.URL, VaultToken: "dev-token"},
+		httpClient: server.Client(),
+	}
+	tests := []struct {
+		name        string
+		source      *models.ExternalValueSource
+		want        string
+		wantVersion string
+		wantErr     bool
+	}{
+		{
+			name:        "string value of kv v2 secret",
+			source:      &models.ExternalValueSource{Backend: models.VaultKV, Path: "secret/data/payments/db", Key: "password"},
+			want:        "s3cr3t",
+			wantVersion: "3",
+		},
+		{
+			name:        "non string value is returned as json",
+			source:      &models.ExternalValueSource{Backend: models.VaultKV, Path: "/secret/data/payments/db", Key: "port"},
+			want:        "5432",
+			wantVersion: "3",
+		},
+		{
+			name:    "missing key",
+			source:  &models.ExternalValueSource{Backend: models.VaultKV, Path: "secret/data/payments/db", Key: "username"},
+			wantErr: true,
+		},
+		{
+			name:    "forbidden path",
+			source:  &models.ExternalValueSource{Backend: models.VaultKV, Path: "secret/data/payments/cache", Key: "password"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, version, err := impl.Resolve(tt.source)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantVersion, version)
+		})
+	}
+}
+
+func TestExternalSecretResolverImpl_ResolveFromAWSSecretsManager(t *testing.T) {
+	t.Setenv("AWS_ACCESS_KEY_ID", "AKIDEXAMPLE")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY")
+	t.Setenv("AWS_SESSION_TOKEN", "")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization := r.Header.Get("Authorization")
+		if !strings.HasPrefix(authorization, "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/") ||
+			!strings.Contains(authorization, "/eu-west-1/secretsmanager/aws4_request") ||
+			!strings.Contains(authorization, "x-amz-target") ||
+			r.Header.Get("X-Amz-Target") != "secretsmanager.GetSecretValue" || len(r.Header.Get("X-Amz-Date")) == 0 {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		payload := make(map[string]string)
+		_ = json.NewDecoder(r.Body).Decode(&payload)
+		if payload["SecretId"] != "prod/payments/db" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		versionId := payload["VersionId"]
+		if len(versionId) == 0 {
+			versionId = "v-current"
+		}
+		_, _ = w.Write([]byte(fmt.Sprintf(`{"SecretString":"{\"password\":\"%s-s3cr3t\"}","VersionId":"%s"}`, versionId, versionId)))
+	}))
+	defer server.Close()
+
+	impl := &ExternalSecretResolverImpl{
+		logger:     zap.NewNop().Sugar(),
+		config:     &ExternalSecretConfig{AWSRegion: "eu-west-1", AWSEndpoint: server.URL},
+		httpClient: server.Client(),
+	}
+	value, version, err := impl.Resolve(&models.ExternalValueSource{Backend: models.AWSSecretsManager, Path: "prod/payments/db", Key: "password"})
+	assert.NoError(t, err)
+	assert.Equal(t, "v-current-s3cr3t", value)
+	assert.Equal(t, "v-current", version)
+
+	value, version, err = impl.Resolve(&models.ExternalValueSource{Backend: models.AWSSecretsManager, Path: "prod/payments/db", Key: "password", Version: "v-old"})
+	assert.NoError(t, err)
+	assert.Equal(t, "v-old-s3cr3t", value)
+	assert.Equal(t, "v-old", version)
+
+	_, _, err = impl.Resolve(&models.ExternalValueSource{Backend: models.AWSSecretsManager, Path: "prod/payments/db", Key: "password", Region: "us-east-1"})
+	assert.Error(t, err, "request signed for another region must be rejected")
+}
+
+func TestNewAWSGetSecretValueRequest(t *testing.T) {
+	signTime := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	source := &models.ExternalValueSource{Backend: models.AWSSecretsManager, Path: "prod/payments/db", Version: "v1"}
+	request, err := newAWSGetSecretValueRequest("https://secretsmanager.eu-west-1.amazonaws.com/", "eu-west-1", source,
+		awsCredentials.NewStaticCredentials("AKIDEXAMPLE", "secret", ""), signTime)
+	assert.NoError(t, err)
+	assert.Equal(t, "20260102T030405Z", request.Header.Get("X-Amz-Date"))
+	assert.Contains(t, request.Header.Get("Authorization"), "Credential=AKIDEXAMPLE/20260102/eu-west-1/secretsmanager/aws4_request")
+	body, err := io.ReadAll(request.Body)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"SecretId":"prod/payments/db","VersionId":"v1"}`, string(body))
+}
+
+func TestExternalSecretResolverImpl_ResolveFromGCPSecretManager(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/projects/demo/secrets/db/versions/latest:access", "/v1/projects/demo/secrets/db/versions/7:access":
+			payload := base64.StdEncoding.EncodeToString([]byte(`{"password":"s3cr3t"}`))
+			_, _ = w.Write([]byte(fmt.Sprintf(`{"name":"projects/123/secrets/db/versions/7","payload":{"data":"%s"}}`, payload)))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	impl := &ExternalSecretResolverImpl{
+		logger: zap.NewNop().Sugar(),
+		config: &ExternalSecretConfig{GCPEndpoint: server.URL, RequestTimeoutSecs: 5},
+		gcpClientProvider: func(ctx context.Context) (*http.Client, error) {
+			return server.Client(), nil
+		},
+	}
+	tests := []struct {
+		name        string
+		source      *models.ExternalValueSource
+		want        string
+		wantVersion string
+		wantErr     bool
+	}{
+		{
+			name:        "latest version is pinned to its number",
+			source:      &models.ExternalValueSource{Backend: models.GCPSecretManager, Path: "projects/demo/secrets/db", Key: "password"},
+			want:        "s3cr3t",
+			wantVersion: "7",
+		},
+		{
+			name:        "whole secret of a given version",
+			source:      &models.ExternalValueSource{Backend: models.GCPSecretManager, Path: "/projects/demo/secrets/db/", Version: "7"},
+			want:        `{"password":"s3cr3t"}`,
+			wantVersion: "7",
+		},
+		{
+			name:    "missing version",
+			source:  &models.ExternalValueSource{Backend: models.GCPSecretManager, Path: "projects/demo/secrets/db", Version: "2"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, version, err := impl.Resolve(tt.source)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantVersion, version)
+		})
+	}
+}
+
+type clusterReadServiceStub struct {
+	read.ClusterReadService
+	cluster *clusterBean.ClusterBean
+}
+
+func (stub clusterReadServiceStub) FindOne(clusterName string) (*clusterBean.ClusterBean, error) {
+	if clusterName != stub.cluster.ClusterName {
+		return nil, fmt.Errorf("cluster %s not found", clusterName)
+	}
+	return stub.cluster, nil
+}
+
+func TestExternalSecretResolverImpl_ResolveFromKubernetesSecret(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer cluster-token" || r.URL.Path != "/api/v1/namespaces/payments/secrets/db" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		password := base64.StdEncoding.EncodeToString([]byte("s3cr3t"))
+		_, _ = w.Write([]byte(fmt.Sprintf(`{"apiVersion":"v1","kind":"Secret","metadata":{"name":"db","namespace":"payments","resourceVersion":"42"},"data":{"password":"%s"}}`, password)))
+	}))
+	defer server.Close()
+
+	k8sUtil, err := k8s.NewK8sUtil(zap.NewNop().Sugar(), &k8s.RuntimeConfig{})
+	assert.NoError(t, err)
+	impl := &ExternalSecretResolverImpl{
+		logger: zap.NewNop().Sugar(),
+		clusterReadService: clusterReadServiceStub{cluster: &clusterBean.ClusterBean{
+			ClusterName:           "prod",
+			ServerUrl:             server.URL,
+			InsecureSkipTLSVerify: true,
+			Config:                map[string]string{commonBean.BearerToken: "cluster-token"},
+		}},
+		k8sUtil: k8sUtil,
+		config:  &ExternalSecretConfig{},
+	}
+	value, version, err := impl.Resolve(&models.ExternalValueSource{Backend: models.KubernetesSecret, Path: "db", Key: "password", ClusterName: "prod", Namespace: "payments"})
+	assert.NoError(t, err)
+	assert.Equal(t, "s3cr3t", value)
+	assert.Empty(t, version, "kubernetes secrets are not versioned")
+
+	_, _, err = impl.Resolve(&models.ExternalValueSource{Backend: models.KubernetesSecret, Path: "db", Key: "username", ClusterName: "prod", Namespace: "payments"})
+	assert.Error(t, err)
+	_, _, err = impl.Resolve(&models.ExternalValueSource{Backend: models.KubernetesSecret, Path: "db", Key: "password", ClusterName: "staging", Namespace: "payments"})
+	assert.Error(t, err)
+}
+
+func TestExternalValueSourceReference(t *testing.T) {
+	source := &models.ExternalValueSource{Backend: models.AWSSecretsManager, Path: "prod/payments/db", Key: "password", Region: "us-east-1"}
+	reference := source.GetRedactedReference()
+	assert.NotContains(t, reference, "s3cr3t")
+	assert.Equal(t, source, models.GetExternalValueSourceFromReference(reference))
+	assert.Nil(t, models.GetExternalValueSourceFromReference("plain-value"))
+	assert.Nil(t, models.GetExternalValueSourceFromReference(5432))
+}
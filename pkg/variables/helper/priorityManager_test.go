@@ -0,0 +1,87 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package helper
+
+import (
+	"testing"
+
+	"github.com/devtron-labs/devtron/pkg/resourceQualifiers"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQualifierComparator(t *testing.T) {
+	// app+env > env > app > cluster > global
+	orderedQualifiers := []resourceQualifiers.Qualifier{
+		resourceQualifiers.APP_AND_ENV_QUALIFIER,
+		resourceQualifiers.ENV_QUALIFIER,
+		resourceQualifiers.APP_QUALIFIER,
+		resourceQualifiers.CLUSTER_QUALIFIER,
+		resourceQualifiers.GLOBAL_QUALIFIER,
+	}
+	for i, higher := range orderedQualifiers {
+		for _, lower := range orderedQualifiers[i+1:] {
+			assert.True(t, QualifierComparator(higher, lower), "qualifier %d should take precedence over %d", higher, lower)
+			assert.False(t, QualifierComparator(lower, higher), "qualifier %d should not take precedence over %d", lower, higher)
+		}
+		assert.False(t, QualifierComparator(higher, higher))
+	}
+}
+
+func TestFindMinWithComparator(t *testing.T) {
+	tests := []struct {
+		name       string
+		qualifiers []resourceQualifiers.Qualifier
+		expected   resourceQualifiers.Qualifier
+	}{
+		{
+			name:       "app and env value wins over every other scope",
+			qualifiers: []resourceQualifiers.Qualifier{resourceQualifiers.GLOBAL_QUALIFIER, resourceQualifiers.APP_QUALIFIER, resourceQualifiers.APP_AND_ENV_QUALIFIER, resourceQualifiers.ENV_QUALIFIER, resourceQualifiers.CLUSTER_QUALIFIER},
+			expected:   resourceQualifiers.APP_AND_ENV_QUALIFIER,
+		},
+		{
+			name:       "env value wins over app value",
+			qualifiers: []resourceQualifiers.Qualifier{resourceQualifiers.APP_QUALIFIER, resourceQualifiers.CLUSTER_QUALIFIER, resourceQualifiers.ENV_QUALIFIER},
+			expected:   resourceQualifiers.ENV_QUALIFIER,
+		},
+		{
+			name:       "app value wins over cluster value",
+			qualifiers: []resourceQualifiers.Qualifier{resourceQualifiers.GLOBAL_QUALIFIER, resourceQualifiers.CLUSTER_QUALIFIER, resourceQualifiers.APP_QUALIFIER},
+			expected:   resourceQualifiers.APP_QUALIFIER,
+		},
+		{
+			name:       "cluster value wins over global value",
+			qualifiers: []resourceQualifiers.Qualifier{resourceQualifiers.GLOBAL_QUALIFIER, resourceQualifiers.CLUSTER_QUALIFIER},
+			expected:   resourceQualifiers.CLUSTER_QUALIFIER,
+		},
+		{
+			name:       "global value is used when nothing more specific matches",
+			qualifiers: []resourceQualifiers.Qualifier{resourceQualifiers.GLOBAL_QUALIFIER},
+			expected:   resourceQualifiers.GLOBAL_QUALIFIER,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scopes := make([]*resourceQualifiers.QualifierMapping, 0, len(tt.qualifiers))
+			for i, qualifier := range tt.qualifiers {
+				scopes = append(scopes, &resourceQualifiers.QualifierMapping{Id: i + 1, QualifierId: int(qualifier)})
+			}
+			selected := FindMinWithComparator(scopes, QualifierComparator)
+			assert.Equal(t, int(tt.expected), selected.QualifierId)
+		})
+	}
+	assert.Nil(t, FindMinWithComparator(nil, QualifierComparator))
+}
@@ -0,0 +1,696 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	apibean "github.com/devtron-labs/devtron/api/bean"
+	bean "github.com/devtron-labs/devtron/pkg/bean"
+
+	chartConfig "github.com/devtron-labs/devtron/internal/sql/repository/chartConfig"
+
+	context "context"
+
+	historyrepository "github.com/devtron-labs/devtron/pkg/pipeline/history/repository"
+
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/devtron-labs/devtron/pkg/variables/models"
+
+	parsers "github.com/devtron-labs/devtron/pkg/variables/parsers"
+
+	pg "github.com/go-pg/pg"
+
+	repository "github.com/devtron-labs/devtron/pkg/variables/repository"
+
+	resourceQualifiers "github.com/devtron-labs/devtron/pkg/resourceQualifiers"
+)
+
+// ScopedVariableCMCSManager is an autogenerated mock type for the ScopedVariableCMCSManager type
+type ScopedVariableCMCSManager struct {
+	mock.Mock
+}
+
+// CreateVariableMappingsForCMApp provides a mock function with given fields: model
+func (_m *ScopedVariableCMCSManager) CreateVariableMappingsForCMApp(model *chartConfig.ConfigMapAppModel) error {
+	ret := _m.Called(model)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateVariableMappingsForCMApp")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*chartConfig.ConfigMapAppModel) error); ok {
+		r0 = rf(model)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateVariableMappingsForCMEnv provides a mock function with given fields: model
+func (_m *ScopedVariableCMCSManager) CreateVariableMappingsForCMEnv(model *chartConfig.ConfigMapEnvModel) error {
+	ret := _m.Called(model)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateVariableMappingsForCMEnv")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*chartConfig.ConfigMapEnvModel) error); ok {
+		r0 = rf(model)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateVariableMappingsForSecretApp provides a mock function with given fields: model
+func (_m *ScopedVariableCMCSManager) CreateVariableMappingsForSecretApp(model *chartConfig.ConfigMapAppModel) error {
+	ret := _m.Called(model)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateVariableMappingsForSecretApp")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*chartConfig.ConfigMapAppModel) error); ok {
+		r0 = rf(model)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateVariableMappingsForSecretEnv provides a mock function with given fields: model
+func (_m *ScopedVariableCMCSManager) CreateVariableMappingsForSecretEnv(model *chartConfig.ConfigMapEnvModel) error {
+	ret := _m.Called(model)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateVariableMappingsForSecretEnv")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*chartConfig.ConfigMapEnvModel) error); ok {
+		r0 = rf(model)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ExtractAndMapVariables provides a mock function with given fields: template, entityId, entityType, userId, tx
+func (_m *ScopedVariableCMCSManager) ExtractAndMapVariables(template string, entityId int, entityType repository.EntityType, userId int32, tx *pg.Tx) error {
+	ret := _m.Called(template, entityId, entityType, userId, tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExtractAndMapVariables")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int, repository.EntityType, int32, *pg.Tx) error); ok {
+		r0 = rf(template, entityId, entityType, userId, tx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ExtractVariablesAndResolveTemplate provides a mock function with given fields: scope, template, templateType, unmaskSensitiveData, maskUnknownVariable, redactExternalValues
+func (_m *ScopedVariableCMCSManager) ExtractVariablesAndResolveTemplate(scope resourceQualifiers.Scope, template string, templateType parsers.VariableTemplateType, unmaskSensitiveData bool, maskUnknownVariable bool, redactExternalValues bool) (string, map[string]string, error) {
+	ret := _m.Called(scope, template, templateType, unmaskSensitiveData, maskUnknownVariable, redactExternalValues)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExtractVariablesAndResolveTemplate")
+	}
+
+	var r0 string
+	var r1 map[string]string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(resourceQualifiers.Scope, string, parsers.VariableTemplateType, bool, bool, bool) (string, map[string]string, error)); ok {
+		return rf(scope, template, templateType, unmaskSensitiveData, maskUnknownVariable, redactExternalValues)
+	}
+	if rf, ok := ret.Get(0).(func(resourceQualifiers.Scope, string, parsers.VariableTemplateType, bool, bool, bool) string); ok {
+		r0 = rf(scope, template, templateType, unmaskSensitiveData, maskUnknownVariable, redactExternalValues)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(resourceQualifiers.Scope, string, parsers.VariableTemplateType, bool, bool, bool) map[string]string); ok {
+		r1 = rf(scope, template, templateType, unmaskSensitiveData, maskUnknownVariable, redactExternalValues)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(map[string]string)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(resourceQualifiers.Scope, string, parsers.VariableTemplateType, bool, bool, bool) error); ok {
+		r2 = rf(scope, template, templateType, unmaskSensitiveData, maskUnknownVariable, redactExternalValues)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ExtractVariablesAndResolveTemplateWithTrace provides a mock function with given fields: scope, template, templateType, unmaskSensitiveData, maskUnknownVariable, redactExternalValues
+func (_m *ScopedVariableCMCSManager) ExtractVariablesAndResolveTemplateWithTrace(scope resourceQualifiers.Scope, template string, templateType parsers.VariableTemplateType, unmaskSensitiveData bool, maskUnknownVariable bool, redactExternalValues bool) (string, map[string]string, map[string]*models.VariableResolutionTrace, error) {
+	ret := _m.Called(scope, template, templateType, unmaskSensitiveData, maskUnknownVariable, redactExternalValues)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExtractVariablesAndResolveTemplateWithTrace")
+	}
+
+	var r0 string
+	var r1 map[string]string
+	var r2 map[string]*models.VariableResolutionTrace
+	var r3 error
+	if rf, ok := ret.Get(0).(func(resourceQualifiers.Scope, string, parsers.VariableTemplateType, bool, bool, bool) (string, map[string]string, map[string]*models.VariableResolutionTrace, error)); ok {
+		return rf(scope, template, templateType, unmaskSensitiveData, maskUnknownVariable, redactExternalValues)
+	}
+	if rf, ok := ret.Get(0).(func(resourceQualifiers.Scope, string, parsers.VariableTemplateType, bool, bool, bool) string); ok {
+		r0 = rf(scope, template, templateType, unmaskSensitiveData, maskUnknownVariable, redactExternalValues)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(resourceQualifiers.Scope, string, parsers.VariableTemplateType, bool, bool, bool) map[string]string); ok {
+		r1 = rf(scope, template, templateType, unmaskSensitiveData, maskUnknownVariable, redactExternalValues)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(map[string]string)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(resourceQualifiers.Scope, string, parsers.VariableTemplateType, bool, bool, bool) map[string]*models.VariableResolutionTrace); ok {
+		r2 = rf(scope, template, templateType, unmaskSensitiveData, maskUnknownVariable, redactExternalValues)
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(map[string]*models.VariableResolutionTrace)
+		}
+	}
+
+	if rf, ok := ret.Get(3).(func(resourceQualifiers.Scope, string, parsers.VariableTemplateType, bool, bool, bool) error); ok {
+		r3 = rf(scope, template, templateType, unmaskSensitiveData, maskUnknownVariable, redactExternalValues)
+	} else {
+		r3 = ret.Error(3)
+	}
+
+	return r0, r1, r2, r3
+}
+
+// GetEntityToVariableMapping provides a mock function with given fields: entity
+func (_m *ScopedVariableCMCSManager) GetEntityToVariableMapping(entity []repository.Entity) (map[repository.Entity][]string, error) {
+	ret := _m.Called(entity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEntityToVariableMapping")
+	}
+
+	var r0 map[repository.Entity][]string
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]repository.Entity) (map[repository.Entity][]string, error)); ok {
+		return rf(entity)
+	}
+	if rf, ok := ret.Get(0).(func([]repository.Entity) map[repository.Entity][]string); ok {
+		r0 = rf(entity)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[repository.Entity][]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]repository.Entity) error); ok {
+		r1 = rf(entity)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetMappedVariablesAndResolveTemplate provides a mock function with given fields: template, scope, entity, unmaskSensitiveData
+func (_m *ScopedVariableCMCSManager) GetMappedVariablesAndResolveTemplate(template string, scope resourceQualifiers.Scope, entity repository.Entity, unmaskSensitiveData bool) (string, map[string]string, error) {
+	ret := _m.Called(template, scope, entity, unmaskSensitiveData)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMappedVariablesAndResolveTemplate")
+	}
+
+	var r0 string
+	var r1 map[string]string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(string, resourceQualifiers.Scope, repository.Entity, bool) (string, map[string]string, error)); ok {
+		return rf(template, scope, entity, unmaskSensitiveData)
+	}
+	if rf, ok := ret.Get(0).(func(string, resourceQualifiers.Scope, repository.Entity, bool) string); ok {
+		r0 = rf(template, scope, entity, unmaskSensitiveData)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, resourceQualifiers.Scope, repository.Entity, bool) map[string]string); ok {
+		r1 = rf(template, scope, entity, unmaskSensitiveData)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(map[string]string)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(string, resourceQualifiers.Scope, repository.Entity, bool) error); ok {
+		r2 = rf(template, scope, entity, unmaskSensitiveData)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetMappedVariablesAndResolveTemplateBatch provides a mock function with given fields: template, scope, entities
+func (_m *ScopedVariableCMCSManager) GetMappedVariablesAndResolveTemplateBatch(template string, scope resourceQualifiers.Scope, entities []repository.Entity) (string, map[string]string, error) {
+	ret := _m.Called(template, scope, entities)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMappedVariablesAndResolveTemplateBatch")
+	}
+
+	var r0 string
+	var r1 map[string]string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(string, resourceQualifiers.Scope, []repository.Entity) (string, map[string]string, error)); ok {
+		return rf(template, scope, entities)
+	}
+	if rf, ok := ret.Get(0).(func(string, resourceQualifiers.Scope, []repository.Entity) string); ok {
+		r0 = rf(template, scope, entities)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, resourceQualifiers.Scope, []repository.Entity) map[string]string); ok {
+		r1 = rf(template, scope, entities)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(map[string]string)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(string, resourceQualifiers.Scope, []repository.Entity) error); ok {
+		r2 = rf(template, scope, entities)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetResolvedCMCSHistoryDtos provides a mock function with given fields: ctx, configType, configList, history, secretList
+func (_m *ScopedVariableCMCSManager) GetResolvedCMCSHistoryDtos(ctx context.Context, configType historyrepository.ConfigType, configList bean.ConfigList, history *historyrepository.ConfigmapAndSecretHistory, secretList bean.SecretList) (map[string]bean.ConfigData, map[string]map[string]string, error) {
+	ret := _m.Called(ctx, configType, configList, history, secretList)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetResolvedCMCSHistoryDtos")
+	}
+
+	var r0 map[string]bean.ConfigData
+	var r1 map[string]map[string]string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, historyrepository.ConfigType, bean.ConfigList, *historyrepository.ConfigmapAndSecretHistory, bean.SecretList) (map[string]bean.ConfigData, map[string]map[string]string, error)); ok {
+		return rf(ctx, configType, configList, history, secretList)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, historyrepository.ConfigType, bean.ConfigList, *historyrepository.ConfigmapAndSecretHistory, bean.SecretList) map[string]bean.ConfigData); ok {
+		r0 = rf(ctx, configType, configList, history, secretList)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]bean.ConfigData)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, historyrepository.ConfigType, bean.ConfigList, *historyrepository.ConfigmapAndSecretHistory, bean.SecretList) map[string]map[string]string); ok {
+		r1 = rf(ctx, configType, configList, history, secretList)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(map[string]map[string]string)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, historyrepository.ConfigType, bean.ConfigList, *historyrepository.ConfigmapAndSecretHistory, bean.SecretList) error); ok {
+		r2 = rf(ctx, configType, configList, history, secretList)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetScopedVariables provides a mock function with given fields: scope, varNames, unmaskSensitiveData
+func (_m *ScopedVariableCMCSManager) GetScopedVariables(scope resourceQualifiers.Scope, varNames []string, unmaskSensitiveData bool) ([]*models.ScopedVariableData, error) {
+	ret := _m.Called(scope, varNames, unmaskSensitiveData)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetScopedVariables")
+	}
+
+	var r0 []*models.ScopedVariableData
+	var r1 error
+	if rf, ok := ret.Get(0).(func(resourceQualifiers.Scope, []string, bool) ([]*models.ScopedVariableData, error)); ok {
+		return rf(scope, varNames, unmaskSensitiveData)
+	}
+	if rf, ok := ret.Get(0).(func(resourceQualifiers.Scope, []string, bool) []*models.ScopedVariableData); ok {
+		r0 = rf(scope, varNames, unmaskSensitiveData)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.ScopedVariableData)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(resourceQualifiers.Scope, []string, bool) error); ok {
+		r1 = rf(scope, varNames, unmaskSensitiveData)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetVariableSnapshotAndResolveTemplate provides a mock function with given fields: template, templateType, reference, isSuperAdmin, ignoreUnknown, redactExternalValues
+func (_m *ScopedVariableCMCSManager) GetVariableSnapshotAndResolveTemplate(template string, templateType parsers.VariableTemplateType, reference repository.HistoryReference, isSuperAdmin bool, ignoreUnknown bool, redactExternalValues bool) (map[string]string, string, error) {
+	ret := _m.Called(template, templateType, reference, isSuperAdmin, ignoreUnknown, redactExternalValues)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetVariableSnapshotAndResolveTemplate")
+	}
+
+	var r0 map[string]string
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(string, parsers.VariableTemplateType, repository.HistoryReference, bool, bool, bool) (map[string]string, string, error)); ok {
+		return rf(template, templateType, reference, isSuperAdmin, ignoreUnknown, redactExternalValues)
+	}
+	if rf, ok := ret.Get(0).(func(string, parsers.VariableTemplateType, repository.HistoryReference, bool, bool, bool) map[string]string); ok {
+		r0 = rf(template, templateType, reference, isSuperAdmin, ignoreUnknown, redactExternalValues)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, parsers.VariableTemplateType, repository.HistoryReference, bool, bool, bool) string); ok {
+		r1 = rf(template, templateType, reference, isSuperAdmin, ignoreUnknown, redactExternalValues)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(string, parsers.VariableTemplateType, repository.HistoryReference, bool, bool, bool) error); ok {
+		r2 = rf(template, templateType, reference, isSuperAdmin, ignoreUnknown, redactExternalValues)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ParseTemplateWithScopedVariables provides a mock function with given fields: request
+func (_m *ScopedVariableCMCSManager) ParseTemplateWithScopedVariables(request parsers.VariableParserRequest) (string, error) {
+	ret := _m.Called(request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ParseTemplateWithScopedVariables")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(parsers.VariableParserRequest) (string, error)); ok {
+		return rf(request)
+	}
+	if rf, ok := ret.Get(0).(func(parsers.VariableParserRequest) string); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(parsers.VariableParserRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveMappedVariables provides a mock function with given fields: entityId, entityType, userId, tx
+func (_m *ScopedVariableCMCSManager) RemoveMappedVariables(entityId int, entityType repository.EntityType, userId int32, tx *pg.Tx) error {
+	ret := _m.Called(entityId, entityType, userId, tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveMappedVariables")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int, repository.EntityType, int32, *pg.Tx) error); ok {
+		r0 = rf(entityId, entityType, userId, tx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ResolveCMCS provides a mock function with given fields: ctx, scope, configAppLevelId, configEnvLevelId, mergedConfigMap, mergedSecret
+func (_m *ScopedVariableCMCSManager) ResolveCMCS(ctx context.Context, scope resourceQualifiers.Scope, configAppLevelId int, configEnvLevelId int, mergedConfigMap map[string]*bean.ConfigData, mergedSecret map[string]*bean.ConfigData) (map[string]*bean.ConfigData, map[string]*bean.ConfigData, map[string]map[string]string, map[string]map[string]string, error) {
+	ret := _m.Called(ctx, scope, configAppLevelId, configEnvLevelId, mergedConfigMap, mergedSecret)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResolveCMCS")
+	}
+
+	var r0 map[string]*bean.ConfigData
+	var r1 map[string]*bean.ConfigData
+	var r2 map[string]map[string]string
+	var r3 map[string]map[string]string
+	var r4 error
+	if rf, ok := ret.Get(0).(func(context.Context, resourceQualifiers.Scope, int, int, map[string]*bean.ConfigData, map[string]*bean.ConfigData) (map[string]*bean.ConfigData, map[string]*bean.ConfigData, map[string]map[string]string, map[string]map[string]string, error)); ok {
+		return rf(ctx, scope, configAppLevelId, configEnvLevelId, mergedConfigMap, mergedSecret)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, resourceQualifiers.Scope, int, int, map[string]*bean.ConfigData, map[string]*bean.ConfigData) map[string]*bean.ConfigData); ok {
+		r0 = rf(ctx, scope, configAppLevelId, configEnvLevelId, mergedConfigMap, mergedSecret)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]*bean.ConfigData)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, resourceQualifiers.Scope, int, int, map[string]*bean.ConfigData, map[string]*bean.ConfigData) map[string]*bean.ConfigData); ok {
+		r1 = rf(ctx, scope, configAppLevelId, configEnvLevelId, mergedConfigMap, mergedSecret)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(map[string]*bean.ConfigData)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, resourceQualifiers.Scope, int, int, map[string]*bean.ConfigData, map[string]*bean.ConfigData) map[string]map[string]string); ok {
+		r2 = rf(ctx, scope, configAppLevelId, configEnvLevelId, mergedConfigMap, mergedSecret)
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(map[string]map[string]string)
+		}
+	}
+
+	if rf, ok := ret.Get(3).(func(context.Context, resourceQualifiers.Scope, int, int, map[string]*bean.ConfigData, map[string]*bean.ConfigData) map[string]map[string]string); ok {
+		r3 = rf(ctx, scope, configAppLevelId, configEnvLevelId, mergedConfigMap, mergedSecret)
+	} else {
+		if ret.Get(3) != nil {
+			r3 = ret.Get(3).(map[string]map[string]string)
+		}
+	}
+
+	if rf, ok := ret.Get(4).(func(context.Context, resourceQualifiers.Scope, int, int, map[string]*bean.ConfigData, map[string]*bean.ConfigData) error); ok {
+		r4 = rf(ctx, scope, configAppLevelId, configEnvLevelId, mergedConfigMap, mergedSecret)
+	} else {
+		r4 = ret.Error(4)
+	}
+
+	return r0, r1, r2, r3, r4
+}
+
+// ResolveCMCSHistoryDto provides a mock function with given fields: ctx, configType, configList, history, componentName, secretList
+func (_m *ScopedVariableCMCSManager) ResolveCMCSHistoryDto(ctx context.Context, configType historyrepository.ConfigType, configList bean.ConfigList, history *historyrepository.ConfigmapAndSecretHistory, componentName string, secretList bean.SecretList) (map[string]string, string, error) {
+	ret := _m.Called(ctx, configType, configList, history, componentName, secretList)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResolveCMCSHistoryDto")
+	}
+
+	var r0 map[string]string
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, historyrepository.ConfigType, bean.ConfigList, *historyrepository.ConfigmapAndSecretHistory, string, bean.SecretList) (map[string]string, string, error)); ok {
+		return rf(ctx, configType, configList, history, componentName, secretList)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, historyrepository.ConfigType, bean.ConfigList, *historyrepository.ConfigmapAndSecretHistory, string, bean.SecretList) map[string]string); ok {
+		r0 = rf(ctx, configType, configList, history, componentName, secretList)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, historyrepository.ConfigType, bean.ConfigList, *historyrepository.ConfigmapAndSecretHistory, string, bean.SecretList) string); ok {
+		r1 = rf(ctx, configType, configList, history, componentName, secretList)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, historyrepository.ConfigType, bean.ConfigList, *historyrepository.ConfigmapAndSecretHistory, string, bean.SecretList) error); ok {
+		r2 = rf(ctx, configType, configList, history, componentName, secretList)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ResolveCMCSTrigger provides a mock function with given fields: cType, scope, configMapAppId, configMapEnvId, configMapByte, secretDataByte, configMapHistoryId, secretHistoryId
+func (_m *ScopedVariableCMCSManager) ResolveCMCSTrigger(cType apibean.DeploymentConfigurationType, scope resourceQualifiers.Scope, configMapAppId int, configMapEnvId int, configMapByte []byte, secretDataByte []byte, configMapHistoryId int, secretHistoryId int) (string, string, map[string]string, map[string]string, error) {
+	ret := _m.Called(cType, scope, configMapAppId, configMapEnvId, configMapByte, secretDataByte, configMapHistoryId, secretHistoryId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResolveCMCSTrigger")
+	}
+
+	var r0 string
+	var r1 string
+	var r2 map[string]string
+	var r3 map[string]string
+	var r4 error
+	if rf, ok := ret.Get(0).(func(apibean.DeploymentConfigurationType, resourceQualifiers.Scope, int, int, []byte, []byte, int, int) (string, string, map[string]string, map[string]string, error)); ok {
+		return rf(cType, scope, configMapAppId, configMapEnvId, configMapByte, secretDataByte, configMapHistoryId, secretHistoryId)
+	}
+	if rf, ok := ret.Get(0).(func(apibean.DeploymentConfigurationType, resourceQualifiers.Scope, int, int, []byte, []byte, int, int) string); ok {
+		r0 = rf(cType, scope, configMapAppId, configMapEnvId, configMapByte, secretDataByte, configMapHistoryId, secretHistoryId)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(apibean.DeploymentConfigurationType, resourceQualifiers.Scope, int, int, []byte, []byte, int, int) string); ok {
+		r1 = rf(cType, scope, configMapAppId, configMapEnvId, configMapByte, secretDataByte, configMapHistoryId, secretHistoryId)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(apibean.DeploymentConfigurationType, resourceQualifiers.Scope, int, int, []byte, []byte, int, int) map[string]string); ok {
+		r2 = rf(cType, scope, configMapAppId, configMapEnvId, configMapByte, secretDataByte, configMapHistoryId, secretHistoryId)
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(map[string]string)
+		}
+	}
+
+	if rf, ok := ret.Get(3).(func(apibean.DeploymentConfigurationType, resourceQualifiers.Scope, int, int, []byte, []byte, int, int) map[string]string); ok {
+		r3 = rf(cType, scope, configMapAppId, configMapEnvId, configMapByte, secretDataByte, configMapHistoryId, secretHistoryId)
+	} else {
+		if ret.Get(3) != nil {
+			r3 = ret.Get(3).(map[string]string)
+		}
+	}
+
+	if rf, ok := ret.Get(4).(func(apibean.DeploymentConfigurationType, resourceQualifiers.Scope, int, int, []byte, []byte, int, int) error); ok {
+		r4 = rf(cType, scope, configMapAppId, configMapEnvId, configMapByte, secretDataByte, configMapHistoryId, secretHistoryId)
+	} else {
+		r4 = ret.Error(4)
+	}
+
+	return r0, r1, r2, r3, r4
+}
+
+// ResolveExternalValues provides a mock function with given fields: scopedVariables
+func (_m *ScopedVariableCMCSManager) ResolveExternalValues(scopedVariables []*models.ScopedVariableData) error {
+	ret := _m.Called(scopedVariables)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResolveExternalValues")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]*models.ScopedVariableData) error); ok {
+		r0 = rf(scopedVariables)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ResolveForPrePostStageTrigger provides a mock function with given fields: scope, configResponse, secretResponse, cmAppId, cmEnvId
+func (_m *ScopedVariableCMCSManager) ResolveForPrePostStageTrigger(scope resourceQualifiers.Scope, configResponse apibean.ConfigMapJson, secretResponse apibean.ConfigSecretJson, cmAppId int, cmEnvId int) (*apibean.ConfigMapJson, *apibean.ConfigSecretJson, error) {
+	ret := _m.Called(scope, configResponse, secretResponse, cmAppId, cmEnvId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResolveForPrePostStageTrigger")
+	}
+
+	var r0 *apibean.ConfigMapJson
+	var r1 *apibean.ConfigSecretJson
+	var r2 error
+	if rf, ok := ret.Get(0).(func(resourceQualifiers.Scope, apibean.ConfigMapJson, apibean.ConfigSecretJson, int, int) (*apibean.ConfigMapJson, *apibean.ConfigSecretJson, error)); ok {
+		return rf(scope, configResponse, secretResponse, cmAppId, cmEnvId)
+	}
+	if rf, ok := ret.Get(0).(func(resourceQualifiers.Scope, apibean.ConfigMapJson, apibean.ConfigSecretJson, int, int) *apibean.ConfigMapJson); ok {
+		r0 = rf(scope, configResponse, secretResponse, cmAppId, cmEnvId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*apibean.ConfigMapJson)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(resourceQualifiers.Scope, apibean.ConfigMapJson, apibean.ConfigSecretJson, int, int) *apibean.ConfigSecretJson); ok {
+		r1 = rf(scope, configResponse, secretResponse, cmAppId, cmEnvId)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*apibean.ConfigSecretJson)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(resourceQualifiers.Scope, apibean.ConfigMapJson, apibean.ConfigSecretJson, int, int) error); ok {
+		r2 = rf(scope, configResponse, secretResponse, cmAppId, cmEnvId)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// SaveVariableHistoriesForTrigger provides a mock function with given fields: variableHistories, userId
+func (_m *ScopedVariableCMCSManager) SaveVariableHistoriesForTrigger(variableHistories []*repository.VariableSnapshotHistoryBean, userId int32) error {
+	ret := _m.Called(variableHistories, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveVariableHistoriesForTrigger")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]*repository.VariableSnapshotHistoryBean, int32) error); ok {
+		r0 = rf(variableHistories, userId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewScopedVariableCMCSManager creates a new instance of ScopedVariableCMCSManager. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewScopedVariableCMCSManager(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ScopedVariableCMCSManager {
+	mock := &ScopedVariableCMCSManager{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
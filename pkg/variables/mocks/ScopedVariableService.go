@@ -0,0 +1,362 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	resourceQualifiers "github.com/devtron-labs/devtron/pkg/resourceQualifiers"
+	models "github.com/devtron-labs/devtron/pkg/variables/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ScopedVariableService is an autogenerated mock type for the ScopedVariableService type
+type ScopedVariableService struct {
+	mock.Mock
+}
+
+// CheckForSensitiveVariables provides a mock function with given fields: variableNames
+func (_m *ScopedVariableService) CheckForSensitiveVariables(variableNames []string) (map[string]bool, error) {
+	ret := _m.Called(variableNames)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckForSensitiveVariables")
+	}
+
+	var r0 map[string]bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]string) (map[string]bool, error)); ok {
+		return rf(variableNames)
+	}
+	if rf, ok := ret.Get(0).(func([]string) map[string]bool); ok {
+		r0 = rf(variableNames)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]bool)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]string) error); ok {
+		r1 = rf(variableNames)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateVariableSetVersion provides a mock function with given fields: payload, comment
+func (_m *ScopedVariableService) CreateVariableSetVersion(payload models.Payload, comment string) (*models.VariableSetVersion, error) {
+	ret := _m.Called(payload, comment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateVariableSetVersion")
+	}
+
+	var r0 *models.VariableSetVersion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.Payload, string) (*models.VariableSetVersion, error)); ok {
+		return rf(payload, comment)
+	}
+	if rf, ok := ret.Get(0).(func(models.Payload, string) *models.VariableSetVersion); ok {
+		r0 = rf(payload, comment)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.VariableSetVersion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(models.Payload, string) error); ok {
+		r1 = rf(payload, comment)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateVariables provides a mock function with given fields: payload
+func (_m *ScopedVariableService) CreateVariables(payload models.Payload) error {
+	ret := _m.Called(payload)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateVariables")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(models.Payload) error); ok {
+		r0 = rf(payload)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetFormattedVariableForName provides a mock function with given fields: name
+func (_m *ScopedVariableService) GetFormattedVariableForName(name string) string {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFormattedVariableForName")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// GetJsonForVariables provides a mock function with no fields
+func (_m *ScopedVariableService) GetJsonForVariables() (*models.Payload, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJsonForVariables")
+	}
+
+	var r0 *models.Payload
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (*models.Payload, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() *models.Payload); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Payload)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetMatchedScopedVariables provides a mock function with given fields: varScope
+func (_m *ScopedVariableService) GetMatchedScopedVariables(varScope []*resourceQualifiers.QualifierMapping) map[int][]*resourceQualifiers.QualifierMapping {
+	ret := _m.Called(varScope)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMatchedScopedVariables")
+	}
+
+	var r0 map[int][]*resourceQualifiers.QualifierMapping
+	if rf, ok := ret.Get(0).(func([]*resourceQualifiers.QualifierMapping) map[int][]*resourceQualifiers.QualifierMapping); ok {
+		r0 = rf(varScope)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int][]*resourceQualifiers.QualifierMapping)
+		}
+	}
+
+	return r0
+}
+
+// GetScopeWithPriority provides a mock function with given fields: variableIdToVariableScopes
+func (_m *ScopedVariableService) GetScopeWithPriority(variableIdToVariableScopes map[int][]*resourceQualifiers.QualifierMapping) map[int]int {
+	ret := _m.Called(variableIdToVariableScopes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetScopeWithPriority")
+	}
+
+	var r0 map[int]int
+	if rf, ok := ret.Get(0).(func(map[int][]*resourceQualifiers.QualifierMapping) map[int]int); ok {
+		r0 = rf(variableIdToVariableScopes)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int]int)
+		}
+	}
+
+	return r0
+}
+
+// GetScopedVariables provides a mock function with given fields: scope, varNames, unmaskSensitiveData
+func (_m *ScopedVariableService) GetScopedVariables(scope resourceQualifiers.Scope, varNames []string, unmaskSensitiveData bool) ([]*models.ScopedVariableData, error) {
+	ret := _m.Called(scope, varNames, unmaskSensitiveData)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetScopedVariables")
+	}
+
+	var r0 []*models.ScopedVariableData
+	var r1 error
+	if rf, ok := ret.Get(0).(func(resourceQualifiers.Scope, []string, bool) ([]*models.ScopedVariableData, error)); ok {
+		return rf(scope, varNames, unmaskSensitiveData)
+	}
+	if rf, ok := ret.Get(0).(func(resourceQualifiers.Scope, []string, bool) []*models.ScopedVariableData); ok {
+		r0 = rf(scope, varNames, unmaskSensitiveData)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.ScopedVariableData)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(resourceQualifiers.Scope, []string, bool) error); ok {
+		r1 = rf(scope, varNames, unmaskSensitiveData)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetVariableSetVersion provides a mock function with given fields: id
+func (_m *ScopedVariableService) GetVariableSetVersion(id int) (*models.VariableSetVersion, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetVariableSetVersion")
+	}
+
+	var r0 *models.VariableSetVersion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (*models.VariableSetVersion, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(int) *models.VariableSetVersion); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.VariableSetVersion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetVariableSetVersions provides a mock function with no fields
+func (_m *ScopedVariableService) GetVariableSetVersions() ([]*models.VariableSetVersion, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetVariableSetVersions")
+	}
+
+	var r0 []*models.VariableSetVersion
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]*models.VariableSetVersion, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []*models.VariableSetVersion); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.VariableSetVersion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReviewVariableSetVersion provides a mock function with given fields: request, userId
+func (_m *ScopedVariableService) ReviewVariableSetVersion(request *models.VariableSetReviewRequest, userId int32) (*models.VariableSetVersion, error) {
+	ret := _m.Called(request, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReviewVariableSetVersion")
+	}
+
+	var r0 *models.VariableSetVersion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*models.VariableSetReviewRequest, int32) (*models.VariableSetVersion, error)); ok {
+		return rf(request, userId)
+	}
+	if rf, ok := ret.Get(0).(func(*models.VariableSetReviewRequest, int32) *models.VariableSetVersion); ok {
+		r0 = rf(request, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.VariableSetVersion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*models.VariableSetReviewRequest, int32) error); ok {
+		r1 = rf(request, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RollbackVariableSetVersion provides a mock function with given fields: request, userId
+func (_m *ScopedVariableService) RollbackVariableSetVersion(request *models.VariableSetRollbackRequest, userId int32) (*models.VariableSetVersion, error) {
+	ret := _m.Called(request, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RollbackVariableSetVersion")
+	}
+
+	var r0 *models.VariableSetVersion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*models.VariableSetRollbackRequest, int32) (*models.VariableSetVersion, error)); ok {
+		return rf(request, userId)
+	}
+	if rf, ok := ret.Get(0).(func(*models.VariableSetRollbackRequest, int32) *models.VariableSetVersion); ok {
+		r0 = rf(request, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.VariableSetVersion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*models.VariableSetRollbackRequest, int32) error); ok {
+		r1 = rf(request, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ValidateVariables provides a mock function with given fields: payload
+func (_m *ScopedVariableService) ValidateVariables(payload models.Payload) error {
+	ret := _m.Called(payload)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateVariables")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(models.Payload) error); ok {
+		r0 = rf(payload)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewScopedVariableService creates a new instance of ScopedVariableService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewScopedVariableService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ScopedVariableService {
+	mock := &ScopedVariableService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -56,6 +56,32 @@ func (_m *VariableEntityMappingRepository) DeleteVariablesForEntity(tx *pg.Tx, v
 	return r0
 }
 
+// GetEntitiesForVariables provides a mock function with given fields: variableNames
+func (_m *VariableEntityMappingRepository) GetEntitiesForVariables(variableNames []string) ([]*repository.VariableEntityMapping, error) {
+	ret := _m.Called(variableNames)
+
+	var r0 []*repository.VariableEntityMapping
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]string) ([]*repository.VariableEntityMapping, error)); ok {
+		return rf(variableNames)
+	}
+	if rf, ok := ret.Get(0).(func([]string) []*repository.VariableEntityMapping); ok {
+		r0 = rf(variableNames)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*repository.VariableEntityMapping)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]string) error); ok {
+		r1 = rf(variableNames)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetVariablesForEntities provides a mock function with given fields: entities
 func (_m *VariableEntityMappingRepository) GetVariablesForEntities(entities []repository.Entity) ([]*repository.VariableEntityMapping, error) {
 	ret := _m.Called(entities)
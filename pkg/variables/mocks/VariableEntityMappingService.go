@@ -26,6 +26,32 @@ func (_m *VariableEntityMappingService) DeleteMappingsForEntities(entities []rep
 	return r0
 }
 
+// GetAllEntitiesForVariable provides a mock function with given fields: variableName
+func (_m *VariableEntityMappingService) GetAllEntitiesForVariable(variableName string) ([]repository.Entity, error) {
+	ret := _m.Called(variableName)
+
+	var r0 []repository.Entity
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]repository.Entity, error)); ok {
+		return rf(variableName)
+	}
+	if rf, ok := ret.Get(0).(func(string) []repository.Entity); ok {
+		r0 = rf(variableName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.Entity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(variableName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetAllMappingsForEntities provides a mock function with given fields: entities
 func (_m *VariableEntityMappingService) GetAllMappingsForEntities(entities []repository.Entity) (map[repository.Entity][]string, error) {
 	ret := _m.Called(entities)
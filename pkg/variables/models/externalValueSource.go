@@ -0,0 +1,93 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+type ExternalSecretBackend string
+
+const (
+	VaultKV           ExternalSecretBackend = "VAULT_KV"
+	AWSSecretsManager ExternalSecretBackend = "AWS_SECRETS_MANAGER"
+	GCPSecretManager  ExternalSecretBackend = "GCP_SECRET_MANAGER"
+	KubernetesSecret  ExternalSecretBackend = "KUBERNETES_SECRET"
+)
+
+// ExternalValueReferencePrefix marks a value captured in variable snapshots in place of the secret fetched from an external source
+const ExternalValueReferencePrefix = "external-secret-ref:"
+
+// ExternalValueSource references a secret which is fetched at trigger time instead of being stored in devtron.
+// Path is the api path of the secret for vault (e.g. secret/data/payments/db), the secret id or arn for aws,
+// the secret resource name for gcp (projects/<project>/secrets/<name>) and the secret name for kubernetes.
+type ExternalValueSource struct {
+	Backend     ExternalSecretBackend `json:"backend" validate:"oneof=VAULT_KV AWS_SECRETS_MANAGER GCP_SECRET_MANAGER KUBERNETES_SECRET"`
+	Path        string                `json:"path" validate:"required"`
+	Key         string                `json:"key,omitempty"`
+	Version     string                `json:"version,omitempty"`
+	Region      string                `json:"region,omitempty"`
+	ClusterName string                `json:"clusterName,omitempty"`
+	Namespace   string                `json:"namespace,omitempty"`
+}
+
+func (source *ExternalValueSource) Validate() error {
+	if len(source.Path) == 0 {
+		return fmt.Errorf("path is required for external value source")
+	}
+	switch source.Backend {
+	case VaultKV:
+		if len(source.Key) == 0 {
+			return fmt.Errorf("key is required for %s value source", source.Backend)
+		}
+	case KubernetesSecret:
+		if len(source.Key) == 0 || len(source.ClusterName) == 0 || len(source.Namespace) == 0 {
+			return fmt.Errorf("key, clusterName and namespace are required for %s value source", source.Backend)
+		}
+	case AWSSecretsManager, GCPSecretManager:
+	default:
+		return fmt.Errorf("unsupported external value source backend %s", source.Backend)
+	}
+	return nil
+}
+
+// GetRedactedReference returns the reference of the secret which is safe to be persisted in place of its value
+func (source *ExternalValueSource) GetRedactedReference() string {
+	reference, _ := json.Marshal(source)
+	return ExternalValueReferencePrefix + string(reference)
+}
+
+// IsExternalValueReference returns true if the value is a string carrying the reference prefix
+func IsExternalValueReference(value interface{}) bool {
+	reference, ok := value.(string)
+	return ok && strings.HasPrefix(reference, ExternalValueReferencePrefix)
+}
+
+// GetExternalValueSourceFromReference parses a reference created by GetRedactedReference, returns nil for any other value
+func GetExternalValueSourceFromReference(value interface{}) *ExternalValueSource {
+	if !IsExternalValueReference(value) {
+		return nil
+	}
+	source := &ExternalValueSource{}
+	err := json.Unmarshal([]byte(strings.TrimPrefix(value.(string), ExternalValueReferencePrefix)), source)
+	if err != nil {
+		return nil
+	}
+	return source
+}
@@ -25,6 +25,33 @@ type ScopedVariableData struct {
 	ShortDescription string         `json:"shortDescription"`
 	VariableValue    *VariableValue `json:"variableValue,omitempty"`
 	IsRedacted       bool           `json:"isRedacted"`
+	// ResolutionTrace is only populated for user defined variables which got resolved for the requested scope
+	ResolutionTrace *VariableResolutionTrace `json:"resolutionTrace,omitempty"`
+	// ValueSource is set for variables whose value is fetched from an external source
+	ValueSource *ExternalValueSource `json:"valueSource,omitempty"`
+	Constraints *VariableConstraints `json:"constraints,omitempty"`
+	DataType    DataType             `json:"-"`
+}
+
+// GetSnapshotValue returns the value to be captured in variable snapshots,
+// values fetched from external sources are captured as their redacted reference
+func (data *ScopedVariableData) GetSnapshotValue() string {
+	if data.ValueSource != nil && !data.IsRedacted {
+		return data.ValueSource.GetRedactedReference()
+	}
+	return data.VariableValue.StringValue()
+}
+
+// VariableResolutionTrace describes the scope which supplied the value of a variable
+// along with the matching scopes which were overridden because of lower priority
+type VariableResolutionTrace struct {
+	ResolvedFrom *VariableScopeTrace   `json:"resolvedFrom"`
+	Overridden   []*VariableScopeTrace `json:"overridden,omitempty"`
+}
+
+type VariableScopeTrace struct {
+	AttributeType   AttributeType             `json:"attributeType"`
+	AttributeParams map[IdentifierType]string `json:"attributeParams,omitempty"`
 }
 
 type VariableScopeMapping struct {
@@ -34,5 +61,6 @@ type VariableScopeMapping struct {
 type VariableScope struct {
 	id int
 	*resourceQualifiers.ResourceMappingSelection
-	Data string
+	Data        string
+	ValueSource *ExternalValueSource
 }
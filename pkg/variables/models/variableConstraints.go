@@ -0,0 +1,260 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/ghodss/yaml"
+	"github.com/xeipuuv/gojsonschema"
+)
+
+type ValueType string
+
+const (
+	StringValueType  ValueType = "string"
+	IntegerValueType ValueType = "integer"
+	NumberValueType  ValueType = "number"
+	BooleanValueType ValueType = "boolean"
+)
+
+// VariableConstraints restricts the values of a variable, values are checked on upload and when templates are resolved.
+// Except JsonSchema, constraints only apply to primitive variables
+type VariableConstraints struct {
+	ValueType ValueType     `json:"valueType,omitempty"`
+	Enum      []interface{} `json:"enum,omitempty"`
+	// Pattern is matched against string values
+	Pattern string   `json:"pattern,omitempty"`
+	Minimum *float64 `json:"minimum,omitempty"`
+	Maximum *float64 `json:"maximum,omitempty"`
+	// MinLength and MaxLength are the bounds of the number of characters of string values
+	MinLength  *int            `json:"minLength,omitempty"`
+	MaxLength  *int            `json:"maxLength,omitempty"`
+	JsonSchema json.RawMessage `json:"jsonSchema,omitempty"`
+}
+
+// Validate checks that the constraints can be satisfied and are applicable to the data type
+func (constraints *VariableConstraints) Validate(dataType DataType) error {
+	if constraints == nil {
+		return nil
+	}
+	switch constraints.ValueType {
+	case "", StringValueType, IntegerValueType, NumberValueType, BooleanValueType:
+	default:
+		return fmt.Errorf("invalid value type %s, supported types are string, integer, number and boolean", constraints.ValueType)
+	}
+	if dataType == JSON_TYPE || dataType == YAML_TYPE {
+		if constraints.hasPrimitiveConstraints() {
+			return fmt.Errorf("only json schema is supported for %s variables", dataType)
+		}
+	}
+	if constraints.Pattern != "" {
+		if _, err := regexp.Compile(constraints.Pattern); err != nil {
+			return fmt.Errorf("invalid pattern %s: %v", constraints.Pattern, err)
+		}
+	}
+	if constraints.Minimum != nil && constraints.Maximum != nil && *constraints.Minimum > *constraints.Maximum {
+		return fmt.Errorf("minimum %v is greater than maximum %v", *constraints.Minimum, *constraints.Maximum)
+	}
+	if (constraints.MinLength != nil && *constraints.MinLength < 0) || (constraints.MaxLength != nil && *constraints.MaxLength < 0) {
+		return fmt.Errorf("minLength and maxLength cannot be negative")
+	}
+	if constraints.MinLength != nil && constraints.MaxLength != nil && *constraints.MinLength > *constraints.MaxLength {
+		return fmt.Errorf("minLength %d is greater than maxLength %d", *constraints.MinLength, *constraints.MaxLength)
+	}
+	if len(constraints.JsonSchema) > 0 {
+		if _, err := gojsonschema.NewSchema(gojsonschema.NewBytesLoader(constraints.JsonSchema)); err != nil {
+			return fmt.Errorf("invalid json schema: %v", err)
+		}
+	}
+	for _, enumValue := range constraints.Enum {
+		if err := constraints.validateType(enumValue); err != nil {
+			return fmt.Errorf("invalid enum value %v: %v", enumValue, err)
+		}
+	}
+	return nil
+}
+
+// ValidateValue checks the value against the constraints, the value can be either received in a payload or read back from a saved variable
+func (constraints *VariableConstraints) ValidateValue(dataType DataType, value interface{}) error {
+	if constraints == nil {
+		return nil
+	}
+	if dataType == JSON_TYPE || dataType == YAML_TYPE {
+		return constraints.validateDocument(dataType, value)
+	}
+	if err := constraints.validateType(value); err != nil {
+		return err
+	}
+	normalizedValue := normalizeConstraintValue(value)
+	if len(constraints.Enum) > 0 && !constraints.isEnumValue(normalizedValue) {
+		return fmt.Errorf("must be one of %v", constraints.Enum)
+	}
+	switch typedValue := normalizedValue.(type) {
+	case string:
+		if constraints.Pattern != "" {
+			pattern, err := regexp.Compile(constraints.Pattern)
+			if err != nil {
+				return fmt.Errorf("invalid pattern %s: %v", constraints.Pattern, err)
+			}
+			if !pattern.MatchString(typedValue) {
+				return fmt.Errorf("must match pattern %s", constraints.Pattern)
+			}
+		}
+		length := utf8.RuneCountInString(typedValue)
+		if constraints.MinLength != nil && length < *constraints.MinLength {
+			return fmt.Errorf("must be at least %d characters long", *constraints.MinLength)
+		}
+		if constraints.MaxLength != nil && length > *constraints.MaxLength {
+			return fmt.Errorf("must be at most %d characters long", *constraints.MaxLength)
+		}
+	case float64:
+		if constraints.Minimum != nil && typedValue < *constraints.Minimum {
+			return fmt.Errorf("must be greater than or equal to %v", *constraints.Minimum)
+		}
+		if constraints.Maximum != nil && typedValue > *constraints.Maximum {
+			return fmt.Errorf("must be less than or equal to %v", *constraints.Maximum)
+		}
+	}
+	if len(constraints.JsonSchema) > 0 {
+		return validateJsonSchema(constraints.JsonSchema, normalizedValue)
+	}
+	return nil
+}
+
+func (constraints *VariableConstraints) hasPrimitiveConstraints() bool {
+	return constraints.ValueType != "" || len(constraints.Enum) > 0 || constraints.Pattern != "" || constraints.Minimum != nil ||
+		constraints.Maximum != nil || constraints.MinLength != nil || constraints.MaxLength != nil
+}
+
+func (constraints *VariableConstraints) validateType(value interface{}) error {
+	normalizedValue := normalizeConstraintValue(value)
+	switch constraints.ValueType {
+	case StringValueType:
+		if _, ok := normalizedValue.(string); !ok {
+			return fmt.Errorf("expected a string but got %v", value)
+		}
+	case IntegerValueType:
+		number, ok := normalizedValue.(float64)
+		if !ok || number != math.Trunc(number) {
+			return fmt.Errorf("expected an integer but got %v", describeValue(value))
+		}
+	case NumberValueType:
+		if _, ok := normalizedValue.(float64); !ok {
+			return fmt.Errorf("expected a number but got %v", describeValue(value))
+		}
+	case BooleanValueType:
+		if _, ok := normalizedValue.(bool); !ok {
+			return fmt.Errorf("expected a boolean but got %v", describeValue(value))
+		}
+	}
+	return nil
+}
+
+func (constraints *VariableConstraints) isEnumValue(normalizedValue interface{}) bool {
+	for _, enumValue := range constraints.Enum {
+		if normalizeConstraintValue(enumValue) == normalizedValue {
+			return true
+		}
+	}
+	return false
+}
+
+func (constraints *VariableConstraints) validateDocument(dataType DataType, value interface{}) error {
+	if len(constraints.JsonSchema) == 0 {
+		return nil
+	}
+	stringValue, ok := value.(string)
+	if !ok {
+		return fmt.Errorf("expected a %s document", dataType)
+	}
+	documentJson := []byte(stringValue)
+	if dataType == YAML_TYPE {
+		var err error
+		documentJson, err = yaml.YAMLToJSON(documentJson)
+		if err != nil {
+			return fmt.Errorf("invalid yaml: %v", err)
+		}
+	}
+	var document interface{}
+	if err := json.Unmarshal(documentJson, &document); err != nil {
+		return fmt.Errorf("invalid json: %v", err)
+	}
+	return validateJsonSchema(constraints.JsonSchema, document)
+}
+
+func validateJsonSchema(schema json.RawMessage, document interface{}) error {
+	result, err := gojsonschema.Validate(gojsonschema.NewBytesLoader(schema), gojsonschema.NewGoLoader(document))
+	if err != nil {
+		return fmt.Errorf("invalid json schema: %v", err)
+	}
+	if result.Valid() {
+		return nil
+	}
+	schemaErrors := make([]string, 0, len(result.Errors()))
+	for _, resultError := range result.Errors() {
+		schemaErrors = append(schemaErrors, resultError.String())
+	}
+	sort.Strings(schemaErrors)
+	return fmt.Errorf("does not match json schema: %s", strings.Join(schemaErrors, "; "))
+}
+
+// normalizeConstraintValue converts all numbers to float64 so that values received in payloads
+// and values read back from saved variables are compared the same way
+func normalizeConstraintValue(value interface{}) interface{} {
+	switch typedValue := value.(type) {
+	case json.Number:
+		if number, err := typedValue.Float64(); err == nil {
+			return number
+		}
+		return typedValue.String()
+	case int:
+		return float64(typedValue)
+	case int64:
+		return float64(typedValue)
+	case float32:
+		return float64(typedValue)
+	}
+	return value
+}
+
+func describeValue(value interface{}) string {
+	if stringValue, ok := value.(string); ok {
+		return fmt.Sprintf("string %q", stringValue)
+	}
+	return fmt.Sprint(value)
+}
+
+// GetScopeDescription describes a scope of a variable value in errors, e.g. ApplicationEnv scope [ApplicationName=app, EnvName=env]
+func GetScopeDescription(attributeType AttributeType, attributeParams map[IdentifierType]string) string {
+	if len(attributeParams) == 0 {
+		return fmt.Sprintf("%s scope", attributeType)
+	}
+	params := make([]string, 0, len(attributeParams))
+	for _, identifierType := range IdentifiersList {
+		if name, ok := attributeParams[identifierType]; ok {
+			params = append(params, fmt.Sprintf("%s=%s", identifierType, name))
+		}
+	}
+	return fmt.Sprintf("%s scope [%s]", attributeType, strings.Join(params, ", "))
+}
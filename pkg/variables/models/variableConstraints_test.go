@@ -0,0 +1,119 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package models
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVariableConstraints_ValidateValue(t *testing.T) {
+	minimum, maximum := 1.0, 10.0
+	maxLength := 5
+	tests := []struct {
+		name        string
+		constraints *VariableConstraints
+		dataType    DataType
+		value       interface{}
+		wantErr     bool
+	}{
+		{
+			name:        "integer from payload",
+			constraints: &VariableConstraints{ValueType: IntegerValueType, Minimum: &minimum, Maximum: &maximum},
+			value:       json.Number("5"),
+		},
+		{
+			name:        "integer read back from saved variable",
+			constraints: &VariableConstraints{ValueType: IntegerValueType},
+			value:       5,
+		},
+		{
+			name:        "string where integer is expected",
+			constraints: &VariableConstraints{ValueType: IntegerValueType},
+			value:       "5",
+			wantErr:     true,
+		},
+		{
+			name:        "float where integer is expected",
+			constraints: &VariableConstraints{ValueType: IntegerValueType},
+			value:       json.Number("5.5"),
+			wantErr:     true,
+		},
+		{
+			name:        "number above maximum",
+			constraints: &VariableConstraints{ValueType: NumberValueType, Maximum: &maximum},
+			value:       10.5,
+			wantErr:     true,
+		},
+		{
+			name:        "enum matches numbers of different types",
+			constraints: &VariableConstraints{Enum: []interface{}{json.Number("1"), json.Number("2")}},
+			value:       2,
+		},
+		{
+			name:        "value outside enum",
+			constraints: &VariableConstraints{Enum: []interface{}{"dev", "prod"}},
+			value:       "qa",
+			wantErr:     true,
+		},
+		{
+			name:        "pattern and length of string",
+			constraints: &VariableConstraints{ValueType: StringValueType, Pattern: "^[a-z]+$", MaxLength: &maxLength},
+			value:       "abcdef",
+			wantErr:     true,
+		},
+		{
+			name:        "primitive json schema",
+			constraints: &VariableConstraints{JsonSchema: json.RawMessage(`{"type":"string","format":"email"}`)},
+			value:       "not-an-email",
+			wantErr:     true,
+		},
+		{
+			name:        "json schema of yaml document",
+			constraints: &VariableConstraints{JsonSchema: json.RawMessage(`{"type":"object","required":["port"]}`)},
+			dataType:    YAML_TYPE,
+			value:       "host: db\nport: 5432",
+		},
+		{
+			name:        "nil constraints",
+			constraints: nil,
+			value:       "anything",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataType := tt.dataType
+			if dataType == "" {
+				dataType = PRIMITIVE_TYPE
+			}
+			err := tt.constraints.ValidateValue(dataType, tt.value)
+			assert.Equal(t, tt.wantErr, err != nil, err)
+		})
+	}
+}
+
+func TestVariableConstraints_Validate(t *testing.T) {
+	minimum, maximum := 10.0, 1.0
+	assert.Error(t, (&VariableConstraints{Minimum: &minimum, Maximum: &maximum}).Validate(PRIMITIVE_TYPE))
+	assert.Error(t, (&VariableConstraints{Pattern: "[a-"}).Validate(PRIMITIVE_TYPE))
+	assert.Error(t, (&VariableConstraints{ValueType: "date"}).Validate(PRIMITIVE_TYPE))
+	assert.Error(t, (&VariableConstraints{ValueType: IntegerValueType, Enum: []interface{}{"one"}}).Validate(PRIMITIVE_TYPE))
+	assert.Error(t, (&VariableConstraints{Pattern: "^a"}).Validate(JSON_TYPE))
+	assert.NoError(t, (&VariableConstraints{ValueType: IntegerValueType, Enum: []interface{}{json.Number("1")}}).Validate(PRIMITIVE_TYPE))
+}
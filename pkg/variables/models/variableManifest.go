@@ -18,7 +18,9 @@ package models
 
 type VariableRequest struct {
 	Manifest ScopedVariableManifest `json:"manifest"`
-	UserId   int32                  `json:"-"`
+	// Comment is recorded on the variable set version created for the request
+	Comment string `json:"comment"`
+	UserId  int32  `json:"-"`
 }
 type ScopedVariableManifest struct {
 	ApiVersion string         `json:"apiVersion" validate:"oneof=devtron.ai/v1beta1"`
@@ -27,17 +29,19 @@ type ScopedVariableManifest struct {
 }
 
 type VariableSpec struct {
-	Notes            string              `json:"notes"`
-	ShortDescription string              `json:"shortDescription" validate:"max=120"`
-	IsSensitive      bool                `json:"isSensitive"`
-	Name             string              `json:"name" validate:"required"`
-	Values           []VariableValueSpec `json:"values" validate:"dive"`
+	Notes            string               `json:"notes"`
+	ShortDescription string               `json:"shortDescription" validate:"max=120"`
+	IsSensitive      bool                 `json:"isSensitive"`
+	Name             string               `json:"name" validate:"required"`
+	Constraints      *VariableConstraints `json:"constraints,omitempty"`
+	Values           []VariableValueSpec  `json:"values" validate:"dive"`
 }
 
 type VariableValueSpec struct {
-	Category  AttributeType `json:"category" validate:"oneof=Global"`
-	Value     interface{}   `json:"value" validate:"required"`
-	Selectors *Selector     `json:"selectors,omitempty"`
+	Category  AttributeType        `json:"category" validate:"oneof=ApplicationEnv Application Env Cluster Global"`
+	Value     interface{}          `json:"value" validate:"required_without=ValueFrom"`
+	ValueFrom *ExternalValueSource `json:"valueFrom,omitempty"`
+	Selectors *Selector            `json:"selectors,omitempty"`
 }
 
 type Selector struct {
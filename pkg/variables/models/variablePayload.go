@@ -31,8 +31,10 @@ type Variables struct {
 }
 type AttributeValue struct {
 	VariableValue   VariableValue             `json:"variableValue" validate:"required,dive"`
-	AttributeType   AttributeType             `json:"attributeType" validate:"oneof=Global"`
+	AttributeType   AttributeType             `json:"attributeType" validate:"oneof=ApplicationEnv Application Env Cluster Global"`
 	AttributeParams map[IdentifierType]string `json:"attributeParams"`
+	// ValueSource when set, the value is fetched from the external source at trigger time and VariableValue is ignored
+	ValueSource *ExternalValueSource `json:"valueSource,omitempty"`
 }
 
 type Definition struct {
@@ -41,6 +43,8 @@ type Definition struct {
 	VarType          VariableType `json:"varType" validate:"oneof=private public"`
 	Description      string       `json:"description" validate:"max=300"`
 	ShortDescription string       `json:"shortDescription"`
+	// Constraints when set are enforced on upload and when templates using the variable are resolved
+	Constraints *VariableConstraints `json:"constraints,omitempty"`
 }
 
 type VariableType string
@@ -71,12 +75,22 @@ func (variableType VariableType) IsTypeSensitive() bool {
 type AttributeType string
 
 const (
-	Global AttributeType = "Global"
+	ApplicationEnv AttributeType = "ApplicationEnv"
+	Application    AttributeType = "Application"
+	Env            AttributeType = "Env"
+	Cluster        AttributeType = "Cluster"
+	Global         AttributeType = "Global"
 )
 
 type IdentifierType string
 
-var IdentifiersList []IdentifierType
+const (
+	ApplicationName IdentifierType = "ApplicationName"
+	EnvName         IdentifierType = "EnvName"
+	ClusterName     IdentifierType = "ClusterName"
+)
+
+var IdentifiersList = []IdentifierType{ApplicationName, EnvName, ClusterName}
 
 type VariableValue struct {
 	Value interface{} `json:"value" validate:"required"`
@@ -0,0 +1,89 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package models
+
+import "time"
+
+type VariableSetVersionStatus string
+
+const (
+	// VariableSetVersionActive is the version whose variables are currently saved, only one version is active at a time
+	VariableSetVersionActive VariableSetVersionStatus = "ACTIVE"
+	// VariableSetVersionPending is an upload waiting for approval, its variables are not saved yet
+	VariableSetVersionPending    VariableSetVersionStatus = "PENDING_APPROVAL"
+	VariableSetVersionSuperseded VariableSetVersionStatus = "SUPERSEDED"
+	VariableSetVersionRejected   VariableSetVersionStatus = "REJECTED"
+)
+
+// VariableSetDiff lists the variable names changed by a version against the version active at upload time
+type VariableSetDiff struct {
+	Added    []string `json:"added"`
+	Removed  []string `json:"removed"`
+	Modified []string `json:"modified"`
+	// ValueChanges lists the changed values per scope, values of private variables are masked
+	ValueChanges []*VariableValueChange `json:"valueChanges"`
+}
+
+type VariableValueChangeOperation string
+
+const (
+	VariableValueAdded    VariableValueChangeOperation = "ADDED"
+	VariableValueRemoved  VariableValueChangeOperation = "REMOVED"
+	VariableValueModified VariableValueChangeOperation = "MODIFIED"
+)
+
+// VariableValueChange is the change of the value of a variable for a scope,
+// values fetched from external sources are shown as their reference
+type VariableValueChange struct {
+	VariableName    string                       `json:"variableName"`
+	AttributeType   AttributeType                `json:"attributeType"`
+	AttributeParams map[IdentifierType]string    `json:"attributeParams,omitempty"`
+	Operation       VariableValueChangeOperation `json:"operation"`
+	OldValue        interface{}                  `json:"oldValue,omitempty"`
+	NewValue        interface{}                  `json:"newValue,omitempty"`
+}
+
+type VariableSetVersion struct {
+	Id      int                      `json:"id"`
+	Version int                      `json:"version"`
+	Status  VariableSetVersionStatus `json:"status"`
+	Comment string                   `json:"comment,omitempty"`
+	// BaseVersionId is the id of the version which was active when this version was uploaded
+	BaseVersionId int `json:"baseVersionId,omitempty"`
+	// RolledBackFromVersionId is set for versions created by rolling back to an older version
+	RolledBackFromVersionId int              `json:"rolledBackFromVersionId,omitempty"`
+	Diff                    *VariableSetDiff `json:"diff"`
+	CreatedBy               int32            `json:"createdBy"`
+	CreatedByEmail          string           `json:"createdByEmail"`
+	CreatedOn               time.Time        `json:"createdOn"`
+	ReviewedBy              int32            `json:"reviewedBy,omitempty"`
+	ReviewedByEmail         string           `json:"reviewedByEmail,omitempty"`
+	ReviewedOn              *time.Time       `json:"reviewedOn,omitempty"`
+	ReviewComment           string           `json:"reviewComment,omitempty"`
+	Payload                 *Payload         `json:"-"`
+}
+
+type VariableSetReviewRequest struct {
+	VersionId int    `json:"versionId" validate:"required"`
+	Approve   bool   `json:"approve"`
+	Comment   string `json:"comment"`
+}
+
+type VariableSetRollbackRequest struct {
+	VersionId int    `json:"versionId" validate:"required"`
+	Comment   string `json:"comment"`
+}
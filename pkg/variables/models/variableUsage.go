@@ -0,0 +1,74 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package models
+
+type VariableUsageEntityType string
+
+const (
+	DeploymentTemplateUsage VariableUsageEntityType = "DeploymentTemplate"
+	ConfigMapUsage          VariableUsageEntityType = "ConfigMap"
+	SecretUsage             VariableUsageEntityType = "Secret"
+	PipelineScriptUsage     VariableUsageEntityType = "PipelineScript"
+)
+
+// VariableImpactRequest contains the proposed definition of a variable,
+// the definition is only evaluated against the current usages and is not saved
+type VariableImpactRequest struct {
+	Spec   VariableSpec `json:"spec" validate:"required"`
+	UserId int32        `json:"-"`
+}
+
+type VariableUsage struct {
+	VariableName string                 `json:"variableName"`
+	Usages       []*VariableEntityUsage `json:"usages"`
+	// ChangedManifests is the number of rendered manifests which change with the proposed definition, only set for impact requests
+	ChangedManifests int `json:"changedManifests"`
+}
+
+type VariableEntityUsage struct {
+	EntityType VariableUsageEntityType `json:"entityType"`
+	EntityId   int                     `json:"entityId"`
+	// IsEnvOverride is true for environment level deployment templates, config maps and secrets
+	IsEnvOverride bool                   `json:"isEnvOverride"`
+	CiPipelineId  int                    `json:"ciPipelineId,omitempty"`
+	CdPipelineId  int                    `json:"cdPipelineId,omitempty"`
+	StageType     string                 `json:"stageType,omitempty"`
+	Targets       []*VariableUsageTarget `json:"targets"`
+}
+
+// VariableUsageTarget is an app/env combination for which the using entity gets rendered,
+// EnvId is 0 for targets which are not bound to an environment (ci pipelines or apps without cd pipelines)
+type VariableUsageTarget struct {
+	AppId           int                      `json:"appId"`
+	AppName         string                   `json:"appName"`
+	EnvId           int                      `json:"envId,omitempty"`
+	EnvName         string                   `json:"envName,omitempty"`
+	ResolvedValue   *VariableValue           `json:"resolvedValue,omitempty"`
+	IsRedacted      bool                     `json:"isRedacted"`
+	ResolutionTrace *VariableResolutionTrace `json:"resolutionTrace,omitempty"`
+	ProposedValue   *VariableValue           `json:"proposedValue,omitempty"`
+	// ManifestChanged is true if rendering the using entity with the proposed value gives a different result,
+	// for pipeline scripts which are only rendered at trigger time the values are compared
+	ManifestChanged bool `json:"manifestChanged"`
+	// RenderedManifest is set for changed deployment templates and config maps, secrets are never included
+	RenderedManifest *RenderedManifestDiff `json:"renderedManifest,omitempty"`
+}
+
+type RenderedManifestDiff struct {
+	Current  string `json:"current"`
+	Proposed string `json:"proposed"`
+}
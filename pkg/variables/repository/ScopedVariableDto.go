@@ -22,22 +22,24 @@ import (
 )
 
 type VariableDefinition struct {
-	tableName        struct{}            `sql:"variable_definition" pg:",discard_unknown_columns"`
-	Id               int                 `sql:"id,pk"`
-	Name             string              `sql:"name"`
-	DataType         models.DataType     `sql:"data_type"`
-	VarType          models.VariableType `sql:"var_type"`
-	Active           bool                `sql:"active"`
-	Description      string              `sql:"description"`
-	ShortDescription string              `json:"short_description"`
+	tableName        struct{}                    `sql:"variable_definition" pg:",discard_unknown_columns"`
+	Id               int                         `sql:"id,pk"`
+	Name             string                      `sql:"name"`
+	DataType         models.DataType             `sql:"data_type"`
+	VarType          models.VariableType         `sql:"var_type"`
+	Active           bool                        `sql:"active"`
+	Description      string                      `sql:"description"`
+	ShortDescription string                      `json:"short_description"`
+	Constraints      *models.VariableConstraints `sql:"constraints"`
 	sql.AuditLog
 }
 
 type VariableData struct {
-	tableName       struct{} `sql:"variable_data" pg:",discard_unknown_columns"`
-	Id              int      `sql:"id,pk"`
-	VariableScopeId int      `sql:"variable_scope_id"`
-	Data            string   `sql:"data"`
+	tableName       struct{}                    `sql:"variable_data" pg:",discard_unknown_columns"`
+	Id              int                         `sql:"id,pk"`
+	VariableScopeId int                         `sql:"variable_scope_id"`
+	Data            string                      `sql:"data"`
+	ValueSource     *models.ExternalValueSource `sql:"value_source"`
 	sql.AuditLog
 }
 
@@ -48,6 +50,7 @@ func CreateFromDefinition(definition models.Definition, auditLog sql.AuditLog) *
 	varDefinition.VarType = definition.VarType
 	varDefinition.Description = definition.Description
 	varDefinition.ShortDescription = definition.ShortDescription
+	varDefinition.Constraints = definition.Constraints
 	varDefinition.Active = true
 	varDefinition.AuditLog = auditLog
 	return varDefinition
@@ -27,6 +27,7 @@ import (
 type VariableEntityMappingRepository interface {
 	sql.TransactionWrapper
 	GetVariablesForEntities(entities []Entity) ([]*VariableEntityMapping, error)
+	GetEntitiesForVariables(variableNames []string) ([]*VariableEntityMapping, error)
 	SaveVariableEntityMappings(tx *pg.Tx, mappings []*VariableEntityMapping) error
 	DeleteAllVariablesForEntities(tx *pg.Tx, entities []Entity, userId int32) error
 	DeleteVariablesForEntity(tx *pg.Tx, variableIDs []string, entity Entity, userId int32) error
@@ -73,6 +74,22 @@ func (impl *VariableEntityMappingRepositoryImpl) GetVariablesForEntities(entitie
 	return mappings, nil
 }
 
+func (impl *VariableEntityMappingRepositoryImpl) GetEntitiesForVariables(variableNames []string) ([]*VariableEntityMapping, error) {
+	mappings := make([]*VariableEntityMapping, 0)
+	if len(variableNames) == 0 {
+		return mappings, nil
+	}
+	err := impl.dbConnection.Model(&mappings).
+		Where("is_deleted = ?", false).
+		Where("variable_name IN (?)", pg.In(variableNames)).
+		Select()
+	if err != nil && err != pg.ErrNoRows {
+		impl.logger.Errorw("err in getting entities for variables", "variableNames", variableNames, "err", err)
+		return nil, err
+	}
+	return mappings, nil
+}
+
 func (impl *VariableEntityMappingRepositoryImpl) DeleteVariablesForEntity(tx *pg.Tx, variableNames []string, entity Entity, userId int32) error {
 
 	_, err := tx.Model((*VariableEntityMapping)(nil)).
@@ -0,0 +1,41 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package repository
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/devtron-labs/devtron/pkg/sql"
+	"github.com/devtron-labs/devtron/pkg/variables/models"
+)
+
+type VariableSetVersion struct {
+	tableName               struct{}                        `sql:"variable_set_version" pg:",discard_unknown_columns"`
+	Id                      int                             `sql:"id,pk"`
+	Version                 int                             `sql:"version,notnull"`
+	Payload                 json.RawMessage                 `sql:"payload"`
+	Diff                    *models.VariableSetDiff         `sql:"diff"`
+	Status                  models.VariableSetVersionStatus `sql:"status,notnull"`
+	Comment                 string                          `sql:"comment"`
+	BaseVersionId           int                             `sql:"base_version_id"`
+	RolledBackFromVersionId int                             `sql:"rolled_back_from_version_id"`
+	ReviewedBy              int32                           `sql:"reviewed_by"`
+	ReviewedOn              time.Time                       `sql:"reviewed_on"`
+	ReviewComment           string                          `sql:"review_comment"`
+	sql.AuditLog
+}
@@ -0,0 +1,120 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package repository
+
+import (
+	"github.com/devtron-labs/devtron/pkg/variables/models"
+	"github.com/go-pg/pg"
+	"go.uber.org/zap"
+)
+
+type VariableSetVersionRepository interface {
+	Save(version *VariableSetVersion, tx *pg.Tx) error
+	Update(version *VariableSetVersion, tx *pg.Tx) error
+	FindById(id int) (*VariableSetVersion, error)
+	FindByIdForUpdate(id int, tx *pg.Tx) (*VariableSetVersion, error)
+	// FindActive returns pg.ErrNoRows when no version is active
+	FindActive(tx *pg.Tx) (*VariableSetVersion, error)
+	// FindAll returns all versions without payload, latest first
+	FindAll() ([]*VariableSetVersion, error)
+	GetLatestVersionNumber(tx *pg.Tx) (int, error)
+	// LockVariableSet serializes the creation and review of versions till tx ends, the active and the latest
+	// version have to be read after taking the lock
+	LockVariableSet(tx *pg.Tx) error
+}
+
+// variableSetLockKey is the key of the transaction level advisory lock taken on variable set updates
+const variableSetLockKey = "variable_set_version"
+
+type VariableSetVersionRepositoryImpl struct {
+	dbConnection *pg.DB
+	logger       *zap.SugaredLogger
+}
+
+func NewVariableSetVersionRepositoryImpl(dbConnection *pg.DB, logger *zap.SugaredLogger) *VariableSetVersionRepositoryImpl {
+	return &VariableSetVersionRepositoryImpl{
+		dbConnection: dbConnection,
+		logger:       logger,
+	}
+}
+
+func (impl *VariableSetVersionRepositoryImpl) Save(version *VariableSetVersion, tx *pg.Tx) error {
+	return tx.Insert(version)
+}
+
+func (impl *VariableSetVersionRepositoryImpl) Update(version *VariableSetVersion, tx *pg.Tx) error {
+	return tx.Update(version)
+}
+
+func (impl *VariableSetVersionRepositoryImpl) FindById(id int) (*VariableSetVersion, error) {
+	version := &VariableSetVersion{}
+	err := impl.dbConnection.Model(version).
+		Where("id = ?", id).
+		Select()
+	return version, err
+}
+
+func (impl *VariableSetVersionRepositoryImpl) FindByIdForUpdate(id int, tx *pg.Tx) (*VariableSetVersion, error) {
+	version := &VariableSetVersion{}
+	err := tx.Model(version).
+		Where("id = ?", id).
+		For("UPDATE").
+		Select()
+	return version, err
+}
+
+func (impl *VariableSetVersionRepositoryImpl) FindActive(tx *pg.Tx) (*VariableSetVersion, error) {
+	version := &VariableSetVersion{}
+	err := tx.Model(version).
+		Where("status = ?", models.VariableSetVersionActive).
+		Order("version DESC").
+		Limit(1).
+		Select()
+	return version, err
+}
+
+func (impl *VariableSetVersionRepositoryImpl) FindAll() ([]*VariableSetVersion, error) {
+	versions := make([]*VariableSetVersion, 0)
+	err := impl.dbConnection.Model(&versions).
+		ExcludeColumn("payload").
+		Order("version DESC").
+		Select()
+	if err != nil && err != pg.ErrNoRows {
+		impl.logger.Errorw("error in fetching variable set versions", "err", err)
+		return nil, err
+	}
+	return versions, nil
+}
+
+func (impl *VariableSetVersionRepositoryImpl) GetLatestVersionNumber(tx *pg.Tx) (int, error) {
+	var version int
+	_, err := tx.Query(&version, "SELECT COALESCE(MAX(version), 0) FROM variable_set_version")
+	if err != nil {
+		impl.logger.Errorw("error in fetching latest variable set version", "err", err)
+		return 0, err
+	}
+	return version, nil
+}
+
+func (impl *VariableSetVersionRepositoryImpl) LockVariableSet(tx *pg.Tx) error {
+	_, err := tx.Exec("SELECT pg_advisory_xact_lock(hashtext(?))", variableSetLockKey)
+	if err != nil {
+		impl.logger.Errorw("error in taking variable set lock", "err", err)
+		return err
+	}
+	return nil
+}
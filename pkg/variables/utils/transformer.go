@@ -29,7 +29,8 @@ func ManifestToPayload(manifest models.ScopedVariableManifest, userId int32) mod
 		for _, value := range spec.Values {
 			attribute := models.AttributeValue{
 				VariableValue: models.VariableValue{Value: value.Value},
-				AttributeType: models.Global,
+				AttributeType: value.Category,
+				ValueSource:   value.ValueFrom,
 			}
 
 			if value.Selectors != nil && value.Selectors.AttributeSelectors != nil {
@@ -44,6 +45,7 @@ func ManifestToPayload(manifest models.ScopedVariableManifest, userId int32) mod
 				VarType:          models.PUBLIC,
 				Description:      spec.Notes,
 				ShortDescription: spec.ShortDescription,
+				Constraints:      spec.Constraints,
 			},
 			AttributeValues: attributes,
 		}
@@ -72,11 +74,13 @@ func PayloadToManifest(payload models.Payload) models.ScopedVariableManifest {
 			ShortDescription: variable.Definition.ShortDescription,
 			Values:           make([]models.VariableValueSpec, 0),
 			IsSensitive:      variable.Definition.VarType.IsTypeSensitive(),
+			Constraints:      variable.Definition.Constraints,
 		}
 		for _, attribute := range variable.AttributeValues {
 			valueSpec := models.VariableValueSpec{
-				Value:    attribute.VariableValue.Value,
-				Category: attribute.AttributeType,
+				Value:     attribute.VariableValue.Value,
+				ValueFrom: attribute.ValueSource,
+				Category:  attribute.AttributeType,
 			}
 			if attribute.AttributeParams != nil {
 				valueSpec.Selectors = &models.Selector{AttributeSelectors: attribute.AttributeParams}
@@ -55,6 +55,15 @@ func DestringifyValue(Data string) (interface{}, error) {
 	return value, nil
 }
 
+// NormalizeValue converts a value of the payload to the value read back once it is saved
+func NormalizeValue(data interface{}) (interface{}, error) {
+	value, err := StringifyValue(data)
+	if err != nil {
+		return nil, err
+	}
+	return DestringifyValue(value)
+}
+
 func IsValidYAML(input string) bool {
 	jsonInput, err := yaml.YAMLToJSONStrict([]byte(input))
 	if err != nil {
@@ -100,9 +100,9 @@ func (impl WebhookHelmServiceImpl) CreateOrUpdateHelmApplication(ctx context.Con
 			return nil, common.ResourceNotFound, "chart repository not found for given chart repo name", http.StatusOK
 		}
 		request.Chart.Repo.Identifier = &ChartRepoIdentifierSpec{
-			Url:      chartRepo.Url,
-			Username: chartRepo.UserName,
-			Password: chartRepo.Password,
+			Url:      chartRepo.GetChartPullUrl(),
+			Username: chartRepo.GetChartPullUserName(),
+			Password: chartRepo.GetChartPullPassword(),
 		}
 	}
 
@@ -65,6 +65,7 @@ import (
 	repository2 "github.com/devtron-labs/devtron/pkg/plugin/repository"
 	"github.com/devtron-labs/devtron/pkg/policyGovernance/security/imageScanning"
 	repository3 "github.com/devtron-labs/devtron/pkg/policyGovernance/security/imageScanning/repository"
+	"github.com/devtron-labs/devtron/pkg/policyGovernance/security/imageSigning"
 	"github.com/devtron-labs/devtron/pkg/sql"
 	"github.com/devtron-labs/devtron/pkg/workflow/cd"
 	bean4 "github.com/devtron-labs/devtron/pkg/workflow/cd/bean"
@@ -150,6 +151,7 @@ type WorkflowDagExecutorImpl struct {
 	commonArtifactService   artifacts.CommonArtifactService
 	deploymentConfigService common2.DeploymentConfigService
 	asyncRunnable           *async.Runnable
+	imageSigningService     imageSigning.ImageSigningService
 	scanHistoryRepository   repository3.ImageScanHistoryRepository
 	imageScanService        imageScanning.ImageScanService
 
@@ -196,6 +198,7 @@ func NewWorkflowDagExecutorImpl(Logger *zap.SugaredLogger, pipelineRepository pi
 	ciHandlerService trigger.HandlerService,
 	workflowTriggerAuditService auditService.WorkflowTriggerAuditService,
 	fluxApplicationService fluxApplication.FluxApplicationService,
+	imageSigningService imageSigning.ImageSigningService,
 ) *WorkflowDagExecutorImpl {
 	wde := &WorkflowDagExecutorImpl{logger: Logger,
 		pipelineRepository:            pipelineRepository,
@@ -231,7 +234,8 @@ func NewWorkflowDagExecutorImpl(Logger *zap.SugaredLogger, pipelineRepository pi
 		workflowService:               workflowService,
 		ciHandlerService:              ciHandlerService,
 		workflowTriggerAuditService:   workflowTriggerAuditService,
-		fluxApplicationService:        fluxApplicationService}
+		fluxApplicationService:        fluxApplicationService,
+		imageSigningService:           imageSigningService}
 	config, err := types.GetCdConfig()
 	if err != nil {
 		return nil
@@ -320,7 +324,7 @@ func (impl *WorkflowDagExecutorImpl) UpdateCiWorkflowStatusFailure(timeoutForFai
 						// skip this and process for next ci workflow
 					}
 				}
-				if ciWorkflow.ExecutorType == cdWorkflow2.WORKFLOW_EXECUTOR_TYPE_SYSTEM {
+				if ciWorkflow.ExecutorType.IsArgoWorkflowLess() {
 					if wf.Status == string(v1alpha1.WorkflowFailed) {
 						isPodDeleted = true
 					}
@@ -1229,6 +1233,10 @@ func (impl *WorkflowDagExecutorImpl) HandleExternalCiWebhook(externalCiId int, r
 		impl.logger.Errorw("error in saving material", "err", err)
 		return 0, err
 	}
+	// signatures of externally built images are recorded for visibility, policies are enforced on deployment
+	impl.asyncRunnable.Execute(func() {
+		impl.imageSigningService.RecordArtifactSignatures(context.Background(), artifact, request.UserId)
+	})
 
 	hasAnyTriggered, err := impl.handleWebhookExternalCiEvent(artifact, request.UserId, externalCiId, auth, token)
 	if err != nil {
@@ -38,6 +38,7 @@ type WorkflowConfigSnapshotRepository interface {
 	SaveWithTx(tx *pg.Tx, snapshot *WorkflowConfigSnapshot) (*WorkflowConfigSnapshot, error)
 	// New methods for retrigger functionality
 	FindLatestFailedWorkflowSnapshot(workflowId int, workflowType types.WorkflowType) (*WorkflowConfigSnapshot, error)
+	FindLatestByWorkflowIdAndType(workflowId int, workflowType types.WorkflowType) (*WorkflowConfigSnapshot, error)
 	sql.TransactionWrapper
 }
 
@@ -78,3 +79,14 @@ func (impl *WorkflowConfigSnapshotRepositoryImpl) FindLatestFailedWorkflowSnapsh
 	}
 	return snapshot, nil
 }
+
+func (impl *WorkflowConfigSnapshotRepositoryImpl) FindLatestByWorkflowIdAndType(workflowId int, workflowType types.WorkflowType) (*WorkflowConfigSnapshot, error) {
+	snapshot := &WorkflowConfigSnapshot{}
+	err := impl.dbConnection.Model(snapshot).
+		Where("workflow_id = ?", workflowId).
+		Where("workflow_type = ?", workflowType).
+		Order("id DESC").
+		Limit(1).
+		Select()
+	return snapshot, err
+}
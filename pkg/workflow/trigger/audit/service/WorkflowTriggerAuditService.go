@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"github.com/devtron-labs/devtron/pkg/pipeline"
 	"github.com/devtron-labs/devtron/pkg/pipeline/types"
+	"github.com/devtron-labs/devtron/pkg/policyGovernance/security/imageSigning"
 	"github.com/devtron-labs/devtron/pkg/sql"
 	"github.com/devtron-labs/devtron/pkg/workflow/trigger/audit/adapter"
 	"github.com/devtron-labs/devtron/pkg/workflow/trigger/audit/repository"
@@ -32,6 +33,15 @@ type WorkflowTriggerAuditService interface {
 
 	// GetWorkflowRequestFromSnapshotForRetrigger fetches workflow request by workflowId and workflowType from snapshot for retrigger
 	GetWorkflowRequestFromSnapshotForRetrigger(workflowId int, workflowType types.WorkflowType) (*types.WorkflowRequest, error)
+
+	// GetSanitizedWorkflowRequestFromSnapshot returns the workflow request a workflow was triggered with, secrets stay masked
+	GetSanitizedWorkflowRequestFromSnapshot(workflowId int, workflowType types.WorkflowType) (*types.WorkflowRequest, error)
+
+	// GetSanitizedCompressedWorkflowRequest compresses a copy of the workflow request with its secrets masked, to be persisted
+	GetSanitizedCompressedWorkflowRequest(workflowRequest *types.WorkflowRequest) (string, error)
+
+	// GetWorkflowRequestFromSanitizedData decompresses a persisted workflow request and restores its secrets
+	GetWorkflowRequestFromSanitizedData(compressedData string) (*types.WorkflowRequest, error)
 }
 
 type WorkflowTriggerAuditServiceImpl struct {
@@ -39,6 +49,7 @@ type WorkflowTriggerAuditServiceImpl struct {
 	workflowConfigSnapshotRepository repository.WorkflowConfigSnapshotRepository
 	config                           *types.CiCdConfig
 	dockerRegistryConfig             pipeline.DockerRegistryConfig
+	imageSigningService              imageSigning.ImageSigningService
 	*sql.TransactionUtilImpl
 }
 
@@ -47,6 +58,7 @@ func NewWorkflowTriggerAuditServiceImpl(
 	workflowConfigSnapshotRepository repository.WorkflowConfigSnapshotRepository,
 	config *types.CiCdConfig,
 	dockerRegistryConfig pipeline.DockerRegistryConfig,
+	imageSigningService imageSigning.ImageSigningService,
 	transactionUtilImpl *sql.TransactionUtilImpl) *WorkflowTriggerAuditServiceImpl {
 
 	return &WorkflowTriggerAuditServiceImpl{
@@ -54,6 +66,7 @@ func NewWorkflowTriggerAuditServiceImpl(
 		workflowConfigSnapshotRepository: workflowConfigSnapshotRepository,
 		config:                           config,
 		dockerRegistryConfig:             dockerRegistryConfig,
+		imageSigningService:              imageSigningService,
 		TransactionUtilImpl:              transactionUtilImpl,
 	}
 }
@@ -106,6 +119,14 @@ func (impl *WorkflowTriggerAuditServiceImpl) maskSecretsInWorkflowRequest(workfl
 	workflowRequest.SecretKey = ""
 	workflowRequest.DockerCert = ""
 
+	// Mask image signing keys
+	if workflowRequest.ImageSigningConfig != nil {
+		for _, key := range workflowRequest.ImageSigningConfig.Keys {
+			key.PrivateKey = ""
+			key.Password = ""
+		}
+	}
+
 	return workflowRequest
 }
 
@@ -155,6 +176,52 @@ func (impl *WorkflowTriggerAuditServiceImpl) GetWorkflowRequestFromSnapshotForRe
 	return &workflowRequest, nil
 }
 
+func (impl *WorkflowTriggerAuditServiceImpl) GetSanitizedWorkflowRequestFromSnapshot(workflowId int, workflowType types.WorkflowType) (*types.WorkflowRequest, error) {
+	snapshot, err := impl.workflowConfigSnapshotRepository.FindLatestByWorkflowIdAndType(workflowId, workflowType)
+	if err != nil {
+		impl.logger.Errorw("error in finding workflow config snapshot", "workflowId", workflowId, "workflowType", workflowType, "err", err)
+		return nil, err
+	}
+	workflowRequest := &types.WorkflowRequest{}
+	err = workflowRequest.DecompressWorkflowRequest(snapshot.WorkflowRequestJson)
+	if err != nil {
+		impl.logger.Errorw("error in decompressing workflow request from snapshot", "snapshotId", snapshot.Id, "err", err)
+		return nil, err
+	}
+	return workflowRequest, nil
+}
+
+func (impl *WorkflowTriggerAuditServiceImpl) GetSanitizedCompressedWorkflowRequest(workflowRequest *types.WorkflowRequest) (string, error) {
+	compressedWorkflowJson, err := workflowRequest.CompressWorkflowRequest()
+	if err != nil {
+		impl.logger.Errorw("error in compressing workflow request", "workflowId", workflowRequest.WorkflowId, "err", err)
+		return "", err
+	}
+	// masking is done on a copy as the original request is still to be submitted
+	workflowRequestCopy := &types.WorkflowRequest{}
+	err = workflowRequestCopy.DecompressWorkflowRequest(compressedWorkflowJson)
+	if err != nil {
+		impl.logger.Errorw("error in decompressing workflow request", "workflowId", workflowRequest.WorkflowId, "err", err)
+		return "", err
+	}
+	return impl.maskSecretsInWorkflowRequest(workflowRequestCopy).CompressWorkflowRequest()
+}
+
+func (impl *WorkflowTriggerAuditServiceImpl) GetWorkflowRequestFromSanitizedData(compressedData string) (*types.WorkflowRequest, error) {
+	workflowRequest := &types.WorkflowRequest{}
+	err := workflowRequest.DecompressWorkflowRequest(compressedData)
+	if err != nil {
+		impl.logger.Errorw("error in decompressing sanitized workflow request", "err", err)
+		return nil, err
+	}
+	err = impl.restoreSecretsInWorkflowRequest(workflowRequest)
+	if err != nil {
+		impl.logger.Errorw("error in restoring secrets in workflow request", "workflowId", workflowRequest.WorkflowId, "err", err)
+		return nil, err
+	}
+	return workflowRequest, nil
+}
+
 // RestoreSecretsInWorkflowRequest restores secrets that were sanitized during storage
 func (impl *WorkflowTriggerAuditServiceImpl) restoreSecretsInWorkflowRequest(workflowRequest *types.WorkflowRequest) error {
 	impl.logger.Debugw("restoring secrets in workflow request", "workflowId", workflowRequest.WorkflowId)
@@ -190,6 +257,13 @@ func (impl *WorkflowTriggerAuditServiceImpl) restoreSecretsInWorkflowRequest(wor
 		return err
 	}
 
+	// Restore image signing keys
+	err = impl.imageSigningService.RestoreCiSigningKeys(workflowRequest.ImageSigningConfig)
+	if err != nil {
+		impl.logger.Errorw("error in restoring image signing keys", "err", err, "workflowId", workflowRequest.WorkflowId)
+		return err
+	}
+
 	impl.logger.Debugw("completed secret restoration in workflow request", "workflowId", workflowRequest.WorkflowId)
 	return nil
 }
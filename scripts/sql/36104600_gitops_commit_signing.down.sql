@@ -0,0 +1,9 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ */
+
+ALTER TABLE public.gitops_config
+    DROP COLUMN IF EXISTS commit_signing_enabled,
+    DROP COLUMN IF EXISTS commit_signing_format,
+    DROP COLUMN IF EXISTS commit_signing_key,
+    DROP COLUMN IF EXISTS commit_signing_passphrase;
@@ -0,0 +1,10 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ */
+
+-- signing key used for signing the commits pushed in gitOps repositories
+ALTER TABLE public.gitops_config
+    ADD COLUMN IF NOT EXISTS commit_signing_enabled BOOL NOT NULL DEFAULT FALSE,
+    ADD COLUMN IF NOT EXISTS commit_signing_format VARCHAR(10),
+    ADD COLUMN IF NOT EXISTS commit_signing_key TEXT,
+    ADD COLUMN IF NOT EXISTS commit_signing_passphrase TEXT;
@@ -0,0 +1,10 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ */
+
+ALTER TABLE public.chart_repo
+    DROP COLUMN IF EXISTS mirror_enabled,
+    DROP COLUMN IF EXISTS mirror_url,
+    DROP COLUMN IF EXISTS mirror_status,
+    DROP COLUMN IF EXISTS mirror_status_message,
+    DROP COLUMN IF EXISTS mirror_synced_on;
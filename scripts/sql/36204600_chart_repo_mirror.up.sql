@@ -0,0 +1,11 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ */
+
+-- chart repositories mirrored into devtron managed storage and served as an internal helm repository
+ALTER TABLE public.chart_repo
+    ADD COLUMN IF NOT EXISTS mirror_enabled BOOL NOT NULL DEFAULT FALSE,
+    ADD COLUMN IF NOT EXISTS mirror_url VARCHAR(500),
+    ADD COLUMN IF NOT EXISTS mirror_status VARCHAR(50),
+    ADD COLUMN IF NOT EXISTS mirror_status_message TEXT,
+    ADD COLUMN IF NOT EXISTS mirror_synced_on TIMESTAMPTZ;
@@ -0,0 +1,7 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ */
+
+DROP INDEX IF EXISTS idx_unique_installed_app_upgrade_advisory_installed_app_id;
+DROP TABLE IF EXISTS public.installed_app_upgrade_advisory;
+DROP SEQUENCE IF EXISTS id_seq_installed_app_upgrade_advisory;
@@ -0,0 +1,27 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ */
+
+CREATE SEQUENCE IF NOT EXISTS id_seq_installed_app_upgrade_advisory;
+
+-- result of the periodic check for newer chart versions of apps installed from the chart store
+CREATE TABLE IF NOT EXISTS public.installed_app_upgrade_advisory
+(
+    "id"                                         integer NOT NULL DEFAULT nextval('id_seq_installed_app_upgrade_advisory'::regclass),
+    "installed_app_id"                           integer NOT NULL,
+    "installed_app_version_id"                   integer NOT NULL,
+    "installed_app_store_application_version_id" integer NOT NULL,
+    "latest_app_store_application_version_id"    integer NOT NULL,
+    "latest_version"                             varchar(250),
+    "upgrade_available"                          bool    NOT NULL DEFAULT FALSE,
+    "checked_on"                                 timestamptz,
+    "created_on"                                 timestamptz NOT NULL,
+    "created_by"                                 integer NOT NULL,
+    "updated_on"                                 timestamptz NOT NULL,
+    "updated_by"                                 integer NOT NULL,
+    CONSTRAINT "installed_app_upgrade_advisory_installed_app_id_fkey" FOREIGN KEY ("installed_app_id") REFERENCES "public"."installed_apps" ("id"),
+    PRIMARY KEY ("id")
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_unique_installed_app_upgrade_advisory_installed_app_id
+    ON public.installed_app_upgrade_advisory (installed_app_id);
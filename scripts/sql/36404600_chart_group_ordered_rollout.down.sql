@@ -0,0 +1,9 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ */
+
+DROP TABLE IF EXISTS public.chart_group_rollout_member;
+DROP SEQUENCE IF EXISTS id_seq_chart_group_rollout_member;
+DROP TABLE IF EXISTS public.chart_group_rollout;
+DROP SEQUENCE IF EXISTS id_seq_chart_group_rollout;
+ALTER TABLE public.chart_group_entry DROP COLUMN IF EXISTS install_order;
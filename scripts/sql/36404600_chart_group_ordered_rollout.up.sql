@@ -0,0 +1,48 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ */
+
+-- entries with a lower install order are installed before entries with a higher one, equal orders are installed together
+ALTER TABLE public.chart_group_entry ADD COLUMN IF NOT EXISTS install_order integer NOT NULL DEFAULT 0;
+
+CREATE SEQUENCE IF NOT EXISTS id_seq_chart_group_rollout;
+
+CREATE TABLE IF NOT EXISTS public.chart_group_rollout
+(
+    "id"                     integer NOT NULL DEFAULT nextval('id_seq_chart_group_rollout'::regclass),
+    "chart_group_id"         integer,
+    "project_id"             integer NOT NULL,
+    "status"                 varchar(50) NOT NULL,
+    "message"                text,
+    "wait_for_healthy"       bool    NOT NULL DEFAULT FALSE,
+    "health_timeout_seconds" integer NOT NULL DEFAULT 0,
+    "rollback_on_failure"    bool    NOT NULL DEFAULT FALSE,
+    "created_on"             timestamptz NOT NULL,
+    "created_by"             integer NOT NULL,
+    "updated_on"             timestamptz NOT NULL,
+    "updated_by"             integer NOT NULL,
+    PRIMARY KEY ("id")
+);
+
+CREATE SEQUENCE IF NOT EXISTS id_seq_chart_group_rollout_member;
+
+CREATE TABLE IF NOT EXISTS public.chart_group_rollout_member
+(
+    "id"                               integer NOT NULL DEFAULT nextval('id_seq_chart_group_rollout_member'::regclass),
+    "chart_group_rollout_id"           integer NOT NULL,
+    "app_name"                         varchar(250) NOT NULL,
+    "environment_id"                   integer NOT NULL,
+    "chart_group_entry_id"             integer,
+    "install_order"                    integer NOT NULL DEFAULT 0,
+    "installed_app_id"                 integer,
+    "installed_app_version_id"         integer,
+    "installed_app_version_history_id" integer,
+    "status"                           varchar(50) NOT NULL,
+    "message"                          text,
+    "created_on"                       timestamptz NOT NULL,
+    "created_by"                       integer NOT NULL,
+    "updated_on"                       timestamptz NOT NULL,
+    "updated_by"                       integer NOT NULL,
+    CONSTRAINT "chart_group_rollout_member_chart_group_rollout_id_fkey" FOREIGN KEY ("chart_group_rollout_id") REFERENCES "public"."chart_group_rollout" ("id"),
+    PRIMARY KEY ("id")
+);
@@ -0,0 +1,5 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ */
+
+ALTER TABLE cluster DROP COLUMN IF EXISTS workflow_executor_type;
@@ -0,0 +1,5 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ */
+
+ALTER TABLE cluster ADD COLUMN IF NOT EXISTS workflow_executor_type VARCHAR(50);
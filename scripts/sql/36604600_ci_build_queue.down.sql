@@ -0,0 +1,8 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ */
+
+DROP INDEX IF EXISTS idx_ci_build_queue_status;
+DROP INDEX IF EXISTS idx_unique_ci_build_queue_ci_workflow_id;
+DROP TABLE IF EXISTS public.ci_build_queue;
+DROP SEQUENCE IF EXISTS id_seq_ci_build_queue;
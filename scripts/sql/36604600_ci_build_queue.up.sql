@@ -0,0 +1,32 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ */
+
+CREATE SEQUENCE IF NOT EXISTS id_seq_ci_build_queue;
+
+-- builds waiting for (or holding) a concurrency slot, workflow_request holds the sanitized and compressed workflow request
+CREATE TABLE IF NOT EXISTS public.ci_build_queue
+(
+    "id"               integer NOT NULL DEFAULT nextval('id_seq_ci_build_queue'::regclass),
+    "ci_workflow_id"   integer NOT NULL,
+    "ci_pipeline_id"   integer NOT NULL,
+    "app_id"           integer NOT NULL,
+    "team_id"          integer NOT NULL,
+    "cluster_id"       integer NOT NULL,
+    "branch_key"       text,
+    "priority"         integer NOT NULL DEFAULT 0,
+    "status"           varchar(50) NOT NULL,
+    "workflow_status"  varchar(50),
+    "workflow_request" text,
+    "message"          text,
+    "dispatched_on"    timestamptz,
+    "created_on"       timestamptz NOT NULL,
+    "created_by"       integer NOT NULL,
+    "updated_on"       timestamptz NOT NULL,
+    "updated_by"       integer NOT NULL,
+    CONSTRAINT "ci_build_queue_ci_workflow_id_fkey" FOREIGN KEY ("ci_workflow_id") REFERENCES "public"."ci_workflow" ("id"),
+    PRIMARY KEY ("id")
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_unique_ci_build_queue_ci_workflow_id ON public.ci_build_queue (ci_workflow_id);
+CREATE INDEX IF NOT EXISTS idx_ci_build_queue_status ON public.ci_build_queue (status);
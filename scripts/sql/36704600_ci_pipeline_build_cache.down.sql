@@ -0,0 +1,12 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ */
+
+ALTER TABLE public.ci_workflow
+    DROP COLUMN IF EXISTS build_cache_backend,
+    DROP COLUMN IF EXISTS build_cache_cached_steps,
+    DROP COLUMN IF EXISTS build_cache_total_steps;
+
+DROP INDEX IF EXISTS idx_unique_ci_pipeline_build_cache_config_ci_pipeline_id;
+DROP TABLE IF EXISTS public.ci_pipeline_build_cache_config;
+DROP SEQUENCE IF EXISTS id_seq_ci_pipeline_build_cache_config;
@@ -0,0 +1,32 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ */
+
+CREATE SEQUENCE IF NOT EXISTS id_seq_ci_pipeline_build_cache_config;
+
+-- cache_version is part of the cache reference, purging a cache bumps it so the next build starts cold
+CREATE TABLE IF NOT EXISTS public.ci_pipeline_build_cache_config
+(
+    "id"                        integer NOT NULL DEFAULT nextval('id_seq_ci_pipeline_build_cache_config'::regclass),
+    "ci_pipeline_id"            integer NOT NULL,
+    "backend"                   varchar(50) NOT NULL,
+    "mode"                      varchar(10),
+    "registry_cache_repository" text,
+    "cache_version"             integer NOT NULL DEFAULT 1,
+    "last_purged_on"            timestamptz,
+    "active"                    bool NOT NULL DEFAULT true,
+    "created_on"                timestamptz NOT NULL,
+    "created_by"                integer NOT NULL,
+    "updated_on"                timestamptz NOT NULL,
+    "updated_by"                integer NOT NULL,
+    CONSTRAINT "ci_pipeline_build_cache_config_ci_pipeline_id_fkey" FOREIGN KEY ("ci_pipeline_id") REFERENCES "public"."ci_pipeline" ("id"),
+    PRIMARY KEY ("id")
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_unique_ci_pipeline_build_cache_config_ci_pipeline_id
+    ON public.ci_pipeline_build_cache_config (ci_pipeline_id) WHERE active = true;
+
+ALTER TABLE public.ci_workflow
+    ADD COLUMN IF NOT EXISTS build_cache_backend varchar(50),
+    ADD COLUMN IF NOT EXISTS build_cache_cached_steps integer,
+    ADD COLUMN IF NOT EXISTS build_cache_total_steps integer;
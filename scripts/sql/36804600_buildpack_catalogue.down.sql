@@ -0,0 +1,12 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ */
+
+DROP TABLE IF EXISTS public.ci_artifact_sbom;
+DROP SEQUENCE IF EXISTS id_seq_ci_artifact_sbom;
+DROP TABLE IF EXISTS public.buildpack_language_default;
+DROP SEQUENCE IF EXISTS id_seq_buildpack_language_default;
+DROP TABLE IF EXISTS public.buildpack;
+DROP SEQUENCE IF EXISTS id_seq_buildpack;
+DROP TABLE IF EXISTS public.buildpack_builder;
+DROP SEQUENCE IF EXISTS id_seq_buildpack_builder;
@@ -0,0 +1,84 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ */
+
+CREATE SEQUENCE IF NOT EXISTS id_seq_buildpack_builder;
+
+CREATE TABLE IF NOT EXISTS public.buildpack_builder
+(
+    "id"          integer NOT NULL DEFAULT nextval('id_seq_buildpack_builder'::regclass),
+    "name"        varchar(250) NOT NULL,
+    "image"       text NOT NULL,
+    "version"     varchar(100) NOT NULL,
+    "description" text,
+    "deprecated"  bool NOT NULL DEFAULT false,
+    "active"      bool NOT NULL DEFAULT true,
+    "created_on"  timestamptz NOT NULL,
+    "created_by"  integer NOT NULL,
+    "updated_on"  timestamptz NOT NULL,
+    "updated_by"  integer NOT NULL,
+    PRIMARY KEY ("id")
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_unique_buildpack_builder_image_version
+    ON public.buildpack_builder (image, version) WHERE active = true;
+
+CREATE SEQUENCE IF NOT EXISTS id_seq_buildpack;
+
+CREATE TABLE IF NOT EXISTS public.buildpack
+(
+    "id"           integer NOT NULL DEFAULT nextval('id_seq_buildpack'::regclass),
+    "buildpack_id" text NOT NULL,
+    "version"      varchar(100) NOT NULL,
+    "deprecated"   bool NOT NULL DEFAULT false,
+    "active"       bool NOT NULL DEFAULT true,
+    "created_on"   timestamptz NOT NULL,
+    "created_by"   integer NOT NULL,
+    "updated_on"   timestamptz NOT NULL,
+    "updated_by"   integer NOT NULL,
+    PRIMARY KEY ("id")
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_unique_buildpack_id_version
+    ON public.buildpack (buildpack_id, version) WHERE active = true;
+
+CREATE SEQUENCE IF NOT EXISTS id_seq_buildpack_language_default;
+
+-- buildpacks is a comma separated list of <buildpack_id>@<version>, empty to let the builder detect them
+CREATE TABLE IF NOT EXISTS public.buildpack_language_default
+(
+    "id"               integer NOT NULL DEFAULT nextval('id_seq_buildpack_language_default'::regclass),
+    "language"         varchar(100) NOT NULL,
+    "language_version" varchar(100) NOT NULL DEFAULT '',
+    "builder_id"       integer NOT NULL,
+    "buildpacks"       text,
+    "active"           bool NOT NULL DEFAULT true,
+    "created_on"       timestamptz NOT NULL,
+    "created_by"       integer NOT NULL,
+    "updated_on"       timestamptz NOT NULL,
+    "updated_by"       integer NOT NULL,
+    CONSTRAINT "buildpack_language_default_builder_id_fkey" FOREIGN KEY ("builder_id") REFERENCES "public"."buildpack_builder" ("id"),
+    PRIMARY KEY ("id")
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_unique_buildpack_language_default_language
+    ON public.buildpack_language_default (language, language_version) WHERE active = true;
+
+CREATE SEQUENCE IF NOT EXISTS id_seq_ci_artifact_sbom;
+
+CREATE TABLE IF NOT EXISTS public.ci_artifact_sbom
+(
+    "id"             integer NOT NULL DEFAULT nextval('id_seq_ci_artifact_sbom'::regclass),
+    "ci_artifact_id" integer NOT NULL,
+    "format"         varchar(50) NOT NULL,
+    "source"         varchar(50) NOT NULL,
+    "content"        text,
+    "created_on"     timestamptz NOT NULL,
+    "created_by"     integer NOT NULL,
+    "updated_on"     timestamptz NOT NULL,
+    "updated_by"     integer NOT NULL,
+    CONSTRAINT "ci_artifact_sbom_ci_artifact_id_fkey" FOREIGN KEY ("ci_artifact_id") REFERENCES "public"."ci_artifact" ("id"),
+    PRIMARY KEY ("id")
+);
+
+CREATE INDEX IF NOT EXISTS idx_ci_artifact_sbom_ci_artifact_id ON public.ci_artifact_sbom (ci_artifact_id);
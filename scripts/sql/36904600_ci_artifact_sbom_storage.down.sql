@@ -0,0 +1,10 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ */
+
+DROP TABLE IF EXISTS public.ci_artifact_sbom_component;
+DROP SEQUENCE IF EXISTS id_seq_ci_artifact_sbom_component;
+
+ALTER TABLE public.ci_artifact_sbom
+    DROP COLUMN IF EXISTS blob_storage_key,
+    DROP COLUMN IF EXISTS component_count;
@@ -0,0 +1,27 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ */
+
+-- sboms uploaded by ci runner to blob storage keep only the key, content stays inline when blob storage is not configured
+ALTER TABLE public.ci_artifact_sbom
+    ADD COLUMN IF NOT EXISTS blob_storage_key text,
+    ADD COLUMN IF NOT EXISTS component_count integer NOT NULL DEFAULT 0;
+
+CREATE SEQUENCE IF NOT EXISTS id_seq_ci_artifact_sbom_component;
+
+-- search index of the packages listed in artifact sboms
+CREATE TABLE IF NOT EXISTS public.ci_artifact_sbom_component
+(
+    "id"                  integer NOT NULL DEFAULT nextval('id_seq_ci_artifact_sbom_component'::regclass),
+    "ci_artifact_sbom_id" integer NOT NULL,
+    "ci_artifact_id"      integer NOT NULL,
+    "name"                text NOT NULL,
+    "version"             text,
+    "purl"                text,
+    "type"                varchar(100),
+    CONSTRAINT "ci_artifact_sbom_component_ci_artifact_sbom_id_fkey" FOREIGN KEY ("ci_artifact_sbom_id") REFERENCES "public"."ci_artifact_sbom" ("id"),
+    PRIMARY KEY ("id")
+);
+
+CREATE INDEX IF NOT EXISTS idx_ci_artifact_sbom_component_name ON public.ci_artifact_sbom_component (lower(name));
+CREATE INDEX IF NOT EXISTS idx_ci_artifact_sbom_component_ci_artifact_id ON public.ci_artifact_sbom_component (ci_artifact_id);
@@ -0,0 +1,10 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ */
+
+DROP TABLE IF EXISTS public.ci_artifact_signature;
+DROP SEQUENCE IF EXISTS id_seq_ci_artifact_signature;
+DROP TABLE IF EXISTS public.image_signature_policy;
+DROP SEQUENCE IF EXISTS id_seq_image_signature_policy;
+DROP TABLE IF EXISTS public.image_signing_key;
+DROP SEQUENCE IF EXISTS id_seq_image_signing_key;
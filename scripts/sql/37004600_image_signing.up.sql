@@ -0,0 +1,67 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ */
+
+CREATE SEQUENCE IF NOT EXISTS id_seq_image_signing_key;
+
+-- cosign compatible key pairs, private key and password are stored encrypted
+CREATE TABLE IF NOT EXISTS public.image_signing_key
+(
+    "id"          integer NOT NULL DEFAULT nextval('id_seq_image_signing_key'::regclass),
+    "name"        varchar(250) NOT NULL,
+    "description" text,
+    "public_key"  text NOT NULL,
+    "private_key" text,
+    "password"    text,
+    "sign_builds" bool NOT NULL DEFAULT false,
+    "active"      bool NOT NULL DEFAULT true,
+    "created_on"  timestamptz NOT NULL,
+    "created_by"  int4 NOT NULL,
+    "updated_on"  timestamptz NOT NULL,
+    "updated_by"  int4 NOT NULL,
+    PRIMARY KEY ("id")
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_unique_image_signing_key_name ON public.image_signing_key (name) WHERE active = true;
+
+CREATE SEQUENCE IF NOT EXISTS id_seq_image_signature_policy;
+
+-- a policy requires the deployed image to be signed by signing_key_id, the most specific level wins
+CREATE TABLE IF NOT EXISTS public.image_signature_policy
+(
+    "id"             integer NOT NULL DEFAULT nextval('id_seq_image_signature_policy'::regclass),
+    "global"         bool NOT NULL DEFAULT false,
+    "cluster_id"     integer,
+    "env_id"         integer,
+    "signing_key_id" integer NOT NULL,
+    "deleted"        bool NOT NULL DEFAULT false,
+    "created_on"     timestamptz NOT NULL,
+    "created_by"     int4 NOT NULL,
+    "updated_on"     timestamptz NOT NULL,
+    "updated_by"     int4 NOT NULL,
+    CONSTRAINT "image_signature_policy_signing_key_id_fkey" FOREIGN KEY ("signing_key_id") REFERENCES "public"."image_signing_key" ("id"),
+    PRIMARY KEY ("id")
+);
+
+CREATE SEQUENCE IF NOT EXISTS id_seq_ci_artifact_signature;
+
+-- signatures reported by ci runner and the outcome of signature verifications of an artifact
+CREATE TABLE IF NOT EXISTS public.ci_artifact_signature
+(
+    "id"             integer NOT NULL DEFAULT nextval('id_seq_ci_artifact_signature'::regclass),
+    "ci_artifact_id" integer NOT NULL,
+    "signing_key_id" integer NOT NULL,
+    "image_digest"   text NOT NULL,
+    "source"         varchar(50) NOT NULL,
+    "verified"       bool NOT NULL DEFAULT false,
+    "verified_on"    timestamptz,
+    "created_on"     timestamptz NOT NULL,
+    "created_by"     int4 NOT NULL,
+    "updated_on"     timestamptz NOT NULL,
+    "updated_by"     int4 NOT NULL,
+    CONSTRAINT "ci_artifact_signature_ci_artifact_id_fkey" FOREIGN KEY ("ci_artifact_id") REFERENCES "public"."ci_artifact" ("id"),
+    CONSTRAINT "ci_artifact_signature_signing_key_id_fkey" FOREIGN KEY ("signing_key_id") REFERENCES "public"."image_signing_key" ("id"),
+    PRIMARY KEY ("id")
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_unique_ci_artifact_signature ON public.ci_artifact_signature (ci_artifact_id, signing_key_id);
@@ -0,0 +1,6 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ */
+
+DROP TABLE IF EXISTS public.ci_artifact_provenance;
+DROP SEQUENCE IF EXISTS id_seq_ci_artifact_provenance;
@@ -0,0 +1,24 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ */
+
+CREATE SEQUENCE IF NOT EXISTS id_seq_ci_artifact_provenance;
+
+-- in-toto statement with the SLSA provenance predicate of the build which produced the artifact
+CREATE TABLE IF NOT EXISTS public.ci_artifact_provenance
+(
+    "id"             integer NOT NULL DEFAULT nextval('id_seq_ci_artifact_provenance'::regclass),
+    "ci_artifact_id" integer NOT NULL,
+    "ci_workflow_id" integer,
+    "predicate_type" varchar(250) NOT NULL,
+    "image_digest"   varchar(250),
+    "content"        text NOT NULL,
+    "created_on"     timestamptz NOT NULL,
+    "created_by"     integer NOT NULL,
+    "updated_on"     timestamptz NOT NULL,
+    "updated_by"     integer NOT NULL,
+    CONSTRAINT "ci_artifact_provenance_ci_artifact_id_fkey" FOREIGN KEY ("ci_artifact_id") REFERENCES "public"."ci_artifact" ("id"),
+    PRIMARY KEY ("id")
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_unique_ci_artifact_provenance_ci_artifact_id ON public.ci_artifact_provenance (ci_artifact_id);
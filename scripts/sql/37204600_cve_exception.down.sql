@@ -0,0 +1,10 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ */
+
+DELETE FROM "public"."notification_templates" WHERE event_type_id = 11;
+DELETE FROM "public"."notification_settings" WHERE event_type_id = 11;
+DELETE FROM "public"."event" WHERE id = 11;
+
+DROP TABLE IF EXISTS public.cve_exception;
+DROP SEQUENCE IF EXISTS id_seq_cve_exception;
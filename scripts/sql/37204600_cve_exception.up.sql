@@ -0,0 +1,108 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ */
+
+CREATE SEQUENCE IF NOT EXISTS id_seq_cve_exception;
+
+-- time bound exception for a cve scoped to app/env/image, only APPROVED and unexpired rows are enforced
+CREATE TABLE IF NOT EXISTS public.cve_exception
+(
+    "id"              integer NOT NULL DEFAULT nextval('id_seq_cve_exception'::regclass),
+    "cve_name"        varchar(255) NOT NULL,
+    "app_id"          integer,
+    "env_id"          integer,
+    "image"           varchar(500),
+    "justification"   text NOT NULL,
+    "status"          varchar(50) NOT NULL,
+    "requested_by"    integer NOT NULL,
+    "reviewed_by"     integer,
+    "reviewed_on"     timestamptz,
+    "review_comment"  text,
+    "expires_on"      timestamptz NOT NULL,
+    "expiry_notified" bool NOT NULL DEFAULT false,
+    "created_on"      timestamptz NOT NULL,
+    "created_by"      integer NOT NULL,
+    "updated_on"      timestamptz NOT NULL,
+    "updated_by"      integer NOT NULL,
+    CONSTRAINT "cve_exception_cve_name_fkey" FOREIGN KEY ("cve_name") REFERENCES "public"."cve_store" ("name"),
+    PRIMARY KEY ("id")
+);
+
+CREATE INDEX IF NOT EXISTS idx_cve_exception_status_expires_on ON public.cve_exception (status, expires_on);
+CREATE INDEX IF NOT EXISTS idx_cve_exception_cve_name ON public.cve_exception (cve_name);
+
+-- event ids up to 9 are taken, 9 being the scoop resource intercept event
+INSERT INTO "public"."event" (id, event_type, description)
+SELECT 11, 'CVE EXCEPTION EXPIRY', ''
+WHERE NOT EXISTS (SELECT 1 FROM "public"."event" WHERE id = 11);
+
+INSERT INTO "public"."notification_templates" (channel_type, node_type, event_type_id, template_name, template_payload)
+VALUES ('slack', 'CD', 11, 'CVE exception expiry slack template', '{
+    "text": ":warning: CVE exception {{#cveException.expired}}expired{{/cveException.expired}}{{^cveException.expired}}expiring{{/cveException.expired}} | {{cveException.cveName}} | Application > {{appName}}",
+    "blocks": [
+        {
+            "type": "section",
+            "text": {
+                "type": "mrkdwn",
+                "text": ":warning: *CVE exception {{#cveException.expired}}expired{{/cveException.expired}}{{^cveException.expired}}expiring{{/cveException.expired}}*\n<!date^{{eventTime}}^{date_long} {time} | \"-\">"
+            }
+        },
+        {
+            "type": "section",
+            "fields": [
+                {
+                    "type": "mrkdwn",
+                    "text": "*CVE*\n{{cveException.cveName}}"
+                },
+                {
+                    "type": "mrkdwn",
+                    "text": "*Expires on*\n{{cveException.expiresOn}}"
+                },
+                {
+                    "type": "mrkdwn",
+                    "text": "*Application*\n{{appName}}{{^appName}}All{{/appName}}"
+                },
+                {
+                    "type": "mrkdwn",
+                    "text": "*Environment*\n{{envName}}{{^envName}}All{{/envName}}"
+                },
+                {
+                    "type": "mrkdwn",
+                    "text": "*Requested by*\n{{cveException.requestedBy}}"
+                },
+                {
+                    "type": "mrkdwn",
+                    "text": "*Approved by*\n{{cveException.approvedBy}}"
+                }
+            ]
+        },
+        {
+            "type": "section",
+            "text": {
+                "type": "mrkdwn",
+                "text": "*Justification*\n{{cveException.justification}}"
+            }
+        }
+    ]
+}');
+
+INSERT INTO "public"."notification_templates" (channel_type, node_type, event_type_id, template_name, template_payload)
+VALUES ('webhook', 'CD', 11, 'CVE exception expiry webhook template', '{
+    "eventType": "CVE EXCEPTION EXPIRY",
+    "eventTime": "{{eventTime}}",
+    "appName": "{{appName}}",
+    "envName": "{{envName}}",
+    "exceptionId": "{{cveException.exceptionId}}",
+    "cveName": "{{cveException.cveName}}",
+    "image": "{{cveException.image}}",
+    "expiresOn": "{{cveException.expiresOn}}",
+    "expired": "{{cveException.expired}}",
+    "requestedBy": "{{cveException.requestedBy}}",
+    "approvedBy": "{{cveException.approvedBy}}"
+}');
+
+INSERT INTO "public"."notification_templates" (channel_type, node_type, event_type_id, template_name, template_payload)
+VALUES ('ses', 'CD', 11, 'CVE exception expiry ses template', '{"from": "{{fromEmail}}", "to": "{{toEmail}}", "subject": "⚠️ CVE exception {{#cveException.expired}}expired{{/cveException.expired}}{{^cveException.expired}}expiring{{/cveException.expired}} | {{cveException.cveName}} | Application: {{appName}}{{^appName}}All{{/appName}}", "html": "<table cellpadding=0 style=\"font-family:Arial,Verdana,Helvetica;width:600px;border:1px solid #D0D4D9;border-radius:8px;padding:20px\"><tr><td><h2 style=\"color:#000a14\">CVE exception {{#cveException.expired}}expired{{/cveException.expired}}{{^cveException.expired}}expiring{{/cveException.expired}}</h2><p><strong>CVE</strong>: {{cveException.cveName}}</p><p><strong>Expires on</strong>: {{cveException.expiresOn}}</p><p><strong>Application</strong>: {{appName}}{{^appName}}All{{/appName}}</p><p><strong>Environment</strong>: {{envName}}{{^envName}}All{{/envName}}</p><p><strong>Image</strong>: {{cveException.image}}{{^cveException.image}}All{{/cveException.image}}</p><p><strong>Requested by</strong>: {{cveException.requestedBy}}</p><p><strong>Approved by</strong>: {{cveException.approvedBy}}</p><p><strong>Justification</strong>: {{cveException.justification}}</p></td></tr></table>"}');
+
+INSERT INTO "public"."notification_templates" (channel_type, node_type, event_type_id, template_name, template_payload)
+VALUES ('smtp', 'CD', 11, 'CVE exception expiry smtp template', '{"from": "{{fromEmail}}", "to": "{{toEmail}}", "subject": "⚠️ CVE exception {{#cveException.expired}}expired{{/cveException.expired}}{{^cveException.expired}}expiring{{/cveException.expired}} | {{cveException.cveName}} | Application: {{appName}}{{^appName}}All{{/appName}}", "html": "<table cellpadding=0 style=\"font-family:Arial,Verdana,Helvetica;width:600px;border:1px solid #D0D4D9;border-radius:8px;padding:20px\"><tr><td><h2 style=\"color:#000a14\">CVE exception {{#cveException.expired}}expired{{/cveException.expired}}{{^cveException.expired}}expiring{{/cveException.expired}}</h2><p><strong>CVE</strong>: {{cveException.cveName}}</p><p><strong>Expires on</strong>: {{cveException.expiresOn}}</p><p><strong>Application</strong>: {{appName}}{{^appName}}All{{/appName}}</p><p><strong>Environment</strong>: {{envName}}{{^envName}}All{{/envName}}</p><p><strong>Image</strong>: {{cveException.image}}{{^cveException.image}}All{{/cveException.image}}</p><p><strong>Requested by</strong>: {{cveException.requestedBy}}</p><p><strong>Approved by</strong>: {{cveException.approvedBy}}</p><p><strong>Justification</strong>: {{cveException.justification}}</p></td></tr></table>"}');
@@ -0,0 +1,10 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ */
+
+DELETE FROM "public"."notification_templates" WHERE event_type_id = 10;
+DELETE FROM "public"."notification_settings" WHERE event_type_id = 10;
+DELETE FROM "public"."event" WHERE id = 10;
+
+DROP TABLE IF EXISTS public.image_rescan;
+DROP SEQUENCE IF EXISTS id_seq_image_rescan;
@@ -0,0 +1,89 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ */
+
+CREATE SEQUENCE IF NOT EXISTS id_seq_image_rescan;
+
+-- periodic rescan of a deployed image, previous_cve_names holds the findings at or above the notification
+-- severity before the rescan so that new findings can be diffed once the image scanner completes
+CREATE TABLE IF NOT EXISTS public.image_rescan
+(
+    "id"                                   integer NOT NULL DEFAULT nextval('id_seq_image_rescan'::regclass),
+    "image"                                varchar(500) NOT NULL,
+    "image_digest"                         varchar(250),
+    "previous_execution_history_id"        integer NOT NULL,
+    "rescan_execution_history_id"          integer,
+    "previous_cve_names"                   text[],
+    "new_cve_names"                        text[],
+    "status"                               varchar(50) NOT NULL,
+    "triggered_on"                         timestamptz NOT NULL,
+    "completed_on"                         timestamptz,
+    "created_on"                           timestamptz NOT NULL,
+    "created_by"                           integer NOT NULL,
+    "updated_on"                           timestamptz NOT NULL,
+    "updated_by"                           integer NOT NULL,
+    PRIMARY KEY ("id")
+);
+
+CREATE INDEX IF NOT EXISTS idx_image_rescan_status ON public.image_rescan (status);
+CREATE INDEX IF NOT EXISTS idx_image_rescan_image ON public.image_rescan (image);
+
+-- event ids up to 9 are taken, 9 being the scoop resource intercept event
+INSERT INTO "public"."event" (id, event_type, description)
+SELECT 10, 'IMAGE RESCAN', ''
+WHERE NOT EXISTS (SELECT 1 FROM "public"."event" WHERE id = 10);
+
+INSERT INTO "public"."notification_templates" (channel_type, node_type, event_type_id, template_name, template_payload)
+VALUES ('slack', 'CD', 10, 'Image rescan slack template', '{
+    "text": ":shield: New vulnerabilities found on rescan | Application > {{appName}} | Environment > {{envName}}",
+    "blocks": [
+        {
+            "type": "section",
+            "text": {
+                "type": "mrkdwn",
+                "text": ":shield: *New vulnerabilities found on rescan*\n<!date^{{eventTime}}^{date_long} {time} | \"-\">"
+            }
+        },
+        {
+            "type": "section",
+            "fields": [
+                {
+                    "type": "mrkdwn",
+                    "text": "*Application*\n{{appName}}"
+                },
+                {
+                    "type": "mrkdwn",
+                    "text": "*Environment*\n{{envName}}"
+                },
+                {
+                    "type": "mrkdwn",
+                    "text": "*Image*\n`{{imageRescan.image}}`"
+                }
+            ]
+        },
+        {
+            "type": "section",
+            "text": {
+                "type": "mrkdwn",
+                "text": "*Vulnerabilities*\n{{#imageRescan.newVulnerabilities}}• {{cveName}} ({{severity}}) {{package}} {{version}}{{#fixedVersion}}, fixed in {{fixedVersion}}{{/fixedVersion}}\n{{/imageRescan.newVulnerabilities}}"
+            }
+        }
+    ]
+}');
+
+INSERT INTO "public"."notification_templates" (channel_type, node_type, event_type_id, template_name, template_payload)
+VALUES ('webhook', 'CD', 10, 'Image rescan webhook template', '{
+    "eventType": "IMAGE RESCAN",
+    "eventTime": "{{eventTime}}",
+    "appName": "{{appName}}",
+    "envName": "{{envName}}",
+    "image": "{{imageRescan.image}}",
+    "imageDigest": "{{imageRescan.imageDigest}}",
+    "newVulnerabilities": "{{#imageRescan.newVulnerabilities}}{{cveName}} {{severity}} {{package}}@{{version}} fixed in {{fixedVersion}}; {{/imageRescan.newVulnerabilities}}"
+}');
+
+INSERT INTO "public"."notification_templates" (channel_type, node_type, event_type_id, template_name, template_payload)
+VALUES ('ses', 'CD', 10, 'Image rescan ses template', '{"from": "{{fromEmail}}", "to": "{{toEmail}}", "subject": "🛡️ New vulnerabilities found on rescan | Application: {{appName}} | Environment: {{envName}}", "html": "<table cellpadding=0 style=\"font-family:Arial,Verdana,Helvetica;width:600px;border:1px solid #D0D4D9;border-radius:8px;padding:20px\"><tr><td><h2 style=\"color:#000a14\">New vulnerabilities found on rescan</h2><p><strong>Application</strong>: {{appName}}</p><p><strong>Environment</strong>: {{envName}}</p><p><strong>Image</strong>: {{imageRescan.image}}</p><table cellpadding=4 style=\"border-collapse:collapse;width:100%\"><tr><th align=left>CVE</th><th align=left>Severity</th><th align=left>Package</th><th align=left>Version</th><th align=left>Fixed version</th></tr>{{#imageRescan.newVulnerabilities}}<tr><td>{{cveName}}</td><td>{{severity}}</td><td>{{package}}</td><td>{{version}}</td><td>{{fixedVersion}}</td></tr>{{/imageRescan.newVulnerabilities}}</table></td></tr></table>"}');
+
+INSERT INTO "public"."notification_templates" (channel_type, node_type, event_type_id, template_name, template_payload)
+VALUES ('smtp', 'CD', 10, 'Image rescan smtp template', '{"from": "{{fromEmail}}", "to": "{{toEmail}}", "subject": "🛡️ New vulnerabilities found on rescan | Application: {{appName}} | Environment: {{envName}}", "html": "<table cellpadding=0 style=\"font-family:Arial,Verdana,Helvetica;width:600px;border:1px solid #D0D4D9;border-radius:8px;padding:20px\"><tr><td><h2 style=\"color:#000a14\">New vulnerabilities found on rescan</h2><p><strong>Application</strong>: {{appName}}</p><p><strong>Environment</strong>: {{envName}}</p><p><strong>Image</strong>: {{imageRescan.image}}</p><table cellpadding=4 style=\"border-collapse:collapse;width:100%\"><tr><th align=left>CVE</th><th align=left>Severity</th><th align=left>Package</th><th align=left>Version</th><th align=left>Fixed version</th></tr>{{#imageRescan.newVulnerabilities}}<tr><td>{{cveName}}</td><td>{{severity}}</td><td>{{package}}</td><td>{{version}}</td><td>{{fixedVersion}}</td></tr>{{/imageRescan.newVulnerabilities}}</table></td></tr></table>"}');
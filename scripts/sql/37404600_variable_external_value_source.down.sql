@@ -0,0 +1,5 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ */
+
+ALTER TABLE "public"."variable_data" DROP COLUMN IF EXISTS "value_source";
@@ -0,0 +1,6 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ */
+
+DROP TABLE IF EXISTS public.variable_set_version;
+DROP SEQUENCE IF EXISTS public.id_seq_variable_set_version;
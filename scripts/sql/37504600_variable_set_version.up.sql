@@ -0,0 +1,29 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ */
+
+CREATE SEQUENCE IF NOT EXISTS id_seq_variable_set_version;
+
+-- immutable record of every scoped variable upload, only the ACTIVE version is saved in variable_definition/variable_data
+CREATE TABLE IF NOT EXISTS public.variable_set_version
+(
+    "id"                          integer NOT NULL DEFAULT nextval('id_seq_variable_set_version'::regclass),
+    "version"                     integer NOT NULL,
+    "payload"                     jsonb NOT NULL,
+    "diff"                        jsonb,
+    "status"                      varchar(50) NOT NULL,
+    "comment"                     text,
+    "base_version_id"             integer,
+    "rolled_back_from_version_id" integer,
+    "reviewed_by"                 integer,
+    "reviewed_on"                 timestamptz,
+    "review_comment"              text,
+    "created_on"                  timestamptz NOT NULL,
+    "created_by"                  integer NOT NULL,
+    "updated_on"                  timestamptz NOT NULL,
+    "updated_by"                  integer NOT NULL,
+    PRIMARY KEY ("id")
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_unique_variable_set_version ON public.variable_set_version (version);
+CREATE INDEX IF NOT EXISTS idx_variable_set_version_status ON public.variable_set_version (status);
@@ -0,0 +1,7 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ */
+
+DROP INDEX IF EXISTS idx_unique_manifest_policy_name;
+DROP TABLE IF EXISTS public.manifest_policy;
+DROP SEQUENCE IF EXISTS id_seq_manifest_policy;
@@ -0,0 +1,28 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ */
+
+CREATE SEQUENCE IF NOT EXISTS id_seq_manifest_policy;
+
+-- rule evaluated against every object of the rendered deployment manifest, empty env_ids and cluster_ids apply globally
+CREATE TABLE IF NOT EXISTS public.manifest_policy
+(
+    "id"          integer NOT NULL DEFAULT nextval('id_seq_manifest_policy'::regclass),
+    "name"        varchar(250) NOT NULL,
+    "description" text,
+    "engine"      varchar(50) NOT NULL,
+    "expression"  text NOT NULL,
+    "message"     text,
+    "mode"        varchar(50) NOT NULL,
+    "kinds"       text[],
+    "env_ids"     integer[],
+    "cluster_ids" integer[],
+    "active"      bool NOT NULL DEFAULT true,
+    "created_on"  timestamptz NOT NULL,
+    "created_by"  integer NOT NULL,
+    "updated_on"  timestamptz NOT NULL,
+    "updated_by"  integer NOT NULL,
+    PRIMARY KEY ("id")
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_unique_manifest_policy_name ON public.manifest_policy (name) WHERE active = true;
@@ -0,0 +1,12 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ */
+
+ALTER TABLE public.config_map_history
+    DROP COLUMN IF EXISTS "rotation_trigger",
+    DROP COLUMN IF EXISTS "rotated_resource_name",
+    DROP COLUMN IF EXISTS "restarted_workloads";
+
+DROP INDEX IF EXISTS idx_unique_cm_cs_rotation_state;
+DROP TABLE IF EXISTS public.cm_cs_rotation_state;
+DROP SEQUENCE IF EXISTS id_seq_cm_cs_rotation_state;
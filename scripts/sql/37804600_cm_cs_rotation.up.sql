@@ -0,0 +1,32 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ */
+
+CREATE SEQUENCE IF NOT EXISTS id_seq_cm_cs_rotation_state;
+
+-- last observed in-cluster data hash of an external configmap/secret having a rotation policy, per cd pipeline
+CREATE TABLE IF NOT EXISTS public.cm_cs_rotation_state
+(
+    "id"              integer NOT NULL DEFAULT nextval('id_seq_cm_cs_rotation_state'::regclass),
+    "pipeline_id"     integer NOT NULL,
+    "app_id"          integer NOT NULL,
+    "env_id"          integer NOT NULL,
+    "resource_type"   varchar(50) NOT NULL,
+    "name"            varchar(250) NOT NULL,
+    "data_hash"       varchar(100) NOT NULL,
+    "last_rotated_on" timestamptz,
+    "created_on"      timestamptz NOT NULL,
+    "created_by"      integer NOT NULL,
+    "updated_on"      timestamptz NOT NULL,
+    "updated_by"      integer NOT NULL,
+    PRIMARY KEY ("id"),
+    CONSTRAINT cm_cs_rotation_state_pipeline_id_fkey FOREIGN KEY ("pipeline_id") REFERENCES public.pipeline ("id")
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_unique_cm_cs_rotation_state ON public.cm_cs_rotation_state (pipeline_id, resource_type, name);
+
+-- rotation entries are recorded in config_map_history with deployed = false
+ALTER TABLE public.config_map_history
+    ADD COLUMN IF NOT EXISTS "rotation_trigger" varchar(50),
+    ADD COLUMN IF NOT EXISTS "rotated_resource_name" varchar(250),
+    ADD COLUMN IF NOT EXISTS "restarted_workloads" text;
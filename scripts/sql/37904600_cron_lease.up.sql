@@ -0,0 +1,12 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ */
+
+-- lease taken by a cron so that it runs on one replica at a time, an expired lease can be taken over by any replica
+CREATE TABLE IF NOT EXISTS public.cron_lease
+(
+    "key"        varchar(100) NOT NULL,
+    "holder"     varchar(250) NOT NULL,
+    "expires_on" timestamptz NOT NULL,
+    PRIMARY KEY ("key")
+);
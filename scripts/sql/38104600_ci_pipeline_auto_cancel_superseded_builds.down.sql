@@ -0,0 +1,5 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ */
+
+ALTER TABLE ci_pipeline DROP COLUMN IF EXISTS auto_cancel_superseded_builds;
@@ -0,0 +1,10 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ */
+
+DROP TABLE IF EXISTS public.chart_repo_mirror_file;
+DROP SEQUENCE IF EXISTS id_seq_chart_repo_mirror_file;
+
+ALTER TABLE public.chart_repo
+    DROP COLUMN IF EXISTS mirror_pull_token,
+    DROP COLUMN IF EXISTS mirror_sync_heartbeat_on;
@@ -0,0 +1,28 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ */
+
+-- helm clients pull a mirrored repository with the pull token, the heartbeat tells a running sync from an interrupted one
+ALTER TABLE public.chart_repo
+    ADD COLUMN IF NOT EXISTS mirror_pull_token VARCHAR(100),
+    ADD COLUMN IF NOT EXISTS mirror_sync_heartbeat_on TIMESTAMPTZ;
+
+CREATE SEQUENCE IF NOT EXISTS id_seq_chart_repo_mirror_file;
+
+-- index file and chart archives of mirrored chart repositories, served by any replica of devtron
+CREATE TABLE IF NOT EXISTS public.chart_repo_mirror_file
+(
+    "id"            integer NOT NULL DEFAULT nextval('id_seq_chart_repo_mirror_file'::regclass),
+    "chart_repo_id" integer NOT NULL,
+    "file_path"     varchar(500) NOT NULL,
+    "content"       bytea NOT NULL,
+    "digest"        varchar(100),
+    "created_on"    timestamptz NOT NULL,
+    "created_by"    integer NOT NULL,
+    "updated_on"    timestamptz NOT NULL,
+    "updated_by"    integer NOT NULL,
+    CONSTRAINT "chart_repo_mirror_file_chart_repo_id_fkey" FOREIGN KEY ("chart_repo_id") REFERENCES "public"."chart_repo" ("id"),
+    PRIMARY KEY ("id")
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_unique_chart_repo_mirror_file ON public.chart_repo_mirror_file (chart_repo_id, file_path);
@@ -0,0 +1,6 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ */
+
+ALTER TABLE public.ci_pipeline_build_cache_config
+    RENAME COLUMN last_invalidated_on TO last_purged_on;
@@ -0,0 +1,7 @@
+/*
+ * Copyright (c) 2025. Devtron Inc.
+ */
+
+-- the cache of a pipeline is invalidated by bumping cache_version, the old cache is not deleted
+ALTER TABLE public.ci_pipeline_build_cache_config
+    RENAME COLUMN last_purged_on TO last_invalidated_on;
@@ -21,6 +21,8 @@ type EventType int
 const Trigger EventType = 1
 const Success EventType = 2
 const Fail EventType = 3
+const ImageRescanVulnerability EventType = 10
+const CveExceptionExpiry EventType = 11
 
 type PipelineType string
 